@@ -0,0 +1,71 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	cmdutil "github.com/tofutf/tofutf/cmd"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/agent"
+	otfapi "github.com/tofutf/tofutf/internal/api"
+	"github.com/tofutf/tofutf/internal/xslog"
+)
+
+// errJobFailed is returned when the job executed by the execute command fails.
+// The reason has already been reported, so it is not printed.
+var errJobFailed = errors.New("job failed")
+
+// executeCommand executes a single job. It is run within the pods created by
+// the kubernetes executor and is not intended to be run by users.
+func executeCommand() (*cobra.Command, error) {
+	var (
+		clientConfig otfapi.Config
+		agentConfig  *agent.Config
+		opts         agent.ExecuteJobOptions
+		phase        string
+	)
+
+	cmd := &cobra.Command{
+		Use:    "execute",
+		Short:  "Execute a single job",
+		Args:   cobra.NoArgs,
+		Hidden: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Spec.Phase = internal.PhaseType(phase)
+			opts.Out = os.Stdout
+
+			// the job's output is written to stdout and stderr, which are
+			// streamed to the run's logs, so discard the agent's own logs.
+			logger := slog.New(&xslog.NoopHandler{})
+
+			if err := agent.ExecuteJob(cmd.Context(), logger, *agentConfig, clientConfig, opts); err != nil {
+				if err := agent.WriteTerminationMessage(err); err != nil {
+					return err
+				}
+				return errJobFailed
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&clientConfig.Address, "address", otfapi.DefaultAddress, "Address of OTF server")
+	cmd.Flags().StringVar(&clientConfig.Token, "token", "", "Job token for authentication")
+	cmd.Flags().StringVar(&opts.AgentID, "agent-id", "", "ID of agent executing the job")
+	cmd.Flags().StringVar(&opts.Spec.RunID, "run-id", "", "ID of the job's run")
+	cmd.Flags().StringVar(&phase, "phase", "", "Phase of the job's run")
+	cmd.Flags().StringVar(&opts.WorkspaceID, "workspace-id", "", "ID of the job's workspace")
+	cmd.MarkFlagRequired("token")        //nolint:errcheck
+	cmd.MarkFlagRequired("run-id")       //nolint:errcheck
+	cmd.MarkFlagRequired("phase")        //nolint:errcheck
+	cmd.MarkFlagRequired("workspace-id") //nolint:errcheck
+
+	agentConfig = agent.NewConfigFromFlags(cmd.Flags())
+
+	if err := cmdutil.SetFlagsFromEnvVariables(cmd.Flags()); err != nil {
+		return nil, errors.Wrap(err, "failed to populate config from environment vars")
+	}
+
+	return cmd, nil
+}
@@ -27,7 +27,9 @@ func main() {
 	}()
 
 	if err := run(ctx, os.Args[1:]); err != nil {
-		cmdutil.PrintError(err)
+		if !errors.Is(err, errJobFailed) {
+			cmdutil.PrintError(err)
+		}
 		os.Exit(1)
 	}
 }
@@ -79,10 +81,17 @@ func run(ctx context.Context, args []string) error {
 
 	loggerConfig = xslog.NewConfigFromFlags(cmd.Flags())
 	agentConfig = agent.NewConfigFromFlags(cmd.Flags())
+	agent.AddExecutorFlags(cmd.Flags(), agentConfig)
 
 	if err := cmdutil.SetFlagsFromEnvVariables(cmd.Flags()); err != nil {
 		return errors.Wrap(err, "failed to populate config from environment vars")
 	}
 
+	executeCmd, err := executeCommand()
+	if err != nil {
+		return err
+	}
+	cmd.AddCommand(executeCmd)
+
 	return cmd.ExecuteContext(ctx)
 }
@@ -12,11 +12,14 @@ import (
 	cmdutil "github.com/tofutf/tofutf/cmd"
 	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/agent"
+	"github.com/tofutf/tofutf/internal/audit"
 	"github.com/tofutf/tofutf/internal/authenticator"
 	"github.com/tofutf/tofutf/internal/daemon"
 	"github.com/tofutf/tofutf/internal/github"
 	"github.com/tofutf/tofutf/internal/gitlab"
 	"github.com/tofutf/tofutf/internal/otel"
+	"github.com/tofutf/tofutf/internal/provider"
+	"github.com/tofutf/tofutf/internal/run"
 	"github.com/tofutf/tofutf/internal/xslog"
 )
 
@@ -92,6 +95,8 @@ func parseFlags(ctx context.Context, args []string, out io.Writer) error {
 	cmd.Flags().StringVar(&cfg.Host, "hostname", "", "User-facing hostname for otf")
 	cmd.Flags().StringVar(&cfg.SiteToken, "site-token", "", "API token with site-wide unlimited permissions. Use with care.")
 	cmd.Flags().StringSliceVar(&cfg.SiteAdmins, "site-admins", nil, "Promote a list of users to site admin.")
+	cmd.Flags().StringVar(&cfg.SCIMToken, "scim-token", "", "Bearer token for identity providers provisioning users via SCIM. Empty disables SCIM.")
+	cmd.Flags().StringVar(&cfg.SCIMOrganization, "scim-organization", "", "Organization in which SCIM groups are provisioned as teams.")
 	cmd.Flags().BytesHexVar(&cfg.Secret, "secret", nil, "Hex-encoded 16 byte secret for cryptographic work. Required.")
 	cmd.Flags().Int64Var(&cfg.MaxConfigSize, "max-config-size", cfg.MaxConfigSize, "Maximum permitted configuration size in bytes.")
 	cmd.Flags().StringVar(&cfg.WebhookHost, "webhook-hostname", "", "External hostname for otf webhooks")
@@ -122,6 +127,21 @@ func parseFlags(ctx context.Context, args []string, out io.Writer) error {
 	cmd.Flags().StringVar(&cfg.OIDC.ClientSecret, "oidc-client-secret", "", "OIDC client secret")
 	cmd.Flags().StringSliceVar(&cfg.OIDC.Scopes, "oidc-scopes", authenticator.DefaultOIDCScopes, "OIDC scopes")
 	cmd.Flags().StringVar(&cfg.OIDC.UsernameClaim, "oidc-username-claim", string(authenticator.DefaultUsernameClaim), "OIDC claim to be used for username (name, email, or sub)")
+	cmd.Flags().StringVar(&cfg.OIDC.GroupsClaim, "oidc-groups-claim", "", "OIDC claim providing the groups to which the user belongs")
+	cmd.Flags().StringSliceVar(&cfg.OIDC.TeamMappings, "oidc-team-mappings", nil, "Map OIDC groups to teams, in the format <group>=<organization>/<team>")
+
+	cmd.Flags().StringVar(&cfg.SAML.Name, "saml-name", "saml", "User friendly SAML identity provider name")
+	cmd.Flags().StringVar(&cfg.SAML.IDPMetadata, "saml-idp-metadata", "", "URL or path to SAML identity provider metadata")
+	cmd.Flags().StringVar(&cfg.SAML.EntityID, "saml-entity-id", "", "SAML service provider entity ID (default: the URL of the service provider metadata)")
+	cmd.Flags().StringVar(&cfg.SAML.CertFile, "saml-cert-file", "", "Path to certificate for signing SAML requests and decrypting assertions")
+	cmd.Flags().StringVar(&cfg.SAML.KeyFile, "saml-key-file", "", "Path to key for signing SAML requests and decrypting assertions")
+	cmd.Flags().StringVar(&cfg.SAML.UsernameAttribute, "saml-username-attribute", "", "SAML attribute to be used for username (default: the subject NameID)")
+	cmd.Flags().StringVar(&cfg.SAML.GroupsAttribute, "saml-groups-attribute", "", "SAML attribute providing the groups to which the user belongs")
+	cmd.Flags().StringSliceVar(&cfg.SAML.TeamMappings, "saml-team-mappings", nil, "Map SAML groups to teams, in the format <group>=<organization>/<team>")
+
+	cmd.Flags().DurationVar(&cfg.AssessmentInterval, "assessment-interval", run.DefaultAssessmentInterval, "Period between health assessments of workspaces with assessments enabled.")
+	cmd.Flags().DurationVar(&cfg.AuditRetention, "audit-retention", audit.DefaultRetention, "Period for which audit events are retained. Set to 0 to retain audit events indefinitely.")
+	cmd.Flags().StringVar(&cfg.AuditSinkURL, "audit-sink-url", "", "URL to which audit events are streamed as JSON via HTTP POST.")
 
 	cmd.Flags().BoolVar(&cfg.RestrictOrganizationCreation, "restrict-org-creation", false, "Restrict organization creation capability to site admin role")
 
@@ -129,8 +149,13 @@ func parseFlags(ctx context.Context, args []string, out io.Writer) error {
 
 	cmd.Flags().StringVar(&cfg.ProviderProxy.URL, "provider-proxy-url", "", "The URL of the provider registry to proxy provider registry requests to")
 	cmd.Flags().BoolVar(&cfg.ProviderProxy.IsArtifactory, "provider-proxy-is-artifactory", false, "Set to true if using artifactory as the backing provider registry")
+	cmd.Flags().StringSliceVar(&cfg.ProviderMirror.Hosts, "provider-mirror-hosts", provider.DefaultMirrorHosts, "Upstream registries from which the provider network mirror is permitted to retrieve providers")
+	cmd.Flags().DurationVar(&cfg.ProviderMirror.Retention, "provider-mirror-retention", provider.DefaultMirrorRetention, "Period for which the provider network mirror caches a version of a provider. Set to 0 to cache versions indefinitely.")
 	cmd.Flags().BoolVar(&cfg.EnableOtel, "otel", false, "enable opentelemetry integration")
 
+	addBlobStoreFlags(cmd.Flags(), &cfg.BlobStore)
+	addEncryptionKeysFlag(cmd.Flags(), &cfg.EncryptionKeys)
+
 	loggerConfig = xslog.NewConfigFromFlags(cmd.Flags())
 	cfg.AgentConfig = agent.NewConfigFromFlags(cmd.Flags())
 
@@ -138,6 +163,18 @@ func parseFlags(ctx context.Context, args []string, out io.Writer) error {
 		return errors.Wrap(err, "failed to populate config from environment vars")
 	}
 
+	migrateBlobsCmd, err := migrateBlobsCommand()
+	if err != nil {
+		return err
+	}
+	cmd.AddCommand(migrateBlobsCmd)
+
+	reencryptCmd, err := reencryptCommand()
+	if err != nil {
+		return err
+	}
+	cmd.AddCommand(reencryptCmd)
+
 	cmd.SetArgs(args)
 	return cmd.ExecuteContext(ctx)
 }
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	cmdutil "github.com/tofutf/tofutf/cmd"
+	"github.com/tofutf/tofutf/internal/blob"
+	"github.com/tofutf/tofutf/internal/sql"
+	"github.com/tofutf/tofutf/internal/xslog"
+)
+
+// addBlobStoreFlags adds flags for configuring the blob store.
+func addBlobStoreFlags(flags *pflag.FlagSet, cfg *blob.Config) {
+	flags.StringVar((*string)(&cfg.Backend), "blob-store", string(blob.PostgresBackend), "Where to persist state files, configuration tarballs, plan files, module tarballs, provider binaries and logs: postgres, local, or s3")
+	flags.StringVar(&cfg.LocalDir, "blob-store-dir", "", "Directory in which to persist blobs (required if blob store is local)")
+	flags.StringVar(&cfg.S3.Bucket, "s3-bucket", "", "S3 bucket in which to persist blobs (required if blob store is s3)")
+	flags.StringVar(&cfg.S3.Endpoint, "s3-endpoint", "", "Hostname and optional port of an S3-compatible object store. Defaults to AWS S3")
+	flags.StringVar(&cfg.S3.Region, "s3-region", "", "S3 region")
+	flags.StringVar(&cfg.S3.AccessKeyID, "s3-access-key-id", "", "S3 access key ID. If unspecified then credentials are sourced from the environment")
+	flags.StringVar(&cfg.S3.SecretAccessKey, "s3-secret-access-key", "", "S3 secret access key")
+	flags.BoolVar(&cfg.S3.Insecure, "s3-insecure", false, "Connect to the S3 endpoint using HTTP rather than HTTPS")
+	flags.BoolVar(&cfg.S3.UsePathStyle, "s3-use-path-style", false, "Use path-style addressing of S3 buckets")
+}
+
+// migrateBlobsCommand moves blobs from the database to the blob store.
+func migrateBlobsCommand() (*cobra.Command, error) {
+	var (
+		database     string
+		blobCfg      blob.Config
+		loggerConfig *xslog.Config
+	)
+
+	cmd := &cobra.Command{
+		Use:   "migrate-blobs",
+		Short: "Move blobs from the database to the blob store",
+		Long:  "Move state files, configuration tarballs, plan files, module tarballs, provider binaries and logs persisted in the database to the configured blob store. It is safe to re-run if interrupted.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger, err := xslog.New(loggerConfig)
+			if err != nil {
+				return err
+			}
+
+			store, err := blob.NewStore(blobCfg)
+			if err != nil {
+				return err
+			}
+
+			db, err := sql.New(cmd.Context(), sql.Options{
+				Logger:     logger,
+				ConnString: database,
+			})
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			return blob.Migrate(cmd.Context(), logger, db, store)
+		},
+	}
+
+	cmd.Flags().StringVar(&database, "database", defaultDatabase, "Postgres connection string")
+	addBlobStoreFlags(cmd.Flags(), &blobCfg)
+	loggerConfig = xslog.NewConfigFromFlags(cmd.Flags())
+
+	if err := cmdutil.SetFlagsFromEnvVariables(cmd.Flags()); err != nil {
+		return nil, errors.Wrap(err, "failed to populate config from environment vars")
+	}
+
+	return cmd, nil
+}
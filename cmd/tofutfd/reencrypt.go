@@ -0,0 +1,81 @@
+package main
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	cmdutil "github.com/tofutf/tofutf/cmd"
+	"github.com/tofutf/tofutf/internal/blob"
+	"github.com/tofutf/tofutf/internal/encryption"
+	"github.com/tofutf/tofutf/internal/sql"
+	"github.com/tofutf/tofutf/internal/xslog"
+)
+
+// addEncryptionKeysFlag adds a flag for configuring encryption keys.
+func addEncryptionKeysFlag(flags *pflag.FlagSet, keys *[]string) {
+	flags.StringSliceVar(keys, "encryption-keys", nil, "Hex-encoded 32 byte keys for encrypting sensitive data at rest. The first key encrypts data; the remainder are previous keys retained for decrypting data. If unspecified then a key derived from the secret is used.")
+}
+
+// reencryptCommand re-encrypts sensitive data with the primary encryption key.
+func reencryptCommand() (*cobra.Command, error) {
+	var (
+		database       string
+		secret         []byte
+		encryptionKeys []string
+		blobCfg        blob.Config
+		loggerConfig   *xslog.Config
+	)
+
+	cmd := &cobra.Command{
+		Use:   "reencrypt",
+		Short: "Re-encrypt sensitive data with the primary encryption key",
+		Long:  "Re-encrypt sensitive variable values, state files, sensitive state outputs and plan files with the first of the configured encryption keys, encrypting data persisted before encryption was enabled and data encrypted with previous keys. Run after adding a new key to the front of --encryption-keys, after which previous keys can be removed. It is safe to re-run if interrupted.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger, err := xslog.New(loggerConfig)
+			if err != nil {
+				return err
+			}
+
+			if secret == nil {
+				return errors.New("--secret is required")
+			}
+			keys, err := encryption.ParseKeys(encryptionKeys...)
+			if err != nil {
+				return err
+			}
+			keyring, err := encryption.NewKeyring(secret, keys...)
+			if err != nil {
+				return err
+			}
+
+			store, err := blob.NewStore(blobCfg)
+			if err != nil {
+				return err
+			}
+
+			db, err := sql.New(cmd.Context(), sql.Options{
+				Logger:     logger,
+				ConnString: database,
+			})
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			return encryption.Reencrypt(cmd.Context(), logger, db, store, keyring)
+		},
+	}
+
+	cmd.Flags().StringVar(&database, "database", defaultDatabase, "Postgres connection string")
+	cmd.Flags().BytesHexVar(&secret, "secret", nil, "Hex-encoded 16 byte secret for cryptographic work. Required.")
+	addEncryptionKeysFlag(cmd.Flags(), &encryptionKeys)
+	addBlobStoreFlags(cmd.Flags(), &blobCfg)
+	loggerConfig = xslog.NewConfigFromFlags(cmd.Flags())
+
+	if err := cmdutil.SetFlagsFromEnvVariables(cmd.Flags()); err != nil {
+		return nil, errors.Wrap(err, "failed to populate config from environment vars")
+	}
+
+	return cmd, nil
+}
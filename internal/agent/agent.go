@@ -49,6 +49,9 @@ type Agent struct {
 	// ID of agent' pool. If nil then the agent is assumed to be a server agent
 	// (otfd).
 	AgentPoolID *string `jsonapi:"attribute" json:"agent-pool-id"`
+	// Labels advertise the agent's capabilities, and are matched against a
+	// workspace's agent selector when allocating jobs.
+	Labels map[string]string `jsonapi:"attribute" json:"labels"`
 }
 
 type registerAgentOptions struct {
@@ -64,6 +67,8 @@ type registerAgentOptions struct {
 	// ID of agent's pool. If unset then the agent is assumed to be a server
 	// agent (which does not belong to a pool).
 	AgentPoolID *string `json:"-"`
+	// Labels advertising the agent's capabilities. Optional.
+	Labels map[string]string `json:"labels,omitempty"`
 	// CurrentJobs are those jobs the agent has discovered leftover from a
 	// previous agent. Not currently used but may be made use of in later
 	// versions.
@@ -76,12 +81,16 @@ type registrar struct {
 }
 
 func (f *registrar) register(ctx context.Context, opts registerAgentOptions) (*Agent, error) {
+	if err := internal.ValidateLabels(opts.Labels); err != nil {
+		return nil, err
+	}
 	agent := &Agent{
 		ID:          internal.NewID("agent"),
 		Name:        opts.Name,
 		Version:     opts.Version,
 		MaxJobs:     opts.Concurrency,
 		AgentPoolID: opts.AgentPoolID,
+		Labels:      opts.Labels,
 	}
 	if err := agent.setStatus(AgentIdle, true); err != nil {
 		return nil, err
@@ -123,6 +132,14 @@ func (a *Agent) setStatus(status AgentStatus, ping bool) error {
 	return nil
 }
 
+// load returns the proportion of the agent's capacity that is in use.
+func (a *Agent) load() float64 {
+	if a.MaxJobs == 0 {
+		return 1
+	}
+	return float64(a.CurrentJobs) / float64(a.MaxJobs)
+}
+
 // IsServer determines whether the agent is part of the server process (otfd) or
 // a separate process (otf-agent).
 func (a *Agent) IsServer() bool { return a.AgentPoolID == nil }
@@ -1,11 +1,13 @@
 package agent
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"log/slog"
 	"slices"
 
+	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/pubsub"
 )
 
@@ -154,6 +156,12 @@ func (a *allocator) allocate(ctx context.Context) error {
 			// job running; ignore
 			continue
 		}
+		// only allocate job to agents with labels matching the job's selector
+		selector, err := internal.ParseLabelSelector(job.AgentSelector)
+		if err != nil {
+			a.logger.Error("parsing agent selector for job", "job", job, "err", err)
+			continue
+		}
 		// allocate job to available agent
 		var available []*Agent
 		for _, agent := range a.agents {
@@ -178,25 +186,28 @@ func (a *allocator) allocate(ctx context.Context) error {
 					continue
 				}
 			}
+			if !selector.Matches(agent.Labels) {
+				continue
+			}
 			available = append(available, agent)
 		}
 		if len(available) == 0 {
-			a.logger.Error("no available agents found for job", "job", job)
+			a.logger.Error("no available agents found for job", "job", job, "selector", job.AgentSelector)
 			continue
 		}
-		// select agent that has most recently sent a ping
+		// select the least loaded agent, i.e. the agent with the lowest
+		// proportion of its capacity in use, and if there is more than one
+		// such agent then select the agent that has most recently sent a ping.
 		slices.SortFunc(available, func(a, b *Agent) int {
-			if a.LastPingAt.After(b.LastPingAt) {
-				// a with more recent ping comes first in list
-				return -1
-			} else {
-				return 1
+			if load := cmp.Compare(a.load(), b.load()); load != 0 {
+				return load
 			}
+			// a with more recent ping comes first in list
+			return b.LastPingAt.Compare(a.LastPingAt)
 		})
 		var (
 			agent      = available[0]
 			updatedJob *Job
-			err        error
 		)
 		if reallocate {
 			from := *job.AgentID
@@ -96,6 +96,67 @@ func TestAllocator_allocate(t *testing.T) {
 				"agent-old": {ID: "agent-old", Status: AgentIdle, MaxJobs: 1, CurrentJobs: 0, LastPingAt: now.Add(-time.Second)},
 			},
 		},
+		{
+			name: "allocate job to least loaded agent",
+			agents: []*Agent{
+				{ID: "agent-busy", Status: AgentBusy, MaxJobs: 2, CurrentJobs: 1, LastPingAt: now},
+				{ID: "agent-quiet", Status: AgentBusy, MaxJobs: 4, CurrentJobs: 1, LastPingAt: now.Add(-time.Second)},
+			},
+			job: &Job{
+				Spec:   JobSpec{RunID: "run-123", Phase: internal.PlanPhase},
+				Status: JobUnallocated,
+			},
+			wantJob: &Job{
+				Spec:    JobSpec{RunID: "run-123", Phase: internal.PlanPhase},
+				Status:  JobAllocated,
+				AgentID: internal.String("agent-quiet"),
+			},
+			wantAgents: map[string]*Agent{
+				"agent-busy":  {ID: "agent-busy", Status: AgentBusy, MaxJobs: 2, CurrentJobs: 1, LastPingAt: now},
+				"agent-quiet": {ID: "agent-quiet", Status: AgentBusy, MaxJobs: 4, CurrentJobs: 2, LastPingAt: now.Add(-time.Second)},
+			},
+		},
+		{
+			name: "allocate job to agent with labels matching selector",
+			agents: []*Agent{
+				{ID: "agent-us", Status: AgentIdle, MaxJobs: 1, Labels: map[string]string{"region": "us"}},
+				{ID: "agent-eu", Status: AgentIdle, MaxJobs: 1, Labels: map[string]string{"region": "eu"}},
+			},
+			job: &Job{
+				Spec:          JobSpec{RunID: "run-123", Phase: internal.PlanPhase},
+				Status:        JobUnallocated,
+				AgentSelector: "region=eu",
+			},
+			wantJob: &Job{
+				Spec:          JobSpec{RunID: "run-123", Phase: internal.PlanPhase},
+				Status:        JobAllocated,
+				AgentSelector: "region=eu",
+				AgentID:       internal.String("agent-eu"),
+			},
+			wantAgents: map[string]*Agent{
+				"agent-us": {ID: "agent-us", Status: AgentIdle, MaxJobs: 1, Labels: map[string]string{"region": "us"}},
+				"agent-eu": {ID: "agent-eu", Status: AgentIdle, MaxJobs: 1, CurrentJobs: 1, Labels: map[string]string{"region": "eu"}},
+			},
+		},
+		{
+			name: "do not allocate job to agent with labels not matching selector",
+			agents: []*Agent{
+				{ID: "agent-1", Status: AgentIdle, MaxJobs: 1, Labels: map[string]string{"gpu": "false"}},
+			},
+			job: &Job{
+				Spec:          JobSpec{RunID: "run-123", Phase: internal.PlanPhase},
+				Status:        JobUnallocated,
+				AgentSelector: "gpu=true",
+			},
+			wantJob: &Job{
+				Spec:          JobSpec{RunID: "run-123", Phase: internal.PlanPhase},
+				Status:        JobUnallocated,
+				AgentSelector: "gpu=true",
+			},
+			wantAgents: map[string]*Agent{
+				"agent-1": {ID: "agent-1", Status: AgentIdle, MaxJobs: 1, Labels: map[string]string{"gpu": "false"}},
+			},
+		},
 		{
 			name:  "allocate job to pool agent",
 			pools: []*Pool{{ID: "pool-1"}},
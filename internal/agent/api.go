@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal"
 	otfapi "github.com/tofutf/tofutf/internal/api"
 	"github.com/tofutf/tofutf/internal/http/decode"
 	"github.com/tofutf/tofutf/internal/tfeapi"
@@ -26,6 +27,11 @@ type (
 		JobSpec
 		finishJobOptions
 	}
+
+	createWorkloadIdentityTokenParams struct {
+		JobSpec
+		createWorkloadIdentityTokenOptions
+	}
 )
 
 func (a *api) addHandlers(r *mux.Router) {
@@ -37,6 +43,7 @@ func (a *api) addHandlers(r *mux.Router) {
 	r.HandleFunc("/agents/status", a.updateStatus).Methods("POST")
 	r.HandleFunc("/agents/start", a.startJob).Methods("POST")
 	r.HandleFunc("/agents/finish", a.finishJob).Methods("POST")
+	r.HandleFunc("/agents/workload-identity-token", a.createWorkloadIdentityToken).Methods("POST")
 
 	// agent tokens
 	r.HandleFunc("/agent-tokens/{pool_id}/create", a.createAgentToken).Methods("POST")
@@ -53,7 +60,10 @@ func (a *api) registerAgent(w http.ResponseWriter, r *http.Request) {
 	opts.IPAddress = net.ParseIP(r.RemoteAddr)
 
 	agent, err := a.service.registerAgent(r.Context(), opts)
-	if err != nil {
+	if errors.Is(err, internal.ErrInvalidLabel) {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	} else if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -149,3 +159,17 @@ func (a *api) finishJob(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+func (a *api) createWorkloadIdentityToken(w http.ResponseWriter, r *http.Request) {
+	var params createWorkloadIdentityTokenParams
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	token, err := a.service.createWorkloadIdentityToken(r.Context(), params.JobSpec, params.createWorkloadIdentityTokenOptions)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	w.Write(token) //nolint:errcheck
+}
@@ -0,0 +1,60 @@
+package agent
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// cgroupCPUPeriod is the period in microseconds over which the CPU quota of a
+// job's cgroup is enforced.
+const cgroupCPUPeriod = 100000
+
+// resourceLimits are limits on the resources available to the processes of a
+// job, in the format of the cgroup v2 interface files.
+type resourceLimits struct {
+	cpuMax    string // value for cpu.max, e.g. "150000 100000"; empty means no limit
+	memoryMax string // value for memory.max, e.g. "1073741824"; empty means no limit
+}
+
+// newResourceLimits constructs resource limits from a number of CPUs, e.g. 1.5
+// or 500m, and an amount of memory, e.g. 512Mi or 2G. An empty string means
+// there is no limit for that resource.
+func newResourceLimits(cpu, memory string) (*resourceLimits, error) {
+	var limits resourceLimits
+	if cpu != "" {
+		q, err := resource.ParseQuantity(cpu)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cpu limit: %w", err)
+		}
+		quota := q.MilliValue() * cgroupCPUPeriod / 1000
+		if quota <= 0 {
+			return nil, errors.New("invalid cpu limit: must be greater than zero")
+		}
+		limits.cpuMax = fmt.Sprintf("%d %d", quota, cgroupCPUPeriod)
+	}
+	if memory != "" {
+		q, err := resource.ParseQuantity(memory)
+		if err != nil {
+			return nil, fmt.Errorf("invalid memory limit: %w", err)
+		}
+		if q.Value() <= 0 {
+			return nil, errors.New("invalid memory limit: must be greater than zero")
+		}
+		limits.memoryMax = strconv.FormatInt(q.Value(), 10)
+	}
+	return &limits, nil
+}
+
+// controllers returns the cgroup controllers required to enforce the limits.
+func (l *resourceLimits) controllers() (controllers []string) {
+	if l.cpuMax != "" {
+		controllers = append(controllers, "cpu")
+	}
+	if l.memoryMax != "" {
+		controllers = append(controllers, "memory")
+	}
+	return controllers
+}
@@ -0,0 +1,121 @@
+package agent
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// cgroupRoot is the mount point of the cgroup v2 hierarchy.
+const cgroupRoot = "/sys/fs/cgroup"
+
+// cgroupManager creates a cgroup for each job, limiting the resources of the
+// job's processes. Job cgroups are created beneath the agent's own cgroup.
+type cgroupManager struct {
+	*resourceLimits
+
+	// path to the agent's cgroup
+	parent string
+}
+
+// newCgroupManager constructs a cgroup manager. The agent moves itself into a
+// leaf cgroup beneath its own cgroup, because cgroup v2 only permits
+// controllers to be enabled for the children of a cgroup that contains no
+// processes.
+func newCgroupManager(limits *resourceLimits) (*cgroupManager, error) {
+	parent, err := ownCgroup()
+	if err != nil {
+		return nil, err
+	}
+	leaf := filepath.Join(parent, "agent")
+	if err := os.MkdirAll(leaf, 0o755); err != nil {
+		return nil, fmt.Errorf("creating agent cgroup: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(leaf, "cgroup.procs"), []byte(strconv.Itoa(os.Getpid())), 0o644); err != nil {
+		return nil, fmt.Errorf("moving agent into its own cgroup: %w", err)
+	}
+	var enable []string
+	for _, controller := range limits.controllers() {
+		enable = append(enable, "+"+controller)
+	}
+	if err := os.WriteFile(filepath.Join(parent, "cgroup.subtree_control"), []byte(strings.Join(enable, " ")), 0o644); err != nil {
+		return nil, fmt.Errorf("enabling cgroup controllers %v; the agent's cgroup must be delegated these controllers: %w", limits.controllers(), err)
+	}
+	return &cgroupManager{resourceLimits: limits, parent: parent}, nil
+}
+
+// ownCgroup returns the path to the cgroup of the current process.
+func ownCgroup() (string, error) {
+	f, err := os.Open("/proc/self/cgroup")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// the cgroup v2 hierarchy has ID 0 and no controllers listed
+		if path, ok := strings.CutPrefix(scanner.Text(), "0::"); ok {
+			return filepath.Join(cgroupRoot, path), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", errors.New("resource limits require cgroup v2")
+}
+
+// create creates a cgroup for a job.
+func (m *cgroupManager) create(spec JobSpec) (*cgroup, error) {
+	path := filepath.Join(m.parent, fmt.Sprintf("job-%s-%s", spec.RunID, spec.Phase))
+	if err := os.Mkdir(path, 0o755); err != nil {
+		return nil, err
+	}
+	cg := &cgroup{path: path}
+	write := func(name, value string) error {
+		if value == "" {
+			return nil
+		}
+		return os.WriteFile(filepath.Join(path, name), []byte(value), 0o644)
+	}
+	if err := write("cpu.max", m.cpuMax); err != nil {
+		return nil, errors.Join(err, cg.close())
+	}
+	if err := write("memory.max", m.memoryMax); err != nil {
+		return nil, errors.Join(err, cg.close())
+	}
+	dir, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Join(err, cg.close())
+	}
+	cg.dir = dir
+	return cg, nil
+}
+
+// cgroup is a cgroup for a job.
+type cgroup struct {
+	path string
+	dir  *os.File
+}
+
+// apply configures the command to start its process within the cgroup.
+func (c *cgroup) apply(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		UseCgroupFD: true,
+		CgroupFD:    int(c.dir.Fd()),
+	}
+}
+
+// close removes the cgroup, which must no longer contain any processes.
+func (c *cgroup) close() error {
+	if c.dir != nil {
+		c.dir.Close()
+	}
+	return os.Remove(c.path)
+}
@@ -0,0 +1,26 @@
+//go:build !linux
+
+package agent
+
+import (
+	"errors"
+	"os/exec"
+)
+
+// cgroupManager is unsupported on this platform.
+type cgroupManager struct{}
+
+func newCgroupManager(*resourceLimits) (*cgroupManager, error) {
+	return nil, errors.New("resource limits are only supported on linux")
+}
+
+func (m *cgroupManager) create(JobSpec) (*cgroup, error) {
+	return nil, errors.New("resource limits are only supported on linux")
+}
+
+// cgroup is unsupported on this platform.
+type cgroup struct{}
+
+func (c *cgroup) apply(*exec.Cmd) {}
+
+func (c *cgroup) close() error { return nil }
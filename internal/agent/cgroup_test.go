@@ -0,0 +1,39 @@
+package agent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewResourceLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		cpu     string
+		memory  string
+		want    *resourceLimits
+		wantErr bool
+	}{
+		{"no limits", "", "", &resourceLimits{}, false},
+		{"whole cpus", "2", "", &resourceLimits{cpuMax: "200000 100000"}, false},
+		{"fractional cpus", "1.5", "", &resourceLimits{cpuMax: "150000 100000"}, false},
+		{"millicpus", "500m", "", &resourceLimits{cpuMax: "50000 100000"}, false},
+		{"memory", "", "1Gi", &resourceLimits{memoryMax: "1073741824"}, false},
+		{"both", "1", "512M", &resourceLimits{cpuMax: "100000 100000", memoryMax: "512000000"}, false},
+		{"invalid cpu", "lots", "", nil, true},
+		{"zero cpu", "0", "", nil, true},
+		{"invalid memory", "", "-1Gi", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newResourceLimits(tt.cpu, tt.memory)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -122,3 +122,18 @@ func (c *client) finishJob(ctx context.Context, spec JobSpec, opts finishJobOpti
 	}
 	return nil
 }
+
+func (c *client) createWorkloadIdentityToken(ctx context.Context, spec JobSpec, opts createWorkloadIdentityTokenOptions) ([]byte, error) {
+	req, err := c.NewRequest("POST", "agents/workload-identity-token", &createWorkloadIdentityTokenParams{
+		JobSpec:                            spec,
+		createWorkloadIdentityTokenOptions: opts,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := c.Do(ctx, req, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/tofutf/tofutf/internal/logs"
 	"github.com/tofutf/tofutf/internal/releases"
 	"github.com/tofutf/tofutf/internal/run"
+	"github.com/tofutf/tofutf/internal/secrets"
 	"github.com/tofutf/tofutf/internal/state"
 	"github.com/tofutf/tofutf/internal/variable"
 	"github.com/tofutf/tofutf/internal/workspace"
@@ -39,12 +40,19 @@ var (
 type (
 	// Config is configuration for an agent daemon
 	Config struct {
-		Name            string // descriptive name for agent
-		Concurrency     int    // number of jobs the agent can execute at any one time
-		Sandbox         bool   // isolate privileged ops within sandbox
-		Debug           bool   // toggle debug mode
-		PluginCache     bool   // toggle use of terraform's shared plugin cache
-		TerraformBinDir string // destination directory for terraform binaries
+		Name            string            // descriptive name for agent
+		Concurrency     int               // number of jobs the agent can execute at any one time
+		Sandbox         bool              // isolate privileged ops within sandbox
+		Debug           bool              // toggle debug mode
+		PluginCache     bool              // toggle use of terraform's shared plugin cache
+		ProviderMirror  bool              // toggle installing providers via the server's provider network mirror
+		TerraformBinDir string            // destination directory for terraform binaries
+		Executor        string            // executor for jobs; only the pool agent supports an executor other than the process executor
+		Kubernetes      KubernetesConfig  // configuration for the kubernetes executor
+		Labels          map[string]string // labels advertising the agent's capabilities
+		CPULimit        string            // maximum CPUs available to each job's processes, e.g. 1.5; linux only
+		MemoryLimit     string            // maximum memory available to each job's processes, e.g. 2Gi; linux only
+		Secrets         secrets.Config    // configuration for resolving variables that reference secrets
 	}
 )
 
@@ -54,7 +62,15 @@ func NewConfigFromFlags(flags *pflag.FlagSet) *Config {
 	flags.BoolVar(&cfg.Sandbox, "sandbox", false, "Isolate terraform apply within sandbox for additional security")
 	flags.BoolVar(&cfg.Debug, "debug", false, "Enable agent debug mode which dumps additional info to terraform runs.")
 	flags.BoolVar(&cfg.PluginCache, "plugin-cache", false, "Enable shared plugin cache for terraform providers.")
+	flags.BoolVar(&cfg.ProviderMirror, "provider-mirror", false, "Install terraform providers via the server's provider network mirror.")
 	flags.StringVar(&cfg.Name, "name", "", "Give agent a descriptive name. Optional.")
+	flags.StringToStringVar(&cfg.Labels, "labels", nil, "Labels advertising the agent's capabilities, e.g. region=eu,gpu=false. Optional.")
+	flags.StringVar(&cfg.CPULimit, "cpu-limit", "", "Maximum CPUs available to each job's processes, e.g. 1.5 or 500m. Linux only. Optional.")
+	flags.StringVar(&cfg.MemoryLimit, "memory-limit", "", "Maximum memory available to each job's processes, e.g. 2Gi. Linux only. Optional.")
+	flags.StringVar(&cfg.Secrets.VaultAddress, "vault-address", "", "Address of Vault server from which to resolve variables referencing vault secrets. Optional.")
+	flags.StringVar(&cfg.Secrets.VaultToken, "vault-token", "", "Token with which to authenticate with Vault.")
+	flags.StringVar(&cfg.Secrets.VaultNamespace, "vault-namespace", "", "Vault enterprise namespace. Optional.")
+	flags.StringVar(&cfg.Secrets.Dir, "secrets-dir", "", "Directory from which to resolve variables referencing secret files. Optional.")
 	return &cfg
 }
 
@@ -70,6 +86,19 @@ type daemon struct {
 	poolLogger *slog.Logger // logger that only logs messages if the agent is a pool agent.
 
 	isPoolAgent bool
+
+	// kubernetes is non-nil when jobs are executed in kubernetes pods.
+	kubernetes *kubernetesExecutor
+	// cgroups is non-nil when the resources of jobs' processes are limited.
+	cgroups *cgroupManager
+	// secrets resolves variables that reference secrets.
+	secrets *secrets.Resolver
+}
+
+// jobRunner executes a job and reports its outcome to the server.
+type jobRunner interface {
+	cancelable
+	doAndFinish()
 }
 
 type DaemonOptions struct {
@@ -81,9 +110,9 @@ type DaemonOptions struct {
 	isPoolAgent bool
 }
 
-// downloader downloads terraform versions
+// downloader downloads terraform and tofu versions
 type downloader interface {
-	Download(ctx context.Context, version string, w io.Writer) (string, error)
+	Download(ctx context.Context, engine releases.Engine, version string, w io.Writer) (string, error)
 }
 
 // newDaemon constructs an agent daemon.
@@ -102,12 +131,25 @@ func newDaemon(opts DaemonOptions) (*daemon, error) {
 	if opts.Config.Debug {
 		opts.Logger.Debug("enabled debug mode")
 	}
+	switch opts.Config.Executor {
+	case "", ProcessExecutor:
+	case KubernetesExecutor:
+		if !opts.isPoolAgent {
+			return nil, errors.New("the kubernetes executor is only supported by pool agents")
+		}
+	default:
+		return nil, fmt.Errorf("unknown executor: %s", opts.Config.Executor)
+	}
 	if opts.Config.Sandbox {
 		if _, err := exec.LookPath("bwrap"); errors.Is(err, exec.ErrNotFound) {
 			return nil, fmt.Errorf("sandbox mode requires bubblewrap: %w", err)
 		}
 		opts.Logger.Debug("enabled sandbox mode")
 	}
+	resolver, err := secrets.NewResolver(opts.Config.Secrets)
+	if err != nil {
+		return nil, fmt.Errorf("configuring secret providers: %w", err)
+	}
 	d := &daemon{
 		daemonClient: opts.client,
 		envs:         DefaultEnvs,
@@ -117,6 +159,7 @@ func newDaemon(opts DaemonOptions) (*daemon, error) {
 		poolLogger:   poolLogger,
 		logger:       opts.Logger,
 		isPoolAgent:  opts.isPoolAgent,
+		secrets:      resolver,
 	}
 	if opts.Config.PluginCache {
 		if err := os.MkdirAll(PluginCacheDir, 0o755); err != nil {
@@ -125,6 +168,20 @@ func newDaemon(opts DaemonOptions) (*daemon, error) {
 		d.envs = append(d.envs, "TF_PLUGIN_CACHE_DIR="+PluginCacheDir)
 		opts.Logger.Debug("enabled plugin cache", "path", PluginCacheDir)
 	}
+	if opts.Config.CPULimit != "" || opts.Config.MemoryLimit != "" {
+		if opts.Config.Executor == KubernetesExecutor {
+			return nil, errors.New("the kubernetes executor does not support --cpu-limit or --memory-limit: use --kubernetes-limits instead")
+		}
+		limits, err := newResourceLimits(opts.Config.CPULimit, opts.Config.MemoryLimit)
+		if err != nil {
+			return nil, err
+		}
+		d.cgroups, err = newCgroupManager(limits)
+		if err != nil {
+			return nil, fmt.Errorf("limiting job resources: %w", err)
+		}
+		opts.Logger.Debug("enabled resource limits", "cpu", opts.Config.CPULimit, "memory", opts.Config.MemoryLimit)
+	}
 	return d, nil
 }
 
@@ -166,12 +223,27 @@ func NewPoolDaemon(logger *slog.Logger, cfg Config, apiConfig otfapi.Config) (*d
 	if err != nil {
 		return nil, err
 	}
-	return newDaemon(DaemonOptions{
+	d, err := newDaemon(DaemonOptions{
 		Logger:      logger,
 		Config:      cfg,
 		client:      rpcClient,
 		isPoolAgent: true,
 	})
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Executor == KubernetesExecutor {
+		client, namespace, err := newKubernetesClient()
+		if err != nil {
+			return nil, err
+		}
+		d.kubernetes, err = newKubernetesExecutor(client, namespace, apiConfig.Address, cfg)
+		if err != nil {
+			return nil, err
+		}
+		logger.Debug("enabled kubernetes executor", "namespace", d.kubernetes.namespace, "image", d.kubernetes.image)
+	}
+	return d, nil
 }
 
 // Start the agent daemon.
@@ -194,6 +266,7 @@ func (d *daemon) Start(ctx context.Context) error {
 		Name:        d.config.Name,
 		Version:     internal.Version,
 		Concurrency: d.config.Concurrency,
+		Labels:      d.config.Labels,
 	})
 	if err != nil {
 		return err
@@ -297,24 +370,36 @@ func (d *daemon) Start(ctx context.Context) error {
 							continue
 						}
 						d.poolLogger.Debug("started job")
-						op := newOperation(newOperationOptions{
-							logger:      d.poolLogger.With("job", j),
-							client:      d.daemonClient,
-							config:      d.config,
-							agentID:     agent.ID,
-							job:         j,
-							downloader:  d.downloader,
-							envs:        d.envs,
-							token:       token,
-							isPoolAgent: d.isPoolAgent,
-						})
+						var op jobRunner
+						if d.kubernetes != nil {
+							op = d.kubernetes.newPodOperation(newPodOperationOptions{
+								logger:  d.poolLogger,
+								client:  d.daemonClient,
+								job:     j,
+								token:   token,
+								agentID: agent.ID,
+							})
+						} else {
+							op = newOperation(newOperationOptions{
+								logger:      d.poolLogger.With("job", j),
+								client:      d.daemonClient,
+								config:      d.config,
+								agentID:     agent.ID,
+								job:         j,
+								downloader:  d.downloader,
+								envs:        d.envs,
+								token:       token,
+								isPoolAgent: d.isPoolAgent,
+								cgroups:     d.cgroups,
+								secrets:     d.secrets,
+							})
+						}
 						// check operation in with the terminator, so that if a cancelation signal
 						// arrives it can be handled accordingly for the duration of the operation.
 						terminator.checkIn(j.Spec, op)
-						op.logger.Debug("started job")
 						g.Go(func() error {
 							op.doAndFinish()
-							terminator.checkOut(op.job.Spec)
+							terminator.checkOut(j.Spec)
 							return nil
 						})
 					} else if j.Signaled != nil {
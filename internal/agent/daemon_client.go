@@ -57,6 +57,7 @@ type (
 
 		startJob(ctx context.Context, spec JobSpec) ([]byte, error)
 		finishJob(ctx context.Context, spec JobSpec, opts finishJobOptions) error
+		createWorkloadIdentityToken(ctx context.Context, spec JobSpec, opts createWorkloadIdentityTokenOptions) ([]byte, error)
 	}
 
 	configClient interface {
@@ -44,6 +44,7 @@ type agentresult struct {
 	LastStatusAt pgtype.Timestamptz `json:"last_status_at"`
 	Status       pgtype.Text        `json:"status"`
 	AgentPoolID  pgtype.Text        `json:"agent_pool_id"`
+	Labels       []string           `json:"labels"`
 	CurrentJobs  pgtype.Int8        `json:"current_jobs"`
 }
 
@@ -58,6 +59,7 @@ func (r agentresult) toAgent() *Agent {
 		LastPingAt:   r.LastPingAt.Time.UTC(),
 		LastStatusAt: r.LastStatusAt.Time.UTC(),
 		Status:       AgentStatus(r.Status.String),
+		Labels:       internal.LabelsFromStrings(r.Labels),
 	}
 
 	if r.AgentPoolID.Valid {
@@ -68,14 +70,17 @@ func (r agentresult) toAgent() *Agent {
 
 // jobresult is the result of a database query for an job
 type jobresult struct {
-	RunID            pgtype.Text `json:"run_id"`
-	Phase            pgtype.Text `json:"phase"`
-	Status           pgtype.Text `json:"status"`
-	Signaled         pgtype.Bool `json:"signaled"`
-	AgentID          pgtype.Text `json:"agent_id"`
-	AgentPoolID      pgtype.Text `json:"agent_pool_id"`
-	WorkspaceID      pgtype.Text `json:"workspace_id"`
-	OrganizationName pgtype.Text `json:"organization_name"`
+	RunID            pgtype.Text        `json:"run_id"`
+	Phase            pgtype.Text        `json:"phase"`
+	Status           pgtype.Text        `json:"status"`
+	Signaled         pgtype.Bool        `json:"signaled"`
+	AgentID          pgtype.Text        `json:"agent_id"`
+	StartedAt        pgtype.Timestamptz `json:"started_at"`
+	AgentPoolID      pgtype.Text        `json:"agent_pool_id"`
+	AgentSelector    pgtype.Text        `json:"agent_selector"`
+	Timeout          pgtype.Int4        `json:"timeout"`
+	WorkspaceID      pgtype.Text        `json:"workspace_id"`
+	OrganizationName pgtype.Text        `json:"organization_name"`
 }
 
 func (r jobresult) toJob() *Job {
@@ -84,9 +89,15 @@ func (r jobresult) toJob() *Job {
 			RunID: r.RunID.String,
 			Phase: internal.PhaseType(r.Phase.String),
 		},
-		Status:       JobStatus(r.Status.String),
-		WorkspaceID:  r.WorkspaceID.String,
-		Organization: r.OrganizationName.String,
+		Status:        JobStatus(r.Status.String),
+		WorkspaceID:   r.WorkspaceID.String,
+		Organization:  r.OrganizationName.String,
+		AgentSelector: r.AgentSelector.String,
+		Timeout:       int(r.Timeout.Int32),
+	}
+	if r.StartedAt.Valid {
+		startedAt := r.StartedAt.Time.UTC()
+		job.StartedAt = &startedAt
 	}
 	if r.AgentID.Valid {
 		job.AgentID = &r.AgentID.String
@@ -270,6 +281,7 @@ func (db *db) createAgent(ctx context.Context, agent *Agent) error {
 			LastPingAt:   sql.Timestamptz(agent.LastPingAt),
 			LastStatusAt: sql.Timestamptz(agent.LastStatusAt),
 			AgentPoolID:  sql.StringPtr(agent.AgentPoolID),
+			Labels:       internal.LabelsToStrings(agent.Labels),
 		})
 
 		return err
@@ -444,6 +456,24 @@ func (db *db) listJobs(ctx context.Context) ([]*Job, error) {
 	})
 }
 
+// listRunningJobs lists jobs that are running and have been started by an
+// agent.
+func (db *db) listRunningJobs(ctx context.Context) ([]*Job, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]*Job, error) {
+		rows, err := q.FindRunningJobs(ctx)
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+
+		jobs := make([]*Job, len(rows))
+		for i, r := range rows {
+			jobs[i] = jobresult(r).toJob()
+		}
+
+		return jobs, nil
+	})
+}
+
 func (db *db) updateJob(ctx context.Context, spec JobSpec, fn func(*Job) error) (*Job, error) {
 	job, err := sql.Tx(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*Job, error) {
 		result, err := q.FindJobForUpdate(ctx, sql.String(spec.RunID), sql.String(string(spec.Phase)))
@@ -457,11 +487,12 @@ func (db *db) updateJob(ctx context.Context, spec JobSpec, fn func(*Job) error)
 		}
 
 		_, err = q.UpdateJob(ctx, pggen.UpdateJobParams{
-			Status:   sql.String(string(job.Status)),
-			Signaled: sql.BoolPtr(job.Signaled),
-			AgentID:  sql.StringPtr(job.AgentID),
-			RunID:    result.RunID,
-			Phase:    result.Phase,
+			Status:    sql.String(string(job.Status)),
+			Signaled:  sql.BoolPtr(job.Signaled),
+			AgentID:   sql.StringPtr(job.AgentID),
+			StartedAt: sql.TimestamptzPtr(job.StartedAt),
+			RunID:     result.RunID,
+			Phase:     result.Phase,
 		})
 		if err != nil {
 			return nil, err
@@ -5,12 +5,19 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/rbac"
 	otfrun "github.com/tofutf/tofutf/internal/run"
 )
 
+// staleJobGracePeriod is the period after a job's timeout has expired that the
+// server waits for the job's agent to report the job's failure before the
+// server gives up on the agent and errors the job itself. It is longer than
+// the period agents give jobs to respond to an interrupt.
+const staleJobGracePeriod = 15 * time.Minute
+
 var (
 	ErrInvalidJobStateTransition = errors.New("invalid job state transition")
 	ErrMalformedJobSpecString    = errors.New("malformed stringified job spec")
@@ -65,12 +72,21 @@ type Job struct {
 	Organization string `jsonapi:"attribute" json:"organization"`
 	// ID of job's workspace
 	WorkspaceID string `jsonapi:"attribute" json:"workspace_id"`
+	// Agent selector of job's workspace. Only agents with labels matching the
+	// selector are allocated the job.
+	AgentSelector string `jsonapi:"attribute" json:"agent_selector"`
 	// ID of agent that this job is allocated to. Only set once job enters
 	// JobAllocated state.
 	AgentID *string `jsonapi:"attribute" json:"agent_id"`
 	// Signaled is non-nil when a cancelation signal has been sent to the job
 	// and it is true when it has been forceably canceled.
 	Signaled *bool `jsonapi:"attribute" json:"signaled"`
+	// Timeout is the maximum duration of the job in minutes, as configured on
+	// the job's workspace or, failing that, its organization. Zero means there
+	// is no timeout.
+	Timeout int `jsonapi:"attribute" json:"timeout"`
+	// StartedAt is the time at which the job entered the JobRunning state.
+	StartedAt *time.Time `jsonapi:"attribute" json:"started_at"`
 }
 
 func newJob(run *otfrun.Run) *Job {
@@ -227,7 +243,28 @@ func (j *Job) cancel(run *otfrun.Run) (*bool, error) {
 }
 
 func (j *Job) startJob() error {
-	return j.updateStatus(JobRunning)
+	if err := j.updateStatus(JobRunning); err != nil {
+		return err
+	}
+	startedAt := internal.CurrentTimestamp(nil)
+	j.StartedAt = &startedAt
+	return nil
+}
+
+// timeout returns the maximum duration of the job, or zero if there is no
+// timeout.
+func (j *Job) timeout() time.Duration {
+	return time.Duration(j.Timeout) * time.Minute
+}
+
+// stale determines whether the job is still running long after its timeout
+// has expired, by which time its agent ought to have interrupted the job,
+// killed it, and reported its failure.
+func (j *Job) stale(now time.Time) bool {
+	if j.Status != JobRunning || j.Timeout == 0 || j.StartedAt == nil {
+		return false
+	}
+	return now.After(j.StartedAt.Add(j.timeout() + staleJobGracePeriod))
 }
 
 func (j *Job) finishJob(to JobStatus) error {
@@ -0,0 +1,571 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/tofutf/tofutf/internal"
+	otfapi "github.com/tofutf/tofutf/internal/api"
+	"github.com/tofutf/tofutf/internal/logs"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// ProcessExecutor executes jobs as processes on the agent's host.
+	ProcessExecutor = "process"
+	// KubernetesExecutor executes each job in its own kubernetes pod.
+	KubernetesExecutor = "kubernetes"
+
+	// TerminationMessagePath is the path to which the pod container writes
+	// the reason for a failed job.
+	TerminationMessagePath = corev1.TerminationMessagePathDefault
+
+	// DefaultKubernetesImage is the default image for job pods, which is
+	// expected to contain the tofutf-agent binary as its entrypoint.
+	DefaultKubernetesImage = "ghcr.io/tofutf/tofutf/tofutf-agent"
+
+	// name of the key in the job secret that holds the job token.
+	jobTokenSecretKey = "token"
+	// name of the key in the job secret that holds the vault token.
+	vaultTokenSecretKey = "vault-token"
+	// name of the container in the job pod.
+	jobContainerName = "job"
+	// time given to a pod's container to gracefully cancel a job before it is
+	// killed.
+	jobTerminationGracePeriod = 10 * time.Minute
+	// default interval between checks on the status of a pod.
+	defaultPodPollInterval = time.Second
+)
+
+// KubernetesConfig configures the kubernetes executor.
+type KubernetesConfig struct {
+	Namespace      string            // namespace in which to create pods; defaults to the agent's namespace
+	Image          string            // image for job pods
+	ServiceAccount string            // service account for job pods
+	NodeSelector   map[string]string // node selector for job pods
+	Requests       map[string]string // resource requests for job pods, e.g. cpu=500m
+	Limits         map[string]string // resource limits for job pods, e.g. memory=1Gi
+}
+
+// AddExecutorFlags adds flags for selecting and configuring the executor for
+// jobs to the given flagset.
+func AddExecutorFlags(flags *pflag.FlagSet, cfg *Config) {
+	flags.StringVar(&cfg.Executor, "executor", ProcessExecutor, "Executor for jobs: process or kubernetes.")
+	flags.StringVar(&cfg.Kubernetes.Namespace, "kubernetes-namespace", "", "Namespace in which to create job pods. Defaults to the agent's namespace.")
+	flags.StringVar(&cfg.Kubernetes.Image, "kubernetes-image", DefaultKubernetesImage+":"+internal.Version, "Image for job pods.")
+	flags.StringVar(&cfg.Kubernetes.ServiceAccount, "kubernetes-service-account", "", "Service account for job pods.")
+	flags.StringToStringVar(&cfg.Kubernetes.NodeSelector, "kubernetes-node-selector", nil, "Node selector for job pods, e.g. disktype=ssd.")
+	flags.StringToStringVar(&cfg.Kubernetes.Requests, "kubernetes-requests", nil, "Resource requests for job pods, e.g. cpu=500m,memory=512Mi.")
+	flags.StringToStringVar(&cfg.Kubernetes.Limits, "kubernetes-limits", nil, "Resource limits for job pods, e.g. cpu=2,memory=2Gi.")
+}
+
+// kubernetesExecutor executes jobs in kubernetes pods.
+type kubernetesExecutor struct {
+	client         kubernetes.Interface
+	namespace      string
+	image          string
+	serviceAccount string
+	nodeSelector   map[string]string
+	resources      corev1.ResourceRequirements
+	// address of the server, which job pods communicate with.
+	serverAddress string
+	// flags passed to the job pod's agent process
+	args []string
+	// token with which the job pod authenticates with vault; it is passed via
+	// the job secret rather than as a flag.
+	vaultToken   string
+	pollInterval time.Duration
+}
+
+// newKubernetesClient constructs a kubernetes client, using the in-cluster
+// configuration if running within a pod, otherwise using the user's
+// kubeconfig. The namespace from the configuration is also returned.
+func newKubernetesClient() (kubernetes.Interface, string, error) {
+	loader := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(),
+		&clientcmd.ConfigOverrides{},
+	)
+	restConfig, err := loader.ClientConfig()
+	if err != nil {
+		return nil, "", fmt.Errorf("loading kubernetes configuration: %w", err)
+	}
+	namespace, _, err := loader.Namespace()
+	if err != nil {
+		return nil, "", fmt.Errorf("determining kubernetes namespace: %w", err)
+	}
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, "", err
+	}
+	return client, namespace, nil
+}
+
+func newKubernetesExecutor(client kubernetes.Interface, namespace, address string, cfg Config) (*kubernetesExecutor, error) {
+	if cfg.Sandbox {
+		return nil, errors.New("sandbox mode is not supported by the kubernetes executor")
+	}
+	requests, err := parseResourceList(cfg.Kubernetes.Requests)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resource requests: %w", err)
+	}
+	limits, err := parseResourceList(cfg.Kubernetes.Limits)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resource limits: %w", err)
+	}
+	if cfg.Kubernetes.Namespace != "" {
+		namespace = cfg.Kubernetes.Namespace
+	}
+	image := cfg.Kubernetes.Image
+	if image == "" {
+		image = DefaultKubernetesImage + ":" + internal.Version
+	}
+	var args []string
+	if cfg.Debug {
+		args = append(args, "--debug")
+	}
+	if cfg.PluginCache {
+		args = append(args, "--plugin-cache")
+	}
+	if cfg.ProviderMirror {
+		args = append(args, "--provider-mirror")
+	}
+	if cfg.Secrets.VaultAddress != "" {
+		args = append(args, "--vault-address", cfg.Secrets.VaultAddress)
+	}
+	if cfg.Secrets.VaultNamespace != "" {
+		args = append(args, "--vault-namespace", cfg.Secrets.VaultNamespace)
+	}
+	return &kubernetesExecutor{
+		client:         client,
+		namespace:      namespace,
+		image:          image,
+		serviceAccount: cfg.Kubernetes.ServiceAccount,
+		nodeSelector:   cfg.Kubernetes.NodeSelector,
+		resources: corev1.ResourceRequirements{
+			Requests: requests,
+			Limits:   limits,
+		},
+		serverAddress: address,
+		args:          args,
+		vaultToken:    cfg.Secrets.VaultToken,
+		pollInterval:  defaultPodPollInterval,
+	}, nil
+}
+
+func parseResourceList(m map[string]string) (corev1.ResourceList, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	list := make(corev1.ResourceList, len(m))
+	for name, value := range m {
+		q, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		list[corev1.ResourceName(name)] = q
+	}
+	return list, nil
+}
+
+// podOperation executes a job in a kubernetes pod, streaming the pod's logs
+// back to the server.
+type podOperation struct {
+	*kubernetesExecutor
+	*daemonClient
+	logger *slog.Logger
+
+	job      *Job
+	token    []byte
+	agentID  string
+	ctx      context.Context
+	cancelfn context.CancelFunc
+
+	mu       sync.Mutex
+	canceled bool
+	pod      string // name of pod once created
+}
+
+type newPodOperationOptions struct {
+	logger  *slog.Logger
+	client  *daemonClient
+	job     *Job
+	token   []byte
+	agentID string
+}
+
+func (e *kubernetesExecutor) newPodOperation(opts newPodOperationOptions) *podOperation {
+	// as with an operation, a pod operation has its own uninherited context,
+	// and is instead canceled via its cancel() method.
+	ctx, cancelfn := context.WithCancel(context.Background())
+	return &podOperation{
+		kubernetesExecutor: e,
+		daemonClient:       opts.client,
+		logger:             opts.logger.With("job", opts.job),
+		job:                opts.job,
+		token:              opts.token,
+		agentID:            opts.agentID,
+		ctx:                ctx,
+		cancelfn:           cancelfn,
+	}
+}
+
+// doAndFinish executes the job and marks the job as complete with the
+// appropriate status.
+func (o *podOperation) doAndFinish() {
+	err := o.do()
+
+	var opts finishJobOptions
+	switch {
+	case o.isCanceled():
+		if o.ctx.Err() != nil {
+			// force-canceled: the server has already canceled the job.
+			o.logger.Error("job forceably canceled", "err", err)
+			return
+		}
+		opts.Status = JobCanceled
+		o.logger.Error("job canceled", "err", err)
+	case err != nil:
+		opts.Status = JobErrored
+		opts.Error = err.Error()
+		o.logger.Error("finished job with error", "err", err)
+	default:
+		opts.Status = JobFinished
+		o.logger.Info("finished job successfully")
+	}
+	if err := o.agents.finishJob(o.ctx, o.job.Spec, opts); err != nil {
+		o.logger.Error("sending job status", "status", opts.Status, "err", err)
+	}
+}
+
+func (o *podOperation) do() error {
+	jc, err := o.newJobClient(o.agentID, o.token, o.logger)
+	if err != nil {
+		return fmt.Errorf("initializing job client: %w", err)
+	}
+	o.daemonClient = jc
+
+	writer := logs.NewPhaseWriter(o.ctx, logs.PhaseWriterOptions{
+		RunID:  o.job.Spec.RunID,
+		Phase:  o.job.Spec.Phase,
+		Writer: o.logs,
+	})
+	defer writer.Close()
+
+	return o.run(writer)
+}
+
+// run creates a pod to execute the job, copies the pod's logs to out, and
+// waits for the pod to terminate, returning an error if the job failed.
+func (o *podOperation) run(out io.Writer) error {
+	// the secret and the pod are deleted using a separate context because the
+	// operation's context may be canceled.
+	cleanup := func(fn func(context.Context) error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := fn(ctx); err != nil && !kerrors.IsNotFound(err) {
+			o.logger.Error("cleaning up job pod", "err", err)
+		}
+	}
+
+	// pass job token, and any vault token, to pod via a secret
+	data := map[string][]byte{jobTokenSecretKey: o.token}
+	if o.vaultToken != "" {
+		data[vaultTokenSecretKey] = []byte(o.vaultToken)
+	}
+	secret, err := o.client.CoreV1().Secrets(o.namespace).Create(o.ctx, &corev1.Secret{
+		ObjectMeta: o.objectMeta(),
+		Data:       data,
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("creating job secret: %w", err)
+	}
+	defer cleanup(func(ctx context.Context) error {
+		return o.client.CoreV1().Secrets(o.namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{})
+	})
+
+	pod, err := o.client.CoreV1().Pods(o.namespace).Create(o.ctx, o.podSpec(secret.Name), metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("creating job pod: %w", err)
+	}
+	name := pod.Name
+	o.logger.Debug("created job pod", "pod", name, "namespace", o.namespace)
+	defer cleanup(func(ctx context.Context) error {
+		return o.client.CoreV1().Pods(o.namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	})
+
+	o.mu.Lock()
+	o.pod = name
+	canceled := o.canceled
+	o.mu.Unlock()
+	if canceled {
+		// canceled before the pod was created
+		o.deletePod(false)
+	}
+
+	// wait for pod's container to start before streaming its logs
+	pod, err = o.waitForPod(name, func(pod *corev1.Pod) (bool, error) {
+		if pod.Status.Phase != corev1.PodPending {
+			return true, nil
+		}
+		// fail early if the container cannot be started
+		for _, status := range pod.Status.ContainerStatuses {
+			if waiting := status.State.Waiting; waiting != nil {
+				switch waiting.Reason {
+				case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "CreateContainerConfigError":
+					return false, fmt.Errorf("starting job pod: %s: %s", waiting.Reason, waiting.Message)
+				}
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	stream, err := o.client.CoreV1().Pods(o.namespace).GetLogs(name, &corev1.PodLogOptions{
+		Container: jobContainerName,
+		Follow:    true,
+	}).Stream(o.ctx)
+	if err != nil {
+		return fmt.Errorf("streaming job pod logs: %w", err)
+	}
+	_, err = io.Copy(out, stream)
+	stream.Close()
+	if err != nil && o.ctx.Err() == nil {
+		return fmt.Errorf("streaming job pod logs: %w", err)
+	}
+
+	// wait for pod to terminate
+	pod, err = o.waitForPod(name, func(pod *corev1.Pod) (bool, error) {
+		switch pod.Status.Phase {
+		case corev1.PodSucceeded, corev1.PodFailed:
+			return true, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	if pod.Status.Phase == corev1.PodSucceeded {
+		return nil
+	}
+	if pod.Status.Reason == "DeadlineExceeded" {
+		return fmt.Errorf("%s exceeded timeout of %s", o.job.Spec.Phase, o.job.timeout())
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if terminated := status.State.Terminated; terminated != nil {
+			if msg := strings.TrimSpace(terminated.Message); msg != "" {
+				return errors.New(msg)
+			}
+			return fmt.Errorf("job pod exited with code %d", terminated.ExitCode)
+		}
+	}
+	return errors.New("job pod failed")
+}
+
+// waitForPod polls the pod until the condition is met, returning an error if
+// the pod is deleted or the operation is force-canceled.
+func (o *podOperation) waitForPod(name string, condition func(*corev1.Pod) (bool, error)) (*corev1.Pod, error) {
+	ticker := time.NewTicker(o.pollInterval)
+	defer ticker.Stop()
+	for {
+		pod, err := o.client.CoreV1().Pods(o.namespace).Get(o.ctx, name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			return nil, errors.New("job pod deleted")
+		} else if err != nil {
+			return nil, fmt.Errorf("retrieving job pod: %w", err)
+		}
+		if done, err := condition(pod); err != nil {
+			return nil, err
+		} else if done {
+			return pod, nil
+		}
+		select {
+		case <-ticker.C:
+		case <-o.ctx.Done():
+			return nil, o.ctx.Err()
+		}
+	}
+}
+
+func (o *podOperation) objectMeta() metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		GenerateName: "tofutf-job-",
+		Labels: map[string]string{
+			"app.kubernetes.io/managed-by": "tofutf-agent",
+			"tofutf.io/run-id":             o.job.Spec.RunID,
+			"tofutf.io/phase":              string(o.job.Spec.Phase),
+		},
+	}
+}
+
+func (o *podOperation) podSpec(secretName string) *corev1.Pod {
+	gracePeriod := int64(jobTerminationGracePeriod.Seconds())
+	args := append([]string{
+		"execute",
+		"--address", o.serverAddress,
+		"--agent-id", o.agentID,
+		"--run-id", o.job.Spec.RunID,
+		"--phase", string(o.job.Spec.Phase),
+		"--workspace-id", o.job.WorkspaceID,
+	}, o.args...)
+	pod := &corev1.Pod{
+		ObjectMeta: o.objectMeta(),
+		Spec: corev1.PodSpec{
+			RestartPolicy:                 corev1.RestartPolicyNever,
+			ServiceAccountName:            o.serviceAccount,
+			NodeSelector:                  o.nodeSelector,
+			TerminationGracePeriodSeconds: &gracePeriod,
+			Containers: []corev1.Container{
+				{
+					Name:      jobContainerName,
+					Image:     o.image,
+					Args:      args,
+					Resources: o.resources,
+					Env: []corev1.EnvVar{
+						{
+							Name: "OTF_TOKEN",
+							ValueFrom: &corev1.EnvVarSource{
+								SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+									Key:                  jobTokenSecretKey,
+								},
+							},
+						},
+					},
+					TerminationMessagePath: TerminationMessagePath,
+				},
+			},
+		},
+	}
+	if o.vaultToken != "" {
+		container := &pod.Spec.Containers[0]
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name: "OTF_VAULT_TOKEN",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Key:                  vaultTokenSecretKey,
+				},
+			},
+		})
+	}
+	if timeout := o.job.timeout(); timeout > 0 {
+		// kubernetes terminates the pod once the deadline has passed,
+		// gracefully at first and then forcefully.
+		deadline := int64(timeout.Seconds())
+		pod.Spec.ActiveDeadlineSeconds = &deadline
+	}
+	return pod
+}
+
+func (o *podOperation) isCanceled() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.canceled
+}
+
+// cancel cancels the job by deleting its pod, which sends the job a SIGTERM
+// and then, if forced or once the grace period has expired, a SIGKILL.
+//
+// Unlike a process, the pod does not receive the signals sent to the agent,
+// so the pod is deleted regardless of sendSignal.
+func (o *podOperation) cancel(force, sendSignal bool) {
+	o.mu.Lock()
+	o.canceled = true
+	o.mu.Unlock()
+
+	o.deletePod(force)
+	if force {
+		o.cancelfn()
+	}
+}
+
+func (o *podOperation) deletePod(force bool) {
+	o.mu.Lock()
+	name := o.pod
+	o.mu.Unlock()
+	if name == "" {
+		// pod not yet created
+		return
+	}
+	var opts metav1.DeleteOptions
+	if force {
+		opts.GracePeriodSeconds = new(int64)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := o.client.CoreV1().Pods(o.namespace).Delete(ctx, name, opts); err != nil && !kerrors.IsNotFound(err) {
+		o.logger.Error("deleting job pod", "pod", name, "err", err)
+	}
+}
+
+// ExecuteJobOptions are options for executing a single job.
+type ExecuteJobOptions struct {
+	AgentID     string
+	Spec        JobSpec
+	WorkspaceID string
+	// Out receives the job's output.
+	Out io.Writer
+}
+
+// ExecuteJob executes a single job within the current process, writing its
+// output to opts.Out rather than to the server. The job is canceled when ctx
+// is canceled. It is invoked within a pod created by the kubernetes executor.
+func ExecuteJob(ctx context.Context, logger *slog.Logger, cfg Config, apiConfig otfapi.Config, opts ExecuteJobOptions) error {
+	d, err := newDaemon(DaemonOptions{
+		Logger:      logger,
+		Config:      cfg,
+		client:      &daemonClient{address: apiConfig.Address},
+		isPoolAgent: true,
+	})
+	if err != nil {
+		return err
+	}
+	op := newOperation(newOperationOptions{
+		logger: logger,
+		client: d.daemonClient,
+		config: d.config,
+		job: &Job{
+			Spec:        opts.Spec,
+			WorkspaceID: opts.WorkspaceID,
+		},
+		downloader:  d.downloader,
+		envs:        d.envs,
+		secrets:     d.secrets,
+		token:       []byte(apiConfig.Token),
+		agentID:     opts.AgentID,
+		isPoolAgent: true,
+		out:         opts.Out,
+	})
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			op.cancel(false, true)
+		case <-done:
+		}
+	}()
+	return op.do()
+}
+
+// WriteTerminationMessage writes the reason for a failed job to the pod's
+// termination message path, from where the agent retrieves it.
+func WriteTerminationMessage(err error) error {
+	return os.WriteFile(TerminationMessagePath, []byte(err.Error()), 0o644)
+}
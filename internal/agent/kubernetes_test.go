@@ -0,0 +1,236 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/secrets"
+	"github.com/tofutf/tofutf/internal/xslog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestKubernetesExecutor(t *testing.T) {
+	job := &Job{
+		Spec:        JobSpec{RunID: "run-123", Phase: internal.PlanPhase},
+		WorkspaceID: "ws-123",
+	}
+
+	// newPodOperation constructs a pod operation using a fake kubernetes
+	// client, which sets the given status on newly created pods.
+	newPodOperation := func(t *testing.T, status corev1.PodStatus) (*podOperation, *fake.Clientset) {
+		client := fake.NewSimpleClientset()
+		client.PrependReactor("create", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			obj := action.(k8stesting.CreateAction).GetObject().(metav1.Object)
+			// the fake client does not generate names
+			obj.SetName(obj.GetGenerateName() + "abc")
+			if pod, ok := obj.(*corev1.Pod); ok {
+				pod.Status = status
+			}
+			return false, nil, nil
+		})
+		executor, err := newKubernetesExecutor(client, "otf", "https://otf.example.com", Config{
+			Debug: true,
+			Kubernetes: KubernetesConfig{
+				Image:          "tofutf-agent:test",
+				ServiceAccount: "tofutf-job",
+				NodeSelector:   map[string]string{"disktype": "ssd"},
+				Requests:       map[string]string{"cpu": "500m"},
+				Limits:         map[string]string{"memory": "1Gi"},
+			},
+		})
+		require.NoError(t, err)
+		executor.pollInterval = time.Millisecond
+
+		op := executor.newPodOperation(newPodOperationOptions{
+			logger:  slog.New(&xslog.NoopHandler{}),
+			job:     job,
+			token:   []byte("job-token"),
+			agentID: "agent-123",
+		})
+		return op, client
+	}
+
+	t.Run("succeeded", func(t *testing.T) {
+		op, client := newPodOperation(t, corev1.PodStatus{Phase: corev1.PodSucceeded})
+
+		var got bytes.Buffer
+		err := op.run(&got)
+		require.NoError(t, err)
+		assert.Equal(t, "fake logs", got.String())
+
+		// check pod was created with expected spec
+		var pod *corev1.Pod
+		for _, action := range client.Actions() {
+			if create, ok := action.(k8stesting.CreateAction); ok && action.GetResource().Resource == "pods" {
+				pod = create.GetObject().(*corev1.Pod)
+			}
+		}
+		require.NotNil(t, pod)
+		assert.Equal(t, "tofutf-job", pod.Spec.ServiceAccountName)
+		assert.Equal(t, map[string]string{"disktype": "ssd"}, pod.Spec.NodeSelector)
+		assert.Equal(t, corev1.RestartPolicyNever, pod.Spec.RestartPolicy)
+		assert.Equal(t, "run-123", pod.Labels["tofutf.io/run-id"])
+
+		require.Len(t, pod.Spec.Containers, 1)
+		container := pod.Spec.Containers[0]
+		assert.Equal(t, "tofutf-agent:test", container.Image)
+		assert.Equal(t, []string{
+			"execute",
+			"--address", "https://otf.example.com",
+			"--agent-id", "agent-123",
+			"--run-id", "run-123",
+			"--phase", "plan",
+			"--workspace-id", "ws-123",
+			"--debug",
+		}, container.Args)
+		assert.Equal(t, resource.MustParse("500m"), container.Resources.Requests[corev1.ResourceCPU])
+		assert.Equal(t, resource.MustParse("1Gi"), container.Resources.Limits[corev1.ResourceMemory])
+		// job token is passed via secret
+		require.Len(t, container.Env, 1)
+		assert.Equal(t, "tofutf-job-abc", container.Env[0].ValueFrom.SecretKeyRef.Name)
+
+		// pod and secret should be deleted
+		pods, err := client.CoreV1().Pods("otf").List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, pods.Items)
+		secrets, err := client.CoreV1().Secrets("otf").List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, secrets.Items)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		op, _ := newPodOperation(t, corev1.PodStatus{
+			Phase: corev1.PodFailed,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: jobContainerName,
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{
+							ExitCode: 1,
+							Message:  "terraform plan failed",
+						},
+					},
+				},
+			},
+		})
+
+		err := op.run(&bytes.Buffer{})
+		assert.EqualError(t, err, "terraform plan failed")
+	})
+
+	t.Run("image pull error", func(t *testing.T) {
+		op, _ := newPodOperation(t, corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: jobContainerName,
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{
+							Reason:  "ErrImagePull",
+							Message: "image not found",
+						},
+					},
+				},
+			},
+		})
+
+		err := op.run(&bytes.Buffer{})
+		assert.EqualError(t, err, "starting job pod: ErrImagePull: image not found")
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		op, client := newPodOperation(t, corev1.PodStatus{Phase: corev1.PodRunning})
+
+		errch := make(chan error)
+		go func() {
+			errch <- op.run(&bytes.Buffer{})
+		}()
+		// wait for pod to be created before canceling
+		require.Eventually(t, func() bool {
+			pods, err := client.CoreV1().Pods("otf").List(context.Background(), metav1.ListOptions{})
+			return err == nil && len(pods.Items) == 1
+		}, time.Second, time.Millisecond)
+
+		op.cancel(false, true)
+
+		assert.EqualError(t, <-errch, "job pod deleted")
+		assert.True(t, op.isCanceled())
+		assert.NoError(t, op.ctx.Err())
+	})
+
+	t.Run("force cancel", func(t *testing.T) {
+		op, client := newPodOperation(t, corev1.PodStatus{Phase: corev1.PodRunning})
+
+		errch := make(chan error)
+		go func() {
+			errch <- op.run(&bytes.Buffer{})
+		}()
+		require.Eventually(t, func() bool {
+			pods, err := client.CoreV1().Pods("otf").List(context.Background(), metav1.ListOptions{})
+			return err == nil && len(pods.Items) == 1
+		}, time.Second, time.Millisecond)
+
+		op.cancel(true, true)
+
+		assert.Error(t, <-errch)
+		assert.Error(t, op.ctx.Err())
+
+		// pod should be deleted without a grace period
+		var deleted bool
+		for _, action := range client.Actions() {
+			if del, ok := action.(k8stesting.DeleteAction); ok && action.GetResource().Resource == "pods" {
+				if period := del.GetDeleteOptions().GracePeriodSeconds; period != nil && *period == 0 {
+					deleted = true
+				}
+			}
+		}
+		assert.True(t, deleted)
+	})
+}
+
+func TestNewKubernetesExecutor_InvalidResources(t *testing.T) {
+	_, err := newKubernetesExecutor(fake.NewSimpleClientset(), "otf", "", Config{
+		Kubernetes: KubernetesConfig{
+			Requests: map[string]string{"cpu": "lots"},
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestKubernetesExecutor_Vault(t *testing.T) {
+	executor, err := newKubernetesExecutor(fake.NewSimpleClientset(), "otf", "https://otf.example.com", Config{
+		Secrets: secrets.Config{
+			VaultAddress: "https://vault.example.com",
+			VaultToken:   "vault-token",
+		},
+	})
+	require.NoError(t, err)
+
+	op := executor.newPodOperation(newPodOperationOptions{
+		logger: slog.New(&xslog.NoopHandler{}),
+		job: &Job{
+			Spec:        JobSpec{RunID: "run-123", Phase: internal.PlanPhase},
+			WorkspaceID: "ws-123",
+		},
+		agentID: "agent-123",
+	})
+	container := op.podSpec("tofutf-job-abc").Spec.Containers[0]
+
+	// vault address is passed as a flag but the token is passed via secret
+	assert.Contains(t, container.Args, "https://vault.example.com")
+	assert.NotContains(t, container.Args, "vault-token")
+	require.Len(t, container.Env, 2)
+	assert.Equal(t, "OTF_VAULT_TOKEN", container.Env[1].Name)
+	assert.Equal(t, vaultTokenSecretKey, container.Env[1].ValueFrom.SecretKeyRef.Key)
+}
@@ -2,6 +2,7 @@ package agent
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
 	"github.com/tofutf/tofutf/internal"
@@ -16,12 +17,14 @@ var (
 // time.
 const ManagerLockID int64 = 5577006791947779413
 
-// manager manages the state of agents.
+// manager manages the state of agents, and errors jobs that have been
+// abandoned by their agents.
 //
 // Only one manager should be running on an OTF cluster at any one time.
 type manager struct {
 	// service for retrieving agents and updating their state.
 	client managerClient
+	logger *slog.Logger
 	// frequency with which the manager will check agents.
 	interval time.Duration
 	// manager identifies itself as a subject when making service calls
@@ -32,11 +35,14 @@ type managerClient interface {
 	listAgents(ctx context.Context) ([]*Agent, error)
 	updateAgentStatus(ctx context.Context, agentID string, status AgentStatus) error
 	deleteAgent(ctx context.Context, agentID string) error
+	listRunningJobs(ctx context.Context) ([]*Job, error)
+	errorStaleJob(ctx context.Context, spec JobSpec) error
 }
 
 func newManager(s *service) *manager {
 	return &manager{
 		client:   s,
+		logger:   s.logger,
 		interval: defaultManagerInterval,
 	}
 }
@@ -50,20 +56,8 @@ func (m *manager) String() string { return "agent-manager" }
 func (m *manager) Start(ctx context.Context) error {
 	ctx = internal.AddSubjectToContext(ctx, m)
 
-	updateAll := func() error {
-		agents, err := m.client.listAgents(ctx)
-		if err != nil {
-			return err
-		}
-		for _, agent := range agents {
-			if err := m.update(ctx, agent); err != nil {
-				return err
-			}
-		}
-		return nil
-	}
 	// run at startup and then every x seconds
-	if err := updateAll(); err != nil {
+	if err := m.updateAll(ctx); err != nil {
 		return err
 	}
 	ticker := time.NewTicker(m.interval)
@@ -71,7 +65,7 @@ func (m *manager) Start(ctx context.Context) error {
 	for {
 		select {
 		case <-ticker.C:
-			if err := updateAll(); err != nil {
+			if err := m.updateAll(ctx); err != nil {
 				return err
 			}
 		case <-ctx.Done():
@@ -80,6 +74,33 @@ func (m *manager) Start(ctx context.Context) error {
 	}
 }
 
+// updateAll updates the status of agents, and errors jobs that have gone
+// stale.
+func (m *manager) updateAll(ctx context.Context) error {
+	agents, err := m.client.listAgents(ctx)
+	if err != nil {
+		return err
+	}
+	for _, agent := range agents {
+		if err := m.update(ctx, agent); err != nil {
+			return err
+		}
+	}
+	jobs, err := m.client.listRunningJobs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		if err := m.checkJob(ctx, job); err != nil {
+			// a job that cannot be errored, e.g. because its run has
+			// already finished, must not prevent the manager from
+			// carrying out its other duties.
+			m.logger.Error("checking job", "job", job, "err", err)
+		}
+	}
+	return nil
+}
+
 func (m *manager) update(ctx context.Context, agent *Agent) error {
 	switch agent.Status {
 	case AgentIdle, AgentBusy:
@@ -104,3 +125,12 @@ func (m *manager) update(ctx context.Context, agent *Agent) error {
 	}
 	return nil
 }
+
+// checkJob errors a job if it is still running long after its timeout has
+// expired, which occurs when its agent has stopped reporting.
+func (m *manager) checkJob(ctx context.Context, job *Job) error {
+	if job.stale(time.Now()) {
+		return m.client.errorStaleJob(ctx, job.Spec)
+	}
+	return nil
+}
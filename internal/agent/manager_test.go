@@ -2,11 +2,15 @@ package agent
 
 import (
 	"context"
+	"errors"
+	"log/slog"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/xslog"
 )
 
 func TestManager(t *testing.T) {
@@ -50,3 +54,88 @@ func TestManager(t *testing.T) {
 		})
 	}
 }
+
+func TestManager_checkJob(t *testing.T) {
+	now := time.Now()
+	spec := JobSpec{RunID: "run-123", Phase: "plan"}
+
+	tests := []struct {
+		name        string
+		job         *Job
+		wantErrored bool
+	}{
+		{
+			name: "no timeout",
+			job:  &Job{Spec: spec, Status: JobRunning, StartedAt: internal.Time(now.Add(-24 * time.Hour))},
+		},
+		{
+			name: "within timeout",
+			job:  &Job{Spec: spec, Status: JobRunning, Timeout: 60, StartedAt: internal.Time(now.Add(-30 * time.Minute))},
+		},
+		{
+			name: "within grace period",
+			job:  &Job{Spec: spec, Status: JobRunning, Timeout: 60, StartedAt: internal.Time(now.Add(-70 * time.Minute))},
+		},
+		{
+			name:        "stale",
+			job:         &Job{Spec: spec, Status: JobRunning, Timeout: 60, StartedAt: internal.Time(now.Add(-2 * time.Hour))},
+			wantErrored: true,
+		},
+		{
+			name: "finished",
+			job:  &Job{Spec: spec, Status: JobFinished, Timeout: 60, StartedAt: internal.Time(now.Add(-2 * time.Hour))},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeService{}
+			m := &manager{client: svc}
+			err := m.checkJob(context.Background(), tt.job)
+			require.NoError(t, err)
+			if tt.wantErrored {
+				assert.Equal(t, &spec, svc.erroredJob)
+			} else {
+				assert.Nil(t, svc.erroredJob)
+			}
+		})
+	}
+}
+
+func TestManager_updateAll(t *testing.T) {
+	stale := internal.Time(time.Now().Add(-2 * time.Hour))
+	client := &fakeManagerClient{
+		jobs: []*Job{
+			{Spec: JobSpec{RunID: "run-finished", Phase: "plan"}, Status: JobRunning, Timeout: 60, StartedAt: stale},
+			{Spec: JobSpec{RunID: "run-stale", Phase: "plan"}, Status: JobRunning, Timeout: 60, StartedAt: stale},
+		},
+		// the run of the first job has already finished
+		failures: map[string]error{"run-finished": errors.New("run already finished")},
+	}
+	m := &manager{client: client, logger: slog.New(&xslog.NoopHandler{})}
+
+	// the failure to error the first job does not prevent the second job from
+	// being errored.
+	require.NoError(t, m.updateAll(context.Background()))
+	assert.Equal(t, []string{"run-finished", "run-stale"}, client.errored)
+}
+
+type fakeManagerClient struct {
+	jobs     []*Job
+	failures map[string]error
+	errored  []string
+
+	managerClient
+}
+
+func (f *fakeManagerClient) listAgents(context.Context) ([]*Agent, error) {
+	return nil, nil
+}
+
+func (f *fakeManagerClient) listRunningJobs(context.Context) ([]*Job, error) {
+	return f.jobs, nil
+}
+
+func (f *fakeManagerClient) errorStaleJob(ctx context.Context, spec JobSpec) error {
+	f.errored = append(f.errored, spec.RunID)
+	return f.failures[spec.RunID]
+}
@@ -15,11 +15,14 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/logs"
+	"github.com/tofutf/tofutf/internal/releases"
 	"github.com/tofutf/tofutf/internal/run"
+	"github.com/tofutf/tofutf/internal/secrets"
 	"github.com/tofutf/tofutf/internal/state"
 	"github.com/tofutf/tofutf/internal/variable"
 )
@@ -29,8 +32,13 @@ const (
 	planFilename       = "plan.out"
 	jsonPlanFilename   = "plan.out.json"
 	lockFilename       = ".terraform.lock.hcl"
+	cliConfigFilename  = ".tofutf.tfrc"
 )
 
+// timeoutGracePeriod is the time given to a job that has exceeded its timeout
+// to respond to an interrupt before it is killed.
+const timeoutGracePeriod = 5 * time.Minute
+
 var ascii = regexp.MustCompile("[[:^ascii:]]")
 
 // operation performs the execution of a job
@@ -42,6 +50,7 @@ type operation struct {
 	config        Config
 	job           *Job
 	canceled      bool
+	timedOut      bool
 	ctx           context.Context
 	cancelfn      context.CancelFunc
 	out           io.Writer
@@ -53,6 +62,9 @@ type operation struct {
 	token         []byte
 	agentID       string
 	isPoolAgent   bool
+	cgroups       *cgroupManager
+	cgroup        *cgroup
+	secrets       *secrets.Resolver
 
 	*workdir
 }
@@ -67,6 +79,13 @@ type newOperationOptions struct {
 	token       []byte
 	agentID     string
 	isPoolAgent bool
+	// cgroups limits the resources of the job's processes; nil means there
+	// are no limits.
+	cgroups *cgroupManager
+	// secrets resolves variables that reference secrets.
+	secrets *secrets.Resolver
+	// out receives the job's output; if nil the output is sent to the server.
+	out io.Writer
 }
 
 func newOperation(opts newOperationOptions) *operation {
@@ -85,6 +104,9 @@ func newOperation(opts newOperationOptions) *operation {
 		cancelfn:     cancelfn,
 		agentID:      opts.agentID,
 		isPoolAgent:  opts.isPoolAgent,
+		cgroups:      opts.cgroups,
+		secrets:      opts.secrets,
+		out:          opts.out,
 	}
 }
 
@@ -93,10 +115,20 @@ func newOperation(opts newOperationOptions) *operation {
 func (o *operation) doAndFinish() {
 	// do the job, and then handle any error and send appropriate job status
 	// update
+	stop := o.enforceTimeout()
 	err := o.do()
+	stop()
 
+	ctx := o.ctx
 	var opts finishJobOptions
 	switch {
+	case o.timedOut && err != nil:
+		// the context may have been canceled in order to kill the job, so
+		// use a context that is not canceled to report the failure.
+		ctx = context.WithoutCancel(o.ctx)
+		opts.Status = JobErrored
+		opts.Error = err.Error()
+		o.logger.Error("job timed out", "timeout", o.job.timeout(), "err", err)
 	case o.canceled:
 		if o.ctx.Err() != nil {
 			// the context is closed, which only occurs when the server has
@@ -116,11 +148,41 @@ func (o *operation) doAndFinish() {
 		opts.Status = JobFinished
 		o.logger.Info("finished job successfully")
 	}
-	if err := o.agents.finishJob(o.ctx, o.job.Spec, opts); err != nil {
+	if err := o.agents.finishJob(ctx, o.job.Spec, opts); err != nil {
 		o.logger.Error("sending job status", "status", opts.Status, "err", err)
 	}
 }
 
+// enforceTimeout interrupts the job if it exceeds its timeout and then kills
+// it if it is still running once a grace period has expired. The returned
+// function stops enforcing the timeout.
+func (o *operation) enforceTimeout() (stop func()) {
+	timeout := o.job.timeout()
+	if timeout == 0 {
+		return func() {}
+	}
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-time.After(timeout):
+		case <-done:
+			return
+		}
+		o.logger.Info("job exceeded timeout; interrupting job", "timeout", timeout)
+		o.timedOut = true
+		o.cancel(false, true)
+
+		select {
+		case <-time.After(timeoutGracePeriod):
+		case <-done:
+			return
+		}
+		o.logger.Info("job failed to respond to interrupt; killing job", "grace_period", timeoutGracePeriod)
+		o.cancel(true, true)
+	}()
+	return func() { close(done) }
+}
+
 // do executes the job
 func (o *operation) do() error {
 	// if this is a pool agent using RPC to communicate with the server
@@ -160,12 +222,51 @@ func (o *operation) do() error {
 	}
 	defer wd.close()
 	o.workdir = wd
+	// place the job's processes in a cgroup that limits their resources
+	if o.cgroups != nil {
+		cg, err := o.cgroups.create(o.job.Spec)
+		if err != nil {
+			return fmt.Errorf("creating cgroup: %w", err)
+		}
+		defer func() {
+			if err := cg.close(); err != nil {
+				o.logger.Error("removing cgroup", "err", err)
+			}
+		}()
+		o.cgroup = cg
+	}
 	// retrieve variables and add them to the environment
 	variables, err := o.daemonClient.variables.ListEffectiveVariables(o.ctx, run.ID)
 	if err != nil {
 		return fmt.Errorf("retrieving variables: %w", err)
 	}
+	variables, err = o.resolveSecrets(variables)
+	if err != nil {
+		return fmt.Errorf("resolving secrets: %w", err)
+	}
 	o.variables = variables
+	// make workload identity token available to terraform, with which it can
+	// authenticate to third parties that trust tofutf as an identity provider.
+	// A token is only created if the workspace has configured an audience.
+	var audience string
+	for _, v := range variables {
+		if v.Category == variable.CategoryEnv && v.Key == WorkloadIdentityAudienceVariable {
+			audience = v.Value
+		}
+	}
+	if audience != "" {
+		identityToken, err := o.agents.createWorkloadIdentityToken(o.ctx, o.job.Spec, createWorkloadIdentityTokenOptions{
+			Audience: audience,
+		})
+		if err != nil {
+			return fmt.Errorf("creating workload identity token: %w", err)
+		}
+		o.envs = append(o.envs,
+			fmt.Sprintf("%s=%s", WorkloadIdentityTokenVariable, identityToken),
+			// terraform ignores environment variables for undeclared variables
+			fmt.Sprintf("TF_VAR_%s=%s", WorkloadIdentityTokenTerraformVariable, identityToken),
+		)
+	}
 	// append variables that are environment variables to the list of
 	// environment variables
 	for _, v := range variables {
@@ -174,13 +275,15 @@ func (o *operation) do() error {
 			o.envs = append(o.envs, ev)
 		}
 	}
-	writer := logs.NewPhaseWriter(o.ctx, logs.PhaseWriterOptions{
-		RunID:  run.ID,
-		Phase:  run.Phase(),
-		Writer: o.logs,
-	})
-	defer writer.Close()
-	o.out = writer
+	if o.out == nil {
+		writer := logs.NewPhaseWriter(o.ctx, logs.PhaseWriterOptions{
+			RunID:  run.ID,
+			Phase:  run.Phase(),
+			Writer: o.logs,
+		})
+		defer writer.Close()
+		o.out = writer
+	}
 
 	// dump info if in debug mode
 	if o.config.Debug {
@@ -203,6 +306,7 @@ func (o *operation) do() error {
 	steps := []step{
 		o.downloadTerraform,
 		o.downloadConfig,
+		o.writeCLIConfig,
 		o.writeTerraformVars,
 		o.deleteBackendConfig,
 		o.downloadState,
@@ -226,12 +330,18 @@ func (o *operation) do() error {
 
 	// do each step
 	for _, step := range steps {
-		// skip remaining steps if op is canceled
+		// skip remaining steps if op has timed out or is canceled
+		if o.timedOut {
+			return fmt.Errorf("%s exceeded timeout of %s", run.Phase(), o.job.timeout())
+		}
 		if o.canceled {
 			return fmt.Errorf("execution canceled")
 		}
 		// do step
 		if err := step(o.ctx); err != nil {
+			if o.timedOut {
+				err = fmt.Errorf("%s exceeded timeout of %s: %w", run.Phase(), o.job.timeout(), err)
+			}
 			// write error message to output
 			errbuilder := strings.Builder{}
 			errbuilder.WriteRune('\n')
@@ -301,13 +411,22 @@ func (o *operation) execute(args []string, funcs ...executionOptionFunc) error {
 	for _, fn := range funcs {
 		fn(&opts)
 	}
-	if opts.sandboxIfEnabled && o.config.Sandbox {
+	sandboxed := opts.sandboxIfEnabled && o.config.Sandbox
+	if sandboxed {
 		args = o.addSandboxWrapper(args)
 	}
 	cmd := exec.Command(args[0], args[1:]...)
+	if o.cgroup != nil {
+		o.cgroup.apply(cmd)
+	}
 	cmd.Dir = o.workdir.String()
 	cmd.Env = os.Environ()
-	cmd.Env = append(os.Environ(), o.envs...)
+	if o.config.ProviderMirror {
+		// set before user-provided environment variables, permitting the user
+		// to override the CLI config.
+		cmd.Env = append(cmd.Env, "TF_CLI_CONFIG_FILE="+o.cliConfigPath(sandboxed))
+	}
+	cmd.Env = append(cmd.Env, o.envs...)
 
 	if opts.redirectStdout != nil {
 		dst, err := os.Create(path.Join(o.workdir.String(), *opts.redirectStdout))
@@ -359,9 +478,47 @@ func (o *operation) addSandboxWrapper(args []string) []string {
 	return append(bargs, args[1:]...)
 }
 
+// resolveSecrets returns the variables, replacing those that reference secrets
+// with copies containing the secrets themselves. The copies are marked
+// sensitive, and exist only for the duration of the job.
+func (o *operation) resolveSecrets(vars []*variable.Variable) ([]*variable.Variable, error) {
+	resolved := make([]*variable.Variable, len(vars))
+	for i, v := range vars {
+		if !v.SecretRef {
+			resolved[i] = v
+			continue
+		}
+		if o.secrets == nil {
+			return nil, fmt.Errorf("variable %s: secret references are unsupported", v.Key)
+		}
+		ref, err := secrets.ParseReference(v.Value)
+		if err != nil {
+			return nil, fmt.Errorf("variable %s: %w", v.Key, err)
+		}
+		// the reference is checked when the variable is created, but check
+		// it again in case the scope has since changed, e.g. the
+		// organization has been renamed.
+		value, err := o.secrets.Resolve(o.ctx, o.Organization, ref)
+		if err != nil {
+			return nil, fmt.Errorf("variable %s: %w", v.Key, err)
+		}
+		secret := *v
+		secret.Value = value
+		secret.Sensitive = true
+		secret.SecretRef = false
+		resolved[i] = &secret
+	}
+	return resolved, nil
+}
+
 func (o *operation) downloadTerraform(ctx context.Context) error {
+	// older servers do not set an engine on runs
+	engine := o.Engine
+	if engine == "" {
+		engine = releases.DefaultEngine
+	}
 	var err error
-	o.terraformPath, err = o.downloader.Download(ctx, o.TerraformVersion, o.out)
+	o.terraformPath, err = o.downloader.Download(ctx, engine, o.TerraformVersion, o.out)
 	return err
 }
 
@@ -410,6 +567,40 @@ func (o *operation) downloadLockFile(ctx context.Context) error {
 	return o.writeFile(lockFilename, lockFile)
 }
 
+// writeCLIConfig writes a terraform CLI config file that configures terraform
+// to install providers via the server's provider network mirror. Providers in
+// the server's own private registry are installed directly.
+func (o *operation) writeCLIConfig(ctx context.Context) error {
+	if !o.config.ProviderMirror {
+		return nil
+	}
+	hostname := o.server.Hostname()
+	config := fmt.Sprintf(`provider_installation {
+  network_mirror {
+    url     = "https://%[1]s/v1/providers/"
+    exclude = ["%[1]s/*/*"]
+  }
+  direct {
+    include = ["%[1]s/*/*"]
+  }
+}
+`, hostname)
+	if err := os.WriteFile(filepath.Join(o.root, cliConfigFilename), []byte(config), 0o644); err != nil {
+		return fmt.Errorf("writing CLI config: %w", err)
+	}
+	return nil
+}
+
+// cliConfigPath returns the path to the CLI config file, from the perspective
+// of terraform, which sees the root directory mounted at /config if it is
+// sandboxed.
+func (o *operation) cliConfigPath(sandboxed bool) string {
+	if sandboxed {
+		return path.Join("/config", cliConfigFilename)
+	}
+	return filepath.Join(o.root, cliConfigFilename)
+}
+
 func (o *operation) writeTerraformVars(ctx context.Context) error {
 	if err := variable.WriteTerraformVars(o.workdir.String(), o.variables); err != nil {
 		return fmt.Errorf("writing terraform.fvars: %w", err)
@@ -426,6 +617,9 @@ func (o *operation) terraformPlan(ctx context.Context) error {
 	if o.IsDestroy {
 		args = append(args, "-destroy")
 	}
+	if o.RefreshOnly {
+		args = append(args, "-refresh-only")
+	}
 	args = append(args, "-out="+planFilename)
 	return o.execute(append([]string{o.terraformPath}, args...))
 }
@@ -2,6 +2,7 @@ package agent
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"log/slog"
 	"os"
@@ -175,3 +176,24 @@ func TestExecutor_addSandboxWrapper(t *testing.T) {
 		assert.Equal(t, want, w.addSandboxWrapper([]string{"/tmp/tf-bins/1.1.1/terraform", "apply", "-input=false", "-no-color"}))
 	})
 }
+
+func TestOperation_writeCLIConfig(t *testing.T) {
+	root := t.TempDir()
+	w := operation{
+		config:       Config{ProviderMirror: true},
+		daemonClient: &daemonClient{server: fakeHostnameClient("tofutf.example.com")},
+		workdir:      &workdir{root: root},
+	}
+	require.NoError(t, w.writeCLIConfig(context.Background()))
+
+	got, err := os.ReadFile(w.cliConfigPath(false))
+	require.NoError(t, err)
+	assert.Contains(t, string(got), `url     = "https://tofutf.example.com/v1/providers/"`)
+	assert.Contains(t, string(got), `include = ["tofutf.example.com/*/*"]`)
+
+	assert.Equal(t, "/config/.tofutf.tfrc", w.cliConfigPath(true))
+}
+
+type fakeHostnameClient string
+
+func (f fakeHostnameClient) Hostname() string { return string(f) }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"slices"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/tofutf/tofutf/internal"
@@ -43,6 +44,7 @@ type (
 
 		startJob(ctx context.Context, spec JobSpec) ([]byte, error)
 		finishJob(ctx context.Context, spec JobSpec, opts finishJobOptions) error
+		createWorkloadIdentityToken(ctx context.Context, spec JobSpec, opts createWorkloadIdentityTokenOptions) ([]byte, error)
 	}
 
 	service struct {
@@ -57,6 +59,7 @@ type (
 		agentBroker pubsub.SubscriptionService[*Agent]
 		jobBroker   pubsub.SubscriptionService[*Job]
 		phases      phaseClient
+		workspaces  *workspace.Service
 
 		db *db
 		*registrar
@@ -91,7 +94,8 @@ func NewService(opts ServiceOptions) Service {
 		tokenFactory: &tokenFactory{
 			tokens: opts.TokensService,
 		},
-		phases: opts.RunService,
+		phases:     opts.RunService,
+		workspaces: opts.WorkspaceService,
 	}
 	svc.tfeapi = &tfe{
 		service:   svc,
@@ -160,6 +164,7 @@ func NewService(opts ServiceOptions) Service {
 	opts.WorkspaceService.BeforeUpdateWorkspace(svc.checkWorkspacePoolAccess)
 	// Register with auth middleware the agent token kind and a means of
 	// retrieving the appropriate agent corresponding to the agent token ID
+	opts.TokensService.RegisterWorkloadIdentityClaims(workloadIdentityClaims...)
 	opts.TokensService.RegisterKind(AgentTokenKind, func(ctx context.Context, tokenID string) (internal.Subject, error) {
 		pool, err := svc.db.getPoolByTokenID(ctx, tokenID)
 		if err != nil {
@@ -592,6 +597,10 @@ func (s *service) listJobs(ctx context.Context) ([]*Job, error) {
 	return s.db.listJobs(ctx)
 }
 
+func (s *service) listRunningJobs(ctx context.Context) ([]*Job, error) {
+	return s.db.listRunningJobs(ctx)
+}
+
 func (s *service) allocateJob(ctx context.Context, spec JobSpec, agentID string) (*Job, error) {
 	allocated, err := s.db.updateJob(ctx, spec, func(job *Job) error {
 		return job.allocate(agentID)
@@ -702,6 +711,70 @@ func (s *service) finishJob(ctx context.Context, spec JobSpec, opts finishJobOpt
 	return nil
 }
 
+type createWorkloadIdentityTokenOptions struct {
+	// Audience of token. If empty then a default audience is used.
+	Audience string `json:"audience,omitempty"`
+}
+
+// createWorkloadIdentityToken creates a workload identity token for a job,
+// with which the job can authenticate to third parties that trust tofutf as an
+// OIDC identity provider. Only the job itself may call this method.
+func (s *service) createWorkloadIdentityToken(ctx context.Context, spec JobSpec, opts createWorkloadIdentityTokenOptions) ([]byte, error) {
+	subject, err := internal.SubjectFromContext(ctx)
+	if err != nil {
+		return nil, internal.ErrAccessNotPermitted
+	}
+	job, ok := subject.(*Job)
+	if !ok || job.Spec != spec {
+		return nil, internal.ErrAccessNotPermitted
+	}
+	ws, err := s.workspaces.Get(ctx, job.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	token, err := s.tokenFactory.createWorkloadIdentityToken(job, ws.Name, opts.Audience)
+	if err != nil {
+		s.logger.Error("creating workload identity token", "spec", spec, "err", err)
+		return nil, err
+	}
+	s.logger.Debug("created workload identity token", "spec", spec, "audience", opts.Audience)
+	return token, nil
+}
+
+// errorStaleJob errors a job that is still running long after its timeout has
+// expired, along with its run phase. Only the manager may call this method.
+func (s *service) errorStaleJob(ctx context.Context, spec JobSpec) error {
+	subject, err := internal.SubjectFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := subject.(*manager); !ok {
+		return internal.ErrAccessNotPermitted
+	}
+	var stale bool
+	job, err := s.db.updateJob(ctx, spec, func(job *Job) error {
+		// the job may have finished since the manager last checked it
+		if stale = job.stale(time.Now()); !stale {
+			return nil
+		}
+		_, err := s.phases.FinishPhase(ctx, spec.RunID, spec.Phase, tofutfrun.PhaseFinishOptions{
+			Errored: true,
+		})
+		if err != nil {
+			return err
+		}
+		return job.finishJob(JobErrored)
+	})
+	if err != nil {
+		s.logger.Error("erroring stale job", "spec", spec, "err", err)
+		return err
+	}
+	if stale {
+		s.logger.Info("errored job that exceeded its timeout without its agent reporting", "job", job, "timeout", job.timeout())
+	}
+	return nil
+}
+
 // agent tokens
 
 func (s *service) CreateAgentToken(ctx context.Context, poolID string, opts CreateAgentTokenOptions) (*agentToken, []byte, error) {
@@ -10,6 +10,7 @@ type fakeService struct {
 	status                 AgentStatus
 	deletedAgentID         string
 	job                    *Job
+	erroredJob             *JobSpec
 
 	service
 }
@@ -49,6 +50,11 @@ func (f *fakeService) deleteAgent(ctx context.Context, agentID string) error {
 	return nil
 }
 
+func (f *fakeService) errorStaleJob(ctx context.Context, spec JobSpec) error {
+	f.erroredJob = &spec
+	return nil
+}
+
 func (f *fakeService) allocateJob(ctx context.Context, spec JobSpec, agentID string) (*Job, error) {
 	if err := f.job.allocate(agentID); err != nil {
 		return nil, err
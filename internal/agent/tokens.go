@@ -14,6 +14,26 @@ const (
 	JobTokenKind   tokens.Kind = "job_token"
 
 	defaultJobTokenExpiry = 60 * time.Minute
+
+	// defaultWorkloadIdentityTokenExpiry is the lifetime of a workload
+	// identity token for a job without a timeout.
+	defaultWorkloadIdentityTokenExpiry = 60 * time.Minute
+	// defaultWorkloadIdentityAudience is the audience of a workload identity
+	// token requested without an audience. Agents only request a token if
+	// the workspace sets WorkloadIdentityAudienceVariable.
+	defaultWorkloadIdentityAudience = "tofutf"
+
+	// WorkloadIdentityTokenVariable is the environment variable in which a job's
+	// workload identity token is made available to terraform.
+	WorkloadIdentityTokenVariable = "TOFUTF_WORKLOAD_IDENTITY_TOKEN"
+	// WorkloadIdentityTokenTerraformVariable is the terraform variable in
+	// which a job's workload identity token is made available to terraform
+	// configurations that declare it.
+	WorkloadIdentityTokenTerraformVariable = "tofutf_workload_identity_token"
+	// WorkloadIdentityAudienceVariable is the environment variable with which a
+	// workspace sets the audience of its workload identity tokens. A job is
+	// only issued a token if the variable is set.
+	WorkloadIdentityAudienceVariable = "TOFUTF_WORKLOAD_IDENTITY_AUDIENCE"
 )
 
 type (
@@ -40,6 +60,10 @@ func (a *agentToken) LogValue() slog.Value {
 	return slog.GroupValue(attrs...)
 }
 
+// workloadIdentityClaims are the custom claims of a workload identity token,
+// which are advertised by the identity provider.
+var workloadIdentityClaims = []string{"organization", "workspace", "workspace_id", "run_id", "run_phase"}
+
 type tokenFactory struct {
 	tokens *tokens.Service
 }
@@ -54,6 +78,32 @@ func (f *tokenFactory) createJobToken(spec JobSpec) ([]byte, error) {
 	})
 }
 
+// createWorkloadIdentityToken constructs a workload identity token for a
+// job, identifying the job to third parties.
+func (f *tokenFactory) createWorkloadIdentityToken(job *Job, workspaceName, audience string) ([]byte, error) {
+	if audience == "" {
+		audience = defaultWorkloadIdentityAudience
+	}
+	lifetime := defaultWorkloadIdentityTokenExpiry
+	if job.Timeout > 0 {
+		lifetime = job.timeout()
+	}
+	return f.tokens.NewWorkloadIdentityToken(tokens.WorkloadIdentityTokenOptions{
+		Subject: fmt.Sprintf("organization:%s:workspace:%s:run_phase:%s",
+			job.Organization, workspaceName, job.Spec.Phase),
+		Audience: audience,
+		Expiry:   internal.CurrentTimestamp(nil).Add(lifetime),
+		// keep in sync with workloadIdentityClaims
+		Claims: map[string]string{
+			"organization": job.Organization,
+			"workspace":    workspaceName,
+			"workspace_id": job.WorkspaceID,
+			"run_id":       job.Spec.RunID,
+			"run_phase":    string(job.Spec.Phase),
+		},
+	})
+}
+
 // NewAgentToken constructs a token for an agent, returning both the
 // representation of the token, and the cryptographic token itself.
 func (f *tokenFactory) NewAgentToken(poolID string, opts CreateAgentTokenOptions) (*agentToken, []byte, error) {
@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	otfapi "github.com/tofutf/tofutf/internal/api"
+	"github.com/tofutf/tofutf/internal/http/decode"
+	"github.com/tofutf/tofutf/internal/tfeapi"
+)
+
+type api struct {
+	*Service
+	*tfeapi.Responder
+}
+
+func (a *api) addHandlers(r *mux.Router) {
+	r = r.PathPrefix(otfapi.DefaultBasePath).Subrouter()
+
+	r.HandleFunc("/audit-events", a.listEvents).Methods("GET")
+}
+
+func (a *api) listEvents(w http.ResponseWriter, r *http.Request) {
+	var opts ListOptions
+	if err := decode.Query(&opts, r.URL.Query()); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	page, err := a.List(r.Context(), opts)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	a.RespondWithPage(w, r, page.Items, page.Pagination)
+}
@@ -0,0 +1,78 @@
+// Package audit records the mutating actions subjects carry out on resources.
+package audit
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/resource"
+)
+
+// DefaultRetention is the default period for which audit events are retained.
+const DefaultRetention = 90 * 24 * time.Hour
+
+type (
+	// Event is a record of a subject carrying out an action on a resource.
+	Event struct {
+		ID      string      `jsonapi:"primary,audit-events" json:"id"`
+		Time    time.Time   `jsonapi:"attribute" json:"time"`
+		Subject string      `jsonapi:"attribute" json:"subject"`
+		Action  rbac.Action `jsonapi:"attribute" json:"action"`
+		// ResourceID is the ID of the resource on which the action was carried
+		// out. For organization-level actions it is the name of the
+		// organization, and for site-wide actions it is empty.
+		ResourceID string `jsonapi:"attribute" json:"resource_id"`
+		// Organization is empty for actions not carried out within an
+		// organization.
+		Organization string `jsonapi:"attribute" json:"organization"`
+		// Request is metadata describing the request that carried out the
+		// action.
+		Request RequestMetadata `jsonapi:"attribute" json:"request"`
+	}
+
+	// RequestMetadata describes the HTTP request in which an action is
+	// carried out.
+	RequestMetadata struct {
+		ClientIP  string `json:"client_ip"`
+		UserAgent string `json:"user_agent"`
+		Method    string `json:"method"`
+		Path      string `json:"path"`
+		// StatusCode is the status code of the response to the request.
+		StatusCode int `json:"status_code"`
+	}
+
+	// ListOptions are options for paginating and filtering a list of audit
+	// events.
+	ListOptions struct {
+		Organization *string `schema:"organization_name,omitempty"`
+		Subject      *string `schema:"subject,omitempty"`
+		// Action is the name of an action, e.g. UnlockWorkspaceAction.
+		Action     *string `schema:"action,omitempty"`
+		ResourceID *string `schema:"resource_id,omitempty"`
+
+		resource.PageOptions
+	}
+)
+
+func newEvent(subject internal.Subject, action rbac.Action, resourceID, organization string) *Event {
+	return &Event{
+		ID:           internal.NewID("audit"),
+		Time:         internal.CurrentTimestamp(nil),
+		Subject:      subject.String(),
+		Action:       action,
+		ResourceID:   resourceID,
+		Organization: organization,
+	}
+}
+
+func (e *Event) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", e.ID),
+		slog.String("subject", e.Subject),
+		slog.String("action", e.Action.String()),
+		slog.String("resource_id", e.ResourceID),
+		slog.String("organization", e.Organization),
+	)
+}
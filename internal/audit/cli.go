@@ -0,0 +1,84 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	otfapi "github.com/tofutf/tofutf/internal/api"
+	"github.com/tofutf/tofutf/internal/resource"
+)
+
+type auditCLI struct {
+	client cliClient
+}
+
+type cliClient interface {
+	List(ctx context.Context, opts ListOptions) (*resource.Page[*Event], error)
+}
+
+func NewCommand(apiClient *otfapi.Client) *cobra.Command {
+	cli := &auditCLI{}
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Audit log",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Parent().PersistentPreRunE(cmd.Parent(), args); err != nil {
+				return err
+			}
+			cli.client = &Client{Client: apiClient}
+			return nil
+		},
+	}
+	cmd.AddCommand(cli.auditListCommand())
+
+	return cmd
+}
+
+func (a *auditCLI) auditListCommand() *cobra.Command {
+	var (
+		opts                                      ListOptions
+		organization, subject, action, resourceID string
+	)
+
+	cmd := &cobra.Command{
+		Use:           "list",
+		Short:         "List audit events, most recent first",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// only filter by those flags that have been set
+			if organization != "" {
+				opts.Organization = &organization
+			}
+			if subject != "" {
+				opts.Subject = &subject
+			}
+			if action != "" {
+				opts.Action = &action
+			}
+			if resourceID != "" {
+				opts.ResourceID = &resourceID
+			}
+			page, err := a.client.List(cmd.Context(), opts)
+			if err != nil {
+				return err
+			}
+			for _, event := range page.Items {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s\t%s\t%s\t%s\t%s\n",
+					event.Time.Format(time.RFC3339), event.Subject, event.Action, event.ResourceID, event.Organization)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&organization, "organization", "", "Only list events in this organization")
+	cmd.Flags().StringVar(&subject, "subject", "", "Only list events carried out by this subject")
+	cmd.Flags().StringVar(&action, "action", "", "Only list events for this action, e.g. UnlockWorkspaceAction")
+	cmd.Flags().StringVar(&resourceID, "resource-id", "", "Only list events for this resource")
+	cmd.Flags().IntVar(&opts.PageNumber, "page", 1, "Page number of results to list")
+	cmd.Flags().IntVar(&opts.PageSize, "page-size", 20, "Number of events to list per page")
+
+	return cmd
+}
@@ -0,0 +1,53 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/resource"
+)
+
+func TestAuditListCommand(t *testing.T) {
+	client := &fakeCLIClient{
+		events: []*Event{
+			{
+				Time:         time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC),
+				Subject:      "bobby",
+				Action:       rbac.LockWorkspaceAction,
+				ResourceID:   "ws-123",
+				Organization: "acme-corp",
+			},
+		},
+	}
+	cli := &auditCLI{client: client}
+	cmd := cli.auditListCommand()
+
+	cmd.SetArgs([]string{"--organization", "acme-corp", "--action", "LockWorkspaceAction"})
+	got := bytes.Buffer{}
+	cmd.SetOut(&got)
+	require.NoError(t, cmd.Execute())
+
+	assert.Equal(t, "2024-06-01T10:00:00Z\tbobby\tLockWorkspaceAction\tws-123\tacme-corp\n", got.String())
+	assert.Equal(t, "acme-corp", *client.opts.Organization)
+	assert.Equal(t, "LockWorkspaceAction", *client.opts.Action)
+	// unset flags are not used to filter events
+	assert.Nil(t, client.opts.Subject)
+	assert.Nil(t, client.opts.ResourceID)
+	assert.Equal(t, 1, client.opts.PageNumber)
+	assert.Equal(t, 20, client.opts.PageSize)
+}
+
+type fakeCLIClient struct {
+	events []*Event
+	opts   ListOptions
+}
+
+func (f *fakeCLIClient) List(ctx context.Context, opts ListOptions) (*resource.Page[*Event], error) {
+	f.opts = opts
+	return resource.NewPage(f.events, opts.PageOptions, nil), nil
+}
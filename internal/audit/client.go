@@ -0,0 +1,25 @@
+package audit
+
+import (
+	"context"
+
+	otfapi "github.com/tofutf/tofutf/internal/api"
+	"github.com/tofutf/tofutf/internal/resource"
+)
+
+type Client struct {
+	*otfapi.Client
+}
+
+// List lists audit events via HTTP/JSONAPI.
+func (c *Client) List(ctx context.Context, opts ListOptions) (*resource.Page[*Event], error) {
+	req, err := c.NewRequest("GET", "audit-events", &opts)
+	if err != nil {
+		return nil, err
+	}
+	var page resource.Page[*Event]
+	if err := c.Do(ctx, req, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
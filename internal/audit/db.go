@@ -0,0 +1,123 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/resource"
+	"github.com/tofutf/tofutf/internal/sql"
+	"github.com/tofutf/tofutf/internal/sql/pggen"
+)
+
+type (
+	// pgdb is an audit event database on postgres
+	pgdb struct {
+		*sql.Pool // provides access to generated SQL queries
+	}
+
+	// pgresult is the result of a database query for an audit event.
+	pgresult struct {
+		AuditEventID     pgtype.Text        `json:"audit_event_id"`
+		Time             pgtype.Timestamptz `json:"time"`
+		Subject          pgtype.Text        `json:"subject"`
+		Action           pgtype.Text        `json:"action"`
+		ResourceID       pgtype.Text        `json:"resource_id"`
+		OrganizationName pgtype.Text        `json:"organization_name"`
+		ClientIP         pgtype.Text        `json:"client_ip"`
+		UserAgent        pgtype.Text        `json:"user_agent"`
+		Method           pgtype.Text        `json:"method"`
+		Path             pgtype.Text        `json:"path"`
+		StatusCode       pgtype.Int4        `json:"status_code"`
+	}
+)
+
+func (r pgresult) toEvent() (*Event, error) {
+	action, err := rbac.ActionFromString(r.Action.String)
+	if err != nil {
+		return nil, err
+	}
+	return &Event{
+		ID:           r.AuditEventID.String,
+		Time:         r.Time.Time.UTC(),
+		Subject:      r.Subject.String,
+		Action:       action,
+		ResourceID:   r.ResourceID.String,
+		Organization: r.OrganizationName.String,
+		Request: RequestMetadata{
+			ClientIP:   r.ClientIP.String,
+			UserAgent:  r.UserAgent.String,
+			Method:     r.Method.String,
+			Path:       r.Path.String,
+			StatusCode: int(r.StatusCode.Int32),
+		},
+	}, nil
+}
+
+func (db *pgdb) create(ctx context.Context, event *Event) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.InsertAuditEvent(ctx, pggen.InsertAuditEventParams{
+			AuditEventID:     sql.String(event.ID),
+			Time:             sql.Timestamptz(event.Time),
+			Subject:          sql.String(event.Subject),
+			Action:           sql.String(event.Action.String()),
+			ResourceID:       sql.String(event.ResourceID),
+			OrganizationName: sql.String(event.Organization),
+			ClientIP:         sql.String(event.Request.ClientIP),
+			UserAgent:        sql.String(event.Request.UserAgent),
+			Method:           sql.String(event.Request.Method),
+			Path:             sql.String(event.Request.Path),
+			StatusCode:       sql.Int4(event.Request.StatusCode),
+		})
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) list(ctx context.Context, opts ListOptions) (*resource.Page[*Event], error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*resource.Page[*Event], error) {
+		rows, err := q.FindAuditEvents(ctx, pggen.FindAuditEventsParams{
+			OrganizationName: sql.StringPtr(opts.Organization),
+			Subject:          sql.StringPtr(opts.Subject),
+			Action:           sql.StringPtr(opts.Action),
+			ResourceID:       sql.StringPtr(opts.ResourceID),
+			Limit:            opts.GetLimit(),
+			Offset:           opts.GetOffset(),
+		})
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		count, err := q.CountAuditEvents(ctx, pggen.CountAuditEventsParams{
+			OrganizationName: sql.StringPtr(opts.Organization),
+			Subject:          sql.StringPtr(opts.Subject),
+			Action:           sql.StringPtr(opts.Action),
+			ResourceID:       sql.StringPtr(opts.ResourceID),
+		})
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+
+		items := make([]*Event, len(rows))
+		for i, r := range rows {
+			event, err := pgresult(r).toEvent()
+			if err != nil {
+				return nil, err
+			}
+			items[i] = event
+		}
+		return resource.NewPage(items, opts.PageOptions, internal.Int64(count.Int64)), nil
+	})
+}
+
+// deleteBefore deletes audit events that occurred before the given time,
+// returning the number of events deleted.
+func (db *pgdb) deleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (int64, error) {
+		tag, err := q.DeleteAuditEventsBefore(ctx, sql.Timestamptz(before))
+		if err != nil {
+			return 0, sql.Error(err)
+		}
+		return tag.RowsAffected(), nil
+	})
+}
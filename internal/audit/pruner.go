@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tofutf/tofutf/internal"
+)
+
+// PrunerLockID guarantees only one pruner on a cluster is running at any time.
+const PrunerLockID int64 = 5577006791947779418
+
+// defaultPruneInterval is how often the pruner deletes expired audit events.
+var defaultPruneInterval = time.Hour
+
+type (
+	// Pruner periodically deletes audit events older than the retention
+	// period.
+	Pruner struct {
+		Logger    *slog.Logger
+		Events    prunerClient
+		Retention time.Duration
+
+		// frequency with which the pruner deletes expired events
+		checkInterval time.Duration
+	}
+
+	prunerClient interface {
+		deleteBefore(ctx context.Context, before time.Time) (int64, error)
+	}
+)
+
+// Start starts the pruner daemon. Should be invoked in a go routine.
+func (p *Pruner) Start(ctx context.Context) error {
+	// run at startup and then every check interval
+	p.prune(ctx)
+	ticker := time.NewTicker(p.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.prune(ctx)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (p *Pruner) prune(ctx context.Context) {
+	before := internal.CurrentTimestamp(nil).Add(-p.Retention)
+	deleted, err := p.Events.deleteBefore(ctx, before)
+	if err != nil {
+		p.Logger.Error("deleting expired audit events", "err", err)
+		return
+	}
+	if deleted > 0 {
+		p.Logger.Info("deleted expired audit events", "deleted", deleted, "before", before)
+	}
+}
@@ -0,0 +1,33 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPruner_prune(t *testing.T) {
+	events := &fakePrunerClient{}
+	pruner := &Pruner{
+		Logger:    slog.Default(),
+		Events:    events,
+		Retention: 24 * time.Hour,
+	}
+
+	pruner.prune(context.Background())
+
+	// events older than the retention period are deleted
+	assert.WithinDuration(t, time.Now().Add(-24*time.Hour), events.before, time.Minute)
+}
+
+type fakePrunerClient struct {
+	before time.Time
+}
+
+func (f *fakePrunerClient) deleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	f.before = before
+	return 0, nil
+}
@@ -0,0 +1,177 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/felixge/httpsnoop"
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal"
+	otfhttp "github.com/tofutf/tofutf/internal/http"
+	"github.com/tofutf/tofutf/internal/http/html"
+	"github.com/tofutf/tofutf/internal/organization"
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/resource"
+	"github.com/tofutf/tofutf/internal/sql"
+	"github.com/tofutf/tofutf/internal/tfeapi"
+)
+
+type (
+	Service struct {
+		logger       *slog.Logger
+		site         internal.Authorizer
+		organization internal.Authorizer
+		db           *pgdb
+		sink         *Sink
+		api          *api
+		web          *webHandlers
+	}
+
+	Options struct {
+		*sql.Pool
+		*tfeapi.Responder
+		html.Renderer
+		Logger *slog.Logger
+
+		// SinkURL is an optional URL to which audit events are streamed.
+		SinkURL string
+	}
+)
+
+func NewService(opts Options) *Service {
+	svc := Service{
+		logger:       opts.Logger,
+		site:         &internal.SiteAuthorizer{Logger: opts.Logger},
+		organization: &organization.Authorizer{Logger: opts.Logger},
+		db:           &pgdb{opts.Pool},
+	}
+	if opts.SinkURL != "" {
+		svc.sink = newSink(opts.Logger, opts.SinkURL)
+	}
+	svc.api = &api{
+		Service:   &svc,
+		Responder: opts.Responder,
+	}
+	svc.web = &webHandlers{
+		Renderer: opts.Renderer,
+		svc:      &svc,
+	}
+	return &svc
+}
+
+func (s *Service) AddHandlers(r *mux.Router) {
+	s.api.addHandlers(r)
+	s.web.addHandlers(r)
+}
+
+// Sink returns the sink to which audit events are streamed, or nil if
+// streaming is not configured.
+func (s *Service) Sink() *Sink {
+	return s.sink
+}
+
+// NewPruner constructs a pruner, which deletes audit events older than the
+// retention period.
+func (s *Service) NewPruner(logger *slog.Logger, retention time.Duration) *Pruner {
+	return &Pruner{
+		Logger:        logger.With("component", "audit-pruner"),
+		Events:        s.db,
+		Retention:     retention,
+		checkInterval: defaultPruneInterval,
+	}
+}
+
+// Middleware returns middleware that records the mutating actions authorized
+// in the course of each request, along with metadata describing the request.
+func (s *Service) Middleware() mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rec := &recorder{}
+			ctx := internal.AddAuditorToContext(r.Context(), rec)
+			m := httpsnoop.CaptureMetrics(next, w, r.WithContext(ctx))
+
+			events := rec.flush()
+			if len(events) == 0 {
+				return
+			}
+			clientIP, _ := otfhttp.GetClientIP(r)
+			metadata := RequestMetadata{
+				ClientIP:   clientIP,
+				UserAgent:  r.UserAgent(),
+				Method:     r.Method,
+				Path:       r.URL.Path,
+				StatusCode: m.Code,
+			}
+			// record events even if the client has gone away
+			ctx = context.WithoutCancel(r.Context())
+			for _, event := range events {
+				event.Request = metadata
+				s.record(ctx, event)
+			}
+		})
+	}
+}
+
+func (s *Service) List(ctx context.Context, opts ListOptions) (*resource.Page[*Event], error) {
+	var (
+		subject internal.Subject
+		err     error
+	)
+	if opts.Organization != nil {
+		subject, err = s.organization.CanAccess(ctx, rbac.ListAuditEventsAction, *opts.Organization)
+	} else {
+		subject, err = s.site.CanAccess(ctx, rbac.ListAuditEventsAction, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if opts.Action != nil {
+		if _, err := rbac.ActionFromString(*opts.Action); err != nil {
+			return nil, err
+		}
+	}
+
+	page, err := s.db.list(ctx, opts)
+	if err != nil {
+		s.logger.Error("listing audit events", "subject", subject, "err", err)
+		return nil, err
+	}
+	s.logger.Debug("listed audit events", "subject", subject)
+	return page, nil
+}
+
+// record persists an audit event and streams it to the sink, if configured.
+func (s *Service) record(ctx context.Context, event *Event) {
+	if err := s.db.create(ctx, event); err != nil {
+		s.logger.Error("recording audit event", "event", event, "err", err)
+		return
+	}
+	if s.sink != nil {
+		s.sink.send(event)
+	}
+}
+
+// recorder collects the actions authorized during a request.
+type recorder struct {
+	mu     sync.Mutex
+	events []*Event
+}
+
+func (r *recorder) Record(_ context.Context, subject internal.Subject, action rbac.Action, resourceID, organization string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, newEvent(subject, action, resourceID, organization))
+}
+
+func (r *recorder) flush() []*Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	events := r.events
+	r.events = nil
+	return events
+}
@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/rbac"
+)
+
+func TestRecorder(t *testing.T) {
+	rec := &recorder{}
+	ctx := internal.AddAuditorToContext(context.Background(), rec)
+	subject := &internal.Superuser{Username: "bobby"}
+
+	internal.RecordAuthorized(ctx, subject, rbac.LockWorkspaceAction, "ws-123", "acme-corp")
+	// read-only actions are not recorded
+	internal.RecordAuthorized(ctx, subject, rbac.GetWorkspaceAction, "ws-123", "acme-corp")
+	// delegating authorizers override the recorded resource
+	internal.RecordAuthorized(internal.AddAuditResourceToContext(ctx, "run-123"), subject, rbac.ApplyRunAction, "ws-123", "acme-corp")
+
+	events := rec.flush()
+	require.Len(t, events, 2)
+
+	assert.Equal(t, "bobby", events[0].Subject)
+	assert.Equal(t, rbac.LockWorkspaceAction, events[0].Action)
+	assert.Equal(t, "ws-123", events[0].ResourceID)
+	assert.Equal(t, "acme-corp", events[0].Organization)
+
+	assert.Equal(t, rbac.ApplyRunAction, events[1].Action)
+	assert.Equal(t, "run-123", events[1].ResourceID)
+
+	// flushing empties the recorder
+	assert.Empty(t, rec.flush())
+}
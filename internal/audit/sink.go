@@ -0,0 +1,81 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// sinkBufferSize is the number of events buffered before events are dropped
+// rather than streamed to the sink.
+const sinkBufferSize = 1000
+
+// Sink streams audit events to an external HTTP endpoint. Each event is sent
+// as a JSON encoded POST request.
+type Sink struct {
+	logger *slog.Logger
+	url    string
+	client *http.Client
+	events chan *Event
+}
+
+func newSink(logger *slog.Logger, url string) *Sink {
+	return &Sink{
+		logger: logger.With("component", "audit-sink"),
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+		events: make(chan *Event, sinkBufferSize),
+	}
+}
+
+// Start streams events to the sink until the context is cancelled. Should be
+// invoked in a go routine.
+func (s *Sink) Start(ctx context.Context) error {
+	for {
+		select {
+		case event := <-s.events:
+			if err := s.post(ctx, event); err != nil {
+				s.logger.Error("streaming audit event", "event", event, "err", err)
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// send queues an event for streaming to the sink. The event is dropped if the
+// queue is full, so as not to hold up the request that carried out the
+// action.
+func (s *Sink) send(event *Event) {
+	select {
+	case s.events <- event:
+	default:
+		s.logger.Warn("dropped audit event: sink buffer is full", "event", event)
+	}
+}
+
+func (s *Sink) post(ctx context.Context, event *Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
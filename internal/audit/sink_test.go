@@ -0,0 +1,57 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal/rbac"
+)
+
+func TestSink(t *testing.T) {
+	got := make(chan *Event, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var event Event
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		got <- &event
+	}))
+	t.Cleanup(srv.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	sink := newSink(slog.Default(), srv.URL)
+	go sink.Start(ctx)
+
+	want := &Event{
+		ID:           "audit-123",
+		Subject:      "bobby",
+		Action:       rbac.UnlockWorkspaceAction,
+		ResourceID:   "ws-123",
+		Organization: "acme-corp",
+		Request: RequestMetadata{
+			Method:     "POST",
+			Path:       "/api/v2/workspaces/ws-123/actions/unlock",
+			StatusCode: 200,
+		},
+	}
+	sink.send(want)
+
+	assert.Equal(t, want, <-got)
+}
+
+func TestSink_DropWhenFull(t *testing.T) {
+	sink := newSink(slog.Default(), "http://sink.example.com")
+
+	// sink is not started, so events are only buffered
+	for range sinkBufferSize + 1 {
+		sink.send(&Event{})
+	}
+	assert.Len(t, sink.events, sinkBufferSize)
+}
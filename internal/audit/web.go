@@ -0,0 +1,76 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal/http/decode"
+	"github.com/tofutf/tofutf/internal/http/html"
+	"github.com/tofutf/tofutf/internal/resource"
+)
+
+type webHandlers struct {
+	html.Renderer
+
+	svc webClient
+}
+
+type webClient interface {
+	List(ctx context.Context, opts ListOptions) (*resource.Page[*Event], error)
+}
+
+func (h *webHandlers) addHandlers(r *mux.Router) {
+	r = html.UIRouter(r)
+
+	r.HandleFunc("/admin/audit-events", h.list).Methods("GET")
+}
+
+func (h *webHandlers) list(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Organization string `schema:"search[organization]"`
+		Subject      string `schema:"search[subject]"`
+		Action       string `schema:"search[action]"`
+		ResourceID   string `schema:"search[resource_id]"`
+		resource.PageOptions
+	}
+	if err := decode.All(&params, r); err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	opts := ListOptions{PageOptions: params.PageOptions}
+	if params.Organization != "" {
+		opts.Organization = &params.Organization
+	}
+	if params.Subject != "" {
+		opts.Subject = &params.Subject
+	}
+	if params.Action != "" {
+		opts.Action = &params.Action
+	}
+	if params.ResourceID != "" {
+		opts.ResourceID = &params.ResourceID
+	}
+	page, err := h.svc.List(r.Context(), opts)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.Render("audit_event_list.tmpl", w, struct {
+		html.SitePage
+		*resource.Page[*Event]
+		Organization string
+		Subject      string
+		Action       string
+		ResourceID   string
+	}{
+		SitePage:     html.NewSitePage(r, "audit events"),
+		Page:         page,
+		Organization: params.Organization,
+		Subject:      params.Subject,
+		Action:       params.Action,
+		ResourceID:   params.ResourceID,
+	})
+}
@@ -0,0 +1,42 @@
+package audit
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/antchfx/htmlquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/testutils"
+	"github.com/tofutf/tofutf/internal/user"
+)
+
+func TestListEventsHandler(t *testing.T) {
+	client := &fakeCLIClient{events: []*Event{
+		{ID: "audit-1", Subject: "bobby", Action: rbac.LockWorkspaceAction, ResourceID: "ws-123", Organization: "acme-corp"},
+		{ID: "audit-2", Subject: "bobby", Action: rbac.UnlockWorkspaceAction, ResourceID: "ws-123", Organization: "acme-corp"},
+	}}
+	h := &webHandlers{
+		Renderer: testutils.NewRenderer(t),
+		svc:      client,
+	}
+
+	r := httptest.NewRequest("GET", "/?search[organization]=acme-corp&search[subject]=bobby", nil)
+	r = r.WithContext(internal.AddSubjectToContext(context.Background(), &user.SiteAdmin))
+	w := httptest.NewRecorder()
+	h.list(w, r)
+	require.Equal(t, 200, w.Code, w.Body.String())
+
+	assert.Equal(t, "acme-corp", *client.opts.Organization)
+	assert.Equal(t, "bobby", *client.opts.Subject)
+	assert.Nil(t, client.opts.Action)
+	assert.Nil(t, client.opts.ResourceID)
+
+	doc, err := htmlquery.Parse(w.Body)
+	require.NoError(t, err)
+	assert.NotNil(t, htmlquery.FindOne(doc, "//div[@id='audit-1']"))
+	assert.NotNil(t, htmlquery.FindOne(doc, "//div[@id='audit-2']"))
+}
@@ -0,0 +1,55 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/tofutf/tofutf/internal/rbac"
+)
+
+// unexported key types prevents collisions
+type (
+	auditorCtxKeyType       string
+	auditResourceCtxKeyType string
+)
+
+const (
+	auditorCtxKey       auditorCtxKeyType       = "auditor"
+	auditResourceCtxKey auditResourceCtxKeyType = "audit_resource"
+)
+
+// Auditor records actions that subjects have been authorized to carry out.
+type Auditor interface {
+	Record(ctx context.Context, subject Subject, action rbac.Action, resourceID, organization string)
+}
+
+// AddAuditorToContext adds an auditor to a context. Authorizers record the
+// mutating actions they authorize with the auditor.
+func AddAuditorToContext(ctx context.Context, auditor Auditor) context.Context {
+	return context.WithValue(ctx, auditorCtxKey, auditor)
+}
+
+// AddAuditResourceToContext adds to the context the ID of the resource to be
+// recorded by an authorizer. An authorizer that delegates to another
+// authorizer uses this to record the resource it is authorizing, e.g. a run,
+// rather than the resource authorized by the delegate, e.g. the run's
+// workspace.
+func AddAuditResourceToContext(ctx context.Context, resourceID string) context.Context {
+	return context.WithValue(ctx, auditResourceCtxKey, resourceID)
+}
+
+// RecordAuthorized records an authorized action with the auditor in the
+// context. Nothing is recorded if there is no auditor in the context or if the
+// action is not a mutation.
+func RecordAuthorized(ctx context.Context, subject Subject, action rbac.Action, resourceID, organization string) {
+	auditor, ok := ctx.Value(auditorCtxKey).(Auditor)
+	if !ok {
+		return
+	}
+	if !action.IsMutation() {
+		return
+	}
+	if id, ok := ctx.Value(auditResourceCtxKey).(string); ok {
+		resourceID = id
+	}
+	auditor.Record(ctx, subject, action, resourceID, organization)
+}
@@ -10,10 +10,11 @@ import (
 
 type fakeTokenHandler struct {
 	username string
+	groups   []string
 }
 
-func (f fakeTokenHandler) getUsername(ctx context.Context, token *oauth2.Token) (string, error) {
-	return f.username, nil
+func (f fakeTokenHandler) getUserInfo(ctx context.Context, token *oauth2.Token) (userInfo, error) {
+	return userInfo{username: f.username, groups: f.groups}, nil
 }
 
 type fakeTokensService struct{}
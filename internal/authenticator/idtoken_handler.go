@@ -14,15 +14,21 @@ var (
 	// gives OTF access to the user's username.
 	DefaultOIDCScopes       = []string{oidc.ScopeOpenID, "profile"}
 	ErrMissingOIDCIssuerURL = errors.New("missing oidc-issuer-url")
+	// ErrMissingOIDCGroupsClaim is returned when team mappings are specified
+	// without a groups claim.
+	ErrMissingOIDCGroupsClaim = errors.New("oidc-team-mappings requires oidc-groups-claim")
 )
 
 type (
 	// idtokenHandler handles specifically an OIDC ID token, extracting the
-	// username from a claim within the token.
+	// username, and optionally groups, from claims within the token.
 	idtokenHandler struct {
 		provider *oidc.Provider
 		verifier *oidc.IDTokenVerifier
 		username *usernameClaim
+		// groupsClaim is the name of the claim providing groups; empty if
+		// groups are not extracted.
+		groupsClaim string
 	}
 
 	// OIDCConfig is the configuration for a generic OIDC provider.
@@ -41,6 +47,12 @@ type (
 		Scopes []string
 		// UsernameClaim is the claim that provides the username.
 		UsernameClaim string
+		// GroupsClaim is the claim that provides the groups to which the
+		// user belongs. If empty then groups are not extracted.
+		GroupsClaim string
+		// TeamMappings map groups to teams. Upon each login the user's
+		// memberships of mapped teams are synchronised with the user's groups.
+		TeamMappings []string
 	}
 )
 
@@ -63,29 +75,39 @@ func newIDTokenHandler(ctx context.Context, opts OIDCConfig) (*idtokenHandler, e
 	}
 
 	return &idtokenHandler{
-		verifier: provider.Verifier(&oidc.Config{ClientID: opts.ClientID}),
-		username: username,
-		provider: provider,
+		verifier:    provider.Verifier(&oidc.Config{ClientID: opts.ClientID}),
+		username:    username,
+		groupsClaim: opts.GroupsClaim,
+		provider:    provider,
 	}, nil
 }
 
-func (o idtokenHandler) getUsername(ctx context.Context, token *oauth2.Token) (string, error) {
+func (o idtokenHandler) getUserInfo(ctx context.Context, token *oauth2.Token) (userInfo, error) {
 	// Extract the ID Token from OAuth2 token.
 	rawIDToken, ok := token.Extra("id_token").(string)
 	if !ok {
-		return "", errors.New("id_token missing")
+		return userInfo{}, errors.New("id_token missing")
 	}
 
 	// Parse and verify ID Token payload.
 	idt, err := o.verifier.Verify(ctx, rawIDToken)
 	if err != nil {
-		return "", err
+		return userInfo{}, err
 	}
 
 	// Extract username from claim
 	if err := idt.Claims(&o.username); err != nil {
-		return "", err
+		return userInfo{}, err
 	}
+	info := userInfo{username: o.username.value}
 
-	return o.username.value, nil
+	// Optionally extract groups from claim
+	if o.groupsClaim != "" {
+		groups := groupsClaim{name: o.groupsClaim}
+		if err := idt.Claims(&groups); err != nil {
+			return userInfo{}, err
+		}
+		info.groups = groups.values
+	}
+	return info, nil
 }
@@ -22,13 +22,14 @@ func Test_newIDTokenHandler(t *testing.T) {
 	assert.Equal(t, ErrMissingOIDCIssuerURL, err)
 }
 
-// Test_idtokenHandler_getUsername tests extracting the 'name' claim from an ID
-// token.
-func Test_idtokenHandler_getUsername(t *testing.T) {
+// Test_idtokenHandler_getUserInfo tests extracting the 'name' and 'groups'
+// claims from an ID token.
+func Test_idtokenHandler_getUserInfo(t *testing.T) {
 	// create id token
 	token, err := jwt.NewBuilder().
 		Audience([]string{"otf"}).
 		Claim("name", "bobby").
+		Claim("groups", []string{"grp-platform", "grp-devs"}).
 		IssuedAt(time.Now()).
 		Expiration(time.Now().Add(time.Minute)).
 		Build()
@@ -48,12 +49,14 @@ func Test_idtokenHandler_getUsername(t *testing.T) {
 	require.NoError(t, err)
 
 	handler := idtokenHandler{
-		verifier: fakeVerifier(t, "otf", key),
-		username: username,
+		verifier:    fakeVerifier(t, "otf", key),
+		username:    username,
+		groupsClaim: "groups",
 	}
-	got, err := handler.getUsername(context.Background(), (&oauth2.Token{}).WithExtra(
+	got, err := handler.getUserInfo(context.Background(), (&oauth2.Token{}).WithExtra(
 		map[string]any{"id_token": string(signed)},
 	))
 	require.NoError(t, err)
-	assert.Equal(t, "bobby", got)
+	assert.Equal(t, "bobby", got.username)
+	assert.Equal(t, []string{"grp-platform", "grp-devs"}, got.groups)
 }
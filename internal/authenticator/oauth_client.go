@@ -22,10 +22,18 @@ const oauthCookieName = "oauth-state"
 var ErrOAuthCredentialsIncomplete = errors.New("must specify both client ID and client secret")
 
 type (
-	// tokenHandler takes an OAuth access token and returns the username
+	// tokenHandler takes an OAuth access token and returns the user
 	// associated with the token.
 	tokenHandler interface {
-		getUsername(context.Context, *oauth2.Token) (string, error)
+		getUserInfo(context.Context, *oauth2.Token) (userInfo, error)
+	}
+
+	// userInfo is information about the authenticated user.
+	userInfo struct {
+		username string
+		// groups to which the user belongs; only populated by handlers that
+		// support groups.
+		groups []string
 	}
 
 	sessionStarter interface {
@@ -44,6 +52,8 @@ type (
 		OAuthConfig
 
 		sessions sessionStarter
+		// optionally synchronise team memberships upon login
+		teams *teamSyncer
 	}
 
 	// OAuthConfig is configuration for constructing an OAuth client
@@ -156,17 +166,26 @@ func (a *OAuthClient) callbackHandler(w http.ResponseWriter, r *http.Request) {
 		http.Redirect(w, r, paths.Login(), http.StatusFound)
 		return
 	}
-	// Extract username from OAuth token
-	username, err := a.getUsername(r.Context(), token)
+	// Extract user info from OAuth token
+	info, err := a.getUserInfo(r.Context(), token)
 	if err != nil {
 		html.Error(w, err.Error(), http.StatusInternalServerError, false)
 		return
 	}
-	err = a.sessions.StartSession(w, r, tokens.StartSessionOptions{Username: &username})
+	err = a.sessions.StartSession(w, r, tokens.StartSessionOptions{Username: &info.username})
 	if err != nil {
 		html.Error(w, err.Error(), http.StatusInternalServerError, false)
 		return
 	}
+	// Team memberships are only synchronised once the session has started,
+	// in order that a user who cannot log in, e.g. a deactivated user, is
+	// left untouched. The response has already been written, so an error
+	// can only be logged.
+	if a.teams != nil {
+		if err := a.teams.sync(r.Context(), info.username, info.groups); err != nil {
+			a.teams.logger.Error("synchronising team memberships", "username", info.username, "err", err)
+		}
+	}
 }
 
 // config generates an oauth2 config for the client - note this is done at
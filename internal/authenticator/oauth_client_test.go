@@ -55,7 +55,7 @@ func newTestOAuthServerClient(t *testing.T, username string) *OAuthClient {
 	require.NoError(t, err)
 
 	client, err := newOAuthClient(
-		fakeTokenHandler{username},
+		fakeTokenHandler{username: username},
 		internal.NewHostnameService("otf-server.com"),
 		&fakeTokensService{},
 		OAuthConfig{
@@ -3,6 +3,7 @@ package authenticator
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 )
 
 const (
@@ -52,3 +53,34 @@ func (uc *usernameClaim) UnmarshalJSON(b []byte) error {
 	}
 	return nil
 }
+
+// groupsClaim retrieves a list of groups from an OIDC claim. The claim is
+// either an array of strings or a single string.
+type groupsClaim struct {
+	name   string
+	values []string
+}
+
+func (gc *groupsClaim) UnmarshalJSON(b []byte) error {
+	var token map[string]json.RawMessage
+	if err := json.Unmarshal(b, &token); err != nil {
+		return err
+	}
+	raw, ok := token[gc.name]
+	if !ok {
+		// user belongs to no groups
+		gc.values = nil
+		return nil
+	}
+	var values []string
+	if err := json.Unmarshal(raw, &values); err == nil {
+		gc.values = values
+		return nil
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return fmt.Errorf("groups claim %s must be a string or an array of strings", gc.name)
+	}
+	gc.values = []string{value}
+	return nil
+}
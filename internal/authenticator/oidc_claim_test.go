@@ -39,3 +39,35 @@ func TestUsernameClaim_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupsClaim_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  []string
+	}{
+		{
+			name:  "array",
+			token: `{"groups": ["grp-platform", "grp-devs"]}`,
+			want:  []string{"grp-platform", "grp-devs"},
+		},
+		{
+			name:  "string",
+			token: `{"groups": "grp-platform"}`,
+			want:  []string{"grp-platform"},
+		},
+		{
+			name:  "missing",
+			token: `{"name": "bobby"}`,
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gc := groupsClaim{name: "groups"}
+			err := json.Unmarshal([]byte(tt.token), &gc)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, gc.values)
+		})
+	}
+}
@@ -24,12 +24,16 @@ type (
 	}
 )
 
-func (a *opaqueHandler) getUsername(ctx context.Context, token *oauth2.Token) (string, error) {
+func (a *opaqueHandler) getUserInfo(ctx context.Context, token *oauth2.Token) (userInfo, error) {
 	// construct client from token
 	client, err := a.ClientConstructor(a.OAuthConfig, token)
 	if err != nil {
-		return "", err
+		return userInfo{}, err
 	}
 	// get username from identity provider
-	return client.GetCurrentUser(ctx)
+	username, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		return userInfo{}, err
+	}
+	return userInfo{username: username}, nil
 }
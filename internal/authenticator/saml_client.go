@@ -0,0 +1,336 @@
+package authenticator
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+	"github.com/gorilla/mux"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/http/html"
+	"github.com/tofutf/tofutf/internal/http/html/paths"
+	"github.com/tofutf/tofutf/internal/tokens"
+)
+
+const samlCookieName = "saml-request-id"
+
+var (
+	// ErrMissingSAMLGroupsAttribute is returned when team mappings are
+	// specified without a groups attribute.
+	ErrMissingSAMLGroupsAttribute = errors.New("saml-team-mappings requires saml-groups-attribute")
+	// ErrMissingSAMLUsername is returned when a SAML assertion does not
+	// provide a username.
+	ErrMissingSAMLUsername = errors.New("saml assertion is missing username")
+)
+
+type (
+	// SAMLConfig is the configuration for a SAML identity provider.
+	SAMLConfig struct {
+		// Name is the user-friendly identifier of the SAML identity provider.
+		Name string
+		// IDPMetadata is either the URL or the path to a file containing the
+		// identity provider's metadata. If empty then SAML is disabled.
+		IDPMetadata string
+		// EntityID identifies tofutf to the identity provider. Defaults to
+		// the URL of the service provider metadata endpoint.
+		EntityID string
+		// CertFile and KeyFile are paths to a certificate and key with which
+		// to sign authentication requests and decrypt assertions. Optional.
+		CertFile, KeyFile string
+		// UsernameAttribute is the attribute that provides the username. If
+		// empty then the assertion's subject NameID is used.
+		UsernameAttribute string
+		// GroupsAttribute is the attribute that provides the groups to which
+		// the user belongs. If empty then groups are not extracted.
+		GroupsAttribute string
+		// TeamMappings map groups to teams. Upon each login the user's
+		// memberships of mapped teams are synchronised with the user's groups.
+		TeamMappings []string
+		// Skip TLS Verification when retrieving identity provider metadata.
+		SkipTLSVerification bool
+	}
+
+	// SAMLClient performs the service provider role in a SAML single sign-on
+	// flow, validating assertions from an identity provider and starting a
+	// session for the asserted user.
+	SAMLClient struct {
+		// for retrieving OTF system hostname to construct service provider
+		// URLs
+		*internal.HostnameService
+
+		logger            *slog.Logger
+		name              string
+		entityID          string
+		idpMetadata       *saml.EntityDescriptor
+		key               crypto.Signer
+		cert              *x509.Certificate
+		usernameAttribute string
+		groupsAttribute   string
+
+		sessions sessionStarter
+		// optionally synchronise team memberships upon login
+		teams *teamSyncer
+	}
+)
+
+func newSAMLClient(ctx context.Context, opts Options) (*SAMLClient, error) {
+	cfg := opts.SAMLConfig
+	idpMetadata, err := loadIDPMetadata(ctx, cfg.IDPMetadata, cfg.SkipTLSVerification)
+	if err != nil {
+		return nil, fmt.Errorf("loading SAML identity provider metadata: %w", err)
+	}
+	client := &SAMLClient{
+		HostnameService:   opts.HostnameService,
+		logger:            opts.Logger,
+		name:              cfg.Name,
+		entityID:          cfg.EntityID,
+		idpMetadata:       idpMetadata,
+		usernameAttribute: cfg.UsernameAttribute,
+		groupsAttribute:   cfg.GroupsAttribute,
+		sessions:          opts.TokensService,
+	}
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		keyPair, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading SAML service provider certificate: %w", err)
+		}
+		signer, ok := keyPair.PrivateKey.(crypto.Signer)
+		if !ok {
+			return nil, errors.New("SAML service provider key must be an RSA or ECDSA key")
+		}
+		client.key = signer
+		client.cert = keyPair.Leaf
+	}
+	if len(cfg.TeamMappings) > 0 {
+		if cfg.GroupsAttribute == "" {
+			return nil, ErrMissingSAMLGroupsAttribute
+		}
+		client.teams, err = newTeamSyncer(opts, cfg.TeamMappings)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+// loadIDPMetadata loads identity provider metadata from either a URL or a
+// file.
+func loadIDPMetadata(ctx context.Context, location string, skipTLSVerification bool) (*saml.EntityDescriptor, error) {
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		u, err := url.Parse(location)
+		if err != nil {
+			return nil, err
+		}
+		client := &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: skipTLSVerification,
+				},
+			},
+		}
+		return samlsp.FetchMetadata(ctx, client, *u)
+	}
+	data, err := os.ReadFile(location)
+	if err != nil {
+		return nil, err
+	}
+	return samlsp.ParseMetadata(data)
+}
+
+// String provides a human-readable identifier for the SAML client.
+func (a *SAMLClient) String() string { return a.name }
+
+func (a *SAMLClient) RequestPath() string {
+	return "/saml/" + a.String() + "/login"
+}
+
+func (a *SAMLClient) metadataPath() string {
+	return "/saml/" + a.String() + "/metadata"
+}
+
+func (a *SAMLClient) acsPath() string {
+	return "/saml/" + a.String() + "/acs"
+}
+
+func (a *SAMLClient) addHandlers(r *mux.Router) {
+	r.HandleFunc(a.RequestPath(), a.requestHandler).Methods("GET")
+	r.HandleFunc(a.metadataPath(), a.metadataHandler).Methods("GET")
+	r.HandleFunc(a.acsPath(), a.acsHandler).Methods("POST")
+}
+
+// metadataHandler serves the service provider metadata, with which the
+// identity provider is configured.
+func (a *SAMLClient) metadataHandler(w http.ResponseWriter, r *http.Request) {
+	buf, err := xml.MarshalIndent(a.serviceProvider().Metadata(), "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.Write(buf) //nolint:errcheck
+}
+
+// requestHandler initiates the SAML flow, redirecting the user to the identity
+// provider with an authentication request.
+func (a *SAMLClient) requestHandler(w http.ResponseWriter, r *http.Request) {
+	sp := a.serviceProvider()
+	req, err := sp.MakeAuthenticationRequest(
+		sp.GetSSOBindingLocation(saml.HTTPRedirectBinding),
+		saml.HTTPRedirectBinding,
+		saml.HTTPPostBinding,
+	)
+	if err != nil {
+		html.Error(w, err.Error(), http.StatusInternalServerError, false)
+		return
+	}
+	redirectURL, err := req.Redirect("", sp)
+	if err != nil {
+		html.Error(w, err.Error(), http.StatusInternalServerError, false)
+		return
+	}
+	// Record the request ID, to check that the response from the identity
+	// provider is in response to this request. The identity provider posts
+	// its response from another site, so the cookie cannot be restricted to
+	// the same site.
+	http.SetCookie(w, &http.Cookie{
+		Name:     samlCookieName,
+		Value:    req.ID,
+		Path:     a.acsPath(),
+		MaxAge:   300, // 5 minutes
+		HttpOnly: true,
+		Secure:   true, // HTTPS only
+		SameSite: http.SameSiteNoneMode,
+	})
+	http.Redirect(w, r, redirectURL.String(), http.StatusFound)
+}
+
+// acsHandler is the assertion consumer service, handling the response from the
+// identity provider, validating the assertion it contains, and starting a new
+// OTF user session.
+func (a *SAMLClient) acsHandler(w http.ResponseWriter, r *http.Request) {
+	// Only accept responses to requests made by this client, i.e. identity
+	// provider initiated logins are not permitted.
+	cookie, err := r.Cookie(samlCookieName)
+	if err != nil {
+		html.FlashError(w, "missing SAML request cookie (the cookie expires after 5 minutes)")
+		http.Redirect(w, r, paths.Login(), http.StatusFound)
+		return
+	}
+	// Expire the cookie regardless of whether the response is valid, so that
+	// each request ID is only used once and a response cannot be replayed.
+	http.SetCookie(w, &http.Cookie{
+		Name:     samlCookieName,
+		Path:     a.acsPath(),
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteNoneMode,
+	})
+	if err := r.ParseForm(); err != nil {
+		html.Error(w, err.Error(), http.StatusUnprocessableEntity, false)
+		return
+	}
+	assertion, err := a.serviceProvider().ParseResponse(r, []string{cookie.Value})
+	if err != nil {
+		var invalid *saml.InvalidResponseError
+		if errors.As(err, &invalid) {
+			err = invalid.PrivateErr
+		}
+		a.logger.Error("validating SAML response", "err", err)
+		html.FlashError(w, "invalid SAML response")
+		http.Redirect(w, r, paths.Login(), http.StatusFound)
+		return
+	}
+	info, err := a.getUserInfo(assertion)
+	if err != nil {
+		html.Error(w, err.Error(), http.StatusInternalServerError, false)
+		return
+	}
+	err = a.sessions.StartSession(w, r, tokens.StartSessionOptions{Username: &info.username})
+	if err != nil {
+		html.Error(w, err.Error(), http.StatusInternalServerError, false)
+		return
+	}
+	// Team memberships are only synchronised once the session has started,
+	// in order that a user who cannot log in, e.g. a deactivated user, is
+	// left untouched. The response has already been written, so an error
+	// can only be logged.
+	if a.teams != nil {
+		if err := a.teams.sync(r.Context(), info.username, info.groups); err != nil {
+			a.logger.Error("synchronising team memberships", "username", info.username, "err", err)
+		}
+	}
+}
+
+// getUserInfo extracts the username, and optionally groups, from an assertion.
+func (a *SAMLClient) getUserInfo(assertion *saml.Assertion) (userInfo, error) {
+	var info userInfo
+	if a.usernameAttribute == "" {
+		if assertion.Subject != nil && assertion.Subject.NameID != nil {
+			info.username = assertion.Subject.NameID.Value
+		}
+	} else if values := attributeValues(assertion, a.usernameAttribute); len(values) > 0 {
+		info.username = values[0]
+	}
+	if info.username == "" {
+		return userInfo{}, ErrMissingSAMLUsername
+	}
+	if a.groupsAttribute != "" {
+		info.groups = attributeValues(assertion, a.groupsAttribute)
+	}
+	return info, nil
+}
+
+// attributeValues returns the values of the attribute with the given name or
+// friendly name.
+func attributeValues(assertion *saml.Assertion, name string) (values []string) {
+	for _, statement := range assertion.AttributeStatements {
+		for _, attr := range statement.Attributes {
+			if attr.Name != name && attr.FriendlyName != name {
+				continue
+			}
+			for _, v := range attr.Values {
+				values = append(values, v.Value)
+			}
+		}
+	}
+	return values
+}
+
+// serviceProvider constructs a SAML service provider - note this is done at
+// run-time because the service provider URLs use an OTF hostname that may only
+// be determined at run-time.
+func (a *SAMLClient) serviceProvider() *saml.ServiceProvider {
+	metadataURL, _ := url.Parse(a.URL(a.metadataPath()))
+	acsURL, _ := url.Parse(a.URL(a.acsPath()))
+	sp := &saml.ServiceProvider{
+		EntityID:          a.entityID,
+		MetadataURL:       *metadataURL,
+		AcsURL:            *acsURL,
+		IDPMetadata:       a.idpMetadata,
+		Key:               a.key,
+		Certificate:       a.cert,
+		AuthnNameIDFormat: saml.UnspecifiedNameIDFormat,
+	}
+	switch a.key.(type) {
+	case *rsa.PrivateKey:
+		sp.SignatureMethod = dsig.RSASHA256SignatureMethod
+	case *ecdsa.PrivateKey:
+		sp.SignatureMethod = dsig.ECDSASHA256SignatureMethod
+	}
+	return sp
+}
@@ -0,0 +1,212 @@
+package authenticator
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/xml"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/http/html/paths"
+	"github.com/tofutf/tofutf/internal/xslog"
+)
+
+func TestSAMLClient_metadataHandler(t *testing.T) {
+	client := newTestSAMLClient(t, newTestIdentityProvider(t))
+
+	r := httptest.NewRequest("GET", "/saml/test-idp/metadata", nil)
+	w := httptest.NewRecorder()
+	client.metadataHandler(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var got saml.EntityDescriptor
+	require.NoError(t, xml.Unmarshal(w.Body.Bytes(), &got))
+	assert.Equal(t, "https://otf-server.com/saml/test-idp/metadata", got.EntityID)
+	require.Len(t, got.SPSSODescriptors, 1)
+	assert.Equal(t, "https://otf-server.com/saml/test-idp/acs", got.SPSSODescriptors[0].AssertionConsumerServices[0].Location)
+}
+
+func TestSAMLClient_requestHandler(t *testing.T) {
+	client := newTestSAMLClient(t, newTestIdentityProvider(t))
+
+	r := httptest.NewRequest("GET", "/saml/test-idp/login", nil)
+	w := httptest.NewRecorder()
+	client.requestHandler(w, r)
+	require.Equal(t, http.StatusFound, w.Code)
+
+	loc, err := w.Result().Location()
+	require.NoError(t, err)
+	assert.Equal(t, "idp.example.com", loc.Host)
+	assert.NotEmpty(t, loc.Query().Get("SAMLRequest"))
+
+	if assert.Equal(t, 1, len(w.Result().Cookies())) {
+		assert.Equal(t, samlCookieName, w.Result().Cookies()[0].Name)
+		assert.NotEmpty(t, w.Result().Cookies()[0].Value)
+	}
+}
+
+func TestSAMLClient_acsHandler(t *testing.T) {
+	idp := newTestIdentityProvider(t)
+	session := &saml.Session{
+		NameID: "bobby@example.com",
+		CustomAttributes: []saml.Attribute{
+			{Name: "username", Values: []saml.AttributeValue{{Value: "bobby"}}},
+			{Name: "groups", Values: []saml.AttributeValue{{Value: "grp-platform"}, {Value: "grp-devs"}}},
+		},
+	}
+
+	t.Run("username from NameID", func(t *testing.T) {
+		client := newTestSAMLClient(t, idp)
+		w := postSAMLResponse(t, client, "id-123", makeSAMLResponse(t, idp, client, "id-123", session))
+		assert.Equal(t, "bobby@example.com", w.Header().Get("username"))
+		assertRequestIDCookieExpired(t, w)
+	})
+
+	t.Run("username from attribute", func(t *testing.T) {
+		client := newTestSAMLClient(t, idp)
+		client.usernameAttribute = "username"
+		w := postSAMLResponse(t, client, "id-123", makeSAMLResponse(t, idp, client, "id-123", session))
+		assert.Equal(t, "bobby", w.Header().Get("username"))
+	})
+
+	t.Run("groups from attribute", func(t *testing.T) {
+		client := newTestSAMLClient(t, idp)
+		client.groupsAttribute = "groups"
+		response := makeSAMLResponse(t, idp, client, "id-123", session)
+
+		r := newSAMLResponseRequest("id-123", response)
+		require.NoError(t, r.ParseForm())
+		assertion, err := client.serviceProvider().ParseResponse(r, []string{"id-123"})
+		require.NoError(t, err)
+		info, err := client.getUserInfo(assertion)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"grp-platform", "grp-devs"}, info.groups)
+	})
+
+	t.Run("mismatched request ID", func(t *testing.T) {
+		client := newTestSAMLClient(t, idp)
+		w := postSAMLResponse(t, client, "id-456", makeSAMLResponse(t, idp, client, "id-123", session))
+		assertRedirectedToLogin(t, w)
+		assertRequestIDCookieExpired(t, w)
+	})
+
+	t.Run("signed by unknown identity provider", func(t *testing.T) {
+		client := newTestSAMLClient(t, idp)
+		imposter := newTestIdentityProvider(t)
+		w := postSAMLResponse(t, client, "id-123", makeSAMLResponse(t, imposter, client, "id-123", session))
+		assertRedirectedToLogin(t, w)
+	})
+}
+
+// newTestIdentityProvider constructs a SAML identity provider with a locally
+// generated certificate.
+func newTestIdentityProvider(t *testing.T) *saml.IdentityProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &saml.IdentityProvider{
+		Key:             key,
+		Certificate:     cert,
+		MetadataURL:     url.URL{Scheme: "https", Host: "idp.example.com", Path: "/metadata"},
+		SSOURL:          url.URL{Scheme: "https", Host: "idp.example.com", Path: "/sso"},
+		SignatureMethod: dsig.RSASHA256SignatureMethod,
+	}
+}
+
+func newTestSAMLClient(t *testing.T, idp *saml.IdentityProvider) *SAMLClient {
+	t.Helper()
+
+	return &SAMLClient{
+		HostnameService: internal.NewHostnameService("otf-server.com"),
+		logger:          slog.New(&xslog.NoopHandler{}),
+		name:            "test-idp",
+		idpMetadata:     idp.Metadata(),
+		sessions:        &fakeTokensService{},
+	}
+}
+
+// makeSAMLResponse makes a signed SAML response from the identity provider,
+// in response to the authentication request with the given ID, and returns it
+// base64-encoded.
+func makeSAMLResponse(t *testing.T, idp *saml.IdentityProvider, client *SAMLClient, requestID string, session *saml.Session) string {
+	t.Helper()
+
+	metadata := client.serviceProvider().Metadata()
+	req := &saml.IdpAuthnRequest{
+		IDP:                     idp,
+		HTTPRequest:             httptest.NewRequest("GET", "/sso", nil),
+		Request:                 saml.AuthnRequest{ID: requestID, IssueInstant: time.Now()},
+		ServiceProviderMetadata: metadata,
+		SPSSODescriptor:         &metadata.SPSSODescriptors[0],
+		ACSEndpoint:             &metadata.SPSSODescriptors[0].AssertionConsumerServices[0],
+		Now:                     time.Now(),
+	}
+	require.NoError(t, saml.DefaultAssertionMaker{}.MakeAssertion(req, session))
+	form, err := req.PostBinding()
+	require.NoError(t, err)
+	return form.SAMLResponse
+}
+
+func newSAMLResponseRequest(requestID, response string) *http.Request {
+	form := url.Values{"SAMLResponse": {response}}
+	r := httptest.NewRequest("POST", "https://otf-server.com/saml/test-idp/acs", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.AddCookie(&http.Cookie{Name: samlCookieName, Value: requestID})
+	return r
+}
+
+func postSAMLResponse(t *testing.T, client *SAMLClient, requestID, response string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	client.acsHandler(w, newSAMLResponseRequest(requestID, response))
+	return w
+}
+
+func assertRedirectedToLogin(t *testing.T, w *httptest.ResponseRecorder) {
+	t.Helper()
+
+	assert.Empty(t, w.Header().Get("username"))
+	if assert.Equal(t, http.StatusFound, w.Code) {
+		loc, err := w.Result().Location()
+		require.NoError(t, err)
+		assert.Equal(t, paths.Login(), loc.Path)
+	}
+}
+
+func assertRequestIDCookieExpired(t *testing.T, w *httptest.ResponseRecorder) {
+	t.Helper()
+
+	for _, cookie := range w.Result().Cookies() {
+		if cookie.Name == samlCookieName {
+			assert.Equal(t, -1, cookie.MaxAge)
+			return
+		}
+	}
+	t.Errorf("expected %s cookie to be expired", samlCookieName)
+}
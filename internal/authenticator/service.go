@@ -8,7 +8,9 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/http/html"
+	"github.com/tofutf/tofutf/internal/team"
 	"github.com/tofutf/tofutf/internal/tokens"
+	"github.com/tofutf/tofutf/internal/user"
 )
 
 type (
@@ -19,21 +21,36 @@ type (
 
 		Logger               *slog.Logger
 		TokensService        *tokens.Service
+		UserService          *user.Service
+		TeamService          *team.Service
 		OpaqueHandlerConfigs []OpaqueHandlerConfig
 		IDTokenHandlerConfig OIDCConfig
+		SAMLConfig           SAMLConfig
 		SkipTLSVerification  bool
 	}
 
 	service struct {
 		html.Renderer
 
-		clients []*OAuthClient
+		clients []loginClient
+	}
+
+	// loginClient logs users onto the system via a third party identity
+	// provider.
+	loginClient interface {
+		// String provides a human-readable identifier for the client.
+		String() string
+		// RequestPath is the path that initiates the login.
+		RequestPath() string
+
+		addHandlers(r *mux.Router)
 	}
 )
 
 // NewAuthenticatorService constructs a service for logging users onto
 // the system. Supports multiple clients: zero or more clients that support an
-// opaque token, and one client that supports IDToken/OIDC.
+// opaque token, one client that supports IDToken/OIDC, and one client that
+// supports SAML.
 func NewAuthenticatorService(ctx context.Context, opts Options) (*service, error) {
 	svc := service{Renderer: opts.Renderer}
 	// Construct clients with opaque token handlers
@@ -57,6 +74,16 @@ func NewAuthenticatorService(ctx context.Context, opts Options) (*service, error
 		svc.clients = append(svc.clients, client)
 		opts.Logger.Info("activated OAuth client", "name", cfg.Name, "hostname", cfg.Hostname)
 	}
+	// Construct SAML client
+	if opts.SAMLConfig.IDPMetadata != "" {
+		opts.SAMLConfig.SkipTLSVerification = opts.SkipTLSVerification
+		client, err := newSAMLClient(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		svc.clients = append(svc.clients, client)
+		opts.Logger.Info("activated SAML client", "name", opts.SAMLConfig.Name)
+	}
 	// Construct client with OIDC IDToken handler
 	if opts.IDTokenHandlerConfig.ClientID == "" && opts.IDTokenHandlerConfig.ClientSecret == "" {
 		// skip creating OIDC authenticator when creds are unspecified
@@ -83,6 +110,16 @@ func NewAuthenticatorService(ctx context.Context, opts Options) (*service, error
 	if err != nil {
 		return nil, err
 	}
+	// Optionally synchronise team memberships with groups upon login
+	if len(opts.IDTokenHandlerConfig.TeamMappings) > 0 {
+		if opts.IDTokenHandlerConfig.GroupsClaim == "" {
+			return nil, ErrMissingOIDCGroupsClaim
+		}
+		client.teams, err = newTeamSyncer(opts, opts.IDTokenHandlerConfig.TeamMappings)
+		if err != nil {
+			return nil, err
+		}
+	}
 	svc.clients = append(svc.clients, client)
 	opts.Logger.Info("activated OIDC client", "name", opts.IDTokenHandlerConfig.Name)
 	return &svc, nil
@@ -98,7 +135,7 @@ func (a *service) AddHandlers(r *mux.Router) {
 func (a *service) loginHandler(w http.ResponseWriter, r *http.Request) {
 	a.Render("login.tmpl", w, struct {
 		html.SitePage
-		Clients []*OAuthClient
+		Clients []loginClient
 	}{
 		SitePage: html.NewSitePage(r, "login"),
 		Clients:  a.clients,
@@ -48,9 +48,10 @@ func TestLoginHandler(t *testing.T) {
 	require.NoError(t, err)
 	svc := &service{Renderer: renderer}
 
-	svc.clients = []*OAuthClient{
-		{OAuthConfig: OAuthConfig{Name: "cloud1"}},
-		{OAuthConfig: OAuthConfig{Name: "cloud2"}},
+	svc.clients = []loginClient{
+		&OAuthClient{OAuthConfig: OAuthConfig{Name: "cloud1"}},
+		&OAuthClient{OAuthConfig: OAuthConfig{Name: "cloud2"}},
+		&SAMLClient{name: "corporate-sso"},
 	}
 
 	r := httptest.NewRequest("GET", "/?", nil)
@@ -60,5 +61,6 @@ func TestLoginHandler(t *testing.T) {
 	if assert.Equal(t, 200, w.Code, "output: %s", body) {
 		assert.Contains(t, body, "Login with Cloud1")
 		assert.Contains(t, body, "Login with Cloud2")
+		assert.Contains(t, body, "/saml/corporate-sso/login")
 	}
 }
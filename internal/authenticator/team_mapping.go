@@ -0,0 +1,144 @@
+package authenticator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/team"
+	"github.com/tofutf/tofutf/internal/user"
+)
+
+type (
+	// TeamMapping maps a group from an identity provider to a team in an
+	// organization.
+	TeamMapping struct {
+		Group        string
+		Organization string
+		Team         string
+	}
+
+	// teamSyncer synchronises a user's team memberships with the groups to
+	// which the user belongs, according to team mappings.
+	teamSyncer struct {
+		logger   *slog.Logger
+		mappings []TeamMapping
+		users    teamSyncerUserClient
+		teams    teamSyncerTeamClient
+	}
+
+	teamSyncerUserClient interface {
+		GetUser(ctx context.Context, spec user.UserSpec) (*user.User, error)
+		AddTeamMembership(ctx context.Context, teamID string, usernames []string) error
+		RemoveTeamMembership(ctx context.Context, teamID string, usernames []string) error
+	}
+
+	teamSyncerTeamClient interface {
+		Get(ctx context.Context, organization, name string) (*team.Team, error)
+	}
+)
+
+func newTeamSyncer(opts Options, rawMappings []string) (*teamSyncer, error) {
+	mappings := make([]TeamMapping, len(rawMappings))
+	for i, raw := range rawMappings {
+		m, err := ParseTeamMapping(raw)
+		if err != nil {
+			return nil, err
+		}
+		mappings[i] = m
+	}
+	return &teamSyncer{
+		logger:   opts.Logger,
+		mappings: mappings,
+		users:    opts.UserService,
+		teams:    opts.TeamService,
+	}, nil
+}
+
+// ParseTeamMapping parses a team mapping in the format
+// <group>=<organization>/<team>, e.g. grp-platform=acme/owners
+func ParseTeamMapping(s string) (TeamMapping, error) {
+	group, orgTeam, ok := strings.Cut(s, "=")
+	if !ok || group == "" {
+		return TeamMapping{}, fmt.Errorf("invalid team mapping: %s: must be in the format <group>=<organization>/<team>", s)
+	}
+	organization, name, ok := strings.Cut(orgTeam, "/")
+	if !ok || organization == "" || name == "" {
+		return TeamMapping{}, fmt.Errorf("invalid team mapping: %s: must be in the format <group>=<organization>/<team>", s)
+	}
+	return TeamMapping{Group: group, Organization: organization, Team: name}, nil
+}
+
+func (m TeamMapping) String() string {
+	return fmt.Sprintf("%s=%s/%s", m.Group, m.Organization, m.Team)
+}
+
+// sync adds the user to each mapped team for which the user belongs to the
+// mapped group, and removes the user from each mapped team for which the user
+// does not belong to a mapped group. Memberships of teams that are not mapped
+// are left untouched.
+//
+// The user must exist and be active, otherwise nothing is synchronised: sync
+// should be invoked only once a session has been started for the user, which
+// creates the user if necessary and refuses deactivated users. Adding a
+// non-existent user to a team would otherwise create the user implicitly, via
+// AddTeamMembership.
+func (s *teamSyncer) sync(ctx context.Context, username string, groups []string) error {
+	ctx = internal.AddSubjectToContext(ctx, &internal.Superuser{Username: "team-syncer"})
+
+	u, err := s.users.GetUser(ctx, user.UserSpec{Username: &username})
+	if errors.Is(err, internal.ErrResourceNotFound) {
+		s.logger.Warn("skipping team sync: user not found", "username", username)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !u.Active {
+		s.logger.Warn("skipping team sync: user deactivated", "username", username)
+		return nil
+	}
+
+	// determine which mapped teams the user should belong to; a team may be
+	// mapped from several groups.
+	var (
+		teams   []*team.Team
+		desired = make(map[string]bool)
+	)
+	for _, m := range s.mappings {
+		t, err := s.teams.Get(ctx, m.Organization, m.Team)
+		if errors.Is(err, internal.ErrResourceNotFound) {
+			s.logger.Warn("skipping team mapping: team not found", "mapping", m)
+			continue
+		} else if err != nil {
+			return err
+		}
+		if _, ok := desired[t.ID]; !ok {
+			teams = append(teams, t)
+		}
+		desired[t.ID] = desired[t.ID] || slices.Contains(groups, m.Group)
+	}
+
+	for _, t := range teams {
+		want := desired[t.ID]
+		isMember := slices.ContainsFunc(u.Teams, func(c *team.Team) bool { return c.ID == t.ID })
+		switch {
+		case want && !isMember:
+			if err := s.users.AddTeamMembership(ctx, t.ID, []string{username}); err != nil {
+				return fmt.Errorf("adding user to team %s/%s: %w", t.Organization, t.Name, err)
+			}
+		case !want && isMember:
+			err := s.users.RemoveTeamMembership(ctx, t.ID, []string{username})
+			if errors.Is(err, user.ErrCannotDeleteOnlyOwner) {
+				s.logger.Warn("not removing the last owner from the owners team", "username", username, "organization", t.Organization)
+				continue
+			} else if err != nil {
+				return fmt.Errorf("removing user from team %s/%s: %w", t.Organization, t.Name, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package authenticator
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/team"
+	"github.com/tofutf/tofutf/internal/user"
+	"github.com/tofutf/tofutf/internal/xslog"
+)
+
+func TestParseTeamMapping(t *testing.T) {
+	got, err := ParseTeamMapping("grp-platform=acme/owners")
+	require.NoError(t, err)
+	assert.Equal(t, TeamMapping{Group: "grp-platform", Organization: "acme", Team: "owners"}, got)
+
+	for _, invalid := range []string{"grp-platform", "grp-platform=acme", "=acme/owners", "grp-platform=/owners", "grp-platform=acme/"} {
+		_, err := ParseTeamMapping(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestTeamSyncer(t *testing.T) {
+	owners := &team.Team{ID: "team-owners", Name: "owners", Organization: "acme"}
+	devs := &team.Team{ID: "team-devs", Name: "devs", Organization: "acme"}
+	unmapped := &team.Team{ID: "team-unmapped", Name: "unmapped", Organization: "acme"}
+	mappings := []TeamMapping{
+		{Group: "grp-platform", Organization: "acme", Team: "owners"},
+		{Group: "grp-devs", Organization: "acme", Team: "devs"},
+		{Group: "grp-engineers", Organization: "acme", Team: "devs"},
+		{Group: "grp-missing", Organization: "acme", Team: "missing"},
+	}
+
+	tests := []struct {
+		name    string
+		user    *user.User
+		current []*team.Team
+		groups  []string
+		want    []string
+	}{
+		{
+			name:   "new user",
+			user:   user.NewUser("bobby"),
+			groups: []string{"grp-platform"},
+			want:   []string{"team-owners"},
+		},
+		{
+			name:   "unknown user is not created",
+			groups: []string{"grp-platform"},
+		},
+		{
+			name:    "deactivated user left untouched",
+			user:    user.NewUser("bobby", user.WithActive(false)),
+			current: []*team.Team{owners},
+			groups:  []string{"grp-devs"},
+			want:    []string{"team-owners"},
+		},
+		{
+			name:    "team mapped from several groups",
+			user:    user.NewUser("bobby"),
+			current: []*team.Team{devs},
+			groups:  []string{"grp-engineers"},
+			want:    []string{"team-devs"},
+		},
+		{
+			name:    "group revoked",
+			user:    user.NewUser("bobby"),
+			current: []*team.Team{owners, devs},
+			groups:  []string{"grp-devs"},
+			want:    []string{"team-devs"},
+		},
+		{
+			name:    "unmapped team left untouched",
+			user:    user.NewUser("bobby"),
+			current: []*team.Team{unmapped},
+			groups:  nil,
+			want:    []string{"team-unmapped"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &fakeTeamSyncerUserClient{user: tt.user}
+			if tt.user != nil {
+				tt.user.Teams = tt.current
+			}
+			syncer := &teamSyncer{
+				logger:   slog.New(&xslog.NoopHandler{}),
+				mappings: mappings,
+				users:    users,
+				teams:    &fakeTeamSyncerTeamClient{teams: []*team.Team{owners, devs, unmapped}},
+			}
+			err := syncer.sync(context.Background(), "bobby", tt.groups)
+			require.NoError(t, err)
+
+			if tt.user == nil {
+				assert.Nil(t, users.user)
+				return
+			}
+			var got []string
+			for _, t := range users.user.Teams {
+				got = append(got, t.ID)
+			}
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
+type (
+	fakeTeamSyncerUserClient struct {
+		user *user.User
+	}
+
+	fakeTeamSyncerTeamClient struct {
+		teams []*team.Team
+	}
+)
+
+func (f *fakeTeamSyncerUserClient) GetUser(ctx context.Context, spec user.UserSpec) (*user.User, error) {
+	if f.user == nil {
+		return nil, internal.ErrResourceNotFound
+	}
+	return f.user, nil
+}
+
+func (f *fakeTeamSyncerUserClient) AddTeamMembership(ctx context.Context, teamID string, usernames []string) error {
+	if f.user == nil {
+		f.user = user.NewUser(usernames[0])
+	}
+	f.user.Teams = append(f.user.Teams, &team.Team{ID: teamID})
+	return nil
+}
+
+func (f *fakeTeamSyncerUserClient) RemoveTeamMembership(ctx context.Context, teamID string, usernames []string) error {
+	f.user.Teams = slices.DeleteFunc(f.user.Teams, func(t *team.Team) bool { return t.ID == teamID })
+	return nil
+}
+
+func (f *fakeTeamSyncerTeamClient) Get(ctx context.Context, organization, name string) (*team.Team, error) {
+	for _, t := range f.teams {
+		if t.Organization == organization && t.Name == name {
+			return t, nil
+		}
+	}
+	return nil, internal.ErrResourceNotFound
+}
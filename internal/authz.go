@@ -39,6 +39,10 @@ type WorkspacePolicy struct {
 	WorkspaceID  string
 	Permissions  []WorkspacePermission
 
+	// ProjectPermissions are permissions inherited from the project to which
+	// the workspace belongs.
+	ProjectPermissions []WorkspacePermission
+
 	// Whether workspace permits its state to be consumed by all workspaces in
 	// the organization.
 	GlobalRemoteState bool
@@ -108,3 +112,47 @@ func (s *Nobody) String() string                                     { return s.
 func (s *Nobody) ID() string                                         { return s.Username }
 func (s *Nobody) IsSiteAdmin() bool                                  { return false }
 func (s *Nobody) IsOwner(string) bool                                { return false }
+
+// ReadOnlySubject restricts a subject to actions that do not alter resources,
+// e.g. the subject of an API token with a read-only scope.
+type ReadOnlySubject struct {
+	Subject
+}
+
+func (s *ReadOnlySubject) CanAccessSite(action rbac.Action) bool {
+	return action.IsReadOnly() && s.Subject.CanAccessSite(action)
+}
+
+func (s *ReadOnlySubject) CanAccessTeam(action rbac.Action, id string) bool {
+	return action.IsReadOnly() && s.Subject.CanAccessTeam(action, id)
+}
+
+func (s *ReadOnlySubject) CanAccessOrganization(action rbac.Action, name string) bool {
+	return action.IsReadOnly() && s.Subject.CanAccessOrganization(action, name)
+}
+
+func (s *ReadOnlySubject) CanAccessWorkspace(action rbac.Action, policy WorkspacePolicy) bool {
+	return action.IsReadOnly() && s.Subject.CanAccessWorkspace(action, policy)
+}
+
+// Unwrap returns the restricted subject.
+func (s *ReadOnlySubject) Unwrap() Subject { return s.Subject }
+
+// UnwrapSubject returns the underlying subject of a subject that wraps another
+// subject, such as a ReadOnlySubject, otherwise it returns the subject as-is.
+func UnwrapSubject(subj Subject) Subject {
+	for {
+		wrapper, ok := subj.(interface{ Unwrap() Subject })
+		if !ok {
+			return subj
+		}
+		subj = wrapper.Unwrap()
+	}
+}
+
+// IsReadOnly determines whether the subject is restricted to actions that do
+// not alter resources.
+func IsReadOnly(subj Subject) bool {
+	_, ok := subj.(*ReadOnlySubject)
+	return ok
+}
@@ -0,0 +1,23 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tofutf/tofutf/internal/rbac"
+)
+
+func TestReadOnlySubject(t *testing.T) {
+	subj := &ReadOnlySubject{Subject: &Superuser{Username: "bob"}}
+
+	assert.True(t, subj.CanAccessSite(rbac.ListUsersAction))
+	assert.False(t, subj.CanAccessSite(rbac.CreateUserAction))
+	assert.True(t, subj.CanAccessOrganization(rbac.GetOrganizationAction, "acme"))
+	assert.False(t, subj.CanAccessOrganization(rbac.DeleteOrganizationAction, "acme"))
+	assert.True(t, subj.CanAccessWorkspace(rbac.GetWorkspaceAction, WorkspacePolicy{}))
+	assert.False(t, subj.CanAccessWorkspace(rbac.ApplyRunAction, WorkspacePolicy{}))
+
+	assert.True(t, IsReadOnly(subj))
+	assert.False(t, IsReadOnly(subj.Subject))
+	assert.Equal(t, subj.Subject, UnwrapSubject(subj))
+}
@@ -4,20 +4,31 @@ package bitbucketserver
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"mime"
+	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 
 	bitbucketapi "github.com/gfleury/go-bitbucket-v1"
 	"github.com/mitchellh/mapstructure"
+	"github.com/tofutf/tofutf/internal"
+	otfhttp "github.com/tofutf/tofutf/internal/http"
 	"github.com/tofutf/tofutf/internal/vcs"
 	"golang.org/x/exp/slog"
 )
 
 type TokenClient struct {
 	client *bitbucketapi.APIClient
+
+	// basepath, token and httpClient are retained for those API calls not
+	// supported by the client library.
+	basepath   string
+	token      string
+	httpClient *http.Client
 }
 
 var _ vcs.Client = &TokenClient{}
@@ -30,12 +41,20 @@ func NewTokenClient(opts vcs.NewTokenClientOptions) (*TokenClient, error) {
 
 	ctx := context.WithValue(context.Background(), bitbucketapi.ContextAccessToken, opts.Token)
 
-	client := bitbucketapi.NewAPIClient(
-		ctx,
-		bitbucketapi.NewConfiguration(basepath),
-	)
+	cfg := bitbucketapi.NewConfiguration(basepath)
+	if opts.SkipTLSVerification {
+		cfg.HTTPClient = &http.Client{Transport: otfhttp.InsecureTransport}
+	}
+	// constructing the client populates the configuration with a default
+	// http client if one has not been set.
+	client := bitbucketapi.NewAPIClient(ctx, cfg)
 
-	return &TokenClient{client: client}, nil
+	return &TokenClient{
+		client:     client,
+		basepath:   basepath,
+		token:      opts.Token,
+		httpClient: cfg.HTTPClient,
+	}, nil
 }
 
 func (g *TokenClient) GetCurrentUser(ctx context.Context) (string, error) {
@@ -389,6 +408,88 @@ func (g *TokenClient) SetStatus(ctx context.Context, opts vcs.SetStatusOptions)
 	return nil
 }
 
+func (g *TokenClient) CreatePullRequestComment(ctx context.Context, opts vcs.CreatePullRequestCommentOptions) (string, error) {
+	owner, name, found := strings.Cut(opts.Repo, "/")
+	if !found {
+		return "", fmt.Errorf("malformed identifier: %s", opts.Repo)
+	}
+
+	response, err := g.client.DefaultApi.CreatePullRequestComment(owner, name, opts.PullRequestNumber, bitbucketapi.Comment{
+		Text: opts.Body,
+	}, []string{"application/json"})
+	if err != nil {
+		return "", fmt.Errorf("failed to create pull request comment: %w", err)
+	}
+
+	id, ok := response.Values["id"].(float64)
+	if !ok {
+		return "", fmt.Errorf("pull request comment response missing id")
+	}
+	return strconv.Itoa(int(id)), nil
+}
+
+func (g *TokenClient) UpdatePullRequestComment(ctx context.Context, opts vcs.UpdatePullRequestCommentOptions) error {
+	owner, name, found := strings.Cut(opts.Repo, "/")
+	if !found {
+		return fmt.Errorf("malformed identifier: %s", opts.Repo)
+	}
+
+	id, err := strconv.Atoi(opts.ID)
+	if err != nil {
+		return err
+	}
+
+	// bitbucket only permits updating the current version of a comment, so
+	// retrieve the comment first to determine its version.
+	response, err := g.client.DefaultApi.GetComment_6(owner, name, opts.PullRequestNumber, id)
+	if err != nil {
+		if response != nil && response.Response != nil && response.StatusCode == http.StatusNotFound {
+			return internal.ErrResourceNotFound
+		}
+		return fmt.Errorf("failed to retrieve pull request comment: %w", err)
+	}
+	version, ok := response.Values["version"].(float64)
+	if !ok {
+		return fmt.Errorf("pull request comment response missing version")
+	}
+
+	// the client library does not support sending a body when updating a
+	// comment, so the request is constructed here.
+	body, err := json.Marshal(struct {
+		Text    string `json:"text"`
+		Version int    `json:"version"`
+	}{
+		Text:    opts.Body,
+		Version: int(version),
+	})
+	if err != nil {
+		return err
+	}
+	u, err := url.JoinPath(g.basepath, "api/1.0/projects", owner, "repos", name, "pull-requests", strconv.Itoa(opts.PullRequestNumber), "comments", opts.ID)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+g.token)
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to update pull request comment: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return internal.ErrResourceNotFound
+	}
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to update pull request comment: unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
 func (g *TokenClient) ListPullRequestFiles(ctx context.Context, repo string, pull int) ([]string, error) {
 	slog.Info("calling ListPullRequestFiles")
 	return nil, nil
@@ -0,0 +1,99 @@
+// Package blob provides storage for large binary objects, i.e. state files,
+// configuration tarballs, plan files, module tarballs, provider binaries and
+// logs.
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/tofutf/tofutf/internal"
+)
+
+const (
+	PostgresBackend Backend = "postgres"
+	LocalBackend    Backend = "local"
+	S3Backend       Backend = "s3"
+)
+
+var ErrInvalidBackend = errors.New("invalid blob store backend: must be one of postgres, local, or s3")
+
+type (
+	// Store persists blobs, each identified by a unique key.
+	Store interface {
+		// Get retrieves the blob with the given key. If the blob does not
+		// exist then internal.ErrResourceNotFound is returned.
+		Get(ctx context.Context, key string) ([]byte, error)
+		// Put persists a blob with the given key, overwriting any existing
+		// blob with the same key.
+		Put(ctx context.Context, key string, data []byte) error
+		// Delete deletes the blob with the given key. No error is returned if
+		// the blob does not exist.
+		Delete(ctx context.Context, key string) error
+	}
+
+	// Backend is the type of store in which blobs are persisted.
+	Backend string
+
+	// Config configures the blob store.
+	Config struct {
+		Backend  Backend
+		LocalDir string // directory in which to persist blobs for the local backend
+		S3       S3Config
+	}
+)
+
+// NewStore constructs a blob store according to the config. A nil store is
+// returned for the postgres backend, in which case blobs are to be persisted
+// in the database alongside their metadata.
+func NewStore(cfg Config) (Store, error) {
+	switch cfg.Backend {
+	case PostgresBackend, "":
+		return nil, nil
+	case LocalBackend:
+		store, err := NewLocalStore(cfg.LocalDir)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	case S3Backend:
+		store, err := NewS3Store(cfg.S3)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	default:
+		return nil, ErrInvalidBackend
+	}
+}
+
+// Offload persists data to the store, returning nil to indicate the data need
+// not be persisted in the database. If store is nil then the data is returned
+// unchanged to be persisted in the database.
+func Offload(ctx context.Context, store Store, key string, data []byte) ([]byte, error) {
+	if store == nil || data == nil {
+		return data, nil
+	}
+	if err := store.Put(ctx, key, data); err != nil {
+		return nil, fmt.Errorf("persisting blob: %w", err)
+	}
+	return nil, nil
+}
+
+// Load returns inline if it is non-nil, i.e. the data was persisted in the
+// database, otherwise it retrieves the data from the store. If store is nil
+// then inline is returned regardless. If the data is not found in the store
+// then internal.ErrResourceNotFound is returned.
+func Load(ctx context.Context, store Store, key string, inline []byte) ([]byte, error) {
+	if inline != nil || store == nil {
+		return inline, nil
+	}
+	data, err := store.Get(ctx, key)
+	if errors.Is(err, internal.ErrResourceNotFound) {
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("retrieving blob: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,73 @@
+package blob
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+)
+
+func TestOffloadAndLoad(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("without store", func(t *testing.T) {
+		inline, err := Offload(ctx, nil, StateKey("sv-123"), []byte("state"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("state"), inline)
+
+		got, err := Load(ctx, nil, StateKey("sv-123"), inline)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("state"), got)
+	})
+
+	t.Run("with store", func(t *testing.T) {
+		store, err := NewLocalStore(t.TempDir())
+		require.NoError(t, err)
+
+		inline, err := Offload(ctx, store, StateKey("sv-123"), []byte("state"))
+		require.NoError(t, err)
+		assert.Nil(t, inline)
+
+		got, err := Load(ctx, store, StateKey("sv-123"), inline)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("state"), got)
+	})
+
+	t.Run("load blob persisted in database before store was configured", func(t *testing.T) {
+		store, err := NewLocalStore(t.TempDir())
+		require.NoError(t, err)
+
+		got, err := Load(ctx, store, StateKey("sv-123"), []byte("state"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("state"), got)
+	})
+
+	t.Run("load missing blob", func(t *testing.T) {
+		store, err := NewLocalStore(t.TempDir())
+		require.NoError(t, err)
+
+		_, err = Load(ctx, store, StateKey("sv-123"), nil)
+		assert.ErrorIs(t, err, internal.ErrResourceNotFound)
+	})
+}
+
+func TestNewStore(t *testing.T) {
+	t.Run("postgres", func(t *testing.T) {
+		store, err := NewStore(Config{Backend: PostgresBackend})
+		require.NoError(t, err)
+		assert.Nil(t, store)
+	})
+
+	t.Run("local", func(t *testing.T) {
+		store, err := NewStore(Config{Backend: LocalBackend, LocalDir: t.TempDir()})
+		require.NoError(t, err)
+		assert.IsType(t, &LocalStore{}, store)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := NewStore(Config{Backend: "gcs"})
+		assert.ErrorIs(t, err, ErrInvalidBackend)
+	})
+}
@@ -0,0 +1,43 @@
+package blob
+
+import (
+	"path"
+	"strconv"
+)
+
+// StateKey returns the key for the state file of a state version.
+func StateKey(stateVersionID string) string {
+	return path.Join("state", stateVersionID)
+}
+
+// ConfigKey returns the key for the tarball of a configuration version.
+func ConfigKey(configVersionID string) string {
+	return path.Join("configs", configVersionID)
+}
+
+// PlanKey returns the key for the plan file of a run in the given format.
+func PlanKey(runID, format string) string {
+	return path.Join("plans", runID, format)
+}
+
+// ModuleKey returns the key for the tarball of a module version.
+func ModuleKey(moduleVersionID string) string {
+	return path.Join("modules", moduleVersionID)
+}
+
+// ProviderBinaryKey returns the key for the zip archive of a platform of a
+// provider version in the private registry.
+func ProviderBinaryKey(platformID string) string {
+	return path.Join("providers", platformID)
+}
+
+// ProviderMirrorArchiveKey returns the key for the zip archive of a platform
+// of a provider version cached by the provider network mirror.
+func ProviderMirrorArchiveKey(mirrorVersionID, os, arch string) string {
+	return path.Join("mirror", mirrorVersionID, os+"_"+arch)
+}
+
+// LogChunkKey returns the key for a chunk of logs for a run phase.
+func LogChunkKey(runID, phase string, chunkID int) string {
+	return path.Join("logs", runID, phase, strconv.Itoa(chunkID))
+}
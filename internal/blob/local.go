@@ -0,0 +1,77 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/natefinch/atomic"
+	"github.com/tofutf/tofutf/internal"
+)
+
+// LocalStore persists blobs as files on the local filesystem.
+type LocalStore struct {
+	dir string
+}
+
+// NewLocalStore constructs a store that persists blobs in files beneath the
+// given directory, creating the directory if it does not exist.
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if dir == "" {
+		return nil, errors.New("local blob store requires a directory")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating blob store directory: %w", err)
+	}
+	return &LocalStore{dir: dir}, nil
+}
+
+func (s *LocalStore) Get(ctx context.Context, key string) ([]byte, error) {
+	fpath, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(fpath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, internal.ErrResourceNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *LocalStore) Put(ctx context.Context, key string, data []byte) error {
+	fpath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(fpath), 0o755); err != nil {
+		return err
+	}
+	return atomic.WriteFile(fpath, bytes.NewReader(data))
+}
+
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	fpath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(fpath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path returns the path to the file for the given key, ensuring it does not
+// reside outside of the store's directory.
+func (s *LocalStore) path(key string) (string, error) {
+	fpath := filepath.Join(s.dir, filepath.FromSlash(key))
+	if !strings.HasPrefix(fpath, filepath.Clean(s.dir)+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid blob key: %s", key)
+	}
+	return fpath, nil
+}
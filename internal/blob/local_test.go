@@ -0,0 +1,68 @@
+package blob
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	testStore(t, ctx, store)
+
+	t.Run("reject key outside of directory", func(t *testing.T) {
+		err := store.Put(ctx, "../escape", []byte("hello"))
+		assert.Error(t, err)
+	})
+}
+
+// testStore tests the behaviour common to all store implementations.
+func testStore(t *testing.T, ctx context.Context, store Store) {
+	t.Run("put and get", func(t *testing.T) {
+		err := store.Put(ctx, StateKey("sv-123"), []byte("state"))
+		require.NoError(t, err)
+
+		got, err := store.Get(ctx, StateKey("sv-123"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("state"), got)
+	})
+
+	t.Run("overwrite", func(t *testing.T) {
+		err := store.Put(ctx, PlanKey("run-123", "json"), []byte("old"))
+		require.NoError(t, err)
+		err = store.Put(ctx, PlanKey("run-123", "json"), []byte("new"))
+		require.NoError(t, err)
+
+		got, err := store.Get(ctx, PlanKey("run-123", "json"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("new"), got)
+	})
+
+	t.Run("get missing blob", func(t *testing.T) {
+		_, err := store.Get(ctx, ConfigKey("cv-does-not-exist"))
+		assert.ErrorIs(t, err, internal.ErrResourceNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		err := store.Put(ctx, ModuleKey("modver-123"), []byte("tarball"))
+		require.NoError(t, err)
+
+		err = store.Delete(ctx, ModuleKey("modver-123"))
+		require.NoError(t, err)
+
+		_, err = store.Get(ctx, ModuleKey("modver-123"))
+		assert.ErrorIs(t, err, internal.ErrResourceNotFound)
+	})
+
+	t.Run("delete missing blob", func(t *testing.T) {
+		err := store.Delete(ctx, LogChunkKey("run-123", "plan", 99))
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,182 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/tofutf/tofutf/internal/sql"
+	"github.com/tofutf/tofutf/internal/sql/pggen"
+)
+
+// migrateBatchSize is the max number of blobs retrieved from the database at
+// a time.
+const migrateBatchSize = 100
+
+// Migrate moves blobs persisted in the database to the store. Each blob is
+// cleared from the database only once it has been persisted to the store, so
+// it is safe to re-run a migration that was interrupted.
+func Migrate(ctx context.Context, logger *slog.Logger, pool *sql.Pool, store Store) error {
+	if store == nil {
+		return errors.New("cannot migrate blobs: a blob store is required")
+	}
+	m := &migrator{store: store}
+	for _, step := range []struct {
+		kind string
+		fn   func(context.Context, pggen.Querier) (int, error)
+	}{
+		{"state files", m.stateFiles},
+		{"configuration tarballs", m.configs},
+		{"plan files", m.plans},
+		{"module tarballs", m.modules},
+		{"provider binaries", m.providers},
+		{"provider mirror archives", m.mirror},
+		{"log chunks", m.logs},
+	} {
+		var total int
+		for {
+			n, err := sql.Tx(ctx, pool, step.fn)
+			if err != nil {
+				return fmt.Errorf("migrating %s: %w", step.kind, err)
+			}
+			if n == 0 {
+				break
+			}
+			total += n
+		}
+		logger.Info("migrated blobs", "kind", step.kind, "count", total)
+	}
+	return nil
+}
+
+// migrator moves batches of blobs from the database to the store, returning
+// the number of blobs moved.
+type migrator struct {
+	store Store
+}
+
+func (m *migrator) stateFiles(ctx context.Context, q pggen.Querier) (int, error) {
+	rows, err := q.FindInlineStateVersions(ctx, sql.Int8(migrateBatchSize))
+	if err != nil {
+		return 0, sql.Error(err)
+	}
+	for _, row := range rows {
+		if err := m.store.Put(ctx, StateKey(row.StateVersionID.String), row.State); err != nil {
+			return 0, err
+		}
+		if _, err := q.ClearStateVersionState(ctx, row.StateVersionID); err != nil {
+			return 0, sql.Error(err)
+		}
+	}
+	return len(rows), nil
+}
+
+func (m *migrator) configs(ctx context.Context, q pggen.Querier) (int, error) {
+	rows, err := q.FindInlineConfigurationVersions(ctx, sql.Int8(migrateBatchSize))
+	if err != nil {
+		return 0, sql.Error(err)
+	}
+	for _, row := range rows {
+		if err := m.store.Put(ctx, ConfigKey(row.ConfigurationVersionID.String), row.Config); err != nil {
+			return 0, err
+		}
+		if _, err := q.ClearConfigurationVersionConfig(ctx, row.ConfigurationVersionID); err != nil {
+			return 0, sql.Error(err)
+		}
+	}
+	return len(rows), nil
+}
+
+func (m *migrator) plans(ctx context.Context, q pggen.Querier) (int, error) {
+	rows, err := q.FindInlinePlanFiles(ctx, sql.Int8(migrateBatchSize))
+	if err != nil {
+		return 0, sql.Error(err)
+	}
+	for _, row := range rows {
+		// keyed by plan format
+		for format, file := range map[string][]byte{"bin": row.PlanBin, "json": row.PlanJSON} {
+			if file == nil {
+				continue
+			}
+			if err := m.store.Put(ctx, PlanKey(row.RunID.String, format), file); err != nil {
+				return 0, err
+			}
+		}
+		if _, err := q.ClearPlanFiles(ctx, row.RunID); err != nil {
+			return 0, sql.Error(err)
+		}
+	}
+	return len(rows), nil
+}
+
+func (m *migrator) modules(ctx context.Context, q pggen.Querier) (int, error) {
+	rows, err := q.FindInlineModuleTarballs(ctx, sql.Int8(migrateBatchSize))
+	if err != nil {
+		return 0, sql.Error(err)
+	}
+	for _, row := range rows {
+		if err := m.store.Put(ctx, ModuleKey(row.ModuleVersionID.String), row.Tarball); err != nil {
+			return 0, err
+		}
+		if _, err := q.ClearModuleTarball(ctx, row.ModuleVersionID); err != nil {
+			return 0, sql.Error(err)
+		}
+	}
+	return len(rows), nil
+}
+
+func (m *migrator) providers(ctx context.Context, q pggen.Querier) (int, error) {
+	rows, err := q.FindInlineRegistryProviderBinaries(ctx, sql.Int8(migrateBatchSize))
+	if err != nil {
+		return 0, sql.Error(err)
+	}
+	for _, row := range rows {
+		if err := m.store.Put(ctx, ProviderBinaryKey(row.RegistryProviderPlatformID.String), row.ProviderBinary); err != nil {
+			return 0, err
+		}
+		if _, err := q.ClearRegistryProviderBinary(ctx, row.RegistryProviderPlatformID); err != nil {
+			return 0, sql.Error(err)
+		}
+	}
+	return len(rows), nil
+}
+
+func (m *migrator) mirror(ctx context.Context, q pggen.Querier) (int, error) {
+	rows, err := q.FindInlineProviderMirrorArchives(ctx, sql.Int8(migrateBatchSize))
+	if err != nil {
+		return 0, sql.Error(err)
+	}
+	for _, row := range rows {
+		key := ProviderMirrorArchiveKey(row.ProviderMirrorVersionID.String, row.Os.String, row.Arch.String)
+		if err := m.store.Put(ctx, key, row.Archive); err != nil {
+			return 0, err
+		}
+		_, err := q.ClearProviderMirrorArchive(ctx, pggen.ClearProviderMirrorArchiveParams{
+			ProviderMirrorVersionID: row.ProviderMirrorVersionID,
+			Os:                      row.Os,
+			Arch:                    row.Arch,
+		})
+		if err != nil {
+			return 0, sql.Error(err)
+		}
+	}
+	return len(rows), nil
+}
+
+func (m *migrator) logs(ctx context.Context, q pggen.Querier) (int, error) {
+	rows, err := q.FindInlineLogChunks(ctx, sql.Int8(migrateBatchSize))
+	if err != nil {
+		return 0, sql.Error(err)
+	}
+	for _, row := range rows {
+		key := LogChunkKey(row.RunID.String, row.Phase.String, int(row.ChunkID.Int32))
+		if err := m.store.Put(ctx, key, row.Chunk); err != nil {
+			return 0, err
+		}
+		if _, err := q.ClearLogChunk(ctx, row.ChunkID); err != nil {
+			return 0, sql.Error(err)
+		}
+	}
+	return len(rows), nil
+}
@@ -0,0 +1,112 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/tofutf/tofutf/internal"
+)
+
+const defaultS3Endpoint = "s3.amazonaws.com"
+
+type (
+	// S3Store persists blobs as objects in a bucket on an S3-compatible
+	// object store.
+	S3Store struct {
+		client *minio.Client
+		bucket string
+	}
+
+	// S3Config configures an S3-compatible object store.
+	S3Config struct {
+		Bucket   string
+		Endpoint string // host[:port] of the object store; defaults to AWS S3
+		Region   string
+		// Static credentials; if unspecified then credentials are sourced
+		// from the environment, the AWS credentials file, or IAM.
+		AccessKeyID     string
+		SecretAccessKey string
+		// Insecure uses HTTP rather than HTTPS.
+		Insecure bool
+		// UsePathStyle addresses buckets using the path rather than the
+		// hostname, which is necessary for some S3-compatible object stores.
+		UsePathStyle bool
+	}
+)
+
+// NewS3Store constructs a store that persists blobs in an S3 bucket.
+func NewS3Store(cfg S3Config) (*S3Store, error) {
+	if cfg.Bucket == "" {
+		return nil, errors.New("s3 blob store requires a bucket")
+	}
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = defaultS3Endpoint
+	}
+	var creds *credentials.Credentials
+	if cfg.AccessKeyID != "" {
+		creds = credentials.NewStaticV4(cfg.AccessKeyID, cfg.SecretAccessKey, "")
+	} else {
+		creds = credentials.NewChainCredentials([]credentials.Provider{
+			&credentials.EnvAWS{},
+			&credentials.FileAWSCredentials{},
+			&credentials.IAM{},
+		})
+	}
+	lookup := minio.BucketLookupAuto
+	if cfg.UsePathStyle {
+		lookup = minio.BucketLookupPath
+	}
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:        creds,
+		Secure:       !cfg.Insecure,
+		Region:       cfg.Region,
+		BucketLookup: lookup,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &S3Store{client: client, bucket: cfg.Bucket}, nil
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) ([]byte, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, s.error(err)
+	}
+	defer obj.Close()
+
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		return nil, s.error(err)
+	}
+	return data, nil
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, data []byte) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: "application/octet-stream",
+	})
+	return s.error(err)
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	return s.error(s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{}))
+}
+
+// error converts a missing object error into internal.ErrResourceNotFound.
+func (s *S3Store) error(err error) error {
+	if err == nil {
+		return nil
+	}
+	resp := minio.ToErrorResponse(err)
+	if resp.Code == "NoSuchKey" || resp.StatusCode == http.StatusNotFound {
+		return internal.ErrResourceNotFound
+	}
+	return err
+}
@@ -0,0 +1,129 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3Store(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewServer(newFakeS3("otf-blobs"))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	store, err := NewS3Store(S3Config{
+		Bucket:          "otf-blobs",
+		Endpoint:        u.Host,
+		Region:          "us-east-1",
+		AccessKeyID:     "minioadmin",
+		SecretAccessKey: "minioadmin",
+		Insecure:        true,
+		UsePathStyle:    true,
+	})
+	require.NoError(t, err)
+
+	testStore(t, ctx, store)
+}
+
+// fakeS3 is a minimal stand-in for an S3-compatible object store, supporting
+// path-style requests to put, get, and delete objects in a single bucket.
+type fakeS3 struct {
+	bucket  string
+	objects map[string][]byte
+	mu      sync.Mutex
+}
+
+func newFakeS3(bucket string) *fakeS3 {
+	return &fakeS3{bucket: bucket, objects: make(map[string][]byte)}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != f.bucket {
+		f.error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			f.error(w, http.StatusInternalServerError, "InternalError")
+			return
+		}
+		if strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+			data, err = decodeChunked(data)
+			if err != nil {
+				f.error(w, http.StatusBadRequest, "IncompleteBody")
+				return
+			}
+		}
+		f.objects[key] = data
+		w.Header().Set("ETag", `"fake"`)
+	case http.MethodGet, http.MethodHead:
+		data, ok := f.objects[key]
+		if !ok {
+			f.error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("ETag", `"fake"`)
+		w.Header().Set("Last-Modified", "Mon, 01 Jan 2024 00:00:00 GMT")
+		w.Header().Set("Content-Type", "application/octet-stream")
+		if r.Method == http.MethodGet {
+			w.Write(data) //nolint:errcheck
+		}
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		f.error(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+// decodeChunked decodes a body uploaded using aws-chunked encoding, in which
+// each chunk is of the form <hex-size>;chunk-signature=<sig>\r\n<data>\r\n.
+// Chunk signatures are not verified.
+func decodeChunked(body []byte) ([]byte, error) {
+	var data []byte
+	for {
+		header, rest, ok := bytes.Cut(body, []byte("\r\n"))
+		if !ok {
+			return nil, errors.New("malformed chunk header")
+		}
+		hexSize, _, _ := bytes.Cut(header, []byte(";"))
+		size, err := strconv.ParseInt(string(hexSize), 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return data, nil
+		}
+		if int64(len(rest)) < size+2 {
+			return nil, errors.New("truncated chunk")
+		}
+		data = append(data, rest[:size]...)
+		body = rest[size+2:]
+	}
+}
+
+func (f *fakeS3) error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>`+code+`</Code><Message>`+code+`</Message></Error>`) //nolint:errcheck
+}
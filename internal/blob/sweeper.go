@@ -0,0 +1,125 @@
+package blob
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tofutf/tofutf/internal/sql"
+	"github.com/tofutf/tofutf/internal/sql/pggen"
+)
+
+// SweeperLockID guarantees only one sweeper on a cluster is running at any
+// time.
+const SweeperLockID int64 = 5577006791947779420
+
+const (
+	// defaultSweepInterval is how often the sweeper removes deleted blobs.
+	defaultSweepInterval = time.Minute
+	// sweepBatchSize is the max number of deleted blobs retrieved from the
+	// database at a time.
+	sweepBatchSize = 100
+)
+
+type (
+	// Sweeper periodically removes blobs from the store once the rows to which
+	// they belong have been deleted from the database. The keys of such blobs
+	// are recorded by database triggers, including for rows deleted by
+	// cascading deletes, e.g. the state versions of a deleted workspace.
+	Sweeper struct {
+		Logger    *slog.Logger
+		Store     Store // nil if blobs are persisted in the database
+		Deletions sweeperClient
+
+		// frequency with which the sweeper removes deleted blobs
+		checkInterval time.Duration
+	}
+
+	sweeperClient interface {
+		listDeletions(ctx context.Context, limit int) ([]string, error)
+		deleteDeletions(ctx context.Context, keys []string) error
+	}
+
+	sweeperDB struct {
+		*sql.Pool
+	}
+)
+
+// NewSweeper constructs a sweeper. If store is nil then the sweeper merely
+// discards the record of deleted blobs.
+func NewSweeper(logger *slog.Logger, pool *sql.Pool, store Store) *Sweeper {
+	return &Sweeper{
+		Logger:        logger.With("component", "blob-sweeper"),
+		Store:         store,
+		Deletions:     &sweeperDB{Pool: pool},
+		checkInterval: defaultSweepInterval,
+	}
+}
+
+// Start starts the sweeper daemon. Should be invoked in a go routine.
+func (s *Sweeper) Start(ctx context.Context) error {
+	// run at startup and then every check interval
+	s.sweep(ctx)
+	ticker := time.NewTicker(s.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.sweep(ctx)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (s *Sweeper) sweep(ctx context.Context) {
+	var total int
+	for {
+		keys, err := s.Deletions.listDeletions(ctx, sweepBatchSize)
+		if err != nil {
+			s.Logger.Error("listing deleted blobs", "err", err)
+			return
+		}
+		if len(keys) == 0 {
+			break
+		}
+		if s.Store != nil {
+			for _, key := range keys {
+				if err := s.Store.Delete(ctx, key); err != nil {
+					// leave the remaining blobs to be retried on the next sweep
+					s.Logger.Error("removing deleted blob", "key", key, "err", err)
+					return
+				}
+			}
+		}
+		if err := s.Deletions.deleteDeletions(ctx, keys); err != nil {
+			s.Logger.Error("discarding deleted blobs", "err", err)
+			return
+		}
+		total += len(keys)
+	}
+	if total > 0 {
+		s.Logger.Info("removed deleted blobs", "count", total)
+	}
+}
+
+func (db *sweeperDB) listDeletions(ctx context.Context, limit int) ([]string, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]string, error) {
+		rows, err := q.FindBlobDeletions(ctx, sql.Int8(limit))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		keys := make([]string, len(rows))
+		for i, row := range rows {
+			keys[i] = row.String
+		}
+		return keys, nil
+	})
+}
+
+func (db *sweeperDB) deleteDeletions(ctx context.Context, keys []string) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.DeleteBlobDeletions(ctx, keys)
+		return sql.Error(err)
+	})
+}
@@ -0,0 +1,54 @@
+package blob
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSweeper_sweep(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("remove deleted blobs", func(t *testing.T) {
+		store, err := NewLocalStore(t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, store.Put(ctx, StateKey("sv-1"), []byte("state")))
+		require.NoError(t, store.Put(ctx, StateKey("sv-2"), []byte("state")))
+
+		deletions := &fakeSweeperClient{keys: []string{StateKey("sv-1")}}
+		sweeper := &Sweeper{Logger: slog.Default(), Store: store, Deletions: deletions}
+
+		sweeper.sweep(ctx)
+
+		_, err = store.Get(ctx, StateKey("sv-1"))
+		assert.Error(t, err)
+		_, err = store.Get(ctx, StateKey("sv-2"))
+		assert.NoError(t, err)
+		assert.Empty(t, deletions.keys)
+	})
+
+	t.Run("discard deleted blobs without store", func(t *testing.T) {
+		deletions := &fakeSweeperClient{keys: []string{StateKey("sv-1")}}
+		sweeper := &Sweeper{Logger: slog.Default(), Deletions: deletions}
+
+		sweeper.sweep(ctx)
+
+		assert.Empty(t, deletions.keys)
+	})
+}
+
+type fakeSweeperClient struct {
+	keys []string
+}
+
+func (f *fakeSweeperClient) listDeletions(ctx context.Context, limit int) ([]string, error) {
+	return f.keys[:min(limit, len(f.keys))], nil
+}
+
+func (f *fakeSweeperClient) deleteDeletions(ctx context.Context, keys []string) error {
+	f.keys = f.keys[len(keys):]
+	return nil
+}
@@ -12,10 +12,14 @@ import (
 	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/agent"
 	"github.com/tofutf/tofutf/internal/api"
+	"github.com/tofutf/tofutf/internal/audit"
+	"github.com/tofutf/tofutf/internal/module"
 	"github.com/tofutf/tofutf/internal/organization"
 	"github.com/tofutf/tofutf/internal/run"
+	"github.com/tofutf/tofutf/internal/schedule"
 	"github.com/tofutf/tofutf/internal/state"
 	"github.com/tofutf/tofutf/internal/team"
+	"github.com/tofutf/tofutf/internal/tokens"
 	"github.com/tofutf/tofutf/internal/user"
 	"github.com/tofutf/tofutf/internal/workspace"
 )
@@ -60,8 +64,12 @@ func (a *CLI) Run(ctx context.Context, args []string, out io.Writer) error {
 	cmd.AddCommand(team.NewTeamCommand(a.client))
 	cmd.AddCommand(workspace.NewCommand(a.client))
 	cmd.AddCommand(run.NewCommand(a.client))
+	cmd.AddCommand(schedule.NewCommand(a.client))
+	cmd.AddCommand(audit.NewCommand(a.client))
+	cmd.AddCommand(tokens.NewCommand(a.client))
 	cmd.AddCommand(state.NewCommand(a.client))
 	cmd.AddCommand(agent.NewAgentsCommand(a.client))
+	cmd.AddCommand(module.NewCommand(a.client))
 
 	if err := cmdutil.SetFlagsFromEnvVariables(cmd.Flags()); err != nil {
 		return errors.Wrap(err, "failed to populate config from environment vars")
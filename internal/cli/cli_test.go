@@ -70,6 +70,10 @@ func TestCommandTree(t *testing.T) {
 			name: "agent token create",
 			args: []string{"agents", "tokens", "new", "-h"},
 		},
+		{
+			name: "modules publish",
+			args: []string{"modules", "publish", "-h"},
+		},
 		{
 			name: "invalid",
 			args: []string{"invalid", "-h"},
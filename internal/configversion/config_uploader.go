@@ -3,19 +3,22 @@ package configversion
 import (
 	"context"
 
+	"github.com/tofutf/tofutf/internal/blob"
 	"github.com/tofutf/tofutf/internal/sql"
 	"github.com/tofutf/tofutf/internal/sql/pggen"
 )
 
 type cvUploader struct {
-	q  pggen.Querier
-	id string
+	q     pggen.Querier
+	blobs blob.Store
+	id    string
 }
 
-func newConfigUploader(q pggen.Querier, id string) *cvUploader {
+func newConfigUploader(q pggen.Querier, blobs blob.Store, id string) *cvUploader {
 	return &cvUploader{
-		q:  q,
-		id: id,
+		q:     q,
+		blobs: blobs,
+		id:    id,
 	}
 }
 
@@ -30,7 +33,11 @@ func (u *cvUploader) SetErrored(ctx context.Context) error {
 
 func (u *cvUploader) Upload(ctx context.Context, config []byte) (ConfigurationStatus, error) {
 	// TODO: add status timestamp
-	_, err := u.q.UpdateConfigurationVersionConfigByID(ctx, config, sql.String(u.id))
+	config, err := blob.Offload(ctx, u.blobs, blob.ConfigKey(u.id), config)
+	if err != nil {
+		return ConfigurationErrored, err
+	}
+	_, err = u.q.UpdateConfigurationVersionConfigByID(ctx, config, sql.String(u.id))
 	if err != nil {
 		return ConfigurationErrored, err
 	}
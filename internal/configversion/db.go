@@ -6,6 +6,7 @@ import (
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/blob"
 	"github.com/tofutf/tofutf/internal/resource"
 	"github.com/tofutf/tofutf/internal/sql"
 	"github.com/tofutf/tofutf/internal/sql/pggen"
@@ -13,6 +14,8 @@ import (
 
 type pgdb struct {
 	*sql.Pool // provides access to generated SQL queries
+
+	blobs blob.Store // optional store for configuration tarballs
 }
 
 func (db *pgdb) CreateConfigurationVersion(ctx context.Context, cv *ConfigurationVersion) error {
@@ -70,7 +73,7 @@ func (db *pgdb) UploadConfigurationVersion(ctx context.Context, id string, fn fu
 		}
 		cv := pgRow(result).toConfigVersion()
 
-		if err := fn(cv, newConfigUploader(q, cv.ID)); err != nil {
+		if err := fn(cv, newConfigUploader(q, db.blobs, cv.ID)); err != nil {
 			return fmt.Errorf("failed to mutate configuration version: %w", err)
 		}
 
@@ -132,7 +135,7 @@ func (db *pgdb) GetConfig(ctx context.Context, id string) ([]byte, error) {
 			return nil, fmt.Errorf("failed to download configuration version tarball: %w", sql.Error(err))
 		}
 
-		return cfg, nil
+		return blob.Load(ctx, db.blobs, blob.ConfigKey(id), cfg)
 	})
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/leg100/surl"
 	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/blob"
 	"github.com/tofutf/tofutf/internal/rbac"
 	"github.com/tofutf/tofutf/internal/resource"
 	"github.com/tofutf/tofutf/internal/sql"
@@ -34,6 +35,8 @@ type (
 		*sql.Pool
 		*surl.Signer
 		*tfeapi.Responder
+
+		BlobStore blob.Store
 	}
 )
 
@@ -44,7 +47,7 @@ func NewService(opts Options) *Service {
 
 	svc.workspace = opts.WorkspaceAuthorizer
 
-	svc.db = &pgdb{opts.Pool}
+	svc.db = &pgdb{opts.Pool, opts.BlobStore}
 	svc.cache = opts.Cache
 	svc.tfeapi = &tfe{
 		logger:        opts.Logger,
@@ -2,12 +2,15 @@ package daemon
 
 import (
 	"errors"
+	"time"
 
 	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/agent"
 	"github.com/tofutf/tofutf/internal/authenticator"
+	"github.com/tofutf/tofutf/internal/blob"
 	"github.com/tofutf/tofutf/internal/configversion"
 	"github.com/tofutf/tofutf/internal/inmem"
+	"github.com/tofutf/tofutf/internal/provider"
 	"github.com/tofutf/tofutf/internal/tokens"
 )
 
@@ -30,6 +33,7 @@ type Config struct {
 	BitbucketServerHostname string
 
 	OIDC                         authenticator.OIDCConfig
+	SAML                         authenticator.SAMLConfig
 	Secret                       []byte // 16-byte secret for signing URLs and encrypting payloads
 	SiteToken                    string
 	Host                         string
@@ -47,6 +51,30 @@ type Config struct {
 	SkipTLSVerification          bool
 	// skip checks for latest terraform version
 	DisableLatestChecker *bool
+	// AssessmentInterval is the period between health assessments of
+	// workspaces with assessments enabled.
+	AssessmentInterval time.Duration
+	// AuditRetention is the period for which audit events are retained. Zero
+	// retains audit events indefinitely.
+	AuditRetention time.Duration
+	// AuditSinkURL is an optional URL to which audit events are streamed.
+	AuditSinkURL string
+	// SCIMToken authenticates requests from an identity provider to the SCIM
+	// API. If empty then the SCIM API is disabled.
+	SCIMToken string
+	// SCIMOrganization is the organization in which SCIM groups are
+	// provisioned as teams.
+	SCIMOrganization string
+
+	// EncryptionKeys are hex-encoded 32 byte keys for encrypting sensitive
+	// data at rest. The first key encrypts data; the remainder are previous
+	// keys retained for decrypting data. If empty, a key derived from Secret
+	// is used.
+	EncryptionKeys []string
+
+	// BlobStore configures where state files, configuration tarballs, plan
+	// files, module tarballs, provider binaries and logs are persisted.
+	BlobStore blob.Config
 
 	// EnableOtel enables the open telemetry integration.
 	EnableOtel bool
@@ -57,6 +85,16 @@ type Config struct {
 		IsArtifactory bool
 	}
 
+	// ProviderMirror configures tofutf's provider network mirror.
+	ProviderMirror struct {
+		// Hosts are the upstream registries from which the mirror is
+		// permitted to retrieve providers.
+		Hosts []string
+		// Retention is the period for which the mirror caches a version of a
+		// provider. Zero caches versions indefinitely.
+		Retention time.Duration
+	}
+
 	tokens.GoogleIAPConfig
 }
 
@@ -72,6 +110,9 @@ func ApplyDefaults(cfg *Config) {
 	if cfg.MaxConfigSize == 0 {
 		cfg.MaxConfigSize = configversion.DefaultConfigMaxSize
 	}
+	if cfg.ProviderMirror.Hosts == nil {
+		cfg.ProviderMirror.Hosts = provider.DefaultMirrorHosts
+	}
 }
 
 func (cfg *Config) Valid() error {
@@ -12,11 +12,14 @@ import (
 	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/agent"
 	"github.com/tofutf/tofutf/internal/api"
+	"github.com/tofutf/tofutf/internal/audit"
 	"github.com/tofutf/tofutf/internal/authenticator"
 	"github.com/tofutf/tofutf/internal/bitbucketserver"
+	"github.com/tofutf/tofutf/internal/blob"
 	"github.com/tofutf/tofutf/internal/configversion"
 	"github.com/tofutf/tofutf/internal/connections"
 	"github.com/tofutf/tofutf/internal/disco"
+	"github.com/tofutf/tofutf/internal/encryption"
 	"github.com/tofutf/tofutf/internal/ghapphandler"
 	"github.com/tofutf/tofutf/internal/github"
 	"github.com/tofutf/tofutf/internal/gitlab"
@@ -29,11 +32,15 @@ import (
 	"github.com/tofutf/tofutf/internal/module"
 	"github.com/tofutf/tofutf/internal/notifications"
 	"github.com/tofutf/tofutf/internal/organization"
+	"github.com/tofutf/tofutf/internal/policy"
 	"github.com/tofutf/tofutf/internal/provider"
 	"github.com/tofutf/tofutf/internal/releases"
 	"github.com/tofutf/tofutf/internal/repohooks"
 	"github.com/tofutf/tofutf/internal/run"
+	"github.com/tofutf/tofutf/internal/runtrigger"
+	"github.com/tofutf/tofutf/internal/schedule"
 	"github.com/tofutf/tofutf/internal/scheduler"
+	"github.com/tofutf/tofutf/internal/scim"
 	"github.com/tofutf/tofutf/internal/sql"
 	"github.com/tofutf/tofutf/internal/state"
 	"github.com/tofutf/tofutf/internal/team"
@@ -59,6 +66,10 @@ type (
 		Workspaces    *workspace.Service
 		Variables     *variable.Service
 		Notifications *notifications.Service
+		RunTriggers   *runtrigger.Service
+		Schedules     *schedule.Service
+		Audit         *audit.Service
+		Policies      *policy.Service
 		Logs          *logs.Service
 		State         *state.Service
 		Configs       *configversion.Service
@@ -74,9 +85,10 @@ type (
 		Connections   *connections.Service
 		System        *internal.HostnameService
 
-		handlers []internal.Handlers
-		listener *sql.Listener
-		agent    agentDaemon
+		handlers    []internal.Handlers
+		listener    *sql.Listener
+		agent       agentDaemon
+		blobSweeper *blob.Sweeper
 	}
 
 	agentDaemon interface {
@@ -131,6 +143,12 @@ func New(ctx context.Context, logger *slog.Logger, cfg Config) (*Daemon, error)
 		return nil, err
 	}
 
+	// blobs is nil if blobs are to be persisted in the database
+	blobs, err := blob.NewStore(cfg.BlobStore)
+	if err != nil {
+		return nil, fmt.Errorf("setting up blob store: %w", err)
+	}
+
 	// listener listens to database events
 	listener := sql.NewListener(logger, db)
 
@@ -140,10 +158,23 @@ func New(ctx context.Context, logger *slog.Logger, cfg Config) (*Daemon, error)
 	// Setup url signer
 	signer := internal.NewSigner(cfg.Secret)
 
+	// keyring encrypts sensitive data at rest
+	encryptionKeys, err := encryption.ParseKeys(cfg.EncryptionKeys...)
+	if err != nil {
+		return nil, err
+	}
+	keyring, err := encryption.NewKeyring(cfg.Secret, encryptionKeys...)
+	if err != nil {
+		return nil, err
+	}
+
 	tokensService, err := tokens.NewService(tokens.Options{
 		Logger:          logger,
 		GoogleIAPConfig: cfg.GoogleIAPConfig,
 		Secret:          cfg.Secret,
+		HostnameService: hostnameService,
+		Responder:       responder,
+		Renderer:        renderer,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("setting up authentication middleware: %w", err)
@@ -250,6 +281,13 @@ func New(ctx context.Context, logger *slog.Logger, cfg Config) (*Daemon, error)
 		Cache:               cache,
 		Signer:              signer,
 		MaxConfigSize:       cfg.MaxConfigSize,
+		BlobStore:           blobs,
+	})
+
+	policyService := policy.NewService(policy.Options{
+		Logger:   logger,
+		Pool:     db,
+		Renderer: renderer,
 	})
 
 	runService := run.NewService(run.Options{
@@ -268,6 +306,9 @@ func New(ctx context.Context, logger *slog.Logger, cfg Config) (*Daemon, error)
 		Signer:               signer,
 		ReleasesService:      releasesService,
 		TokensService:        tokensService,
+		PolicyService:        policyService,
+		BlobStore:            blobs,
+		Keyring:              keyring,
 	})
 	logsService := logs.NewService(logs.Options{
 		Logger:        logger,
@@ -276,6 +317,7 @@ func New(ctx context.Context, logger *slog.Logger, cfg Config) (*Daemon, error)
 		Cache:         cache,
 		Listener:      listener,
 		Verifier:      signer,
+		BlobStore:     blobs,
 	})
 	moduleService := module.NewService(module.Options{
 		Logger:             logger,
@@ -287,15 +329,34 @@ func New(ctx context.Context, logger *slog.Logger, cfg Config) (*Daemon, error)
 		ConnectionsService: connectionService,
 		RepohookService:    repoService,
 		VCSEventSubscriber: vcsEventBroker,
+		BlobStore:          blobs,
+		Responder:          responder,
+	})
+	privateregistryService, err := gpgkeys.NewService(gpgkeys.Options{
+		Logger:                 logger,
+		Pool:                   db,
+		HostnameService:        hostnameService,
+		Signer:                 signer,
+		Renderer:               renderer,
+		OrganizationAuthorizer: orgService,
+		Responder:              responder,
 	})
+	if err != nil {
+		return nil, err
+	}
+
 	providerService := provider.NewService(provider.Options{
 		Logger:             logger,
 		Pool:               db,
 		HostnameService:    hostnameService,
 		Signer:             signer,
 		Renderer:           renderer,
+		Responder:          responder,
+		BlobStore:          blobs,
+		GPGKeys:            privateregistryService,
 		ProxyURL:           cfg.ProviderProxy.URL,
 		ProxyIsArtifactory: cfg.ProviderProxy.IsArtifactory,
+		MirrorHosts:        cfg.ProviderMirror.Hosts,
 	})
 	stateService := state.NewService(state.Options{
 		Logger:           logger,
@@ -305,6 +366,8 @@ func New(ctx context.Context, logger *slog.Logger, cfg Config) (*Daemon, error)
 		Renderer:         renderer,
 		Responder:        responder,
 		Signer:           signer,
+		BlobStore:        blobs,
+		Keyring:          keyring,
 	})
 	variableService := variable.NewService(variable.Options{
 		Logger:              logger,
@@ -314,6 +377,7 @@ func New(ctx context.Context, logger *slog.Logger, cfg Config) (*Daemon, error)
 		WorkspaceAuthorizer: workspaceService,
 		WorkspaceService:    workspaceService,
 		RunClient:           runService,
+		Keyring:             keyring,
 	})
 
 	agentService := agent.NewService(agent.ServiceOptions{
@@ -350,6 +414,8 @@ func New(ctx context.Context, logger *slog.Logger, cfg Config) (*Daemon, error)
 		Renderer:        renderer,
 		HostnameService: hostnameService,
 		TokensService:   tokensService,
+		UserService:     userService,
+		TeamService:     teamService,
 		OpaqueHandlerConfigs: []authenticator.OpaqueHandlerConfig{
 			{
 				ClientConstructor: github.NewOAuthClient,
@@ -375,6 +441,7 @@ func New(ctx context.Context, logger *slog.Logger, cfg Config) (*Daemon, error)
 			},
 		},
 		IDTokenHandlerConfig: cfg.OIDC,
+		SAMLConfig:           cfg.SAML,
 		SkipTLSVerification:  cfg.SkipTLSVerification,
 	})
 	if err != nil {
@@ -389,18 +456,31 @@ func New(ctx context.Context, logger *slog.Logger, cfg Config) (*Daemon, error)
 		WorkspaceAuthorizer: workspaceService,
 	})
 
-	privateregistryService, err := gpgkeys.NewService(gpgkeys.Options{
-		Logger:                 logger,
-		Pool:                   db,
-		HostnameService:        hostnameService,
-		Signer:                 signer,
-		Renderer:               renderer,
-		OrganizationAuthorizer: orgService,
-		Responder:              responder,
+	runTriggerService := runtrigger.NewService(runtrigger.Options{
+		Logger:           logger,
+		Pool:             db,
+		Responder:        responder,
+		Renderer:         renderer,
+		WorkspaceService: workspaceService,
+		RunService:       runService,
+	})
+
+	scheduleService := schedule.NewService(schedule.Options{
+		Logger:           logger,
+		Pool:             db,
+		Responder:        responder,
+		Renderer:         renderer,
+		WorkspaceService: workspaceService,
+		RunService:       runService,
+	})
+
+	auditService := audit.NewService(audit.Options{
+		Logger:    logger,
+		Pool:      db,
+		Responder: responder,
+		Renderer:  renderer,
+		SinkURL:   cfg.AuditSinkURL,
 	})
-	if err != nil {
-		return nil, err
-	}
 
 	handlers := []internal.Handlers{
 		teamService,
@@ -422,10 +502,22 @@ func New(ctx context.Context, logger *slog.Logger, cfg Config) (*Daemon, error)
 			Renderer:    renderer,
 			UserService: userService,
 		}),
+		scim.NewServer(scim.Options{
+			Logger:       logger,
+			Token:        cfg.SCIMToken,
+			Organization: cfg.SCIMOrganization,
+			UserService:  userService,
+			TeamService:  teamService,
+		}),
 		configService,
 		notificationService,
+		runTriggerService,
+		scheduleService,
+		auditService,
+		policyService,
 		githubAppService,
 		agentService,
+		tokensService,
 		disco.Service{},
 		&ghapphandler.Handler{
 			Logger:       logger,
@@ -447,6 +539,10 @@ func New(ctx context.Context, logger *slog.Logger, cfg Config) (*Daemon, error)
 		Workspaces:    workspaceService,
 		Variables:     variableService,
 		Notifications: notificationService,
+		RunTriggers:   runTriggerService,
+		Schedules:     scheduleService,
+		Audit:         auditService,
+		Policies:      policyService,
 		Logs:          logsService,
 		State:         stateService,
 		Configs:       configService,
@@ -463,6 +559,7 @@ func New(ctx context.Context, logger *slog.Logger, cfg Config) (*Daemon, error)
 		Pool:          db,
 		agent:         agentDaemon,
 		listener:      listener,
+		blobSweeper:   blob.NewSweeper(logger, db, blobs),
 	}, nil
 }
 
@@ -482,7 +579,7 @@ func (d *Daemon) Start(ctx context.Context, started chan struct{}) error {
 		KeyFile:              d.KeyFile,
 		EnableRequestLogging: d.EnableRequestLogging,
 		DevMode:              d.DevMode,
-		Middleware:           []mux.MiddlewareFunc{d.Tokens.Middleware()},
+		Middleware:           []mux.MiddlewareFunc{d.Tokens.Middleware(), d.Audit.Middleware()},
 		Handlers:             d.handlers,
 	})
 	if err != nil {
@@ -545,6 +642,38 @@ func (d *Daemon) Start(ctx context.Context, started chan struct{}) error {
 				Pool:               d.Pool,
 			}),
 		},
+		{
+			Name:      "run-triggerer",
+			Logger:    d.Logger,
+			Exclusive: true,
+			DB:        d.Pool,
+			LockID:    internal.Int64(runtrigger.LockID),
+			System:    d.RunTriggers.NewTriggerer(d.Logger),
+		},
+		{
+			Name:      "schedule-dispatcher",
+			Logger:    d.Logger,
+			Exclusive: true,
+			DB:        d.Pool,
+			LockID:    internal.Int64(schedule.LockID),
+			System:    d.Schedules.NewDispatcher(d.Logger),
+		},
+		{
+			Name:      "assessor",
+			Logger:    d.Logger,
+			Exclusive: true,
+			DB:        d.Pool,
+			LockID:    internal.Int64(run.AssessorLockID),
+			System:    d.Runs.NewAssessor(d.Logger, d.AssessmentInterval),
+		},
+		{
+			Name:      "auto-destroyer",
+			Logger:    d.Logger,
+			Exclusive: true,
+			DB:        d.Pool,
+			LockID:    internal.Int64(run.AutoDestroyerLockID),
+			System:    d.Runs.NewAutoDestroyer(d.Logger),
+		},
 		{
 			Name:      "job-allocator",
 			Logger:    d.Logger,
@@ -567,6 +696,41 @@ func (d *Daemon) Start(ctx context.Context, started chan struct{}) error {
 			DB:     d.Pool,
 			System: d.agent,
 		},
+		{
+			Name:      "blob-sweeper",
+			Logger:    d.Logger,
+			Exclusive: true,
+			DB:        d.Pool,
+			LockID:    internal.Int64(blob.SweeperLockID),
+			System:    d.blobSweeper,
+		},
+	}
+	if d.AuditRetention > 0 {
+		subsystems = append(subsystems, &Subsystem{
+			Name:      "audit-pruner",
+			Logger:    d.Logger,
+			Exclusive: true,
+			DB:        d.Pool,
+			LockID:    internal.Int64(audit.PrunerLockID),
+			System:    d.Audit.NewPruner(d.Logger, d.AuditRetention),
+		})
+	}
+	if d.ProviderMirror.Retention > 0 {
+		subsystems = append(subsystems, &Subsystem{
+			Name:      "provider-mirror-pruner",
+			Logger:    d.Logger,
+			Exclusive: true,
+			DB:        d.Pool,
+			LockID:    internal.Int64(provider.MirrorPrunerLockID),
+			System:    d.Providers.NewMirrorPruner(d.Logger, d.ProviderMirror.Retention),
+		})
+	}
+	if sink := d.Audit.Sink(); sink != nil {
+		subsystems = append(subsystems, &Subsystem{
+			Name:   "audit-sink",
+			Logger: d.Logger,
+			System: sink,
+		})
 	}
 	if !d.DisableScheduler {
 		subsystems = append(subsystems, &Subsystem{
@@ -0,0 +1,267 @@
+// Package encryption provides envelope encryption of sensitive data at rest:
+// sensitive variable values, state files, sensitive state outputs and plan
+// files.
+//
+// Each item of data is encrypted with its own randomly generated data key,
+// which in turn is encrypted with a key encryption key from a keyring and
+// stored alongside the encrypted data. Rotating the key encryption key only
+// requires re-encrypting the data keys, although in practice data is simply
+// re-encrypted in its entirety.
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// KeySize is the size in bytes of keys.
+	KeySize = 32
+
+	// info parameter for deriving the default key encryption key from the
+	// secret, ensuring it differs from any other key derived from the
+	// secret.
+	derivedKeyInfo = "tofutf encryption key"
+
+	keyIDSize      = 8  // hex-encoded key ID
+	nonceSize      = 12 // GCM standard nonce size
+	tagSize        = 16 // GCM standard tag size
+	wrappedKeySize = nonceSize + KeySize + tagSize
+)
+
+// magic prefixes encrypted data, distinguishing it from data persisted before
+// encryption was introduced, which is decrypted as-is.
+var magic = []byte("tofutf:enc1:")
+
+var (
+	ErrUnknownKey    = errors.New("data is encrypted with an unknown key")
+	ErrNoKeyring     = errors.New("data is encrypted but no encryption keys are configured")
+	ErrInvalidKey    = fmt.Errorf("encryption key must be %d bytes", KeySize)
+	ErrMalformedData = errors.New("malformed encrypted data")
+)
+
+type (
+	// Keyring encrypts and decrypts data using key encryption keys. The
+	// primary key encrypts data and all keys decrypt data. A nil keyring
+	// performs no encryption.
+	Keyring struct {
+		primary *key
+		keys    map[string]*key
+	}
+
+	key struct {
+		id   string
+		aead cipher.AEAD
+	}
+)
+
+// NewKeyring constructs a keyring from the given keys, the first of which is
+// the primary key, with the remainder retained for decrypting data encrypted
+// with previous keys. A key derived from the secret is always added for
+// decryption, and if no keys are given it is used as the primary key.
+func NewKeyring(secret []byte, keys ...[]byte) (*Keyring, error) {
+	derived := make([]byte, KeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, nil, []byte(derivedKeyInfo)), derived); err != nil {
+		return nil, fmt.Errorf("deriving encryption key: %w", err)
+	}
+	keyring := &Keyring{keys: make(map[string]*key, len(keys)+1)}
+	for _, raw := range append(slices.Clone(keys), derived) {
+		k, err := newKey(raw)
+		if err != nil {
+			return nil, err
+		}
+		if keyring.primary == nil {
+			keyring.primary = k
+		}
+		keyring.keys[k.id] = k
+	}
+	return keyring, nil
+}
+
+// ParseKeys decodes hex-encoded keys.
+func ParseKeys(encoded ...string) ([][]byte, error) {
+	keys := make([][]byte, len(encoded))
+	for i, enc := range encoded {
+		k, err := hex.DecodeString(enc)
+		if err != nil {
+			return nil, fmt.Errorf("decoding encryption key: %w", err)
+		}
+		if len(k) != KeySize {
+			return nil, ErrInvalidKey
+		}
+		keys[i] = k
+	}
+	return keys, nil
+}
+
+func newKey(raw []byte) (*key, error) {
+	if len(raw) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	aead, err := newAEAD(raw)
+	if err != nil {
+		return nil, err
+	}
+	// identify key using a truncated hash of the key
+	sum := sha256.Sum256(raw)
+	return &key{id: hex.EncodeToString(sum[:keyIDSize/2]), aead: aead}, nil
+}
+
+func newAEAD(raw []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// IsEncrypted determines whether data has been encrypted.
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, magic)
+}
+
+// Encrypt encrypts plaintext using a new data key, which itself is encrypted
+// with the primary key. If the keyring is nil or the plaintext is nil then
+// plaintext is returned unchanged.
+func (k *Keyring) Encrypt(plaintext []byte) ([]byte, error) {
+	if k == nil || plaintext == nil {
+		return plaintext, nil
+	}
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	// encrypted data is laid out as follows:
+	//
+	// magic | primary key ID | encrypted data key | nonce | ciphertext
+	out := make([]byte, 0, len(magic)+keyIDSize+wrappedKeySize+nonceSize+len(plaintext)+tagSize)
+	out = append(out, magic...)
+	out = append(out, k.primary.id...)
+	out, err = seal(k.primary.aead, out, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return seal(aead, out, plaintext)
+}
+
+// seal encrypts plaintext, appending a random nonce followed by the
+// ciphertext to dst.
+func seal(aead cipher.AEAD, dst, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	dst = append(dst, nonce...)
+	return aead.Seal(dst, nonce, plaintext, nil), nil
+}
+
+// Decrypt decrypts data encrypted with any key in the keyring. If data is not
+// encrypted then it is returned unchanged.
+func (k *Keyring) Decrypt(data []byte) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return data, nil
+	}
+	if k == nil {
+		return nil, ErrNoKeyring
+	}
+	data = data[len(magic):]
+	if len(data) < keyIDSize+wrappedKeySize+nonceSize+tagSize {
+		return nil, ErrMalformedData
+	}
+	kek, ok := k.keys[string(data[:keyIDSize])]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, data[:keyIDSize])
+	}
+	data = data[keyIDSize:]
+	dataKey, err := kek.aead.Open(nil, data[:nonceSize], data[nonceSize:wrappedKeySize], nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting data key: %w", err)
+	}
+	data = data[wrappedKeySize:]
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, data[:nonceSize], data[nonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting data: %w", err)
+	}
+	return plaintext, nil
+}
+
+// EncryptString encrypts a string, returning the encrypted data as a string.
+func (k *Keyring) EncryptString(plaintext string) (string, error) {
+	if k == nil {
+		return plaintext, nil
+	}
+	encrypted, err := k.Encrypt([]byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	// retain magic as a prefix and base64-encode the remainder
+	return string(magic) + base64.RawStdEncoding.EncodeToString(encrypted[len(magic):]), nil
+}
+
+// DecryptString decrypts a string encrypted with EncryptString. If the string
+// is not encrypted then it is returned unchanged.
+func (k *Keyring) DecryptString(s string) (string, error) {
+	encoded, ok := strings.CutPrefix(s, string(magic))
+	if !ok {
+		return s, nil
+	}
+	decoded, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", ErrMalformedData
+	}
+	plaintext, err := k.Decrypt(slices.Concat(magic, decoded))
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation determines whether data is not encrypted with the primary key,
+// i.e. it is either unencrypted or encrypted with a previous key.
+func (k *Keyring) NeedsRotation(data []byte) bool {
+	if k == nil {
+		return false
+	}
+	if !IsEncrypted(data) {
+		return true
+	}
+	id := data[len(magic):]
+	return len(id) < keyIDSize || string(id[:keyIDSize]) != k.primary.id
+}
+
+// NeedsRotationString is the equivalent of NeedsRotation for strings
+// encrypted with EncryptString.
+func (k *Keyring) NeedsRotationString(s string) bool {
+	if k == nil {
+		return false
+	}
+	encoded, ok := strings.CutPrefix(s, string(magic))
+	if !ok {
+		return true
+	}
+	decoded, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return true
+	}
+	return k.NeedsRotation(slices.Concat(magic, decoded))
+}
@@ -0,0 +1,137 @@
+package encryption
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyring(t *testing.T) {
+	secret := bytes.Repeat([]byte{0x01}, 16)
+	key1 := bytes.Repeat([]byte{0x02}, KeySize)
+	key2 := bytes.Repeat([]byte{0x03}, KeySize)
+
+	t.Run("encrypt and decrypt", func(t *testing.T) {
+		keyring, err := NewKeyring(secret)
+		require.NoError(t, err)
+
+		encrypted, err := keyring.Encrypt([]byte("hello world"))
+		require.NoError(t, err)
+		assert.True(t, IsEncrypted(encrypted))
+		assert.NotContains(t, string(encrypted), "hello world")
+
+		decrypted, err := keyring.Decrypt(encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(decrypted))
+	})
+
+	t.Run("encrypt and decrypt string", func(t *testing.T) {
+		keyring, err := NewKeyring(secret, key1)
+		require.NoError(t, err)
+
+		encrypted, err := keyring.EncryptString("hello world")
+		require.NoError(t, err)
+		assert.NotEqual(t, "hello world", encrypted)
+
+		decrypted, err := keyring.DecryptString(encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", decrypted)
+	})
+
+	t.Run("decrypt unencrypted data", func(t *testing.T) {
+		keyring, err := NewKeyring(secret)
+		require.NoError(t, err)
+
+		decrypted, err := keyring.Decrypt([]byte(`{"version":4}`))
+		require.NoError(t, err)
+		assert.Equal(t, `{"version":4}`, string(decrypted))
+
+		s, err := keyring.DecryptString("plaintext")
+		require.NoError(t, err)
+		assert.Equal(t, "plaintext", s)
+	})
+
+	t.Run("rotate key", func(t *testing.T) {
+		old, err := NewKeyring(secret, key1)
+		require.NoError(t, err)
+		encrypted, err := old.Encrypt([]byte("hello world"))
+		require.NoError(t, err)
+
+		// new primary key, retaining old key for decryption
+		keyring, err := NewKeyring(secret, key2, key1)
+		require.NoError(t, err)
+		assert.True(t, keyring.NeedsRotation(encrypted))
+
+		decrypted, err := keyring.Decrypt(encrypted)
+		require.NoError(t, err)
+		reencrypted, err := keyring.Encrypt(decrypted)
+		require.NoError(t, err)
+		assert.False(t, keyring.NeedsRotation(reencrypted))
+
+		// old key removed
+		removed, err := NewKeyring(secret, key2)
+		require.NoError(t, err)
+		_, err = removed.Decrypt(encrypted)
+		assert.ErrorIs(t, err, ErrUnknownKey)
+	})
+
+	t.Run("derived key retained for decryption", func(t *testing.T) {
+		derived, err := NewKeyring(secret)
+		require.NoError(t, err)
+		encrypted, err := derived.EncryptString("hello world")
+		require.NoError(t, err)
+
+		keyring, err := NewKeyring(secret, key1)
+		require.NoError(t, err)
+		assert.True(t, keyring.NeedsRotationString(encrypted))
+		decrypted, err := keyring.DecryptString(encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", decrypted)
+	})
+
+	t.Run("tampered data", func(t *testing.T) {
+		keyring, err := NewKeyring(secret)
+		require.NoError(t, err)
+		encrypted, err := keyring.Encrypt([]byte("hello world"))
+		require.NoError(t, err)
+
+		encrypted[len(encrypted)-1] ^= 0xff
+		_, err = keyring.Decrypt(encrypted)
+		assert.Error(t, err)
+	})
+
+	t.Run("nil keyring", func(t *testing.T) {
+		var keyring *Keyring
+
+		encrypted, err := keyring.Encrypt([]byte("hello world"))
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(encrypted))
+
+		other, err := NewKeyring(secret)
+		require.NoError(t, err)
+		encrypted, err = other.Encrypt([]byte("hello world"))
+		require.NoError(t, err)
+		_, err = keyring.Decrypt(encrypted)
+		assert.ErrorIs(t, err, ErrNoKeyring)
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		_, err := NewKeyring(secret, []byte("too short"))
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+
+	t.Run("parse keys", func(t *testing.T) {
+		keys, err := ParseKeys(strings.Repeat("ab", KeySize))
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{bytes.Repeat([]byte{0xab}, KeySize)}, keys)
+
+		_, err = ParseKeys("abcd")
+		assert.ErrorIs(t, err, ErrInvalidKey)
+
+		_, err = ParseKeys("not hex")
+		assert.Error(t, err)
+	})
+}
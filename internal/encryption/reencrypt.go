@@ -0,0 +1,225 @@
+package encryption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/blob"
+	"github.com/tofutf/tofutf/internal/sql"
+	"github.com/tofutf/tofutf/internal/sql/pggen"
+)
+
+// reencryptBatchSize is the max number of items retrieved from the database at
+// a time.
+const reencryptBatchSize = 100
+
+// Reencrypt encrypts sensitive data with the keyring's primary key: sensitive
+// variable values, state files, sensitive state outputs and plan files. Data
+// that is unencrypted or encrypted with a previous key is re-encrypted, and
+// data already encrypted with the primary key is skipped, so it is safe to
+// re-run if interrupted. State files and plan files persisted in the blob
+// store are re-encrypted in place.
+func Reencrypt(ctx context.Context, logger *slog.Logger, pool *sql.Pool, store blob.Store, keyring *Keyring) error {
+	if keyring == nil {
+		return errors.New("cannot re-encrypt: a keyring is required")
+	}
+	r := &reencrypter{store: store, keyring: keyring}
+	for _, step := range []struct {
+		kind string
+		fn   func(context.Context, pggen.Querier, string) (string, int, error)
+	}{
+		{"sensitive variables", r.variables},
+		{"state files", r.stateFiles},
+		{"sensitive state outputs", r.outputs},
+		{"plan files", r.plans},
+	} {
+		var (
+			after string
+			total int
+		)
+		for {
+			var (
+				next string
+				n    int
+			)
+			err := pool.Tx(ctx, func(ctx context.Context, q pggen.Querier) (err error) {
+				next, n, err = step.fn(ctx, q, after)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("re-encrypting %s: %w", step.kind, err)
+			}
+			total += n
+			if next == after {
+				break
+			}
+			after = next
+		}
+		logger.Info("re-encrypted data", "kind", step.kind, "count", total)
+	}
+	return nil
+}
+
+// reencrypter re-encrypts batches of data, each batch starting after the given
+// ID, returning the ID of the last item in the batch along with the number of
+// items re-encrypted. An empty batch returns the ID it was given.
+type reencrypter struct {
+	store   blob.Store
+	keyring *Keyring
+}
+
+func (r *reencrypter) variables(ctx context.Context, q pggen.Querier, after string) (string, int, error) {
+	rows, err := q.FindSensitiveVariablesForReencryption(ctx, sql.String(after), sql.Int8(reencryptBatchSize))
+	if err != nil {
+		return "", 0, sql.Error(err)
+	}
+	var n int
+	for _, row := range rows {
+		after = row.VariableID.String
+		if !r.keyring.NeedsRotationString(row.Value.String) {
+			continue
+		}
+		value, err := r.keyring.DecryptString(row.Value.String)
+		if err != nil {
+			return "", 0, fmt.Errorf("decrypting variable %s: %w", after, err)
+		}
+		value, err = r.keyring.EncryptString(value)
+		if err != nil {
+			return "", 0, err
+		}
+		if _, err := q.UpdateVariableValue(ctx, sql.String(value), row.VariableID); err != nil {
+			return "", 0, sql.Error(err)
+		}
+		n++
+	}
+	return after, n, nil
+}
+
+func (r *reencrypter) stateFiles(ctx context.Context, q pggen.Querier, after string) (string, int, error) {
+	rows, err := q.FindStateVersionsForReencryption(ctx, sql.String(after), sql.Int8(reencryptBatchSize))
+	if err != nil {
+		return "", 0, sql.Error(err)
+	}
+	var n int
+	for _, row := range rows {
+		after = row.StateVersionID.String
+		state, ok, err := r.blob(ctx, blob.StateKey(after), row.State)
+		if err != nil {
+			return "", 0, fmt.Errorf("state version %s: %w", after, err)
+		}
+		if !ok {
+			continue
+		}
+		n++
+		if row.State == nil {
+			// re-encrypted in place in the blob store
+			continue
+		}
+		if _, err := q.UpdateStateVersionState(ctx, state, row.StateVersionID); err != nil {
+			return "", 0, sql.Error(err)
+		}
+	}
+	return after, n, nil
+}
+
+func (r *reencrypter) outputs(ctx context.Context, q pggen.Querier, after string) (string, int, error) {
+	rows, err := q.FindSensitiveStateVersionOutputsForReencryption(ctx, sql.String(after), sql.Int8(reencryptBatchSize))
+	if err != nil {
+		return "", 0, sql.Error(err)
+	}
+	var n int
+	for _, row := range rows {
+		after = row.StateVersionOutputID.String
+		value, ok, err := r.reencrypt(row.Value)
+		if err != nil {
+			return "", 0, fmt.Errorf("state output %s: %w", after, err)
+		}
+		if !ok {
+			continue
+		}
+		if _, err := q.UpdateStateVersionOutputValue(ctx, value, row.StateVersionOutputID); err != nil {
+			return "", 0, sql.Error(err)
+		}
+		n++
+	}
+	return after, n, nil
+}
+
+func (r *reencrypter) plans(ctx context.Context, q pggen.Querier, after string) (string, int, error) {
+	rows, err := q.FindPlanFilesForReencryption(ctx, sql.String(after), sql.Int8(reencryptBatchSize))
+	if err != nil {
+		return "", 0, sql.Error(err)
+	}
+	var n int
+	for _, row := range rows {
+		after = row.RunID.String
+		// keyed by plan format
+		for format, file := range map[string][]byte{"bin": row.PlanBin, "json": row.PlanJSON} {
+			reencrypted, ok, err := r.blob(ctx, blob.PlanKey(after, format), file)
+			if err != nil {
+				return "", 0, fmt.Errorf("plan file for run %s: %w", after, err)
+			}
+			if !ok {
+				continue
+			}
+			n++
+			if file == nil {
+				// re-encrypted in place in the blob store
+				continue
+			}
+			switch format {
+			case "bin":
+				_, err = q.UpdatePlanBinByID(ctx, reencrypted, row.RunID)
+			case "json":
+				_, err = q.UpdatePlanJSONByID(ctx, reencrypted, row.RunID)
+			}
+			if err != nil {
+				return "", 0, sql.Error(err)
+			}
+		}
+	}
+	return after, n, nil
+}
+
+// blob re-encrypts data that is either inline, i.e. persisted in the database,
+// or persisted in the blob store. Inline data is returned for the caller to
+// persist, whereas data in the blob store is persisted in place. False is
+// returned if the data does not exist or does not need re-encrypting.
+func (r *reencrypter) blob(ctx context.Context, key string, inline []byte) ([]byte, bool, error) {
+	data, err := blob.Load(ctx, r.store, key, inline)
+	if errors.Is(err, internal.ErrResourceNotFound) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	data, ok, err := r.reencrypt(data)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	if inline == nil {
+		if err := r.store.Put(ctx, key, data); err != nil {
+			return nil, false, err
+		}
+	}
+	return data, true, nil
+}
+
+// reencrypt re-encrypts data with the primary key, returning false if the data
+// is nil or is already encrypted with the primary key.
+func (r *reencrypter) reencrypt(data []byte) ([]byte, bool, error) {
+	if data == nil || !r.keyring.NeedsRotation(data) {
+		return nil, false, nil
+	}
+	plaintext, err := r.keyring.Decrypt(data)
+	if err != nil {
+		return nil, false, err
+	}
+	encrypted, err := r.keyring.Encrypt(plaintext)
+	if err != nil {
+		return nil, false, err
+	}
+	return encrypted, true, nil
+}
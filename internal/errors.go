@@ -52,6 +52,18 @@ var (
 	ErrStatusTimestampNotFound = errors.New("corresponding status timestamp not found")
 
 	ErrInvalidRepo = errors.New("repository path is invalid")
+
+	// ErrInvalidLabel is returned when an agent label has an invalid key or
+	// value.
+	ErrInvalidLabel = errors.New("invalid label")
+
+	// ErrInvalidLabelSelector is returned when a label selector cannot be
+	// parsed.
+	ErrInvalidLabelSelector = errors.New("invalid label selector")
+
+	// ErrInvalidTimeout is returned when a run phase timeout is not a
+	// positive number of minutes.
+	ErrInvalidTimeout = errors.New("invalid timeout: must be a positive number of minutes")
 )
 
 type (
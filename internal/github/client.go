@@ -472,6 +472,45 @@ func (g *Client) SetStatus(ctx context.Context, opts vcs.SetStatusOptions) error
 	return err
 }
 
+func (g *Client) CreatePullRequestComment(ctx context.Context, opts vcs.CreatePullRequestCommentOptions) (string, error) {
+	owner, name, found := strings.Cut(opts.Repo, "/")
+	if !found {
+		return "", fmt.Errorf("malformed identifier: %s", opts.Repo)
+	}
+
+	// pull requests are issues as far as comments are concerned
+	comment, _, err := g.client.Issues.CreateComment(ctx, owner, name, opts.PullRequestNumber, &github.IssueComment{
+		Body: internal.String(opts.Body),
+	})
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(comment.GetID(), 10), nil
+}
+
+func (g *Client) UpdatePullRequestComment(ctx context.Context, opts vcs.UpdatePullRequestCommentOptions) error {
+	owner, name, found := strings.Cut(opts.Repo, "/")
+	if !found {
+		return fmt.Errorf("malformed identifier: %s", opts.Repo)
+	}
+
+	intID, err := strconv.ParseInt(opts.ID, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, resp, err := g.client.Issues.EditComment(ctx, owner, name, intID, &github.IssueComment{
+		Body: internal.String(opts.Body),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return internal.ErrResourceNotFound
+		}
+		return err
+	}
+	return nil
+}
+
 func (g *Client) ListPullRequestFiles(ctx context.Context, repo string, pull int) ([]string, error) {
 	owner, name, found := strings.Cut(repo, "/")
 	if !found {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/tofutf/tofutf/internal"
@@ -63,7 +64,7 @@ func (a *Service) AddHandlers(r *mux.Router) {
 }
 
 func (a *Service) CreateApp(ctx context.Context, opts CreateAppOptions) (*App, error) {
-	subject, err := a.site.CanAccess(ctx, rbac.CreateGithubAppAction, "")
+	subject, err := a.site.CanAccess(ctx, rbac.CreateGithubAppAction, strconv.FormatInt(opts.AppID, 10))
 	if err != nil {
 		return nil, err
 	}
@@ -97,18 +98,23 @@ func (a *Service) GetApp(ctx context.Context) (*App, error) {
 }
 
 func (a *Service) DeleteApp(ctx context.Context) error {
-	subject, err := a.site.CanAccess(ctx, rbac.DeleteGithubAppAction, "")
+	// retrieve the app in order to record its ID with the audit event
+	app, err := a.db.get(ctx)
+	if err != nil {
+		return err
+	}
+	subject, err := a.site.CanAccess(ctx, rbac.DeleteGithubAppAction, strconv.FormatInt(app.ID, 10))
 	if err != nil {
 		return err
 	}
 
 	err = a.db.delete(ctx)
 	if err != nil {
-		a.logger.Error("deleting github app", "subject", subject, "err", err)
+		a.logger.Error("deleting github app", "app", app, "subject", subject, "err", err)
 		return err
 	}
 
-	a.logger.Info("deleted github app", "subject", subject)
+	a.logger.Info("deleted github app", "app", app, "subject", subject)
 	return nil
 }
 
@@ -170,6 +176,11 @@ func (a *Service) GetInstallCredentials(ctx context.Context, installID int64) (*
 }
 
 func (a *Service) DeleteInstallation(ctx context.Context, installID int64) error {
+	subject, err := a.site.CanAccess(ctx, rbac.DeleteGithubAppInstallAction, strconv.FormatInt(installID, 10))
+	if err != nil {
+		return err
+	}
+
 	app, err := a.db.get(ctx)
 	if err != nil {
 		return err
@@ -179,8 +190,10 @@ func (a *Service) DeleteInstallation(ctx context.Context, installID int64) error
 		return err
 	}
 	if err := client.DeleteInstallation(ctx, installID); err != nil {
+		a.logger.Error("deleting github app installation", "install", installID, "subject", subject, "err", err)
 		return err
 	}
+	a.logger.Info("deleted github app installation", "install", installID, "subject", subject)
 	return nil
 }
 
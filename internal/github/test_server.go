@@ -11,6 +11,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"path"
+	"strconv"
 	"testing"
 	"time"
 
@@ -34,6 +36,8 @@ type (
 	TestServer struct {
 		// status updates received from otfd
 		statuses chan *github.StatusEvent
+		// pull request comments created or updated by otfd
+		comments chan *github.IssueComment
 
 		// webhook created/updated/deleted events channel
 		WebhookEvents chan webhookEvent
@@ -57,6 +61,8 @@ type (
 		// pull request stub
 		pullNumber string
 		pullFiles  []string
+		// ID of the most recently created pull request comment
+		lastCommentID int64
 
 		// url of server, only populated once server starts
 		url *string
@@ -82,6 +88,7 @@ func NewTestServer(t *testing.T, opts ...TestServerOption) (*TestServer, *url.UR
 	srv := TestServer{
 		testdb:        &testdb{},
 		statuses:      make(chan *github.StatusEvent, 999),
+		comments:      make(chan *github.IssueComment, 999),
 		WebhookEvents: make(chan webhookEvent, 999),
 		mux:           http.NewServeMux(),
 	}
@@ -250,6 +257,49 @@ func NewTestServer(t *testing.T, opts ...TestServerOption) (*TestServer, *url.UR
 			srv.statuses <- &commit
 			w.WriteHeader(http.StatusCreated)
 		})
+		// https://docs.github.com/en/rest/issues/comments?apiVersion=2022-11-28#create-an-issue-comment
+		srv.mux.HandleFunc("/api/v3/repos/"+*srv.repo+"/issues/"+srv.pullNumber+"/comments", func(w http.ResponseWriter, r *http.Request) {
+			var comment github.IssueComment
+			if err := json.NewDecoder(r.Body).Decode(&comment); err != nil {
+				http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+				return
+			}
+			srv.lastCommentID++
+			comment.ID = internal.Int64(srv.lastCommentID)
+			srv.comments <- &comment
+
+			out, err := json.Marshal(&comment)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+				return
+			}
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			w.Write(out) //nolint:errcheck
+		})
+		// https://docs.github.com/en/rest/issues/comments?apiVersion=2022-11-28#update-an-issue-comment
+		srv.mux.HandleFunc("/api/v3/repos/"+*srv.repo+"/issues/comments/", func(w http.ResponseWriter, r *http.Request) {
+			var comment github.IssueComment
+			if err := json.NewDecoder(r.Body).Decode(&comment); err != nil {
+				http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+				return
+			}
+			id, err := strconv.ParseInt(path.Base(r.URL.Path), 10, 64)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			comment.ID = internal.Int64(id)
+			srv.comments <- &comment
+
+			out, err := json.Marshal(&comment)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+				return
+			}
+			w.Header().Add("Content-Type", "application/json")
+			w.Write(out) //nolint:errcheck
+		})
 		// https://docs.github.com/en/rest/pulls/pulls?apiVersion=2022-11-28#list-pull-requests-files
 		srv.mux.HandleFunc("/api/v3/repos/"+*srv.repo+"/pulls/"+srv.pullNumber+"/files", func(w http.ResponseWriter, r *http.Request) {
 			var commits []*github.CommitFile
@@ -384,6 +434,23 @@ func (s *TestServer) GetStatus(t *testing.T, ctx context.Context) *github.Status
 	return nil
 }
 
+// GetComment retrieves a created or updated pull request comment off the
+// queue, timing out after 10 seconds if nothing is on the queue.
+func (s *TestServer) GetComment(t *testing.T, ctx context.Context) *github.IssueComment {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	select {
+	case comment := <-s.comments:
+		return comment
+	case <-ctx.Done():
+		t.Fatalf("github server: waiting to receive pull request comment: %s", ctx.Err().Error())
+	}
+	return nil
+}
+
 // SendEventRequest sends a GitHub event via a http request to the url, signed with the secret,
 func SendEventRequest(t *testing.T, event GithubEvent, url, secret string, payload []byte) {
 	t.Helper()
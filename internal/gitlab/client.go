@@ -276,6 +276,34 @@ func (g *Client) SetStatus(ctx context.Context, opts vcs.SetStatusOptions) error
 	return nil
 }
 
+func (g *Client) CreatePullRequestComment(ctx context.Context, opts vcs.CreatePullRequestCommentOptions) (string, error) {
+	note, _, err := g.client.Notes.CreateMergeRequestNote(opts.Repo, opts.PullRequestNumber, &gitlab.CreateMergeRequestNoteOptions{
+		Body: internal.String(opts.Body),
+	})
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(note.ID), nil
+}
+
+func (g *Client) UpdatePullRequestComment(ctx context.Context, opts vcs.UpdatePullRequestCommentOptions) error {
+	id, err := strconv.Atoi(opts.ID)
+	if err != nil {
+		return err
+	}
+
+	_, resp, err := g.client.Notes.UpdateMergeRequestNote(opts.Repo, opts.PullRequestNumber, id, &gitlab.UpdateMergeRequestNoteOptions{
+		Body: internal.String(opts.Body),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return internal.ErrResourceNotFound
+		}
+		return err
+	}
+	return nil
+}
+
 func (g *Client) ListPullRequestFiles(ctx context.Context, repo string, pull int) ([]string, error) {
 	diffs, _, err := g.client.MergeRequests.ListMergeRequestDiffs(repo, pull, &gitlab.ListMergeRequestDiffsOptions{})
 	if err != nil {
@@ -17,6 +17,12 @@ func init() {
 
 	funcmap["adminLoginPath"] = AdminLogin
 
+	funcmap["auditEventsPath"] = AuditEvents
+
+	funcmap["staleTokensPath"] = StaleTokens
+
+	funcmap["revokeStaleTokensPath"] = RevokeStaleTokens
+
 	funcmap["profilePath"] = Profile
 
 	funcmap["tokensPath"] = Tokens
@@ -67,6 +73,9 @@ func init() {
 	funcmap["createTagWorkspacePath"] = CreateTagWorkspace
 	funcmap["deleteTagWorkspacePath"] = DeleteTagWorkspace
 	funcmap["stateWorkspacePath"] = StateWorkspace
+	funcmap["runTriggersWorkspacePath"] = RunTriggersWorkspace
+	funcmap["createRunTriggerWorkspacePath"] = CreateRunTriggerWorkspace
+	funcmap["deleteRunTriggerWorkspacePath"] = DeleteRunTriggerWorkspace
 	funcmap["poolsWorkspacePath"] = PoolsWorkspace
 
 	funcmap["runsPath"] = Runs
@@ -83,6 +92,7 @@ func init() {
 	funcmap["retryRunPath"] = RetryRun
 	funcmap["tailRunPath"] = TailRun
 	funcmap["widgetRunPath"] = WidgetRun
+	funcmap["overridePolicyCheckRunPath"] = OverridePolicyCheckRun
 
 	funcmap["variablesPath"] = Variables
 	funcmap["createVariablePath"] = CreateVariable
@@ -92,6 +102,14 @@ func init() {
 	funcmap["updateVariablePath"] = UpdateVariable
 	funcmap["deleteVariablePath"] = DeleteVariable
 
+	funcmap["schedulesPath"] = Schedules
+	funcmap["createSchedulePath"] = CreateSchedule
+	funcmap["newSchedulePath"] = NewSchedule
+	funcmap["schedulePath"] = Schedule
+	funcmap["editSchedulePath"] = EditSchedule
+	funcmap["updateSchedulePath"] = UpdateSchedule
+	funcmap["deleteSchedulePath"] = DeleteSchedule
+
 	funcmap["agentsPath"] = Agents
 	funcmap["createAgentPath"] = CreateAgent
 	funcmap["newAgentPath"] = NewAgent
@@ -133,6 +151,32 @@ func init() {
 	funcmap["updateVariableSetVariablePath"] = UpdateVariableSetVariable
 	funcmap["deleteVariableSetVariablePath"] = DeleteVariableSetVariable
 
+	funcmap["projectsPath"] = Projects
+	funcmap["createProjectPath"] = CreateProject
+	funcmap["newProjectPath"] = NewProject
+	funcmap["projectPath"] = Project
+	funcmap["editProjectPath"] = EditProject
+	funcmap["updateProjectPath"] = UpdateProject
+	funcmap["deleteProjectPath"] = DeleteProject
+	funcmap["setPermissionProjectPath"] = SetPermissionProject
+	funcmap["unsetPermissionProjectPath"] = UnsetPermissionProject
+
+	funcmap["policySetsPath"] = PolicySets
+	funcmap["createPolicySetPath"] = CreatePolicySet
+	funcmap["newPolicySetPath"] = NewPolicySet
+	funcmap["policySetPath"] = PolicySet
+	funcmap["editPolicySetPath"] = EditPolicySet
+	funcmap["updatePolicySetPath"] = UpdatePolicySet
+	funcmap["deletePolicySetPath"] = DeletePolicySet
+
+	funcmap["policiesPath"] = Policies
+	funcmap["createPolicyPath"] = CreatePolicy
+	funcmap["newPolicyPath"] = NewPolicy
+	funcmap["policyPath"] = Policy
+	funcmap["editPolicyPath"] = EditPolicy
+	funcmap["updatePolicyPath"] = UpdatePolicy
+	funcmap["deletePolicyPath"] = DeletePolicy
+
 	funcmap["organizationTokenPath"] = OrganizationToken
 	funcmap["createOrganizationTokenPath"] = CreateOrganizationToken
 	funcmap["deleteOrganizationTokenPath"] = DeleteOrganizationToken
@@ -76,6 +76,8 @@ type controllerSpec struct {
 	skipDefaultActions bool
 	camel              string
 	lowerCamel         string
+	// plural form of name, if not merely the name suffixed with an 's'
+	plural string
 	// disable site-wide prefix
 	noprefix bool
 
@@ -92,6 +94,7 @@ type controller struct {
 	skipDefaultActions bool
 	camel              string
 	lowerCamel         string
+	plural             string
 	// disable site-wide prefix
 	noprefix bool
 
@@ -118,6 +121,21 @@ var specs = []controllerSpec{
 		path:           "/admin/login",
 		noprefix:       true,
 	},
+	{
+		Name:           "audit_events",
+		controllerType: singlePath,
+		path:           "/admin/audit-events",
+	},
+	{
+		Name:           "stale_tokens",
+		controllerType: singlePath,
+		path:           "/admin/stale-tokens",
+	},
+	{
+		Name:           "revoke_stale_tokens",
+		controllerType: singlePath,
+		path:           "/admin/stale-tokens/revoke",
+	},
 	{
 		Name:           "profile",
 		controllerType: singlePath,
@@ -209,6 +227,15 @@ var specs = []controllerSpec{
 					{
 						name: "state",
 					},
+					{
+						name: "run-triggers",
+					},
+					{
+						name: "create-run-trigger",
+					},
+					{
+						name: "delete-run-trigger",
+					},
 					{
 						name: "pools",
 					},
@@ -239,12 +266,19 @@ var specs = []controllerSpec{
 							{
 								name: "widget",
 							},
+							{
+								name: "override-policy-check",
+							},
 						},
 					},
 					{
 						Name:           "variable",
 						controllerType: resourcePath,
 					},
+					{
+						Name:           "schedule",
+						controllerType: resourcePath,
+					},
 				},
 			},
 			{
@@ -277,6 +311,29 @@ var specs = []controllerSpec{
 					},
 				},
 			},
+			{
+				Name:           "project",
+				controllerType: resourcePath,
+				actions: []action{
+					{
+						name: "set-permission",
+					},
+					{
+						name: "unset-permission",
+					},
+				},
+			},
+			{
+				Name:           "policy_set",
+				controllerType: resourcePath,
+				nested: []controllerSpec{
+					{
+						Name:           "policy",
+						controllerType: resourcePath,
+						plural:         "policies",
+					},
+				},
+			},
 			{
 				Name:               "organization_token",
 				controllerType:     resourcePath,
@@ -355,6 +412,14 @@ func (r controller) LowerCamel() string {
 	return strcase.ToLowerCamel(r.Name)
 }
 
+// PluralPath returns the path to the collection of resources.
+func (r controller) PluralPath() string {
+	if r.plural != "" {
+		return "/" + strcase.ToKebab(r.plural)
+	}
+	return r.Path() + "s"
+}
+
 // FormatString returns a format string for use with fmt.Sprintf within a
 // template for a path helper.
 func (r controller) FormatString(action action) string {
@@ -370,13 +435,11 @@ func (r controller) FormatString(action action) string {
 	}
 	if action.collection {
 		if r.Parent != nil {
-			b.WriteString(r.Parent.Path())
-			b.WriteString("s")
+			b.WriteString(r.Parent.PluralPath())
 			b.WriteString("/%s")
 		}
 	}
-	b.WriteString(r.Path())
-	b.WriteString("s")
+	b.WriteString(r.PluralPath())
 	if action.name == "list" {
 		// list has no explict action specified in the path
 		return b.String()
@@ -411,6 +474,9 @@ func (r controller) HelperName(action action) string {
 		return r.Camel()
 	case "list":
 		// list path helper is merely the plural form of the resource name
+		if r.plural != "" {
+			return strcase.ToCamel(r.plural)
+		}
 		return r.Camel() + "s"
 	default:
 		return strcase.ToCamel(action.name) + r.Camel()
@@ -425,6 +491,9 @@ func (r controller) FuncMapName(action action) string {
 		return r.LowerCamel() + "Path"
 	case "list":
 		// list funcmap name is merely the plural form of the resource name
+		if r.plural != "" {
+			return strcase.ToLowerCamel(r.plural) + "Path"
+		}
 		return r.LowerCamel() + "sPath"
 	default:
 		// funcmap names for all other actions include their name followed by
@@ -527,6 +596,7 @@ func buildControllers(parent *controller, specs []controllerSpec) []controller {
 			Name:               spec.Name,
 			camel:              spec.camel,
 			lowerCamel:         spec.lowerCamel,
+			plural:             spec.plural,
 			path:               spec.path,
 			Parent:             parent,
 			controllerType:     spec.controllerType,
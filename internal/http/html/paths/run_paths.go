@@ -59,3 +59,7 @@ func TailRun(run string) string {
 func WidgetRun(run string) string {
 	return fmt.Sprintf("/app/runs/%s/widget", run)
 }
+
+func OverridePolicyCheckRun(run string) string {
+	return fmt.Sprintf("/app/runs/%s/override-policy-check", run)
+}
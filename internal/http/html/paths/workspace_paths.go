@@ -88,6 +88,18 @@ func StateWorkspace(workspace string) string {
 	return fmt.Sprintf("/app/workspaces/%s/state", workspace)
 }
 
+func RunTriggersWorkspace(workspace string) string {
+	return fmt.Sprintf("/app/workspaces/%s/run-triggers", workspace)
+}
+
+func CreateRunTriggerWorkspace(workspace string) string {
+	return fmt.Sprintf("/app/workspaces/%s/create-run-trigger", workspace)
+}
+
+func DeleteRunTriggerWorkspace(workspace string) string {
+	return fmt.Sprintf("/app/workspaces/%s/delete-run-trigger", workspace)
+}
+
 func PoolsWorkspace(workspace string) string {
 	return fmt.Sprintf("/app/workspaces/%s/pools", workspace)
 }
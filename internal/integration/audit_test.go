@@ -0,0 +1,55 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/audit"
+	"github.com/tofutf/tofutf/internal/rbac"
+)
+
+// TestIntegration_Audit tests that mutating actions carried out via the API are
+// recorded in the audit log.
+func TestIntegration_Audit(t *testing.T) {
+	integrationTest(t)
+
+	daemon, org, ctx := setup(t, nil)
+	ws := daemon.createWorkspace(t, ctx, org)
+
+	// lock and unlock workspace via CLI, which goes through the API
+	daemon.otfcli(t, ctx, "workspaces", "lock", ws.Name, "--organization", org.Name)
+	daemon.otfcli(t, ctx, "workspaces", "unlock", ws.Name, "--organization", org.Name)
+
+	page, err := daemon.Audit.List(ctx, audit.ListOptions{
+		Organization: internal.String(org.Name),
+		ResourceID:   internal.String(ws.ID),
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Items, 2)
+
+	// most recent first
+	assert.Equal(t, rbac.UnlockWorkspaceAction, page.Items[0].Action)
+	assert.Equal(t, rbac.LockWorkspaceAction, page.Items[1].Action)
+	for _, event := range page.Items {
+		assert.Equal(t, org.Name, event.Organization)
+		assert.Equal(t, "POST", event.Request.Method)
+		assert.Equal(t, 200, event.Request.StatusCode)
+	}
+
+	// read-only actions are not recorded
+	daemon.otfcli(t, ctx, "workspaces", "show", ws.Name, "--organization", org.Name)
+	page, err = daemon.Audit.List(ctx, audit.ListOptions{
+		Organization: internal.String(org.Name),
+		ResourceID:   internal.String(ws.ID),
+	})
+	require.NoError(t, err)
+	assert.Len(t, page.Items, 2)
+
+	// list events via CLI
+	out := daemon.otfcli(t, ctx, "audit", "list", "--organization", org.Name, "--action", "LockWorkspaceAction")
+	assert.Contains(t, out, ws.ID)
+	assert.Contains(t, out, "LockWorkspaceAction")
+	assert.NotContains(t, out, "UnlockWorkspaceAction")
+}
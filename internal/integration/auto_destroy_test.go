@@ -0,0 +1,63 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/workspace"
+)
+
+func TestIntegration_AutoDestroy(t *testing.T) {
+	integrationTest(t)
+
+	daemon, org, ctx := setup(t, nil)
+	deadline := time.Now().Add(time.Hour).Truncate(time.Microsecond).UTC()
+
+	ws, err := daemon.Workspaces.Create(ctx, workspace.CreateOptions{
+		Name:                        internal.String("preview-" + internal.GenerateRandomString(4)),
+		Organization:                &org.Name,
+		AutoDestroyAt:               &deadline,
+		AutoDestroyActivityDuration: internal.String("14d"),
+		DeleteAfterAutoDestroy:      internal.Bool(true),
+	})
+	require.NoError(t, err)
+
+	got, err := daemon.Workspaces.Get(ctx, ws.ID)
+	require.NoError(t, err)
+	assert.Equal(t, deadline, *got.AutoDestroyAt)
+	assert.Equal(t, "14d", *got.AutoDestroyActivityDuration)
+	assert.True(t, got.DeleteAfterAutoDestroy)
+
+	// workspace without auto-destroy should not be listed
+	_ = daemon.createWorkspace(t, ctx, org)
+
+	listed, err := daemon.Workspaces.ListForAutoDestroy(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(listed))
+	assert.Equal(t, ws.ID, listed[0].ID)
+
+	t.Run("record destroy run", func(t *testing.T) {
+		r := daemon.createRun(t, ctx, ws, nil)
+
+		updated, err := daemon.Workspaces.SetAutoDestroyRun(ctx, ws.ID, r.ID)
+		require.NoError(t, err)
+		assert.Equal(t, r.ID, *updated.AutoDestroyRunID)
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		updated, err := daemon.Workspaces.Update(ctx, ws.ID, workspace.UpdateOptions{
+			ClearAutoDestroyAt:          true,
+			AutoDestroyActivityDuration: internal.String(""),
+		})
+		require.NoError(t, err)
+		assert.Nil(t, updated.AutoDestroyAt)
+		assert.Nil(t, updated.AutoDestroyActivityDuration)
+
+		listed, err := daemon.Workspaces.ListForAutoDestroy(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(listed))
+	})
+}
@@ -45,9 +45,9 @@ type (
 		downloader
 	}
 
-	// downloader downloads terraform versions
+	// downloader downloads terraform and tofu versions
 	downloader interface {
-		Download(ctx context.Context, version string, w io.Writer) (string, error)
+		Download(ctx context.Context, engine releases.Engine, version string, w io.Writer) (string, error)
 	}
 
 	// configures the daemon for integration tests
@@ -507,7 +507,7 @@ func (s *testDaemon) downloadTerraform(t *testing.T, ctx context.Context, versio
 	if version == nil {
 		version = internal.String(releases.DefaultTerraformVersion)
 	}
-	tfpath, err := s.Download(ctx, *version, io.Discard)
+	tfpath, err := s.Download(ctx, releases.TerraformEngine, *version, io.Discard)
 	require.NoError(t, err)
 	return tfpath
 }
@@ -76,5 +76,12 @@ func TestGithubPullRequest(t *testing.T) {
 		got := daemon.GetStatus(t, ctx)
 		require.Equal(t, "success", got.GetState())
 		require.Equal(t, "planned: +2/~0/−0", got.GetDescription())
+
+		// github should receive a comment on the pull request summarising the
+		// plan; the second run updates the comment created by the first run.
+		comment := daemon.GetComment(t, ctx)
+		require.Equal(t, int64(1), comment.GetID())
+		require.Contains(t, comment.GetBody(), "**Planned**: +2/~0/−0")
+		require.Contains(t, comment.GetBody(), event.commit)
 	}
 }
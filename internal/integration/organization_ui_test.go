@@ -35,7 +35,7 @@ func TestIntegration_OrganizationUI(t *testing.T) {
 		chromedp.Clear("input#name", chromedp.ByQuery),
 		input.InsertText("super-duper-org"),
 		screenshot(t),
-		chromedp.Click(`//button[text()='Update organization name']`),
+		chromedp.Click(`//button[text()='Update organization']`),
 		screenshot(t),
 		matchText(t, "//div[@role='alert']", "updated organization"),
 		// delete the organization
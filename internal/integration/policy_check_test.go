@@ -0,0 +1,75 @@
+package integration
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/policy"
+	"github.com/tofutf/tofutf/internal/run"
+)
+
+// TestIntegration_PolicyCheck demonstrates a policy check failing a run and an
+// owner overriding the failure.
+func TestIntegration_PolicyCheck(t *testing.T) {
+	integrationTest(t)
+
+	daemon, org, ctx := setup(t, nil)
+
+	set, err := daemon.Policies.CreatePolicySet(ctx, org.Name, policy.CreatePolicySetOptions{
+		Name: internal.String("default"),
+	})
+	require.NoError(t, err)
+	_, err = daemon.Policies.CreatePolicy(ctx, set.ID, policy.CreatePolicyOptions{
+		Name:             internal.String("no-foo"),
+		EnforcementLevel: policy.SoftMandatory,
+		Source: `package terraform
+
+deny[msg] {
+	input.output_changes.foo
+	msg := "output foo is forbidden"
+}
+`,
+	})
+	require.NoError(t, err)
+
+	root := t.TempDir()
+	err = os.WriteFile(filepath.Join(root, "main.tf"), []byte(`output "foo" { value = "bar" }`), 0o777)
+	require.NoError(t, err)
+	tarball, err := internal.Pack(root)
+	require.NoError(t, err)
+
+	ws := daemon.createWorkspace(t, ctx, org)
+	cv := daemon.createConfigurationVersion(t, ctx, ws, nil)
+	err = daemon.Configs.UploadConfig(ctx, cv.ID, tarball)
+	require.NoError(t, err)
+
+	sub, unsub := daemon.Runs.Watch(ctx)
+	defer unsub()
+	_ = daemon.createRun(t, ctx, ws, cv)
+
+	for event := range sub {
+		switch event.Payload.Status {
+		case run.RunPolicySoftFailed:
+			check, err := daemon.Runs.GetPolicyCheckByRunID(ctx, event.Payload.ID)
+			require.NoError(t, err)
+			assert.Equal(t, run.PolicyCheckSoftFailed, check.Status)
+			require.Len(t, check.Results, 1)
+			assert.Equal(t, []string{"output foo is forbidden"}, check.Results[0].Violations)
+
+			check, err = daemon.Runs.OverridePolicyCheck(ctx, check.ID)
+			require.NoError(t, err)
+			assert.Equal(t, run.PolicyCheckOverridden, check.Status)
+		case run.RunPolicyChecked:
+			err := daemon.Runs.Apply(ctx, event.Payload.ID)
+			require.NoError(t, err)
+		case run.RunApplied:
+			return
+		case run.RunErrored, run.RunPlannedAndFinished:
+			t.Fatalf("run unexpectedly finished with status %s", event.Payload.Status)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/user"
+	"github.com/tofutf/tofutf/internal/workspace"
+)
+
+func TestIntegration_ProjectService(t *testing.T) {
+	integrationTest(t)
+
+	t.Run("create", func(t *testing.T) {
+		daemon, org, ctx := setup(t, nil)
+
+		project, err := daemon.Workspaces.CreateProject(ctx, org.Name, workspace.CreateProjectOptions{
+			Name: internal.String("networking"),
+		})
+		require.NoError(t, err)
+
+		t.Run("duplicate", func(t *testing.T) {
+			_, err := daemon.Workspaces.CreateProject(ctx, org.Name, workspace.CreateProjectOptions{
+				Name: internal.String(project.Name),
+			})
+			assert.ErrorIs(t, err, internal.ErrResourceAlreadyExists)
+		})
+	})
+
+	t.Run("update", func(t *testing.T) {
+		daemon, org, ctx := setup(t, nil)
+		project, err := daemon.Workspaces.CreateProject(ctx, org.Name, workspace.CreateProjectOptions{
+			Name: internal.String("networking"),
+		})
+		require.NoError(t, err)
+
+		got, err := daemon.Workspaces.UpdateProject(ctx, project.ID, workspace.UpdateProjectOptions{
+			Description: internal.String("all things networking"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "all things networking", got.Description)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		daemon, org, ctx := setup(t, nil)
+		networking, err := daemon.Workspaces.CreateProject(ctx, org.Name, workspace.CreateProjectOptions{
+			Name: internal.String("networking"),
+		})
+		require.NoError(t, err)
+		compute, err := daemon.Workspaces.CreateProject(ctx, org.Name, workspace.CreateProjectOptions{
+			Name: internal.String("compute"),
+		})
+		require.NoError(t, err)
+
+		page, err := daemon.Workspaces.ListProjects(ctx, org.Name, workspace.ListProjectsOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(page.Items))
+		assert.Contains(t, page.Items, networking)
+		assert.Contains(t, page.Items, compute)
+
+		t.Run("search", func(t *testing.T) {
+			page, err := daemon.Workspaces.ListProjects(ctx, org.Name, workspace.ListProjectsOptions{
+				Search: "net",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, []*workspace.Project{networking}, page.Items)
+		})
+	})
+
+	t.Run("workspaces", func(t *testing.T) {
+		daemon, org, ctx := setup(t, nil)
+		project, err := daemon.Workspaces.CreateProject(ctx, org.Name, workspace.CreateProjectOptions{
+			Name: internal.String("networking"),
+		})
+		require.NoError(t, err)
+		ws, err := daemon.Workspaces.Create(ctx, workspace.CreateOptions{
+			Name:         internal.String("dev"),
+			Organization: internal.String(org.Name),
+			ProjectID:    internal.String(project.ID),
+		})
+		require.NoError(t, err)
+		_ = daemon.createWorkspace(t, ctx, org)
+
+		t.Run("filter by project", func(t *testing.T) {
+			page, err := daemon.Workspaces.List(ctx, workspace.ListOptions{
+				Organization: internal.String(org.Name),
+				ProjectID:    internal.String(project.ID),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, []*workspace.Workspace{ws}, page.Items)
+		})
+
+		t.Run("project in different organization", func(t *testing.T) {
+			other := daemon.createWorkspace(t, ctx, nil)
+			_, err := daemon.Workspaces.Update(ctx, other.ID, workspace.UpdateOptions{
+				ProjectID: internal.String(project.ID),
+			})
+			assert.ErrorIs(t, err, workspace.ErrProjectOrganizationMismatch)
+		})
+
+		t.Run("cannot delete project with workspaces", func(t *testing.T) {
+			err := daemon.Workspaces.DeleteProject(ctx, project.ID)
+			assert.ErrorIs(t, err, workspace.ErrProjectNotEmpty)
+		})
+
+		t.Run("delete project after removing workspaces", func(t *testing.T) {
+			_, err := daemon.Workspaces.Update(ctx, ws.ID, workspace.UpdateOptions{
+				ProjectID: internal.String(""),
+			})
+			require.NoError(t, err)
+
+			err = daemon.Workspaces.DeleteProject(ctx, project.ID)
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("permissions", func(t *testing.T) {
+		daemon, org, ctx := setup(t, nil)
+		project, err := daemon.Workspaces.CreateProject(ctx, org.Name, workspace.CreateProjectOptions{
+			Name: internal.String("networking"),
+		})
+		require.NoError(t, err)
+		ws, err := daemon.Workspaces.Create(ctx, workspace.CreateOptions{
+			Name:         internal.String("dev"),
+			Organization: internal.String(org.Name),
+			ProjectID:    internal.String(project.ID),
+		})
+		require.NoError(t, err)
+		team := daemon.createTeam(t, ctx, org)
+
+		perm, err := daemon.Workspaces.SetProjectPermission(ctx, project.ID, team.ID, rbac.WorkspaceWriteRole)
+		require.NoError(t, err)
+		assert.Equal(t, team.ID, perm.TeamID)
+
+		t.Run("applied to workspace policy", func(t *testing.T) {
+			policy, err := daemon.Workspaces.GetPolicy(ctx, ws.ID)
+			require.NoError(t, err)
+			assert.Empty(t, policy.Permissions)
+			assert.Equal(t, []internal.WorkspacePermission{
+				{TeamID: team.ID, Role: rbac.WorkspaceWriteRole},
+			}, policy.ProjectPermissions)
+		})
+
+		t.Run("team member can list workspace", func(t *testing.T) {
+			member := daemon.createUser(t, user.WithTeams(team))
+			ctx := internal.AddSubjectToContext(ctx, member)
+			page, err := daemon.Workspaces.List(ctx, workspace.ListOptions{
+				Organization: internal.String(org.Name),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, []*workspace.Workspace{ws}, page.Items)
+		})
+
+		t.Run("unset", func(t *testing.T) {
+			err := daemon.Workspaces.UnsetProjectPermission(ctx, project.ID, team.ID)
+			require.NoError(t, err)
+
+			perms, err := daemon.Workspaces.ListProjectPermissions(ctx, project.ID)
+			require.NoError(t, err)
+			assert.Empty(t, perms)
+		})
+	})
+}
@@ -0,0 +1,89 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/module"
+)
+
+// TestIntegration_RegistryModule demonstrates publishing a module to the
+// private registry by uploading it via the TFE API.
+func TestIntegration_RegistryModule(t *testing.T) {
+	integrationTest(t)
+
+	daemon, org, ctx := setup(t, nil)
+	_, token := daemon.createToken(t, ctx, nil)
+
+	client, err := tfe.NewClient(&tfe.Config{
+		Address:           "https://" + daemon.System.Hostname(),
+		Token:             string(token),
+		RetryServerErrors: true,
+	})
+	require.NoError(t, err)
+
+	// create module and version
+	_, err = client.RegistryModules.Create(ctx, org.Name, tfe.RegistryModuleCreateOptions{
+		Name:     internal.String("vpc"),
+		Provider: internal.String("aws"),
+	})
+	require.NoError(t, err)
+	version, err := client.RegistryModules.CreateVersion(ctx, tfe.RegistryModuleID{
+		Organization: org.Name,
+		Name:         "vpc",
+		Provider:     "aws",
+	}, tfe.RegistryModuleCreateVersionOptions{
+		Version: internal.String("1.0.0"),
+	})
+	require.NoError(t, err)
+
+	// upload module from a directory
+	dir := t.TempDir()
+	err = os.WriteFile(filepath.Join(dir, "main.tf"), []byte(`output "foo" { value = "bar" }`), 0o644)
+	require.NoError(t, err)
+	err = client.RegistryModules.Upload(ctx, *version, dir)
+	require.NoError(t, err)
+
+	// module version should now be available
+	got, err := daemon.Modules.GetModule(ctx, module.GetModuleOptions{
+		Organization: org.Name,
+		Name:         "vpc",
+		Provider:     "aws",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, module.ModuleStatusSetupComplete, got.Status)
+	if assert.NotNil(t, got.Latest()) {
+		assert.Equal(t, "1.0.0", got.Latest().Version)
+	}
+
+	// retrieve the module version's details
+	u := fmt.Sprintf("https://%s/api/registry/v1/modules/%s/vpc/aws/1.0.0", daemon.System.Hostname(), org.Name)
+	r, err := http.NewRequest("GET", u, nil)
+	require.NoError(t, err)
+	r.Header.Add("Authorization", "Bearer "+string(token))
+	resp, err := http.DefaultClient.Do(r)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, 200, resp.StatusCode)
+	var details struct {
+		Version string `json:"version"`
+		Root    struct {
+			Outputs []module.ModuleOutput `json:"outputs"`
+		} `json:"root"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&details))
+	assert.Equal(t, "1.0.0", details.Version)
+	assert.Equal(t, []module.ModuleOutput{{Name: "foo"}}, details.Root.Outputs)
+
+	// a version cannot be uploaded twice
+	err = client.RegistryModules.Upload(ctx, *version, dir)
+	assert.Error(t, err)
+}
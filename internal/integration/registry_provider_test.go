@@ -0,0 +1,143 @@
+package integration
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal/provider"
+)
+
+// TestIntegration_RegistryProvider demonstrates publishing a provider to the
+// private registry via the TFE API, and retrieving it via the provider
+// registry protocol.
+func TestIntegration_RegistryProvider(t *testing.T) {
+	integrationTest(t)
+
+	daemon, org, ctx := setup(t, nil)
+	_, token := daemon.createToken(t, ctx, nil)
+
+	client, err := tfe.NewClient(&tfe.Config{
+		Address:           "https://" + daemon.System.Hostname(),
+		Token:             string(token),
+		RetryServerErrors: true,
+	})
+	require.NoError(t, err)
+
+	// generate signing key and add it to the organization's private registry
+	entity, err := openpgp.NewEntity("acme", "", "acme@example.com", nil)
+	require.NoError(t, err)
+	var armored bytes.Buffer
+	w, err := armor.Encode(&armored, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+	key, err := client.GPGKeys.Create(ctx, tfe.PrivateRegistry, tfe.GPGKeyCreateOptions{
+		Namespace:  org.Name,
+		AsciiArmor: armored.String(),
+	})
+	require.NoError(t, err)
+
+	// create provider, version and platform
+	providerID := tfe.RegistryProviderID{
+		OrganizationName: org.Name,
+		RegistryName:     tfe.PrivateRegistry,
+		Namespace:        org.Name,
+		Name:             "internal",
+	}
+	_, err = client.RegistryProviders.Create(ctx, org.Name, tfe.RegistryProviderCreateOptions{
+		Name:         providerID.Name,
+		Namespace:    providerID.Namespace,
+		RegistryName: providerID.RegistryName,
+	})
+	require.NoError(t, err)
+	version, err := client.RegistryProviderVersions.Create(ctx, providerID, tfe.RegistryProviderVersionCreateOptions{
+		Version:   "1.0.0",
+		KeyID:     key.KeyID,
+		Protocols: []string{"5.0"},
+	})
+	require.NoError(t, err)
+	binary := []byte("zip archive")
+	sum := sha256.Sum256(binary)
+	filename := "terraform-provider-internal_1.0.0_linux_amd64.zip"
+	platform, err := client.RegistryProviderPlatforms.Create(ctx, tfe.RegistryProviderVersionID{
+		RegistryProviderID: providerID,
+		Version:            "1.0.0",
+	}, tfe.RegistryProviderPlatformCreateOptions{
+		OS:       "linux",
+		Arch:     "amd64",
+		Shasum:   hex.EncodeToString(sum[:]),
+		Filename: filename,
+	})
+	require.NoError(t, err)
+
+	// upload artifacts
+	shasums := []byte(hex.EncodeToString(sum[:]) + "  " + filename + "\n")
+	var sig bytes.Buffer
+	require.NoError(t, openpgp.DetachSign(&sig, entity, bytes.NewReader(shasums), nil))
+	put := func(t *testing.T, link any, body []byte) int {
+		req, err := http.NewRequest("PUT", link.(string), bytes.NewReader(body))
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+	t.Run("signature before shasums", func(t *testing.T) {
+		assert.Equal(t, 422, put(t, version.Links["shasums-sig-upload"], sig.Bytes()))
+	})
+	assert.Equal(t, 200, put(t, version.Links["shasums-upload"], shasums))
+	t.Run("invalid signature", func(t *testing.T) {
+		assert.Equal(t, 422, put(t, version.Links["shasums-sig-upload"], []byte("garbage")))
+	})
+	assert.Equal(t, 200, put(t, version.Links["shasums-sig-upload"], sig.Bytes()))
+	t.Run("binary checksum mismatch", func(t *testing.T) {
+		assert.Equal(t, 422, put(t, platform.Links["provider-binary-upload"], []byte("tampered")))
+	})
+	assert.Equal(t, 200, put(t, platform.Links["provider-binary-upload"], binary))
+
+	// retrieve provider via the provider registry protocol
+	versions, err := daemon.Providers.GetProviderVersions(ctx, provider.GetProviderVersionsOptions{
+		Namespace: org.Name,
+		Type:      "internal",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(versions.Versions))
+	assert.Equal(t, "1.0.0", versions.Versions[0].Version)
+	assert.Equal(t, []provider.ProviderPlatform{{Os: "linux", Arch: "amd64"}}, versions.Versions[0].Platforms)
+
+	manifest, err := daemon.Providers.FindProviderPackage(ctx, provider.FindProviderPackageOptions{
+		Namespace: org.Name,
+		Type:      "internal",
+		Version:   "1.0.0",
+		OS:        "linux",
+		Arch:      "amd64",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, filename, manifest.Filename)
+	assert.Equal(t, hex.EncodeToString(sum[:]), manifest.Shasum)
+	require.Equal(t, 1, len(manifest.SigningKeys.GpgPublicKeys))
+	assert.Equal(t, key.KeyID, manifest.SigningKeys.GpgPublicKeys[0].KeyID)
+
+	// download artifacts via manifest's signed URLs
+	for url, want := range map[string][]byte{
+		manifest.DownloadURL:         binary,
+		manifest.ShasumsURL:          shasums,
+		manifest.ShasumsSignatureURL: sig.Bytes(),
+	} {
+		resp, err := http.Get("https://" + daemon.System.Hostname() + url)
+		require.NoError(t, err)
+		got, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+}
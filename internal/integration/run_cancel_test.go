@@ -22,7 +22,7 @@ func TestIntegration_RunCancel(t *testing.T) {
 	// stage a fake terraform bin that sleeps until it receives an interrupt
 	// signal
 	bins := filepath.Join(t.TempDir(), "bins")
-	dst := filepath.Join(bins, releases.DefaultTerraformVersion, "terraform")
+	dst := filepath.Join(bins, string(releases.TerraformEngine), releases.DefaultTerraformVersion, "terraform")
 	err := os.MkdirAll(filepath.Dir(dst), 0o755)
 	require.NoError(t, err)
 	wd, err := os.Getwd()
@@ -0,0 +1,71 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/runtrigger"
+)
+
+func TestIntegration_RunTriggerService(t *testing.T) {
+	integrationTest(t)
+
+	t.Run("create", func(t *testing.T) {
+		daemon, org, ctx := setup(t, nil)
+		upstream := daemon.createWorkspace(t, ctx, org)
+		downstream := daemon.createWorkspace(t, ctx, org)
+
+		rt, err := daemon.RunTriggers.Create(ctx, downstream.ID, upstream.ID)
+		require.NoError(t, err)
+		assert.Equal(t, upstream.Name, rt.SourceableName)
+		assert.Equal(t, downstream.Name, rt.WorkspaceName)
+
+		t.Run("duplicate", func(t *testing.T) {
+			_, err := daemon.RunTriggers.Create(ctx, downstream.ID, upstream.ID)
+			assert.ErrorIs(t, err, internal.ErrResourceAlreadyExists)
+		})
+
+		t.Run("cycle", func(t *testing.T) {
+			_, err := daemon.RunTriggers.Create(ctx, upstream.ID, downstream.ID)
+			assert.ErrorIs(t, err, runtrigger.ErrCycle)
+		})
+
+		t.Run("different organization", func(t *testing.T) {
+			other := daemon.createWorkspace(t, ctx, nil)
+			_, err := daemon.RunTriggers.Create(ctx, downstream.ID, other.ID)
+			assert.ErrorIs(t, err, runtrigger.ErrDifferentOrg)
+		})
+	})
+
+	t.Run("list", func(t *testing.T) {
+		daemon, org, ctx := setup(t, nil)
+		upstream := daemon.createWorkspace(t, ctx, org)
+		downstream := daemon.createWorkspace(t, ctx, org)
+		rt, err := daemon.RunTriggers.Create(ctx, downstream.ID, upstream.ID)
+		require.NoError(t, err)
+
+		inbound, err := daemon.RunTriggers.List(ctx, downstream.ID, runtrigger.Inbound)
+		require.NoError(t, err)
+		assert.Equal(t, []*runtrigger.RunTrigger{rt}, inbound)
+
+		outbound, err := daemon.RunTriggers.List(ctx, upstream.ID, runtrigger.Outbound)
+		require.NoError(t, err)
+		assert.Equal(t, []*runtrigger.RunTrigger{rt}, outbound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		daemon, org, ctx := setup(t, nil)
+		upstream := daemon.createWorkspace(t, ctx, org)
+		downstream := daemon.createWorkspace(t, ctx, org)
+		rt, err := daemon.RunTriggers.Create(ctx, downstream.ID, upstream.ID)
+		require.NoError(t, err)
+
+		err = daemon.RunTriggers.Delete(ctx, rt.ID)
+		require.NoError(t, err)
+
+		_, err = daemon.RunTriggers.Get(ctx, rt.ID)
+		assert.ErrorIs(t, err, internal.ErrResourceNotFound)
+	})
+}
@@ -0,0 +1,84 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/run"
+	"github.com/tofutf/tofutf/internal/schedule"
+)
+
+func TestIntegration_ScheduleService(t *testing.T) {
+	integrationTest(t)
+
+	t.Run("create", func(t *testing.T) {
+		daemon, _, ctx := setup(t, nil)
+		ws := daemon.createWorkspace(t, ctx, nil)
+		destroy := run.DestroyAllOperation
+
+		sched, err := daemon.Schedules.Create(ctx, ws.ID, schedule.CreateOptions{
+			Cron:      internal.String("0 2 * * 6"),
+			Operation: &destroy,
+			AutoApply: internal.Bool(true),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, ws.ID, sched.WorkspaceID)
+		assert.Equal(t, run.DestroyAllOperation, sched.Operation)
+
+		t.Run("invalid cron expression", func(t *testing.T) {
+			_, err := daemon.Schedules.Create(ctx, ws.ID, schedule.CreateOptions{
+				Cron: internal.String("every saturday"),
+			})
+			assert.ErrorIs(t, err, schedule.ErrInvalidCron)
+		})
+	})
+
+	t.Run("list", func(t *testing.T) {
+		daemon, _, ctx := setup(t, nil)
+		ws := daemon.createWorkspace(t, ctx, nil)
+		sched, err := daemon.Schedules.Create(ctx, ws.ID, schedule.CreateOptions{
+			Cron: internal.String("@daily"),
+		})
+		require.NoError(t, err)
+
+		got, err := daemon.Schedules.List(ctx, ws.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []*schedule.Schedule{sched}, got)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		daemon, _, ctx := setup(t, nil)
+		ws := daemon.createWorkspace(t, ctx, nil)
+		sched, err := daemon.Schedules.Create(ctx, ws.ID, schedule.CreateOptions{
+			Cron: internal.String("@daily"),
+		})
+		require.NoError(t, err)
+
+		updated, err := daemon.Schedules.Update(ctx, sched.ID, schedule.UpdateOptions{
+			Cron: internal.String("@weekly"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "@weekly", updated.Cron)
+
+		got, err := daemon.Schedules.Get(ctx, sched.ID)
+		require.NoError(t, err)
+		assert.Equal(t, updated, got)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		daemon, _, ctx := setup(t, nil)
+		ws := daemon.createWorkspace(t, ctx, nil)
+		sched, err := daemon.Schedules.Create(ctx, ws.ID, schedule.CreateOptions{
+			Cron: internal.String("@daily"),
+		})
+		require.NoError(t, err)
+
+		_, err = daemon.Schedules.Delete(ctx, sched.ID)
+		require.NoError(t, err)
+
+		_, err = daemon.Schedules.Get(ctx, sched.ID)
+		assert.ErrorIs(t, err, internal.ErrResourceNotFound)
+	})
+}
@@ -0,0 +1,143 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	labelKeyRegex   = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$`)
+	labelValueRegex = regexp.MustCompile(`^[a-zA-Z0-9._/-]*$`)
+)
+
+// ValidateLabels validates label keys and values. A key must begin and end
+// with an alphanumeric character and may contain dots, underscores, slashes
+// and hyphens in between. A value may be empty or contain alphanumeric
+// characters, dots, underscores, slashes and hyphens.
+func ValidateLabels(labels map[string]string) error {
+	for k, v := range labels {
+		if !labelKeyRegex.MatchString(k) {
+			return fmt.Errorf("%w: invalid key: %q", ErrInvalidLabel, k)
+		}
+		if !labelValueRegex.MatchString(v) {
+			return fmt.Errorf("%w: invalid value for %s: %q", ErrInvalidLabel, k, v)
+		}
+	}
+	return nil
+}
+
+// LabelsToStrings converts labels into a sorted list of key=value strings.
+func LabelsToStrings(labels map[string]string) []string {
+	if len(labels) == 0 {
+		return nil
+	}
+	list := make([]string, 0, len(labels))
+	for k, v := range labels {
+		list = append(list, k+"="+v)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// LabelsFromStrings converts a list of key=value strings into labels.
+func LabelsFromStrings(list []string) map[string]string {
+	if len(list) == 0 {
+		return nil
+	}
+	labels := make(map[string]string, len(list))
+	for _, kv := range list {
+		k, v, _ := strings.Cut(kv, "=")
+		labels[k] = v
+	}
+	return labels
+}
+
+// LabelSelector selects labels that satisfy all of its requirements.
+type LabelSelector []LabelRequirement
+
+// LabelRequirement is a requirement of a label selector, in one of the
+// following forms:
+//
+//	key=value	label must be present and equal value
+//	key!=value	label must be absent or not equal value
+//	key		label must be present
+//	!key		label must be absent
+type LabelRequirement struct {
+	Key   string
+	Value string
+	// Operator is one of =, !=, exists or !exists
+	Operator string
+}
+
+// ParseLabelSelector parses a comma-separated list of label requirements. An
+// empty string returns an empty selector that matches all labels.
+func ParseLabelSelector(s string) (LabelSelector, error) {
+	var selector LabelSelector
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		var req LabelRequirement
+		if k, v, ok := strings.Cut(part, "!="); ok {
+			req = LabelRequirement{Key: k, Value: v, Operator: "!="}
+		} else if k, v, ok := strings.Cut(part, "="); ok {
+			req = LabelRequirement{Key: k, Value: v, Operator: "="}
+		} else if k, ok := strings.CutPrefix(part, "!"); ok {
+			req = LabelRequirement{Key: k, Operator: "!exists"}
+		} else {
+			req = LabelRequirement{Key: part, Operator: "exists"}
+		}
+		req.Key = strings.TrimSpace(req.Key)
+		req.Value = strings.TrimSpace(req.Value)
+		if err := ValidateLabels(map[string]string{req.Key: req.Value}); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidLabelSelector, part)
+		}
+		selector = append(selector, req)
+	}
+	return selector, nil
+}
+
+// Matches determines whether the labels satisfy all of the selector's
+// requirements.
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for _, req := range s {
+		v, ok := labels[req.Key]
+		switch req.Operator {
+		case "=":
+			if !ok || v != req.Value {
+				return false
+			}
+		case "!=":
+			if ok && v == req.Value {
+				return false
+			}
+		case "exists":
+			if !ok {
+				return false
+			}
+		case "!exists":
+			if ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (s LabelSelector) String() string {
+	parts := make([]string, len(s))
+	for i, req := range s {
+		switch req.Operator {
+		case "exists":
+			parts[i] = req.Key
+		case "!exists":
+			parts[i] = "!" + req.Key
+		default:
+			parts[i] = req.Key + req.Operator + req.Value
+		}
+	}
+	return strings.Join(parts, ",")
+}
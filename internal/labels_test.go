@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelSelector(t *testing.T) {
+	labels := map[string]string{
+		"region":    "eu",
+		"gpu":       "false",
+		"terraform": "1.7",
+	}
+
+	tests := []struct {
+		name     string
+		selector string
+		want     bool
+	}{
+		{"empty", "", true},
+		{"equals", "region=eu", true},
+		{"not equals", "region=us", false},
+		{"multiple", "region=eu, terraform=1.7", true},
+		{"multiple with mismatch", "region=eu,gpu=true", false},
+		{"inequality", "gpu!=true", true},
+		{"inequality with missing label", "arch!=arm64", true},
+		{"inequality mismatch", "gpu!=false", false},
+		{"exists", "terraform", true},
+		{"exists mismatch", "arch", false},
+		{"not exists", "!arch", true},
+		{"not exists mismatch", "!region", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := ParseLabelSelector(tt.selector)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, selector.Matches(labels))
+		})
+	}
+
+	t.Run("string", func(t *testing.T) {
+		selector, err := ParseLabelSelector(" region = eu,gpu!=true,terraform,!arch ")
+		require.NoError(t, err)
+		assert.Equal(t, "region=eu,gpu!=true,terraform,!arch", selector.String())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ParseLabelSelector("region=eu=us")
+		assert.ErrorIs(t, err, ErrInvalidLabelSelector)
+
+		_, err = ParseLabelSelector("=eu")
+		assert.ErrorIs(t, err, ErrInvalidLabelSelector)
+	})
+}
+
+func TestLabelsToStrings(t *testing.T) {
+	labels := map[string]string{"region": "eu", "gpu": "false"}
+	list := LabelsToStrings(labels)
+	assert.Equal(t, []string{"gpu=false", "region=eu"}, list)
+	assert.Equal(t, labels, LabelsFromStrings(list))
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/blob"
 	"github.com/tofutf/tofutf/internal/sql"
 	"github.com/tofutf/tofutf/internal/sql/pggen"
 )
@@ -13,6 +14,8 @@ import (
 // pgdb is a logs database on postgres
 type pgdb struct {
 	*sql.Pool // provides access to generated SQL queries
+
+	blobs blob.Store // optional store for log chunks
 }
 
 // put persists a chunk of logs to the DB and returns the chunk updated with a
@@ -20,21 +23,35 @@ type pgdb struct {
 
 // put persists data to the DB and returns a unique identifier for the chunk
 func (db *pgdb) put(ctx context.Context, opts internal.PutChunkOptions) (string, error) {
-	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (string, error) {
+	return sql.Tx(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (string, error) {
 		if len(opts.Data) == 0 {
 			return "", fmt.Errorf("refusing to persist empty chunk")
 		}
 
+		// the chunk's key in the blob store includes its ID, which is only
+		// known once it has been inserted, so only persist the chunk in the
+		// database if there is no blob store.
+		var data []byte
+		if db.blobs == nil {
+			data = opts.Data
+		}
 		id, err := q.InsertLogChunk(ctx, pggen.InsertLogChunkParams{
 			RunID:  sql.String(opts.RunID),
 			Phase:  sql.String(string(opts.Phase)),
-			Chunk:  opts.Data,
+			Chunk:  data,
 			Offset: sql.Int4(opts.Offset),
 		})
 		if err != nil {
 			return "", sql.Error(err)
 		}
 
+		if data == nil {
+			key := blob.LogChunkKey(opts.RunID, string(opts.Phase), int(id.Int32))
+			if err := db.blobs.Put(ctx, key, opts.Data); err != nil {
+				return "", err
+			}
+		}
+
 		return strconv.Itoa(int(id.Int32)), nil
 	})
 }
@@ -51,11 +68,16 @@ func (db *pgdb) getChunk(ctx context.Context, chunkID string) (internal.Chunk, e
 			return internal.Chunk{}, sql.Error(err)
 		}
 
+		data, err := blob.Load(ctx, db.blobs, blob.LogChunkKey(chunk.RunID.String, chunk.Phase.String, id), chunk.Chunk)
+		if err != nil {
+			return internal.Chunk{}, err
+		}
+
 		return internal.Chunk{
 			ID:     chunkID,
 			RunID:  chunk.RunID.String,
 			Phase:  internal.PhaseType(chunk.Phase.String),
-			Data:   chunk.Chunk,
+			Data:   data,
 			Offset: int(chunk.Offset.Int32),
 		}, nil
 	})
@@ -63,17 +85,21 @@ func (db *pgdb) getChunk(ctx context.Context, chunkID string) (internal.Chunk, e
 
 func (db *pgdb) getLogs(ctx context.Context, runID string, phase internal.PhaseType) ([]byte, error) {
 	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]byte, error) {
-		data, err := q.FindLogs(ctx, sql.String(runID), sql.String(string(phase)))
+		rows, err := q.FindLogChunks(ctx, sql.String(runID), sql.String(string(phase)))
 		if err != nil {
-			// Don't consider no rows an error because logs may not have been
-			// uploaded yet.
-			if sql.NoRowsInResultError(err) {
-				return nil, nil
-			}
-
 			return nil, sql.Error(err)
 		}
 
+		// Logs may not have been uploaded yet, in which case nil is returned.
+		var data []byte
+		for _, row := range rows {
+			chunk, err := blob.Load(ctx, db.blobs, blob.LogChunkKey(runID, string(phase), int(row.ChunkID.Int32)), row.Chunk)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, chunk...)
+		}
+
 		return data, nil
 	})
 }
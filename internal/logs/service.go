@@ -6,6 +6,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/blob"
 	"github.com/tofutf/tofutf/internal/pubsub"
 	"github.com/tofutf/tofutf/internal/rbac"
 	"github.com/tofutf/tofutf/internal/sql"
@@ -38,11 +39,12 @@ type (
 		internal.Verifier
 
 		RunAuthorizer internal.Authorizer
+		BlobStore     blob.Store
 	}
 )
 
 func NewService(opts Options) *Service {
-	db := &pgdb{opts.Pool}
+	db := &pgdb{opts.Pool, opts.BlobStore}
 	svc := Service{
 		logger: opts.Logger,
 		run:    opts.RunAuthorizer,
@@ -30,10 +30,18 @@ func (h *api) addHandlers(r *mux.Router) {
 	// Implements the Module Registry Protocol:
 	//
 	// https://developer.hashicorp.com/terraform/internals/module-registry-protocol
-	r = r.PathPrefix(tfeapi.ModuleV1Prefix).Subrouter()
+	modules := r.PathPrefix(tfeapi.ModuleV1Prefix).Subrouter()
 
-	r.HandleFunc("/{organization}/{name}/{provider}/versions", h.listAvailableVersions).Methods("GET")
-	r.HandleFunc("/{organization}/{name}/{provider}/{version}/download", h.getModuleVersionDownloadLink).Methods("GET")
+	modules.HandleFunc("/{organization}/{name}/{provider}/versions", h.listAvailableVersions).Methods("GET")
+	modules.HandleFunc("/{organization}/{name}/{provider}/{version}/download", h.getModuleVersionDownloadLink).Methods("GET")
+
+	// module details are served both by the module registry and by the TFE
+	// private registry API.
+	for _, prefix := range []string{tfeapi.ModuleV1Prefix, tfeapi.RegistryModuleV1Prefix} {
+		details := r.PathPrefix(prefix).Subrouter()
+		details.HandleFunc("/{organization}/{name}/{provider}", h.getModuleDetails).Methods("GET")
+		details.HandleFunc("/{organization}/{name}/{provider}/{version}", h.getModuleDetails).Methods("GET")
+	}
 }
 
 type (
@@ -47,6 +55,24 @@ type (
 	listAvailableVersionsVersion struct {
 		Version string
 	}
+
+	// moduleDetails is the module registry's representation of a module
+	// version.
+	//
+	// https://developer.hashicorp.com/terraform/registry/api-docs#get-a-specific-module
+	moduleDetails struct {
+		ID          string    `json:"id"`
+		Namespace   string    `json:"namespace"`
+		Name        string    `json:"name"`
+		Version     string    `json:"version"`
+		Provider    string    `json:"provider"`
+		Source      string    `json:"source"`
+		PublishedAt time.Time `json:"published_at"`
+		Providers   []string  `json:"providers"`
+		Versions    []string  `json:"versions"`
+
+		*ModuleMetadata
+	}
 )
 
 // List Available Versions for a Specific Module.
@@ -145,3 +171,66 @@ func (h *api) downloadModuleVersion(w http.ResponseWriter, r *http.Request) {
 
 	w.Write(tarball) //nolint:errcheck
 }
+
+// getModuleDetails retrieves the inputs, outputs, resources, etc, of a module
+// version, or of the latest version if a version is not specified.
+func (h *api) getModuleDetails(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Name         string  `schema:"name,required"`
+		Provider     string  `schema:"provider,required"`
+		Organization string  `schema:"organization,required"`
+		Version      *string `schema:"version"`
+	}
+	if err := decode.Route(&params, r); err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	mod, err := h.svc.GetModule(r.Context(), GetModuleOptions{
+		Name:         params.Name,
+		Provider:     params.Provider,
+		Organization: params.Organization,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	version := mod.Latest()
+	if params.Version != nil {
+		version = mod.Version(*params.Version)
+	}
+	if version == nil || version.Status != ModuleVersionStatusOK {
+		http.Error(w, "version not found", http.StatusNotFound)
+		return
+	}
+
+	metadata, err := h.svc.GetModuleMetadata(r.Context(), version.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := moduleDetails{
+		ID:             strings.Join([]string{mod.Organization, mod.Name, mod.Provider, version.Version}, "/"),
+		Namespace:      mod.Organization,
+		Name:           mod.Name,
+		Version:        version.Version,
+		Provider:       mod.Provider,
+		PublishedAt:    version.CreatedAt,
+		Providers:      []string{mod.Provider},
+		ModuleMetadata: metadata,
+	}
+	if mod.Connection != nil {
+		response.Source = mod.Connection.Repo
+	}
+	for _, ver := range mod.AvailableVersions() {
+		response.Versions = append(response.Versions, ver.Version)
+	}
+
+	w.Header().Set("Content-type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,102 @@
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tofutf/tofutf/internal"
+	otfapi "github.com/tofutf/tofutf/internal/api"
+	"github.com/tofutf/tofutf/internal/tfeapi/types"
+)
+
+type CLI struct {
+	client cliClient
+}
+
+type cliClient interface {
+	GetModule(ctx context.Context, organization, name, provider string) (*types.RegistryModule, error)
+	CreateModule(ctx context.Context, organization, name, provider string) (*types.RegistryModule, error)
+	CreateVersion(ctx context.Context, organization, name, provider, version string) (*types.RegistryModuleVersion, string, error)
+	Upload(ctx context.Context, link string, tarball []byte) error
+}
+
+func NewCommand(client *otfapi.Client) *cobra.Command {
+	cli := &CLI{}
+	cmd := &cobra.Command{
+		Use:   "modules",
+		Short: "Module registry management",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Parent().PersistentPreRunE(cmd.Parent(), args); err != nil {
+				return err
+			}
+			cli.client = &Client{Client: client}
+			return nil
+		},
+	}
+
+	cmd.AddCommand(cli.publishCommand())
+
+	return cmd
+}
+
+func (a *CLI) publishCommand() *cobra.Command {
+	var (
+		organization string
+		name         string
+		provider     string
+		version      string
+	)
+	cmd := &cobra.Command{
+		Use:           "publish [path]",
+		Short:         "Publish a module version from a directory",
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			tarball, err := internal.Pack(args[0])
+			if err != nil {
+				return fmt.Errorf("packing module: %w", err)
+			}
+
+			// create module if it doesn't exist already
+			_, err = a.client.GetModule(ctx, organization, name, provider)
+			if errors.Is(err, internal.ErrResourceNotFound) {
+				_, err = a.client.CreateModule(ctx, organization, name, provider)
+			}
+			if err != nil {
+				return err
+			}
+
+			modver, link, err := a.client.CreateVersion(ctx, organization, name, provider, version)
+			if err != nil {
+				return fmt.Errorf("creating module version: %w", err)
+			}
+			if err := a.client.Upload(ctx, link, tarball); err != nil {
+				return fmt.Errorf("uploading module: %w", err)
+			}
+
+			source := strings.Join([]string{organization, name, provider}, "/")
+			fmt.Fprintf(cmd.OutOrStdout(), "Published module %s version %s\n", source, modver.Version)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&organization, "organization", "", "Organization to which the module belongs")
+	cmd.MarkFlagRequired("organization") //nolint:errcheck
+
+	cmd.Flags().StringVar(&name, "name", "", "Name of the module")
+	cmd.MarkFlagRequired("name") //nolint:errcheck
+
+	cmd.Flags().StringVar(&provider, "provider", "", "Name of the module's provider, e.g. aws")
+	cmd.MarkFlagRequired("provider") //nolint:errcheck
+
+	cmd.Flags().StringVar(&version, "version", "", "Semantic version of the module, e.g. 1.0.0")
+	cmd.MarkFlagRequired("version") //nolint:errcheck
+
+	return cmd
+}
@@ -0,0 +1,66 @@
+package module
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/tfeapi/types"
+)
+
+func TestCLI_Publish(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.tf"), []byte(`output "foo" {}`), 0o644))
+
+	tests := []struct {
+		name   string
+		client *fakeCLIClient
+	}{
+		{"new module", &fakeCLIClient{}},
+		{"existing module", &fakeCLIClient{module: &types.RegistryModule{ID: "mod-123"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := (&CLI{client: tt.client}).publishCommand()
+			cmd.SetArgs([]string{dir, "--organization", "acme", "--name", "vpc", "--provider", "aws", "--version", "1.0.0"})
+			got := bytes.Buffer{}
+			cmd.SetOut(&got)
+			require.NoError(t, cmd.Execute())
+
+			assert.Equal(t, "Published module acme/vpc/aws version 1.0.0\n", got.String())
+			assert.NotNil(t, tt.client.module)
+			assert.NotEmpty(t, tt.client.uploaded)
+		})
+	}
+}
+
+type fakeCLIClient struct {
+	module   *types.RegistryModule
+	uploaded []byte
+}
+
+func (f *fakeCLIClient) GetModule(context.Context, string, string, string) (*types.RegistryModule, error) {
+	if f.module == nil {
+		return nil, internal.ErrResourceNotFound
+	}
+	return f.module, nil
+}
+
+func (f *fakeCLIClient) CreateModule(context.Context, string, string, string) (*types.RegistryModule, error) {
+	f.module = &types.RegistryModule{ID: "mod-123"}
+	return f.module, nil
+}
+
+func (f *fakeCLIClient) CreateVersion(_ context.Context, _, _, _, version string) (*types.RegistryModuleVersion, string, error) {
+	return &types.RegistryModuleVersion{Version: version}, "https://tofutf.dev/upload", nil
+}
+
+func (f *fakeCLIClient) Upload(_ context.Context, _ string, tarball []byte) error {
+	f.uploaded = tarball
+	return nil
+}
@@ -0,0 +1,105 @@
+package module
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/DataDog/jsonapi"
+	"github.com/tofutf/tofutf/internal"
+	otfapi "github.com/tofutf/tofutf/internal/api"
+	"github.com/tofutf/tofutf/internal/tfeapi"
+	"github.com/tofutf/tofutf/internal/tfeapi/types"
+)
+
+// Client publishes modules via the TFE API.
+type Client struct {
+	*otfapi.Client
+}
+
+func (c *Client) modulePath(organization, name, provider string) string {
+	return fmt.Sprintf("%sorganizations/%s/registry-modules/%s/%s/%s/%s",
+		tfeapi.APIPrefixV2,
+		url.PathEscape(organization),
+		tfeapi.PrivateRegistry,
+		url.PathEscape(organization),
+		url.PathEscape(name),
+		url.PathEscape(provider),
+	)
+}
+
+func (c *Client) GetModule(ctx context.Context, organization, name, provider string) (*types.RegistryModule, error) {
+	req, err := c.NewRequest("GET", c.modulePath(organization, name, provider), nil)
+	if err != nil {
+		return nil, err
+	}
+	var mod types.RegistryModule
+	if err := c.Do(ctx, req, &mod); err != nil {
+		return nil, err
+	}
+	return &mod, nil
+}
+
+func (c *Client) CreateModule(ctx context.Context, organization, name, provider string) (*types.RegistryModule, error) {
+	u := fmt.Sprintf("%sorganizations/%s/registry-modules", tfeapi.APIPrefixV2, url.PathEscape(organization))
+	req, err := c.NewRequest("POST", u, &types.RegistryModuleCreateOptions{
+		Name:         internal.String(name),
+		Provider:     internal.String(provider),
+		RegistryName: tfeapi.PrivateRegistry,
+		Namespace:    organization,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var mod types.RegistryModule
+	if err := c.Do(ctx, req, &mod); err != nil {
+		return nil, err
+	}
+	return &mod, nil
+}
+
+// CreateVersion creates a version of a module, returning the version along
+// with a link for uploading its tarball.
+func (c *Client) CreateVersion(ctx context.Context, organization, name, provider, version string) (*types.RegistryModuleVersion, string, error) {
+	u := c.modulePath(organization, name, provider) + "/versions"
+	req, err := c.NewRequest("POST", u, &types.RegistryModuleCreateVersionOptions{
+		Version: internal.String(version),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	// retrieve raw response in order to retrieve the upload link as well as
+	// the version.
+	var buf bytes.Buffer
+	if err := c.Do(ctx, req, &buf); err != nil {
+		return nil, "", err
+	}
+	var modver types.RegistryModuleVersion
+	if err := jsonapi.Unmarshal(buf.Bytes(), &modver); err != nil {
+		return nil, "", err
+	}
+	var links struct {
+		Data struct {
+			Links map[string]string `json:"links"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &links); err != nil {
+		return nil, "", err
+	}
+	upload, ok := links.Data.Links["upload"]
+	if !ok {
+		return nil, "", fmt.Errorf("response is missing upload link")
+	}
+	return &modver, upload, nil
+}
+
+// Upload uploads a module tarball to the upload link of a version.
+func (c *Client) Upload(ctx context.Context, link string, tarball []byte) error {
+	req, err := c.NewRequest("PUT", link, tarball)
+	if err != nil {
+		return err
+	}
+	return c.Do(ctx, req, nil)
+}
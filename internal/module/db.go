@@ -2,9 +2,12 @@ package module
 
 import (
 	"context"
+	"encoding/json"
+	"html/template"
 	"sort"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tofutf/tofutf/internal/blob"
 	"github.com/tofutf/tofutf/internal/connections"
 	"github.com/tofutf/tofutf/internal/semver"
 	"github.com/tofutf/tofutf/internal/sql"
@@ -15,6 +18,8 @@ type (
 	// pgdb is the registry database on postgres
 	pgdb struct {
 		*sql.Pool // provides access to generated SQL queries
+
+		blobs blob.Store // optional store for module tarballs
 	}
 
 	// moduleRow is a row from a database query for modules.
@@ -163,7 +168,11 @@ func (db *pgdb) deleteModuleVersion(ctx context.Context, versionID string) error
 
 func (db *pgdb) saveTarball(ctx context.Context, versionID string, tarball []byte) error {
 	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
-		_, err := q.InsertModuleTarball(ctx, tarball, sql.String(versionID))
+		tarball, err := blob.Offload(ctx, db.blobs, blob.ModuleKey(versionID), tarball)
+		if err != nil {
+			return err
+		}
+		_, err = q.InsertModuleTarball(ctx, tarball, sql.String(versionID))
 		return sql.Error(err)
 	})
 }
@@ -175,7 +184,37 @@ func (db *pgdb) getTarball(ctx context.Context, versionID string) ([]byte, error
 			return nil, sql.Error(err)
 		}
 
-		return tarball, nil
+		return blob.Load(ctx, db.blobs, blob.ModuleKey(versionID), tarball)
+	})
+}
+
+func (db *pgdb) saveMetadata(ctx context.Context, versionID string, metadata *ModuleMetadata) error {
+	encoded, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.UpsertModuleVersionMetadata(ctx, pggen.UpsertModuleVersionMetadataParams{
+			ModuleVersionID: sql.String(versionID),
+			Metadata:        encoded,
+			ReadmeHTML:      sql.String(string(metadata.ReadmeHTML)),
+		})
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) getMetadata(ctx context.Context, versionID string) (*ModuleMetadata, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*ModuleMetadata, error) {
+		row, err := q.FindModuleVersionMetadata(ctx, sql.String(versionID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		var metadata ModuleMetadata
+		if err := json.Unmarshal(row.Metadata, &metadata); err != nil {
+			return nil, err
+		}
+		metadata.ReadmeHTML = template.HTML(row.ReadmeHTML.String)
+		return &metadata, nil
 	})
 }
 
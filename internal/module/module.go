@@ -3,6 +3,8 @@ package module
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"log/slog"
@@ -10,6 +12,7 @@ import (
 	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/connections"
 	"github.com/tofutf/tofutf/internal/resource"
+	"github.com/tofutf/tofutf/internal/semver"
 	"github.com/tofutf/tofutf/internal/vcs"
 )
 
@@ -28,7 +31,13 @@ const (
 	ModuleVersionStatusOK                  ModuleVersionStatus = "ok"
 )
 
-var ErrInvalidModuleRepo = errors.New("invalid repository name for module")
+var (
+	ErrInvalidModuleRepo    = errors.New("invalid repository name for module")
+	ErrInvalidModuleVersion = errors.New("module version must be a semantic version")
+	ErrModuleNotConnected   = errors.New("module is not connected to a VCS repository")
+	ErrInvalidModuleTarball = errors.New("invalid module tarball")
+	ErrVersionUploaded      = errors.New("module version has already been uploaded")
+)
 
 type (
 	Module struct {
@@ -97,7 +106,13 @@ type (
 	}
 )
 
-func newModule(opts CreateOptions) *Module {
+func newModule(opts CreateOptions) (*Module, error) {
+	if err := resource.ValidateName(&opts.Name); err != nil {
+		return nil, fmt.Errorf("name: %w", err)
+	}
+	if err := resource.ValidateName(&opts.Provider); err != nil {
+		return nil, fmt.Errorf("provider: %w", err)
+	}
 	return &Module{
 		ID:           internal.NewID("mod"),
 		CreatedAt:    internal.CurrentTimestamp(nil),
@@ -106,20 +121,22 @@ func newModule(opts CreateOptions) *Module {
 		Provider:     opts.Provider,
 		Status:       ModuleStatusPending,
 		Organization: opts.Organization,
-	}
+	}, nil
 }
 
-func newModuleVersion(opts CreateModuleVersionOptions) *ModuleVersion {
+func newModuleVersion(opts CreateModuleVersionOptions) (*ModuleVersion, error) {
+	if !semver.IsValid(opts.Version) {
+		return nil, ErrInvalidModuleVersion
+	}
 	return &ModuleVersion{
 		ID:        internal.NewID("modver"),
 		CreatedAt: internal.CurrentTimestamp(nil),
 		UpdatedAt: internal.CurrentTimestamp(nil),
 		ModuleID:  opts.ModuleID,
-		// TODO: check version is a semver, and decide whether to keep or drop
-		// 'v' prefix
-		Version: opts.Version,
+		// strip off v prefix if it has one
+		Version: strings.TrimPrefix(opts.Version, "v"),
 		Status:  ModuleVersionStatusPending,
-	}
+	}, nil
 }
 
 func (m *Module) LogValue() slog.Value {
@@ -154,6 +171,15 @@ func (m *Module) Version(v string) *ModuleVersion {
 	return nil
 }
 
+func (m *Module) versionByID(id string) *ModuleVersion {
+	for _, modver := range m.Versions {
+		if modver.ID == id {
+			return &modver
+		}
+	}
+	return nil
+}
+
 // Latest retrieves the latest version, which is the greatest version with an
 // ok status. If there is no such version, nil is returned.
 func (m *Module) Latest() *ModuleVersion {
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestModule(t *testing.T) {
@@ -24,3 +25,16 @@ func TestModule(t *testing.T) {
 		assert.Equal(t, &modver2, mod.Version("v2"))
 	})
 }
+
+func TestNewModuleVersion(t *testing.T) {
+	t.Run("strip v prefix", func(t *testing.T) {
+		got, err := newModuleVersion(CreateModuleVersionOptions{Version: "v1.2.3"})
+		require.NoError(t, err)
+		assert.Equal(t, "1.2.3", got.Version)
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		_, err := newModuleVersion(CreateModuleVersionOptions{Version: "latest"})
+		assert.ErrorIs(t, err, ErrInvalidModuleVersion)
+	})
+}
@@ -2,6 +2,7 @@ package module
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/leg100/surl"
 	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/blob"
 	"github.com/tofutf/tofutf/internal/connections"
 	"github.com/tofutf/tofutf/internal/http/html"
 	"github.com/tofutf/tofutf/internal/organization"
@@ -17,6 +19,7 @@ import (
 	"github.com/tofutf/tofutf/internal/semver"
 	"github.com/tofutf/tofutf/internal/sql"
 	"github.com/tofutf/tofutf/internal/sql/pggen"
+	"github.com/tofutf/tofutf/internal/tfeapi"
 	"github.com/tofutf/tofutf/internal/vcs"
 	"github.com/tofutf/tofutf/internal/vcsprovider"
 )
@@ -28,6 +31,7 @@ type (
 		organization internal.Authorizer
 
 		api          *api
+		tfe          *tfeHandlers
 		web          *webHandlers
 		vcsproviders *vcsprovider.Service
 		connections  *connections.Service
@@ -40,11 +44,13 @@ type (
 		*internal.HostnameService
 		*surl.Signer
 		html.Renderer
+		*tfeapi.Responder
 
 		RepohookService    *repohooks.Service
 		VCSProviderService *vcsprovider.Service
 		ConnectionsService *connections.Service
 		VCSEventSubscriber vcs.Subscriber
+		BlobStore          blob.Store
 	}
 )
 
@@ -53,13 +59,18 @@ func NewService(opts Options) *Service {
 		logger:       opts.Logger,
 		connections:  opts.ConnectionsService,
 		organization: &organization.Authorizer{Logger: opts.Logger},
-		db:           &pgdb{opts.Pool},
+		db:           &pgdb{opts.Pool, opts.BlobStore},
 		vcsproviders: opts.VCSProviderService,
 	}
 	svc.api = &api{
 		svc:    &svc,
 		Signer: opts.Signer,
 	}
+	svc.tfe = &tfeHandlers{
+		svc:       &svc,
+		Signer:    opts.Signer,
+		Responder: opts.Responder,
+	}
 	svc.web = &webHandlers{
 		Renderer:     opts.Renderer,
 		client:       &svc,
@@ -79,6 +90,7 @@ func NewService(opts Options) *Service {
 
 func (s *Service) AddHandlers(r *mux.Router) {
 	s.api.addHandlers(r)
+	s.tfe.addHandlers(r)
 	s.web.addHandlers(r)
 }
 
@@ -111,11 +123,14 @@ func (s *Service) publishModule(ctx context.Context, organization string, opts P
 		return nil, err
 	}
 
-	mod := newModule(CreateOptions{
+	mod, err := newModule(CreateOptions{
 		Name:         name,
 		Provider:     provider,
 		Organization: organization,
 	})
+	if err != nil {
+		return nil, err
+	}
 
 	// persist module to db and connect to repository
 	if err := s.db.createModule(ctx, mod); err != nil {
@@ -218,7 +233,10 @@ func (s *Service) CreateModule(ctx context.Context, opts CreateOptions) (*Module
 		return nil, err
 	}
 
-	module := newModule(opts)
+	module, err := newModule(opts)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := s.db.createModule(ctx, module); err != nil {
 		s.logger.Error("creating module", "subject", subject, "module", module, "err", err)
@@ -325,6 +343,9 @@ func (s *Service) RefreshModule(ctx context.Context, id string) (*Module, error)
 	if err != nil {
 		return nil, err
 	}
+	if module.Connection == nil {
+		return nil, ErrModuleNotConnected
+	}
 
 	client, err := s.vcsproviders.GetVCSClient(ctx, module.Connection.VCSProviderID)
 	if err != nil {
@@ -400,7 +421,10 @@ func (s *Service) CreateVersion(ctx context.Context, opts CreateModuleVersionOpt
 		return nil, err
 	}
 
-	modver := newModuleVersion(opts)
+	modver, err := newModuleVersion(opts)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := s.db.createModuleVersion(ctx, modver); err != nil {
 		s.logger.Error("creating module version", "organization", module.Organization, "subject", subject, "module_version", modver, "err", err)
@@ -410,12 +434,29 @@ func (s *Service) CreateVersion(ctx context.Context, opts CreateModuleVersionOpt
 	return modver, nil
 }
 
-func (s *Service) GetModuleInfo(ctx context.Context, versionID string) (*TerraformModule, error) {
-	tarball, err := s.db.getTarball(ctx, versionID)
-	if err != nil {
+// GetModuleMetadata retrieves the inputs, outputs, resources, etc, of a module
+// version. Versions published before metadata was recorded have their
+// metadata parsed from their tarball and recorded upon first retrieval.
+func (s *Service) GetModuleMetadata(ctx context.Context, versionID string) (*ModuleMetadata, error) {
+	metadata, err := s.db.getMetadata(ctx, versionID)
+	if errors.Is(err, internal.ErrResourceNotFound) {
+		tarball, err := s.db.getTarball(ctx, versionID)
+		if err != nil {
+			return nil, err
+		}
+		metadata, err = parseModule(tarball)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.db.saveMetadata(ctx, versionID, metadata); err != nil {
+			s.logger.Error("saving module metadata", "module_version", versionID, "err", err)
+			return nil, err
+		}
+	} else if err != nil {
+		s.logger.Error("retrieving module metadata", "module_version", versionID, "err", err)
 		return nil, err
 	}
-	return unmarshalTerraformModule(tarball)
+	return metadata, nil
 }
 
 func (s *Service) updateModuleStatus(ctx context.Context, mod *Module, status ModuleStatus) (*Module, error) {
@@ -436,7 +477,8 @@ func (s *Service) uploadVersion(ctx context.Context, versionID string, tarball [
 	}
 
 	// validate tarball
-	if _, err := unmarshalTerraformModule(tarball); err != nil {
+	metadata, err := parseModule(tarball)
+	if err != nil {
 		s.logger.Error("uploading module version", "module_version", versionID, "err", err)
 		return s.db.updateModuleVersionStatus(ctx, UpdateModuleVersionStatusOptions{
 			ID:     versionID,
@@ -445,11 +487,14 @@ func (s *Service) uploadVersion(ctx context.Context, versionID string, tarball [
 		})
 	}
 
-	// save tarball, set status, and make it the latest version
+	// save tarball and metadata, set status, and make it the latest version
 	err = s.db.Tx(ctx, func(ctx context.Context, q pggen.Querier) error {
 		if err := s.db.saveTarball(ctx, versionID, tarball); err != nil {
 			return err
 		}
+		if err := s.db.saveMetadata(ctx, versionID, metadata); err != nil {
+			return err
+		}
 		err := s.db.updateModuleVersionStatus(ctx, UpdateModuleVersionStatusOptions{
 			ID:     versionID,
 			Status: ModuleVersionStatusOK,
@@ -457,6 +502,13 @@ func (s *Service) uploadVersion(ctx context.Context, versionID string, tarball [
 		if err != nil {
 			return err
 		}
+		// a module published by upload rather than from a VCS repository is
+		// set up once its first version is uploaded.
+		if module.Connection == nil && module.Status != ModuleStatusSetupComplete {
+			if err := s.db.updateModuleStatus(ctx, module.ID, ModuleStatusSetupComplete); err != nil {
+				return err
+			}
+		}
 		// re-retrieve module so that includes the above version with updated
 		// status
 		_, err = s.db.getModuleByID(ctx, module.ID)
@@ -474,6 +526,29 @@ func (s *Service) uploadVersion(ctx context.Context, versionID string, tarball [
 	return nil
 }
 
+// receiveVersion uploads the tarball of a module version published via the
+// API, and should be accessed via signed URL. Unlike uploadVersion, an invalid
+// tarball is reported to the uploader, and a version cannot be uploaded more
+// than once.
+func (s *Service) receiveVersion(ctx context.Context, versionID string, tarball []byte) error {
+	module, err := s.db.getModuleByVersionID(ctx, versionID)
+	if err != nil {
+		return err
+	}
+	if modver := module.versionByID(versionID); modver != nil && modver.Status == ModuleVersionStatusOK {
+		return ErrVersionUploaded
+	}
+	if err := s.uploadVersion(ctx, versionID, tarball); err != nil {
+		return err
+	}
+	// uploadVersion records an invalid tarball in the version's status
+	// rather than returning an error.
+	if _, err := parseModule(tarball); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidModuleTarball, err)
+	}
+	return nil
+}
+
 // downloadVersion should be accessed via signed URL
 func (s *Service) downloadVersion(ctx context.Context, versionID string) ([]byte, error) {
 	tarball, err := s.db.getTarball(ctx, versionID)
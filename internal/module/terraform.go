@@ -2,44 +2,224 @@ package module
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"html/template"
 	"os"
 	"path"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-config-inspect/tfconfig"
 	"github.com/pkg/errors"
 	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/http/html"
 )
 
-// TerraformModule is a module of terraform configuration
-type TerraformModule struct {
-	*tfconfig.Module
+type (
+	// ModuleMetadata describes the contents of a module version, parsed from
+	// its tarball when the version is published. Its JSON encoding follows
+	// that of the module registry API.
+	ModuleMetadata struct {
+		Root       ModuleDetails   `json:"root"`
+		Submodules []ModuleDetails `json:"submodules"`
+		Examples   []ModuleDetails `json:"examples"`
 
-	readme []byte
+		// ReadmeHTML is the root module's README rendered as HTML.
+		ReadmeHTML template.HTML `json:"-"`
+	}
+
+	// ModuleDetails describes the root module, a submodule, or an example.
+	ModuleDetails struct {
+		Path                 string               `json:"path"`
+		Readme               string               `json:"readme"`
+		Empty                bool                 `json:"empty"`
+		Inputs               []ModuleInput        `json:"inputs"`
+		Outputs              []ModuleOutput       `json:"outputs"`
+		Dependencies         []ModuleDependency   `json:"dependencies"`
+		ProviderDependencies []ProviderDependency `json:"provider_dependencies"`
+		Resources            []ModuleResource     `json:"resources"`
+	}
+
+	ModuleInput struct {
+		Name        string `json:"name"`
+		Type        string `json:"type"`
+		Description string `json:"description"`
+		// Default is the JSON encoded default value, or an empty string if
+		// there is no default.
+		Default  string `json:"default"`
+		Required bool   `json:"required"`
+	}
+
+	ModuleOutput struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	// ModuleDependency is a call to another module.
+	ModuleDependency struct {
+		Name    string `json:"name"`
+		Source  string `json:"source"`
+		Version string `json:"version"`
+	}
+
+	ProviderDependency struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+		Source    string `json:"source"`
+		Version   string `json:"version"`
+	}
+
+	ModuleResource struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	}
+)
+
+// RequiredInputs returns the inputs that have no default value.
+func (d ModuleDetails) RequiredInputs() (required []ModuleInput) {
+	for _, input := range d.Inputs {
+		if input.Required {
+			required = append(required, input)
+		}
+	}
+	return
 }
 
-func unmarshalTerraformModule(tarball []byte) (*TerraformModule, error) {
+// parseModule unpacks a module tarball and parses its contents, returning an
+// error if the module is invalid.
+func parseModule(tarball []byte) (*ModuleMetadata, error) {
 	dir, err := os.MkdirTemp("", "")
 	if err != nil {
 		return nil, errors.Wrap(err, "creating temporary directory")
 	}
+	defer os.RemoveAll(dir)
+
 	if err := internal.Unpack(bytes.NewReader(tarball), dir); err != nil {
 		return nil, errors.Wrap(err, "extracting tarball")
 	}
 
-	// parse module to check that it is valid
-	mod, diags := tfconfig.LoadModule(dir)
+	root, err := parseModuleDir(dir, "")
+	if err != nil {
+		return nil, err
+	}
+	metadata := &ModuleMetadata{
+		Root:       *root,
+		ReadmeHTML: html.MarkdownToHTML([]byte(root.Readme)),
+	}
+
+	// submodules and examples are found in sub-directories of the modules/
+	// and examples/ directories respectively
+	for _, nested := range []struct {
+		parent string
+		dst    *[]ModuleDetails
+	}{
+		{"modules", &metadata.Submodules},
+		{"examples", &metadata.Examples},
+	} {
+		entries, err := os.ReadDir(filepath.Join(dir, nested.parent))
+		if err != nil {
+			// ignore missing directory
+			continue
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			details, err := parseModuleDir(dir, path.Join(nested.parent, entry.Name()))
+			if err != nil {
+				return nil, err
+			}
+			*nested.dst = append(*nested.dst, *details)
+		}
+	}
+	return metadata, nil
+}
+
+// parseModuleDir parses the module in the directory at relpath within root.
+func parseModuleDir(root, relpath string) (*ModuleDetails, error) {
+	mod, diags := tfconfig.LoadModule(filepath.Join(root, relpath))
 	if diags.HasErrors() {
 		return nil, fmt.Errorf("parsing HCL: %s", diags.Error())
 	}
 
-	tfmod := &TerraformModule{Module: mod}
-
+	details := &ModuleDetails{
+		Path: relpath,
+		Empty: len(mod.Variables) == 0 && len(mod.Outputs) == 0 &&
+			len(mod.ManagedResources) == 0 && len(mod.DataResources) == 0 &&
+			len(mod.ModuleCalls) == 0,
+	}
 	// retrieve readme if there is one
-	if readme, err := os.ReadFile(path.Join(dir, "README.md")); err == nil {
-		tfmod.readme = readme
+	if readme, err := os.ReadFile(filepath.Join(root, relpath, "README.md")); err == nil {
+		details.Readme = string(readme)
+	}
+	for _, v := range mod.Variables {
+		input := ModuleInput{
+			Name:        v.Name,
+			Type:        v.Type,
+			Description: v.Description,
+			Required:    v.Required,
+		}
+		if !v.Required {
+			def, err := json.Marshal(v.Default)
+			if err != nil {
+				return nil, fmt.Errorf("encoding default value for %s: %w", v.Name, err)
+			}
+			input.Default = string(def)
+		}
+		details.Inputs = append(details.Inputs, input)
+	}
+	for _, o := range mod.Outputs {
+		details.Outputs = append(details.Outputs, ModuleOutput{
+			Name:        o.Name,
+			Description: o.Description,
+		})
+	}
+	for _, call := range mod.ModuleCalls {
+		details.Dependencies = append(details.Dependencies, ModuleDependency{
+			Name:    call.Name,
+			Source:  call.Source,
+			Version: call.Version,
+		})
+	}
+	for name, req := range mod.RequiredProviders {
+		// providers without an explicit source default to the hashicorp
+		// namespace
+		source := req.Source
+		if source == "" {
+			source = "hashicorp/" + name
+		}
+		namespace := "hashicorp"
+		if parts := strings.Split(source, "/"); len(parts) > 1 {
+			namespace = parts[len(parts)-2]
+		}
+		details.ProviderDependencies = append(details.ProviderDependencies, ProviderDependency{
+			Name:      name,
+			Namespace: namespace,
+			Source:    source,
+			Version:   strings.Join(req.VersionConstraints, ", "),
+		})
+	}
+	for _, res := range mod.ManagedResources {
+		details.Resources = append(details.Resources, ModuleResource{
+			Name: res.Name,
+			Type: res.Type,
+		})
 	}
 
-	// valid module
-	return tfmod, nil
+	// sort everything because tfconfig returns maps
+	sort.Slice(details.Inputs, func(i, j int) bool { return details.Inputs[i].Name < details.Inputs[j].Name })
+	sort.Slice(details.Outputs, func(i, j int) bool { return details.Outputs[i].Name < details.Outputs[j].Name })
+	sort.Slice(details.Dependencies, func(i, j int) bool { return details.Dependencies[i].Name < details.Dependencies[j].Name })
+	sort.Slice(details.ProviderDependencies, func(i, j int) bool {
+		return details.ProviderDependencies[i].Name < details.ProviderDependencies[j].Name
+	})
+	sort.Slice(details.Resources, func(i, j int) bool {
+		if details.Resources[i].Type != details.Resources[j].Type {
+			return details.Resources[i].Type < details.Resources[j].Type
+		}
+		return details.Resources[i].Name < details.Resources[j].Name
+	})
+	return details, nil
 }
@@ -0,0 +1,84 @@
+package module
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+)
+
+func TestParseModule(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"README.md": "# VPC",
+		"main.tf": `
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = ">= 5.0"
+    }
+  }
+}
+
+variable "name" {
+  type        = string
+  description = "name of the vpc"
+}
+
+variable "cidr" {
+  type    = string
+  default = "10.0.0.0/16"
+}
+
+resource "aws_vpc" "this" {}
+
+module "subnets" {
+  source = "./modules/subnets"
+}
+
+output "id" {
+  description = "id of the vpc"
+  value       = aws_vpc.this.id
+}
+`,
+		"modules/subnets/main.tf": `output "ids" { value = [] }`,
+		"examples/simple/main.tf": `module "vpc" { source = "../.." }`,
+	}
+	for path, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, path)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, path), []byte(content), 0o644))
+	}
+	tarball, err := internal.Pack(dir)
+	require.NoError(t, err)
+
+	got, err := parseModule(tarball)
+	require.NoError(t, err)
+
+	assert.Equal(t, "# VPC", got.Root.Readme)
+	assert.Contains(t, string(got.ReadmeHTML), "<h1")
+	assert.Equal(t, []ModuleInput{
+		{Name: "cidr", Type: "string", Default: `"10.0.0.0/16"`},
+		{Name: "name", Type: "string", Description: "name of the vpc", Required: true},
+	}, got.Root.Inputs)
+	assert.Equal(t, []ModuleInput{
+		{Name: "name", Type: "string", Description: "name of the vpc", Required: true},
+	}, got.Root.RequiredInputs())
+	assert.Equal(t, []ModuleOutput{{Name: "id", Description: "id of the vpc"}}, got.Root.Outputs)
+	assert.Equal(t, []ModuleDependency{{Name: "subnets", Source: "./modules/subnets"}}, got.Root.Dependencies)
+	assert.Equal(t, []ProviderDependency{
+		{Name: "aws", Namespace: "hashicorp", Source: "hashicorp/aws", Version: ">= 5.0"},
+	}, got.Root.ProviderDependencies)
+	assert.Equal(t, []ModuleResource{{Name: "this", Type: "aws_vpc"}}, got.Root.Resources)
+
+	if assert.Len(t, got.Submodules, 1) {
+		assert.Equal(t, "modules/subnets", got.Submodules[0].Path)
+		assert.Equal(t, []ModuleOutput{{Name: "ids"}}, got.Submodules[0].Outputs)
+	}
+	if assert.Len(t, got.Examples, 1) {
+		assert.Equal(t, "examples/simple", got.Examples[0].Path)
+	}
+}
@@ -47,8 +47,8 @@ func (f *fakeService) GetVCSClient(ctx context.Context, providerID string) (vcs.
 	return &fakeModulesCloudClient{repos: f.repos}, nil
 }
 
-func (f *fakeService) GetModuleInfo(context.Context, string) (*TerraformModule, error) {
-	return unmarshalTerraformModule(f.tarball)
+func (f *fakeService) GetModuleMetadata(context.Context, string) (*ModuleMetadata, error) {
+	return parseModule(f.tarball)
 }
 
 func (f *fakeService) Hostname() string {
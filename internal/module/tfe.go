@@ -0,0 +1,276 @@
+package module
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/leg100/surl"
+	"github.com/tofutf/tofutf/internal"
+	otfhttp "github.com/tofutf/tofutf/internal/http"
+	"github.com/tofutf/tofutf/internal/http/decode"
+	"github.com/tofutf/tofutf/internal/tfeapi"
+	"github.com/tofutf/tofutf/internal/tfeapi/types"
+)
+
+// uploadPath is the path of the signed URL for uploading the tarball of a
+// module version.
+func uploadPath(versionID string) string {
+	return path.Join("/modules/upload", versionID)
+}
+
+type tfeHandlers struct {
+	*surl.Signer
+	*tfeapi.Responder
+
+	svc *Service
+}
+
+func (h *tfeHandlers) addHandlers(r *mux.Router) {
+	// signed route for uploading the tarball of a module version
+	signed := r.PathPrefix("/signed/{signature.expiry}").Subrouter()
+	signed.Use(internal.VerifySignedURL(h.Signer))
+	signed.HandleFunc("/modules/upload/{module_version_id}", h.uploadVersion).Methods("PUT")
+
+	r = r.PathPrefix(tfeapi.APIPrefixV2).Subrouter()
+
+	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/private-registry/modules
+	r.HandleFunc("/organizations/{organization_name}/registry-modules", h.createModule).Methods("POST")
+	r.HandleFunc("/organizations/{organization_name}/registry-modules/{registry_name}/{namespace}/{name}/{provider}", h.getModule).Methods("GET")
+	r.HandleFunc("/organizations/{organization_name}/registry-modules/{registry_name}/{namespace}/{name}/{provider}", h.deleteModule).Methods("DELETE")
+	r.HandleFunc("/organizations/{organization_name}/registry-modules/{registry_name}/{namespace}/{name}/{provider}/versions", h.createVersion).Methods("POST")
+	// legacy route, still used by go-tfe to create versions
+	r.HandleFunc("/registry-modules/{organization_name}/{name}/{provider}/versions", h.createVersion).Methods("POST")
+}
+
+type moduleRouteParams struct {
+	Organization string  `schema:"organization_name,required"`
+	RegistryName *string `schema:"registry_name"`
+	Namespace    *string `schema:"namespace"`
+	Name         string  `schema:"name,required"`
+	Provider     string  `schema:"provider,required"`
+}
+
+func (p moduleRouteParams) options() (GetModuleOptions, error) {
+	// registry and namespace are absent from the legacy route
+	if p.RegistryName != nil && p.Namespace != nil {
+		if err := tfeapi.CheckRegistry(p.Organization, *p.RegistryName, *p.Namespace); err != nil {
+			return GetModuleOptions{}, err
+		}
+	}
+	return GetModuleOptions{
+		Organization: p.Organization,
+		Name:         p.Name,
+		Provider:     p.Provider,
+	}, nil
+}
+
+func (h *tfeHandlers) createModule(w http.ResponseWriter, r *http.Request) {
+	org, err := decode.Param("organization_name", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	var params types.RegistryModuleCreateOptions
+	if err := tfeapi.Unmarshal(r.Body, &params); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	// registry and namespace are optional, defaulting to the private registry
+	// of the organization
+	registryName, namespace := params.RegistryName, params.Namespace
+	if registryName == "" {
+		registryName = tfeapi.PrivateRegistry
+	}
+	if namespace == "" {
+		namespace = org
+	}
+	if err := tfeapi.CheckRegistry(org, registryName, namespace); err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	module, err := h.svc.CreateModule(r.Context(), CreateOptions{
+		Organization: org,
+		Name:         internal.NewStringFromPtr(params.Name),
+		Provider:     internal.NewStringFromPtr(params.Provider),
+	})
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	h.Respond(w, r, h.toModule(module), http.StatusCreated)
+}
+
+func (h *tfeHandlers) getModule(w http.ResponseWriter, r *http.Request) {
+	var params moduleRouteParams
+	if err := decode.Route(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	opts, err := params.options()
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	module, err := h.svc.GetModule(r.Context(), opts)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	h.Respond(w, r, h.toModule(module), http.StatusOK)
+}
+
+func (h *tfeHandlers) deleteModule(w http.ResponseWriter, r *http.Request) {
+	var params moduleRouteParams
+	if err := decode.Route(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	opts, err := params.options()
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	module, err := h.svc.GetModule(r.Context(), opts)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	if _, err := h.svc.DeleteModule(r.Context(), module.ID); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *tfeHandlers) createVersion(w http.ResponseWriter, r *http.Request) {
+	var params moduleRouteParams
+	if err := decode.Route(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	opts, err := params.options()
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+	var payload types.RegistryModuleCreateVersionOptions
+	if err := tfeapi.Unmarshal(r.Body, &payload); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	module, err := h.svc.GetModule(r.Context(), opts)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	if module.Connection != nil {
+		tfeapi.Error(w, &internal.HTTPError{
+			Code:    http.StatusUnprocessableEntity,
+			Message: "versions of a module connected to a VCS repository are published from its tags",
+		})
+		return
+	}
+	version, err := h.svc.CreateVersion(r.Context(), CreateModuleVersionOptions{
+		ModuleID: module.ID,
+		Version:  internal.NewStringFromPtr(payload.Version),
+	})
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	signed, err := h.Sign(uploadPath(version.ID), time.Hour)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	links := map[string]string{
+		"upload": otfhttp.Absolute(r, signed),
+	}
+	h.RespondWithLinks(w, r, h.toVersion(module, version), http.StatusCreated, links)
+}
+
+func (h *tfeHandlers) uploadVersion(w http.ResponseWriter, r *http.Request) {
+	id, err := decode.Param("module_version_id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	buf := new(bytes.Buffer)
+	if _, err := io.Copy(buf, r.Body); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	if err := h.svc.receiveVersion(r.Context(), id, buf.Bytes()); err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+}
+
+func (h *tfeHandlers) toModule(from *Module) *types.RegistryModule {
+	to := &types.RegistryModule{
+		ID:           from.ID,
+		Name:         from.Name,
+		Provider:     from.Provider,
+		RegistryName: tfeapi.PrivateRegistry,
+		Namespace:    from.Organization,
+		Status:       string(from.Status),
+		CreatedAt:    from.CreatedAt,
+		UpdatedAt:    from.UpdatedAt,
+		Organization: &types.Organization{Name: from.Organization},
+	}
+	for _, modver := range from.Versions {
+		to.VersionStatuses = append(to.VersionStatuses, types.RegistryModuleVersionStatuses{
+			Version: modver.Version,
+			Status:  string(modver.Status),
+			Error:   modver.StatusError,
+		})
+	}
+	return to
+}
+
+func (h *tfeHandlers) toVersion(module *Module, from *ModuleVersion) *types.RegistryModuleVersion {
+	return &types.RegistryModuleVersion{
+		ID:             from.ID,
+		Source:         "tfe-api",
+		Status:         string(from.Status),
+		Version:        from.Version,
+		CreatedAt:      from.CreatedAt,
+		UpdatedAt:      from.UpdatedAt,
+		RegistryModule: &types.RegistryModule{ID: module.ID},
+	}
+}
+
+// toHTTPError reports invalid modules and versions as a 422.
+func toHTTPError(err error) error {
+	for _, invalid := range []error{
+		tfeapi.ErrInvalidRegistryName,
+		tfeapi.ErrInvalidNamespace,
+		ErrInvalidModuleVersion,
+		ErrInvalidModuleTarball,
+		ErrVersionUploaded,
+		internal.ErrInvalidName,
+		internal.ErrRequiredName,
+		internal.ErrEmptyValue,
+	} {
+		if errors.Is(err, invalid) {
+			return &internal.HTTPError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}
+		}
+	}
+	return err
+}
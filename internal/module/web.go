@@ -3,7 +3,6 @@ package module
 import (
 	"context"
 	"errors"
-	"html/template"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -42,7 +41,7 @@ type (
 	// webModulesClient provides web handlers with access to modules
 	webModulesClient interface {
 		GetModuleByID(ctx context.Context, id string) (*Module, error)
-		GetModuleInfo(ctx context.Context, versionID string) (*TerraformModule, error)
+		GetModuleMetadata(ctx context.Context, versionID string) (*ModuleMetadata, error)
 		ListModules(context.Context, ListModulesOptions) ([]*Module, error)
 		PublishModule(context.Context, PublishOptions) (*Module, error)
 		DeleteModule(ctx context.Context, id string) (*Module, error)
@@ -116,35 +115,26 @@ func (h *webHandlers) get(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var (
-		modver *ModuleVersion
-		tfmod  *TerraformModule
-		readme template.HTML
+		modver   *ModuleVersion
+		metadata *ModuleMetadata
 	)
 	if params.Version != nil {
 		modver = module.Version(*params.Version)
 	} else {
 		modver = module.Latest()
 	}
-	if modver != nil {
-		tfmod, err = h.client.GetModuleInfo(r.Context(), modver.ID)
+	if modver != nil && modver.Status == ModuleVersionStatusOK {
+		metadata, err = h.client.GetModuleMetadata(r.Context(), modver.ID)
 		if err != nil {
 			h.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 	}
 
-	switch module.Status {
-	case ModuleStatusSetupComplete:
-		if tfmod != nil {
-			readme = html.MarkdownToHTML(tfmod.readme)
-		}
-	}
-
 	h.Render("module_get.tmpl", w, struct {
 		organization.OrganizationPage
 		Module                    *Module
-		TerraformModule           *TerraformModule
-		Readme                    template.HTML
+		Metadata                  *ModuleMetadata
 		CurrentVersion            *ModuleVersion
 		Hostname                  string
 		ModuleStatusPending       ModuleStatus
@@ -155,8 +145,7 @@ func (h *webHandlers) get(w http.ResponseWriter, r *http.Request) {
 	}{
 		OrganizationPage:          organization.NewPage(r, module.ID, module.Organization),
 		Module:                    module,
-		TerraformModule:           tfmod,
-		Readme:                    readme,
+		Metadata:                  metadata,
 		CurrentVersion:            modver,
 		Hostname:                  h.system.Hostname(),
 		ModuleStatusPending:       ModuleStatusPending,
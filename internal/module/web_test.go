@@ -60,7 +60,7 @@ func TestGetModule(t *testing.T) {
 			mod: Module{
 				Connection: &connections.Connection{},
 				Status:     ModuleStatusSetupComplete,
-				Versions:   []ModuleVersion{{Version: "1.0.0"}},
+				Versions:   []ModuleVersion{{Version: "1.0.0", Status: ModuleVersionStatusOK}},
 			},
 		},
 	}
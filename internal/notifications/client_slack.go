@@ -35,6 +35,10 @@ func newSlackClient(cfg *Config) (*slackClient, error) {
 }
 
 func (c *slackClient) Publish(ctx context.Context, n *notification) error {
+	summary := fmt.Sprintf("*run %s*", strings.ReplaceAll(string(n.run.Status), "_", " "))
+	if n.trigger == TriggerAssessmentDrifted {
+		summary = "*drift detected*"
+	}
 	data, err := json.Marshal(slackMessage{
 		Blocks: []slackBlock{
 			{
@@ -48,7 +52,7 @@ func (c *slackClient) Publish(ctx context.Context, n *notification) error {
 				Type: "section",
 				Text: &slackBlock{
 					Type: "mrkdwn",
-					Text: fmt.Sprintf("*run %s*", strings.ReplaceAll(string(n.run.Status), "_", " ")),
+					Text: summary,
 				},
 			},
 		},
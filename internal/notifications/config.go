@@ -29,6 +29,10 @@ const (
 	TriggerApplying       Trigger = "run:applying"
 	TriggerCompleted      Trigger = "run:completed"
 	TriggerErrored        Trigger = "run:errored"
+
+	// TriggerAssessmentDrifted is triggered when a health assessment detects
+	// drift.
+	TriggerAssessmentDrifted Trigger = "assessment:drifted"
 )
 
 var (
@@ -178,12 +182,20 @@ func (c *Config) update(opts UpdateConfigOptions) error {
 // matchTrigger determines whether the config has a trigger that matches the
 // given run state
 func (c *Config) matchTrigger(r *run.Run) (Trigger, bool) {
+	if r.Source == run.SourceHealthAssessment {
+		// health assessments only ever trigger a notification upon detecting
+		// drift.
+		if r.Status == run.RunPlannedAndFinished && r.Drifted() {
+			return TriggerAssessmentDrifted, c.hasTrigger(TriggerAssessmentDrifted)
+		}
+		return "", false
+	}
 	switch r.Status {
 	case run.RunPending:
 		return TriggerCreated, c.hasTrigger(TriggerCreated)
 	case run.RunPlanning:
 		return TriggerPlanning, c.hasTrigger(TriggerPlanning)
-	case run.RunPlanned:
+	case run.RunPlanned, run.RunPolicyChecked, run.RunPolicySoftFailed:
 		return TriggerNeedsAttention, c.hasTrigger(TriggerNeedsAttention)
 	case run.RunApplying:
 		return TriggerApplying, c.hasTrigger(TriggerApplying)
@@ -208,7 +220,8 @@ func validTriggers(triggers []Trigger) error {
 			TriggerNeedsAttention,
 			TriggerApplying,
 			TriggerCompleted,
-			TriggerErrored:
+			TriggerErrored,
+			TriggerAssessmentDrifted:
 		default:
 			return ErrInvalidTrigger
 		}
@@ -24,6 +24,21 @@ func TestNotifier_handleRun(t *testing.T) {
 		Status:      run.RunPlanning,
 		WorkspaceID: "ws-matching",
 	}
+	driftedAssessment := &run.Run{
+		Status:      run.RunPlannedAndFinished,
+		Source:      run.SourceHealthAssessment,
+		RefreshOnly: true,
+		WorkspaceID: "ws-matching",
+		Plan: run.Phase{
+			ResourceReport: &run.Report{Changes: 1},
+		},
+	}
+	undriftedAssessment := &run.Run{
+		Status:      run.RunPlannedAndFinished,
+		Source:      run.SourceHealthAssessment,
+		RefreshOnly: true,
+		WorkspaceID: "ws-matching",
+	}
 	disabledConfig := &Config{
 		URL:         internal.String(""),
 		WorkspaceID: "ws-matching",
@@ -46,6 +61,12 @@ func TestNotifier_handleRun(t *testing.T) {
 		WorkspaceID: "ws-matching",
 		Triggers:    []Trigger{TriggerApplying},
 	}
+	assessmentConfig := &Config{
+		URL:         internal.String(""),
+		Enabled:     true,
+		WorkspaceID: "ws-matching",
+		Triggers:    []Trigger{TriggerAssessmentDrifted, TriggerCompleted},
+	}
 	configForDifferentWorkspace := &Config{
 		URL:         internal.String(""),
 		WorkspaceID: "ws-zzz",
@@ -65,6 +86,8 @@ func TestNotifier_handleRun(t *testing.T) {
 		{"enabled but no triggers", planningRun, configWithNoTriggers, false},
 		{"enabled but mis-matching triggers", planningRun, configWithDifferentTriggers, false},
 		{"matching trigger", planningRun, enabledConfig, true},
+		{"drifted assessment", driftedAssessment, assessmentConfig, true},
+		{"undrifted assessment", undriftedAssessment, assessmentConfig, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -22,6 +22,7 @@ func (a *Authorizer) CanAccess(ctx context.Context, action rbac.Action, name str
 		return subj, nil
 	}
 	if subj.CanAccessOrganization(action, name) {
+		internal.RecordAuthorized(ctx, subj, action, name, name)
 		return subj, nil
 	}
 	a.Logger.Error("unauthorized action", "organization", name, "action", action.String(), "subject", subj)
@@ -2,12 +2,14 @@ package organization
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/resource"
 	"github.com/tofutf/tofutf/internal/sql"
 	"github.com/tofutf/tofutf/internal/sql/pggen"
+	"github.com/tofutf/tofutf/internal/tokens"
 )
 
 type (
@@ -31,6 +33,8 @@ type row struct {
 	CollaboratorAuthPolicy     pgtype.Text        `json:"collaborator_auth_policy"`
 	AllowForceDeleteWorkspaces pgtype.Bool        `json:"allow_force_delete_workspaces"`
 	CostEstimationEnabled      pgtype.Bool        `json:"cost_estimation_enabled"`
+	PlanTimeout                pgtype.Int4        `json:"plan_timeout"`
+	ApplyTimeout               pgtype.Int4        `json:"apply_timeout"`
 }
 
 // row converts an organization database row into an
@@ -52,6 +56,14 @@ func (r row) toOrganization() *Organization {
 		sessionTimeoutInt := int(r.SessionTimeout.Int32)
 		org.SessionTimeout = &sessionTimeoutInt
 	}
+	if r.PlanTimeout.Valid {
+		planTimeout := int(r.PlanTimeout.Int32)
+		org.PlanTimeout = &planTimeout
+	}
+	if r.ApplyTimeout.Valid {
+		applyTimeout := int(r.ApplyTimeout.Int32)
+		org.ApplyTimeout = &applyTimeout
+	}
 	if r.Email.Valid {
 		org.Email = &r.Email.String
 	}
@@ -79,6 +91,8 @@ func (db *pgdb) create(ctx context.Context, org *Organization) error {
 			CollaboratorAuthPolicy:     sql.StringPtr(org.CollaboratorAuthPolicy),
 			CostEstimationEnabled:      sql.Bool(org.CostEstimationEnabled),
 			AllowForceDeleteWorkspaces: sql.Bool(org.AllowForceDeleteWorkspaces),
+			PlanTimeout:                sql.Int4Ptr(org.PlanTimeout),
+			ApplyTimeout:               sql.Int4Ptr(org.ApplyTimeout),
 		})
 		if err != nil {
 			return sql.Error(err)
@@ -109,6 +123,8 @@ func (db *pgdb) update(ctx context.Context, name string, fn func(*Organization)
 			SessionTimeout:             sql.Int4Ptr(org.SessionTimeout),
 			UpdatedAt:                  sql.Timestamptz(org.UpdatedAt),
 			AllowForceDeleteWorkspaces: sql.Bool(org.AllowForceDeleteWorkspaces),
+			PlanTimeout:                sql.Int4Ptr(org.PlanTimeout),
+			ApplyTimeout:               sql.Int4Ptr(org.ApplyTimeout),
 		})
 		if err != nil {
 			return err
@@ -190,6 +206,8 @@ type tokenRow struct {
 	CreatedAt           pgtype.Timestamptz `json:"created_at"`
 	OrganizationName    pgtype.Text        `json:"organization_name"`
 	Expiry              pgtype.Timestamptz `json:"expiry"`
+	Scope               pgtype.Text        `json:"scope"`
+	LastUsedAt          pgtype.Timestamptz `json:"last_used_at"`
 }
 
 func (result tokenRow) toToken() *OrganizationToken {
@@ -197,13 +215,30 @@ func (result tokenRow) toToken() *OrganizationToken {
 		ID:           result.OrganizationTokenID.String,
 		CreatedAt:    result.CreatedAt.Time.UTC(),
 		Organization: result.OrganizationName.String,
+		Scope:        tokens.Scope(result.Scope.String),
 	}
 	if result.Expiry.Valid {
 		ot.Expiry = internal.Time(result.Expiry.Time.UTC())
 	}
+	if result.LastUsedAt.Valid {
+		ot.LastUsedAt = internal.Time(result.LastUsedAt.Time.UTC())
+	}
 	return ot
 }
 
+func (result tokenRow) toStaleToken() *tokens.StaleToken {
+	ot := result.toToken()
+	return &tokens.StaleToken{
+		ID:         ot.ID,
+		Kind:       OrganizationTokenKind,
+		Owner:      ot.Organization,
+		Scope:      ot.Scope,
+		CreatedAt:  ot.CreatedAt,
+		Expiry:     ot.Expiry,
+		LastUsedAt: ot.LastUsedAt,
+	}
+}
+
 func (db *pgdb) upsertOrganizationToken(ctx context.Context, token *OrganizationToken) error {
 	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
 		_, err := q.UpsertOrganizationToken(ctx, pggen.UpsertOrganizationTokenParams{
@@ -211,6 +246,7 @@ func (db *pgdb) upsertOrganizationToken(ctx context.Context, token *Organization
 			OrganizationName:    sql.String(token.Organization),
 			CreatedAt:           sql.Timestamptz(token.CreatedAt),
 			Expiry:              sql.TimestamptzPtr(token.Expiry),
+			Scope:               sql.String(string(token.Scope)),
 		})
 
 		return err
@@ -250,16 +286,48 @@ func (db *pgdb) getOrganizationTokenByID(ctx context.Context, tokenID string) (*
 		if err != nil {
 			return nil, sql.Error(err)
 		}
-		ot := &OrganizationToken{
-			ID:           result.OrganizationTokenID.String,
-			CreatedAt:    result.CreatedAt.Time.UTC(),
-			Organization: result.OrganizationName.String,
+		return tokenRow(result).toToken(), nil
+	})
+}
+
+func (db *pgdb) updateOrganizationTokenLastUsedAt(ctx context.Context, tokenID string, lastUsedAt time.Time) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.UpdateOrganizationTokenLastUsedAt(ctx, sql.Timestamptz(lastUsedAt), sql.String(tokenID))
+		if err != nil {
+			return sql.Error(err)
+		}
+		return nil
+	})
+}
+
+// findStaleOrganizationTokens finds organization tokens that have expired or
+// have not been used since the cutoff, deleting them too if revoke is true.
+func (db *pgdb) findStaleOrganizationTokens(ctx context.Context, cutoff time.Time, revoke bool) ([]*tokens.StaleToken, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]*tokens.StaleToken, error) {
+		var rows []tokenRow
+		if revoke {
+			result, err := q.DeleteStaleOrganizationTokens(ctx, sql.Timestamptz(cutoff))
+			if err != nil {
+				return nil, sql.Error(err)
+			}
+			for _, r := range result {
+				rows = append(rows, tokenRow(r))
+			}
+		} else {
+			result, err := q.FindStaleOrganizationTokens(ctx, sql.Timestamptz(cutoff))
+			if err != nil {
+				return nil, sql.Error(err)
+			}
+			for _, r := range result {
+				rows = append(rows, tokenRow(r))
+			}
 		}
 
-		if result.Expiry.Valid {
-			ot.Expiry = internal.Time(result.Expiry.Time.UTC())
+		stale := make([]*tokens.StaleToken, len(rows))
+		for i, r := range rows {
+			stale[i] = r.toStaleToken()
 		}
-		return ot, nil
+		return stale, nil
 	})
 }
 
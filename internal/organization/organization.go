@@ -21,6 +21,12 @@ type (
 		UpdatedAt time.Time `jsonapi:"attribute" json:"updated-at"`
 		Name      string    `jsonapi:"attribute" json:"name"`
 
+		// PlanTimeout and ApplyTimeout are the maximum durations in minutes
+		// of the plan and apply phases of the organization's runs. Either may
+		// be overridden by a workspace. Nil means there is no timeout.
+		PlanTimeout  *int `jsonapi:"attribute" json:"plan_timeout"`
+		ApplyTimeout *int `jsonapi:"attribute" json:"apply_timeout"`
+
 		// TFE fields that OTF does not support but persists merely to pass the
 		// go-tfe integration tests
 		Email                      *string
@@ -36,6 +42,10 @@ type (
 		Name            *string
 		SessionRemember *int
 		SessionTimeout  *int
+		// PlanTimeout and ApplyTimeout set the maximum durations in minutes
+		// of run phases. Zero removes the timeout.
+		PlanTimeout  *int
+		ApplyTimeout *int
 
 		// TFE fields that OTF does not support but persists merely to pass the
 		// go-tfe integration tests
@@ -48,7 +58,9 @@ type (
 	// CreateOptions represents the options for creating an organization. See
 	// types.CreateOptions for more details.
 	CreateOptions struct {
-		Name *string
+		Name         *string
+		PlanTimeout  *int
+		ApplyTimeout *int
 
 		// TFE fields that OTF does not support but persists merely to pass the
 		// go-tfe integration tests
@@ -85,6 +97,16 @@ func NewOrganization(opts CreateOptions) (*Organization, error) {
 	if opts.CostEstimationEnabled != nil {
 		org.CostEstimationEnabled = *opts.CostEstimationEnabled
 	}
+	if opts.PlanTimeout != nil {
+		if err := setTimeout(&org.PlanTimeout, *opts.PlanTimeout); err != nil {
+			return nil, err
+		}
+	}
+	if opts.ApplyTimeout != nil {
+		if err := setTimeout(&org.ApplyTimeout, *opts.ApplyTimeout); err != nil {
+			return nil, err
+		}
+	}
 	return &org, nil
 }
 
@@ -112,6 +134,29 @@ func (org *Organization) Update(opts UpdateOptions) error {
 	if opts.AllowForceDeleteWorkspaces != nil {
 		org.AllowForceDeleteWorkspaces = *opts.AllowForceDeleteWorkspaces
 	}
+	if opts.PlanTimeout != nil {
+		if err := setTimeout(&org.PlanTimeout, *opts.PlanTimeout); err != nil {
+			return err
+		}
+	}
+	if opts.ApplyTimeout != nil {
+		if err := setTimeout(&org.ApplyTimeout, *opts.ApplyTimeout); err != nil {
+			return err
+		}
+	}
 	org.UpdatedAt = internal.CurrentTimestamp(nil)
 	return nil
 }
+
+// setTimeout sets a run phase timeout in minutes; zero removes the timeout.
+func setTimeout(timeout **int, minutes int) error {
+	switch {
+	case minutes < 0:
+		return internal.ErrInvalidTimeout
+	case minutes == 0:
+		*timeout = nil
+	default:
+		*timeout = &minutes
+	}
+	return nil
+}
@@ -95,6 +95,8 @@ func NewService(opts Options) *Service {
 	opts.TokensService.RegisterKind(OrganizationTokenKind, func(ctx context.Context, tokenID string) (internal.Subject, error) {
 		return svc.getOrganizationTokenByID(ctx, tokenID)
 	})
+	opts.TokensService.RegisterUsageRecorder(OrganizationTokenKind, svc.db.updateOrganizationTokenLastUsedAt)
+	opts.TokensService.RegisterStaleTokenFinder(OrganizationTokenKind, svc.db.findStaleOrganizationTokens)
 	return &svc
 }
 
@@ -110,7 +112,8 @@ func (s *Service) WatchOrganizations(ctx context.Context) (<-chan pubsub.Event[*
 
 // Create creates an organization. Only users can create
 // organizations, or, if RestrictOrganizationCreation is true, then only the
-// site admin can create organizations. Creating an organization automatically
+// site admin can create organizations. A read-only subject cannot create
+// organizations. Creating an organization automatically
 // creates an owners team and adds creator as an owner.
 func (s *Service) Create(ctx context.Context, opts CreateOptions) (*Organization, error) {
 	creator, err := s.restrictOrganizationCreation(ctx)
@@ -250,6 +253,12 @@ func (s *Service) restrictOrganizationCreation(ctx context.Context) (internal.Su
 	if err != nil {
 		return nil, err
 	}
+	// creating an organization is not authorized via CanAccess, so a
+	// read-only subject must be rejected explicitly.
+	if internal.IsReadOnly(subject) {
+		s.logger.Error("unauthorized action", "action", rbac.CreateOrganizationAction, "subject", subject)
+		return subject, internal.ErrAccessNotPermitted
+	}
 	if s.RestrictOrganizationCreation && !subject.IsSiteAdmin() {
 		s.logger.Error("unauthorized action", "action", rbac.CreateOrganizationAction, "subject", subject)
 		return subject, internal.ErrAccessNotPermitted
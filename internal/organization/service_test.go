@@ -20,6 +20,8 @@ func TestAuthorize(t *testing.T) {
 		{"site admin", &internal.Superuser{}, false, nil},
 		{"restrict to site admin - site admin", &internal.Superuser{}, true, nil},
 		{"restrict to site admin - user", &unprivUser{}, true, internal.ErrAccessNotPermitted},
+		{"read-only user", &internal.ReadOnlySubject{Subject: &unprivUser{}}, false, internal.ErrAccessNotPermitted},
+		{"read-only site admin", &internal.ReadOnlySubject{Subject: &internal.Superuser{}}, true, internal.ErrAccessNotPermitted},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
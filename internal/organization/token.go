@@ -19,6 +19,10 @@ type (
 		Organization string
 		// Optional expiry.
 		Expiry *time.Time
+		Scope  tokens.Scope
+		// When the token was last used to authenticate a request. Nil if it
+		// has never been used.
+		LastUsedAt *time.Time
 	}
 
 	// CreateOrganizationTokenOptions are options for creating an organization token via the service
@@ -26,6 +30,8 @@ type (
 	CreateOrganizationTokenOptions struct {
 		Organization string `schema:"organization_name,required"`
 		Expiry       *time.Time
+		// Optional scope. Defaults to read-write.
+		Scope tokens.Scope
 	}
 
 	// tokenFactory constructs organization tokens
@@ -35,16 +41,22 @@ type (
 )
 
 func (f *tokenFactory) NewOrganizationToken(opts CreateOrganizationTokenOptions) (*OrganizationToken, []byte, error) {
+	scope, err := tokens.ResolveScope(opts.Scope)
+	if err != nil {
+		return nil, nil, err
+	}
 	ot := OrganizationToken{
 		ID:           internal.NewID("ot"),
 		CreatedAt:    internal.CurrentTimestamp(nil),
 		Organization: opts.Organization,
 		Expiry:       opts.Expiry,
+		Scope:        scope,
 	}
 	token, err := f.tokens.NewToken(tokens.NewTokenOptions{
 		Subject: ot.ID,
 		Kind:    OrganizationTokenKind,
 		Expiry:  opts.Expiry,
+		Scope:   scope,
 	})
 	if err != nil {
 		return nil, nil, err
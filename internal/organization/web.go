@@ -188,8 +188,10 @@ func (a *web) edit(w http.ResponseWriter, r *http.Request) {
 
 func (a *web) update(w http.ResponseWriter, r *http.Request) {
 	var params struct {
-		Name        string `schema:"name,required"`
-		UpdatedName string `schema:"new_name,required"`
+		Name         string `schema:"name,required"`
+		UpdatedName  string `schema:"new_name,required"`
+		PlanTimeout  int    `schema:"plan_timeout"`
+		ApplyTimeout int    `schema:"apply_timeout"`
 	}
 	if err := decode.All(&params, r); err != nil {
 		a.Error(w, err.Error(), http.StatusUnprocessableEntity)
@@ -197,7 +199,9 @@ func (a *web) update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	org, err := a.svc.Update(r.Context(), params.Name, UpdateOptions{
-		Name: &params.UpdatedName,
+		Name:         &params.UpdatedName,
+		PlanTimeout:  &params.PlanTimeout,
+		ApplyTimeout: &params.ApplyTimeout,
 	})
 	if err != nil {
 		a.Error(w, err.Error(), http.StatusInternalServerError)
@@ -230,11 +234,25 @@ func (a *web) delete(w http.ResponseWriter, r *http.Request) {
 //
 
 func (a *web) createOrganizationToken(w http.ResponseWriter, r *http.Request) {
-	var opts CreateOrganizationTokenOptions
-	if err := decode.Route(&opts, r); err != nil {
+	var params struct {
+		Organization string       `schema:"organization_name,required"`
+		Expiry       string       `schema:"expiry"`
+		Scope        tokens.Scope `schema:"scope"`
+	}
+	if err := decode.All(&params, r); err != nil {
+		a.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	expiry, err := tokens.ParseExpiry(params.Expiry)
+	if err != nil {
 		a.Error(w, err.Error(), http.StatusUnprocessableEntity)
 		return
 	}
+	opts := CreateOrganizationTokenOptions{
+		Organization: params.Organization,
+		Expiry:       expiry,
+		Scope:        params.Scope,
+	}
 	_, token, err := a.svc.CreateToken(r.Context(), opts)
 	if err != nil {
 		a.Error(w, err.Error(), http.StatusInternalServerError)
@@ -0,0 +1,206 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tofutf/tofutf/internal/sql"
+	"github.com/tofutf/tofutf/internal/sql/pggen"
+)
+
+type (
+	// pgdb is a policy database on postgres
+	pgdb struct {
+		*sql.Pool // provides access to generated SQL queries
+	}
+
+	// policySetRow is the result of a database query for a policy set.
+	policySetRow struct {
+		PolicySetID      pgtype.Text        `json:"policy_set_id"`
+		CreatedAt        pgtype.Timestamptz `json:"created_at"`
+		UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+		Name             pgtype.Text        `json:"name"`
+		Description      pgtype.Text        `json:"description"`
+		OrganizationName pgtype.Text        `json:"organization_name"`
+	}
+
+	// policyRow is the result of a database query for a policy.
+	policyRow struct {
+		PolicyID         pgtype.Text        `json:"policy_id"`
+		CreatedAt        pgtype.Timestamptz `json:"created_at"`
+		UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+		Name             pgtype.Text        `json:"name"`
+		EnforcementLevel pgtype.Text        `json:"enforcement_level"`
+		Source           pgtype.Text        `json:"source"`
+		PolicySetID      pgtype.Text        `json:"policy_set_id"`
+	}
+)
+
+func (r policySetRow) toPolicySet() *PolicySet {
+	return &PolicySet{
+		ID:           r.PolicySetID.String,
+		CreatedAt:    r.CreatedAt.Time.UTC(),
+		UpdatedAt:    r.UpdatedAt.Time.UTC(),
+		Name:         r.Name.String,
+		Description:  r.Description.String,
+		Organization: r.OrganizationName.String,
+	}
+}
+
+func (r policyRow) toPolicy() *Policy {
+	return &Policy{
+		ID:               r.PolicyID.String,
+		CreatedAt:        r.CreatedAt.Time.UTC(),
+		UpdatedAt:        r.UpdatedAt.Time.UTC(),
+		Name:             r.Name.String,
+		EnforcementLevel: EnforcementLevel(r.EnforcementLevel.String),
+		Source:           r.Source.String,
+		PolicySetID:      r.PolicySetID.String,
+	}
+}
+
+func (db *pgdb) createSet(ctx context.Context, set *PolicySet) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.InsertPolicySet(ctx, pggen.InsertPolicySetParams{
+			PolicySetID:      sql.String(set.ID),
+			CreatedAt:        sql.Timestamptz(set.CreatedAt),
+			UpdatedAt:        sql.Timestamptz(set.UpdatedAt),
+			Name:             sql.String(set.Name),
+			Description:      sql.String(set.Description),
+			OrganizationName: sql.String(set.Organization),
+		})
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) updateSet(ctx context.Context, id string, updateFunc func(*PolicySet) error) (*PolicySet, error) {
+	return sql.Tx(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*PolicySet, error) {
+		row, err := q.FindPolicySetForUpdate(ctx, sql.String(id))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		set := policySetRow(row).toPolicySet()
+		if err := updateFunc(set); err != nil {
+			return nil, err
+		}
+		_, err = q.UpdatePolicySet(ctx, pggen.UpdatePolicySetParams{
+			UpdatedAt:   sql.Timestamptz(set.UpdatedAt),
+			Name:        sql.String(set.Name),
+			Description: sql.String(set.Description),
+			PolicySetID: sql.String(set.ID),
+		})
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return set, nil
+	})
+}
+
+// listSets lists the policy sets in an organization, along with their
+// policies.
+func (db *pgdb) listSets(ctx context.Context, organization string) ([]*PolicySet, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]*PolicySet, error) {
+		setRows, err := q.FindPolicySetsByOrganization(ctx, sql.String(organization))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		policyRows, err := q.FindPoliciesByOrganization(ctx, sql.String(organization))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		sets := make([]*PolicySet, len(setRows))
+		lookup := make(map[string]*PolicySet, len(setRows))
+		for i, row := range setRows {
+			sets[i] = policySetRow(row).toPolicySet()
+			lookup[sets[i].ID] = sets[i]
+		}
+		for _, row := range policyRows {
+			policy := policyRow(row).toPolicy()
+			if set, ok := lookup[policy.PolicySetID]; ok {
+				set.Policies = append(set.Policies, policy)
+			}
+		}
+		return sets, nil
+	})
+}
+
+// getSet retrieves a policy set along with its policies.
+func (db *pgdb) getSet(ctx context.Context, id string) (*PolicySet, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*PolicySet, error) {
+		row, err := q.FindPolicySet(ctx, sql.String(id))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		set := policySetRow(row).toPolicySet()
+		policyRows, err := q.FindPoliciesByPolicySetID(ctx, sql.String(id))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		for _, row := range policyRows {
+			set.Policies = append(set.Policies, policyRow(row).toPolicy())
+		}
+		return set, nil
+	})
+}
+
+func (db *pgdb) deleteSet(ctx context.Context, id string) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.DeletePolicySet(ctx, sql.String(id))
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) createPolicy(ctx context.Context, policy *Policy) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.InsertPolicy(ctx, pggen.InsertPolicyParams{
+			PolicyID:         sql.String(policy.ID),
+			CreatedAt:        sql.Timestamptz(policy.CreatedAt),
+			UpdatedAt:        sql.Timestamptz(policy.UpdatedAt),
+			Name:             sql.String(policy.Name),
+			EnforcementLevel: sql.String(string(policy.EnforcementLevel)),
+			Source:           sql.String(policy.Source),
+			PolicySetID:      sql.String(policy.PolicySetID),
+		})
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) updatePolicy(ctx context.Context, id string, updateFunc func(*Policy) error) (*Policy, error) {
+	return sql.Tx(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*Policy, error) {
+		row, err := q.FindPolicyForUpdate(ctx, sql.String(id))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		policy := policyRow(row).toPolicy()
+		if err := updateFunc(policy); err != nil {
+			return nil, err
+		}
+		_, err = q.UpdatePolicy(ctx, pggen.UpdatePolicyParams{
+			UpdatedAt:        sql.Timestamptz(policy.UpdatedAt),
+			EnforcementLevel: sql.String(string(policy.EnforcementLevel)),
+			Source:           sql.String(policy.Source),
+			PolicyID:         sql.String(policy.ID),
+		})
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return policy, nil
+	})
+}
+
+func (db *pgdb) getPolicy(ctx context.Context, id string) (*Policy, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*Policy, error) {
+		row, err := q.FindPolicy(ctx, sql.String(id))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return policyRow(row).toPolicy(), nil
+	})
+}
+
+func (db *pgdb) deletePolicy(ctx context.Context, id string) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.DeletePolicy(ctx, sql.String(id))
+		return sql.Error(err)
+	})
+}
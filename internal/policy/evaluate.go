@@ -0,0 +1,101 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/open-policy-agent/opa/rego"
+)
+
+// Result is the outcome of evaluating a policy against a plan.
+type Result struct {
+	PolicySet        string           `json:"policy_set"`
+	Policy           string           `json:"policy"`
+	EnforcementLevel EnforcementLevel `json:"enforcement_level"`
+	Passed           bool             `json:"passed"`
+	// Violations are the values produced by the policy's deny rule.
+	Violations []string `json:"violations,omitempty"`
+	// Error is populated if the policy could not be evaluated, in which case
+	// the policy is deemed to have failed.
+	Error string `json:"error,omitempty"`
+}
+
+// evaluate evaluates each policy in each set against a JSON-encoded plan.
+func evaluate(ctx context.Context, sets []*PolicySet, plan []byte) ([]Result, error) {
+	if !hasPolicies(sets) {
+		return nil, nil
+	}
+	var input any
+	if err := json.Unmarshal(plan, &input); err != nil {
+		return nil, fmt.Errorf("decoding JSON plan: %w", err)
+	}
+	var results []Result
+	for _, set := range sets {
+		for _, policy := range set.Policies {
+			result := Result{
+				PolicySet:        set.Name,
+				Policy:           policy.Name,
+				EnforcementLevel: policy.EnforcementLevel,
+			}
+			violations, err := evaluatePolicy(ctx, policy, input)
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				result.Violations = violations
+				result.Passed = len(violations) == 0
+			}
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+func hasPolicies(sets []*PolicySet) bool {
+	for _, set := range sets {
+		if len(set.Policies) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluatePolicy evaluates the deny rule of the policy against the input,
+// returning any violations.
+func evaluatePolicy(ctx context.Context, policy *Policy, input any) ([]string, error) {
+	module, err := ast.ParseModule(policy.Name, policy.Source)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := rego.New(
+		rego.Query(denyRef(module).String()),
+		rego.ParsedModule(module),
+		rego.Input(input),
+	).Eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var violations []string
+	for _, result := range rs {
+		for _, expr := range result.Expressions {
+			values, ok := expr.Value.([]any)
+			if !ok {
+				return nil, fmt.Errorf("%s rule must be a set or array", denyRule)
+			}
+			for _, v := range values {
+				if msg, ok := v.(string); ok {
+					violations = append(violations, msg)
+					continue
+				}
+				// non-string violations are reported in their JSON form
+				encoded, err := json.Marshal(v)
+				if err != nil {
+					return nil, err
+				}
+				violations = append(violations, string(encoded))
+			}
+		}
+	}
+	return violations, nil
+}
@@ -0,0 +1,104 @@
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluate(t *testing.T) {
+	plan := []byte(`{"resource_changes":[{"address":"aws_s3_bucket.public","type":"aws_s3_bucket","change":{"actions":["create"],"after":{"acl":"public-read"}}}]}`)
+
+	set := func(policies ...*Policy) []*PolicySet {
+		return []*PolicySet{{Name: "default", Policies: policies}}
+	}
+
+	tests := []struct {
+		name   string
+		policy *Policy
+		want   Result
+	}{
+		{
+			name: "pass",
+			policy: &Policy{Name: "no-deletes", EnforcementLevel: HardMandatory, Source: `package terraform
+
+deny[msg] {
+	rc := input.resource_changes[_]
+	rc.change.actions[_] == "delete"
+	msg := sprintf("%s is deleted", [rc.address])
+}
+`},
+			want: Result{PolicySet: "default", Policy: "no-deletes", EnforcementLevel: HardMandatory, Passed: true},
+		},
+		{
+			name: "deny",
+			policy: &Policy{Name: "no-public-buckets", EnforcementLevel: SoftMandatory, Source: `package terraform
+
+deny[msg] {
+	rc := input.resource_changes[_]
+	rc.change.after.acl == "public-read"
+	msg := sprintf("%s is public", [rc.address])
+}
+`},
+			want: Result{
+				PolicySet:        "default",
+				Policy:           "no-public-buckets",
+				EnforcementLevel: SoftMandatory,
+				Violations:       []string{"aws_s3_bucket.public is public"},
+			},
+		},
+		{
+			name: "non-string violation",
+			policy: &Policy{Name: "addresses", EnforcementLevel: Advisory, Source: `package terraform
+
+deny[violation] {
+	rc := input.resource_changes[_]
+	violation := {"address": rc.address}
+}
+`},
+			want: Result{
+				PolicySet:        "default",
+				Policy:           "addresses",
+				EnforcementLevel: Advisory,
+				Violations:       []string{`{"address":"aws_s3_bucket.public"}`},
+			},
+		},
+		{
+			name: "deny rule is not a set",
+			policy: &Policy{Name: "boolean", EnforcementLevel: Advisory, Source: `package terraform
+
+deny := true
+`},
+			want: Result{
+				PolicySet:        "default",
+				Policy:           "boolean",
+				EnforcementLevel: Advisory,
+				Error:            "deny rule must be a set or array",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := evaluate(context.Background(), set(tt.policy), plan)
+			require.NoError(t, err)
+			require.Len(t, got, 1)
+			assert.Equal(t, tt.want, got[0])
+		})
+	}
+}
+
+func TestEvaluate_InvalidPlan(t *testing.T) {
+	sets := []*PolicySet{{Name: "default", Policies: []*Policy{{Name: "p1"}}}}
+	_, err := evaluate(context.Background(), sets, []byte("not json"))
+	assert.Error(t, err)
+}
+
+func TestEvaluate_NoPolicies(t *testing.T) {
+	// the plan is not decoded if there are no policies to evaluate
+	sets := []*PolicySet{{Name: "empty"}}
+	got, err := evaluate(context.Background(), sets, []byte("not json"))
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
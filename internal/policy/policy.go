@@ -0,0 +1,207 @@
+// Package policy provides organization-level policy sets, containing OPA
+// policies that are evaluated against the plan of each run before it can be
+// applied.
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/resource"
+)
+
+const (
+	// Advisory policies are permitted to fail, and merely warn the user.
+	Advisory EnforcementLevel = "advisory"
+	// SoftMandatory policies must pass unless a failure is overridden by an
+	// organization owner.
+	SoftMandatory EnforcementLevel = "soft-mandatory"
+	// HardMandatory policies must pass; a failure prevents the run from being
+	// applied.
+	HardMandatory EnforcementLevel = "hard-mandatory"
+
+	// denyRule is the rule each policy is expected to define; any values it
+	// produces are violations of the policy.
+	denyRule = "deny"
+)
+
+var (
+	// enforcementLevels lists the enforcement levels in order of severity.
+	enforcementLevels = []EnforcementLevel{Advisory, SoftMandatory, HardMandatory}
+
+	ErrInvalidEnforcementLevel = errors.New("invalid enforcement level: must be one of advisory, soft-mandatory, or hard-mandatory")
+	ErrEmptySource             = errors.New("policy source cannot be empty")
+)
+
+type (
+	// PolicySet is a named collection of policies belonging to an organization.
+	// Every policy in every policy set is evaluated against each run in the
+	// organization.
+	PolicySet struct {
+		ID           string
+		CreatedAt    time.Time
+		UpdatedAt    time.Time
+		Name         string
+		Description  string
+		Organization string
+		Policies     []*Policy
+	}
+
+	// Policy is a rego module defining a deny rule, the values of which are
+	// violations of the policy.
+	Policy struct {
+		ID               string
+		CreatedAt        time.Time
+		UpdatedAt        time.Time
+		Name             string
+		EnforcementLevel EnforcementLevel
+		Source           string
+		PolicySetID      string
+	}
+
+	// EnforcementLevel determines the consequences of a policy failing.
+	EnforcementLevel string
+
+	CreatePolicySetOptions struct {
+		Name        *string
+		Description *string
+	}
+
+	UpdatePolicySetOptions struct {
+		Name        *string
+		Description *string
+	}
+
+	CreatePolicyOptions struct {
+		Name             *string
+		EnforcementLevel EnforcementLevel
+		Source           string
+	}
+
+	UpdatePolicyOptions struct {
+		EnforcementLevel *EnforcementLevel
+		Source           *string
+	}
+)
+
+func newPolicySet(organization string, opts CreatePolicySetOptions) (*PolicySet, error) {
+	if err := resource.ValidateName(opts.Name); err != nil {
+		return nil, err
+	}
+	set := &PolicySet{
+		ID:           internal.NewID("polset"),
+		CreatedAt:    internal.CurrentTimestamp(nil),
+		Name:         *opts.Name,
+		Organization: organization,
+	}
+	set.UpdatedAt = set.CreatedAt
+	if opts.Description != nil {
+		set.Description = *opts.Description
+	}
+	return set, nil
+}
+
+func (s *PolicySet) update(opts UpdatePolicySetOptions) error {
+	if opts.Name != nil {
+		if err := resource.ValidateName(opts.Name); err != nil {
+			return err
+		}
+		s.Name = *opts.Name
+	}
+	if opts.Description != nil {
+		s.Description = *opts.Description
+	}
+	s.UpdatedAt = internal.CurrentTimestamp(nil)
+	return nil
+}
+
+func (s *PolicySet) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", s.ID),
+		slog.String("name", s.Name),
+		slog.String("organization", s.Organization),
+	)
+}
+
+func newPolicy(policySetID string, opts CreatePolicyOptions) (*Policy, error) {
+	if err := resource.ValidateName(opts.Name); err != nil {
+		return nil, err
+	}
+	if err := opts.EnforcementLevel.Valid(); err != nil {
+		return nil, err
+	}
+	if err := validateSource(*opts.Name, opts.Source); err != nil {
+		return nil, err
+	}
+	policy := &Policy{
+		ID:               internal.NewID("pol"),
+		CreatedAt:        internal.CurrentTimestamp(nil),
+		Name:             *opts.Name,
+		EnforcementLevel: opts.EnforcementLevel,
+		Source:           opts.Source,
+		PolicySetID:      policySetID,
+	}
+	policy.UpdatedAt = policy.CreatedAt
+	return policy, nil
+}
+
+func (p *Policy) update(opts UpdatePolicyOptions) error {
+	if opts.EnforcementLevel != nil {
+		if err := opts.EnforcementLevel.Valid(); err != nil {
+			return err
+		}
+		p.EnforcementLevel = *opts.EnforcementLevel
+	}
+	if opts.Source != nil {
+		if err := validateSource(p.Name, *opts.Source); err != nil {
+			return err
+		}
+		p.Source = *opts.Source
+	}
+	p.UpdatedAt = internal.CurrentTimestamp(nil)
+	return nil
+}
+
+func (p *Policy) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", p.ID),
+		slog.String("name", p.Name),
+		slog.String("enforcement_level", string(p.EnforcementLevel)),
+		slog.String("policy_set_id", p.PolicySetID),
+	)
+}
+
+// Valid returns an error if the enforcement level is invalid.
+func (l EnforcementLevel) Valid() error {
+	switch l {
+	case Advisory, SoftMandatory, HardMandatory:
+		return nil
+	default:
+		return ErrInvalidEnforcementLevel
+	}
+}
+
+// validateSource checks the source is a rego module that compiles.
+func validateSource(name, source string) error {
+	if source == "" {
+		return ErrEmptySource
+	}
+	compiler, err := ast.CompileModules(map[string]string{name: source})
+	if err != nil {
+		return fmt.Errorf("invalid policy: %w", err)
+	}
+	if len(compiler.GetRulesExact(denyRef(compiler.Modules[name]))) == 0 {
+		return fmt.Errorf("invalid policy: no %s rule defined", denyRule)
+	}
+	return nil
+}
+
+// denyRef returns a reference to the deny rule of a module, e.g.
+// data.terraform.deny
+func denyRef(module *ast.Module) ast.Ref {
+	return module.Package.Path.Append(ast.StringTerm(denyRule))
+}
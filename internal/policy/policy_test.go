@@ -0,0 +1,95 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+)
+
+const (
+	denyAll = `package terraform
+
+deny[msg] {
+	msg := "denied"
+}
+`
+	noDenyRule = `package terraform
+
+allow := true
+`
+)
+
+func TestNewPolicy(t *testing.T) {
+	tests := []struct {
+		name string
+		opts CreatePolicyOptions
+		want error
+	}{
+		{
+			name: "valid",
+			opts: CreatePolicyOptions{Name: internal.String("deny-all"), EnforcementLevel: Advisory, Source: denyAll},
+		},
+		{
+			name: "invalid name",
+			opts: CreatePolicyOptions{Name: internal.String("$%^&"), EnforcementLevel: Advisory, Source: denyAll},
+			want: internal.ErrInvalidName,
+		},
+		{
+			name: "invalid enforcement level",
+			opts: CreatePolicyOptions{Name: internal.String("deny-all"), EnforcementLevel: "strict", Source: denyAll},
+			want: ErrInvalidEnforcementLevel,
+		},
+		{
+			name: "empty source",
+			opts: CreatePolicyOptions{Name: internal.String("deny-all"), EnforcementLevel: Advisory},
+			want: ErrEmptySource,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newPolicy("polset-123", tt.opts)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestNewPolicy_InvalidSource(t *testing.T) {
+	t.Run("syntax error", func(t *testing.T) {
+		_, err := newPolicy("polset-123", CreatePolicyOptions{
+			Name:             internal.String("broken"),
+			EnforcementLevel: Advisory,
+			Source:           "package terraform\n\ndeny[msg] {",
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("no deny rule", func(t *testing.T) {
+		_, err := newPolicy("polset-123", CreatePolicyOptions{
+			Name:             internal.String("allow"),
+			EnforcementLevel: Advisory,
+			Source:           noDenyRule,
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestPolicy_Update(t *testing.T) {
+	policy, err := newPolicy("polset-123", CreatePolicyOptions{
+		Name:             internal.String("deny-all"),
+		EnforcementLevel: Advisory,
+		Source:           denyAll,
+	})
+	require.NoError(t, err)
+
+	err = policy.update(UpdatePolicyOptions{EnforcementLevel: enforcementLevelPtr(HardMandatory)})
+	require.NoError(t, err)
+	assert.Equal(t, HardMandatory, policy.EnforcementLevel)
+
+	err = policy.update(UpdatePolicyOptions{Source: internal.String(noDenyRule)})
+	assert.Error(t, err)
+	assert.Equal(t, denyAll, policy.Source)
+}
+
+func enforcementLevelPtr(l EnforcementLevel) *EnforcementLevel { return &l }
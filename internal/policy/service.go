@@ -0,0 +1,260 @@
+package policy
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/http/html"
+	"github.com/tofutf/tofutf/internal/organization"
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/sql"
+)
+
+type (
+	Service struct {
+		logger       *slog.Logger
+		organization internal.Authorizer
+		db           *pgdb
+		web          *webHandlers
+	}
+
+	Options struct {
+		*sql.Pool
+		html.Renderer
+		Logger *slog.Logger
+	}
+)
+
+func NewService(opts Options) *Service {
+	svc := Service{
+		logger:       opts.Logger,
+		organization: &organization.Authorizer{Logger: opts.Logger},
+		db:           &pgdb{opts.Pool},
+	}
+	svc.web = &webHandlers{
+		Renderer: opts.Renderer,
+		svc:      &svc,
+	}
+	return &svc
+}
+
+func (s *Service) AddHandlers(r *mux.Router) {
+	s.web.addHandlers(r)
+}
+
+func (s *Service) CreatePolicySet(ctx context.Context, organization string, opts CreatePolicySetOptions) (*PolicySet, error) {
+	subject, err := s.organization.CanAccess(ctx, rbac.CreatePolicySetAction, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	set, err := newPolicySet(organization, opts)
+	if err != nil {
+		s.logger.Error("constructing policy set", "subject", subject, "organization", organization, "err", err)
+		return nil, err
+	}
+
+	if err := s.db.createSet(ctx, set); err != nil {
+		s.logger.Error("creating policy set", "subject", subject, "set", set, "err", err)
+		return nil, err
+	}
+
+	s.logger.Info("created policy set", "subject", subject, "set", set)
+	return set, nil
+}
+
+func (s *Service) UpdatePolicySet(ctx context.Context, id string, opts UpdatePolicySetOptions) (*PolicySet, error) {
+	var subject internal.Subject
+	set, err := s.db.updateSet(ctx, id, func(set *PolicySet) (err error) {
+		subject, err = s.organization.CanAccess(ctx, rbac.UpdatePolicySetAction, set.Organization)
+		if err != nil {
+			return err
+		}
+		return set.update(opts)
+	})
+	if err != nil {
+		s.logger.Error("updating policy set", "id", id, "subject", subject, "err", err)
+		return nil, err
+	}
+
+	s.logger.Info("updated policy set", "subject", subject, "set", set)
+	return set, nil
+}
+
+func (s *Service) ListPolicySets(ctx context.Context, organization string) ([]*PolicySet, error) {
+	subject, err := s.organization.CanAccess(ctx, rbac.ListPolicySetsAction, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	sets, err := s.db.listSets(ctx, organization)
+	if err != nil {
+		s.logger.Error("listing policy sets", "subject", subject, "organization", organization, "err", err)
+		return nil, err
+	}
+
+	s.logger.Debug("listed policy sets", "subject", subject, "organization", organization, "count", len(sets))
+	return sets, nil
+}
+
+func (s *Service) GetPolicySet(ctx context.Context, id string) (*PolicySet, error) {
+	set, err := s.db.getSet(ctx, id)
+	if err != nil {
+		s.logger.Error("retrieving policy set", "id", id, "err", err)
+		return nil, err
+	}
+
+	subject, err := s.organization.CanAccess(ctx, rbac.GetPolicySetAction, set.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("retrieved policy set", "subject", subject, "set", set)
+	return set, nil
+}
+
+func (s *Service) DeletePolicySet(ctx context.Context, id string) (*PolicySet, error) {
+	set, err := s.db.getSet(ctx, id)
+	if err != nil {
+		s.logger.Error("retrieving policy set", "id", id, "err", err)
+		return nil, err
+	}
+
+	subject, err := s.organization.CanAccess(ctx, rbac.DeletePolicySetAction, set.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.db.deleteSet(ctx, id); err != nil {
+		s.logger.Error("deleting policy set", "subject", subject, "set", set, "err", err)
+		return nil, err
+	}
+
+	s.logger.Info("deleted policy set", "subject", subject, "set", set)
+	return set, nil
+}
+
+// CreatePolicy adds a policy to a policy set.
+func (s *Service) CreatePolicy(ctx context.Context, policySetID string, opts CreatePolicyOptions) (*Policy, error) {
+	set, err := s.db.getSet(ctx, policySetID)
+	if err != nil {
+		s.logger.Error("retrieving policy set", "id", policySetID, "err", err)
+		return nil, err
+	}
+
+	subject, err := s.organization.CanAccess(ctx, rbac.UpdatePolicySetAction, set.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := newPolicy(policySetID, opts)
+	if err != nil {
+		s.logger.Error("constructing policy", "subject", subject, "set", set, "err", err)
+		return nil, err
+	}
+
+	if err := s.db.createPolicy(ctx, policy); err != nil {
+		s.logger.Error("creating policy", "subject", subject, "policy", policy, "err", err)
+		return nil, err
+	}
+
+	s.logger.Info("created policy", "subject", subject, "policy", policy)
+	return policy, nil
+}
+
+func (s *Service) UpdatePolicy(ctx context.Context, id string, opts UpdatePolicyOptions) (*Policy, error) {
+	var subject internal.Subject
+	policy, err := s.db.updatePolicy(ctx, id, func(policy *Policy) error {
+		set, err := s.db.getSet(ctx, policy.PolicySetID)
+		if err != nil {
+			return err
+		}
+		subject, err = s.organization.CanAccess(ctx, rbac.UpdatePolicySetAction, set.Organization)
+		if err != nil {
+			return err
+		}
+		return policy.update(opts)
+	})
+	if err != nil {
+		s.logger.Error("updating policy", "id", id, "subject", subject, "err", err)
+		return nil, err
+	}
+
+	s.logger.Info("updated policy", "subject", subject, "policy", policy)
+	return policy, nil
+}
+
+func (s *Service) GetPolicy(ctx context.Context, id string) (*Policy, error) {
+	policy, err := s.db.getPolicy(ctx, id)
+	if err != nil {
+		s.logger.Error("retrieving policy", "id", id, "err", err)
+		return nil, err
+	}
+	set, err := s.db.getSet(ctx, policy.PolicySetID)
+	if err != nil {
+		return nil, err
+	}
+
+	subject, err := s.organization.CanAccess(ctx, rbac.GetPolicySetAction, set.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("retrieved policy", "subject", subject, "policy", policy)
+	return policy, nil
+}
+
+func (s *Service) DeletePolicy(ctx context.Context, id string) (*Policy, error) {
+	policy, err := s.db.getPolicy(ctx, id)
+	if err != nil {
+		s.logger.Error("retrieving policy", "id", id, "err", err)
+		return nil, err
+	}
+	set, err := s.db.getSet(ctx, policy.PolicySetID)
+	if err != nil {
+		return nil, err
+	}
+
+	subject, err := s.organization.CanAccess(ctx, rbac.UpdatePolicySetAction, set.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.db.deletePolicy(ctx, id); err != nil {
+		s.logger.Error("deleting policy", "subject", subject, "policy", policy, "err", err)
+		return nil, err
+	}
+
+	s.logger.Info("deleted policy", "subject", subject, "policy", policy)
+	return policy, nil
+}
+
+// Evaluate evaluates the policies of every policy set in the organization
+// against a JSON-encoded plan, which is only retrieved using getPlan if there
+// are policies to evaluate. If there are no policies then no results are
+// returned.
+//
+// NOTE: no authorization is performed; it is intended to be invoked by the
+// run service upon a plan finishing.
+func (s *Service) Evaluate(ctx context.Context, organization string, getPlan func(context.Context) ([]byte, error)) ([]Result, error) {
+	sets, err := s.db.listSets(ctx, organization)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPolicies(sets) {
+		return nil, nil
+	}
+	plan, err := getPlan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results, err := evaluate(ctx, sets, plan)
+	if err != nil {
+		s.logger.Error("evaluating policies", "organization", organization, "err", err)
+		return nil, err
+	}
+	s.logger.Debug("evaluated policies", "organization", organization, "count", len(results))
+	return results, nil
+}
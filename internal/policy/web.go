@@ -0,0 +1,319 @@
+package policy
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/http/decode"
+	"github.com/tofutf/tofutf/internal/http/html"
+	"github.com/tofutf/tofutf/internal/http/html/paths"
+	"github.com/tofutf/tofutf/internal/organization"
+	"github.com/tofutf/tofutf/internal/rbac"
+)
+
+type webHandlers struct {
+	html.Renderer
+
+	svc *Service
+}
+
+func (h *webHandlers) addHandlers(r *mux.Router) {
+	r = html.UIRouter(r)
+
+	r.HandleFunc("/organizations/{organization_name}/policy-sets", h.listPolicySets).Methods("GET")
+	r.HandleFunc("/organizations/{organization_name}/policy-sets/new", h.newPolicySet).Methods("GET")
+	r.HandleFunc("/organizations/{organization_name}/policy-sets/create", h.createPolicySet).Methods("POST")
+	r.HandleFunc("/policy-sets/{policy_set_id}/edit", h.editPolicySet).Methods("GET")
+	r.HandleFunc("/policy-sets/{policy_set_id}/update", h.updatePolicySet).Methods("POST")
+	r.HandleFunc("/policy-sets/{policy_set_id}/delete", h.deletePolicySet).Methods("POST")
+
+	r.HandleFunc("/policy-sets/{policy_set_id}/policies/new", h.newPolicy).Methods("GET")
+	r.HandleFunc("/policy-sets/{policy_set_id}/policies/create", h.createPolicy).Methods("POST")
+	r.HandleFunc("/policies/{policy_id}/edit", h.editPolicy).Methods("GET")
+	r.HandleFunc("/policies/{policy_id}/update", h.updatePolicy).Methods("POST")
+	r.HandleFunc("/policies/{policy_id}/delete", h.deletePolicy).Methods("POST")
+}
+
+func (h *webHandlers) listPolicySets(w http.ResponseWriter, r *http.Request) {
+	org, err := decode.Param("organization_name", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	sets, err := h.svc.ListPolicySets(r.Context(), org)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	user, err := internal.SubjectFromContext(r.Context())
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.Render("policy_set_list.tmpl", w, struct {
+		organization.OrganizationPage
+		PolicySets []*PolicySet
+		CanCreate  bool
+	}{
+		OrganizationPage: organization.NewPage(r, "policy sets", org),
+		PolicySets:       sets,
+		CanCreate:        user.CanAccessOrganization(rbac.CreatePolicySetAction, org),
+	})
+}
+
+func (h *webHandlers) newPolicySet(w http.ResponseWriter, r *http.Request) {
+	org, err := decode.Param("organization_name", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	h.Render("policy_set_new.tmpl", w, struct {
+		organization.OrganizationPage
+		PolicySet  *PolicySet
+		FormAction string
+	}{
+		OrganizationPage: organization.NewPage(r, "new policy set", org),
+		PolicySet:        &PolicySet{},
+		FormAction:       paths.CreatePolicySet(org),
+	})
+}
+
+func (h *webHandlers) createPolicySet(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Name         *string `schema:"name,required"`
+		Description  *string
+		Organization string `schema:"organization_name,required"`
+	}
+	if err := decode.All(&params, r); err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	set, err := h.svc.CreatePolicySet(r.Context(), params.Organization, CreatePolicySetOptions{
+		Name:        params.Name,
+		Description: params.Description,
+	})
+	if err != nil {
+		html.FlashError(w, err.Error())
+		http.Redirect(w, r, paths.NewPolicySet(params.Organization), http.StatusFound)
+		return
+	}
+
+	html.FlashSuccess(w, "added policy set: "+set.Name)
+	http.Redirect(w, r, paths.EditPolicySet(set.ID), http.StatusFound)
+}
+
+func (h *webHandlers) editPolicySet(w http.ResponseWriter, r *http.Request) {
+	setID, err := decode.Param("policy_set_id", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	set, err := h.svc.GetPolicySet(r.Context(), setID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	user, err := internal.SubjectFromContext(r.Context())
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.Render("policy_set_edit.tmpl", w, struct {
+		organization.OrganizationPage
+		PolicySet  *PolicySet
+		FormAction string
+		CanUpdate  bool
+		CanDelete  bool
+	}{
+		OrganizationPage: organization.NewPage(r, "edit | "+set.ID, set.Organization),
+		PolicySet:        set,
+		FormAction:       paths.UpdatePolicySet(set.ID),
+		CanUpdate:        user.CanAccessOrganization(rbac.UpdatePolicySetAction, set.Organization),
+		CanDelete:        user.CanAccessOrganization(rbac.DeletePolicySetAction, set.Organization),
+	})
+}
+
+func (h *webHandlers) updatePolicySet(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		SetID       string `schema:"policy_set_id,required"`
+		Name        *string
+		Description *string
+	}
+	if err := decode.All(&params, r); err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	set, err := h.svc.UpdatePolicySet(r.Context(), params.SetID, UpdatePolicySetOptions{
+		Name:        params.Name,
+		Description: params.Description,
+	})
+	if err != nil {
+		html.FlashError(w, err.Error())
+		http.Redirect(w, r, paths.EditPolicySet(params.SetID), http.StatusFound)
+		return
+	}
+
+	html.FlashSuccess(w, "updated policy set: "+set.Name)
+	http.Redirect(w, r, paths.EditPolicySet(set.ID), http.StatusFound)
+}
+
+func (h *webHandlers) deletePolicySet(w http.ResponseWriter, r *http.Request) {
+	setID, err := decode.Param("policy_set_id", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	set, err := h.svc.DeletePolicySet(r.Context(), setID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	html.FlashSuccess(w, "deleted policy set: "+set.Name)
+	http.Redirect(w, r, paths.PolicySets(set.Organization), http.StatusFound)
+}
+
+func (h *webHandlers) newPolicy(w http.ResponseWriter, r *http.Request) {
+	setID, err := decode.Param("policy_set_id", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	set, err := h.svc.GetPolicySet(r.Context(), setID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.Render("policy_new.tmpl", w, struct {
+		organization.OrganizationPage
+		PolicySet         *PolicySet
+		Policy            *Policy
+		EditMode          bool
+		FormAction        string
+		EnforcementLevels []EnforcementLevel
+	}{
+		OrganizationPage:  organization.NewPage(r, "new policy | policy sets", set.Organization),
+		PolicySet:         set,
+		Policy:            &Policy{EnforcementLevel: Advisory},
+		EditMode:          false,
+		FormAction:        paths.CreatePolicy(set.ID),
+		EnforcementLevels: enforcementLevels,
+	})
+}
+
+func (h *webHandlers) createPolicy(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		SetID            string           `schema:"policy_set_id,required"`
+		Name             *string          `schema:"name,required"`
+		EnforcementLevel EnforcementLevel `schema:"enforcement_level,required"`
+		Source           string           `schema:"source,required"`
+	}
+	if err := decode.All(&params, r); err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	policy, err := h.svc.CreatePolicy(r.Context(), params.SetID, CreatePolicyOptions{
+		Name:             params.Name,
+		EnforcementLevel: params.EnforcementLevel,
+		Source:           params.Source,
+	})
+	if err != nil {
+		html.FlashError(w, err.Error())
+		http.Redirect(w, r, paths.NewPolicy(params.SetID), http.StatusFound)
+		return
+	}
+
+	html.FlashSuccess(w, "added policy: "+policy.Name)
+	http.Redirect(w, r, paths.EditPolicySet(params.SetID), http.StatusFound)
+}
+
+func (h *webHandlers) editPolicy(w http.ResponseWriter, r *http.Request) {
+	policyID, err := decode.Param("policy_id", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	policy, err := h.svc.GetPolicy(r.Context(), policyID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	set, err := h.svc.GetPolicySet(r.Context(), policy.PolicySetID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.Render("policy_edit.tmpl", w, struct {
+		organization.OrganizationPage
+		PolicySet         *PolicySet
+		Policy            *Policy
+		EditMode          bool
+		FormAction        string
+		EnforcementLevels []EnforcementLevel
+	}{
+		OrganizationPage:  organization.NewPage(r, "edit policy | policy sets", set.Organization),
+		PolicySet:         set,
+		Policy:            policy,
+		EditMode:          true,
+		FormAction:        paths.UpdatePolicy(policy.ID),
+		EnforcementLevels: enforcementLevels,
+	})
+}
+
+func (h *webHandlers) updatePolicy(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		PolicyID         string `schema:"policy_id,required"`
+		EnforcementLevel *EnforcementLevel
+		Source           *string
+	}
+	if err := decode.All(&params, r); err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	policy, err := h.svc.UpdatePolicy(r.Context(), params.PolicyID, UpdatePolicyOptions{
+		EnforcementLevel: params.EnforcementLevel,
+		Source:           params.Source,
+	})
+	if err != nil {
+		html.FlashError(w, err.Error())
+		http.Redirect(w, r, paths.EditPolicy(params.PolicyID), http.StatusFound)
+		return
+	}
+
+	html.FlashSuccess(w, "updated policy: "+policy.Name)
+	http.Redirect(w, r, paths.EditPolicySet(policy.PolicySetID), http.StatusFound)
+}
+
+func (h *webHandlers) deletePolicy(w http.ResponseWriter, r *http.Request) {
+	policyID, err := decode.Param("policy_id", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	policy, err := h.svc.DeletePolicy(r.Context(), policyID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	html.FlashSuccess(w, "deleted policy: "+policy.Name)
+	http.Redirect(w, r, paths.EditPolicySet(policy.PolicySetID), http.StatusFound)
+}
@@ -1,15 +1,35 @@
 package provider
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"io"
 	"net/http"
+	"path"
 
 	"github.com/gorilla/mux"
 	"github.com/leg100/surl"
+	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/http/decode"
 	"github.com/tofutf/tofutf/internal/tfeapi"
 )
 
+// paths of the artifacts of providers in the private registry, which are
+// accessed via signed URLs.
+
+func shasumsPath(versionID string) string {
+	return path.Join("/registry-providers/shasums", versionID)
+}
+
+func shasumsSigPath(versionID string) string {
+	return path.Join("/registry-providers/shasums-sig", versionID)
+}
+
+func binaryPath(platformID string) string {
+	return path.Join("/registry-providers/binaries", platformID)
+}
+
 type apiHandlers struct {
 	*surl.Signer
 
@@ -17,18 +37,38 @@ type apiHandlers struct {
 }
 
 func (h *apiHandlers) addHandlers(r *mux.Router) {
-	// authenticated module api routes
+	// signed routes for uploading and downloading the artifacts of providers
+	// in the private registry
+	signed := r.PathPrefix("/signed/{signature.expiry}").Subrouter()
+	signed.Use(internal.VerifySignedURL(h.Signer))
+	signed.HandleFunc("/registry-providers/shasums/{id}", h.upload(h.svc.uploadShasums)).Methods("PUT")
+	signed.HandleFunc("/registry-providers/shasums/{id}", h.download(h.svc.downloadShasums)).Methods("GET")
+	signed.HandleFunc("/registry-providers/shasums-sig/{id}", h.upload(h.svc.uploadShasumsSig)).Methods("PUT")
+	signed.HandleFunc("/registry-providers/shasums-sig/{id}", h.download(h.svc.downloadShasumsSig)).Methods("GET")
+	signed.HandleFunc("/registry-providers/binaries/{id}", h.upload(h.svc.uploadBinary)).Methods("PUT")
+	signed.HandleFunc("/registry-providers/binaries/{id}", h.download(h.svc.downloadBinary)).Methods("GET")
+	// signed route for downloading archives cached by the provider network
+	// mirror
+	signed.HandleFunc("/provider-mirror/{id}/{os}/{arch}", h.downloadMirrorArchive).Methods("GET")
+
+	// authenticated provider api routes
 	//
-	// Implements the Module Registry Protocol:
+	// Implements the Provider Registry Protocol:
 	//
 	// https://developer.hashicorp.com/terraform/internals/provider-registry-protocol
 	r = r.PathPrefix(tfeapi.ProviderV1Prefix).Subrouter()
 
 	r.HandleFunc("/{namespace}/{type}/versions", h.listAvailableVersions).Methods("GET")
 	r.HandleFunc("/{namespace}/{type}/{version}/download/{os}/{arch}", h.findProviderPackage).Methods("GET")
+
+	// Implements the Provider Network Mirror Protocol:
+	//
+	// https://developer.hashicorp.com/terraform/internals/provider-network-mirror-protocol
+	r.HandleFunc("/{hostname}/{namespace}/{type}/index.json", h.listMirrorVersions).Methods("GET")
+	r.HandleFunc("/{hostname}/{namespace}/{type}/{version}.json", h.listMirrorPackages).Methods("GET")
 }
 
-// List Available Versions for a Specific Module.
+// List Available Versions for a Specific Provider.
 //
 // https://developer.hashicorp.com/terraform/internals/provider-registry-protocol#list-available-versions
 func (h *apiHandlers) listAvailableVersions(w http.ResponseWriter, r *http.Request) {
@@ -91,3 +131,133 @@ func (h *apiHandlers) findProviderPackage(w http.ResponseWriter, r *http.Request
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 	}
 }
+
+// listMirrorVersions lists the available versions of a provider in an
+// upstream registry.
+//
+// https://developer.hashicorp.com/terraform/internals/provider-network-mirror-protocol#list-available-versions
+func (h *apiHandlers) listMirrorVersions(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Hostname  string `schema:"hostname,required"`
+		Namespace string `schema:"namespace,required"`
+		Type      string `schema:"type,required"`
+	}
+	if err := decode.Route(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	index, err := h.svc.GetMirrorIndex(r.Context(), MirrorProviderOptions{
+		Hostname:  params.Hostname,
+		Namespace: params.Namespace,
+		Type:      params.Type,
+	})
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	w.Header().Set("Content-type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(index); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// listMirrorPackages lists the installation packages of a version of a
+// provider in an upstream registry.
+//
+// https://developer.hashicorp.com/terraform/internals/provider-network-mirror-protocol#list-available-installation-packages
+func (h *apiHandlers) listMirrorPackages(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Hostname  string `schema:"hostname,required"`
+		Namespace string `schema:"namespace,required"`
+		Type      string `schema:"type,required"`
+		Version   string `schema:"version,required"`
+	}
+	if err := decode.Route(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	packages, err := h.svc.GetMirrorPackages(r.Context(), MirrorVersionOptions{
+		MirrorProviderOptions: MirrorProviderOptions{
+			Hostname:  params.Hostname,
+			Namespace: params.Namespace,
+			Type:      params.Type,
+		},
+		Version: params.Version,
+	})
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	w.Header().Set("Content-type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(packages); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (h *apiHandlers) downloadMirrorArchive(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		ID   string `schema:"id,required"`
+		OS   string `schema:"os,required"`
+		Arch string `schema:"arch,required"`
+	}
+	if err := decode.Route(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	data, err := h.svc.downloadMirrorArchive(r.Context(), params.ID, params.OS, params.Arch)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	w.Header().Set("Content-type", "application/zip")
+	w.Write(data) //nolint:errcheck
+}
+
+// upload returns a handler that uploads an artifact of a provider in the
+// private registry.
+func (h *apiHandlers) upload(fn func(ctx context.Context, id string, data []byte) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := decode.Param("id", r)
+		if err != nil {
+			tfeapi.Error(w, err)
+			return
+		}
+		buf := new(bytes.Buffer)
+		if _, err := io.Copy(buf, r.Body); err != nil {
+			tfeapi.Error(w, err)
+			return
+		}
+		if err := fn(r.Context(), id, buf.Bytes()); err != nil {
+			tfeapi.Error(w, toHTTPError(err))
+			return
+		}
+	}
+}
+
+// download returns a handler that downloads an artifact of a provider in the
+// private registry.
+func (h *apiHandlers) download(fn func(ctx context.Context, id string) ([]byte, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := decode.Param("id", r)
+		if err != nil {
+			tfeapi.Error(w, err)
+			return
+		}
+		data, err := fn(r.Context(), id)
+		if err != nil {
+			tfeapi.Error(w, err)
+			return
+		}
+		if data == nil {
+			tfeapi.Error(w, internal.ErrResourceNotFound)
+			return
+		}
+		w.Write(data) //nolint:errcheck
+	}
+}
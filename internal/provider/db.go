@@ -0,0 +1,473 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/blob"
+	"github.com/tofutf/tofutf/internal/sql"
+	"github.com/tofutf/tofutf/internal/sql/pggen"
+)
+
+type (
+	// pgdb is the private registry database on postgres
+	pgdb struct {
+		*sql.Pool            // provides access to generated SQL queries
+		blobs     blob.Store // optional store for provider binaries
+	}
+
+	providerRow struct {
+		RegistryProviderID pgtype.Text        `json:"registry_provider_id"`
+		CreatedAt          pgtype.Timestamptz `json:"created_at"`
+		UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+		Name               pgtype.Text        `json:"name"`
+		OrganizationName   pgtype.Text        `json:"organization_name"`
+	}
+
+	versionRow struct {
+		RegistryProviderVersionID pgtype.Text        `json:"registry_provider_version_id"`
+		CreatedAt                 pgtype.Timestamptz `json:"created_at"`
+		UpdatedAt                 pgtype.Timestamptz `json:"updated_at"`
+		Version                   pgtype.Text        `json:"version"`
+		KeyID                     pgtype.Text        `json:"key_id"`
+		Protocols                 []string           `json:"protocols"`
+		RegistryProviderID        pgtype.Text        `json:"registry_provider_id"`
+		ShasumsUploaded           pgtype.Bool        `json:"shasums_uploaded"`
+		ShasumsSigUploaded        pgtype.Bool        `json:"shasums_sig_uploaded"`
+	}
+
+	platformRow struct {
+		RegistryProviderPlatformID pgtype.Text        `json:"registry_provider_platform_id"`
+		CreatedAt                  pgtype.Timestamptz `json:"created_at"`
+		UpdatedAt                  pgtype.Timestamptz `json:"updated_at"`
+		Os                         pgtype.Text        `json:"os"`
+		Arch                       pgtype.Text        `json:"arch"`
+		Shasum                     pgtype.Text        `json:"shasum"`
+		Filename                   pgtype.Text        `json:"filename"`
+		BinaryUploaded             pgtype.Bool        `json:"binary_uploaded"`
+		RegistryProviderVersionID  pgtype.Text        `json:"registry_provider_version_id"`
+	}
+)
+
+func (row providerRow) toProvider() *RegistryProvider {
+	return &RegistryProvider{
+		ID:           row.RegistryProviderID.String,
+		CreatedAt:    row.CreatedAt.Time.UTC(),
+		UpdatedAt:    row.UpdatedAt.Time.UTC(),
+		Name:         row.Name.String,
+		Organization: row.OrganizationName.String,
+	}
+}
+
+func (row versionRow) toVersion() *RegistryProviderVersion {
+	return &RegistryProviderVersion{
+		ID:                 row.RegistryProviderVersionID.String,
+		CreatedAt:          row.CreatedAt.Time.UTC(),
+		UpdatedAt:          row.UpdatedAt.Time.UTC(),
+		Version:            row.Version.String,
+		KeyID:              row.KeyID.String,
+		Protocols:          row.Protocols,
+		ProviderID:         row.RegistryProviderID.String,
+		ShasumsUploaded:    row.ShasumsUploaded.Bool,
+		ShasumsSigUploaded: row.ShasumsSigUploaded.Bool,
+	}
+}
+
+func (row platformRow) toPlatform() *RegistryProviderPlatform {
+	return &RegistryProviderPlatform{
+		ID:             row.RegistryProviderPlatformID.String,
+		CreatedAt:      row.CreatedAt.Time.UTC(),
+		UpdatedAt:      row.UpdatedAt.Time.UTC(),
+		OS:             row.Os.String,
+		Arch:           row.Arch.String,
+		Shasum:         row.Shasum.String,
+		Filename:       row.Filename.String,
+		BinaryUploaded: row.BinaryUploaded.Bool,
+		VersionID:      row.RegistryProviderVersionID.String,
+	}
+}
+
+func (db *pgdb) createProvider(ctx context.Context, provider *RegistryProvider) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.InsertRegistryProvider(ctx, pggen.InsertRegistryProviderParams{
+			RegistryProviderID: sql.String(provider.ID),
+			CreatedAt:          sql.Timestamptz(provider.CreatedAt),
+			UpdatedAt:          sql.Timestamptz(provider.UpdatedAt),
+			Name:               sql.String(provider.Name),
+			OrganizationName:   sql.String(provider.Organization),
+		})
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) listProviders(ctx context.Context, organization string) ([]*RegistryProvider, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]*RegistryProvider, error) {
+		rows, err := q.FindRegistryProviders(ctx, sql.String(organization))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		providers := make([]*RegistryProvider, len(rows))
+		for i, r := range rows {
+			providers[i] = providerRow(r).toProvider()
+		}
+		return providers, nil
+	})
+}
+
+func (db *pgdb) getProvider(ctx context.Context, organization, name string) (*RegistryProvider, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*RegistryProvider, error) {
+		row, err := q.FindRegistryProvider(ctx, sql.String(organization), sql.String(name))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return providerRow(row).toProvider(), nil
+	})
+}
+
+func (db *pgdb) getProviderByID(ctx context.Context, providerID string) (*RegistryProvider, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*RegistryProvider, error) {
+		row, err := q.FindRegistryProviderByID(ctx, sql.String(providerID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return providerRow(row).toProvider(), nil
+	})
+}
+
+func (db *pgdb) deleteProvider(ctx context.Context, providerID string) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.DeleteRegistryProvider(ctx, sql.String(providerID))
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) createVersion(ctx context.Context, version *RegistryProviderVersion) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.InsertRegistryProviderVersion(ctx, pggen.InsertRegistryProviderVersionParams{
+			RegistryProviderVersionID: sql.String(version.ID),
+			CreatedAt:                 sql.Timestamptz(version.CreatedAt),
+			UpdatedAt:                 sql.Timestamptz(version.UpdatedAt),
+			Version:                   sql.String(version.Version),
+			KeyID:                     sql.String(version.KeyID),
+			Protocols:                 version.Protocols,
+			RegistryProviderID:        sql.String(version.ProviderID),
+		})
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) listVersions(ctx context.Context, providerID string) ([]*RegistryProviderVersion, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]*RegistryProviderVersion, error) {
+		rows, err := q.FindRegistryProviderVersions(ctx, sql.String(providerID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		versions := make([]*RegistryProviderVersion, len(rows))
+		for i, r := range rows {
+			versions[i] = versionRow(r).toVersion()
+		}
+		return versions, nil
+	})
+}
+
+func (db *pgdb) getVersion(ctx context.Context, providerID, version string) (*RegistryProviderVersion, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*RegistryProviderVersion, error) {
+		row, err := q.FindRegistryProviderVersion(ctx, sql.String(providerID), sql.String(version))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return versionRow(row).toVersion(), nil
+	})
+}
+
+func (db *pgdb) getVersionByID(ctx context.Context, versionID string) (*RegistryProviderVersion, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*RegistryProviderVersion, error) {
+		row, err := q.FindRegistryProviderVersionByID(ctx, sql.String(versionID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return versionRow(row).toVersion(), nil
+	})
+}
+
+func (db *pgdb) deleteVersion(ctx context.Context, versionID string) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.DeleteRegistryProviderVersion(ctx, sql.String(versionID))
+		return sql.Error(err)
+	})
+}
+
+// saveShasums persists a version's SHA256SUMS file, removing any existing
+// signature, which no longer applies.
+func (db *pgdb) saveShasums(ctx context.Context, versionID string, shasums []byte) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.UpdateRegistryProviderVersionShasums(ctx, pggen.UpdateRegistryProviderVersionShasumsParams{
+			RegistryProviderVersionID: sql.String(versionID),
+			Shasums:                   shasums,
+			UpdatedAt:                 sql.Timestamptz(internal.CurrentTimestamp(nil)),
+		})
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) getShasums(ctx context.Context, versionID string) ([]byte, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]byte, error) {
+		shasums, err := q.FindRegistryProviderVersionShasums(ctx, sql.String(versionID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return shasums, nil
+	})
+}
+
+func (db *pgdb) saveShasumsSig(ctx context.Context, versionID string, sig []byte) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.UpdateRegistryProviderVersionShasumsSig(ctx, pggen.UpdateRegistryProviderVersionShasumsSigParams{
+			RegistryProviderVersionID: sql.String(versionID),
+			ShasumsSig:                sig,
+			UpdatedAt:                 sql.Timestamptz(internal.CurrentTimestamp(nil)),
+		})
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) getShasumsSig(ctx context.Context, versionID string) ([]byte, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]byte, error) {
+		sig, err := q.FindRegistryProviderVersionShasumsSig(ctx, sql.String(versionID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return sig, nil
+	})
+}
+
+func (db *pgdb) createPlatform(ctx context.Context, platform *RegistryProviderPlatform) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.InsertRegistryProviderPlatform(ctx, pggen.InsertRegistryProviderPlatformParams{
+			RegistryProviderPlatformID: sql.String(platform.ID),
+			CreatedAt:                  sql.Timestamptz(platform.CreatedAt),
+			UpdatedAt:                  sql.Timestamptz(platform.UpdatedAt),
+			Os:                         sql.String(platform.OS),
+			Arch:                       sql.String(platform.Arch),
+			Shasum:                     sql.String(platform.Shasum),
+			Filename:                   sql.String(platform.Filename),
+			RegistryProviderVersionID:  sql.String(platform.VersionID),
+		})
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) listPlatforms(ctx context.Context, versionID string) ([]*RegistryProviderPlatform, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]*RegistryProviderPlatform, error) {
+		rows, err := q.FindRegistryProviderPlatforms(ctx, sql.String(versionID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		platforms := make([]*RegistryProviderPlatform, len(rows))
+		for i, r := range rows {
+			platforms[i] = platformRow(r).toPlatform()
+		}
+		return platforms, nil
+	})
+}
+
+func (db *pgdb) getPlatform(ctx context.Context, versionID, os, arch string) (*RegistryProviderPlatform, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*RegistryProviderPlatform, error) {
+		row, err := q.FindRegistryProviderPlatform(ctx, pggen.FindRegistryProviderPlatformParams{
+			RegistryProviderVersionID: sql.String(versionID),
+			Os:                        sql.String(os),
+			Arch:                      sql.String(arch),
+		})
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return platformRow(row).toPlatform(), nil
+	})
+}
+
+func (db *pgdb) getPlatformByID(ctx context.Context, platformID string) (*RegistryProviderPlatform, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*RegistryProviderPlatform, error) {
+		row, err := q.FindRegistryProviderPlatformByID(ctx, sql.String(platformID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return platformRow(row).toPlatform(), nil
+	})
+}
+
+func (db *pgdb) deletePlatform(ctx context.Context, platformID string) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.DeleteRegistryProviderPlatform(ctx, sql.String(platformID))
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) saveBinary(ctx context.Context, platformID string, binary []byte) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		binary, err := blob.Offload(ctx, db.blobs, blob.ProviderBinaryKey(platformID), binary)
+		if err != nil {
+			return err
+		}
+		_, err = q.UpdateRegistryProviderPlatformBinary(ctx, pggen.UpdateRegistryProviderPlatformBinaryParams{
+			RegistryProviderPlatformID: sql.String(platformID),
+			ProviderBinary:             binary,
+			UpdatedAt:                  sql.Timestamptz(internal.CurrentTimestamp(nil)),
+		})
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) getBinary(ctx context.Context, platformID string) ([]byte, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]byte, error) {
+		binary, err := q.FindRegistryProviderPlatformBinary(ctx, sql.String(platformID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return blob.Load(ctx, db.blobs, blob.ProviderBinaryKey(platformID), binary)
+	})
+}
+
+type mirrorVersionRow struct {
+	ProviderMirrorVersionID pgtype.Text        `json:"provider_mirror_version_id"`
+	CreatedAt               pgtype.Timestamptz `json:"created_at"`
+	Hostname                pgtype.Text        `json:"hostname"`
+	Namespace               pgtype.Text        `json:"namespace"`
+	Type                    pgtype.Text        `json:"type"`
+	Version                 pgtype.Text        `json:"version"`
+	Shasums                 []byte             `json:"shasums"`
+}
+
+func (row mirrorVersionRow) toMirrorVersion() *mirrorVersion {
+	return &mirrorVersion{
+		ID:        row.ProviderMirrorVersionID.String,
+		CreatedAt: row.CreatedAt.Time.UTC(),
+		MirrorProviderOptions: MirrorProviderOptions{
+			Hostname:  row.Hostname.String,
+			Namespace: row.Namespace.String,
+			Type:      row.Type.String,
+		},
+		Version: row.Version.String,
+		Shasums: row.Shasums,
+	}
+}
+
+// createMirrorVersion persists a version cached by the mirror. If the version
+// has already been cached, e.g. by a concurrent request, then the existing
+// version is left untouched.
+func (db *pgdb) createMirrorVersion(ctx context.Context, version *mirrorVersion) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.InsertProviderMirrorVersion(ctx, pggen.InsertProviderMirrorVersionParams{
+			ProviderMirrorVersionID: sql.String(version.ID),
+			CreatedAt:               sql.Timestamptz(version.CreatedAt),
+			Hostname:                sql.String(version.Hostname),
+			Namespace:               sql.String(version.Namespace),
+			Type:                    sql.String(version.Type),
+			Version:                 sql.String(version.Version),
+			Shasums:                 version.Shasums,
+		})
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) listMirrorVersions(ctx context.Context, opts MirrorProviderOptions) ([]*mirrorVersion, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]*mirrorVersion, error) {
+		rows, err := q.FindProviderMirrorVersions(ctx, pggen.FindProviderMirrorVersionsParams{
+			Hostname:  sql.String(opts.Hostname),
+			Namespace: sql.String(opts.Namespace),
+			Type:      sql.String(opts.Type),
+		})
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		versions := make([]*mirrorVersion, len(rows))
+		for i, r := range rows {
+			versions[i] = mirrorVersionRow(r).toMirrorVersion()
+		}
+		return versions, nil
+	})
+}
+
+func (db *pgdb) getMirrorVersion(ctx context.Context, opts MirrorVersionOptions) (*mirrorVersion, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*mirrorVersion, error) {
+		row, err := q.FindProviderMirrorVersion(ctx, pggen.FindProviderMirrorVersionParams{
+			Hostname:  sql.String(opts.Hostname),
+			Namespace: sql.String(opts.Namespace),
+			Type:      sql.String(opts.Type),
+			Version:   sql.String(opts.Version),
+		})
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return mirrorVersionRow(row).toMirrorVersion(), nil
+	})
+}
+
+func (db *pgdb) getMirrorVersionByID(ctx context.Context, versionID string) (*mirrorVersion, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*mirrorVersion, error) {
+		row, err := q.FindProviderMirrorVersionByID(ctx, sql.String(versionID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return mirrorVersionRow(row).toMirrorVersion(), nil
+	})
+}
+
+// saveMirrorArchive persists the zip archive of a platform of a version cached
+// by the mirror.
+func (db *pgdb) saveMirrorArchive(ctx context.Context, versionID string, archive *mirrorArchive, data []byte) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		data, err := blob.Offload(ctx, db.blobs, blob.ProviderMirrorArchiveKey(versionID, archive.OS, archive.Arch), data)
+		if err != nil {
+			return err
+		}
+		_, err = q.InsertProviderMirrorArchive(ctx, pggen.InsertProviderMirrorArchiveParams{
+			ProviderMirrorVersionID: sql.String(versionID),
+			CreatedAt:               sql.Timestamptz(internal.CurrentTimestamp(nil)),
+			Os:                      sql.String(archive.OS),
+			Arch:                    sql.String(archive.Arch),
+			Filename:                sql.String(archive.Filename),
+			Shasum:                  sql.String(archive.Shasum),
+			Archive:                 data,
+		})
+		return sql.Error(err)
+	})
+}
+
+// getMirrorArchive retrieves the zip archive of a platform of a version cached
+// by the mirror. If it has not been cached then nil is returned.
+func (db *pgdb) getMirrorArchive(ctx context.Context, versionID, os, arch string) ([]byte, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]byte, error) {
+		row, err := q.FindProviderMirrorArchive(ctx, pggen.FindProviderMirrorArchiveParams{
+			ProviderMirrorVersionID: sql.String(versionID),
+			Os:                      sql.String(os),
+			Arch:                    sql.String(arch),
+		})
+		if sql.NoRowsInResultError(err) {
+			return nil, nil
+		} else if err != nil {
+			return nil, sql.Error(err)
+		}
+		data, err := blob.Load(ctx, db.blobs, blob.ProviderMirrorArchiveKey(versionID, os, arch), row.Archive)
+		if errors.Is(err, internal.ErrResourceNotFound) {
+			// archive has gone missing from the blob store, so re-cache it
+			return nil, nil
+		}
+		return data, err
+	})
+}
+
+// deleteMirrorVersionsBefore deletes versions cached by the mirror before the
+// given time, along with their archives, returning the number of versions
+// deleted.
+func (db *pgdb) deleteMirrorVersionsBefore(ctx context.Context, before time.Time) (int64, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (int64, error) {
+		tag, err := q.DeleteProviderMirrorVersionsBefore(ctx, sql.Timestamptz(before))
+		if err != nil {
+			return 0, sql.Error(err)
+		}
+		return tag.RowsAffected(), nil
+	})
+}
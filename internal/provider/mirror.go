@@ -0,0 +1,476 @@
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tofutf/tofutf/internal"
+)
+
+var (
+	ErrUpstreamSignature = errors.New("upstream SHA256SUMS is not signed by any of the provider's signing keys")
+	ErrUpstreamChecksum  = errors.New("checksum of upstream archive does not match SHA256SUMS")
+	ErrUpstreamTooLarge  = errors.New("response from upstream provider registry exceeds maximum size")
+
+	// DefaultMirrorHosts are the upstream registries from which the mirror
+	// retrieves providers by default.
+	DefaultMirrorHosts = []string{"registry.terraform.io", "registry.opentofu.org"}
+)
+
+const (
+	// DefaultMirrorRetention is the default period for which the mirror caches
+	// a version of a provider.
+	DefaultMirrorRetention = 30 * 24 * time.Hour
+
+	// maximum size of responses from upstream registries: metadata, i.e.
+	// JSON documents and SHA256SUMS files, and zip archives respectively.
+	defaultMaxMetadataSize = 10 << 20
+	defaultMaxArchiveSize  = 512 << 20
+)
+
+type (
+	// MirrorProviderOptions identify a provider in an upstream registry.
+	MirrorProviderOptions struct {
+		Hostname  string
+		Namespace string
+		Type      string
+	}
+
+	// MirrorVersionOptions identify a version of a provider in an upstream
+	// registry.
+	MirrorVersionOptions struct {
+		MirrorProviderOptions
+
+		Version string
+	}
+
+	// MirrorIndex lists the available versions of a provider.
+	//
+	// https://developer.hashicorp.com/terraform/internals/provider-network-mirror-protocol#list-available-versions
+	MirrorIndex struct {
+		Versions map[string]struct{} `json:"versions"`
+	}
+
+	// MirrorPackages lists the packages of a version of a provider, keyed by
+	// platform, e.g. linux_amd64.
+	//
+	// https://developer.hashicorp.com/terraform/internals/provider-network-mirror-protocol#list-available-installation-packages
+	MirrorPackages struct {
+		Archives map[string]MirrorPackage `json:"archives"`
+	}
+
+	MirrorPackage struct {
+		URL    string   `json:"url"`
+		Hashes []string `json:"hashes,omitempty"`
+	}
+
+	// mirrorVersion is a version of a provider cached by the mirror, along
+	// with its verified SHA256SUMS file, which lists the checksums of the
+	// version's zip archives.
+	mirrorVersion struct {
+		ID        string
+		CreatedAt time.Time
+		MirrorProviderOptions
+		Version string
+		Shasums []byte
+	}
+
+	// mirrorArchive is the zip archive of a platform of a version cached by
+	// the mirror.
+	mirrorArchive struct {
+		OS       string
+		Arch     string
+		Filename string
+		Shasum   string
+	}
+)
+
+func mirrorArchivePath(versionID, os, arch string) string {
+	return path.Join("/provider-mirror", versionID, os, arch)
+}
+
+// archives parses the version's SHA256SUMS file, returning the zip archives
+// it lists.
+func (v *mirrorVersion) archives() []mirrorArchive {
+	prefix := fmt.Sprintf("terraform-provider-%s_%s_", v.Type, v.Version)
+	var archives []mirrorArchive
+	scanner := bufio.NewScanner(bytes.NewReader(v.Shasums))
+	for scanner.Scan() {
+		shasum, filename, ok := strings.Cut(scanner.Text(), "  ")
+		if !ok || !strings.HasPrefix(filename, prefix) || !strings.HasSuffix(filename, ".zip") {
+			continue
+		}
+		platform := strings.TrimSuffix(strings.TrimPrefix(filename, prefix), ".zip")
+		os, arch, ok := strings.Cut(platform, "_")
+		if !ok {
+			continue
+		}
+		archives = append(archives, mirrorArchive{
+			OS:       os,
+			Arch:     arch,
+			Filename: filename,
+			Shasum:   shasum,
+		})
+	}
+	return archives
+}
+
+func (v *mirrorVersion) archive(os, arch string) (mirrorArchive, bool) {
+	for _, archive := range v.archives() {
+		if archive.OS == os && archive.Arch == arch {
+			return archive, true
+		}
+	}
+	return mirrorArchive{}, false
+}
+
+func (v *mirrorVersion) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", v.ID),
+		slog.String("hostname", v.Hostname),
+		slog.String("namespace", v.Namespace),
+		slog.String("type", v.Type),
+		slog.String("version", v.Version),
+	)
+}
+
+// GetMirrorIndex lists the available versions of a provider in an upstream
+// registry. If the upstream registry cannot be reached then the versions
+// already cached by the mirror are listed instead.
+func (s *Service) GetMirrorIndex(ctx context.Context, opts MirrorProviderOptions) (*MirrorIndex, error) {
+	subject, err := internal.SubjectFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkMirrorHostname(opts.Hostname); err != nil {
+		return nil, err
+	}
+
+	index := MirrorIndex{Versions: make(map[string]struct{})}
+	versions, err := s.upstream.listVersions(ctx, opts)
+	if err != nil {
+		if errors.Is(err, internal.ErrResourceNotFound) {
+			return nil, err
+		}
+		cached, cerr := s.db.listMirrorVersions(ctx, opts)
+		if cerr != nil || len(cached) == 0 {
+			s.logger.Error("listing upstream provider versions", "provider", opts, "subject", subject, "err", err)
+			return nil, err
+		}
+		s.logger.Warn("upstream registry unavailable: listing cached provider versions", "provider", opts, "subject", subject, "err", err)
+		for _, version := range cached {
+			index.Versions[version.Version] = struct{}{}
+		}
+		return &index, nil
+	}
+	for _, version := range versions.Versions {
+		index.Versions[version.Version] = struct{}{}
+	}
+	s.logger.Debug("listed mirror provider versions", "provider", opts, "subject", subject)
+	return &index, nil
+}
+
+// GetMirrorPackages lists the packages of a version of a provider in an
+// upstream registry. The package URLs are signed and relative to the host,
+// and the archives they reference are cached upon their first download.
+func (s *Service) GetMirrorPackages(ctx context.Context, opts MirrorVersionOptions) (*MirrorPackages, error) {
+	subject, err := internal.SubjectFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkMirrorHostname(opts.Hostname); err != nil {
+		return nil, err
+	}
+
+	version, err := s.getMirrorVersion(ctx, opts)
+	if err != nil {
+		s.logger.Error("retrieving mirror provider version", "provider", opts, "version", opts.Version, "subject", subject, "err", err)
+		return nil, err
+	}
+	packages := MirrorPackages{Archives: make(map[string]MirrorPackage)}
+	for _, archive := range version.archives() {
+		signed, err := s.signer.Sign(mirrorArchivePath(version.ID, archive.OS, archive.Arch), time.Hour)
+		if err != nil {
+			return nil, err
+		}
+		packages.Archives[archive.OS+"_"+archive.Arch] = MirrorPackage{
+			URL:    signed,
+			Hashes: []string{"zh:" + archive.Shasum},
+		}
+	}
+	s.logger.Debug("listed mirror provider packages", "version", version, "subject", subject)
+	return &packages, nil
+}
+
+// getMirrorVersion retrieves a version cached by the mirror, caching it first
+// if necessary.
+func (s *Service) getMirrorVersion(ctx context.Context, opts MirrorVersionOptions) (*mirrorVersion, error) {
+	version, err := s.db.getMirrorVersion(ctx, opts)
+	if !errors.Is(err, internal.ErrResourceNotFound) {
+		return version, err
+	}
+	version, err = s.upstream.fetchVersion(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.db.createMirrorVersion(ctx, version); err != nil {
+		return nil, err
+	}
+	s.logger.Info("cached mirror provider version", "version", version)
+	// re-retrieve version in case a concurrent request cached it first.
+	return s.db.getMirrorVersion(ctx, opts)
+}
+
+// downloadMirrorArchive downloads the zip archive of a platform of a version
+// cached by the mirror, caching the archive first if necessary.
+//
+// NOTE: unauthenticated - access granted only via signed URL
+func (s *Service) downloadMirrorArchive(ctx context.Context, versionID, os, arch string) ([]byte, error) {
+	data, err := s.db.getMirrorArchive(ctx, versionID, os, arch)
+	if err != nil || data != nil {
+		return data, err
+	}
+	version, err := s.db.getMirrorVersionByID(ctx, versionID)
+	if err != nil {
+		return nil, err
+	}
+	archive, ok := version.archive(os, arch)
+	if !ok {
+		return nil, internal.ErrResourceNotFound
+	}
+	data, err = s.upstream.fetchArchive(ctx, version, archive)
+	if err != nil {
+		s.logger.Error("downloading upstream provider archive", "version", version, "os", os, "arch", arch, "err", err)
+		return nil, err
+	}
+	if err := s.db.saveMirrorArchive(ctx, versionID, &archive, data); err != nil {
+		return nil, err
+	}
+	s.logger.Info("cached mirror provider archive", "version", version, "os", os, "arch", arch)
+	return data, nil
+}
+
+// checkMirrorHostname permits the mirror to only retrieve providers from the
+// configured upstream registries, preventing it from being used to make
+// requests to arbitrary hosts. It also prevents the mirror from proxying
+// requests for providers in the private registry, which would otherwise see
+// the mirror make requests to itself.
+func (s *Service) checkMirrorHostname(hostname string) error {
+	if s.system != nil && hostname == s.system.Hostname() {
+		return internal.ErrResourceNotFound
+	}
+	if !slices.Contains(s.mirrorHosts, hostname) {
+		return internal.ErrResourceNotFound
+	}
+	return nil
+}
+
+// upstream retrieves providers from upstream registries using the provider
+// registry protocol.
+//
+// https://developer.hashicorp.com/terraform/internals/provider-registry-protocol
+type upstream struct {
+	client *http.Client
+
+	// maximum size of metadata and archives retrieved from upstream
+	maxMetadataSize int64
+	maxArchiveSize  int64
+}
+
+func newUpstream() *upstream {
+	return &upstream{
+		client:          &http.Client{},
+		maxMetadataSize: defaultMaxMetadataSize,
+		maxArchiveSize:  defaultMaxArchiveSize,
+	}
+}
+
+// discover returns the base URL of the provider registry API of the host.
+//
+// https://developer.hashicorp.com/terraform/internals/remote-service-discovery
+func (u *upstream) discover(ctx context.Context, hostname string) (*url.URL, error) {
+	discoveryURL := &url.URL{Scheme: "https", Host: hostname, Path: "/.well-known/terraform.json"}
+	var services map[string]any
+	if err := u.getJSON(ctx, discoveryURL, &services); err != nil {
+		return nil, fmt.Errorf("discovering services: %w", err)
+	}
+	providers, ok := services["providers.v1"].(string)
+	if !ok {
+		return nil, fmt.Errorf("host does not provide a provider registry: %w", internal.ErrResourceNotFound)
+	}
+	return discoveryURL.Parse(providers)
+}
+
+func (u *upstream) listVersions(ctx context.Context, opts MirrorProviderOptions) (*ProviderVersions, error) {
+	base, err := u.discover(ctx, opts.Hostname)
+	if err != nil {
+		return nil, err
+	}
+	return u.versions(ctx, base, opts)
+}
+
+func (u *upstream) versions(ctx context.Context, base *url.URL, opts MirrorProviderOptions) (*ProviderVersions, error) {
+	var versions ProviderVersions
+	if err := u.getJSON(ctx, base.JoinPath(opts.Namespace, opts.Type, "versions"), &versions); err != nil {
+		return nil, err
+	}
+	return &versions, nil
+}
+
+// findPackage retrieves the manifest for a package, along with the URL from
+// which it was retrieved.
+func (u *upstream) findPackage(ctx context.Context, base *url.URL, opts MirrorVersionOptions, os, arch string) (*ProviderVersionManifest, *url.URL, error) {
+	manifestURL := base.JoinPath(opts.Namespace, opts.Type, opts.Version, "download", os, arch)
+	var manifest ProviderVersionManifest
+	if err := u.getJSON(ctx, manifestURL, &manifest); err != nil {
+		return nil, nil, err
+	}
+	return &manifest, manifestURL, nil
+}
+
+// fetchVersion retrieves a version's SHA256SUMS file, verifying its signature
+// with the provider's signing keys.
+func (u *upstream) fetchVersion(ctx context.Context, opts MirrorVersionOptions) (*mirrorVersion, error) {
+	base, err := u.discover(ctx, opts.Hostname)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := u.versions(ctx, base, opts.MirrorProviderOptions)
+	if err != nil {
+		return nil, err
+	}
+	var platforms []ProviderPlatform
+	for _, v := range versions.Versions {
+		if v.Version == opts.Version {
+			platforms = v.Platforms
+		}
+	}
+	if len(platforms) == 0 {
+		return nil, internal.ErrResourceNotFound
+	}
+	// every package of a version shares the same SHA256SUMS file, so the
+	// manifest of any one of them will do.
+	manifest, manifestURL, err := u.findPackage(ctx, base, opts, platforms[0].Os, platforms[0].Arch)
+	if err != nil {
+		return nil, err
+	}
+	shasums, err := u.getRelative(ctx, manifestURL, manifest.ShasumsURL, u.maxMetadataSize)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving SHA256SUMS: %w", err)
+	}
+	sig, err := u.getRelative(ctx, manifestURL, manifest.ShasumsSignatureURL, u.maxMetadataSize)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving SHA256SUMS signature: %w", err)
+	}
+	var verified bool
+	for _, key := range manifest.SigningKeys.GpgPublicKeys {
+		if verifySignature(key.ASCIIArmor, shasums, sig) == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, ErrUpstreamSignature
+	}
+	version := &mirrorVersion{
+		ID:                    internal.NewID("mirver"),
+		CreatedAt:             internal.CurrentTimestamp(nil),
+		MirrorProviderOptions: opts.MirrorProviderOptions,
+		Version:               opts.Version,
+		Shasums:               shasums,
+	}
+	// check the manifest agrees with the verified SHA256SUMS
+	if archive, ok := version.archive(manifest.Os, manifest.Arch); !ok || archive.Shasum != manifest.Shasum {
+		return nil, ErrUpstreamChecksum
+	}
+	return version, nil
+}
+
+// fetchArchive retrieves a zip archive, verifying its checksum against the
+// version's SHA256SUMS file.
+func (u *upstream) fetchArchive(ctx context.Context, version *mirrorVersion, archive mirrorArchive) ([]byte, error) {
+	base, err := u.discover(ctx, version.Hostname)
+	if err != nil {
+		return nil, err
+	}
+	opts := MirrorVersionOptions{MirrorProviderOptions: version.MirrorProviderOptions, Version: version.Version}
+	manifest, manifestURL, err := u.findPackage(ctx, base, opts, archive.OS, archive.Arch)
+	if err != nil {
+		return nil, err
+	}
+	data, err := u.getRelative(ctx, manifestURL, manifest.DownloadURL, u.maxArchiveSize)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving archive: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	if hex.EncodeToString(sum[:]) != archive.Shasum {
+		return nil, ErrUpstreamChecksum
+	}
+	return data, nil
+}
+
+// getRelative retrieves the resource at the given reference, which may be
+// relative to the URL of the manifest in which it is found.
+func (u *upstream) getRelative(ctx context.Context, manifestURL *url.URL, ref string, limit int64) ([]byte, error) {
+	target, err := manifestURL.Parse(ref)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return u.get(ctx, target, limit)
+}
+
+func (u *upstream) getJSON(ctx context.Context, target *url.URL, v any) error {
+	body, err := u.get(ctx, target, u.maxMetadataSize)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("decoding response from %s: %w", target, err)
+	}
+	return nil
+}
+
+// get retrieves the resource at the target URL, returning an error if it
+// exceeds limit bytes.
+func (u *upstream) get(ctx context.Context, target *url.URL, limit int64) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	response, err := u.client.Do(request)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		// read one byte beyond the limit to detect a body exceeding it
+		body, err := io.ReadAll(io.LimitReader(response.Body, limit+1))
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if int64(len(body)) > limit {
+			return nil, fmt.Errorf("%w: %s", ErrUpstreamTooLarge, target)
+		}
+		return body, nil
+	case http.StatusNotFound:
+		return nil, internal.ErrResourceNotFound
+	default:
+		return nil, errors.Errorf("unexpected status code from upstream provider registry: %s: %d", target, response.StatusCode)
+	}
+}
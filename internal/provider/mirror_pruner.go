@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tofutf/tofutf/internal"
+)
+
+// MirrorPrunerLockID guarantees only one mirror pruner on a cluster is running
+// at any time.
+const MirrorPrunerLockID int64 = 5577006791947779419
+
+// defaultMirrorPruneInterval is how often the pruner deletes expired versions
+// from the mirror's cache.
+var defaultMirrorPruneInterval = time.Hour
+
+type (
+	// MirrorPruner periodically deletes versions cached by the mirror that are
+	// older than the retention period, along with their archives. A deleted
+	// version is cached afresh from the upstream registry upon its next
+	// installation.
+	MirrorPruner struct {
+		Logger    *slog.Logger
+		Versions  mirrorPrunerClient
+		Retention time.Duration
+
+		// frequency with which the pruner deletes expired versions
+		checkInterval time.Duration
+	}
+
+	mirrorPrunerClient interface {
+		deleteMirrorVersionsBefore(ctx context.Context, before time.Time) (int64, error)
+	}
+)
+
+// NewMirrorPruner constructs a pruner that deletes versions cached by the
+// mirror that are older than the retention period.
+func (s *Service) NewMirrorPruner(logger *slog.Logger, retention time.Duration) *MirrorPruner {
+	return &MirrorPruner{
+		Logger:        logger.With("component", "provider-mirror-pruner"),
+		Versions:      s.db,
+		Retention:     retention,
+		checkInterval: defaultMirrorPruneInterval,
+	}
+}
+
+// Start starts the pruner daemon. Should be invoked in a go routine.
+func (p *MirrorPruner) Start(ctx context.Context) error {
+	// run at startup and then every check interval
+	p.prune(ctx)
+	ticker := time.NewTicker(p.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.prune(ctx)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (p *MirrorPruner) prune(ctx context.Context) {
+	before := internal.CurrentTimestamp(nil).Add(-p.Retention)
+	deleted, err := p.Versions.deleteMirrorVersionsBefore(ctx, before)
+	if err != nil {
+		p.Logger.Error("deleting expired mirror provider versions", "err", err)
+		return
+	}
+	if deleted > 0 {
+		p.Logger.Info("deleted expired mirror provider versions", "deleted", deleted, "before", before)
+	}
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMirrorPruner_prune(t *testing.T) {
+	versions := &fakeMirrorPrunerClient{}
+	pruner := &MirrorPruner{
+		Logger:    slog.Default(),
+		Versions:  versions,
+		Retention: 24 * time.Hour,
+	}
+
+	pruner.prune(context.Background())
+
+	// versions older than the retention period are deleted
+	assert.WithinDuration(t, time.Now().Add(-24*time.Hour), versions.before, time.Minute)
+}
+
+type fakeMirrorPrunerClient struct {
+	before time.Time
+}
+
+func (f *fakeMirrorPrunerClient) deleteMirrorVersionsBefore(ctx context.Context, before time.Time) (int64, error) {
+	f.before = before
+	return 0, nil
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+)
+
+func TestMirrorVersion_archives(t *testing.T) {
+	version := mirrorVersion{
+		MirrorProviderOptions: MirrorProviderOptions{Type: "aws"},
+		Version:               "5.0.0",
+		Shasums: []byte(`aaaa  terraform-provider-aws_5.0.0_linux_amd64.zip
+bbbb  terraform-provider-aws_5.0.0_darwin_arm64.zip
+cccc  terraform-provider-aws_5.0.0_manifest.json
+`),
+	}
+	want := []mirrorArchive{
+		{OS: "linux", Arch: "amd64", Filename: "terraform-provider-aws_5.0.0_linux_amd64.zip", Shasum: "aaaa"},
+		{OS: "darwin", Arch: "arm64", Filename: "terraform-provider-aws_5.0.0_darwin_arm64.zip", Shasum: "bbbb"},
+	}
+	assert.Equal(t, want, version.archives())
+
+	_, ok := version.archive("windows", "amd64")
+	assert.False(t, ok)
+}
+
+func TestUpstream(t *testing.T) {
+	entity, err := openpgp.NewEntity("acme", "", "acme@example.com", nil)
+	require.NoError(t, err)
+	var key bytes.Buffer
+	w, err := armor.Encode(&key, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+
+	archive := []byte("zip archive")
+	sum := sha256.Sum256(archive)
+	shasums := []byte(hex.EncodeToString(sum[:]) + "  terraform-provider-aws_5.0.0_linux_amd64.zip\n")
+	var sig bytes.Buffer
+	require.NoError(t, openpgp.DetachSign(&sig, entity, bytes.NewReader(shasums), nil))
+
+	// fake upstream registry, with the download URLs relative to the manifest.
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/terraform.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"providers.v1":"/v1/providers/"}`))
+	})
+	mux.HandleFunc("/v1/providers/hashicorp/aws/versions", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"versions":[{"version":"5.0.0","platforms":[{"os":"linux","arch":"amd64"}]}]}`))
+	})
+	mux.HandleFunc("/v1/providers/hashicorp/aws/5.0.0/download/linux/amd64", func(w http.ResponseWriter, r *http.Request) {
+		manifest := ProviderVersionManifest{
+			Os:                  "linux",
+			Arch:                "amd64",
+			Filename:            "terraform-provider-aws_5.0.0_linux_amd64.zip",
+			Shasum:              hex.EncodeToString(sum[:]),
+			DownloadURL:         "/files/archive.zip",
+			ShasumsURL:          "/files/SHA256SUMS",
+			ShasumsSignatureURL: "/files/SHA256SUMS.sig",
+		}
+		manifest.SigningKeys.GpgPublicKeys = []GpgPublicKey{{ASCIIArmor: key.String()}}
+		json.NewEncoder(w).Encode(manifest) //nolint:errcheck
+	})
+	mux.HandleFunc("/files/SHA256SUMS", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(shasums)
+	})
+	mux.HandleFunc("/files/SHA256SUMS.sig", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(sig.Bytes())
+	})
+	mux.HandleFunc("/files/archive.zip", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(archive)
+	})
+	srv := httptest.NewTLSServer(mux)
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	up := newUpstream()
+	up.client = srv.Client()
+	opts := MirrorVersionOptions{
+		MirrorProviderOptions: MirrorProviderOptions{
+			Hostname:  u.Host,
+			Namespace: "hashicorp",
+			Type:      "aws",
+		},
+		Version: "5.0.0",
+	}
+
+	t.Run("list versions", func(t *testing.T) {
+		got, err := up.listVersions(context.Background(), opts.MirrorProviderOptions)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(got.Versions))
+		assert.Equal(t, "5.0.0", got.Versions[0].Version)
+	})
+
+	t.Run("fetch version and archive", func(t *testing.T) {
+		version, err := up.fetchVersion(context.Background(), opts)
+		require.NoError(t, err)
+		assert.Equal(t, shasums, version.Shasums)
+
+		got, err := up.fetchArchive(context.Background(), version, version.archives()[0])
+		require.NoError(t, err)
+		assert.Equal(t, archive, got)
+	})
+
+	t.Run("archive checksum mismatch", func(t *testing.T) {
+		version, err := up.fetchVersion(context.Background(), opts)
+		require.NoError(t, err)
+		tampered := version.archives()[0]
+		tampered.Shasum = "0000"
+
+		_, err = up.fetchArchive(context.Background(), version, tampered)
+		assert.ErrorIs(t, err, ErrUpstreamChecksum)
+	})
+
+	t.Run("unsigned shasums", func(t *testing.T) {
+		shasums = append(shasums, '\n')
+		t.Cleanup(func() { shasums = shasums[:len(shasums)-1] })
+
+		_, err := up.fetchVersion(context.Background(), opts)
+		assert.ErrorIs(t, err, ErrUpstreamSignature)
+	})
+
+	t.Run("archive exceeds maximum size", func(t *testing.T) {
+		version, err := up.fetchVersion(context.Background(), opts)
+		require.NoError(t, err)
+
+		small := *up
+		small.maxArchiveSize = int64(len(archive) - 1)
+		_, err = small.fetchArchive(context.Background(), version, version.archives()[0])
+		assert.ErrorIs(t, err, ErrUpstreamTooLarge)
+	})
+
+	t.Run("version not found", func(t *testing.T) {
+		opts := opts
+		opts.Version = "6.0.0"
+
+		_, err := up.fetchVersion(context.Background(), opts)
+		assert.ErrorIs(t, err, internal.ErrResourceNotFound)
+	})
+}
+
+func TestService_checkMirrorHostname(t *testing.T) {
+	svc := &Service{
+		system:      internal.NewHostnameService("tofutf.example.com"),
+		mirrorHosts: []string{"registry.terraform.io", "registry.opentofu.org", "tofutf.example.com"},
+	}
+
+	assert.NoError(t, svc.checkMirrorHostname("registry.terraform.io"))
+	assert.NoError(t, svc.checkMirrorHostname("registry.opentofu.org"))
+	// hosts not configured as upstream registries are refused
+	assert.ErrorIs(t, svc.checkMirrorHostname("169.254.169.254"), internal.ErrResourceNotFound)
+	// the system's own hostname is refused even if configured
+	assert.ErrorIs(t, svc.checkMirrorHostname("tofutf.example.com"), internal.ErrResourceNotFound)
+}
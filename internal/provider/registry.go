@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/resource"
+	"github.com/tofutf/tofutf/internal/semver"
+)
+
+var (
+	ErrInvalidVersion     = errors.New("version must be a semantic version")
+	ErrInvalidShasum      = errors.New("shasum must be a hex-encoded SHA256 checksum")
+	ErrShasumMismatch     = errors.New("checksum of uploaded binary does not match the platform's shasum")
+	ErrShasumsNotUploaded = errors.New("SHA256SUMS must be uploaded before its signature")
+	ErrInvalidSignature   = errors.New("signature does not verify SHA256SUMS with the version's GPG key")
+	ErrShadowsUpstream    = errors.New("a provider with the same namespace and name exists in the upstream registry")
+)
+
+type (
+	// RegistryProvider is a provider published to an organization's private
+	// registry.
+	RegistryProvider struct {
+		ID           string
+		CreatedAt    time.Time
+		UpdatedAt    time.Time
+		Name         string
+		Organization string
+	}
+
+	// RegistryProviderVersion is a version of a provider in the private
+	// registry.
+	RegistryProviderVersion struct {
+		ID         string
+		CreatedAt  time.Time
+		UpdatedAt  time.Time
+		Version    string
+		KeyID      string // ID of GPG key with which SHA256SUMS is signed
+		Protocols  []string
+		ProviderID string
+
+		ShasumsUploaded    bool
+		ShasumsSigUploaded bool
+	}
+
+	// RegistryProviderPlatform is a platform for which a version of a provider
+	// in the private registry is built, along with the provider's zip archive
+	// for that platform.
+	RegistryProviderPlatform struct {
+		ID        string
+		CreatedAt time.Time
+		UpdatedAt time.Time
+		OS        string
+		Arch      string
+		Shasum    string // SHA256 checksum of zip archive
+		Filename  string // filename of zip archive
+		VersionID string
+
+		BinaryUploaded bool
+	}
+
+	CreateRegistryProviderOptions struct {
+		Organization string
+		Name         string
+	}
+
+	// RegistryProviderOptions identify a provider in the private registry.
+	RegistryProviderOptions struct {
+		Organization string
+		Name         string
+	}
+
+	CreateRegistryProviderVersionOptions struct {
+		RegistryProviderOptions
+
+		Version   string
+		KeyID     string
+		Protocols []string
+	}
+
+	// RegistryProviderVersionOptions identify a version of a provider in the
+	// private registry.
+	RegistryProviderVersionOptions struct {
+		RegistryProviderOptions
+
+		Version string
+	}
+
+	CreateRegistryProviderPlatformOptions struct {
+		RegistryProviderVersionOptions
+
+		OS       string
+		Arch     string
+		Shasum   string
+		Filename string
+	}
+
+	// RegistryProviderPlatformOptions identify a platform of a version of a
+	// provider in the private registry.
+	RegistryProviderPlatformOptions struct {
+		RegistryProviderVersionOptions
+
+		OS   string
+		Arch string
+	}
+)
+
+func newRegistryProvider(opts CreateRegistryProviderOptions) (*RegistryProvider, error) {
+	if err := resource.ValidateName(&opts.Name); err != nil {
+		return nil, err
+	}
+	return &RegistryProvider{
+		ID:           internal.NewID("prov"),
+		CreatedAt:    internal.CurrentTimestamp(nil),
+		UpdatedAt:    internal.CurrentTimestamp(nil),
+		Name:         opts.Name,
+		Organization: opts.Organization,
+	}, nil
+}
+
+func newRegistryProviderVersion(providerID string, opts CreateRegistryProviderVersionOptions) (*RegistryProviderVersion, error) {
+	if !semver.IsValid(opts.Version) {
+		return nil, ErrInvalidVersion
+	}
+	if opts.KeyID == "" {
+		return nil, fmt.Errorf("key ID: %w", internal.ErrEmptyValue)
+	}
+	if len(opts.Protocols) == 0 {
+		return nil, fmt.Errorf("protocols: %w", internal.ErrEmptyValue)
+	}
+	return &RegistryProviderVersion{
+		ID:         internal.NewID("provver"),
+		CreatedAt:  internal.CurrentTimestamp(nil),
+		UpdatedAt:  internal.CurrentTimestamp(nil),
+		Version:    opts.Version,
+		KeyID:      opts.KeyID,
+		Protocols:  opts.Protocols,
+		ProviderID: providerID,
+	}, nil
+}
+
+func newRegistryProviderPlatform(versionID string, opts CreateRegistryProviderPlatformOptions) (*RegistryProviderPlatform, error) {
+	if opts.OS == "" {
+		return nil, fmt.Errorf("os: %w", internal.ErrEmptyValue)
+	}
+	if opts.Arch == "" {
+		return nil, fmt.Errorf("arch: %w", internal.ErrEmptyValue)
+	}
+	if opts.Filename == "" {
+		return nil, fmt.Errorf("filename: %w", internal.ErrEmptyValue)
+	}
+	if b, err := hex.DecodeString(opts.Shasum); err != nil || len(b) != 32 {
+		return nil, ErrInvalidShasum
+	}
+	opts.Shasum = strings.ToLower(opts.Shasum)
+	return &RegistryProviderPlatform{
+		ID:        internal.NewID("provplat"),
+		CreatedAt: internal.CurrentTimestamp(nil),
+		UpdatedAt: internal.CurrentTimestamp(nil),
+		OS:        opts.OS,
+		Arch:      opts.Arch,
+		Shasum:    opts.Shasum,
+		Filename:  opts.Filename,
+		VersionID: versionID,
+	}, nil
+}
+
+func (p *RegistryProvider) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", p.ID),
+		slog.String("organization", p.Organization),
+		slog.String("name", p.Name),
+	)
+}
+
+func (v *RegistryProviderVersion) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", v.ID),
+		slog.String("version", v.Version),
+	)
+}
+
+func (p *RegistryProviderPlatform) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", p.ID),
+		slog.String("os", p.OS),
+		slog.String("arch", p.Arch),
+	)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+)
+
+func TestNewRegistryProviderVersion(t *testing.T) {
+	opts := CreateRegistryProviderVersionOptions{
+		Version:   "1.0.0",
+		KeyID:     "ABCDEF",
+		Protocols: []string{"5.0"},
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		got, err := newRegistryProviderVersion("prov-123", opts)
+		require.NoError(t, err)
+		assert.Equal(t, "prov-123", got.ProviderID)
+		assert.False(t, got.ShasumsUploaded)
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		opts := opts
+		opts.Version = "latest"
+		_, err := newRegistryProviderVersion("prov-123", opts)
+		assert.ErrorIs(t, err, ErrInvalidVersion)
+	})
+
+	t.Run("missing protocols", func(t *testing.T) {
+		opts := opts
+		opts.Protocols = nil
+		_, err := newRegistryProviderVersion("prov-123", opts)
+		assert.ErrorIs(t, err, internal.ErrEmptyValue)
+	})
+}
+
+func TestNewRegistryProviderPlatform(t *testing.T) {
+	opts := CreateRegistryProviderPlatformOptions{
+		OS:       "linux",
+		Arch:     "amd64",
+		Shasum:   "8F69533BC8AFC227B40D15116358F91505BB638CE5919712FBB38A2DEC1BBA38",
+		Filename: "terraform-provider-internal_1.0.0_linux_amd64.zip",
+	}
+
+	t.Run("normalize shasum", func(t *testing.T) {
+		got, err := newRegistryProviderPlatform("provver-123", opts)
+		require.NoError(t, err)
+		assert.Equal(t, "8f69533bc8afc227b40d15116358f91505bb638ce5919712fbb38a2dec1bba38", got.Shasum)
+	})
+
+	t.Run("invalid shasum", func(t *testing.T) {
+		opts := opts
+		opts.Shasum = "not-a-checksum"
+		_, err := newRegistryProviderPlatform("provver-123", opts)
+		assert.ErrorIs(t, err, ErrInvalidShasum)
+	})
+}
@@ -2,19 +2,29 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"net/url"
+	"time"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
 	"github.com/gorilla/mux"
 	"github.com/leg100/surl"
 	"github.com/pkg/errors"
 	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/blob"
+	"github.com/tofutf/tofutf/internal/gpgkeys"
 	"github.com/tofutf/tofutf/internal/http/html"
 	"github.com/tofutf/tofutf/internal/organization"
+	"github.com/tofutf/tofutf/internal/rbac"
 	"github.com/tofutf/tofutf/internal/sql"
+	"github.com/tofutf/tofutf/internal/tfeapi"
 )
 
 type (
@@ -25,29 +35,58 @@ type (
 		*internal.HostnameService
 		*surl.Signer
 		html.Renderer
+		*tfeapi.Responder
+
+		BlobStore blob.Store
+		GPGKeys   GPGKeyService
 
 		ProxyURL           string
 		ProxyIsArtifactory bool
+
+		// MirrorHosts are the upstream registries from which the mirror is
+		// permitted to retrieve providers.
+		MirrorHosts []string
 	}
 
 	Service struct {
 		logger       *slog.Logger
 		organization internal.Authorizer
 
+		db       *pgdb
+		gpgkeys  GPGKeyService
+		signer   *surl.Signer
+		system   *internal.HostnameService
+		upstream *upstream
+
+		mirrorHosts []string
+
 		api *apiHandlers
 		web *webHandlers
+		tfe *tfeHandlers
 
 		proxyURL           string
 		proxyIsArtifactory bool
 
 		client *http.Client
 	}
+
+	// GPGKeyService retrieves the GPG keys with which the SHA256SUMS files of
+	// providers in the private registry are signed.
+	GPGKeyService interface {
+		Get(ctx context.Context, opts gpgkeys.GetOptions) (*gpgkeys.GPGKey, error)
+	}
 )
 
 func NewService(opts Options) *Service {
 	svc := Service{
 		logger:             opts.Logger,
 		organization:       &organization.Authorizer{Logger: opts.Logger},
+		db:                 &pgdb{opts.Pool, opts.BlobStore},
+		gpgkeys:            opts.GPGKeys,
+		signer:             opts.Signer,
+		system:             opts.HostnameService,
+		upstream:           newUpstream(),
+		mirrorHosts:        opts.MirrorHosts,
 		proxyURL:           opts.ProxyURL,
 		proxyIsArtifactory: opts.ProxyIsArtifactory,
 		client:             &http.Client{},
@@ -56,6 +95,11 @@ func NewService(opts Options) *Service {
 		svc:    &svc,
 		Signer: opts.Signer,
 	}
+	svc.tfe = &tfeHandlers{
+		svc:       &svc,
+		Signer:    opts.Signer,
+		Responder: opts.Responder,
+	}
 	svc.web = &webHandlers{
 		client:   &svc,
 		signer:   opts.Signer,
@@ -69,6 +113,7 @@ func NewService(opts Options) *Service {
 func (s *Service) AddHandlers(r *mux.Router) {
 	s.api.addHandlers(r)
 	s.web.addHandlers(r)
+	s.tfe.addHandlers(r)
 }
 
 type GetProviderVersionsOptions struct {
@@ -91,8 +136,25 @@ type ProviderPlatform struct {
 	Arch string `json:"arch"`
 }
 
-// GetProviderVersions retrieves the list of versions that exist for a given provider.
+// GetProviderVersions retrieves the list of versions that exist for a given
+// provider. Providers published to the private registry take precedence over
+// those in the upstream registry, but only for members of the provider's
+// organization.
 func (s *Service) GetProviderVersions(ctx context.Context, options GetProviderVersionsOptions) (*ProviderVersions, error) {
+	private, err := s.getPrivateProviderVersions(ctx, options)
+	if !errors.Is(err, internal.ErrResourceNotFound) {
+		return private, err
+	}
+	return s.getUpstreamProviderVersions(ctx, options)
+}
+
+// getUpstreamProviderVersions retrieves the list of versions of a provider from
+// the upstream registry.
+func (s *Service) getUpstreamProviderVersions(ctx context.Context, options GetProviderVersionsOptions) (*ProviderVersions, error) {
+	if s.proxyURL == "" {
+		return nil, internal.ErrResourceNotFound
+	}
+
 	s.logger.Info("proxying provider versions request", "namespace", options.Namespace, "type", options.Type)
 
 	versionsURL, err := url.JoinPath(s.proxyURL, options.Namespace, options.Type, "versions")
@@ -100,7 +162,7 @@ func (s *Service) GetProviderVersions(ctx context.Context, options GetProviderVe
 		return nil, errors.WithStack(err)
 	}
 
-	request, err := http.NewRequest(http.MethodGet, versionsURL, nil)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, versionsURL, nil)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -109,7 +171,11 @@ func (s *Service) GetProviderVersions(ctx context.Context, options GetProviderVe
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	defer response.Body.Close()
 
+	if response.StatusCode == http.StatusNotFound {
+		return nil, internal.ErrResourceNotFound
+	}
 	if response.StatusCode != http.StatusOK {
 		err := errors.Errorf("unexpected error from upstream provider registery: %d", response.StatusCode)
 
@@ -146,21 +212,33 @@ type ProviderVersionManifest struct {
 	ShasumsSignatureURL string   `json:"shasums_signature_url"`
 	Shasum              string   `json:"shasum"`
 	SigningKeys         struct {
-		GpgPublicKeys []struct {
-			KeyID          string `json:"key_id"`
-			ASCIIArmor     string `json:"ascii_armor"`
-			TrustSignature string `json:"trust_signature"`
-			Source         string `json:"source"`
-			SourceURL      string `json:"source_url"`
-		} `json:"gpg_public_keys"`
+		GpgPublicKeys []GpgPublicKey `json:"gpg_public_keys"`
 	} `json:"signing_keys"`
 }
 
-func (s Service) FindProviderPackage(ctx context.Context, options FindProviderPackageOptions) (*ProviderVersionManifest, error) {
+type GpgPublicKey struct {
+	KeyID          string `json:"key_id"`
+	ASCIIArmor     string `json:"ascii_armor"`
+	TrustSignature string `json:"trust_signature"`
+	Source         string `json:"source"`
+	SourceURL      string `json:"source_url"`
+}
+
+// FindProviderPackage retrieves the manifest for a provider package. Providers
+// published to the private registry take precedence over those in the
+// upstream registry, but only for members of the provider's organization.
+func (s *Service) FindProviderPackage(ctx context.Context, options FindProviderPackageOptions) (*ProviderVersionManifest, error) {
+	private, err := s.findPrivateProviderPackage(ctx, options)
+	if !errors.Is(err, internal.ErrResourceNotFound) {
+		return private, err
+	}
+	if s.proxyURL == "" {
+		return nil, internal.ErrResourceNotFound
+	}
+
 	s.logger.Info("proxying provider download request", "namespace", options.Namespace, "type", options.Type, "version", options.Version, "os", options.OS, "arch", options.Arch)
 
 	var manifestURL string
-	var err error
 	if s.proxyIsArtifactory {
 		// artifactory is kinda garbage and doesn't actually build correct URLs for terraform at this point in time.
 		manifestURL, err = url.JoinPath(s.proxyURL, options.Namespace, options.Type, options.Version, options.OS, options.Arch)
@@ -201,3 +279,431 @@ func (s Service) FindProviderPackage(ctx context.Context, options FindProviderPa
 
 	return &manifest, nil
 }
+
+func (s *Service) CreateRegistryProvider(ctx context.Context, opts CreateRegistryProviderOptions) (*RegistryProvider, error) {
+	subject, err := s.organization.CanAccess(ctx, rbac.CreateRegistryProviderAction, opts.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := newRegistryProvider(opts)
+	if err != nil {
+		return nil, err
+	}
+	// refuse to shadow a provider in the upstream registry with the same
+	// namespace and name.
+	_, err = s.getUpstreamProviderVersions(ctx, GetProviderVersionsOptions{
+		Namespace: provider.Organization,
+		Type:      provider.Name,
+	})
+	if err == nil {
+		return nil, ErrShadowsUpstream
+	} else if !errors.Is(err, internal.ErrResourceNotFound) {
+		return nil, fmt.Errorf("checking upstream registry: %w", err)
+	}
+	if err := s.db.createProvider(ctx, provider); err != nil {
+		s.logger.Error("creating registry provider", "provider", provider, "subject", subject, "err", err)
+		return nil, err
+	}
+	s.logger.Info("created registry provider", "provider", provider, "subject", subject)
+	return provider, nil
+}
+
+func (s *Service) ListRegistryProviders(ctx context.Context, organization string) ([]*RegistryProvider, error) {
+	subject, err := s.organization.CanAccess(ctx, rbac.ListRegistryProvidersAction, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	providers, err := s.db.listProviders(ctx, organization)
+	if err != nil {
+		s.logger.Error("listing registry providers", "organization", organization, "subject", subject, "err", err)
+		return nil, err
+	}
+	s.logger.Debug("listed registry providers", "organization", organization, "subject", subject)
+	return providers, nil
+}
+
+func (s *Service) GetRegistryProvider(ctx context.Context, opts RegistryProviderOptions) (*RegistryProvider, error) {
+	subject, err := s.organization.CanAccess(ctx, rbac.GetRegistryProviderAction, opts.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := s.db.getProvider(ctx, opts.Organization, opts.Name)
+	if err != nil {
+		s.logger.Error("retrieving registry provider", "organization", opts.Organization, "name", opts.Name, "subject", subject, "err", err)
+		return nil, err
+	}
+	s.logger.Debug("retrieved registry provider", "provider", provider, "subject", subject)
+	return provider, nil
+}
+
+func (s *Service) DeleteRegistryProvider(ctx context.Context, opts RegistryProviderOptions) error {
+	subject, err := s.organization.CanAccess(ctx, rbac.DeleteRegistryProviderAction, opts.Organization)
+	if err != nil {
+		return err
+	}
+
+	provider, err := s.db.getProvider(ctx, opts.Organization, opts.Name)
+	if err != nil {
+		return err
+	}
+	if err := s.db.deleteProvider(ctx, provider.ID); err != nil {
+		s.logger.Error("deleting registry provider", "provider", provider, "subject", subject, "err", err)
+		return err
+	}
+	s.logger.Info("deleted registry provider", "provider", provider, "subject", subject)
+	return nil
+}
+
+func (s *Service) CreateRegistryProviderVersion(ctx context.Context, opts CreateRegistryProviderVersionOptions) (*RegistryProviderVersion, error) {
+	subject, err := s.organization.CanAccess(ctx, rbac.CreateRegistryProviderVersionAction, opts.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := s.db.getProvider(ctx, opts.Organization, opts.Name)
+	if err != nil {
+		return nil, err
+	}
+	// check the key exists before it is used to sign anything
+	if _, err := s.getKey(ctx, opts.Organization, opts.KeyID); err != nil {
+		return nil, fmt.Errorf("retrieving gpg key: %w", err)
+	}
+	version, err := newRegistryProviderVersion(provider.ID, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.db.createVersion(ctx, version); err != nil {
+		s.logger.Error("creating registry provider version", "provider", provider, "version", version, "subject", subject, "err", err)
+		return nil, err
+	}
+	s.logger.Info("created registry provider version", "provider", provider, "version", version, "subject", subject)
+	return version, nil
+}
+
+func (s *Service) ListRegistryProviderVersions(ctx context.Context, opts RegistryProviderOptions) ([]*RegistryProviderVersion, error) {
+	provider, err := s.GetRegistryProvider(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return s.db.listVersions(ctx, provider.ID)
+}
+
+func (s *Service) GetRegistryProviderVersion(ctx context.Context, opts RegistryProviderVersionOptions) (*RegistryProviderVersion, error) {
+	provider, err := s.GetRegistryProvider(ctx, opts.RegistryProviderOptions)
+	if err != nil {
+		return nil, err
+	}
+	return s.db.getVersion(ctx, provider.ID, opts.Version)
+}
+
+func (s *Service) DeleteRegistryProviderVersion(ctx context.Context, opts RegistryProviderVersionOptions) error {
+	subject, err := s.organization.CanAccess(ctx, rbac.DeleteRegistryProviderVersionAction, opts.Organization)
+	if err != nil {
+		return err
+	}
+
+	provider, err := s.db.getProvider(ctx, opts.Organization, opts.Name)
+	if err != nil {
+		return err
+	}
+	version, err := s.db.getVersion(ctx, provider.ID, opts.Version)
+	if err != nil {
+		return err
+	}
+	if err := s.db.deleteVersion(ctx, version.ID); err != nil {
+		s.logger.Error("deleting registry provider version", "provider", provider, "version", version, "subject", subject, "err", err)
+		return err
+	}
+	s.logger.Info("deleted registry provider version", "provider", provider, "version", version, "subject", subject)
+	return nil
+}
+
+func (s *Service) CreateRegistryProviderPlatform(ctx context.Context, opts CreateRegistryProviderPlatformOptions) (*RegistryProviderPlatform, error) {
+	subject, err := s.organization.CanAccess(ctx, rbac.CreateRegistryProviderPlatformAction, opts.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := s.db.getProvider(ctx, opts.Organization, opts.Name)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.db.getVersion(ctx, provider.ID, opts.Version)
+	if err != nil {
+		return nil, err
+	}
+	platform, err := newRegistryProviderPlatform(version.ID, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.db.createPlatform(ctx, platform); err != nil {
+		s.logger.Error("creating registry provider platform", "provider", provider, "version", version, "platform", platform, "subject", subject, "err", err)
+		return nil, err
+	}
+	s.logger.Info("created registry provider platform", "provider", provider, "version", version, "platform", platform, "subject", subject)
+	return platform, nil
+}
+
+func (s *Service) ListRegistryProviderPlatforms(ctx context.Context, opts RegistryProviderVersionOptions) ([]*RegistryProviderPlatform, error) {
+	version, err := s.GetRegistryProviderVersion(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return s.db.listPlatforms(ctx, version.ID)
+}
+
+func (s *Service) GetRegistryProviderPlatform(ctx context.Context, opts RegistryProviderPlatformOptions) (*RegistryProviderPlatform, error) {
+	version, err := s.GetRegistryProviderVersion(ctx, opts.RegistryProviderVersionOptions)
+	if err != nil {
+		return nil, err
+	}
+	return s.db.getPlatform(ctx, version.ID, opts.OS, opts.Arch)
+}
+
+func (s *Service) DeleteRegistryProviderPlatform(ctx context.Context, opts RegistryProviderPlatformOptions) error {
+	subject, err := s.organization.CanAccess(ctx, rbac.DeleteRegistryProviderPlatformAction, opts.Organization)
+	if err != nil {
+		return err
+	}
+
+	provider, err := s.db.getProvider(ctx, opts.Organization, opts.Name)
+	if err != nil {
+		return err
+	}
+	version, err := s.db.getVersion(ctx, provider.ID, opts.Version)
+	if err != nil {
+		return err
+	}
+	platform, err := s.db.getPlatform(ctx, version.ID, opts.OS, opts.Arch)
+	if err != nil {
+		return err
+	}
+	if err := s.db.deletePlatform(ctx, platform.ID); err != nil {
+		s.logger.Error("deleting registry provider platform", "provider", provider, "version", version, "platform", platform, "subject", subject, "err", err)
+		return err
+	}
+	s.logger.Info("deleted registry provider platform", "provider", provider, "version", version, "platform", platform, "subject", subject)
+	return nil
+}
+
+// uploadShasums uploads a version's SHA256SUMS file. Any existing signature is
+// removed and must be uploaded again.
+//
+// NOTE: unauthenticated - access granted only via signed URL
+func (s *Service) uploadShasums(ctx context.Context, versionID string, shasums []byte) error {
+	if _, err := s.db.getVersionByID(ctx, versionID); err != nil {
+		return err
+	}
+	if err := s.db.saveShasums(ctx, versionID, shasums); err != nil {
+		s.logger.Error("uploading SHA256SUMS", "version_id", versionID, "err", err)
+		return err
+	}
+	s.logger.Info("uploaded SHA256SUMS", "version_id", versionID)
+	return nil
+}
+
+// uploadShasumsSig uploads the signature of a version's SHA256SUMS file,
+// verifying it with the version's GPG key.
+//
+// NOTE: unauthenticated - access granted only via signed URL
+func (s *Service) uploadShasumsSig(ctx context.Context, versionID string, sig []byte) error {
+	version, err := s.db.getVersionByID(ctx, versionID)
+	if err != nil {
+		return err
+	}
+	if !version.ShasumsUploaded {
+		return ErrShasumsNotUploaded
+	}
+	provider, err := s.db.getProviderByID(ctx, version.ProviderID)
+	if err != nil {
+		return err
+	}
+	key, err := s.getKey(ctx, provider.Organization, version.KeyID)
+	if err != nil {
+		return fmt.Errorf("retrieving gpg key: %w", err)
+	}
+	shasums, err := s.db.getShasums(ctx, versionID)
+	if err != nil {
+		return err
+	}
+	if err := verifySignature(key.ASCIIArmor, shasums, sig); err != nil {
+		return err
+	}
+	if err := s.db.saveShasumsSig(ctx, versionID, sig); err != nil {
+		s.logger.Error("uploading SHA256SUMS signature", "version", version, "err", err)
+		return err
+	}
+	s.logger.Info("uploaded SHA256SUMS signature", "version", version)
+	return nil
+}
+
+// uploadBinary uploads a platform's zip archive, checking its checksum matches
+// the platform's shasum.
+//
+// NOTE: unauthenticated - access granted only via signed URL
+func (s *Service) uploadBinary(ctx context.Context, platformID string, binary []byte) error {
+	platform, err := s.db.getPlatformByID(ctx, platformID)
+	if err != nil {
+		return err
+	}
+	if sum := sha256.Sum256(binary); hex.EncodeToString(sum[:]) != platform.Shasum {
+		return ErrShasumMismatch
+	}
+	if err := s.db.saveBinary(ctx, platformID, binary); err != nil {
+		s.logger.Error("uploading provider binary", "platform", platform, "err", err)
+		return err
+	}
+	s.logger.Info("uploaded provider binary", "platform", platform)
+	return nil
+}
+
+// NOTE: unauthenticated - access granted only via signed URL
+func (s *Service) downloadShasums(ctx context.Context, versionID string) ([]byte, error) {
+	return s.db.getShasums(ctx, versionID)
+}
+
+// NOTE: unauthenticated - access granted only via signed URL
+func (s *Service) downloadShasumsSig(ctx context.Context, versionID string) ([]byte, error) {
+	return s.db.getShasumsSig(ctx, versionID)
+}
+
+// NOTE: unauthenticated - access granted only via signed URL
+func (s *Service) downloadBinary(ctx context.Context, platformID string) ([]byte, error) {
+	return s.db.getBinary(ctx, platformID)
+}
+
+// getPrivateProviderVersions lists the installable versions of a provider in
+// the private registry, i.e. those with a signed SHA256SUMS file, along with
+// their platforms for which a binary has been uploaded.
+func (s *Service) getPrivateProviderVersions(ctx context.Context, opts GetProviderVersionsOptions) (*ProviderVersions, error) {
+	provider, err := s.db.getProvider(ctx, opts.Namespace, opts.Type)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.canAccessPrivateProvider(ctx, provider.Organization); err != nil {
+		return nil, err
+	}
+	versions, err := s.db.listVersions(ctx, provider.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp := ProviderVersions{Versions: []ProviderVersionsVersions{}}
+	for _, version := range versions {
+		if !version.ShasumsSigUploaded {
+			continue
+		}
+		platforms, err := s.db.listPlatforms(ctx, version.ID)
+		if err != nil {
+			return nil, err
+		}
+		v := ProviderVersionsVersions{
+			Version:   version.Version,
+			Protocols: version.Protocols,
+			Platforms: []ProviderPlatform{},
+		}
+		for _, platform := range platforms {
+			if platform.BinaryUploaded {
+				v.Platforms = append(v.Platforms, ProviderPlatform{Os: platform.OS, Arch: platform.Arch})
+			}
+		}
+		resp.Versions = append(resp.Versions, v)
+	}
+	return &resp, nil
+}
+
+// canAccessPrivateProvider checks whether the subject can access the private
+// providers of an organization. A private provider is reported as not found to
+// anonymous clients and to non-members, in order that their requests fall
+// through to the upstream registry rather than retrieving a private provider
+// with the same namespace and name as an upstream provider.
+func (s *Service) canAccessPrivateProvider(ctx context.Context, organization string) error {
+	if _, err := internal.SubjectFromContext(ctx); err != nil {
+		return internal.ErrResourceNotFound
+	}
+	_, err := s.organization.CanAccess(ctx, rbac.GetRegistryProviderAction, organization)
+	if errors.Is(err, internal.ErrAccessNotPermitted) {
+		return internal.ErrResourceNotFound
+	}
+	return err
+}
+
+// findPrivateProviderPackage retrieves the manifest for a package of a
+// provider in the private registry. The manifest's URLs are signed and
+// relative to the host.
+func (s *Service) findPrivateProviderPackage(ctx context.Context, opts FindProviderPackageOptions) (*ProviderVersionManifest, error) {
+	provider, err := s.db.getProvider(ctx, opts.Namespace, opts.Type)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.canAccessPrivateProvider(ctx, provider.Organization); err != nil {
+		return nil, err
+	}
+	version, err := s.db.getVersion(ctx, provider.ID, opts.Version)
+	if err != nil {
+		return nil, err
+	}
+	if !version.ShasumsSigUploaded {
+		return nil, internal.ErrResourceNotFound
+	}
+	platform, err := s.db.getPlatform(ctx, version.ID, opts.OS, opts.Arch)
+	if err != nil {
+		return nil, err
+	}
+	if !platform.BinaryUploaded {
+		return nil, internal.ErrResourceNotFound
+	}
+	key, err := s.getKey(ctx, provider.Organization, version.KeyID)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving gpg key: %w", err)
+	}
+
+	manifest := ProviderVersionManifest{
+		Protocols: version.Protocols,
+		Os:        platform.OS,
+		Arch:      platform.Arch,
+		Filename:  platform.Filename,
+		Shasum:    platform.Shasum,
+	}
+	if manifest.DownloadURL, err = s.signer.Sign(binaryPath(platform.ID), time.Hour); err != nil {
+		return nil, err
+	}
+	if manifest.ShasumsURL, err = s.signer.Sign(shasumsPath(version.ID), time.Hour); err != nil {
+		return nil, err
+	}
+	if manifest.ShasumsSignatureURL, err = s.signer.Sign(shasumsSigPath(version.ID), time.Hour); err != nil {
+		return nil, err
+	}
+	manifest.SigningKeys.GpgPublicKeys = []GpgPublicKey{{
+		KeyID:      key.KeyID,
+		ASCIIArmor: key.ASCIIArmor,
+	}}
+	return &manifest, nil
+}
+
+// getKey retrieves a GPG key from the private registry. Anyone permitted to
+// install a provider is permitted to verify it, so the key is retrieved with
+// superuser privileges.
+func (s *Service) getKey(ctx context.Context, organization, keyID string) (*gpgkeys.GPGKey, error) {
+	ctx = internal.AddSubjectToContext(ctx, &internal.Superuser{Username: "provider-registry"})
+	return s.gpgkeys.Get(ctx, gpgkeys.GetOptions{
+		RegistryName: tfeapi.PrivateRegistry,
+		Organization: organization,
+		KeyID:        keyID,
+	})
+}
+
+// verifySignature verifies a detached signature of a SHA256SUMS file with an
+// ASCII-armored public key.
+func verifySignature(armoredKey string, shasums, sig []byte) error {
+	keyring, err := openpgp.ReadArmoredKeyRing(bytes.NewReader([]byte(armoredKey)))
+	if err != nil {
+		return fmt.Errorf("reading gpg key: %w", err)
+	}
+	if _, err := openpgp.CheckDetachedSignature(keyring, bytes.NewReader(shasums), bytes.NewReader(sig), nil); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/organization"
+	"github.com/tofutf/tofutf/internal/xslog"
+)
+
+func TestVerifySignature(t *testing.T) {
+	entity, err := openpgp.NewEntity("acme", "", "acme@example.com", nil)
+	require.NoError(t, err)
+
+	var key bytes.Buffer
+	w, err := armor.Encode(&key, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+
+	shasums := []byte("8f69533bc8afc227b40d15116358f91505bb638ce5919712fbb38a2dec1bba38  terraform-provider-internal_1.0.0_linux_amd64.zip\n")
+	var sig bytes.Buffer
+	require.NoError(t, openpgp.DetachSign(&sig, entity, bytes.NewReader(shasums), nil))
+
+	t.Run("valid", func(t *testing.T) {
+		err := verifySignature(key.String(), shasums, sig.Bytes())
+		assert.NoError(t, err)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		err := verifySignature(key.String(), append(shasums, 'x'), sig.Bytes())
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+}
+
+func TestService_canAccessPrivateProvider(t *testing.T) {
+	logger := slog.New(&xslog.NoopHandler{})
+	svc := &Service{organization: &organization.Authorizer{Logger: logger}}
+
+	t.Run("member", func(t *testing.T) {
+		ctx := internal.AddSubjectToContext(context.Background(), &internal.Superuser{})
+		assert.NoError(t, svc.canAccessPrivateProvider(ctx, "acme"))
+	})
+
+	t.Run("non-member", func(t *testing.T) {
+		ctx := internal.AddSubjectToContext(context.Background(), &internal.Nobody{})
+		err := svc.canAccessPrivateProvider(ctx, "acme")
+		assert.ErrorIs(t, err, internal.ErrResourceNotFound)
+	})
+
+	t.Run("anonymous", func(t *testing.T) {
+		err := svc.canAccessPrivateProvider(context.Background(), "acme")
+		assert.ErrorIs(t, err, internal.ErrResourceNotFound)
+	})
+}
+
+func TestService_getUpstreamProviderVersions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/hashicorp/aws/versions" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"versions":[{"version":"5.0.0"}]}`)) //nolint:errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	svc := &Service{
+		logger:   slog.New(&xslog.NoopHandler{}),
+		proxyURL: srv.URL,
+		client:   srv.Client(),
+	}
+
+	t.Run("found", func(t *testing.T) {
+		got, err := svc.getUpstreamProviderVersions(context.Background(), GetProviderVersionsOptions{Namespace: "hashicorp", Type: "aws"})
+		require.NoError(t, err)
+		assert.Equal(t, "5.0.0", got.Versions[0].Version)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := svc.getUpstreamProviderVersions(context.Background(), GetProviderVersionsOptions{Namespace: "acme", Type: "internal"})
+		assert.ErrorIs(t, err, internal.ErrResourceNotFound)
+	})
+
+	t.Run("proxy disabled", func(t *testing.T) {
+		_, err := (&Service{}).getUpstreamProviderVersions(context.Background(), GetProviderVersionsOptions{Namespace: "hashicorp", Type: "aws"})
+		assert.ErrorIs(t, err, internal.ErrResourceNotFound)
+	})
+}
@@ -0,0 +1,509 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/leg100/surl"
+	"github.com/tofutf/tofutf/internal"
+	otfhttp "github.com/tofutf/tofutf/internal/http"
+	"github.com/tofutf/tofutf/internal/http/decode"
+	"github.com/tofutf/tofutf/internal/resource"
+	"github.com/tofutf/tofutf/internal/tfeapi"
+	"github.com/tofutf/tofutf/internal/tfeapi/types"
+)
+
+type tfeHandlers struct {
+	*surl.Signer
+	*tfeapi.Responder
+
+	svc *Service
+}
+
+func (h *tfeHandlers) addHandlers(r *mux.Router) {
+	r = r.PathPrefix(tfeapi.APIPrefixV2).Subrouter()
+
+	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/private-registry/providers
+	r.HandleFunc("/organizations/{organization_name}/registry-providers", h.createProvider).Methods("POST")
+	r.HandleFunc("/organizations/{organization_name}/registry-providers", h.listProviders).Methods("GET")
+	r.HandleFunc("/organizations/{organization_name}/registry-providers/{registry_name}/{namespace}/{name}", h.getProvider).Methods("GET")
+	r.HandleFunc("/organizations/{organization_name}/registry-providers/{registry_name}/{namespace}/{name}", h.deleteProvider).Methods("DELETE")
+
+	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/private-registry/provider-versions-platforms
+	r.HandleFunc("/organizations/{organization_name}/registry-providers/{registry_name}/{namespace}/{name}/versions", h.createVersion).Methods("POST")
+	r.HandleFunc("/organizations/{organization_name}/registry-providers/{registry_name}/{namespace}/{name}/versions", h.listVersions).Methods("GET")
+	r.HandleFunc("/organizations/{organization_name}/registry-providers/{registry_name}/{namespace}/{name}/versions/{version}", h.getVersion).Methods("GET")
+	r.HandleFunc("/organizations/{organization_name}/registry-providers/{registry_name}/{namespace}/{name}/versions/{version}", h.deleteVersion).Methods("DELETE")
+	r.HandleFunc("/organizations/{organization_name}/registry-providers/{registry_name}/{namespace}/{name}/versions/{version}/platforms", h.createPlatform).Methods("POST")
+	r.HandleFunc("/organizations/{organization_name}/registry-providers/{registry_name}/{namespace}/{name}/versions/{version}/platforms", h.listPlatforms).Methods("GET")
+	r.HandleFunc("/organizations/{organization_name}/registry-providers/{registry_name}/{namespace}/{name}/versions/{version}/platforms/{os}/{arch}", h.getPlatform).Methods("GET")
+	r.HandleFunc("/organizations/{organization_name}/registry-providers/{registry_name}/{namespace}/{name}/versions/{version}/platforms/{os}/{arch}", h.deletePlatform).Methods("DELETE")
+}
+
+type (
+	providerRouteParams struct {
+		Organization string `schema:"organization_name,required"`
+		RegistryName string `schema:"registry_name,required"`
+		Namespace    string `schema:"namespace,required"`
+		Name         string `schema:"name,required"`
+	}
+
+	versionRouteParams struct {
+		providerRouteParams
+		Version string `schema:"version,required"`
+	}
+
+	platformRouteParams struct {
+		versionRouteParams
+		OS   string `schema:"os,required"`
+		Arch string `schema:"arch,required"`
+	}
+)
+
+func (p providerRouteParams) options() (RegistryProviderOptions, error) {
+	if err := tfeapi.CheckRegistry(p.Organization, p.RegistryName, p.Namespace); err != nil {
+		return RegistryProviderOptions{}, err
+	}
+	return RegistryProviderOptions{Organization: p.Organization, Name: p.Name}, nil
+}
+
+func (p versionRouteParams) options() (RegistryProviderVersionOptions, error) {
+	opts, err := p.providerRouteParams.options()
+	if err != nil {
+		return RegistryProviderVersionOptions{}, err
+	}
+	return RegistryProviderVersionOptions{RegistryProviderOptions: opts, Version: p.Version}, nil
+}
+
+func (p platformRouteParams) options() (RegistryProviderPlatformOptions, error) {
+	opts, err := p.versionRouteParams.options()
+	if err != nil {
+		return RegistryProviderPlatformOptions{}, err
+	}
+	return RegistryProviderPlatformOptions{RegistryProviderVersionOptions: opts, OS: p.OS, Arch: p.Arch}, nil
+}
+
+func (h *tfeHandlers) createProvider(w http.ResponseWriter, r *http.Request) {
+	org, err := decode.Param("organization_name", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	var params types.RegistryProviderCreateOptions
+	if err := tfeapi.Unmarshal(r.Body, &params); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	if err := tfeapi.CheckRegistry(org, params.RegistryName, params.Namespace); err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	provider, err := h.svc.CreateRegistryProvider(r.Context(), CreateRegistryProviderOptions{
+		Organization: org,
+		Name:         params.Name,
+	})
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	h.Respond(w, r, h.toProvider(provider), http.StatusCreated)
+}
+
+func (h *tfeHandlers) listProviders(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Organization string `schema:"organization_name,required"`
+		types.ListOptions
+	}
+	if err := decode.All(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	providers, err := h.svc.ListRegistryProviders(r.Context(), params.Organization)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	// client expects a page, whereas ListRegistryProviders returns full result
+	// set, so convert to page first
+	page := resource.NewPage(providers, resource.PageOptions(params.ListOptions), nil)
+
+	// convert items
+	items := make([]*types.RegistryProvider, len(page.Items))
+	for i, from := range page.Items {
+		items[i] = h.toProvider(from)
+	}
+	h.RespondWithPage(w, r, items, page.Pagination)
+}
+
+func (h *tfeHandlers) getProvider(w http.ResponseWriter, r *http.Request) {
+	var params providerRouteParams
+	if err := decode.Route(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	opts, err := params.options()
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	provider, err := h.svc.GetRegistryProvider(r.Context(), opts)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	h.Respond(w, r, h.toProvider(provider), http.StatusOK)
+}
+
+func (h *tfeHandlers) deleteProvider(w http.ResponseWriter, r *http.Request) {
+	var params providerRouteParams
+	if err := decode.Route(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	opts, err := params.options()
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	if err := h.svc.DeleteRegistryProvider(r.Context(), opts); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *tfeHandlers) createVersion(w http.ResponseWriter, r *http.Request) {
+	var params providerRouteParams
+	if err := decode.Route(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	opts, err := params.options()
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+	var payload types.RegistryProviderVersionCreateOptions
+	if err := tfeapi.Unmarshal(r.Body, &payload); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	version, err := h.svc.CreateRegistryProviderVersion(r.Context(), CreateRegistryProviderVersionOptions{
+		RegistryProviderOptions: opts,
+		Version:                 payload.Version,
+		KeyID:                   payload.KeyID,
+		Protocols:               payload.Protocols,
+	})
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	links, err := h.versionLinks(r, version)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	h.RespondWithLinks(w, r, h.toVersion(version), http.StatusCreated, links)
+}
+
+func (h *tfeHandlers) listVersions(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		providerRouteParams
+		types.ListOptions
+	}
+	if err := decode.All(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	opts, err := params.options()
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	versions, err := h.svc.ListRegistryProviderVersions(r.Context(), opts)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	page := resource.NewPage(versions, resource.PageOptions(params.ListOptions), nil)
+	items := make([]*types.RegistryProviderVersion, len(page.Items))
+	for i, from := range page.Items {
+		items[i] = h.toVersion(from)
+	}
+	h.RespondWithPage(w, r, items, page.Pagination)
+}
+
+func (h *tfeHandlers) getVersion(w http.ResponseWriter, r *http.Request) {
+	var params versionRouteParams
+	if err := decode.Route(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	opts, err := params.options()
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	version, err := h.svc.GetRegistryProviderVersion(r.Context(), opts)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	links, err := h.versionLinks(r, version)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	h.RespondWithLinks(w, r, h.toVersion(version), http.StatusOK, links)
+}
+
+func (h *tfeHandlers) deleteVersion(w http.ResponseWriter, r *http.Request) {
+	var params versionRouteParams
+	if err := decode.Route(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	opts, err := params.options()
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	if err := h.svc.DeleteRegistryProviderVersion(r.Context(), opts); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *tfeHandlers) createPlatform(w http.ResponseWriter, r *http.Request) {
+	var params versionRouteParams
+	if err := decode.Route(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	opts, err := params.options()
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+	var payload types.RegistryProviderPlatformCreateOptions
+	if err := tfeapi.Unmarshal(r.Body, &payload); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	platform, err := h.svc.CreateRegistryProviderPlatform(r.Context(), CreateRegistryProviderPlatformOptions{
+		RegistryProviderVersionOptions: opts,
+		OS:                             payload.OS,
+		Arch:                           payload.Arch,
+		Shasum:                         payload.Shasum,
+		Filename:                       payload.Filename,
+	})
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	links, err := h.platformLinks(r, platform)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	h.RespondWithLinks(w, r, h.toPlatform(platform), http.StatusCreated, links)
+}
+
+func (h *tfeHandlers) listPlatforms(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		versionRouteParams
+		types.ListOptions
+	}
+	if err := decode.All(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	opts, err := params.options()
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	platforms, err := h.svc.ListRegistryProviderPlatforms(r.Context(), opts)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	page := resource.NewPage(platforms, resource.PageOptions(params.ListOptions), nil)
+	items := make([]*types.RegistryProviderPlatform, len(page.Items))
+	for i, from := range page.Items {
+		items[i] = h.toPlatform(from)
+	}
+	h.RespondWithPage(w, r, items, page.Pagination)
+}
+
+func (h *tfeHandlers) getPlatform(w http.ResponseWriter, r *http.Request) {
+	var params platformRouteParams
+	if err := decode.Route(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	opts, err := params.options()
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	platform, err := h.svc.GetRegistryProviderPlatform(r.Context(), opts)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	links, err := h.platformLinks(r, platform)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	h.RespondWithLinks(w, r, h.toPlatform(platform), http.StatusOK, links)
+}
+
+func (h *tfeHandlers) deletePlatform(w http.ResponseWriter, r *http.Request) {
+	var params platformRouteParams
+	if err := decode.Route(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	opts, err := params.options()
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	if err := h.svc.DeleteRegistryProviderPlatform(r.Context(), opts); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// versionLinks returns signed links for uploading a version's SHA256SUMS file
+// and its signature, and for downloading them once uploaded.
+func (h *tfeHandlers) versionLinks(r *http.Request, version *RegistryProviderVersion) (map[string]string, error) {
+	links := make(map[string]string, 4)
+	for _, link := range []struct {
+		name     string
+		path     string
+		uploaded bool
+	}{
+		{"shasums", shasumsPath(version.ID), version.ShasumsUploaded},
+		{"shasums-sig", shasumsSigPath(version.ID), version.ShasumsSigUploaded},
+	} {
+		signed, err := h.Sign(link.path, time.Hour)
+		if err != nil {
+			return nil, err
+		}
+		links[link.name+"-upload"] = otfhttp.Absolute(r, signed)
+		if link.uploaded {
+			links[link.name+"-download"] = otfhttp.Absolute(r, signed)
+		}
+	}
+	return links, nil
+}
+
+// platformLinks returns a signed link for uploading a platform's binary, and
+// for downloading it once uploaded.
+func (h *tfeHandlers) platformLinks(r *http.Request, platform *RegistryProviderPlatform) (map[string]string, error) {
+	signed, err := h.Sign(binaryPath(platform.ID), time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	links := map[string]string{
+		"provider-binary-upload": otfhttp.Absolute(r, signed),
+	}
+	if platform.BinaryUploaded {
+		links["provider-binary-download"] = otfhttp.Absolute(r, signed)
+	}
+	return links, nil
+}
+
+func (h *tfeHandlers) toProvider(from *RegistryProvider) *types.RegistryProvider {
+	return &types.RegistryProvider{
+		ID:           from.ID,
+		Name:         from.Name,
+		Namespace:    from.Organization,
+		RegistryName: tfeapi.PrivateRegistry,
+		CreatedAt:    from.CreatedAt,
+		UpdatedAt:    from.UpdatedAt,
+		Organization: &types.Organization{Name: from.Organization},
+	}
+}
+
+func (h *tfeHandlers) toVersion(from *RegistryProviderVersion) *types.RegistryProviderVersion {
+	return &types.RegistryProviderVersion{
+		ID:                 from.ID,
+		Version:            from.Version,
+		KeyID:              from.KeyID,
+		Protocols:          from.Protocols,
+		ShasumsUploaded:    from.ShasumsUploaded,
+		ShasumsSigUploaded: from.ShasumsSigUploaded,
+		CreatedAt:          from.CreatedAt,
+		UpdatedAt:          from.UpdatedAt,
+		RegistryProvider:   &types.RegistryProvider{ID: from.ProviderID},
+	}
+}
+
+func (h *tfeHandlers) toPlatform(from *RegistryProviderPlatform) *types.RegistryProviderPlatform {
+	return &types.RegistryProviderPlatform{
+		ID:                      from.ID,
+		OS:                      from.OS,
+		Arch:                    from.Arch,
+		Filename:                from.Filename,
+		Shasum:                  from.Shasum,
+		ProviderBinaryUploaded:  from.BinaryUploaded,
+		RegistryProviderVersion: &types.RegistryProviderVersion{ID: from.VersionID},
+	}
+}
+
+// toHTTPError reports invalid providers and artifacts as a 422.
+func toHTTPError(err error) error {
+	for _, invalid := range []error{
+		tfeapi.ErrInvalidRegistryName,
+		tfeapi.ErrInvalidNamespace,
+		ErrInvalidVersion,
+		ErrInvalidShasum,
+		ErrShasumMismatch,
+		ErrShasumsNotUploaded,
+		ErrInvalidSignature,
+		ErrShadowsUpstream,
+		internal.ErrInvalidName,
+		internal.ErrRequiredName,
+		internal.ErrEmptyValue,
+	} {
+		if errors.Is(err, invalid) {
+			return &internal.HTTPError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}
+		}
+	}
+	return err
+}
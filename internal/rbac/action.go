@@ -1,5 +1,10 @@
 package rbac
 
+import (
+	"fmt"
+	"strings"
+)
+
 // Action identifies an action a subject carries out on a resource for
 // authorization purposes.
 type Action int
@@ -127,6 +132,7 @@ const (
 	CreateUserAction
 	ListUsersAction
 	GetUserAction
+	UpdateUserAction
 	DeleteUserAction
 
 	CreateTeamAction
@@ -143,6 +149,24 @@ const (
 	GetNotificationConfigurationAction
 	DeleteNotificationConfigurationAction
 
+	CreateRunTriggerAction
+	ListRunTriggersAction
+	GetRunTriggerAction
+	DeleteRunTriggerAction
+
+	CreateScheduleAction
+	UpdateScheduleAction
+	ListSchedulesAction
+	GetScheduleAction
+	DeleteScheduleAction
+
+	CreatePolicySetAction
+	UpdatePolicySetAction
+	ListPolicySetsAction
+	GetPolicySetAction
+	DeletePolicySetAction
+	OverridePolicyCheckAction
+
 	CreateGithubAppAction
 	UpdateGithubAppAction
 	GetGithubAppAction
@@ -156,4 +180,203 @@ const (
 	UpdateGPGKeyAction
 	GetGPGKeyAction
 	DeleteGPGKeyAction
+
+	CreateRegistryProviderAction
+	ListRegistryProvidersAction
+	GetRegistryProviderAction
+	DeleteRegistryProviderAction
+	CreateRegistryProviderVersionAction
+	DeleteRegistryProviderVersionAction
+	CreateRegistryProviderPlatformAction
+	DeleteRegistryProviderPlatformAction
+
+	CreateProjectAction
+	UpdateProjectAction
+	ListProjectsAction
+	GetProjectAction
+	DeleteProjectAction
+	SetProjectPermissionAction
+	UnsetProjectPermissionAction
+
+	ListAuditEventsAction
+
+	ListStaleTokensAction
+	RevokeStaleTokensAction
 )
+
+// mutations are the user-facing actions that alter resources. Actions carried
+// out by agents in the course of a run, e.g. uploading a plan file, alter
+// resources too but are deliberately omitted.
+var mutations = map[Action]bool{
+	CreateOrganizationAction: true,
+	UpdateOrganizationAction: true,
+	DeleteOrganizationAction: true,
+
+	CreateVCSProviderAction: true,
+	DeleteVCSProviderAction: true,
+
+	CreateAgentPoolAction: true,
+	UpdateAgentPoolAction: true,
+	DeleteAgentPoolAction: true,
+
+	CreateAgentTokenAction: true,
+	DeleteAgentTokenAction: true,
+
+	CreateOrganizationTokenAction: true,
+	DeleteOrganizationTokenAction: true,
+
+	CreateTeamTokenAction: true,
+	DeleteTeamTokenAction: true,
+
+	CreateModuleAction:        true,
+	CreateModuleVersionAction: true,
+	UpdateModuleAction:        true,
+	DeleteModuleAction:        true,
+	DeleteModuleVersionAction: true,
+
+	CreateWorkspaceVariableAction: true,
+	UpdateWorkspaceVariableAction: true,
+	DeleteWorkspaceVariableAction: true,
+
+	CreateVariableSetAction: true,
+	UpdateVariableSetAction: true,
+	DeleteVariableSetAction: true,
+
+	CreateVariableSetVariableAction: true,
+	UpdateVariableSetVariableAction: true,
+	DeleteVariableSetVariableAction: true,
+
+	AddVariableToSetAction:      true,
+	RemoveVariableFromSetAction: true,
+
+	ApplyVariableSetToWorkspacesAction:    true,
+	DeleteVariableSetFromWorkspacesAction: true,
+
+	ApplyRunAction:       true,
+	CreateRunAction:      true,
+	DiscardRunAction:     true,
+	DeleteRunAction:      true,
+	CancelRunAction:      true,
+	ForceCancelRunAction: true,
+
+	CreateWorkspaceAction:          true,
+	DeleteWorkspaceAction:          true,
+	SetWorkspacePermissionAction:   true,
+	UnsetWorkspacePermissionAction: true,
+	UpdateWorkspaceAction:          true,
+
+	DeleteTagsAction:    true,
+	TagWorkspacesAction: true,
+	AddTagsAction:       true,
+	RemoveTagsAction:    true,
+
+	LockWorkspaceAction:        true,
+	UnlockWorkspaceAction:      true,
+	ForceUnlockWorkspaceAction: true,
+
+	CreateStateVersionAction:   true,
+	DeleteStateVersionAction:   true,
+	RollbackStateVersionAction: true,
+	UploadStateAction:          true,
+
+	CreateConfigurationVersionAction: true,
+	DeleteConfigurationVersionAction: true,
+
+	CreateUserAction: true,
+	UpdateUserAction: true,
+	DeleteUserAction: true,
+
+	CreateTeamAction:           true,
+	UpdateTeamAction:           true,
+	DeleteTeamAction:           true,
+	AddTeamMembershipAction:    true,
+	RemoveTeamMembershipAction: true,
+
+	CreateNotificationConfigurationAction: true,
+	UpdateNotificationConfigurationAction: true,
+	DeleteNotificationConfigurationAction: true,
+
+	CreateRunTriggerAction: true,
+	DeleteRunTriggerAction: true,
+
+	CreateScheduleAction: true,
+	UpdateScheduleAction: true,
+	DeleteScheduleAction: true,
+
+	CreatePolicySetAction:     true,
+	UpdatePolicySetAction:     true,
+	DeletePolicySetAction:     true,
+	OverridePolicyCheckAction: true,
+
+	CreateGithubAppAction:        true,
+	UpdateGithubAppAction:        true,
+	DeleteGithubAppAction:        true,
+	CreateGithubAppInstallAction: true,
+	DeleteGithubAppInstallAction: true,
+
+	CreateGPGKeyAction: true,
+	UpdateGPGKeyAction: true,
+	DeleteGPGKeyAction: true,
+
+	CreateRegistryProviderAction:         true,
+	DeleteRegistryProviderAction:         true,
+	CreateRegistryProviderVersionAction:  true,
+	DeleteRegistryProviderVersionAction:  true,
+	CreateRegistryProviderPlatformAction: true,
+	DeleteRegistryProviderPlatformAction: true,
+
+	CreateProjectAction:          true,
+	UpdateProjectAction:          true,
+	DeleteProjectAction:          true,
+	SetProjectPermissionAction:   true,
+	UnsetProjectPermissionAction: true,
+
+	RevokeStaleTokensAction: true,
+}
+
+// IsMutation determines whether the action is a user-facing action that
+// alters a resource.
+func (a Action) IsMutation() bool {
+	return mutations[a]
+}
+
+// readOnlyPrefixes are the prefixes of actions that do not alter resources.
+var readOnlyPrefixes = []string{"Get", "List", "Watch", "Tail", "Download"}
+
+// IsReadOnly determines whether the action merely reads resources. Unlike
+// IsMutation, actions carried out by agents are not read-only.
+func (a Action) IsReadOnly() bool {
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(a.String(), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler, permitting an action to be
+// serialized by name.
+func (a Action) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, permitting an action to
+// be deserialized from its name.
+func (a *Action) UnmarshalText(text []byte) error {
+	action, err := ActionFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*a = action
+	return nil
+}
+
+// ActionFromString retrieves the action with the given name.
+func ActionFromString(name string) (Action, error) {
+	for i := range len(_Action_index) - 1 {
+		if Action(i).String() == name {
+			return Action(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown action: %s", name)
+}
@@ -108,35 +108,75 @@ func _() {
 	_ = x[CreateUserAction-97]
 	_ = x[ListUsersAction-98]
 	_ = x[GetUserAction-99]
-	_ = x[DeleteUserAction-100]
-	_ = x[CreateTeamAction-101]
-	_ = x[UpdateTeamAction-102]
-	_ = x[GetTeamAction-103]
-	_ = x[ListTeamsAction-104]
-	_ = x[DeleteTeamAction-105]
-	_ = x[AddTeamMembershipAction-106]
-	_ = x[RemoveTeamMembershipAction-107]
-	_ = x[CreateNotificationConfigurationAction-108]
-	_ = x[UpdateNotificationConfigurationAction-109]
-	_ = x[ListNotificationConfigurationsAction-110]
-	_ = x[GetNotificationConfigurationAction-111]
-	_ = x[DeleteNotificationConfigurationAction-112]
-	_ = x[CreateGithubAppAction-113]
-	_ = x[UpdateGithubAppAction-114]
-	_ = x[GetGithubAppAction-115]
-	_ = x[ListGithubAppsAction-116]
-	_ = x[DeleteGithubAppAction-117]
-	_ = x[CreateGithubAppInstallAction-118]
-	_ = x[DeleteGithubAppInstallAction-119]
+	_ = x[UpdateUserAction-100]
+	_ = x[DeleteUserAction-101]
+	_ = x[CreateTeamAction-102]
+	_ = x[UpdateTeamAction-103]
+	_ = x[GetTeamAction-104]
+	_ = x[ListTeamsAction-105]
+	_ = x[DeleteTeamAction-106]
+	_ = x[AddTeamMembershipAction-107]
+	_ = x[RemoveTeamMembershipAction-108]
+	_ = x[CreateNotificationConfigurationAction-109]
+	_ = x[UpdateNotificationConfigurationAction-110]
+	_ = x[ListNotificationConfigurationsAction-111]
+	_ = x[GetNotificationConfigurationAction-112]
+	_ = x[DeleteNotificationConfigurationAction-113]
+	_ = x[CreateRunTriggerAction-114]
+	_ = x[ListRunTriggersAction-115]
+	_ = x[GetRunTriggerAction-116]
+	_ = x[DeleteRunTriggerAction-117]
+	_ = x[CreateScheduleAction-118]
+	_ = x[UpdateScheduleAction-119]
+	_ = x[ListSchedulesAction-120]
+	_ = x[GetScheduleAction-121]
+	_ = x[DeleteScheduleAction-122]
+	_ = x[CreatePolicySetAction-123]
+	_ = x[UpdatePolicySetAction-124]
+	_ = x[ListPolicySetsAction-125]
+	_ = x[GetPolicySetAction-126]
+	_ = x[DeletePolicySetAction-127]
+	_ = x[OverridePolicyCheckAction-128]
+	_ = x[CreateGithubAppAction-129]
+	_ = x[UpdateGithubAppAction-130]
+	_ = x[GetGithubAppAction-131]
+	_ = x[ListGithubAppsAction-132]
+	_ = x[DeleteGithubAppAction-133]
+	_ = x[CreateGithubAppInstallAction-134]
+	_ = x[DeleteGithubAppInstallAction-135]
+	_ = x[CreateGPGKeyAction-136]
+	_ = x[ListGPGKeyAction-137]
+	_ = x[UpdateGPGKeyAction-138]
+	_ = x[GetGPGKeyAction-139]
+	_ = x[DeleteGPGKeyAction-140]
+	_ = x[CreateRegistryProviderAction-141]
+	_ = x[ListRegistryProvidersAction-142]
+	_ = x[GetRegistryProviderAction-143]
+	_ = x[DeleteRegistryProviderAction-144]
+	_ = x[CreateRegistryProviderVersionAction-145]
+	_ = x[DeleteRegistryProviderVersionAction-146]
+	_ = x[CreateRegistryProviderPlatformAction-147]
+	_ = x[DeleteRegistryProviderPlatformAction-148]
+	_ = x[CreateProjectAction-149]
+	_ = x[UpdateProjectAction-150]
+	_ = x[ListProjectsAction-151]
+	_ = x[GetProjectAction-152]
+	_ = x[DeleteProjectAction-153]
+	_ = x[SetProjectPermissionAction-154]
+	_ = x[UnsetProjectPermissionAction-155]
+	_ = x[ListAuditEventsAction-156]
+	_ = x[ListStaleTokensAction-157]
+	_ = x[RevokeStaleTokensAction-158]
 }
 
-const _Action_name = "WatchActionCreateOrganizationActionUpdateOrganizationActionGetOrganizationActionListOrganizationsActionGetEntitlementsActionDeleteOrganizationActionCreateVCSProviderActionGetVCSProviderActionListVCSProvidersActionDeleteVCSProviderActionCreateAgentPoolActionUpdateAgentPoolActionListAgentPoolsActionGetAgentPoolActionDeleteAgentPoolActionCreateAgentTokenActionListAgentTokensActionGetAgentTokenActionDeleteAgentTokenActionListAgentsActionWatchAgentsActionCreateOrganizationTokenActionDeleteOrganizationTokenActionCreateRunTokenActionCreateTeamTokenActionGetTeamTokenActionDeleteTeamTokenActionCreateModuleActionCreateModuleVersionActionUpdateModuleActionListModulesActionGetModuleActionDeleteModuleActionDeleteModuleVersionActionCreateWorkspaceVariableActionUpdateWorkspaceVariableActionListWorkspaceVariablesActionGetWorkspaceVariableActionDeleteWorkspaceVariableActionCreateVariableSetActionUpdateVariableSetActionListVariableSetsActionGetVariableSetActionDeleteVariableSetActionCreateVariableSetVariableActionUpdateVariableSetVariableActionGetVariableSetVariableActionDeleteVariableSetVariableActionAddVariableToSetActionRemoveVariableFromSetActionApplyVariableSetToWorkspacesActionDeleteVariableSetFromWorkspacesActionGetRunActionListRunsActionApplyRunActionCreateRunActionDiscardRunActionDeleteRunActionCancelRunActionForceCancelRunActionEnqueuePlanActionPutChunkActionTailLogsActionGetPlanFileActionUploadPlanFileActionGetLockFileActionUploadLockFileActionListWorkspacesActionGetWorkspaceActionCreateWorkspaceActionDeleteWorkspaceActionSetWorkspacePermissionActionUnsetWorkspacePermissionActionUpdateWorkspaceActionListTagsActionDeleteTagsActionTagWorkspacesActionAddTagsActionRemoveTagsActionListWorkspaceTagsLockWorkspaceActionUnlockWorkspaceActionForceUnlockWorkspaceActionCreateStateVersionActionListStateVersionsActionGetStateVersionActionDeleteStateVersionActionRollbackStateVersionActionUploadStateActionDownloadStateActionGetStateVersionOutputActionCreateConfigurationVersionActionListConfigurationVersionsActionGetConfigurationVersionActionDownloadConfigurationVersionActionDeleteConfigurationVersionActionCreateUserActionListUsersActionGetUserActionDeleteUserActionCreateTeamActionUpdateTeamActionGetTeamActionListTeamsActionDeleteTeamActionAddTeamMembershipActionRemoveTeamMembershipActionCreateNotificationConfigurationActionUpdateNotificationConfigurationActionListNotificationConfigurationsActionGetNotificationConfigurationActionDeleteNotificationConfigurationActionCreateGithubAppActionUpdateGithubAppActionGetGithubAppActionListGithubAppsActionDeleteGithubAppActionCreateGithubAppInstallActionDeleteGithubAppInstallAction"
+const _Action_name = "WatchActionCreateOrganizationActionUpdateOrganizationActionGetOrganizationActionListOrganizationsActionGetEntitlementsActionDeleteOrganizationActionCreateVCSProviderActionGetVCSProviderActionListVCSProvidersActionDeleteVCSProviderActionCreateAgentPoolActionUpdateAgentPoolActionListAgentPoolsActionGetAgentPoolActionDeleteAgentPoolActionCreateAgentTokenActionListAgentTokensActionGetAgentTokenActionDeleteAgentTokenActionListAgentsActionWatchAgentsActionCreateOrganizationTokenActionDeleteOrganizationTokenActionCreateRunTokenActionCreateTeamTokenActionGetTeamTokenActionDeleteTeamTokenActionCreateModuleActionCreateModuleVersionActionUpdateModuleActionListModulesActionGetModuleActionDeleteModuleActionDeleteModuleVersionActionCreateWorkspaceVariableActionUpdateWorkspaceVariableActionListWorkspaceVariablesActionGetWorkspaceVariableActionDeleteWorkspaceVariableActionCreateVariableSetActionUpdateVariableSetActionListVariableSetsActionGetVariableSetActionDeleteVariableSetActionCreateVariableSetVariableActionUpdateVariableSetVariableActionGetVariableSetVariableActionDeleteVariableSetVariableActionAddVariableToSetActionRemoveVariableFromSetActionApplyVariableSetToWorkspacesActionDeleteVariableSetFromWorkspacesActionGetRunActionListRunsActionApplyRunActionCreateRunActionDiscardRunActionDeleteRunActionCancelRunActionForceCancelRunActionEnqueuePlanActionPutChunkActionTailLogsActionGetPlanFileActionUploadPlanFileActionGetLockFileActionUploadLockFileActionListWorkspacesActionGetWorkspaceActionCreateWorkspaceActionDeleteWorkspaceActionSetWorkspacePermissionActionUnsetWorkspacePermissionActionUpdateWorkspaceActionListTagsActionDeleteTagsActionTagWorkspacesActionAddTagsActionRemoveTagsActionListWorkspaceTagsLockWorkspaceActionUnlockWorkspaceActionForceUnlockWorkspaceActionCreateStateVersionActionListStateVersionsActionGetStateVersionActionDeleteStateVersionActionRollbackStateVersionActionUploadStateActionDownloadStateActionGetStateVersionOutputActionCreateConfigurationVersionActionListConfigurationVersionsActionGetConfigurationVersionActionDownloadConfigurationVersionActionDeleteConfigurationVersionActionCreateUserActionListUsersActionGetUserActionUpdateUserActionDeleteUserActionCreateTeamActionUpdateTeamActionGetTeamActionListTeamsActionDeleteTeamActionAddTeamMembershipActionRemoveTeamMembershipActionCreateNotificationConfigurationActionUpdateNotificationConfigurationActionListNotificationConfigurationsActionGetNotificationConfigurationActionDeleteNotificationConfigurationActionCreateRunTriggerActionListRunTriggersActionGetRunTriggerActionDeleteRunTriggerActionCreateScheduleActionUpdateScheduleActionListSchedulesActionGetScheduleActionDeleteScheduleActionCreatePolicySetActionUpdatePolicySetActionListPolicySetsActionGetPolicySetActionDeletePolicySetActionOverridePolicyCheckActionCreateGithubAppActionUpdateGithubAppActionGetGithubAppActionListGithubAppsActionDeleteGithubAppActionCreateGithubAppInstallActionDeleteGithubAppInstallActionCreateGPGKeyActionListGPGKeyActionUpdateGPGKeyActionGetGPGKeyActionDeleteGPGKeyActionCreateRegistryProviderActionListRegistryProvidersActionGetRegistryProviderActionDeleteRegistryProviderActionCreateRegistryProviderVersionActionDeleteRegistryProviderVersionActionCreateRegistryProviderPlatformActionDeleteRegistryProviderPlatformActionCreateProjectActionUpdateProjectActionListProjectsActionGetProjectActionDeleteProjectActionSetProjectPermissionActionUnsetProjectPermissionActionListAuditEventsActionListStaleTokensActionRevokeStaleTokensAction"
 
-var _Action_index = [...]uint16{0, 11, 35, 59, 80, 103, 124, 148, 171, 191, 213, 236, 257, 278, 298, 316, 337, 359, 380, 399, 421, 437, 454, 483, 512, 532, 553, 571, 592, 610, 635, 653, 670, 685, 703, 728, 757, 786, 814, 840, 869, 892, 915, 937, 957, 980, 1011, 1042, 1070, 1101, 1123, 1150, 1184, 1221, 1233, 1247, 1261, 1276, 1292, 1307, 1322, 1342, 1359, 1373, 1387, 1404, 1424, 1441, 1461, 1481, 1499, 1520, 1541, 1569, 1599, 1620, 1634, 1650, 1669, 1682, 1698, 1715, 1734, 1755, 1781, 1805, 1828, 1849, 1873, 1899, 1916, 1935, 1962, 1994, 2025, 2054, 2088, 2120, 2136, 2151, 2164, 2180, 2196, 2212, 2225, 2240, 2256, 2279, 2305, 2342, 2379, 2415, 2449, 2486, 2507, 2528, 2546, 2566, 2587, 2615, 2643}
+var _Action_index = [...]uint16{0, 11, 35, 59, 80, 103, 124, 148, 171, 191, 213, 236, 257, 278, 298, 316, 337, 359, 380, 399, 421, 437, 454, 483, 512, 532, 553, 571, 592, 610, 635, 653, 670, 685, 703, 728, 757, 786, 814, 840, 869, 892, 915, 937, 957, 980, 1011, 1042, 1070, 1101, 1123, 1150, 1184, 1221, 1233, 1247, 1261, 1276, 1292, 1307, 1322, 1342, 1359, 1373, 1387, 1404, 1424, 1441, 1461, 1481, 1499, 1520, 1541, 1569, 1599, 1620, 1634, 1650, 1669, 1682, 1698, 1715, 1734, 1755, 1781, 1805, 1828, 1849, 1873, 1899, 1916, 1935, 1962, 1994, 2025, 2054, 2088, 2120, 2136, 2151, 2164, 2180, 2196, 2212, 2228, 2241, 2256, 2272, 2295, 2321, 2358, 2395, 2431, 2465, 2502, 2524, 2545, 2564, 2586, 2606, 2626, 2645, 2662, 2682, 2703, 2724, 2744, 2762, 2783, 2808, 2829, 2850, 2868, 2888, 2909, 2937, 2965, 2983, 2999, 3017, 3032, 3050, 3078, 3105, 3130, 3158, 3193, 3228, 3264, 3300, 3319, 3338, 3356, 3372, 3391, 3417, 3445, 3466, 3487, 3510}
 
 func (i Action) String() string {
-	if i < 0 || i >= Action(len(_Action_index)-1) {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_Action_index)-1 {
 		return "Action(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
-	return _Action_name[_Action_index[i]:_Action_index[i+1]]
+	return _Action_name[_Action_index[idx]:_Action_index[idx+1]]
 }
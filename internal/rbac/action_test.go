@@ -0,0 +1,52 @@
+package rbac
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAction_IsMutation(t *testing.T) {
+	assert.True(t, CreateWorkspaceAction.IsMutation())
+	assert.True(t, UnlockWorkspaceAction.IsMutation())
+	assert.True(t, DeleteStateVersionAction.IsMutation())
+
+	assert.False(t, GetWorkspaceAction.IsMutation())
+	assert.False(t, ListRunsAction.IsMutation())
+	assert.False(t, WatchAction.IsMutation())
+	assert.False(t, TailLogsAction.IsMutation())
+	assert.False(t, DownloadStateAction.IsMutation())
+
+	// actions carried out by agents are not user-facing mutations
+	assert.False(t, PutChunkAction.IsMutation())
+	assert.False(t, UploadPlanFileAction.IsMutation())
+	assert.False(t, UploadLockFileAction.IsMutation())
+	assert.False(t, EnqueuePlanAction.IsMutation())
+}
+
+func TestAction_IsReadOnly(t *testing.T) {
+	assert.True(t, GetWorkspaceAction.IsReadOnly())
+	assert.True(t, ListRunsAction.IsReadOnly())
+	assert.True(t, DownloadStateAction.IsReadOnly())
+
+	assert.False(t, CreateWorkspaceAction.IsReadOnly())
+	assert.False(t, PutChunkAction.IsReadOnly())
+	assert.False(t, UploadPlanFileAction.IsReadOnly())
+}
+
+func TestAction_JSON(t *testing.T) {
+	b, err := json.Marshal(UnlockWorkspaceAction)
+	require.NoError(t, err)
+	assert.Equal(t, `"UnlockWorkspaceAction"`, string(b))
+
+	var got Action
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, UnlockWorkspaceAction, got)
+
+	t.Run("unknown action", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`"FlyToTheMoonAction"`), &got)
+		assert.Error(t, err)
+	})
+}
@@ -22,8 +22,15 @@ var (
 			GetVCSProviderAction:   true,
 			ListVariableSetsAction: true,
 			GetVariableSetAction:   true,
+			ListPolicySetsAction:   true,
+			GetPolicySetAction:     true,
 			WatchAgentsAction:      true,
 			ListAgentsAction:       true,
+			ListProjectsAction:     true,
+			GetProjectAction:       true,
+
+			ListRegistryProvidersAction: true,
+			GetRegistryProviderAction:   true,
 		},
 	}
 
@@ -48,6 +55,10 @@ var (
 			TailLogsAction:                       true,
 			ListNotificationConfigurationsAction: true,
 			GetNotificationConfigurationAction:   true,
+			ListRunTriggersAction:                true,
+			GetRunTriggerAction:                  true,
+			ListSchedulesAction:                  true,
+			GetScheduleAction:                    true,
 		},
 	}
 
@@ -77,6 +88,9 @@ var (
 			CreateNotificationConfigurationAction: true,
 			UpdateNotificationConfigurationAction: true,
 			DeleteNotificationConfigurationAction: true,
+			CreateScheduleAction:                  true,
+			UpdateScheduleAction:                  true,
+			DeleteScheduleAction:                  true,
 		},
 		inherits: &WorkspacePlanRole,
 	}
@@ -91,6 +105,8 @@ var (
 			DeleteWorkspaceAction:          true,
 			ForceUnlockWorkspaceAction:     true,
 			UpdateWorkspaceAction:          true,
+			CreateRunTriggerAction:         true,
+			DeleteRunTriggerAction:         true,
 		},
 		inherits: &WorkspaceWriteRole,
 	}
@@ -100,11 +116,16 @@ var (
 	WorkspaceManagerRole = Role{
 		name: "workspace-manager",
 		permissions: map[Action]bool{
-			CreateWorkspaceAction: true,
-			ListWorkspacesAction:  true,
-			UpdateWorkspaceAction: true,
-			AddTagsAction:         true,
-			RemoveTagsAction:      true,
+			CreateWorkspaceAction:        true,
+			ListWorkspacesAction:         true,
+			UpdateWorkspaceAction:        true,
+			AddTagsAction:                true,
+			RemoveTagsAction:             true,
+			CreateProjectAction:          true,
+			UpdateProjectAction:          true,
+			DeleteProjectAction:          true,
+			SetProjectPermissionAction:   true,
+			UnsetProjectPermissionAction: true,
 		},
 		inherits: &WorkspaceAdminRole,
 	}
@@ -130,6 +151,20 @@ var (
 			DeleteModuleAction:        true,
 		},
 	}
+
+	// ProviderManagerRole is scoped to an organization and permits management
+	// of the organization's private registry of providers.
+	ProviderManagerRole = Role{
+		name: "provider-manager",
+		permissions: map[Action]bool{
+			CreateRegistryProviderAction:         true,
+			DeleteRegistryProviderAction:         true,
+			CreateRegistryProviderVersionAction:  true,
+			DeleteRegistryProviderVersionAction:  true,
+			CreateRegistryProviderPlatformAction: true,
+			DeleteRegistryProviderPlatformAction: true,
+		},
+	}
 )
 
 // Role is a set of permitted actions
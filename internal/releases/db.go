@@ -13,19 +13,19 @@ type db struct {
 	*sql.Pool
 }
 
-func (db *db) updateLatestVersion(ctx context.Context, v string) error {
+func (db *db) updateLatestVersion(ctx context.Context, engine Engine, v string) error {
 	return db.Lock(ctx, "latest_terraform_version", func(ctx context.Context, q pggen.Querier) error {
-		rows, err := q.FindLatestTerraformVersion(ctx)
+		rows, err := q.FindLatestTerraformVersion(ctx, sql.String(string(engine)))
 		if err != nil {
 			return err
 		}
 		if len(rows) == 0 {
-			_, err = q.InsertLatestTerraformVersion(ctx, sql.String(v))
+			_, err = q.InsertLatestTerraformVersion(ctx, sql.String(v), sql.String(string(engine)))
 			if err != nil {
 				return err
 			}
 		} else {
-			_, err = q.UpdateLatestTerraformVersion(ctx, sql.String(v))
+			_, err = q.UpdateLatestTerraformVersion(ctx, sql.String(v), sql.String(string(engine)))
 			if err != nil {
 				return err
 			}
@@ -34,14 +34,14 @@ func (db *db) updateLatestVersion(ctx context.Context, v string) error {
 	})
 }
 
-func (db *db) getLatest(ctx context.Context) (string, time.Time, error) {
+func (db *db) getLatest(ctx context.Context, engine Engine) (string, time.Time, error) {
 	type latestRelease struct {
 		Version    string
 		Checkpoint time.Time
 	}
 
 	latest, err := sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (latestRelease, error) {
-		rows, err := q.FindLatestTerraformVersion(ctx)
+		rows, err := q.FindLatestTerraformVersion(ctx, sql.String(string(engine)))
 		if err != nil {
 			return latestRelease{}, err
 		}
@@ -2,25 +2,40 @@ package releases
 
 import (
 	"archive/zip"
+	"bufio"
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
 	"github.com/natefinch/atomic"
 	"github.com/tofutf/tofutf/internal"
 )
 
-// download represents a current download of a version of terraform
+// download represents a current download of a version of an engine
 type download struct {
 	// for outputting progress updates
 	io.Writer
 
-	version   string
+	engine  Engine
+	version string
+	binary  string // name of binary within archive
+	archive string // filename of archive
+
 	src, dest string
-	client    *http.Client
+	checksums string // URL of checksums file
+	signature string // URL of detached signature of checksums file
+	publicKey string // armored public key with which to verify signature
+
+	client *http.Client
 }
 
 func (d *download) download(ctx context.Context) error {
@@ -28,12 +43,21 @@ func (d *download) download(ctx context.Context) error {
 		return nil
 	}
 
+	sums, err := d.getChecksums(ctx)
+	if err != nil {
+		return err
+	}
+
 	zipfile, err := d.getZipfile(ctx)
 	if err != nil {
 		return fmt.Errorf("downloading zipfile from %s: %w", d.src, err)
 	}
 	defer os.Remove(zipfile)
 
+	if err := d.verify(zipfile, sums); err != nil {
+		return fmt.Errorf("verifying archive: %w", err)
+	}
+
 	if err := os.MkdirAll(filepath.Dir(d.dest), 0o777); err != nil {
 		return fmt.Errorf("creating directory: %w", err)
 	}
@@ -45,6 +69,50 @@ func (d *download) download(ctx context.Context) error {
 	return nil
 }
 
+// getChecksums retrieves the checksums file and verifies its signature.
+func (d *download) getChecksums(ctx context.Context) ([]byte, error) {
+	sums, err := d.get(ctx, d.checksums)
+	if err != nil {
+		return nil, fmt.Errorf("downloading checksums from %s: %w", d.checksums, err)
+	}
+	sig, err := d.get(ctx, d.signature)
+	if err != nil {
+		return nil, fmt.Errorf("downloading signature from %s: %w", d.signature, err)
+	}
+	keyring, err := openpgp.ReadArmoredKeyRing(strings.NewReader(d.publicKey))
+	if err != nil {
+		return nil, fmt.Errorf("reading public key: %w", err)
+	}
+	// signature may be either armored or binary
+	if bytes.HasPrefix(bytes.TrimSpace(sig), []byte("-----BEGIN")) {
+		_, err = openpgp.CheckArmoredDetachedSignature(keyring, bytes.NewReader(sums), bytes.NewReader(sig), nil)
+	} else {
+		_, err = openpgp.CheckDetachedSignature(keyring, bytes.NewReader(sums), bytes.NewReader(sig), nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("verifying checksums signature: %w", err)
+	}
+	return sums, nil
+}
+
+func (d *download) get(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building request: %w", err)
+	}
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("received non-200 HTTP code: %d", res.StatusCode)
+	}
+	return io.ReadAll(res.Body)
+}
+
 func (d *download) getZipfile(ctx context.Context) (string, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", d.src, nil)
 	if err != nil {
@@ -61,13 +129,13 @@ func (d *download) getZipfile(ctx context.Context) (string, error) {
 		return "", fmt.Errorf("received non-200 HTTP code: %d", res.StatusCode)
 	}
 
-	tmp, err := os.CreateTemp("", "terraform-download-*")
+	tmp, err := os.CreateTemp("", d.binary+"-download-*")
 	if err != nil {
 		return "", fmt.Errorf("creating placeholder for download: %w", err)
 	}
 	defer tmp.Close()
 
-	d.Write([]byte("downloading terraform, version " + d.version + "\n")) //nolint:errcheck
+	d.Write([]byte("downloading " + string(d.engine) + ", version " + d.version + "\n")) //nolint:errcheck
 
 	_, err = io.Copy(tmp, res.Body)
 	if err != nil {
@@ -77,6 +145,42 @@ func (d *download) getZipfile(ctx context.Context) (string, error) {
 	return tmp.Name(), nil
 }
 
+// verify checks the zipfile's SHA256 checksum matches that listed in the
+// checksums file.
+func (d *download) verify(zipfile string, sums []byte) error {
+	var want string
+	scanner := bufio.NewScanner(bytes.NewReader(sums))
+	for scanner.Scan() {
+		// each line is of the form <checksum>  <filename>
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[1] == d.archive {
+			want = fields[0]
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if want == "" {
+		return fmt.Errorf("checksum not found for %s", d.archive)
+	}
+
+	f, err := os.Open(zipfile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	if got := hex.EncodeToString(h.Sum(nil)); got != want {
+		return errors.New("checksum mismatch")
+	}
+	return nil
+}
+
 func (d *download) unzip(zipfile string) error {
 	zr, err := zip.OpenReader(zipfile)
 	if err != nil {
@@ -85,17 +189,17 @@ func (d *download) unzip(zipfile string) error {
 	defer zr.Close()
 
 	for _, f := range zr.File {
-		if f.Name == "terraform" {
+		if f.Name == d.binary {
 			fr, err := f.Open()
 			if err != nil {
 				return err
 			}
 			defer fr.Close()
 			if err := atomic.WriteFile(d.dest, fr, atomic.DefaultFileMode(0o755)); err != nil {
-				return fmt.Errorf("writing terraform binary: %w", err)
+				return fmt.Errorf("writing %s binary: %w", d.binary, err)
 			}
 			return nil
 		}
 	}
-	return fmt.Errorf("terraform binary not found")
+	return fmt.Errorf("%s binary not found", d.binary)
 }
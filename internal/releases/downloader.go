@@ -2,32 +2,28 @@ package releases
 
 import (
 	"context"
-	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"os"
 	"path"
-	"runtime"
 
 	"github.com/tofutf/tofutf/internal"
 )
 
-const hashicorpReleasesHost = "releases.hashicorp.com"
-
 var defaultTerraformBinDir = path.Join(os.TempDir(), "otf-terraform-bins")
 
-// downloader downloads terraform binaries
+// downloader downloads terraform and tofu binaries
 type downloader struct {
-	destdir string        // destination directory for binaries
-	host    string        // server hosting binaries
-	client  *http.Client  // client for downloading from server via http
-	mu      chan struct{} // ensures only one download at a time
+	destdir string            // destination directory for binaries
+	sources map[Engine]source // where to find releases for each engine
+	client  *http.Client      // client for downloading from server via http
+	mu      chan struct{}     // ensures only one download at a time
 }
 
-// NewDownloader constructs a terraform downloader, with destdir set as the
-// parent directory into which the binaries are downloaded. Pass an empty string
-// to use a default.
+// NewDownloader constructs a downloader of engine binaries, with destdir set as
+// the parent directory into which the binaries are downloaded. Pass an empty
+// string to use a default.
 func NewDownloader(destdir string) *downloader {
 	if destdir == "" {
 		destdir = defaultTerraformBinDir
@@ -36,21 +32,35 @@ func NewDownloader(destdir string) *downloader {
 	mu := make(chan struct{}, 1)
 	mu <- struct{}{}
 
+	// copy sources so that they can be altered without affecting other
+	// downloaders.
+	srcs := make(map[Engine]source, len(sources))
+	for engine, src := range sources {
+		srcs[engine] = src
+	}
+
 	return &downloader{
-		host:    hashicorpReleasesHost,
 		destdir: destdir,
+		sources: srcs,
 		client:  &http.Client{},
 		mu:      mu,
 	}
 }
 
-// Download ensures the given version of terraform is available on the local
-// filesystem and returns its path. Thread-safe: if a Download is in-flight and
-// another Download is requested then it'll be made to wait until the
-// former has finished.
-func (d *downloader) Download(ctx context.Context, version string, w io.Writer) (string, error) {
-	if internal.Exists(d.dest(version)) {
-		return d.dest(version), nil
+// Download ensures the given version of the engine is available on the local
+// filesystem and returns its path. The release archive is verified against
+// the engine's signed checksums before the binary is extracted. Thread-safe:
+// if a Download is in-flight and another Download is requested then it'll be
+// made to wait until the former has finished.
+func (d *downloader) Download(ctx context.Context, engine Engine, version string, w io.Writer) (string, error) {
+	if err := engine.Valid(); err != nil {
+		return "", err
+	}
+	src := d.sources[engine]
+	dest := d.dest(engine, version)
+
+	if internal.Exists(dest) {
+		return dest, nil
 	}
 
 	select {
@@ -60,29 +70,32 @@ func (d *downloader) Download(ctx context.Context, version string, w io.Writer)
 	}
 
 	err := (&download{
-		Writer:  w,
-		version: version,
-		src:     d.src(version),
-		dest:    d.dest(version),
-		client:  d.client,
+		Writer:    w,
+		engine:    engine,
+		version:   version,
+		binary:    src.binary,
+		archive:   src.archive(version),
+		src:       d.url(src, version, src.archive(version)),
+		checksums: d.url(src, version, src.checksums(version)),
+		signature: d.url(src, version, src.signature(version)),
+		publicKey: src.publicKey,
+		dest:      dest,
+		client:    d.client,
 	}).download(ctx)
 
 	d.mu <- struct{}{}
 
-	return d.dest(version), err
+	return dest, err
 }
 
-func (d *downloader) src(version string) string {
+func (d *downloader) url(src source, version, filename string) string {
 	return (&url.URL{
 		Scheme: "https",
-		Host:   d.host,
-		Path: path.Join(
-			"terraform",
-			version,
-			fmt.Sprintf("terraform_%s_%s_%s.zip", version, runtime.GOOS, runtime.GOARCH)),
+		Host:   src.host,
+		Path:   path.Join(src.dir(version), filename),
 	}).String()
 }
 
-func (d *downloader) dest(version string) string {
-	return path.Join(d.destdir, version, "terraform")
+func (d *downloader) dest(engine Engine, version string) string {
+	return path.Join(d.destdir, string(engine), version, d.sources[engine].binary)
 }
@@ -12,30 +12,66 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	otfhttp "github.com/tofutf/tofutf/internal/http"
+	"github.com/tofutf/tofutf/internal/testutils"
 )
 
 func TestDownloader(t *testing.T) {
-	// setup web server
-	http.Handle("/", http.FileServer(http.Dir("testdata/releases")))
-	srv := httptest.NewTLSServer(nil)
-	t.Cleanup(func() {
-		srv.Close()
+	tests := []struct {
+		name    string
+		engine  Engine
+		version string
+		want    string
+	}{
+		{"terraform", TerraformEngine, "1.2.3", "I am a fake terraform binary\n"},
+		{"tofu", TofuEngine, "1.6.2", "I am a fake tofu binary\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dl := newTestDownloader(t, string(testutils.ReadFile(t, "testdata/public_key.asc")))
+
+			buf := new(bytes.Buffer)
+			binpath, err := dl.Download(context.Background(), tt.engine, tt.version, buf)
+			require.NoError(t, err)
+			require.FileExists(t, binpath)
+			bin, err := os.ReadFile(binpath)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(bin))
+			assert.Equal(t, "downloading "+string(tt.engine)+", version "+tt.version+"\n", buf.String())
+		})
+	}
+
+	t.Run("invalid signature", func(t *testing.T) {
+		// verify checksums with a key other than the one that signed them
+		dl := newTestDownloader(t, opentofuPublicKey)
+
+		_, err := dl.Download(context.Background(), TofuEngine, "1.6.2", new(bytes.Buffer))
+		assert.ErrorContains(t, err, "verifying checksums signature")
+	})
+
+	t.Run("invalid engine", func(t *testing.T) {
+		dl := NewDownloader(t.TempDir())
+
+		_, err := dl.Download(context.Background(), Engine("pulumi"), "1.2.3", new(bytes.Buffer))
+		assert.ErrorIs(t, err, ErrInvalidEngine)
 	})
+}
+
+// newTestDownloader constructs a downloader that retrieves releases from a
+// local fake release server, verifying them with the given public key.
+func newTestDownloader(t *testing.T, publicKey string) *downloader {
+	srv := httptest.NewTLSServer(http.FileServer(http.Dir("testdata/releases")))
+	t.Cleanup(srv.Close)
 	u, err := url.Parse(srv.URL)
 	require.NoError(t, err)
 
 	dl := NewDownloader(t.TempDir())
-	dl.host = u.Host
+	for engine, src := range dl.sources {
+		src.host = u.Host
+		src.publicKey = publicKey
+		dl.sources[engine] = src
+	}
 	dl.client = &http.Client{
 		Transport: otfhttp.InsecureTransport,
 	}
-
-	buf := new(bytes.Buffer)
-	tfpath, err := dl.Download(context.Background(), "1.2.3", buf)
-	require.NoError(t, err)
-	require.FileExists(t, tfpath)
-	tfbin, err := os.ReadFile(tfpath)
-	require.NoError(t, err)
-	assert.Equal(t, "I am a fake terraform binary\n", string(tfbin))
-	assert.Equal(t, "downloading terraform, version 1.2.3\n", buf.String())
+	return dl
 }
@@ -0,0 +1,123 @@
+package releases
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"path"
+	"runtime"
+)
+
+const (
+	TerraformEngine Engine = "terraform"
+	TofuEngine      Engine = "tofu"
+
+	// DefaultEngine is the engine used when none is specified.
+	DefaultEngine = TerraformEngine
+
+	DefaultTofuVersion = "1.6.2"
+)
+
+var (
+	ErrInvalidEngine = errors.New("invalid engine: must be either terraform or tofu")
+
+	// Engines lists all supported engines.
+	Engines = []Engine{TerraformEngine, TofuEngine}
+
+	//go:embed keys/hashicorp.asc
+	hashicorpPublicKey string
+
+	//go:embed keys/opentofu.asc
+	opentofuPublicKey string
+
+	// sources maps each engine to the location of its releases.
+	sources = map[Engine]source{
+		TerraformEngine: {
+			host:   "releases.hashicorp.com",
+			binary: "terraform",
+			dir: func(version string) string {
+				return path.Join("terraform", version)
+			},
+			checksums: func(version string) string {
+				return fmt.Sprintf("terraform_%s_SHA256SUMS", version)
+			},
+			signature: func(version string) string {
+				return fmt.Sprintf("terraform_%s_SHA256SUMS.sig", version)
+			},
+			publicKey:      hashicorpPublicKey,
+			latestEndpoint: "https://api.releases.hashicorp.com/v1/releases/terraform/latest",
+		},
+		TofuEngine: {
+			host:   "github.com",
+			binary: "tofu",
+			dir: func(version string) string {
+				return path.Join("opentofu", "opentofu", "releases", "download", "v"+version)
+			},
+			checksums: func(version string) string {
+				return fmt.Sprintf("tofu_%s_SHA256SUMS", version)
+			},
+			signature: func(version string) string {
+				return fmt.Sprintf("tofu_%s_SHA256SUMS.gpgsig", version)
+			},
+			publicKey:      opentofuPublicKey,
+			latestEndpoint: "https://api.github.com/repos/opentofu/opentofu/releases/latest",
+		},
+	}
+)
+
+type (
+	// Engine is the program that executes runs: either terraform or its open
+	// source fork, tofu.
+	Engine string
+
+	// source describes where releases of an engine are hosted and how they
+	// are verified.
+	source struct {
+		host      string                      // server hosting releases
+		binary    string                      // name of binary within archive
+		dir       func(version string) string // path to release on server
+		checksums func(version string) string // name of checksums file
+		signature func(version string) string // name of checksums signature file
+		publicKey string                      // armored public key of signer
+
+		latestEndpoint string // API endpoint returning latest release
+	}
+)
+
+// ParseEngine parses a string into an engine, returning the default engine if
+// the string is empty.
+func ParseEngine(s string) (Engine, error) {
+	if s == "" {
+		return DefaultEngine, nil
+	}
+	engine := Engine(s)
+	if err := engine.Valid(); err != nil {
+		return "", err
+	}
+	return engine, nil
+}
+
+// Valid returns an error if the engine is unsupported.
+func (e Engine) Valid() error {
+	for _, engine := range Engines {
+		if e == engine {
+			return nil
+		}
+	}
+	return ErrInvalidEngine
+}
+
+// DefaultVersion returns the version of the engine to use when a version
+// cannot otherwise be determined.
+func (e Engine) DefaultVersion() string {
+	if e == TofuEngine {
+		return DefaultTofuVersion
+	}
+	return DefaultTerraformVersion
+}
+
+func (e Engine) String() string { return string(e) }
+
+func (s source) archive(version string) string {
+	return fmt.Sprintf("%s_%s_%s_%s.zip", s.binary, version, runtime.GOOS, runtime.GOARCH)
+}
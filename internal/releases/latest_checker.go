@@ -4,36 +4,53 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 )
 
-const latestEndpoint = "https://api.releases.hashicorp.com/v1/releases/terraform/latest"
-
-// latestChecker checks for a new latest release of terraform.
+// latestChecker checks for a new latest release of an engine.
 type latestChecker struct {
-	endpoint string
+	endpoints map[Engine]string
+}
+
+func newLatestChecker() latestChecker {
+	endpoints := make(map[Engine]string, len(sources))
+	for engine, src := range sources {
+		endpoints[engine] = src.latestEndpoint
+	}
+	return latestChecker{endpoints: endpoints}
 }
 
-func (c latestChecker) check(last time.Time) (string, error) {
+func (c latestChecker) check(engine Engine, last time.Time) (string, error) {
 	// skip check if already checked within last 24 hours
 	if last.After(time.Now().Add(-24 * time.Hour)) {
 		return "", nil
 	}
+	endpoint, ok := c.endpoints[engine]
+	if !ok {
+		return "", fmt.Errorf("no latest release endpoint for engine: %s", engine)
+	}
 	// check releases endpoint
-	resp, err := http.Get(c.endpoint)
+	resp, err := http.Get(endpoint)
 	if err != nil {
 		return "", err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != 200 {
-		return "", fmt.Errorf("%s return non-200 status code: %s", c.endpoint, resp.Status)
+		return "", fmt.Errorf("%s return non-200 status code: %s", endpoint, resp.Status)
 	}
-	// decode endpoint response
+	// decode endpoint response: hashicorp's releases API returns the version
+	// whereas github's releases API returns the tag name, which is the
+	// version prefixed with a 'v'.
 	var release struct {
 		Version string `json:"version"`
+		TagName string `json:"tag_name"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
 		return "", err
 	}
-	return release.Version, nil
+	if release.Version != "" {
+		return release.Version, nil
+	}
+	return strings.TrimPrefix(release.TagName, "v"), nil
 }
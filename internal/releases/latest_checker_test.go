@@ -14,22 +14,25 @@ import (
 
 func Test_latestChecker(t *testing.T) {
 	tests := []struct {
-		name string
-		last time.Time // last time checked
-		got  string    // version returned
+		name     string
+		engine   Engine
+		response string    // path to stub endpoint response
+		last     time.Time // last time checked
+		got      string    // version returned
 	}{
-		{"skip check", time.Now(), ""},
-		{"perform check", time.Time{}, "1.6.1"},
+		{"skip check", TerraformEngine, "./testdata/latest.json", time.Now(), ""},
+		{"perform check", TerraformEngine, "./testdata/latest.json", time.Time{}, "1.6.1"},
+		{"perform tofu check", TofuEngine, "./testdata/latest_tofu.json", time.Time{}, "1.6.2"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// endpoint is a stub endpoint that always returns 1.6.1 as latest
+			// endpoint is a stub endpoint that always returns the same latest
 			// version
 			endpoint := func() string {
 				mux := http.NewServeMux()
 				mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 					w.Header().Add("Content-Type", "application/json")
-					w.Write(testutils.ReadFile(t, "./testdata/latest.json")) //nolint:errcheck
+					w.Write(testutils.ReadFile(t, tt.response)) //nolint:errcheck
 				})
 				srv := httptest.NewServer(mux)
 				t.Cleanup(srv.Close)
@@ -38,7 +41,7 @@ func Test_latestChecker(t *testing.T) {
 				return u.String()
 			}()
 
-			v, err := latestChecker{endpoint}.check(tt.last)
+			v, err := latestChecker{map[Engine]string{tt.engine: endpoint}}.check(tt.engine, tt.last)
 			require.NoError(t, err)
 			assert.Equal(t, tt.got, v)
 		})
@@ -1,4 +1,4 @@
-// Package releases manages terraform releases.
+// Package releases manages terraform and tofu releases.
 package releases
 
 import (
@@ -31,7 +31,7 @@ type (
 		*sql.Pool
 
 		Logger          *slog.Logger
-		TerraformBinDir string // destination directory for terraform binaries
+		TerraformBinDir string // destination directory for terraform and tofu binaries
 	}
 )
 
@@ -39,44 +39,20 @@ func NewService(opts Options) *Service {
 	svc := &Service{
 		logger:        opts.Logger,
 		db:            &db{opts.Pool},
-		latestChecker: latestChecker{latestEndpoint},
+		latestChecker: newLatestChecker(),
 		downloader:    NewDownloader(opts.TerraformBinDir),
 	}
 	return svc
 }
 
-// StartLatestChecker starts the latest checker go routine, checking the Hashicorp
-// API endpoint for a new latest version.
+// StartLatestChecker starts the latest checker go routine, checking the
+// Hashicorp and OpenTofu API endpoints for a new latest version of each engine.
 func (s *Service) StartLatestChecker(ctx context.Context) {
 	check := func() {
-		err := func() error {
-			before, checkpoint, err := s.GetLatest(ctx)
-			if err != nil {
-				return err
+		for _, engine := range Engines {
+			if err := s.checkLatest(ctx, engine); err != nil {
+				s.logger.Error("checking latest version", "engine", engine, "err", err)
 			}
-			after, err := s.latestChecker.check(checkpoint)
-			if err != nil {
-				return err
-			}
-			if after == "" {
-				// check was skipped (too early)
-				return nil
-			}
-			// perform sanity check
-			if n := semver.Compare(after, before); n < 0 {
-				return fmt.Errorf("endpoint returned older version: before: %s; after: %s", before, after)
-			}
-			// update db (even if version hasn't changed we need to update the
-			// checkpoint)
-			if err := s.db.updateLatestVersion(ctx, after); err != nil {
-				return err
-			}
-
-			s.logger.Debug("checked latest terraform version", "before", before, "after", after)
-			return nil
-		}()
-		if err != nil {
-			s.logger.Error("checking latest terraform version", "err", err)
 		}
 	}
 	// check once at startup
@@ -96,15 +72,42 @@ func (s *Service) StartLatestChecker(ctx context.Context) {
 	}()
 }
 
-// GetLatest returns the latest terraform version and the time when it was
-// fetched; if it has not yet been fetched then the default version is returned
-// instead along with zero time.
-func (s *Service) GetLatest(ctx context.Context) (string, time.Time, error) {
-	latest, checkpoint, err := s.db.getLatest(ctx)
+func (s *Service) checkLatest(ctx context.Context, engine Engine) error {
+	before, checkpoint, err := s.GetLatest(ctx, engine)
+	if err != nil {
+		return err
+	}
+	after, err := s.latestChecker.check(engine, checkpoint)
+	if err != nil {
+		return err
+	}
+	if after == "" {
+		// check was skipped (too early)
+		return nil
+	}
+	// perform sanity check
+	if n := semver.Compare(after, before); n < 0 {
+		return fmt.Errorf("endpoint returned older version: before: %s; after: %s", before, after)
+	}
+	// update db (even if version hasn't changed we need to update the
+	// checkpoint)
+	if err := s.db.updateLatestVersion(ctx, engine, after); err != nil {
+		return err
+	}
+
+	s.logger.Debug("checked latest version", "engine", engine, "before", before, "after", after)
+	return nil
+}
+
+// GetLatest returns the latest version of the engine and the time when it was
+// fetched; if it has not yet been fetched then the engine's default version is
+// returned instead along with zero time.
+func (s *Service) GetLatest(ctx context.Context, engine Engine) (string, time.Time, error) {
+	latest, checkpoint, err := s.db.getLatest(ctx, engine)
 	if errors.Is(err, internal.ErrResourceNotFound) {
 		// no latest version has yet been persisted to the database so return
 		// the default version instead
-		return DefaultTerraformVersion, time.Time{}, nil
+		return engine.DefaultVersion(), time.Time{}, nil
 	} else if err != nil {
 		return "", time.Time{}, err
 	}
@@ -2,6 +2,7 @@ package run
 
 import (
 	"bytes"
+	"encoding/json"
 	"io"
 	"log/slog"
 	"net/http"
@@ -24,6 +25,7 @@ func (a *api) addHandlers(r *mux.Router) {
 	r.HandleFunc("/runs/{id}", a.get).Methods("GET")
 	r.HandleFunc("/runs/{id}/planfile", a.getPlanFile).Methods("GET")
 	r.HandleFunc("/runs/{id}/planfile", a.uploadPlanFile).Methods("PUT")
+	r.HandleFunc("/runs/{id}/plan-changes", a.getPlanChanges).Methods("GET")
 	r.HandleFunc("/runs/{id}/lockfile", a.getLockFile).Methods("GET")
 	r.HandleFunc("/runs/{id}/lockfile", a.uploadLockFile).Methods("PUT")
 }
@@ -78,6 +80,24 @@ func (a *api) getPlanFile(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (a *api) getPlanChanges(w http.ResponseWriter, r *http.Request) {
+	id, err := decode.Param("id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	changes, err := a.GetPlanChanges(r.Context(), id)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	w.Header().Set("Content-type", "application/json")
+	if err := json.NewEncoder(w).Encode(changes); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
 func (a *api) uploadPlanFile(w http.ResponseWriter, r *http.Request) {
 	id, err := decode.Param("id", r)
 	if err != nil {
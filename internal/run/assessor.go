@@ -0,0 +1,163 @@
+package run
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/workspace"
+)
+
+// AssessorLockID guarantees only one assessor on a cluster is running at any
+// time.
+const AssessorLockID int64 = 5577006791947779415
+
+var (
+	// DefaultAssessmentInterval is the default period between health
+	// assessments of a workspace.
+	DefaultAssessmentInterval = 24 * time.Hour
+	// defaultAssessorCheckInterval is how often the assessor checks for
+	// workspaces due an assessment.
+	defaultAssessorCheckInterval = time.Minute
+)
+
+type (
+	// Assessor periodically creates refresh-only plan runs on idle workspaces
+	// that have health assessments enabled, in order to detect drift.
+	Assessor struct {
+		Logger     *slog.Logger
+		Workspaces assessorWorkspaceClient
+		Runs       assessorRunClient
+
+		// Interval is the period between health assessments of a workspace.
+		Interval time.Duration
+		// frequency with which the assessor checks for workspaces due an
+		// assessment.
+		checkInterval time.Duration
+	}
+
+	assessorWorkspaceClient interface {
+		ListForAssessment(ctx context.Context, dueBefore time.Time) ([]*workspace.Workspace, error)
+		SetLatestAssessment(ctx context.Context, workspaceID string, assessment workspace.Assessment) (*workspace.Workspace, error)
+	}
+
+	assessorRunClient interface {
+		Create(ctx context.Context, workspaceID string, opts CreateOptions) (*Run, error)
+	}
+)
+
+// NewAssessor constructs an assessor that assesses workspaces every interval.
+// If interval is zero then DefaultAssessmentInterval is used.
+func (s *Service) NewAssessor(logger *slog.Logger, interval time.Duration) *Assessor {
+	if interval == 0 {
+		interval = DefaultAssessmentInterval
+	}
+	return &Assessor{
+		Logger:        logger.With("component", "assessor"),
+		Workspaces:    s.workspaces,
+		Runs:          s,
+		Interval:      interval,
+		checkInterval: defaultAssessorCheckInterval,
+	}
+}
+
+// Start starts the assessor daemon. Should be invoked in a go routine.
+func (a *Assessor) Start(ctx context.Context) error {
+	// run at startup and then every check interval
+	a.assessAll(ctx)
+	ticker := time.NewTicker(a.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			a.assessAll(ctx)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (a *Assessor) assessAll(ctx context.Context) {
+	due := internal.CurrentTimestamp(nil).Add(-a.Interval)
+	workspaces, err := a.Workspaces.ListForAssessment(ctx, due)
+	if err != nil {
+		a.Logger.Error("listing workspaces due an assessment", "err", err)
+		return
+	}
+	for _, ws := range workspaces {
+		if !idle(ws) {
+			// try again on the next check
+			continue
+		}
+		if err := a.assess(ctx, ws); err != nil {
+			// carry on assessing other workspaces
+			a.Logger.Error("assessing workspace", "workspace", ws, "err", err)
+		}
+	}
+}
+
+// assess creates a refresh-only plan run for the workspace, and records it as
+// the workspace's latest assessment. The outcome of the assessment is
+// recorded once the plan finishes. A workspace without a configuration
+// version cannot be assessed, in which case a skipped assessment is recorded
+// so that it is not retried until the next interval.
+func (a *Assessor) assess(ctx context.Context, ws *workspace.Workspace) error {
+	created, err := a.Runs.Create(ctx, ws.ID, CreateOptions{
+		Source:      SourceHealthAssessment,
+		RefreshOnly: internal.Bool(true),
+		PlanOnly:    internal.Bool(true),
+		Message:     internal.String("Health assessment"),
+	})
+	if errors.Is(err, internal.ErrResourceNotFound) {
+		_, err = a.Workspaces.SetLatestAssessment(ctx, ws.ID, workspace.Assessment{
+			CreatedAt: internal.CurrentTimestamp(nil),
+			Status:    workspace.AssessmentSkipped,
+		})
+		if err != nil {
+			return err
+		}
+		a.Logger.Info("skipped health assessment: no configuration", "workspace", ws)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	_, err = a.Workspaces.SetLatestAssessment(ctx, ws.ID, workspace.Assessment{
+		RunID:     created.ID,
+		CreatedAt: created.CreatedAt,
+		Status:    workspace.AssessmentPending,
+	})
+	if err != nil {
+		return err
+	}
+	a.Logger.Info("started health assessment", "workspace", ws, "run", created.ID)
+	return nil
+}
+
+// idle determines whether the workspace is neither locked nor has a run in
+// progress.
+func idle(ws *workspace.Workspace) bool {
+	if ws.Locked() {
+		return false
+	}
+	if ws.LatestRun == nil {
+		return true
+	}
+	latest := Run{Status: Status(ws.LatestRun.Status)}
+	return latest.Done()
+}
+
+// assessmentStatus determines the outcome of a finished health assessment run.
+func assessmentStatus(run *Run) workspace.AssessmentStatus {
+	switch run.Status {
+	case RunErrored, RunCanceled, RunForceCanceled, RunDiscarded:
+		return workspace.AssessmentErrored
+	}
+	switch {
+	case run.Drifted():
+		return workspace.AssessmentDrifted
+	default:
+		return workspace.AssessmentNoDrift
+	}
+}
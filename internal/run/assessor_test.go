@@ -0,0 +1,188 @@
+package run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/workspace"
+)
+
+func TestAssessor_assessAll(t *testing.T) {
+	ctx := context.Background()
+
+	idleWorkspace := &workspace.Workspace{ID: "ws-idle"}
+	finishedWorkspace := &workspace.Workspace{ID: "ws-finished", LatestRun: &workspace.LatestRun{ID: "run-1", Status: "applied"}}
+	busyWorkspace := &workspace.Workspace{ID: "ws-busy", LatestRun: &workspace.LatestRun{ID: "run-2", Status: "planning"}}
+	lockedWorkspace := &workspace.Workspace{ID: "ws-locked"}
+	require.NoError(t, lockedWorkspace.Enlock("bobby", workspace.UserLock))
+
+	tests := []struct {
+		name       string
+		workspaces []*workspace.Workspace
+		// workspaces in which a run fails to be created
+		failures []string
+		want     []string
+	}{
+		{
+			name:       "idle workspaces",
+			workspaces: []*workspace.Workspace{idleWorkspace, finishedWorkspace},
+			want:       []string{"ws-idle", "ws-finished"},
+		},
+		{
+			name:       "skip busy and locked workspaces",
+			workspaces: []*workspace.Workspace{busyWorkspace, lockedWorkspace, idleWorkspace},
+			want:       []string{"ws-idle"},
+		},
+		{
+			name:       "skip workspace that fails",
+			workspaces: []*workspace.Workspace{idleWorkspace, finishedWorkspace},
+			failures:   []string{"ws-idle"},
+			want:       []string{"ws-finished"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runs := &fakeAssessorRunClient{failures: tt.failures}
+			workspaces := &fakeAssessorWorkspaceClient{workspaces: tt.workspaces}
+			assessor := &Assessor{
+				Logger:     slog.Default(),
+				Workspaces: workspaces,
+				Runs:       runs,
+				Interval:   time.Hour,
+			}
+
+			assessor.assessAll(ctx)
+
+			assert.Equal(t, tt.want, runs.created)
+			for _, opts := range runs.opts {
+				assert.Equal(t, SourceHealthAssessment, opts.Source)
+				assert.True(t, *opts.RefreshOnly)
+				assert.True(t, *opts.PlanOnly)
+			}
+			// each assessment is recorded as pending
+			assert.Equal(t, tt.want, workspaces.assessed)
+			for _, assessment := range workspaces.assessments {
+				assert.Equal(t, workspace.AssessmentPending, assessment.Status)
+			}
+			// workspaces are due if they have not been assessed in the last
+			// interval
+			assert.WithinDuration(t, time.Now().Add(-time.Hour), workspaces.dueBefore, time.Minute)
+		})
+	}
+}
+
+func TestAssessor_assess_noConfiguration(t *testing.T) {
+	ctx := context.Background()
+	runs := &fakeAssessorRunClient{unconfigured: []string{"ws-1"}}
+	workspaces := &fakeAssessorWorkspaceClient{}
+	assessor := &Assessor{
+		Logger:     slog.Default(),
+		Workspaces: workspaces,
+		Runs:       runs,
+		Interval:   time.Hour,
+	}
+
+	err := assessor.assess(ctx, &workspace.Workspace{ID: "ws-1"})
+	require.NoError(t, err)
+
+	// a skipped assessment is recorded without a run so that the workspace is
+	// not due again until the next interval
+	assert.Empty(t, runs.created)
+	require.Equal(t, []string{"ws-1"}, workspaces.assessed)
+	assert.Equal(t, workspace.AssessmentSkipped, workspaces.assessments[0].Status)
+	assert.Empty(t, workspaces.assessments[0].RunID)
+	assert.WithinDuration(t, time.Now(), workspaces.assessments[0].CreatedAt, time.Minute)
+}
+
+func TestAssessor_assessmentStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		run  *Run
+		want workspace.AssessmentStatus
+	}{
+		{
+			name: "drifted",
+			run:  &Run{Status: RunPlannedAndFinished, RefreshOnly: true, Plan: Phase{ResourceReport: &Report{Changes: 1}}},
+			want: workspace.AssessmentDrifted,
+		},
+		{
+			name: "no drift",
+			run:  &Run{Status: RunPlannedAndFinished, RefreshOnly: true, Plan: Phase{ResourceReport: &Report{}}},
+			want: workspace.AssessmentNoDrift,
+		},
+		{
+			name: "errored",
+			run:  &Run{Status: RunErrored, RefreshOnly: true},
+			want: workspace.AssessmentErrored,
+		},
+		{
+			name: "canceled",
+			run:  &Run{Status: RunCanceled, RefreshOnly: true},
+			want: workspace.AssessmentErrored,
+		},
+		{
+			name: "force canceled",
+			run:  &Run{Status: RunForceCanceled, RefreshOnly: true},
+			want: workspace.AssessmentErrored,
+		},
+		{
+			name: "discarded",
+			run:  &Run{Status: RunDiscarded, RefreshOnly: true},
+			want: workspace.AssessmentErrored,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, assessmentStatus(tt.run))
+		})
+	}
+}
+
+type fakeAssessorRunClient struct {
+	failures []string
+	// workspaces without a configuration version
+	unconfigured []string
+	created      []string
+	opts         []CreateOptions
+}
+
+func (f *fakeAssessorRunClient) Create(ctx context.Context, workspaceID string, opts CreateOptions) (*Run, error) {
+	for _, failure := range f.failures {
+		if failure == workspaceID {
+			return nil, errors.New("cannot create run")
+		}
+	}
+	for _, unconfigured := range f.unconfigured {
+		if unconfigured == workspaceID {
+			return nil, fmt.Errorf("failed to retrieve latest configuration: %w", internal.ErrResourceNotFound)
+		}
+	}
+	f.created = append(f.created, workspaceID)
+	f.opts = append(f.opts, opts)
+	return &Run{ID: "run-" + workspaceID, WorkspaceID: workspaceID}, nil
+}
+
+type fakeAssessorWorkspaceClient struct {
+	workspaces  []*workspace.Workspace
+	dueBefore   time.Time
+	assessed    []string
+	assessments []workspace.Assessment
+}
+
+func (f *fakeAssessorWorkspaceClient) ListForAssessment(ctx context.Context, dueBefore time.Time) ([]*workspace.Workspace, error) {
+	f.dueBefore = dueBefore
+	return f.workspaces, nil
+}
+
+func (f *fakeAssessorWorkspaceClient) SetLatestAssessment(ctx context.Context, workspaceID string, assessment workspace.Assessment) (*workspace.Workspace, error) {
+	f.assessed = append(f.assessed, workspaceID)
+	f.assessments = append(f.assessments, assessment)
+	return &workspace.Workspace{ID: workspaceID, LatestAssessment: &assessment}, nil
+}
@@ -18,5 +18,6 @@ func (a *authorizer) CanAccess(ctx context.Context, action rbac.Action, runID st
 	if err != nil {
 		return nil, err
 	}
+	ctx = internal.AddAuditResourceToContext(ctx, runID)
 	return a.workspace.CanAccess(ctx, action, run.WorkspaceID)
 }
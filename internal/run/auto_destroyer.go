@@ -0,0 +1,192 @@
+package run
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/resource"
+	"github.com/tofutf/tofutf/internal/workspace"
+)
+
+// AutoDestroyerLockID guarantees only one auto-destroyer on a cluster is
+// running at any time.
+const AutoDestroyerLockID int64 = 5577006791947779417
+
+// defaultAutoDestroyerCheckInterval is how often the auto-destroyer checks for
+// workspaces due to be destroyed.
+var defaultAutoDestroyerCheckInterval = time.Minute
+
+// activitySources are the sources of runs that count as activity on a
+// workspace. Runs created automatically, i.e. health assessments, scheduled
+// runs and auto-destroy runs, do not count.
+var activitySources = []Source{
+	SourceAPI,
+	SourceUI,
+	SourceTerraform,
+	SourceGithub,
+	SourceGitlab,
+	SourceRunTrigger,
+}
+
+type (
+	// AutoDestroyer periodically creates destroy runs on idle workspaces
+	// whose auto-destroy deadline has passed or which have been inactive for
+	// longer than their inactivity duration. Once a destroy run has
+	// successfully applied, the workspace is optionally deleted.
+	AutoDestroyer struct {
+		Logger     *slog.Logger
+		Workspaces autoDestroyerWorkspaceClient
+		Runs       autoDestroyerRunClient
+
+		// frequency with which the auto-destroyer checks for workspaces due to
+		// be destroyed.
+		checkInterval time.Duration
+	}
+
+	autoDestroyerWorkspaceClient interface {
+		ListForAutoDestroy(ctx context.Context) ([]*workspace.Workspace, error)
+		SetAutoDestroyRun(ctx context.Context, workspaceID, runID string) (*workspace.Workspace, error)
+		Update(ctx context.Context, workspaceID string, opts workspace.UpdateOptions) (*workspace.Workspace, error)
+		Delete(ctx context.Context, workspaceID string) (*workspace.Workspace, error)
+	}
+
+	autoDestroyerRunClient interface {
+		Create(ctx context.Context, workspaceID string, opts CreateOptions) (*Run, error)
+		Get(ctx context.Context, runID string) (*Run, error)
+		List(ctx context.Context, opts ListOptions) (*resource.Page[*Run], error)
+	}
+)
+
+// NewAutoDestroyer constructs an auto-destroyer.
+func (s *Service) NewAutoDestroyer(logger *slog.Logger) *AutoDestroyer {
+	return &AutoDestroyer{
+		Logger:        logger.With("component", "auto-destroyer"),
+		Workspaces:    s.workspaces,
+		Runs:          s,
+		checkInterval: defaultAutoDestroyerCheckInterval,
+	}
+}
+
+// Start starts the auto-destroyer daemon. Should be invoked in a go routine.
+func (a *AutoDestroyer) Start(ctx context.Context) error {
+	// run at startup and then every check interval
+	a.checkAll(ctx)
+	ticker := time.NewTicker(a.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			a.checkAll(ctx)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (a *AutoDestroyer) checkAll(ctx context.Context) {
+	workspaces, err := a.Workspaces.ListForAutoDestroy(ctx)
+	if err != nil {
+		a.Logger.Error("listing workspaces with auto-destroy enabled", "err", err)
+		return
+	}
+	for _, ws := range workspaces {
+		if err := a.check(ctx, ws); err != nil {
+			// carry on checking other workspaces
+			a.Logger.Error("auto-destroying workspace", "workspace", ws, "err", err)
+		}
+	}
+}
+
+// check either finishes off a previously queued destroy run, or queues a
+// destroy run if the workspace is due to be destroyed.
+func (a *AutoDestroyer) check(ctx context.Context, ws *workspace.Workspace) error {
+	if !idle(ws) {
+		// try again on the next check
+		return nil
+	}
+	lastActivity, err := a.lastActivity(ctx, ws)
+	if err != nil {
+		return err
+	}
+	if ws.AutoDestroyRunID != nil {
+		destroyRun, err := a.Runs.Get(ctx, *ws.AutoDestroyRunID)
+		if err != nil && !errors.Is(err, internal.ErrResourceNotFound) {
+			return err
+		}
+		if destroyRun != nil && !lastActivity.After(destroyRun.CreatedAt) {
+			// nothing has happened on the workspace since its destroy run
+			return a.finish(ctx, ws, destroyRun)
+		}
+	}
+	if !ws.AutoDestroyDue(lastActivity, internal.CurrentTimestamp(nil)) {
+		return nil
+	}
+	return a.destroy(ctx, ws)
+}
+
+// lastActivity returns when the workspace was last updated or when its most
+// recent run was created, ignoring runs created automatically.
+func (a *AutoDestroyer) lastActivity(ctx context.Context, ws *workspace.Workspace) (time.Time, error) {
+	lastActivity := ws.UpdatedAt
+	page, err := a.Runs.List(ctx, ListOptions{
+		WorkspaceID: &ws.ID,
+		Sources:     activitySources,
+		PageOptions: resource.PageOptions{PageSize: 1},
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(page.Items) > 0 && page.Items[0].CreatedAt.After(lastActivity) {
+		lastActivity = page.Items[0].CreatedAt
+	}
+	return lastActivity, nil
+}
+
+// destroy creates a destroy run for the workspace and records it as the
+// workspace's auto-destroy run.
+func (a *AutoDestroyer) destroy(ctx context.Context, ws *workspace.Workspace) error {
+	created, err := a.Runs.Create(ctx, ws.ID, CreateOptions{
+		Source:    SourceAutoDestroy,
+		IsDestroy: internal.Bool(true),
+		AutoApply: internal.Bool(true),
+		Message:   internal.String("Triggered by auto-destroy"),
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := a.Workspaces.SetAutoDestroyRun(ctx, ws.ID, created.ID); err != nil {
+		return err
+	}
+	a.Logger.Info("queued auto-destroy run", "workspace", ws, "run", created.ID)
+	return nil
+}
+
+// finish handles a finished destroy run. If the run succeeded then either the
+// workspace is deleted or its deadline is cleared, to prevent it from being
+// destroyed again. A failed run is left for the user to investigate.
+func (a *AutoDestroyer) finish(ctx context.Context, ws *workspace.Workspace, destroyRun *Run) error {
+	switch destroyRun.Status {
+	case RunApplied, RunPlannedAndFinished:
+	default:
+		return nil
+	}
+	if ws.DeleteAfterAutoDestroy {
+		if _, err := a.Workspaces.Delete(ctx, ws.ID); err != nil {
+			return err
+		}
+		a.Logger.Info("deleted auto-destroyed workspace", "workspace", ws)
+		return nil
+	}
+	if ws.AutoDestroyAt != nil {
+		_, err := a.Workspaces.Update(ctx, ws.ID, workspace.UpdateOptions{
+			ClearAutoDestroyAt: true,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
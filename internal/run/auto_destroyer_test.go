@@ -0,0 +1,215 @@
+package run
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/resource"
+	"github.com/tofutf/tofutf/internal/workspace"
+)
+
+func TestAutoDestroyer_checkAll(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	past := internal.Time(now.Add(-time.Hour))
+	future := internal.Time(now.Add(time.Hour))
+
+	tests := []struct {
+		name       string
+		workspaces []*workspace.Workspace
+		runs       map[string]*Run
+		// want IDs of workspaces in which destroy runs are created
+		wantDestroyed []string
+		// want IDs of workspaces that are deleted
+		wantDeleted []string
+		// want IDs of workspaces whose deadline is cleared
+		wantCleared []string
+	}{
+		{
+			name: "deadline passed",
+			workspaces: []*workspace.Workspace{
+				{ID: "ws-1", AutoDestroyAt: past},
+			},
+			wantDestroyed: []string{"ws-1"},
+		},
+		{
+			name: "deadline not passed",
+			workspaces: []*workspace.Workspace{
+				{ID: "ws-1", AutoDestroyAt: future},
+			},
+		},
+		{
+			name: "skip busy workspace",
+			workspaces: []*workspace.Workspace{
+				{ID: "ws-1", AutoDestroyAt: past, LatestRun: &workspace.LatestRun{ID: "run-1", Status: "planning"}},
+			},
+		},
+		{
+			name: "inactive workspace",
+			workspaces: []*workspace.Workspace{
+				{ID: "ws-1", AutoDestroyActivityDuration: internal.String("2h"), LatestRun: &workspace.LatestRun{ID: "run-1", Status: "applied"}},
+			},
+			runs: map[string]*Run{
+				"run-1": {ID: "run-1", WorkspaceID: "ws-1", Source: SourceUI, CreatedAt: now.Add(-3 * time.Hour)},
+			},
+			wantDestroyed: []string{"ws-1"},
+		},
+		{
+			name: "inactive workspace with automated runs",
+			workspaces: []*workspace.Workspace{
+				{ID: "ws-1", AutoDestroyActivityDuration: internal.String("2h"), LatestRun: &workspace.LatestRun{ID: "run-3", Status: "planned_and_finished"}},
+			},
+			runs: map[string]*Run{
+				"run-1": {ID: "run-1", WorkspaceID: "ws-1", Source: SourceUI, CreatedAt: now.Add(-3 * time.Hour)},
+				"run-2": {ID: "run-2", WorkspaceID: "ws-1", Source: SourceSchedule, CreatedAt: now.Add(-time.Hour)},
+				"run-3": {ID: "run-3", WorkspaceID: "ws-1", Source: SourceHealthAssessment, CreatedAt: now.Add(-time.Minute)},
+			},
+			wantDestroyed: []string{"ws-1"},
+		},
+		{
+			name: "active workspace",
+			workspaces: []*workspace.Workspace{
+				{ID: "ws-1", AutoDestroyActivityDuration: internal.String("2h"), LatestRun: &workspace.LatestRun{ID: "run-1", Status: "applied"}},
+			},
+			runs: map[string]*Run{
+				"run-1": {ID: "run-1", WorkspaceID: "ws-1", Source: SourceUI, CreatedAt: now.Add(-time.Hour)},
+			},
+		},
+		{
+			name: "delete after successful destroy",
+			workspaces: []*workspace.Workspace{
+				{ID: "ws-1", AutoDestroyAt: past, DeleteAfterAutoDestroy: true, AutoDestroyRunID: internal.String("run-1"), LatestRun: &workspace.LatestRun{ID: "run-1", Status: "applied"}},
+			},
+			runs: map[string]*Run{
+				"run-1": {ID: "run-1", WorkspaceID: "ws-1", Source: SourceAutoDestroy, Status: RunApplied, CreatedAt: now.Add(-time.Minute)},
+			},
+			wantDeleted: []string{"ws-1"},
+		},
+		{
+			name: "clear deadline after successful destroy",
+			workspaces: []*workspace.Workspace{
+				{ID: "ws-1", AutoDestroyAt: past, AutoDestroyRunID: internal.String("run-1"), LatestRun: &workspace.LatestRun{ID: "run-1", Status: "planned_and_finished"}},
+			},
+			runs: map[string]*Run{
+				"run-1": {ID: "run-1", WorkspaceID: "ws-1", Source: SourceAutoDestroy, Status: RunPlannedAndFinished, CreatedAt: now.Add(-time.Minute)},
+			},
+			wantCleared: []string{"ws-1"},
+		},
+		{
+			name: "leave failed destroy",
+			workspaces: []*workspace.Workspace{
+				{ID: "ws-1", AutoDestroyAt: past, DeleteAfterAutoDestroy: true, AutoDestroyRunID: internal.String("run-1"), LatestRun: &workspace.LatestRun{ID: "run-1", Status: "errored"}},
+			},
+			runs: map[string]*Run{
+				"run-1": {ID: "run-1", WorkspaceID: "ws-1", Source: SourceAutoDestroy, Status: RunErrored, CreatedAt: now.Add(-time.Minute)},
+			},
+		},
+		{
+			name: "do not destroy inactive workspace again",
+			workspaces: []*workspace.Workspace{
+				{ID: "ws-1", AutoDestroyActivityDuration: internal.String("2h"), AutoDestroyRunID: internal.String("run-1"), LatestRun: &workspace.LatestRun{ID: "run-1", Status: "applied"}},
+			},
+			runs: map[string]*Run{
+				"run-1": {ID: "run-1", WorkspaceID: "ws-1", Source: SourceAutoDestroy, Status: RunApplied, CreatedAt: now.Add(-time.Hour)},
+			},
+		},
+		{
+			name: "do not destroy inactive workspace again after automated runs",
+			workspaces: []*workspace.Workspace{
+				{ID: "ws-1", AutoDestroyActivityDuration: internal.String("2h"), AutoDestroyRunID: internal.String("run-1"), LatestRun: &workspace.LatestRun{ID: "run-2", Status: "planned_and_finished"}},
+			},
+			runs: map[string]*Run{
+				"run-1": {ID: "run-1", WorkspaceID: "ws-1", Source: SourceAutoDestroy, Status: RunApplied, CreatedAt: now.Add(-time.Hour)},
+				"run-2": {ID: "run-2", WorkspaceID: "ws-1", Source: SourceHealthAssessment, CreatedAt: now.Add(-time.Minute)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runs := &fakeAutoDestroyerRunClient{runs: tt.runs}
+			workspaces := &fakeAutoDestroyerWorkspaceClient{workspaces: tt.workspaces}
+			destroyer := &AutoDestroyer{
+				Logger:     slog.Default(),
+				Workspaces: workspaces,
+				Runs:       runs,
+			}
+
+			destroyer.checkAll(ctx)
+
+			assert.Equal(t, tt.wantDestroyed, runs.created)
+			for _, opts := range runs.opts {
+				assert.Equal(t, SourceAutoDestroy, opts.Source)
+				assert.True(t, *opts.IsDestroy)
+				assert.True(t, *opts.AutoApply)
+			}
+			// each destroy run is recorded
+			assert.Equal(t, tt.wantDestroyed, workspaces.destroyed)
+			assert.Equal(t, tt.wantDeleted, workspaces.deleted)
+			assert.Equal(t, tt.wantCleared, workspaces.cleared)
+		})
+	}
+}
+
+type fakeAutoDestroyerRunClient struct {
+	runs    map[string]*Run
+	created []string
+	opts    []CreateOptions
+}
+
+func (f *fakeAutoDestroyerRunClient) Create(ctx context.Context, workspaceID string, opts CreateOptions) (*Run, error) {
+	f.created = append(f.created, workspaceID)
+	f.opts = append(f.opts, opts)
+	return &Run{ID: "run-" + workspaceID, WorkspaceID: workspaceID}, nil
+}
+
+func (f *fakeAutoDestroyerRunClient) Get(ctx context.Context, runID string) (*Run, error) {
+	if run, ok := f.runs[runID]; ok {
+		return run, nil
+	}
+	return nil, internal.ErrResourceNotFound
+}
+
+func (f *fakeAutoDestroyerRunClient) List(ctx context.Context, opts ListOptions) (*resource.Page[*Run], error) {
+	var runs []*Run
+	for _, run := range f.runs {
+		if run.WorkspaceID == *opts.WorkspaceID && slices.Contains(opts.Sources, run.Source) {
+			runs = append(runs, run)
+		}
+	}
+	// most recent first
+	slices.SortFunc(runs, func(a, b *Run) int { return b.CreatedAt.Compare(a.CreatedAt) })
+	return resource.NewPage(runs, opts.PageOptions, nil), nil
+}
+
+type fakeAutoDestroyerWorkspaceClient struct {
+	workspaces []*workspace.Workspace
+	destroyed  []string
+	deleted    []string
+	cleared    []string
+}
+
+func (f *fakeAutoDestroyerWorkspaceClient) ListForAutoDestroy(ctx context.Context) ([]*workspace.Workspace, error) {
+	return f.workspaces, nil
+}
+
+func (f *fakeAutoDestroyerWorkspaceClient) SetAutoDestroyRun(ctx context.Context, workspaceID, runID string) (*workspace.Workspace, error) {
+	f.destroyed = append(f.destroyed, workspaceID)
+	return &workspace.Workspace{ID: workspaceID, AutoDestroyRunID: &runID}, nil
+}
+
+func (f *fakeAutoDestroyerWorkspaceClient) Update(ctx context.Context, workspaceID string, opts workspace.UpdateOptions) (*workspace.Workspace, error) {
+	if opts.ClearAutoDestroyAt {
+		f.cleared = append(f.cleared, workspaceID)
+	}
+	return &workspace.Workspace{ID: workspaceID}, nil
+}
+
+func (f *fakeAutoDestroyerWorkspaceClient) Delete(ctx context.Context, workspaceID string) (*workspace.Workspace, error) {
+	f.deleted = append(f.deleted, workspaceID)
+	return &workspace.Workspace{ID: workspaceID}, nil
+}
@@ -3,6 +3,7 @@ package run
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 
@@ -30,6 +31,25 @@ func (c *Client) GetPlanFile(ctx context.Context, runID string, format PlanForma
 	return buf.Bytes(), nil
 }
 
+func (c *Client) GetPlanChanges(ctx context.Context, runID string) (*PlanChanges, error) {
+	u := fmt.Sprintf("runs/%s/plan-changes", url.QueryEscape(runID))
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.Buffer{}
+	if err := c.Do(ctx, req, &buf); err != nil {
+		return nil, err
+	}
+
+	var changes PlanChanges
+	if err := json.Unmarshal(buf.Bytes(), &changes); err != nil {
+		return nil, err
+	}
+	return &changes, nil
+}
+
 func (c *Client) UploadPlanFile(ctx context.Context, runID string, plan []byte, format PlanFormat) error {
 	u := fmt.Sprintf("runs/%s/planfile", url.QueryEscape(runID))
 	req, err := c.NewRequest("PUT", u, plan)
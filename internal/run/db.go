@@ -2,13 +2,17 @@ package run
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/blob"
 	"github.com/tofutf/tofutf/internal/configversion"
+	"github.com/tofutf/tofutf/internal/encryption"
+	"github.com/tofutf/tofutf/internal/releases"
 	"github.com/tofutf/tofutf/internal/resource"
 	"github.com/tofutf/tofutf/internal/sql"
 	"github.com/tofutf/tofutf/internal/sql/pggen"
@@ -19,6 +23,9 @@ type (
 	// pgdb is a database of runs on postgres
 	pgdb struct {
 		*sql.Pool // provides access to generated SQL queries
+
+		blobs   blob.Store          // optional store for plan files and logs
+		keyring *encryption.Keyring // encrypts plan files
 	}
 
 	// pgresult is the result of a database query for a run.
@@ -46,6 +53,7 @@ type (
 		CreatedBy              pgtype.Text                    `json:"created_by"`
 		TerraformVersion       pgtype.Text                    `json:"terraform_version"`
 		AllowEmptyApply        pgtype.Bool                    `json:"allow_empty_apply"`
+		Engine                 pgtype.Text                    `json:"engine"`
 		ExecutionMode          pgtype.Text                    `json:"execution_mode"`
 		Latest                 pgtype.Bool                    `json:"latest"`
 		OrganizationName       pgtype.Text                    `json:"organization_name"`
@@ -74,6 +82,7 @@ func (result pgresult) toRun() *Run {
 		PlanOnly:               result.PlanOnly.Bool,
 		AllowEmptyApply:        result.AllowEmptyApply.Bool,
 		TerraformVersion:       result.TerraformVersion.String,
+		Engine:                 releases.Engine(result.Engine.String),
 		ExecutionMode:          workspace.ExecutionMode(result.ExecutionMode.String),
 		Latest:                 result.Latest.Bool,
 		Organization:           result.OrganizationName.String,
@@ -166,6 +175,7 @@ func (db *pgdb) CreateRun(ctx context.Context, run *Run) error {
 			PlanOnly:               sql.Bool(run.PlanOnly),
 			AllowEmptyApply:        sql.Bool(run.AllowEmptyApply),
 			TerraformVersion:       sql.String(run.TerraformVersion),
+			Engine:                 sql.String(string(run.Engine)),
 			ConfigurationVersionID: sql.String(run.ConfigurationVersionID),
 			WorkspaceID:            sql.String(run.WorkspaceID),
 			CreatedBy:              sql.StringPtr(run.CreatedBy),
@@ -386,6 +396,14 @@ func (db *pgdb) GetRun(ctx context.Context, runID string) (*Run, error) {
 // SetPlanFile writes a plan file to the db
 func (db *pgdb) SetPlanFile(ctx context.Context, runID string, file []byte, format PlanFormat) error {
 	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		file, err := db.keyring.Encrypt(file)
+		if err != nil {
+			return fmt.Errorf("encrypting plan file: %w", err)
+		}
+		file, err = blob.Offload(ctx, db.blobs, blob.PlanKey(runID, string(format)), file)
+		if err != nil {
+			return err
+		}
 		switch format {
 		case PlanFormatBinary:
 			_, err := q.UpdatePlanBinByID(ctx, file, sql.String(runID))
@@ -402,14 +420,26 @@ func (db *pgdb) SetPlanFile(ctx context.Context, runID string, file []byte, form
 // GetPlanFile retrieves a plan file for the run
 func (db *pgdb) GetPlanFile(ctx context.Context, runID string, format PlanFormat) ([]byte, error) {
 	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]byte, error) {
+		var (
+			file []byte
+			err  error
+		)
 		switch format {
 		case PlanFormatBinary:
-			return q.GetPlanBinByID(ctx, sql.String(runID))
+			file, err = q.GetPlanBinByID(ctx, sql.String(runID))
 		case PlanFormatJSON:
-			return q.GetPlanJSONByID(ctx, sql.String(runID))
+			file, err = q.GetPlanJSONByID(ctx, sql.String(runID))
 		default:
 			return nil, fmt.Errorf("unknown plan format: %s", string(format))
 		}
+		if err != nil {
+			return nil, err
+		}
+		file, err = blob.Load(ctx, db.blobs, blob.PlanKey(runID, string(format)), file)
+		if err != nil {
+			return nil, err
+		}
+		return db.keyring.Decrypt(file)
 	})
 }
 
@@ -470,16 +500,123 @@ func (db *pgdb) insertPhaseStatusTimestamp(ctx context.Context, phase Phase) err
 
 func (db *pgdb) findLogs(ctx context.Context, runID string, phase internal.PhaseType) ([]byte, error) {
 	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]byte, error) {
-		data, err := q.FindLogs(ctx, sql.String(runID), sql.String(string(phase)))
+		rows, err := q.FindLogChunks(ctx, sql.String(runID), sql.String(string(phase)))
 		if err != nil {
-			// Don't consider no rows an error because logs may not have been
-			// uploaded yet.
-			if sql.NoRowsInResultError(err) {
-				return nil, nil
+			return nil, sql.Error(err)
+		}
+		// logs may not have been uploaded yet, in which case nil is returned.
+		var data []byte
+		for _, row := range rows {
+			chunk, err := blob.Load(ctx, db.blobs, blob.LogChunkKey(runID, string(phase), int(row.ChunkID.Int32)), row.Chunk)
+			if err != nil {
+				return nil, err
 			}
+			data = append(data, chunk...)
+		}
+		return data, nil
+	})
+}
+
+// policyCheckRow is the result of a database query for a policy check.
+type policyCheckRow struct {
+	PolicyCheckID pgtype.Text        `json:"policy_check_id"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	Status        pgtype.Text        `json:"status"`
+	Results       []byte             `json:"results"`
+	OverriddenBy  pgtype.Text        `json:"overridden_by"`
+	RunID         pgtype.Text        `json:"run_id"`
+}
+
+func (row policyCheckRow) toPolicyCheck() (*PolicyCheck, error) {
+	check := &PolicyCheck{
+		ID:        row.PolicyCheckID.String,
+		CreatedAt: row.CreatedAt.Time.UTC(),
+		Status:    PolicyCheckStatus(row.Status.String),
+		RunID:     row.RunID.String,
+	}
+	if err := json.Unmarshal(row.Results, &check.Results); err != nil {
+		return nil, err
+	}
+	if row.OverriddenBy.Valid {
+		check.OverriddenBy = &row.OverriddenBy.String
+	}
+	return check, nil
+}
+
+func (db *pgdb) createPolicyCheck(ctx context.Context, check *PolicyCheck) error {
+	results, err := json.Marshal(check.Results)
+	if err != nil {
+		return err
+	}
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.InsertPolicyCheck(ctx, pggen.InsertPolicyCheckParams{
+			PolicyCheckID: sql.String(check.ID),
+			CreatedAt:     sql.Timestamptz(check.CreatedAt),
+			Status:        sql.String(string(check.Status)),
+			Results:       results,
+			RunID:         sql.String(check.RunID),
+		})
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) getPolicyCheck(ctx context.Context, checkID string) (*PolicyCheck, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*PolicyCheck, error) {
+		row, err := q.FindPolicyCheck(ctx, sql.String(checkID))
+		if err != nil {
 			return nil, sql.Error(err)
 		}
+		return policyCheckRow(row).toPolicyCheck()
+	})
+}
 
-		return data, nil
+func (db *pgdb) getPolicyCheckByRunID(ctx context.Context, runID string) (*PolicyCheck, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*PolicyCheck, error) {
+		row, err := q.FindPolicyCheckByRunID(ctx, sql.String(runID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return policyCheckRow(row).toPolicyCheck()
+	})
+}
+
+func (db *pgdb) updatePolicyCheckStatus(ctx context.Context, check *PolicyCheck) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.UpdatePolicyCheckStatus(ctx, pggen.UpdatePolicyCheckStatusParams{
+			Status:        sql.String(string(check.Status)),
+			OverriddenBy:  sql.StringPtr(check.OverriddenBy),
+			PolicyCheckID: sql.String(check.ID),
+		})
+		return sql.Error(err)
+	})
+}
+
+// getPullRequestCommentID retrieves the ID of the comment the reporter
+// maintains on a pull request for a workspace.
+func (db *pgdb) getPullRequestCommentID(ctx context.Context, workspaceID, repo string, pull int) (string, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (string, error) {
+		id, err := q.FindPullRequestCommentID(ctx, pggen.FindPullRequestCommentIDParams{
+			WorkspaceID:       sql.String(workspaceID),
+			Repo:              sql.String(repo),
+			PullRequestNumber: sql.Int4(pull),
+		})
+		if err != nil {
+			return "", sql.Error(err)
+		}
+		return id.String, nil
+	})
+}
+
+// setPullRequestCommentID persists the ID of the comment the reporter
+// maintains on a pull request for a workspace.
+func (db *pgdb) setPullRequestCommentID(ctx context.Context, workspaceID, repo string, pull int, commentID string) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.UpsertPullRequestComment(ctx, pggen.UpsertPullRequestCommentParams{
+			WorkspaceID:       sql.String(workspaceID),
+			Repo:              sql.String(repo),
+			PullRequestNumber: sql.Int4(pull),
+			CommentID:         sql.String(commentID),
+		})
+		return sql.Error(err)
 	})
 }
@@ -3,9 +3,10 @@ package run
 import "errors"
 
 var (
-	ErrRunDiscardNotAllowed     = errors.New("run was not paused for confirmation or priority; discard not allowed")
-	ErrRunCancelNotAllowed      = errors.New("run was not planning or applying; cancel not allowed")
-	ErrRunForceCancelNotAllowed = errors.New("run was not planning or applying, has not been canceled non-forcefully, or the cool-off period has not yet passed")
+	ErrRunDiscardNotAllowed          = errors.New("run was not paused for confirmation or priority; discard not allowed")
+	ErrRunCancelNotAllowed           = errors.New("run was not planning or applying; cancel not allowed")
+	ErrRunForceCancelNotAllowed      = errors.New("run was not planning or applying, has not been canceled non-forcefully, or the cool-off period has not yet passed")
+	ErrPolicyCheckOverrideNotAllowed = errors.New("policy check did not soft fail; override not allowed")
 	//
 	ErrPhaseAlreadyStarted = errors.New("phase already started")
 )
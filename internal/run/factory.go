@@ -42,7 +42,7 @@ type (
 	}
 
 	factoryReleasesClient interface {
-		GetLatest(ctx context.Context) (string, time.Time, error)
+		GetLatest(ctx context.Context, engine releases.Engine) (string, time.Time, error)
 	}
 )
 
@@ -58,9 +58,9 @@ func (f *factory) NewRun(ctx context.Context, workspaceID string, opts CreateOpt
 	}
 
 	if ws.TerraformVersion == releases.LatestVersionString {
-		ws.TerraformVersion, _, err = f.releases.GetLatest(ctx)
+		ws.TerraformVersion, _, err = f.releases.GetLatest(ctx, ws.Engine)
 		if err != nil {
-			return nil, fmt.Errorf("failed to retrieve terraform version: %w", err)
+			return nil, fmt.Errorf("failed to retrieve %s version: %w", ws.Engine, err)
 		}
 	}
 
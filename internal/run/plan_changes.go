@@ -0,0 +1,349 @@
+package run
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	NoOpAction    ChangeAction = "no-op"
+	ReadAction    ChangeAction = "read"
+	ReplaceAction ChangeAction = "replace"
+
+	sensitiveValue  = "(sensitive value)"
+	knownAfterApply = "(known after apply)"
+)
+
+// identifierRegex matches object keys that can be referenced using dot
+// notation in an attribute path.
+var identifierRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+type (
+	// PlanChanges is a normalized list of the changes proposed in a plan,
+	// parsed from its JSON representation. Sensitive values are masked.
+	PlanChanges struct {
+		Resources []PlannedResourceChange `json:"resources"`
+		Outputs   []PlannedOutputChange   `json:"outputs"`
+	}
+
+	// PlannedResourceChange is a proposed change to a resource.
+	PlannedResourceChange struct {
+		Address         string       `json:"address"`
+		PreviousAddress string       `json:"previous_address,omitempty"`
+		ModuleAddress   string       `json:"module_address,omitempty"`
+		Mode            string       `json:"mode"`
+		Type            string       `json:"type"`
+		Name            string       `json:"name"`
+		ProviderName    string       `json:"provider_name"`
+		Action          ChangeAction `json:"action"`
+		// ActionReason is why the action was chosen, e.g.
+		// replace_because_tainted.
+		ActionReason string `json:"action_reason,omitempty"`
+		// ReplacePaths are paths of the attributes that force replacement.
+		ReplacePaths []string `json:"replace_paths,omitempty"`
+		// Attributes lists the attributes that are changing.
+		Attributes []AttributeChange `json:"attributes"`
+	}
+
+	// AttributeChange is a proposed change to an attribute of a resource.
+	AttributeChange struct {
+		Path string `json:"path"`
+		ValueChange
+		// ForcesReplacement is true if the change forces replacement of the
+		// resource.
+		ForcesReplacement bool `json:"forces_replacement,omitempty"`
+	}
+
+	// PlannedOutputChange is a proposed change to an output.
+	PlannedOutputChange struct {
+		Name   string       `json:"name"`
+		Action ChangeAction `json:"action"`
+		ValueChange
+	}
+
+	// ValueChange is the before and after values of a change. A sensitive
+	// value is omitted, as is an after value that is unknown until apply.
+	ValueChange struct {
+		Before          any  `json:"before"`
+		After           any  `json:"after"`
+		BeforeSensitive bool `json:"before_sensitive,omitempty"`
+		AfterSensitive  bool `json:"after_sensitive,omitempty"`
+		AfterUnknown    bool `json:"after_unknown,omitempty"`
+	}
+
+	// planJSON is the subset of the schema of a JSON plan from which changes
+	// are parsed.
+	planJSON struct {
+		ResourceChanges []resourceChangeJSON  `json:"resource_changes"`
+		ResourceDrift   []resourceChangeJSON  `json:"resource_drift"`
+		OutputChanges   map[string]changeJSON `json:"output_changes"`
+	}
+
+	resourceChangeJSON struct {
+		Address         string     `json:"address"`
+		PreviousAddress string     `json:"previous_address"`
+		ModuleAddress   string     `json:"module_address"`
+		Mode            string     `json:"mode"`
+		Type            string     `json:"type"`
+		Name            string     `json:"name"`
+		ProviderName    string     `json:"provider_name"`
+		ActionReason    string     `json:"action_reason"`
+		Change          changeJSON `json:"change"`
+	}
+
+	changeJSON struct {
+		Actions         []ChangeAction `json:"actions"`
+		Before          any            `json:"before"`
+		After           any            `json:"after"`
+		AfterUnknown    any            `json:"after_unknown"`
+		BeforeSensitive any            `json:"before_sensitive"`
+		AfterSensitive  any            `json:"after_sensitive"`
+		ReplacePaths    [][]any        `json:"replace_paths"`
+	}
+)
+
+// ParsePlanChanges parses the changes proposed in a JSON representation of a
+// plan file. Resources and outputs that are not changing are omitted. If
+// refreshOnly is true then the resource changes are instead the drift
+// detected.
+func ParsePlanChanges(data []byte, refreshOnly bool) (*PlanChanges, error) {
+	var plan planJSON
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, err
+	}
+	resources := plan.ResourceChanges
+	if refreshOnly {
+		resources = plan.ResourceDrift
+	}
+	changes := PlanChanges{
+		Resources: []PlannedResourceChange{},
+		Outputs:   []PlannedOutputChange{},
+	}
+	for _, rc := range resources {
+		action := normalizeActions(rc.Change.Actions)
+		if action == NoOpAction {
+			continue
+		}
+		resource := PlannedResourceChange{
+			Address:         rc.Address,
+			PreviousAddress: rc.PreviousAddress,
+			ModuleAddress:   rc.ModuleAddress,
+			Mode:            rc.Mode,
+			Type:            rc.Type,
+			Name:            rc.Name,
+			ProviderName:    rc.ProviderName,
+			Action:          action,
+			ActionReason:    rc.ActionReason,
+			Attributes:      []AttributeChange{},
+		}
+		for _, steps := range rc.Change.ReplacePaths {
+			resource.ReplacePaths = append(resource.ReplacePaths, formatPath(steps))
+		}
+		diffAttributes(&resource, "", rc.Change.Before, rc.Change.After, rc.Change.BeforeSensitive, rc.Change.AfterSensitive, rc.Change.AfterUnknown)
+		changes.Resources = append(changes.Resources, resource)
+	}
+	for name, oc := range plan.OutputChanges {
+		action := normalizeActions(oc.Actions)
+		if action == NoOpAction {
+			continue
+		}
+		changes.Outputs = append(changes.Outputs, PlannedOutputChange{
+			Name:        name,
+			Action:      action,
+			ValueChange: newValueChange(oc.Before, oc.After, oc.BeforeSensitive, oc.AfterSensitive, oc.AfterUnknown),
+		})
+	}
+	sort.Slice(changes.Outputs, func(i, j int) bool {
+		return changes.Outputs[i].Name < changes.Outputs[j].Name
+	})
+	return &changes, nil
+}
+
+// normalizeActions reduces the actions of a change to a single action, with
+// the combination of a delete and a create normalized to a replacement.
+func normalizeActions(actions []ChangeAction) ChangeAction {
+	switch {
+	case len(actions) == 0:
+		return NoOpAction
+	case slices.Contains(actions, CreateAction) && slices.Contains(actions, DeleteAction):
+		return ReplaceAction
+	default:
+		return actions[0]
+	}
+}
+
+// diffAttributes walks the before and after values of a resource, adding an
+// attribute change for each leaf value that differs. The sensitive and unknown
+// values mirror the structure of the before and after values, with true
+// marking a value, and any values nested within it, as sensitive or unknown.
+func diffAttributes(resource *PlannedResourceChange, path string, before, after, beforeSensitive, afterSensitive, afterUnknown any) {
+	if !isMarked(beforeSensitive) && !isMarked(afterSensitive) && !isMarked(afterUnknown) {
+		beforeMap, beforeIsMap := before.(map[string]any)
+		afterMap, afterIsMap := after.(map[string]any)
+		if (beforeIsMap || before == nil) && (afterIsMap || after == nil) && (beforeIsMap || afterIsMap) {
+			// union of keys, including keys whose values are unknown and
+			// therefore absent from the after value.
+			keys := make(map[string]struct{})
+			for k := range beforeMap {
+				keys[k] = struct{}{}
+			}
+			for k := range afterMap {
+				keys[k] = struct{}{}
+			}
+			if unknown, ok := afterUnknown.(map[string]any); ok {
+				for k := range unknown {
+					keys[k] = struct{}{}
+				}
+			}
+			sorted := make([]string, 0, len(keys))
+			for k := range keys {
+				sorted = append(sorted, k)
+			}
+			sort.Strings(sorted)
+			for _, k := range sorted {
+				diffAttributes(resource, joinPath(path, k), beforeMap[k], afterMap[k], index(beforeSensitive, k), index(afterSensitive, k), index(afterUnknown, k))
+			}
+			return
+		}
+		beforeList, beforeIsList := before.([]any)
+		afterList, afterIsList := after.([]any)
+		if (beforeIsList || before == nil) && (afterIsList || after == nil) && (beforeIsList || afterIsList) {
+			n := max(len(beforeList), len(afterList))
+			if unknown, ok := afterUnknown.([]any); ok {
+				n = max(n, len(unknown))
+			}
+			for i := range n {
+				diffAttributes(resource, fmt.Sprintf("%s[%d]", path, i), elem(beforeList, i), elem(afterList, i), index(beforeSensitive, i), index(afterSensitive, i), index(afterUnknown, i))
+			}
+			return
+		}
+	}
+	// leaf value
+	if !isMarked(afterUnknown) && isMarked(beforeSensitive) == isMarked(afterSensitive) && reflect.DeepEqual(before, after) {
+		return
+	}
+	resource.Attributes = append(resource.Attributes, AttributeChange{
+		Path:              path,
+		ValueChange:       newValueChange(before, after, beforeSensitive, afterSensitive, afterUnknown),
+		ForcesReplacement: forcesReplacement(resource.ReplacePaths, path),
+	})
+}
+
+// forcesReplacement determines whether the attribute at the given path is, or
+// is nested within, one of the replace paths.
+func forcesReplacement(replacePaths []string, path string) bool {
+	for _, rp := range replacePaths {
+		if path == rp || strings.HasPrefix(path, rp+".") || strings.HasPrefix(path, rp+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+func newValueChange(before, after, beforeSensitive, afterSensitive, afterUnknown any) ValueChange {
+	change := ValueChange{
+		Before:          before,
+		After:           after,
+		BeforeSensitive: isMarked(beforeSensitive),
+		AfterSensitive:  isMarked(afterSensitive),
+		AfterUnknown:    isMarked(afterUnknown),
+	}
+	if change.BeforeSensitive {
+		change.Before = nil
+	}
+	if change.AfterSensitive || change.AfterUnknown {
+		change.After = nil
+	}
+	return change
+}
+
+// BeforeString formats the before value for display.
+func (c ValueChange) BeforeString() string {
+	if c.BeforeSensitive {
+		return sensitiveValue
+	}
+	return formatValue(c.Before)
+}
+
+// AfterString formats the after value for display.
+func (c ValueChange) AfterString() string {
+	switch {
+	case c.AfterUnknown:
+		return knownAfterApply
+	case c.AfterSensitive:
+		return sensitiveValue
+	default:
+		return formatValue(c.After)
+	}
+}
+
+func formatValue(v any) string {
+	if v == nil {
+		return "null"
+	}
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(encoded)
+}
+
+// isMarked determines whether a sensitive or unknown value marks the value
+// to which it corresponds.
+func isMarked(v any) bool {
+	marked, ok := v.(bool)
+	return ok && marked
+}
+
+// index retrieves the value at the given key or index of a map or list,
+// returning nil if v is neither or the element does not exist.
+func index(v any, key any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		if k, ok := key.(string); ok {
+			return v[k]
+		}
+	case []any:
+		if i, ok := key.(int); ok {
+			return elem(v, i)
+		}
+	}
+	return nil
+}
+
+func elem(list []any, i int) any {
+	if i < len(list) {
+		return list[i]
+	}
+	return nil
+}
+
+func joinPath(path, key string) string {
+	if !identifierRegex.MatchString(key) {
+		return fmt.Sprintf("%s[%s]", path, strconv.Quote(key))
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// formatPath formats a path of steps as found in the replace_paths of a JSON
+// plan, where a step is either a string key or a numeric index.
+func formatPath(steps []any) (path string) {
+	for _, step := range steps {
+		switch step := step.(type) {
+		case string:
+			path = joinPath(path, step)
+		case float64:
+			path = fmt.Sprintf("%s[%d]", path, int(step))
+		}
+	}
+	return path
+}
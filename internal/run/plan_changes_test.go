@@ -0,0 +1,98 @@
+package run
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePlanChanges(t *testing.T) {
+	data, err := os.ReadFile("testdata/plan_changes.json")
+	require.NoError(t, err)
+
+	got, err := ParsePlanChanges(data, false)
+	require.NoError(t, err)
+
+	want := &PlanChanges{
+		Resources: []PlannedResourceChange{
+			{
+				Address:      "aws_instance.web",
+				Mode:         "managed",
+				Type:         "aws_instance",
+				Name:         "web",
+				ProviderName: "registry.terraform.io/hashicorp/aws",
+				Action:       UpdateAction,
+				Attributes: []AttributeChange{
+					{Path: "instance_type", ValueChange: ValueChange{Before: "t2.micro", After: "t3.micro"}},
+					{Path: "tags.env", ValueChange: ValueChange{Before: "dev", After: "prod"}},
+					{Path: "user_data", ValueChange: ValueChange{BeforeSensitive: true, AfterSensitive: true}},
+				},
+			},
+			{
+				Address:      "aws_instance.db",
+				Mode:         "managed",
+				Type:         "aws_instance",
+				Name:         "db",
+				ProviderName: "registry.terraform.io/hashicorp/aws",
+				Action:       ReplaceAction,
+				ActionReason: "replace_because_cannot_update",
+				ReplacePaths: []string{"ami", "ebs_block_device[0].volume_size"},
+				Attributes: []AttributeChange{
+					{Path: "ami", ValueChange: ValueChange{Before: "ami-old", After: "ami-new"}, ForcesReplacement: true},
+					{Path: "ebs_block_device[0].volume_size", ValueChange: ValueChange{Before: float64(10), After: float64(20)}, ForcesReplacement: true},
+					{Path: "id", ValueChange: ValueChange{Before: "i-456", AfterUnknown: true}},
+				},
+			},
+		},
+		Outputs: []PlannedOutputChange{
+			{Name: "instance_type", Action: UpdateAction, ValueChange: ValueChange{Before: "t2.micro", After: "t3.micro"}},
+			{Name: "password", Action: UpdateAction, ValueChange: ValueChange{BeforeSensitive: true, AfterSensitive: true}},
+		},
+	}
+	assert.Equal(t, want, got)
+
+	t.Run("format values", func(t *testing.T) {
+		attrs := got.Resources[1].Attributes
+		assert.Equal(t, `"ami-old"`, attrs[0].BeforeString())
+		assert.Equal(t, "20", attrs[1].AfterString())
+		assert.Equal(t, "(known after apply)", attrs[2].AfterString())
+		assert.Equal(t, "(sensitive value)", got.Outputs[1].BeforeString())
+	})
+}
+
+func TestParsePlanChanges_Create(t *testing.T) {
+	data, err := os.ReadFile("testdata/plan.json")
+	require.NoError(t, err)
+
+	got, err := ParsePlanChanges(data, false)
+	require.NoError(t, err)
+
+	require.Len(t, got.Resources, 2)
+	assert.Equal(t, "module.random.random_id.test", got.Resources[0].Address)
+	assert.Equal(t, "module.random", got.Resources[0].ModuleAddress)
+	assert.Equal(t, CreateAction, got.Resources[0].Action)
+	assert.Equal(t, []AttributeChange{
+		{Path: "b64_std", ValueChange: ValueChange{AfterUnknown: true}},
+		{Path: "b64_url", ValueChange: ValueChange{AfterUnknown: true}},
+		{Path: "byte_length", ValueChange: ValueChange{After: float64(2)}},
+		{Path: "dec", ValueChange: ValueChange{AfterUnknown: true}},
+		{Path: "hex", ValueChange: ValueChange{AfterUnknown: true}},
+		{Path: "id", ValueChange: ValueChange{AfterUnknown: true}},
+	}, got.Resources[0].Attributes)
+
+	require.Len(t, got.Outputs, 1)
+	assert.Equal(t, "random_string", got.Outputs[0].Name)
+	assert.True(t, got.Outputs[0].AfterUnknown)
+}
+
+func TestParsePlanChanges_RefreshOnly(t *testing.T) {
+	data, err := os.ReadFile("testdata/plan_refresh_only.json")
+	require.NoError(t, err)
+
+	got, err := ParsePlanChanges(data, true)
+	require.NoError(t, err)
+
+	assert.Len(t, got.Resources, 2)
+}
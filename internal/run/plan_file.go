@@ -15,6 +15,9 @@ type (
 	PlanFile struct {
 		ResourceChanges []ResourceChange  `json:"resource_changes"`
 		OutputChanges   map[string]Change `json:"output_changes"`
+		// ResourceDrift lists changes made to resources outside of terraform,
+		// detected when refreshing state.
+		ResourceDrift []ResourceChange `json:"resource_drift"`
 	}
 
 	// PlanFileOptions are options for the plan file API
@@ -24,7 +27,8 @@ type (
 
 	// ResourceChange represents a proposed change to a resource in a plan file
 	ResourceChange struct {
-		Change Change
+		Address string `json:"address"`
+		Change  Change
 	}
 
 	// Change represents the type of change being made
@@ -37,43 +41,58 @@ type (
 
 // Summarize provides a tally of the types of changes proposed in the plan file.
 func (pf *PlanFile) Summarize() (resource, output Report) {
-	for _, rc := range pf.ResourceChanges {
-		for _, action := range rc.Change.Actions {
-			switch action {
-			case CreateAction:
-				resource.Additions++
-			case UpdateAction:
-				resource.Changes++
-			case DeleteAction:
-				resource.Destructions++
-			}
-		}
+	return summarizeResources(pf.ResourceChanges), pf.summarizeOutputs()
+}
+
+// SummarizeDrift provides a tally of the types of changes made to resources
+// outside of terraform, along with the changes to outputs. Only a refresh-only
+// plan can be summarized in this way, because its resource changes are the
+// drift itself.
+func (pf *PlanFile) SummarizeDrift() (resource, output Report) {
+	return summarizeResources(pf.ResourceDrift), pf.summarizeOutputs()
+}
+
+func (pf *PlanFile) summarizeOutputs() (report Report) {
+	for _, oc := range pf.OutputChanges {
+		report.tally(oc.Actions)
 	}
-	for _, rc := range pf.OutputChanges {
-		for _, action := range rc.Actions {
-			switch action {
-			case CreateAction:
-				output.Additions++
-			case UpdateAction:
-				output.Changes++
-			case DeleteAction:
-				output.Destructions++
-			}
-		}
+	return report
+}
+
+func summarizeResources(changes []ResourceChange) (report Report) {
+	for _, rc := range changes {
+		report.tally(rc.Change.Actions)
 	}
+	return report
+}
 
-	return
+func (r *Report) tally(actions []ChangeAction) {
+	for _, action := range actions {
+		switch action {
+		case CreateAction:
+			r.Additions++
+		case UpdateAction:
+			r.Changes++
+		case DeleteAction:
+			r.Destructions++
+		}
+	}
 }
 
 // CompilePlanReports compiles reports of planned changes from a JSON
 // representation of a plan file: one report for planned *resources*, and
-// another for planned *outputs*.
-func CompilePlanReports(planJSON []byte) (resources Report, outputs Report, err error) {
+// another for planned *outputs*. If refreshOnly is true then the report for
+// resources is instead a report of the drift detected.
+func CompilePlanReports(planJSON []byte, refreshOnly bool) (resources Report, outputs Report, err error) {
 	planFile := PlanFile{}
 	if err := json.Unmarshal(planJSON, &planFile); err != nil {
 		return Report{}, Report{}, err
 	}
 
-	resources, outputs = planFile.Summarize()
+	if refreshOnly {
+		resources, outputs = planFile.SummarizeDrift()
+	} else {
+		resources, outputs = planFile.Summarize()
+	}
 	return resources, outputs, nil
 }
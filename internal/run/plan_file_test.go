@@ -19,6 +19,7 @@ func TestPlanFile(t *testing.T) {
 	want := PlanFile{
 		ResourceChanges: []ResourceChange{
 			{
+				Address: "module.random.random_id.test",
 				Change: Change{
 					Actions: []ChangeAction{
 						CreateAction,
@@ -26,6 +27,7 @@ func TestPlanFile(t *testing.T) {
 				},
 			},
 			{
+				Address: "null_resource.example",
 				Change: Change{
 					Actions: []ChangeAction{
 						CreateAction,
@@ -61,3 +63,20 @@ func TestPlanFile_Changes(t *testing.T) {
 	assert.Equal(t, 0, outputReport.Changes)
 	assert.Equal(t, 0, outputReport.Destructions)
 }
+
+func TestCompilePlanReports_RefreshOnly(t *testing.T) {
+	data, err := os.ReadFile("testdata/plan_refresh_only.json")
+	require.NoError(t, err)
+
+	resourceReport, outputReport, err := CompilePlanReports(data, true)
+	require.NoError(t, err)
+
+	assert.Equal(t, Report{Changes: 1, Destructions: 1}, resourceReport)
+	assert.Equal(t, Report{}, outputReport)
+
+	// a normal plan ignores drift
+	resourceReport, _, err = CompilePlanReports(data, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, Report{}, resourceReport)
+}
@@ -0,0 +1,130 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/policy"
+)
+
+const (
+	// PolicyCheckPassed indicates no mandatory policies failed.
+	PolicyCheckPassed PolicyCheckStatus = "passed"
+	// PolicyCheckSoftFailed indicates one or more soft-mandatory policies
+	// failed, and no hard-mandatory policies failed.
+	PolicyCheckSoftFailed PolicyCheckStatus = "soft_failed"
+	// PolicyCheckHardFailed indicates one or more hard-mandatory policies
+	// failed.
+	PolicyCheckHardFailed PolicyCheckStatus = "hard_failed"
+	// PolicyCheckOverridden indicates a soft failure was overridden.
+	PolicyCheckOverridden PolicyCheckStatus = "overridden"
+)
+
+type (
+	// PolicyCheckStatus is the outcome of a policy check.
+	PolicyCheckStatus string
+
+	// PolicyCheck is the result of evaluating an organization's policies
+	// against the plan of a run.
+	PolicyCheck struct {
+		ID        string
+		CreatedAt time.Time
+		RunID     string
+		Status    PolicyCheckStatus
+		Results   []policy.Result
+		// OverriddenBy is the username of the user who overrode the check; nil
+		// if the check has not been overridden.
+		OverriddenBy *string
+	}
+
+	// policyEvaluator evaluates an organization's policies against a JSON
+	// plan.
+	policyEvaluator interface {
+		Evaluate(ctx context.Context, organization string, getPlan func(context.Context) ([]byte, error)) ([]policy.Result, error)
+	}
+)
+
+func newPolicyCheck(runID string, results []policy.Result) *PolicyCheck {
+	check := &PolicyCheck{
+		ID:        internal.NewID("polchk"),
+		CreatedAt: internal.CurrentTimestamp(nil),
+		RunID:     runID,
+		Status:    PolicyCheckPassed,
+		Results:   results,
+	}
+	for _, result := range results {
+		if result.Passed {
+			continue
+		}
+		switch result.EnforcementLevel {
+		case policy.HardMandatory:
+			check.Status = PolicyCheckHardFailed
+		case policy.SoftMandatory:
+			if check.Status != PolicyCheckHardFailed {
+				check.Status = PolicyCheckSoftFailed
+			}
+		}
+	}
+	return check
+}
+
+// Passed returns the number of policies that passed.
+func (c *PolicyCheck) Passed() int { return c.count(true, "") }
+
+// AdvisoryFailed returns the number of advisory policies that failed.
+func (c *PolicyCheck) AdvisoryFailed() int { return c.count(false, policy.Advisory) }
+
+// SoftFailed returns the number of soft-mandatory policies that failed.
+func (c *PolicyCheck) SoftFailed() int { return c.count(false, policy.SoftMandatory) }
+
+// HardFailed returns the number of hard-mandatory policies that failed.
+func (c *PolicyCheck) HardFailed() int { return c.count(false, policy.HardMandatory) }
+
+// Overridable determines whether the check can be overridden.
+func (c *PolicyCheck) Overridable() bool { return c.Status == PolicyCheckSoftFailed }
+
+// Output renders a human-readable summary of the results.
+func (c *PolicyCheck) Output() string {
+	var b strings.Builder
+	for _, result := range c.Results {
+		outcome := "passed"
+		if !result.Passed {
+			outcome = "failed"
+		}
+		fmt.Fprintf(&b, "%s/%s (%s): %s\n", result.PolicySet, result.Policy, result.EnforcementLevel, outcome)
+		if result.Error != "" {
+			fmt.Fprintf(&b, "  error: %s\n", result.Error)
+		}
+		for _, violation := range result.Violations {
+			fmt.Fprintf(&b, "  - %s\n", violation)
+		}
+	}
+	fmt.Fprintf(&b, "\n%d passed, %d advisory failed, %d soft failed, %d hard failed\n",
+		c.Passed(), c.AdvisoryFailed(), c.SoftFailed(), c.HardFailed())
+	return b.String()
+}
+
+func (c *PolicyCheck) count(passed bool, level policy.EnforcementLevel) (n int) {
+	for _, result := range c.Results {
+		if result.Passed != passed {
+			continue
+		}
+		if level != "" && result.EnforcementLevel != level {
+			continue
+		}
+		n++
+	}
+	return n
+}
+
+func (c *PolicyCheck) override(username string) error {
+	if !c.Overridable() {
+		return ErrPolicyCheckOverrideNotAllowed
+	}
+	c.Status = PolicyCheckOverridden
+	c.OverriddenBy = &username
+	return nil
+}
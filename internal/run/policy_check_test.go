@@ -0,0 +1,69 @@
+package run
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal/policy"
+)
+
+func TestNewPolicyCheck(t *testing.T) {
+	var (
+		passed         = policy.Result{EnforcementLevel: policy.HardMandatory, Passed: true}
+		advisoryFailed = policy.Result{EnforcementLevel: policy.Advisory}
+		softFailed     = policy.Result{EnforcementLevel: policy.SoftMandatory}
+		hardFailed     = policy.Result{EnforcementLevel: policy.HardMandatory}
+	)
+
+	tests := []struct {
+		name    string
+		results []policy.Result
+		want    PolicyCheckStatus
+	}{
+		{"passed", []policy.Result{passed}, PolicyCheckPassed},
+		{"advisory failure", []policy.Result{passed, advisoryFailed}, PolicyCheckPassed},
+		{"soft failure", []policy.Result{passed, advisoryFailed, softFailed}, PolicyCheckSoftFailed},
+		{"hard failure", []policy.Result{hardFailed, softFailed}, PolicyCheckHardFailed},
+		{"hard failure after soft failure", []policy.Result{softFailed, hardFailed}, PolicyCheckHardFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := newPolicyCheck("run-123", tt.results)
+			assert.Equal(t, tt.want, check.Status)
+		})
+	}
+}
+
+func TestPolicyCheck_Counts(t *testing.T) {
+	check := newPolicyCheck("run-123", []policy.Result{
+		{EnforcementLevel: policy.Advisory, Passed: true},
+		{EnforcementLevel: policy.HardMandatory, Passed: true},
+		{EnforcementLevel: policy.Advisory},
+		{EnforcementLevel: policy.SoftMandatory},
+		{EnforcementLevel: policy.SoftMandatory},
+	})
+
+	assert.Equal(t, 2, check.Passed())
+	assert.Equal(t, 1, check.AdvisoryFailed())
+	assert.Equal(t, 2, check.SoftFailed())
+	assert.Equal(t, 0, check.HardFailed())
+}
+
+func TestPolicyCheck_Override(t *testing.T) {
+	t.Run("soft failed", func(t *testing.T) {
+		check := &PolicyCheck{Status: PolicyCheckSoftFailed}
+
+		require.NoError(t, check.override("bobby"))
+
+		assert.Equal(t, PolicyCheckOverridden, check.Status)
+		assert.Equal(t, "bobby", *check.OverriddenBy)
+	})
+
+	t.Run("hard failed", func(t *testing.T) {
+		check := &PolicyCheck{Status: PolicyCheckHardFailed}
+
+		err := check.override("bobby")
+		assert.ErrorIs(t, err, ErrPolicyCheckOverrideNotAllowed)
+	})
+}
@@ -0,0 +1,68 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// pullRequestComment renders the body of the comment the reporter maintains
+// on a pull request, summarising the outcome of a speculative run.
+func pullRequestComment(workspace, commitSHA string, run *Run, changes []ResourceChange, runURL string) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "### Plan for workspace `%s`\n\n", workspace)
+	switch run.Status {
+	case RunPlannedAndFinished:
+		if run.Plan.ResourceReport != nil {
+			fmt.Fprintf(&b, "**Planned**: %s\n\n", run.Plan.ResourceReport)
+		} else {
+			b.WriteString("**Planned**\n\n")
+		}
+	default:
+		fmt.Fprintf(&b, "**Status**: %s\n\n", run.Status)
+	}
+
+	if rows := changedResources(changes); len(rows) > 0 {
+		b.WriteString("<details><summary>Changed resources</summary>\n\n")
+		b.WriteString("| Action | Resource |\n|-|-|\n")
+		for _, row := range rows {
+			fmt.Fprintf(&b, "| %s | `%s` |\n", row[0], row[1])
+		}
+		b.WriteString("\n</details>\n\n")
+	}
+
+	fmt.Fprintf(&b, "[View run](%s) for commit `%s`\n", runURL, commitSHA)
+	return b.String()
+}
+
+// changedResources returns the action and address of each resource to be
+// changed, skipping those resources that are only read or left unchanged.
+func changedResources(changes []ResourceChange) (rows [][2]string) {
+	for _, rc := range changes {
+		var action string
+		switch {
+		case slices.Contains(rc.Change.Actions, CreateAction) && slices.Contains(rc.Change.Actions, DeleteAction):
+			action = "replace"
+		case slices.Contains(rc.Change.Actions, CreateAction):
+			action = string(CreateAction)
+		case slices.Contains(rc.Change.Actions, UpdateAction):
+			action = string(UpdateAction)
+		case slices.Contains(rc.Change.Actions, DeleteAction):
+			action = string(DeleteAction)
+		default:
+			continue
+		}
+		rows = append(rows, [2]string{action, rc.Address})
+	}
+	return rows
+}
+
+func (s *Service) getPullRequestCommentID(ctx context.Context, workspaceID, repo string, pull int) (string, error) {
+	return s.db.getPullRequestCommentID(ctx, workspaceID, repo, pull)
+}
+
+func (s *Service) setPullRequestCommentID(ctx context.Context, workspaceID, repo string, pull int, commentID string) error {
+	return s.db.setPullRequestCommentID(ctx, workspaceID, repo, pull, commentID)
+}
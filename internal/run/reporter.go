@@ -2,6 +2,8 @@ package run
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -18,7 +20,8 @@ const ReporterLockID int64 = 179366396344335597
 
 type (
 	// Reporter reports back to VCS providers the current status of VCS-triggered
-	// runs.
+	// runs. For speculative runs triggered by a pull request, it also maintains
+	// a comment on the pull request summarising the plan.
 	Reporter struct {
 		*internal.HostnameService
 
@@ -43,6 +46,10 @@ type (
 
 	reporterRunClient interface {
 		Watch(context.Context) (<-chan pubsub.Event[*Run], func())
+		GetPlanFile(ctx context.Context, runID string, format PlanFormat) ([]byte, error)
+
+		getPullRequestCommentID(ctx context.Context, workspaceID, repo string, pull int) (string, error)
+		setPullRequestCommentID(ctx context.Context, workspaceID, repo string, pull int, commentID string) error
 	}
 )
 
@@ -65,8 +72,9 @@ func (r *Reporter) Start(ctx context.Context) error {
 }
 
 func (r *Reporter) handleRun(ctx context.Context, run *Run) error {
-	// Skip runs triggered via the UI or API
-	if run.Source == SourceUI || run.Source == SourceAPI {
+	// Skip runs triggered via the UI, API, a run trigger, a schedule, or
+	// auto-destroy
+	if run.Source == SourceUI || run.Source == SourceAPI || run.Source == SourceRunTrigger || run.Source == SourceSchedule || run.Source == SourceAutoDestroy {
 		return nil
 	}
 
@@ -101,8 +109,11 @@ func (r *Reporter) handleRun(ctx context.Context, run *Run) error {
 	switch run.Status {
 	case RunPending, RunPlanQueued, RunApplyQueued:
 		status = vcs.PendingStatus
-	case RunPlanning, RunApplying, RunPlanned, RunConfirmed:
+	case RunPlanning, RunApplying, RunPlanned, RunConfirmed, RunPolicyChecked:
+		status = vcs.RunningStatus
+	case RunPolicySoftFailed:
 		status = vcs.RunningStatus
+		description = "policy check soft failed"
 	case RunPlannedAndFinished:
 		status = vcs.SuccessStatus
 		if run.Plan.ResourceReport != nil {
@@ -119,7 +130,7 @@ func (r *Reporter) handleRun(ctx context.Context, run *Run) error {
 	default:
 		return fmt.Errorf("unknown run status: %s", run.Status)
 	}
-	return client.SetStatus(ctx, vcs.SetStatusOptions{
+	err = client.SetStatus(ctx, vcs.SetStatusOptions{
 		Workspace:   ws.Name,
 		Ref:         cv.IngressAttributes.CommitSHA,
 		Repo:        cv.IngressAttributes.Repo,
@@ -127,4 +138,68 @@ func (r *Reporter) handleRun(ctx context.Context, run *Run) error {
 		Description: description,
 		TargetURL:   r.URL(paths.Run(run.ID)),
 	})
+	if err != nil {
+		return err
+	}
+
+	if run.PlanOnly && cv.IngressAttributes.IsPullRequest {
+		// Failing to comment is not fatal: the commit status already reports
+		// the outcome of the run.
+		if err := r.comment(ctx, client, run, ws, cv.IngressAttributes); err != nil {
+			r.Logger.Error("commenting on pull request", "run", run.ID, "pull", cv.IngressAttributes.PullRequestNumber, "err", err)
+		}
+	}
+	return nil
+}
+
+// comment creates or updates the comment on the pull request that triggered
+// the run, once the outcome of the run is known. There is only ever one such
+// comment per workspace on a pull request, which is updated with each
+// subsequent run.
+func (r *Reporter) comment(ctx context.Context, client vcs.Client, run *Run, ws *workspace.Workspace, attrs *configversion.IngressAttributes) error {
+	var changes []ResourceChange
+	switch run.Status {
+	case RunPlannedAndFinished:
+		file, err := r.Runs.GetPlanFile(ctx, run.ID, PlanFormatJSON)
+		if err != nil {
+			return fmt.Errorf("retrieving plan file: %w", err)
+		}
+		var planFile PlanFile
+		if err := json.Unmarshal(file, &planFile); err != nil {
+			return fmt.Errorf("parsing plan file: %w", err)
+		}
+		changes = planFile.ResourceChanges
+	case RunErrored, RunCanceled, RunForceCanceled:
+	default:
+		return nil
+	}
+	body := pullRequestComment(ws.Name, attrs.CommitSHA, run, changes, r.URL(paths.Run(run.ID)))
+
+	id, err := r.Runs.getPullRequestCommentID(ctx, ws.ID, attrs.Repo, attrs.PullRequestNumber)
+	if err == nil {
+		err = client.UpdatePullRequestComment(ctx, vcs.UpdatePullRequestCommentOptions{
+			Repo:              attrs.Repo,
+			PullRequestNumber: attrs.PullRequestNumber,
+			ID:                id,
+			Body:              body,
+		})
+		if !errors.Is(err, internal.ErrResourceNotFound) {
+			return err
+		}
+		// The comment has since been deleted, so fallback to creating a new
+		// comment.
+		r.Logger.Warn("pull request comment not found; creating new comment", "run", run.ID, "comment", id)
+	} else if !errors.Is(err, internal.ErrResourceNotFound) {
+		return err
+	}
+
+	id, err = client.CreatePullRequestComment(ctx, vcs.CreatePullRequestCommentOptions{
+		Repo:              attrs.Repo,
+		PullRequestNumber: attrs.PullRequestNumber,
+		Body:              body,
+	})
+	if err != nil {
+		return err
+	}
+	return r.Runs.setPullRequestCommentID(ctx, ws.ID, attrs.Repo, attrs.PullRequestNumber, id)
 }
@@ -2,6 +2,9 @@ package run
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -79,6 +82,139 @@ func TestReporter_HandleRun(t *testing.T) {
 	}
 }
 
+func TestReporter_Comment(t *testing.T) {
+	ctx := context.Background()
+	ws := &workspace.Workspace{
+		ID:         "ws-123",
+		Name:       "dev",
+		Connection: &workspace.Connection{},
+	}
+	cv := &configversion.ConfigurationVersion{
+		IngressAttributes: &configversion.IngressAttributes{
+			CommitSHA:         "abc123",
+			Repo:              "leg100/otf",
+			IsPullRequest:     true,
+			PullRequestNumber: 2,
+		},
+	}
+	planned := &Run{
+		ID:          "run-123",
+		WorkspaceID: "ws-123",
+		Status:      RunPlannedAndFinished,
+		PlanOnly:    true,
+		Plan:        Phase{ResourceReport: &Report{Additions: 1, Destructions: 1}},
+	}
+	planFile := []byte(`{"resource_changes": [
+		{"address": "random_pet.cat", "change": {"actions": ["create"]}},
+		{"address": "random_pet.dog", "change": {"actions": ["delete", "create"]}},
+		{"address": "random_pet.fish", "change": {"actions": ["no-op"]}}
+	]}`)
+
+	t.Run("create comment", func(t *testing.T) {
+		runs := &fakeReporterRunService{planFile: planFile, comments: map[string]string{}}
+		cloud := &fakeReporterCloudClient{got: &vcs.SetStatusOptions{}}
+		reporter := &Reporter{
+			Logger:          slog.Default(),
+			Workspaces:      &fakeReporterWorkspaceService{ws: ws},
+			Configs:         &fakeReporterConfigurationVersionService{cv: cv},
+			VCS:             &fakeReporterVCSProviderService{client: cloud},
+			Runs:            runs,
+			HostnameService: internal.NewHostnameService("otf-host.org"),
+		}
+		require.NoError(t, reporter.handleRun(ctx, planned))
+
+		require.Len(t, cloud.created, 1)
+		assert.Equal(t, 2, cloud.created[0].PullRequestNumber)
+		assert.Equal(t, "leg100/otf", cloud.created[0].Repo)
+		assert.Equal(t, "### Plan for workspace `dev`\n\n"+
+			"**Planned**: +1/~0/\u22121\n\n"+
+			"<details><summary>Changed resources</summary>\n\n"+
+			"| Action | Resource |\n|-|-|\n"+
+			"| create | `random_pet.cat` |\n"+
+			"| replace | `random_pet.dog` |\n"+
+			"\n</details>\n\n"+
+			"[View run](https://otf-host.org/app/runs/run-123) for commit `abc123`\n",
+			cloud.created[0].Body)
+		assert.Equal(t, "comment-1", runs.comments["ws-123/leg100/otf/2"])
+	})
+
+	t.Run("update existing comment", func(t *testing.T) {
+		runs := &fakeReporterRunService{planFile: planFile, comments: map[string]string{
+			"ws-123/leg100/otf/2": "comment-1",
+		}}
+		cloud := &fakeReporterCloudClient{got: &vcs.SetStatusOptions{}}
+		reporter := &Reporter{
+			Logger:          slog.Default(),
+			Workspaces:      &fakeReporterWorkspaceService{ws: ws},
+			Configs:         &fakeReporterConfigurationVersionService{cv: cv},
+			VCS:             &fakeReporterVCSProviderService{client: cloud},
+			Runs:            runs,
+			HostnameService: internal.NewHostnameService("otf-host.org"),
+		}
+		require.NoError(t, reporter.handleRun(ctx, planned))
+
+		assert.Empty(t, cloud.created)
+		require.Len(t, cloud.updated, 1)
+		assert.Equal(t, "comment-1", cloud.updated[0].ID)
+	})
+
+	t.Run("recreate deleted comment", func(t *testing.T) {
+		runs := &fakeReporterRunService{planFile: planFile, comments: map[string]string{
+			"ws-123/leg100/otf/2": "comment-1",
+		}}
+		cloud := &fakeReporterCloudClient{got: &vcs.SetStatusOptions{}, updateErr: internal.ErrResourceNotFound}
+		reporter := &Reporter{
+			Logger:          slog.Default(),
+			Workspaces:      &fakeReporterWorkspaceService{ws: ws},
+			Configs:         &fakeReporterConfigurationVersionService{cv: cv},
+			VCS:             &fakeReporterVCSProviderService{client: cloud},
+			Runs:            runs,
+			HostnameService: internal.NewHostnameService("otf-host.org"),
+		}
+		require.NoError(t, reporter.handleRun(ctx, planned))
+
+		require.Len(t, cloud.created, 1)
+		assert.Equal(t, "comment-1", runs.comments["ws-123/leg100/otf/2"])
+	})
+
+	t.Run("do not recreate comment on other errors", func(t *testing.T) {
+		runs := &fakeReporterRunService{planFile: planFile, comments: map[string]string{
+			"ws-123/leg100/otf/2": "comment-1",
+		}}
+		cloud := &fakeReporterCloudClient{got: &vcs.SetStatusOptions{}, updateErr: errors.New("service unavailable")}
+		reporter := &Reporter{
+			Logger:          slog.Default(),
+			Workspaces:      &fakeReporterWorkspaceService{ws: ws},
+			Configs:         &fakeReporterConfigurationVersionService{cv: cv},
+			VCS:             &fakeReporterVCSProviderService{client: cloud},
+			Runs:            runs,
+			HostnameService: internal.NewHostnameService("otf-host.org"),
+		}
+		// failing to comment is logged rather than failing the run report
+		require.NoError(t, reporter.handleRun(ctx, planned))
+
+		assert.Len(t, cloud.updated, 1)
+		assert.Empty(t, cloud.created)
+	})
+
+	t.Run("skip run in progress", func(t *testing.T) {
+		runs := &fakeReporterRunService{comments: map[string]string{}}
+		cloud := &fakeReporterCloudClient{got: &vcs.SetStatusOptions{}}
+		reporter := &Reporter{
+			Logger:          slog.Default(),
+			Workspaces:      &fakeReporterWorkspaceService{ws: ws},
+			Configs:         &fakeReporterConfigurationVersionService{cv: cv},
+			VCS:             &fakeReporterVCSProviderService{client: cloud},
+			Runs:            runs,
+			HostnameService: internal.NewHostnameService("otf-host.org"),
+		}
+		require.NoError(t, reporter.handleRun(ctx, &Run{ID: "run-123", Status: RunPlanning, PlanOnly: true}))
+
+		assert.Empty(t, cloud.created)
+		assert.Empty(t, cloud.updated)
+	})
+}
+
 type fakeReporterConfigurationVersionService struct {
 	configversion.Service
 
@@ -100,20 +236,63 @@ func (f *fakeReporterWorkspaceService) Get(context.Context, string) (*workspace.
 }
 
 type fakeReporterVCSProviderService struct {
-	got *vcs.SetStatusOptions
+	got    *vcs.SetStatusOptions
+	client *fakeReporterCloudClient
 }
 
 func (f *fakeReporterVCSProviderService) GetVCSClient(context.Context, string) (vcs.Client, error) {
+	if f.client != nil {
+		return f.client, nil
+	}
 	return &fakeReporterCloudClient{got: f.got}, nil
 }
 
 type fakeReporterCloudClient struct {
 	vcs.Client
 
-	got *vcs.SetStatusOptions
+	got     *vcs.SetStatusOptions
+	created []vcs.CreatePullRequestCommentOptions
+	updated []vcs.UpdatePullRequestCommentOptions
+	// error returned when updating a comment
+	updateErr error
 }
 
 func (f *fakeReporterCloudClient) SetStatus(ctx context.Context, opts vcs.SetStatusOptions) error {
 	*f.got = opts
 	return nil
 }
+
+func (f *fakeReporterCloudClient) CreatePullRequestComment(ctx context.Context, opts vcs.CreatePullRequestCommentOptions) (string, error) {
+	f.created = append(f.created, opts)
+	return fmt.Sprintf("comment-%d", len(f.created)), nil
+}
+
+func (f *fakeReporterCloudClient) UpdatePullRequestComment(ctx context.Context, opts vcs.UpdatePullRequestCommentOptions) error {
+	f.updated = append(f.updated, opts)
+	return f.updateErr
+}
+
+type fakeReporterRunService struct {
+	reporterRunClient
+
+	planFile []byte
+	// comment IDs keyed by workspace ID, repo and pull request number
+	comments map[string]string
+}
+
+func (f *fakeReporterRunService) GetPlanFile(context.Context, string, PlanFormat) ([]byte, error) {
+	return f.planFile, nil
+}
+
+func (f *fakeReporterRunService) getPullRequestCommentID(ctx context.Context, workspaceID, repo string, pull int) (string, error) {
+	id, ok := f.comments[fmt.Sprintf("%s/%s/%d", workspaceID, repo, pull)]
+	if !ok {
+		return "", internal.ErrResourceNotFound
+	}
+	return id, nil
+}
+
+func (f *fakeReporterRunService) setPullRequestCommentID(ctx context.Context, workspaceID, repo string, pull int, commentID string) error {
+	f.comments[fmt.Sprintf("%s/%s/%d", workspaceID, repo, pull)] = commentID
+	return nil
+}
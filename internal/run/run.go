@@ -12,6 +12,7 @@ import (
 	"github.com/tofutf/tofutf/internal/configversion"
 	"github.com/tofutf/tofutf/internal/organization"
 	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/releases"
 	"github.com/tofutf/tofutf/internal/resource"
 	"github.com/tofutf/tofutf/internal/user"
 	"github.com/tofutf/tofutf/internal/workspace"
@@ -52,6 +53,7 @@ type (
 		PositionInQueue        int                     `jsonapi:"attribute" json:"position_in_queue"`
 		TargetAddrs            []string                `jsonapi:"attribute" json:"target_addrs"`
 		TerraformVersion       string                  `jsonapi:"attribute" json:"terraform_version"`
+		Engine                 releases.Engine         `jsonapi:"attribute" json:"engine"`
 		AllowEmptyApply        bool                    `jsonapi:"attribute" json:"allow_empty_apply"`
 		AutoApply              bool                    `jsonapi:"attribute" json:"auto_apply"`
 		PlanOnly               bool                    `jsonapi:"attribute" json:"plan_only"`
@@ -167,6 +169,7 @@ func newRun(ctx context.Context, org *organization.Organization, cv *configversi
 		CostEstimationEnabled:  org.CostEstimationEnabled,
 		Source:                 opts.Source,
 		TerraformVersion:       ws.TerraformVersion,
+		Engine:                 ws.Engine,
 		Variables:              opts.Variables,
 	}
 	run.Plan = newPhase(run.ID, internal.PlanPhase)
@@ -194,6 +197,9 @@ func newRun(ctx context.Context, org *organization.Organization, cv *configversi
 	if opts.Refresh != nil {
 		run.Refresh = *opts.Refresh
 	}
+	if opts.RefreshOnly != nil {
+		run.RefreshOnly = *opts.RefreshOnly
+	}
 	if opts.AutoApply != nil {
 		run.AutoApply = *opts.AutoApply
 	}
@@ -213,6 +219,12 @@ func (r *Run) HasChanges() bool {
 	return r.Plan.HasChanges()
 }
 
+// Drifted determines whether a refresh-only run detected changes made to
+// resources outside of terraform.
+func (r *Run) Drifted() bool {
+	return r.RefreshOnly && r.Plan.HasChanges()
+}
+
 // HasStarted is used by the running_time.tmpl partial template to determine
 // whether to show the "elapsed time" for a run.
 func (r *Run) HasStarted() bool { return true }
@@ -272,7 +284,7 @@ func (r *Run) Phase() internal.PhaseType {
 	switch r.Status {
 	case RunPending:
 		return internal.PendingPhase
-	case RunPlanQueued, RunPlanning, RunPlanned:
+	case RunPlanQueued, RunPlanning, RunPlanned, RunPolicyChecked, RunPolicySoftFailed:
 		return internal.PlanPhase
 	case RunApplyQueued, RunApplying, RunApplied:
 		return internal.ApplyPhase
@@ -345,7 +357,7 @@ func (r *Run) Cancel(isUser, force bool) error {
 			r.Plan.UpdateStatus(PhaseCanceled)
 			r.Apply.UpdateStatus(PhaseUnreachable)
 		}
-	case RunPlanned:
+	case RunPlanned, RunPolicyChecked, RunPolicySoftFailed:
 		r.Apply.UpdateStatus(PhaseUnreachable)
 	case RunApplying:
 		if isUser && !force {
@@ -463,7 +475,7 @@ func (r *Run) CanAccessWorkspace(action rbac.Action, policy *internal.WorkspaceP
 
 func (r *Run) EnqueueApply() error {
 	switch r.Status {
-	case RunPlanned, RunCostEstimated:
+	case RunPlanned, RunCostEstimated, RunPolicyChecked:
 		// applyable statuses
 	default:
 		return fmt.Errorf("cannot apply run with status %s", r.Status)
@@ -551,6 +563,40 @@ func (r *Run) Finish(phase internal.PhaseType, opts PhaseFinishOptions) (autoapp
 	}
 }
 
+// FinishPolicyCheck updates the run to reflect the outcome of a policy check
+// carried out upon the plan finishing. If an apply should be automatically
+// enqueued then autoapply will be set to true.
+func (r *Run) FinishPolicyCheck(status PolicyCheckStatus) (autoapply bool, err error) {
+	if r.Status != RunPlanned && r.Status != RunCostEstimated {
+		return false, ErrInvalidRunStateTransition
+	}
+	switch status {
+	case PolicyCheckPassed:
+		r.updateStatus(RunPolicyChecked, nil)
+		return r.AutoApply, nil
+	case PolicyCheckSoftFailed:
+		r.updateStatus(RunPolicySoftFailed, nil)
+		return false, nil
+	case PolicyCheckHardFailed:
+		r.updateStatus(RunErrored, nil)
+		r.Apply.UpdateStatus(PhaseUnreachable)
+		return false, nil
+	default:
+		return false, fmt.Errorf("unknown policy check status: %s", status)
+	}
+}
+
+// OverridePolicyCheck updates the run to reflect a soft-failed policy check
+// having been overridden. If an apply should be automatically enqueued then
+// autoapply will be set to true.
+func (r *Run) OverridePolicyCheck() (autoapply bool, err error) {
+	if r.Status != RunPolicySoftFailed {
+		return false, ErrPolicyCheckOverrideNotAllowed
+	}
+	r.updateStatus(RunPolicyChecked, nil)
+	return r.AutoApply, nil
+}
+
 func (r *Run) updateStatus(status Status, now *time.Time) *Run {
 	r.Status = status
 	r.StatusTimestamps = append(r.StatusTimestamps, StatusTimestamp{
@@ -563,7 +609,7 @@ func (r *Run) updateStatus(status Status, now *time.Time) *Run {
 // Discardable determines whether run can be discarded.
 func (r *Run) Discardable() bool {
 	switch r.Status {
-	case RunPending, RunPlanned, RunCostEstimated:
+	case RunPending, RunPlanned, RunCostEstimated, RunPolicyChecked, RunPolicySoftFailed:
 		return true
 	default:
 		return false
@@ -573,7 +619,7 @@ func (r *Run) Discardable() bool {
 // Confirmable determines whether run can be confirmed.
 func (r *Run) Confirmable() bool {
 	switch r.Status {
-	case RunPlanned:
+	case RunPlanned, RunPolicyChecked:
 		return true
 	default:
 		return false
@@ -583,8 +629,11 @@ func (r *Run) Confirmable() bool {
 // Helper methods for templates; helps avoid using strings within templates to refer
 // to constants.
 
-func (r *Run) IsGithubSource() bool { return r.Source == SourceGithub }
-func (r *Run) IsGitlabSource() bool { return r.Source == SourceGitlab }
-func (r *Run) IsUISource() bool     { return r.Source == SourceUI }
-func (r *Run) IsAPISource() bool    { return r.Source == SourceAPI }
-func (r *Run) IsCLISource() bool    { return r.Source == SourceTerraform }
+func (r *Run) IsGithubSource() bool      { return r.Source == SourceGithub }
+func (r *Run) IsGitlabSource() bool      { return r.Source == SourceGitlab }
+func (r *Run) IsUISource() bool          { return r.Source == SourceUI }
+func (r *Run) IsAPISource() bool         { return r.Source == SourceAPI }
+func (r *Run) IsCLISource() bool         { return r.Source == SourceTerraform }
+func (r *Run) IsRunTriggerSource() bool  { return r.Source == SourceRunTrigger }
+func (r *Run) IsScheduleSource() bool    { return r.Source == SourceSchedule }
+func (r *Run) IsAutoDestroySource() bool { return r.Source == SourceAutoDestroy }
@@ -22,6 +22,17 @@ func TestRun_New_CreatedBy(t *testing.T) {
 	assert.Equal(t, "terry", *run.CreatedBy)
 }
 
+func TestRun_New_RefreshOnly(t *testing.T) {
+	run := newTestRun(context.Background(), CreateOptions{
+		RefreshOnly: internal.Bool(true),
+	})
+	assert.True(t, run.RefreshOnly)
+	assert.False(t, run.Drifted())
+
+	run.Plan.ResourceReport = &Report{Changes: 1}
+	assert.True(t, run.Drifted())
+}
+
 func TestRun_States(t *testing.T) {
 	ctx := context.Background()
 
@@ -138,6 +149,70 @@ func TestRun_States(t *testing.T) {
 		require.Equal(t, PhasePending, run.Apply.Status)
 	})
 
+	t.Run("finish policy check with pass", func(t *testing.T) {
+		run := newTestRun(ctx, CreateOptions{AutoApply: internal.Bool(true)})
+		run.Status = RunPlanned
+
+		autoapply, err := run.FinishPolicyCheck(PolicyCheckPassed)
+		require.NoError(t, err)
+
+		assert.True(t, autoapply)
+		assert.Equal(t, RunPolicyChecked, run.Status)
+		assert.True(t, run.Confirmable())
+	})
+
+	t.Run("finish policy check with soft failure", func(t *testing.T) {
+		run := newTestRun(ctx, CreateOptions{AutoApply: internal.Bool(true)})
+		run.Status = RunPlanned
+
+		autoapply, err := run.FinishPolicyCheck(PolicyCheckSoftFailed)
+		require.NoError(t, err)
+
+		assert.False(t, autoapply)
+		assert.Equal(t, RunPolicySoftFailed, run.Status)
+		assert.False(t, run.Confirmable())
+		assert.True(t, run.Discardable())
+	})
+
+	t.Run("finish policy check with hard failure", func(t *testing.T) {
+		run := newTestRun(ctx, CreateOptions{})
+		run.Status = RunPlanned
+
+		autoapply, err := run.FinishPolicyCheck(PolicyCheckHardFailed)
+		require.NoError(t, err)
+
+		assert.False(t, autoapply)
+		assert.Equal(t, RunErrored, run.Status)
+		assert.Equal(t, PhaseUnreachable, run.Apply.Status)
+	})
+
+	t.Run("override policy check", func(t *testing.T) {
+		run := newTestRun(ctx, CreateOptions{})
+		run.Status = RunPolicySoftFailed
+
+		autoapply, err := run.OverridePolicyCheck()
+		require.NoError(t, err)
+
+		assert.False(t, autoapply)
+		assert.Equal(t, RunPolicyChecked, run.Status)
+	})
+
+	t.Run("enqueue apply after policy check", func(t *testing.T) {
+		run := newTestRun(ctx, CreateOptions{})
+		run.Status = RunPolicyChecked
+
+		require.NoError(t, run.EnqueueApply())
+
+		require.Equal(t, RunApplyQueued, run.Status)
+	})
+
+	t.Run("enqueue apply after policy check soft failure", func(t *testing.T) {
+		run := newTestRun(ctx, CreateOptions{})
+		run.Status = RunPolicySoftFailed
+
+		require.Error(t, run.EnqueueApply())
+	})
+
 	t.Run("enqueue apply", func(t *testing.T) {
 		run := newTestRun(ctx, CreateOptions{})
 		run.Status = RunPlanned
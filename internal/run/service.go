@@ -9,9 +9,12 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/leg100/surl"
 	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/blob"
 	"github.com/tofutf/tofutf/internal/configversion"
+	"github.com/tofutf/tofutf/internal/encryption"
 	"github.com/tofutf/tofutf/internal/http/html"
 	"github.com/tofutf/tofutf/internal/organization"
+	"github.com/tofutf/tofutf/internal/policy"
 	"github.com/tofutf/tofutf/internal/pubsub"
 	"github.com/tofutf/tofutf/internal/rbac"
 	"github.com/tofutf/tofutf/internal/releases"
@@ -38,6 +41,7 @@ type (
 		*authorizer
 
 		workspaces *workspace.Service
+		policies   policyEvaluator
 
 		logger                 *slog.Logger
 		cache                  internal.Cache
@@ -64,7 +68,10 @@ type (
 		ReleasesService      *releases.Service
 		VCSProviderService   *vcsprovider.Service
 		TokensService        *tokens.Service
+		PolicyService        *policy.Service
 		Logger               *slog.Logger
+		BlobStore            blob.Store
+		Keyring              *encryption.Keyring
 
 		internal.Cache
 		*sql.Pool
@@ -76,10 +83,11 @@ type (
 )
 
 func NewService(opts Options) *Service {
-	db := &pgdb{opts.Pool}
+	db := &pgdb{opts.Pool, opts.BlobStore, opts.Keyring}
 	svc := Service{
 		logger:              opts.Logger,
 		workspaces:          opts.WorkspaceService,
+		policies:            opts.PolicyService,
 		db:                  db,
 		cache:               opts.Cache,
 		site:                &internal.SiteAuthorizer{Logger: opts.Logger},
@@ -311,16 +319,38 @@ func (s *Service) FinishPhase(ctx context.Context, runID string, phase internal.
 			opts.Errored = true
 		}
 	}
+	var check *PolicyCheck
+	if phase == internal.PlanPhase && !opts.Errored {
+		var err error
+		check, err = s.checkPolicies(ctx, runID)
+		if err != nil {
+			s.logger.Error("checking policies", "id", runID, "err", err)
+			opts.Errored = true
+		}
+	}
 	var run *Run
 	err := s.db.Tx(ctx, func(ctx context.Context, q pggen.Querier) (err error) {
 		var autoapply bool
 		run, err = s.db.UpdateStatus(ctx, runID, func(run *Run) (err error) {
 			autoapply, err = run.Finish(phase, opts)
+			if err != nil {
+				return err
+			}
+			// a policy check only gates runs that would otherwise await
+			// confirmation or be automatically applied.
+			if check != nil && (run.Status == RunPlanned || run.Status == RunCostEstimated) {
+				autoapply, err = run.FinishPolicyCheck(check.Status)
+			}
 			return err
 		})
 		if err != nil {
 			return err
 		}
+		if check != nil {
+			if err := s.db.createPolicyCheck(ctx, check); err != nil {
+				return err
+			}
+		}
 		if autoapply {
 			return s.Apply(ctx, runID)
 		}
@@ -331,9 +361,135 @@ func (s *Service) FinishPhase(ctx context.Context, runID string, phase internal.
 		return nil, err
 	}
 	s.logger.Info("finished "+string(phase), "id", runID, "resource_changes", resourceReport, "output_changes", outputReport, "run_status", run.Status)
+	s.finishAssessment(ctx, run)
 	return run, nil
 }
 
+// finishAssessment records the outcome of a health assessment run on its
+// workspace once the run has reached a terminal state. Runs that are not
+// health assessments, or have yet to finish, are ignored.
+func (s *Service) finishAssessment(ctx context.Context, run *Run) {
+	if run.Source != SourceHealthAssessment || !run.Done() {
+		return
+	}
+	status := assessmentStatus(run)
+	_, err := s.workspaces.SetLatestAssessment(ctx, run.WorkspaceID, workspace.Assessment{
+		RunID:     run.ID,
+		CreatedAt: run.CreatedAt,
+		Status:    status,
+	})
+	if err != nil {
+		s.logger.Error("recording health assessment", "id", run.ID, "err", err)
+		return
+	}
+	s.logger.Info("finished health assessment", "id", run.ID, "workspace_id", run.WorkspaceID, "status", status)
+}
+
+// checkPolicies evaluates the organization's policies against the JSON plan of
+// the run. If the organization has no policies then nil is returned.
+func (s *Service) checkPolicies(ctx context.Context, runID string) (*PolicyCheck, error) {
+	run, err := s.db.GetRun(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	// the plan is only retrieved if the organization has policies
+	getPlan := func(ctx context.Context) ([]byte, error) {
+		return s.GetPlanFile(ctx, runID, PlanFormatJSON)
+	}
+	results, err := s.policies.Evaluate(ctx, run.Organization, getPlan)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+	return newPolicyCheck(runID, results), nil
+}
+
+// GetPolicyCheck retrieves a policy check.
+func (s *Service) GetPolicyCheck(ctx context.Context, checkID string) (*PolicyCheck, error) {
+	check, err := s.db.getPolicyCheck(ctx, checkID)
+	if err != nil {
+		s.logger.Error("retrieving policy check", "id", checkID, "err", err)
+		return nil, err
+	}
+
+	subject, err := s.CanAccess(ctx, rbac.GetRunAction, check.RunID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("retrieved policy check", "id", checkID, "subject", subject)
+	return check, nil
+}
+
+// GetPolicyCheckByRunID retrieves the policy check for a run. If no policy
+// check was carried out then internal.ErrResourceNotFound is returned.
+func (s *Service) GetPolicyCheckByRunID(ctx context.Context, runID string) (*PolicyCheck, error) {
+	subject, err := s.CanAccess(ctx, rbac.GetRunAction, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	check, err := s.db.getPolicyCheckByRunID(ctx, runID)
+	if err != nil {
+		if !errors.Is(err, internal.ErrResourceNotFound) {
+			s.logger.Error("retrieving policy check", "run_id", runID, "subject", subject, "err", err)
+		}
+		return nil, err
+	}
+
+	s.logger.Debug("retrieved policy check", "run_id", runID, "subject", subject)
+	return check, nil
+}
+
+// OverridePolicyCheck overrides a soft-failed policy check, permitting its run
+// to be applied. Only organization owners may override a policy check.
+func (s *Service) OverridePolicyCheck(ctx context.Context, checkID string) (*PolicyCheck, error) {
+	check, err := s.db.getPolicyCheck(ctx, checkID)
+	if err != nil {
+		s.logger.Error("retrieving policy check", "id", checkID, "err", err)
+		return nil, err
+	}
+	run, err := s.db.GetRun(ctx, check.RunID)
+	if err != nil {
+		return nil, err
+	}
+
+	subject, err := s.organization.CanAccess(ctx, rbac.OverridePolicyCheckAction, run.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Tx(ctx, func(ctx context.Context, q pggen.Querier) error {
+		var autoapply bool
+		_, err := s.db.UpdateStatus(ctx, check.RunID, func(run *Run) (err error) {
+			autoapply, err = run.OverridePolicyCheck()
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if err := check.override(subject.String()); err != nil {
+			return err
+		}
+		if err := s.db.updatePolicyCheckStatus(ctx, check); err != nil {
+			return err
+		}
+		if autoapply {
+			return s.Apply(ctx, check.RunID)
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("overriding policy check", "id", checkID, "subject", subject, "err", err)
+		return nil, err
+	}
+
+	s.logger.Info("overrode policy check", "id", checkID, "run_id", check.RunID, "subject", subject)
+	return check, nil
+}
+
 func (s *Service) Watch(ctx context.Context) (<-chan pubsub.Event[*Run], func()) {
 	return s.broker.Subscribe(ctx)
 }
@@ -419,7 +575,7 @@ func (s *Service) Discard(ctx context.Context, runID string) error {
 		return err
 	}
 
-	_, err = s.db.UpdateStatus(ctx, runID, func(run *Run) error {
+	run, err := s.db.UpdateStatus(ctx, runID, func(run *Run) error {
 		return run.Discard()
 	})
 	if err != nil {
@@ -428,6 +584,7 @@ func (s *Service) Discard(ctx context.Context, runID string) error {
 	}
 
 	s.logger.Info("discarded run", "id", runID, "subject", subject)
+	s.finishAssessment(ctx, run)
 
 	return err
 }
@@ -452,6 +609,7 @@ func (s *Service) Cancel(ctx context.Context, runID string) error {
 		} else {
 			s.logger.Info("canceled run", "id", runID, "subject", subject)
 		}
+		s.finishAssessment(ctx, run)
 		// invoke AfterCancel hooks
 		for _, hook := range s.afterCancelHooks {
 			if err := hook(ctx, run); err != nil {
@@ -482,6 +640,7 @@ func (s *Service) ForceCancel(ctx context.Context, runID string) error {
 			return err
 		}
 		s.logger.Info("force canceled run", "id", runID, "subject", subject)
+		s.finishAssessment(ctx, run)
 		// invoke AfterForceCancelRun hooks
 		for _, hook := range s.afterForceCancelHooks {
 			if err := hook(ctx, run); err != nil {
@@ -524,6 +683,29 @@ func (s *Service) GetPlanFile(ctx context.Context, runID string, format PlanForm
 	return file, nil
 }
 
+// GetPlanChanges returns the changes proposed in the run's plan, parsed from
+// its JSON plan file. If the plan has yet to produce a plan file then
+// internal.ErrResourceNotFound is returned.
+func (s *Service) GetPlanChanges(ctx context.Context, runID string) (*PlanChanges, error) {
+	run, err := s.Get(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	file, err := s.GetPlanFile(ctx, runID, PlanFormatJSON)
+	if err != nil {
+		return nil, err
+	}
+	if len(file) == 0 {
+		return nil, internal.ErrResourceNotFound
+	}
+	changes, err := ParsePlanChanges(file, run.RefreshOnly)
+	if err != nil {
+		s.logger.Error("parsing plan file", "id", runID, "err", err)
+		return nil, err
+	}
+	return changes, nil
+}
+
 // UploadPlanFile persists a run's plan file. The plan format should be either
 // be binary or json.
 func (s *Service) UploadPlanFile(ctx context.Context, runID string, plan []byte, format PlanFormat) error {
@@ -560,11 +742,15 @@ func (s *Service) createReports(ctx context.Context, runID string, phase interna
 }
 
 func (s *Service) createPlanReports(ctx context.Context, runID string) (resources Report, outputs Report, err error) {
+	run, err := s.db.GetRun(ctx, runID)
+	if err != nil {
+		return Report{}, Report{}, err
+	}
 	plan, err := s.GetPlanFile(ctx, runID, PlanFormatJSON)
 	if err != nil {
 		return Report{}, Report{}, err
 	}
-	resourceReport, outputReport, err := CompilePlanReports(plan)
+	resourceReport, outputReport, err := CompilePlanReports(plan, run.RefreshOnly)
 	if err != nil {
 		return Report{}, Report{}, err
 	}
@@ -1,11 +1,19 @@
 package run
 
 const (
-	SourceAPI       Source = "tfe-api"
-	SourceUI        Source = "tfe-ui"
-	SourceTerraform Source = "terraform+cloud"
-	SourceGithub    Source = "github"
-	SourceGitlab    Source = "gitlab"
+	SourceAPI        Source = "tfe-api"
+	SourceUI         Source = "tfe-ui"
+	SourceTerraform  Source = "terraform+cloud"
+	SourceGithub     Source = "github"
+	SourceGitlab     Source = "gitlab"
+	SourceRunTrigger Source = "tfe-run-trigger"
+	// SourceHealthAssessment is a refresh-only run created by the assessor to
+	// detect drift.
+	SourceHealthAssessment Source = "tfe-health-assessment"
+	// SourceSchedule is a run created by a workspace schedule.
+	SourceSchedule Source = "tfe-schedule"
+	// SourceAutoDestroy is a destroy run created by the auto-destroyer.
+	SourceAutoDestroy Source = "tfe-auto-destroy"
 )
 
 // Source represents a source type of a run.
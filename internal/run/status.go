@@ -20,6 +20,8 @@ const (
 	RunPlanned            Status = "planned"
 	RunPlannedAndFinished Status = "planned_and_finished"
 	RunPlanning           Status = "planning"
+	RunPolicyChecked      Status = "policy_checked"
+	RunPolicySoftFailed   Status = "policy_soft_failed"
 
 	// OTF doesn't support cost estimation but go-tfe API tests expect this
 	// status so it is included expressly to pass the tests.
@@ -53,6 +55,8 @@ var (
 		RunPlanQueued,
 		RunPlanned,
 		RunPlanning,
+		RunPolicyChecked,
+		RunPolicySoftFailed,
 	}
 	IncompleteRun = append(ActiveRun, RunPending)
 )
@@ -22,8 +22,10 @@ func (f *fakeSubService) Subscribe(context.Context) (<-chan pubsub.Event[*Run],
 
 type (
 	fakeWebServices struct {
-		runs []*Run
-		ws   *workspace.Workspace
+		runs    []*Run
+		ws      *workspace.Workspace
+		check   *PolicyCheck
+		changes *PlanChanges
 
 		// fakeWebServices does not implement all of webRunClient
 		webRunClient
@@ -38,6 +40,18 @@ func withWorkspace(workspace *workspace.Workspace) fakeWebServiceOption {
 	}
 }
 
+func withPolicyCheck(check *PolicyCheck) fakeWebServiceOption {
+	return func(svc *fakeWebServices) {
+		svc.check = check
+	}
+}
+
+func withPlanChanges(changes *PlanChanges) fakeWebServiceOption {
+	return func(svc *fakeWebServices) {
+		svc.changes = changes
+	}
+}
+
 func withRuns(runs ...*Run) fakeWebServiceOption {
 	return func(svc *fakeWebServices) {
 		svc.runs = runs
@@ -87,3 +101,17 @@ func (f *fakeWebServices) Get(ctx context.Context, runID string) (*Run, error) {
 func (f *fakeWebServices) Apply(ctx context.Context, runID string) error {
 	return nil
 }
+
+func (f *fakeWebServices) GetPolicyCheckByRunID(ctx context.Context, runID string) (*PolicyCheck, error) {
+	if f.check == nil {
+		return nil, internal.ErrResourceNotFound
+	}
+	return f.check, nil
+}
+
+func (f *fakeWebServices) GetPlanChanges(ctx context.Context, runID string) (*PlanChanges, error) {
+	if f.changes == nil {
+		return nil, internal.ErrResourceNotFound
+	}
+	return f.changes, nil
+}
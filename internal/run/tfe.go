@@ -2,6 +2,7 @@ package run
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"slices"
@@ -49,6 +50,12 @@ func (a *tfe) addHandlers(r *mux.Router) {
 
 	// Run events routes
 	r.HandleFunc("/runs/{id}/run-events", a.listRunEvents).Methods("GET")
+
+	// Policy check routes
+	r.HandleFunc("/runs/{id}/policy-checks", a.listPolicyChecks).Methods("GET")
+	r.HandleFunc("/policy-checks/{id}", a.getPolicyCheck).Methods("GET")
+	r.HandleFunc("/policy-checks/{id}/actions/override", a.overridePolicyCheck).Methods("POST")
+	r.HandleFunc("/policy-checks/{id}/output", a.getPolicyCheckOutput).Methods("GET")
 }
 
 func (a *tfe) createRun(w http.ResponseWriter, r *http.Request) {
@@ -315,6 +322,93 @@ func (a *tfe) listRunEvents(w http.ResponseWriter, r *http.Request) {
 	a.Respond(w, r, []*types.RunEvent{}, http.StatusOK)
 }
 
+// listPolicyChecks lists the policy checks for a run. OTF carries out at most
+// one policy check per run, and none if the organization has no policies.
+func (a *tfe) listPolicyChecks(w http.ResponseWriter, r *http.Request) {
+	runID, err := decode.Param("id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	to := []*types.PolicyCheck{}
+	check, err := a.GetPolicyCheckByRunID(r.Context(), runID)
+	if err == nil {
+		converted, err := a.toPolicyCheck(check, r.Context())
+		if err != nil {
+			tfeapi.Error(w, err)
+			return
+		}
+		to = append(to, converted)
+	} else if !errors.Is(err, internal.ErrResourceNotFound) {
+		tfeapi.Error(w, err)
+		return
+	}
+	a.Respond(w, r, to, http.StatusOK)
+}
+
+func (a *tfe) getPolicyCheck(w http.ResponseWriter, r *http.Request) {
+	id, err := decode.Param("id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	check, err := a.GetPolicyCheck(r.Context(), id)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	converted, err := a.toPolicyCheck(check, r.Context())
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	a.Respond(w, r, converted, http.StatusOK)
+}
+
+func (a *tfe) overridePolicyCheck(w http.ResponseWriter, r *http.Request) {
+	id, err := decode.Param("id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	check, err := a.OverridePolicyCheck(r.Context(), id)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	converted, err := a.toPolicyCheck(check, r.Context())
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	a.Respond(w, r, converted, http.StatusOK)
+}
+
+// getPolicyCheckOutput retrieves a human-readable summary of the results of a
+// policy check.
+func (a *tfe) getPolicyCheckOutput(w http.ResponseWriter, r *http.Request) {
+	id, err := decode.Param("id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	check, err := a.GetPolicyCheck(r.Context(), id)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	if _, err := w.Write([]byte(check.Output())); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
 func (a *tfe) includeCurrentRun(ctx context.Context, v any) ([]any, error) {
 	ws, ok := v.(*types.Workspace)
 	if !ok {
@@ -376,6 +470,10 @@ func (a *tfe) toRun(from *Run, ctx context.Context) (*types.Run, error) {
 			timestamps.PlannedAt = &rst.Timestamp
 		case RunPlannedAndFinished:
 			timestamps.PlannedAndFinishedAt = &rst.Timestamp
+		case RunPolicyChecked:
+			timestamps.PolicyCheckedAt = &rst.Timestamp
+		case RunPolicySoftFailed:
+			timestamps.PolicySoftFailedAt = &rst.Timestamp
 		case RunApplyQueued:
 			timestamps.ApplyQueuedAt = &rst.Timestamp
 		case RunApplying:
@@ -531,3 +629,45 @@ func (a *tfe) logURL(r *http.Request, phase Phase) (string, error) {
 	// terraform CLI expects an absolute URL
 	return otfhttp.Absolute(r, logs), nil
 }
+
+// toPolicyCheck converts a policy check into its equivalent json:api struct
+func (a *tfe) toPolicyCheck(from *PolicyCheck, ctx context.Context) (*types.PolicyCheck, error) {
+	subject, err := internal.SubjectFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	run, err := a.db.GetRun(ctx, from.RunID)
+	if err != nil {
+		return nil, err
+	}
+	to := &types.PolicyCheck{
+		ID: from.ID,
+		Actions: &types.PolicyActions{
+			IsOverridable: from.Overridable(),
+		},
+		Permissions: &types.PolicyPermissions{
+			CanOverride: subject.CanAccessOrganization(rbac.OverridePolicyCheckAction, run.Organization),
+		},
+		Result: &types.PolicyResult{
+			AdvisoryFailed: from.AdvisoryFailed(),
+			HardFailed:     from.HardFailed(),
+			Passed:         from.Passed(),
+			Result:         from.Status == PolicyCheckPassed,
+			SoftFailed:     from.SoftFailed(),
+			TotalFailed:    from.AdvisoryFailed() + from.SoftFailed() + from.HardFailed(),
+		},
+		Scope:            types.PolicyScopeOrganization,
+		Status:           types.PolicyStatus(from.Status),
+		StatusTimestamps: &types.PolicyStatusTimestamps{},
+		Run:              &types.Run{ID: from.RunID},
+	}
+	switch from.Status {
+	case PolicyCheckPassed, PolicyCheckOverridden:
+		to.StatusTimestamps.PassedAt = &from.CreatedAt
+	case PolicyCheckSoftFailed:
+		to.StatusTimestamps.SoftFailedAt = &from.CreatedAt
+	case PolicyCheckHardFailed:
+		to.StatusTimestamps.HardFailedAt = &from.CreatedAt
+	}
+	return to, nil
+}
@@ -3,6 +3,7 @@ package run
 import (
 	"bytes"
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 
@@ -35,6 +36,9 @@ type (
 		ForceCancel(ctx context.Context, runID string) error
 		Apply(ctx context.Context, runID string) error
 		Discard(ctx context.Context, runID string) error
+		GetPolicyCheckByRunID(ctx context.Context, runID string) (*PolicyCheck, error)
+		OverridePolicyCheck(ctx context.Context, checkID string) (*PolicyCheck, error)
+		GetPlanChanges(ctx context.Context, runID string) (*PlanChanges, error)
 
 		getLogs(ctx context.Context, runID string, phase internal.PhaseType) ([]byte, error)
 		watchWithOptions(ctx context.Context, opts WatchOptions) (<-chan pubsub.Event[*Run], error)
@@ -59,6 +63,7 @@ func (h *webHandlers) addHandlers(r *mux.Router) {
 	r.HandleFunc("/runs/{run_id}/apply", h.apply).Methods("POST")
 	r.HandleFunc("/runs/{run_id}/discard", h.discard).Methods("POST")
 	r.HandleFunc("/runs/{run_id}/retry", h.retry).Methods("POST")
+	r.HandleFunc("/runs/{run_id}/override-policy-check", h.overridePolicyCheck).Methods("POST")
 	r.HandleFunc("/workspaces/{workspace_id}/watch", h.watch).Methods("GET")
 
 	// this handles the link the terraform CLI shows during a plan/apply.
@@ -176,16 +181,45 @@ func (h *webHandlers) get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Get policy check, if one was carried out.
+	check, err := h.runs.GetPolicyCheckByRunID(r.Context(), run.ID)
+	if err != nil && !errors.Is(err, internal.ErrResourceNotFound) {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Get planned changes, if the plan has finished.
+	var changes *PlanChanges
+	if run.Plan.Status == PhaseFinished {
+		changes, err = h.runs.GetPlanChanges(r.Context(), run.ID)
+		if err != nil && !errors.Is(err, internal.ErrResourceNotFound) {
+			h.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	user, err := internal.SubjectFromContext(r.Context())
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	h.Render("run_get.tmpl", w, struct {
 		workspace.WorkspacePage
-		Run       *Run
-		PlanLogs  internal.Chunk
-		ApplyLogs internal.Chunk
+		Run         *Run
+		PlanLogs    internal.Chunk
+		ApplyLogs   internal.Chunk
+		PlanChanges *PlanChanges
+		PolicyCheck *PolicyCheck
+		CanOverride bool
 	}{
 		WorkspacePage: workspace.NewPage(r, run.ID, ws),
 		Run:           run,
 		PlanLogs:      internal.Chunk{Data: planLogs},
 		ApplyLogs:     internal.Chunk{Data: applyLogs},
+		PlanChanges:   changes,
+		PolicyCheck:   check,
+		CanOverride:   user.CanAccessOrganization(rbac.OverridePolicyCheckAction, run.Organization),
 	})
 }
 
@@ -274,6 +308,28 @@ func (h *webHandlers) apply(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, paths.Run(runID)+"#apply", http.StatusFound)
 }
 
+func (h *webHandlers) overridePolicyCheck(w http.ResponseWriter, r *http.Request) {
+	runID, err := decode.Param("run_id", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	check, err := h.runs.GetPolicyCheckByRunID(r.Context(), runID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if _, err := h.runs.OverridePolicyCheck(r.Context(), check.ID); err != nil {
+		html.FlashError(w, err.Error())
+		http.Redirect(w, r, paths.Run(runID), http.StatusFound)
+		return
+	}
+
+	html.FlashSuccess(w, "overrode policy check")
+	http.Redirect(w, r, paths.Run(runID)+"#policy-check", http.StatusFound)
+}
+
 func (h *webHandlers) discard(w http.ResponseWriter, r *http.Request) {
 	runID, err := decode.Param("run_id", r)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/http/html/paths"
+	"github.com/tofutf/tofutf/internal/policy"
 	"github.com/tofutf/tofutf/internal/testutils"
 	"github.com/tofutf/tofutf/internal/user"
 	"github.com/tofutf/tofutf/internal/workspace"
@@ -61,11 +62,57 @@ func TestWeb_GetHandler(t *testing.T) {
 	)
 
 	r := httptest.NewRequest("GET", "/?run_id=run-123", nil)
+	r = r.WithContext(internal.AddSubjectToContext(r.Context(), &user.User{ID: "janitor"}))
 	w := httptest.NewRecorder()
 	h.get(w, r)
 	assert.Equal(t, 200, w.Code, "output: %s", w.Body.String())
 }
 
+func TestWeb_GetHandler_PolicyCheck(t *testing.T) {
+	h := newTestWebHandlers(t,
+		withWorkspace(&workspace.Workspace{ID: "ws-123"}),
+		withRuns((&Run{ID: "run-123", WorkspaceID: "ws-1"}).updateStatus(RunPolicySoftFailed, nil)),
+		withPolicyCheck(newPolicyCheck("run-123", []policy.Result{
+			{PolicySet: "default", Policy: "no-public-buckets", EnforcementLevel: policy.SoftMandatory, Violations: []string{"bucket is public"}},
+		})),
+	)
+
+	r := httptest.NewRequest("GET", "/?run_id=run-123", nil)
+	r = r.WithContext(internal.AddSubjectToContext(r.Context(), &user.User{ID: "janitor"}))
+	w := httptest.NewRecorder()
+	h.get(w, r)
+	assert.Equal(t, 200, w.Code, "output: %s", w.Body.String())
+	assert.Contains(t, w.Body.String(), "no-public-buckets")
+	assert.Contains(t, w.Body.String(), "bucket is public")
+}
+
+func TestWeb_GetHandler_PlanChanges(t *testing.T) {
+	run := &Run{ID: "run-123", WorkspaceID: "ws-1", Plan: Phase{Status: PhaseFinished}}
+	h := newTestWebHandlers(t,
+		withWorkspace(&workspace.Workspace{ID: "ws-123"}),
+		withRuns(run.updateStatus(RunPlanned, nil)),
+		withPlanChanges(&PlanChanges{
+			Resources: []PlannedResourceChange{
+				{
+					Address: "aws_instance.web",
+					Action:  UpdateAction,
+					Attributes: []AttributeChange{
+						{Path: "user_data", ValueChange: ValueChange{BeforeSensitive: true, AfterSensitive: true}},
+					},
+				},
+			},
+		}),
+	)
+
+	r := httptest.NewRequest("GET", "/?run_id=run-123", nil)
+	r = r.WithContext(internal.AddSubjectToContext(r.Context(), &user.User{ID: "janitor"}))
+	w := httptest.NewRecorder()
+	h.get(w, r)
+	assert.Equal(t, 200, w.Code, "output: %s", w.Body.String())
+	assert.Contains(t, w.Body.String(), "aws_instance.web")
+	assert.Contains(t, w.Body.String(), "(sensitive value)")
+}
+
 func TestRuns_CancelHandler(t *testing.T) {
 	h := newTestWebHandlers(t, withRuns(&Run{ID: "run-1"}))
 
@@ -0,0 +1,109 @@
+package runtrigger
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tofutf/tofutf/internal/sql"
+	"github.com/tofutf/tofutf/internal/sql/pggen"
+)
+
+type (
+	// pgdb is a run trigger database on postgres
+	pgdb struct {
+		*sql.Pool // provides access to generated SQL queries
+	}
+
+	// pgresult is the result of a database query for a run trigger.
+	pgresult struct {
+		RunTriggerID            pgtype.Text        `json:"run_trigger_id"`
+		CreatedAt               pgtype.Timestamptz `json:"created_at"`
+		WorkspaceID             pgtype.Text        `json:"workspace_id"`
+		WorkspaceName           pgtype.Text        `json:"workspace_name"`
+		SourceableWorkspaceID   pgtype.Text        `json:"sourceable_workspace_id"`
+		SourceableWorkspaceName pgtype.Text        `json:"sourceable_workspace_name"`
+	}
+)
+
+func (r pgresult) toRunTrigger() *RunTrigger {
+	return &RunTrigger{
+		ID:             r.RunTriggerID.String,
+		CreatedAt:      r.CreatedAt.Time.UTC(),
+		WorkspaceID:    r.WorkspaceID.String,
+		WorkspaceName:  r.WorkspaceName.String,
+		SourceableID:   r.SourceableWorkspaceID.String,
+		SourceableName: r.SourceableWorkspaceName.String,
+	}
+}
+
+func (db *pgdb) create(ctx context.Context, rt *RunTrigger) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.InsertRunTrigger(ctx, pggen.InsertRunTriggerParams{
+			RunTriggerID:          sql.String(rt.ID),
+			CreatedAt:             sql.Timestamptz(rt.CreatedAt),
+			WorkspaceID:           sql.String(rt.WorkspaceID),
+			SourceableWorkspaceID: sql.String(rt.SourceableID),
+		})
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) get(ctx context.Context, id string) (*RunTrigger, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*RunTrigger, error) {
+		row, err := q.FindRunTrigger(ctx, sql.String(id))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return pgresult(row).toRunTrigger(), nil
+	})
+}
+
+// listInbound lists the run triggers that queue runs in the workspace.
+func (db *pgdb) listInbound(ctx context.Context, workspaceID string) ([]*RunTrigger, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]*RunTrigger, error) {
+		rows, err := q.FindRunTriggersByWorkspaceID(ctx, sql.String(workspaceID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		triggers := make([]*RunTrigger, len(rows))
+		for i, row := range rows {
+			triggers[i] = pgresult(row).toRunTrigger()
+		}
+		return triggers, nil
+	})
+}
+
+// listOutbound lists the run triggers that the workspace sources.
+func (db *pgdb) listOutbound(ctx context.Context, workspaceID string) ([]*RunTrigger, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]*RunTrigger, error) {
+		rows, err := q.FindRunTriggersBySourceableWorkspaceID(ctx, sql.String(workspaceID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		triggers := make([]*RunTrigger, len(rows))
+		for i, row := range rows {
+			triggers[i] = pgresult(row).toRunTrigger()
+		}
+		return triggers, nil
+	})
+}
+
+// listSourceIDs lists the IDs of the source workspaces of the workspace.
+func (db *pgdb) listSourceIDs(ctx context.Context, workspaceID string) ([]string, error) {
+	triggers, err := db.listInbound(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(triggers))
+	for i, rt := range triggers {
+		ids[i] = rt.SourceableID
+	}
+	return ids, nil
+}
+
+func (db *pgdb) delete(ctx context.Context, id string) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.DeleteRunTrigger(ctx, sql.String(id))
+		return sql.Error(err)
+	})
+}
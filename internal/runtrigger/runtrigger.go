@@ -0,0 +1,106 @@
+// Package runtrigger provides run triggers, which queue runs in a workspace
+// whenever a run is applied in one of its source workspaces.
+package runtrigger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tofutf/tofutf/internal"
+)
+
+// MaxSources is the maximum number of source workspaces a workspace may have.
+const MaxSources = 20
+
+var (
+	ErrSelfReference      = errors.New("a workspace cannot be its own run trigger source")
+	ErrDifferentOrg       = errors.New("source workspace must belong to the same organization")
+	ErrCycle              = errors.New("run trigger would create a cycle between workspaces")
+	ErrMaxSourcesExceeded = fmt.Errorf("a workspace cannot have more than %d source workspaces", MaxSources)
+	ErrInvalidDirection   = errors.New("invalid run trigger type: must be either inbound or outbound")
+)
+
+const (
+	// Inbound run triggers queue runs in the workspace.
+	Inbound Direction = "inbound"
+	// Outbound run triggers queue runs in other workspaces.
+	Outbound Direction = "outbound"
+)
+
+type (
+	// RunTrigger queues a run in a workspace whenever a run is applied in a
+	// source workspace.
+	RunTrigger struct {
+		ID        string
+		CreatedAt time.Time
+		// Workspace in which runs are queued
+		WorkspaceID   string
+		WorkspaceName string
+		// Workspace whose applies trigger runs
+		SourceableID   string
+		SourceableName string
+	}
+
+	// Direction is the direction of run triggers relative to a workspace.
+	Direction string
+)
+
+func newRunTrigger(workspaceID, sourceableID string) (*RunTrigger, error) {
+	if workspaceID == sourceableID {
+		return nil, ErrSelfReference
+	}
+	return &RunTrigger{
+		ID:           internal.NewID("rt"),
+		CreatedAt:    internal.CurrentTimestamp(nil),
+		WorkspaceID:  workspaceID,
+		SourceableID: sourceableID,
+	}, nil
+}
+
+func (rt *RunTrigger) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", rt.ID),
+		slog.String("workspace_id", rt.WorkspaceID),
+		slog.String("sourceable_id", rt.SourceableID),
+	)
+}
+
+// Valid returns an error if the direction is invalid.
+func (d Direction) Valid() error {
+	switch d {
+	case Inbound, Outbound:
+		return nil
+	default:
+		return ErrInvalidDirection
+	}
+}
+
+// detectCycle returns ErrCycle if making sourceID a source of workspaceID
+// would create a cycle, i.e. if workspaceID is already, directly or
+// indirectly, a source of sourceID. sources lists the IDs of the source
+// workspaces of a workspace.
+func detectCycle(ctx context.Context, workspaceID, sourceID string, sources func(context.Context, string) ([]string, error)) error {
+	visited := map[string]bool{sourceID: true}
+	queue := []string{sourceID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		ids, err := sources(ctx, current)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if id == workspaceID {
+				return ErrCycle
+			}
+			if !visited[id] {
+				visited[id] = true
+				queue = append(queue, id)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package runtrigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRunTrigger(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		rt, err := newRunTrigger("ws-downstream", "ws-upstream")
+		require.NoError(t, err)
+
+		assert.Equal(t, "ws-downstream", rt.WorkspaceID)
+		assert.Equal(t, "ws-upstream", rt.SourceableID)
+	})
+
+	t.Run("self reference", func(t *testing.T) {
+		_, err := newRunTrigger("ws-123", "ws-123")
+		assert.Equal(t, ErrSelfReference, err)
+	})
+}
+
+func TestDirection_Valid(t *testing.T) {
+	assert.NoError(t, Inbound.Valid())
+	assert.NoError(t, Outbound.Valid())
+	assert.Equal(t, ErrInvalidDirection, Direction("sideways").Valid())
+}
+
+func TestDetectCycle(t *testing.T) {
+	ctx := context.Background()
+
+	// map of workspace to its sources: a <- b <- c, i.e. applies in c trigger
+	// runs in b, and applies in b trigger runs in a.
+	graph := map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"d": {"c"},
+	}
+	sources := func(ctx context.Context, workspaceID string) ([]string, error) {
+		return graph[workspaceID], nil
+	}
+
+	tests := []struct {
+		name        string
+		workspaceID string
+		sourceID    string
+		want        error
+	}{
+		{"new leaf source", "c", "e", nil},
+		{"additional source", "a", "c", nil},
+		{"sibling", "b", "d", nil},
+		{"direct cycle", "b", "a", ErrCycle},
+		{"indirect cycle", "c", "a", ErrCycle},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := detectCycle(ctx, tt.workspaceID, tt.sourceID, sources)
+			assert.Equal(t, tt.want, err)
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package runtrigger
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/http/html"
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/run"
+	"github.com/tofutf/tofutf/internal/sql"
+	"github.com/tofutf/tofutf/internal/tfeapi"
+	"github.com/tofutf/tofutf/internal/workspace"
+)
+
+type (
+	Service struct {
+		logger              *slog.Logger
+		workspaceAuthorizer internal.Authorizer // authorize workspaces actions
+		workspaces          *workspace.Service
+		runs                *run.Service
+		db                  *pgdb
+		api                 *tfe
+		web                 *webHandlers
+	}
+
+	Options struct {
+		*sql.Pool
+		*tfeapi.Responder
+		html.Renderer
+		Logger *slog.Logger
+
+		WorkspaceService *workspace.Service
+		RunService       *run.Service
+	}
+)
+
+func NewService(opts Options) *Service {
+	svc := Service{
+		logger:              opts.Logger,
+		workspaceAuthorizer: opts.WorkspaceService,
+		workspaces:          opts.WorkspaceService,
+		runs:                opts.RunService,
+		db:                  &pgdb{opts.Pool},
+	}
+	svc.api = &tfe{
+		Service:   &svc,
+		Responder: opts.Responder,
+	}
+	svc.web = &webHandlers{
+		Renderer:   opts.Renderer,
+		Service:    &svc,
+		workspaces: opts.WorkspaceService,
+	}
+	return &svc
+}
+
+func (s *Service) AddHandlers(r *mux.Router) {
+	s.api.addHandlers(r)
+	s.web.addHandlers(r)
+}
+
+// NewTriggerer constructs a triggerer, which queues runs in downstream
+// workspaces whenever a run is applied.
+func (s *Service) NewTriggerer(logger *slog.Logger) *Triggerer {
+	return &Triggerer{
+		Logger:   logger.With("component", "triggerer"),
+		Runs:     s.runs,
+		Triggers: s.db,
+	}
+}
+
+// Create a run trigger such that an apply in the source workspace queues a run
+// in the workspace.
+func (s *Service) Create(ctx context.Context, workspaceID, sourceableID string) (*RunTrigger, error) {
+	subject, err := s.workspaceAuthorizer.CanAccess(ctx, rbac.CreateRunTriggerAction, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	rt, err := newRunTrigger(workspaceID, sourceableID)
+	if err != nil {
+		s.logger.Error("constructing run trigger", "subject", subject, "err", err)
+		return nil, err
+	}
+
+	ws, err := s.workspaces.Get(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	// caller must be permitted to read the source workspace too.
+	source, err := s.workspaces.Get(ctx, sourceableID)
+	if err != nil {
+		return nil, err
+	}
+	if ws.Organization != source.Organization {
+		return nil, ErrDifferentOrg
+	}
+	rt.WorkspaceName = ws.Name
+	rt.SourceableName = source.Name
+
+	existing, err := s.db.listInbound(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= MaxSources {
+		return nil, ErrMaxSourcesExceeded
+	}
+	if err := detectCycle(ctx, workspaceID, sourceableID, s.db.listSourceIDs); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.create(ctx, rt); err != nil {
+		s.logger.Error("creating run trigger", "trigger", rt, "subject", subject, "err", err)
+		return nil, err
+	}
+
+	s.logger.Info("created run trigger", "trigger", rt, "subject", subject)
+	return rt, nil
+}
+
+func (s *Service) Get(ctx context.Context, id string) (*RunTrigger, error) {
+	rt, err := s.db.get(ctx, id)
+	if err != nil {
+		s.logger.Error("retrieving run trigger", "id", id, "err", err)
+		return nil, err
+	}
+
+	subject, err := s.workspaceAuthorizer.CanAccess(ctx, rbac.GetRunTriggerAction, rt.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("retrieved run trigger", "trigger", rt, "subject", subject)
+	return rt, nil
+}
+
+// List lists the run triggers of a workspace. Inbound triggers queue runs in
+// the workspace; outbound triggers queue runs in other workspaces.
+func (s *Service) List(ctx context.Context, workspaceID string, direction Direction) ([]*RunTrigger, error) {
+	if err := direction.Valid(); err != nil {
+		return nil, err
+	}
+	subject, err := s.workspaceAuthorizer.CanAccess(ctx, rbac.ListRunTriggersAction, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var triggers []*RunTrigger
+	if direction == Inbound {
+		triggers, err = s.db.listInbound(ctx, workspaceID)
+	} else {
+		triggers, err = s.db.listOutbound(ctx, workspaceID)
+	}
+	if err != nil {
+		s.logger.Error("listing run triggers", "id", workspaceID, "direction", direction, "err", err)
+		return nil, err
+	}
+	s.logger.Debug("listed run triggers", "total", len(triggers), "direction", direction, "subject", subject)
+	return triggers, nil
+}
+
+func (s *Service) Delete(ctx context.Context, id string) error {
+	rt, err := s.db.get(ctx, id)
+	if err != nil {
+		s.logger.Error("retrieving run trigger", "id", id, "err", err)
+		return err
+	}
+
+	subject, err := s.workspaceAuthorizer.CanAccess(ctx, rbac.DeleteRunTriggerAction, rt.WorkspaceID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.db.delete(ctx, id); err != nil {
+		s.logger.Error("deleting run trigger", "id", id, "err", err)
+		return err
+	}
+
+	s.logger.Info("deleted run trigger", "trigger", rt, "subject", subject)
+	return nil
+}
@@ -0,0 +1,137 @@
+package runtrigger
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/http/decode"
+	"github.com/tofutf/tofutf/internal/resource"
+	"github.com/tofutf/tofutf/internal/tfeapi"
+	"github.com/tofutf/tofutf/internal/tfeapi/types"
+)
+
+type tfe struct {
+	*Service
+	*tfeapi.Responder
+}
+
+func (a *tfe) addHandlers(r *mux.Router) {
+	r = r.PathPrefix(tfeapi.APIPrefixV2).Subrouter()
+
+	r.HandleFunc("/workspaces/{workspace_id}/run-triggers", a.createRunTrigger).Methods("POST")
+	r.HandleFunc("/workspaces/{workspace_id}/run-triggers", a.listRunTriggers).Methods("GET")
+	r.HandleFunc("/run-triggers/{id}", a.getRunTrigger).Methods("GET")
+	r.HandleFunc("/run-triggers/{id}", a.deleteRunTrigger).Methods("DELETE")
+}
+
+func (a *tfe) createRunTrigger(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := decode.Param("workspace_id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	var params types.RunTriggerCreateOptions
+	if err := tfeapi.Unmarshal(r.Body, &params); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	if params.Sourceable == nil || params.Sourceable.ID == "" {
+		tfeapi.Error(w, &internal.MissingParameterError{Parameter: "sourceable"})
+		return
+	}
+
+	rt, err := a.Create(r.Context(), workspaceID, params.Sourceable.ID)
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	a.Respond(w, r, a.convert(rt), http.StatusCreated)
+}
+
+func (a *tfe) listRunTriggers(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := decode.Param("workspace_id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	var params types.RunTriggerListOptions
+	if err := decode.All(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	triggers, err := a.List(r.Context(), workspaceID, Direction(params.RunTriggerType))
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	// client expects a page, whereas List returns full result set, so
+	// convert to page first
+	page := resource.NewPage(triggers, resource.PageOptions(params.ListOptions), nil)
+
+	// convert items
+	items := make([]*types.RunTrigger, len(page.Items))
+	for i, from := range page.Items {
+		items[i] = a.convert(from)
+	}
+	a.RespondWithPage(w, r, items, page.Pagination)
+}
+
+func (a *tfe) getRunTrigger(w http.ResponseWriter, r *http.Request) {
+	id, err := decode.Param("id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	rt, err := a.Get(r.Context(), id)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	a.Respond(w, r, a.convert(rt), http.StatusOK)
+}
+
+func (a *tfe) deleteRunTrigger(w http.ResponseWriter, r *http.Request) {
+	id, err := decode.Param("id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	if err := a.Delete(r.Context(), id); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a *tfe) convert(from *RunTrigger) *types.RunTrigger {
+	return &types.RunTrigger{
+		ID:             from.ID,
+		CreatedAt:      from.CreatedAt,
+		SourceableName: from.SourceableName,
+		WorkspaceName:  from.WorkspaceName,
+		Sourceable:     &types.Workspace{ID: from.SourceableID},
+		Workspace:      &types.Workspace{ID: from.WorkspaceID},
+	}
+}
+
+// toHTTPError reports run trigger validation errors as a 422.
+func toHTTPError(err error) error {
+	for _, invalid := range []error{ErrSelfReference, ErrDifferentOrg, ErrCycle, ErrMaxSourcesExceeded, ErrInvalidDirection} {
+		if errors.Is(err, invalid) {
+			return &internal.HTTPError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,75 @@
+package runtrigger
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/pubsub"
+	"github.com/tofutf/tofutf/internal/run"
+)
+
+// LockID guarantees only one triggerer on a cluster is running at any time.
+const LockID int64 = 5577006791947779414
+
+type (
+	// Triggerer queues runs in downstream workspaces whenever a run is applied
+	// in a source workspace.
+	Triggerer struct {
+		Logger   *slog.Logger
+		Runs     triggererRunClient
+		Triggers triggererTriggerClient
+	}
+
+	triggererRunClient interface {
+		Create(ctx context.Context, workspaceID string, opts run.CreateOptions) (*run.Run, error)
+		Watch(context.Context) (<-chan pubsub.Event[*run.Run], func())
+	}
+
+	triggererTriggerClient interface {
+		listOutbound(ctx context.Context, workspaceID string) ([]*RunTrigger, error)
+	}
+)
+
+// Start starts the triggerer daemon. Should be invoked in a go routine.
+func (t *Triggerer) Start(ctx context.Context) error {
+	// subscribe to run events
+	sub, unsub := t.Runs.Watch(ctx)
+	defer unsub()
+
+	for event := range sub {
+		if event.Type == pubsub.DeletedEvent {
+			// Skip deleted run events
+			continue
+		}
+		if err := t.handleRun(ctx, event.Payload); err != nil {
+			t.Logger.Error("handling run event", "run", event.Payload.ID, "err", err)
+		}
+	}
+	return pubsub.ErrSubscriptionTerminated
+}
+
+func (t *Triggerer) handleRun(ctx context.Context, applied *run.Run) error {
+	if applied.Status != run.RunApplied {
+		return nil
+	}
+
+	triggers, err := t.Triggers.listOutbound(ctx, applied.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	for _, rt := range triggers {
+		created, err := t.Runs.Create(ctx, rt.WorkspaceID, run.CreateOptions{
+			Source:  run.SourceRunTrigger,
+			Message: internal.String(fmt.Sprintf("Triggered by an apply in workspace %s (%s)", rt.SourceableName, applied.ID)),
+		})
+		if err != nil {
+			// carry on queuing runs in other downstream workspaces
+			t.Logger.Error("queuing triggered run", "trigger", rt, "err", err)
+			continue
+		}
+		t.Logger.Info("queued triggered run", "trigger", rt, "run", created.ID)
+	}
+	return nil
+}
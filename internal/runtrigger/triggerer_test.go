@@ -0,0 +1,107 @@
+package runtrigger
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal/pubsub"
+	"github.com/tofutf/tofutf/internal/run"
+)
+
+func TestTriggerer_handleRun(t *testing.T) {
+	ctx := context.Background()
+
+	triggers := []*RunTrigger{
+		{WorkspaceID: "ws-downstream-1", SourceableID: "ws-upstream", SourceableName: "upstream"},
+		{WorkspaceID: "ws-downstream-2", SourceableID: "ws-upstream", SourceableName: "upstream"},
+	}
+
+	tests := []struct {
+		name string
+		run  *run.Run
+		// workspaces in which a run fails to be created
+		failures []string
+		want     []string
+	}{
+		{
+			name: "applied run",
+			run:  &run.Run{ID: "run-123", Status: run.RunApplied, WorkspaceID: "ws-upstream"},
+			want: []string{"ws-downstream-1", "ws-downstream-2"},
+		},
+		{
+			name: "planned run",
+			run:  &run.Run{ID: "run-123", Status: run.RunPlanned, WorkspaceID: "ws-upstream"},
+			want: nil,
+		},
+		{
+			name: "applied run in workspace without triggers",
+			run:  &run.Run{ID: "run-123", Status: run.RunApplied, WorkspaceID: "ws-other"},
+			want: nil,
+		},
+		{
+			name:     "skip downstream workspace that fails",
+			run:      &run.Run{ID: "run-123", Status: run.RunApplied, WorkspaceID: "ws-upstream"},
+			failures: []string{"ws-downstream-1"},
+			want:     []string{"ws-downstream-2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runs := &fakeTriggererRunClient{failures: tt.failures}
+			triggerer := &Triggerer{
+				Logger:   slog.Default(),
+				Runs:     runs,
+				Triggers: &fakeTriggererTriggerClient{triggers: triggers},
+			}
+
+			err := triggerer.handleRun(ctx, tt.run)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, runs.created)
+			for _, opts := range runs.opts {
+				assert.Equal(t, run.SourceRunTrigger, opts.Source)
+				assert.Equal(t, "Triggered by an apply in workspace upstream (run-123)", *opts.Message)
+			}
+		})
+	}
+}
+
+type (
+	fakeTriggererRunClient struct {
+		failures []string
+		created  []string
+		opts     []run.CreateOptions
+	}
+
+	fakeTriggererTriggerClient struct {
+		triggers []*RunTrigger
+	}
+)
+
+func (f *fakeTriggererRunClient) Create(ctx context.Context, workspaceID string, opts run.CreateOptions) (*run.Run, error) {
+	for _, id := range f.failures {
+		if id == workspaceID {
+			return nil, errors.New("workspace is locked")
+		}
+	}
+	f.created = append(f.created, workspaceID)
+	f.opts = append(f.opts, opts)
+	return &run.Run{WorkspaceID: workspaceID}, nil
+}
+
+func (f *fakeTriggererRunClient) Watch(context.Context) (<-chan pubsub.Event[*run.Run], func()) {
+	return nil, nil
+}
+
+func (f *fakeTriggererTriggerClient) listOutbound(ctx context.Context, workspaceID string) (triggers []*RunTrigger, err error) {
+	for _, rt := range f.triggers {
+		if rt.SourceableID == workspaceID {
+			triggers = append(triggers, rt)
+		}
+	}
+	return triggers, nil
+}
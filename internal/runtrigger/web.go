@@ -0,0 +1,148 @@
+package runtrigger
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/http/decode"
+	"github.com/tofutf/tofutf/internal/http/html"
+	"github.com/tofutf/tofutf/internal/http/html/paths"
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/resource"
+	"github.com/tofutf/tofutf/internal/workspace"
+)
+
+type webHandlers struct {
+	html.Renderer
+	*Service
+
+	workspaces *workspace.Service
+}
+
+func (h *webHandlers) addHandlers(r *mux.Router) {
+	r = html.UIRouter(r)
+
+	r.HandleFunc("/workspaces/{workspace_id}/run-triggers", h.listRunTriggers).Methods("GET")
+	r.HandleFunc("/workspaces/{workspace_id}/create-run-trigger", h.createRunTrigger).Methods("POST")
+	r.HandleFunc("/workspaces/{workspace_id}/delete-run-trigger", h.deleteRunTrigger).Methods("POST")
+}
+
+func (h *webHandlers) listRunTriggers(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := decode.Param("workspace_id", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	ws, err := h.workspaces.Get(r.Context(), workspaceID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	inbound, err := h.List(r.Context(), workspaceID, Inbound)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	outbound, err := h.List(r.Context(), workspaceID, Outbound)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	policy, err := h.workspaces.GetPolicy(r.Context(), workspaceID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	user, err := internal.SubjectFromContext(r.Context())
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// populate list of workspaces that can be added as a source, excluding
+	// the workspace itself and existing sources.
+	var candidates []*workspace.Workspace
+	canCreate := user.CanAccessWorkspace(rbac.CreateRunTriggerAction, policy) && len(inbound) < MaxSources
+	if canCreate {
+		workspaces, err := resource.ListAll(func(opts resource.PageOptions) (*resource.Page[*workspace.Workspace], error) {
+			return h.workspaces.List(r.Context(), workspace.ListOptions{
+				Organization: &ws.Organization,
+				PageOptions:  opts,
+			})
+		})
+		if err != nil {
+			h.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		existing := map[string]bool{ws.ID: true}
+		for _, rt := range inbound {
+			existing[rt.SourceableID] = true
+		}
+		for _, candidate := range workspaces {
+			if !existing[candidate.ID] {
+				candidates = append(candidates, candidate)
+			}
+		}
+	}
+
+	err = h.RenderTemplate("run_triggers_get.tmpl", w, struct {
+		Workspace  *workspace.Workspace
+		Inbound    []*RunTrigger
+		Outbound   []*RunTrigger
+		Candidates []*workspace.Workspace
+		CanCreate  bool
+		CanDelete  bool
+	}{
+		Workspace:  ws,
+		Inbound:    inbound,
+		Outbound:   outbound,
+		Candidates: candidates,
+		CanCreate:  canCreate,
+		CanDelete:  user.CanAccessWorkspace(rbac.DeleteRunTriggerAction, policy),
+	})
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (h *webHandlers) createRunTrigger(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		WorkspaceID  *string `schema:"workspace_id,required"`
+		SourceableID *string `schema:"sourceable_id,required"`
+	}
+	if err := decode.All(&params, r); err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	rt, err := h.Create(r.Context(), *params.WorkspaceID, *params.SourceableID)
+	if err != nil {
+		html.FlashError(w, "creating run trigger: "+err.Error())
+		http.Redirect(w, r, paths.Workspace(*params.WorkspaceID), http.StatusFound)
+		return
+	}
+
+	html.FlashSuccess(w, "added run trigger from workspace: "+rt.SourceableName)
+	http.Redirect(w, r, paths.Workspace(*params.WorkspaceID), http.StatusFound)
+}
+
+func (h *webHandlers) deleteRunTrigger(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		WorkspaceID  *string `schema:"workspace_id,required"`
+		RunTriggerID *string `schema:"run_trigger_id,required"`
+	}
+	if err := decode.All(&params, r); err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	if err := h.Delete(r.Context(), *params.RunTriggerID); err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	html.FlashSuccess(w, "removed run trigger")
+	http.Redirect(w, r, paths.Workspace(*params.WorkspaceID), http.StatusFound)
+}
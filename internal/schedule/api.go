@@ -0,0 +1,130 @@
+package schedule
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal"
+	otfapi "github.com/tofutf/tofutf/internal/api"
+	"github.com/tofutf/tofutf/internal/http/decode"
+	"github.com/tofutf/tofutf/internal/tfeapi"
+)
+
+type api struct {
+	*Service
+	*tfeapi.Responder
+}
+
+func (a *api) addHandlers(r *mux.Router) {
+	r = r.PathPrefix(otfapi.DefaultBasePath).Subrouter()
+
+	r.HandleFunc("/workspaces/{workspace_id}/schedules", a.createSchedule).Methods("POST")
+	r.HandleFunc("/workspaces/{workspace_id}/schedules", a.listSchedules).Methods("GET")
+	r.HandleFunc("/schedules/{schedule_id}", a.getSchedule).Methods("GET")
+	r.HandleFunc("/schedules/{schedule_id}", a.updateSchedule).Methods("PATCH")
+	r.HandleFunc("/schedules/{schedule_id}", a.deleteSchedule).Methods("DELETE")
+}
+
+func (a *api) createSchedule(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := decode.Param("workspace_id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	var opts CreateOptions
+	if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	sched, err := a.Create(r.Context(), workspaceID, opts)
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	a.Respond(w, r, sched, http.StatusCreated)
+}
+
+func (a *api) listSchedules(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := decode.Param("workspace_id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	schedules, err := a.List(r.Context(), workspaceID)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	a.Respond(w, r, schedules, http.StatusOK)
+}
+
+func (a *api) getSchedule(w http.ResponseWriter, r *http.Request) {
+	id, err := decode.Param("schedule_id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	sched, err := a.Get(r.Context(), id)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	a.Respond(w, r, sched, http.StatusOK)
+}
+
+func (a *api) updateSchedule(w http.ResponseWriter, r *http.Request) {
+	id, err := decode.Param("schedule_id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	var opts UpdateOptions
+	if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	sched, err := a.Update(r.Context(), id, opts)
+	if err != nil {
+		tfeapi.Error(w, toHTTPError(err))
+		return
+	}
+
+	a.Respond(w, r, sched, http.StatusOK)
+}
+
+func (a *api) deleteSchedule(w http.ResponseWriter, r *http.Request) {
+	id, err := decode.Param("schedule_id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	if _, err := a.Delete(r.Context(), id); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// toHTTPError reports invalid schedules as a 422.
+func toHTTPError(err error) error {
+	for _, invalid := range []error{ErrInvalidCron, ErrInvalidOperation, ErrAutoApplyPlanOnly} {
+		if errors.Is(err, invalid) {
+			return &internal.HTTPError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}
+		}
+	}
+	return err
+}
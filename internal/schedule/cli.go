@@ -0,0 +1,186 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	otfapi "github.com/tofutf/tofutf/internal/api"
+	"github.com/tofutf/tofutf/internal/run"
+	"github.com/tofutf/tofutf/internal/workspace"
+)
+
+type scheduleCLI struct {
+	client cliClient
+}
+
+type cliClient interface {
+	GetWorkspace(ctx context.Context, organization, name string) (*workspace.Workspace, error)
+	Create(ctx context.Context, workspaceID string, opts CreateOptions) (*Schedule, error)
+	List(ctx context.Context, workspaceID string) ([]*Schedule, error)
+	Update(ctx context.Context, id string, opts UpdateOptions) (*Schedule, error)
+	Delete(ctx context.Context, id string) error
+}
+
+func NewCommand(apiClient *otfapi.Client) *cobra.Command {
+	cli := &scheduleCLI{}
+	cmd := &cobra.Command{
+		Use:   "schedules",
+		Short: "Workspace schedule management",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Parent().PersistentPreRunE(cmd.Parent(), args); err != nil {
+				return err
+			}
+			cli.client = &Client{Client: apiClient}
+			return nil
+		},
+	}
+	cmd.AddCommand(cli.scheduleListCommand())
+	cmd.AddCommand(cli.scheduleNewCommand())
+	cmd.AddCommand(cli.scheduleEditCommand())
+	cmd.AddCommand(cli.scheduleDeleteCommand())
+
+	return cmd
+}
+
+func (a *scheduleCLI) scheduleListCommand() *cobra.Command {
+	var organization, workspace string
+
+	cmd := &cobra.Command{
+		Use:           "list",
+		Short:         "List a workspace's schedules",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ws, err := a.client.GetWorkspace(cmd.Context(), organization, workspace)
+			if err != nil {
+				return err
+			}
+			schedules, err := a.client.List(cmd.Context(), ws.ID)
+			if err != nil {
+				return err
+			}
+			for _, sched := range schedules {
+				autoApply := "workspace"
+				if sched.AutoApply != nil {
+					autoApply = sched.AutoApplyString()
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%s\t%s\t%s\tauto-apply=%s\tnext=%s\n",
+					sched.ID, sched.Cron, sched.Operation, autoApply, sched.NextRunAt.Format(time.RFC3339))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&organization, "organization", "", "Organization workspace belongs to")
+	cmd.MarkFlagRequired("organization") //nolint:errcheck
+	cmd.Flags().StringVar(&workspace, "workspace", "", "Name of workspace")
+	cmd.MarkFlagRequired("workspace") //nolint:errcheck
+
+	return cmd
+}
+
+func (a *scheduleCLI) scheduleNewCommand() *cobra.Command {
+	var (
+		organization, workspace string
+		operation               string
+		autoApply               bool
+	)
+
+	cmd := &cobra.Command{
+		Use:           "new [cron]",
+		Short:         "Create a new workspace schedule",
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ws, err := a.client.GetWorkspace(cmd.Context(), organization, workspace)
+			if err != nil {
+				return err
+			}
+			opts := CreateOptions{
+				Cron:      &args[0],
+				Operation: (*run.Operation)(&operation),
+			}
+			if cmd.Flags().Changed("auto-apply") {
+				opts.AutoApply = &autoApply
+			}
+			sched, err := a.client.Create(cmd.Context(), ws.ID, opts)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Successfully created schedule %s\n", sched.ID)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&operation, "operation", string(run.PlanOnlyOperation), "Operation to perform. Valid values are plan-only, plan-and-apply and destroy-all.")
+	cmd.Flags().BoolVar(&autoApply, "auto-apply", false, "Automatically apply plan-and-apply and destroy-all runs. Defaults to the workspace's apply method.")
+	cmd.Flags().StringVar(&organization, "organization", "", "Organization workspace belongs to")
+	cmd.MarkFlagRequired("organization") //nolint:errcheck
+	cmd.Flags().StringVar(&workspace, "workspace", "", "Name of workspace")
+	cmd.MarkFlagRequired("workspace") //nolint:errcheck
+
+	return cmd
+}
+
+func (a *scheduleCLI) scheduleEditCommand() *cobra.Command {
+	var (
+		cron           string
+		operation      string
+		autoApply      bool
+		clearAutoApply bool
+	)
+
+	cmd := &cobra.Command{
+		Use:           "edit [id]",
+		Short:         "Edit a workspace schedule",
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var opts UpdateOptions
+			if cmd.Flags().Changed("cron") {
+				opts.Cron = &cron
+			}
+			if cmd.Flags().Changed("operation") {
+				opts.Operation = (*run.Operation)(&operation)
+			}
+			if cmd.Flags().Changed("auto-apply") {
+				opts.AutoApply = &autoApply
+			}
+			opts.ClearAutoApply = clearAutoApply
+			if _, err := a.client.Update(cmd.Context(), args[0], opts); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Successfully updated schedule %s\n", args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&cron, "cron", "", "Cron expression, evaluated in UTC.")
+	cmd.Flags().StringVar(&operation, "operation", "", "Operation to perform. Valid values are plan-only, plan-and-apply and destroy-all.")
+	cmd.Flags().BoolVar(&autoApply, "auto-apply", false, "Automatically apply plan-and-apply and destroy-all runs.")
+	cmd.Flags().BoolVar(&clearAutoApply, "clear-auto-apply", false, "Defer to the workspace's apply method.")
+	cmd.MarkFlagsMutuallyExclusive("auto-apply", "clear-auto-apply")
+
+	return cmd
+}
+
+func (a *scheduleCLI) scheduleDeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:           "delete [id]",
+		Short:         "Delete a workspace schedule",
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := a.client.Delete(cmd.Context(), args[0]); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Successfully deleted schedule %s\n", args[0])
+			return nil
+		},
+	}
+}
@@ -0,0 +1,87 @@
+package schedule
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal/run"
+	"github.com/tofutf/tofutf/internal/workspace"
+)
+
+func TestScheduleNewCommand(t *testing.T) {
+	client := &fakeCLIClient{}
+	cli := &scheduleCLI{client: client}
+	cmd := cli.scheduleNewCommand()
+
+	cmd.SetArgs([]string{"0 2 * * 6", "--organization", "acme-corp", "--workspace", "dev", "--operation", "destroy-all", "--auto-apply"})
+	got := bytes.Buffer{}
+	cmd.SetOut(&got)
+	require.NoError(t, cmd.Execute())
+
+	assert.Equal(t, "Successfully created schedule sched-123\n", got.String())
+	assert.Equal(t, "ws-dev", client.workspaceID)
+	assert.Equal(t, "0 2 * * 6", *client.createOpts.Cron)
+	assert.Equal(t, run.DestroyAllOperation, *client.createOpts.Operation)
+	assert.True(t, *client.createOpts.AutoApply)
+
+	t.Run("defer to workspace auto-apply", func(t *testing.T) {
+		client := &fakeCLIClient{}
+		cli := &scheduleCLI{client: client}
+		cmd := cli.scheduleNewCommand()
+
+		cmd.SetArgs([]string{"0 2 * * 6", "--organization", "acme-corp", "--workspace", "dev", "--operation", "destroy-all"})
+		cmd.SetOut(&bytes.Buffer{})
+		require.NoError(t, cmd.Execute())
+
+		assert.Nil(t, client.createOpts.AutoApply)
+	})
+}
+
+func TestScheduleEditCommand(t *testing.T) {
+	client := &fakeCLIClient{}
+	cli := &scheduleCLI{client: client}
+	cmd := cli.scheduleEditCommand()
+
+	cmd.SetArgs([]string{"sched-123", "--cron", "@daily"})
+	got := bytes.Buffer{}
+	cmd.SetOut(&got)
+	require.NoError(t, cmd.Execute())
+
+	assert.Equal(t, "Successfully updated schedule sched-123\n", got.String())
+	assert.Equal(t, "@daily", *client.updateOpts.Cron)
+	// unset flags are left unchanged
+	assert.Nil(t, client.updateOpts.Operation)
+	assert.Nil(t, client.updateOpts.AutoApply)
+}
+
+type fakeCLIClient struct {
+	workspaceID string
+	createOpts  CreateOptions
+	updateOpts  UpdateOptions
+}
+
+func (f *fakeCLIClient) GetWorkspace(ctx context.Context, organization, name string) (*workspace.Workspace, error) {
+	return &workspace.Workspace{ID: "ws-" + name, Name: name, Organization: organization}, nil
+}
+
+func (f *fakeCLIClient) Create(ctx context.Context, workspaceID string, opts CreateOptions) (*Schedule, error) {
+	f.workspaceID = workspaceID
+	f.createOpts = opts
+	return &Schedule{ID: "sched-123", WorkspaceID: workspaceID}, nil
+}
+
+func (f *fakeCLIClient) List(ctx context.Context, workspaceID string) ([]*Schedule, error) {
+	return nil, nil
+}
+
+func (f *fakeCLIClient) Update(ctx context.Context, id string, opts UpdateOptions) (*Schedule, error) {
+	f.updateOpts = opts
+	return &Schedule{ID: id}, nil
+}
+
+func (f *fakeCLIClient) Delete(ctx context.Context, id string) error {
+	return nil
+}
@@ -0,0 +1,75 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	otfapi "github.com/tofutf/tofutf/internal/api"
+	"github.com/tofutf/tofutf/internal/workspace"
+)
+
+type Client struct {
+	*otfapi.Client
+}
+
+// GetWorkspace retrieves a workspace via HTTP/JSONAPI.
+func (c *Client) GetWorkspace(ctx context.Context, organization, name string) (*workspace.Workspace, error) {
+	return (&workspace.Client{Client: c.Client}).GetByName(ctx, organization, name)
+}
+
+// Create creates a schedule via HTTP/JSONAPI.
+func (c *Client) Create(ctx context.Context, workspaceID string, opts CreateOptions) (*Schedule, error) {
+	// validate params
+	if _, err := newSchedule(workspaceID, opts); err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("workspaces/%s/schedules", url.QueryEscape(workspaceID))
+	req, err := c.NewRequest("POST", u, &opts)
+	if err != nil {
+		return nil, err
+	}
+	var sched Schedule
+	if err := c.Do(ctx, req, &sched); err != nil {
+		return nil, err
+	}
+	return &sched, nil
+}
+
+// List lists a workspace's schedules via HTTP/JSONAPI.
+func (c *Client) List(ctx context.Context, workspaceID string) ([]*Schedule, error) {
+	u := fmt.Sprintf("workspaces/%s/schedules", url.QueryEscape(workspaceID))
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	var list []*Schedule
+	if err := c.Do(ctx, req, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Update updates a schedule via HTTP/JSONAPI.
+func (c *Client) Update(ctx context.Context, id string, opts UpdateOptions) (*Schedule, error) {
+	u := fmt.Sprintf("schedules/%s", url.QueryEscape(id))
+	req, err := c.NewRequest("PATCH", u, &opts)
+	if err != nil {
+		return nil, err
+	}
+	var sched Schedule
+	if err := c.Do(ctx, req, &sched); err != nil {
+		return nil, err
+	}
+	return &sched, nil
+}
+
+// Delete deletes a schedule via HTTP/JSONAPI.
+func (c *Client) Delete(ctx context.Context, id string) error {
+	u := fmt.Sprintf("schedules/%s", url.QueryEscape(id))
+	req, err := c.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+	return c.Do(ctx, req, nil)
+}
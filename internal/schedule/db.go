@@ -0,0 +1,144 @@
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tofutf/tofutf/internal/run"
+	"github.com/tofutf/tofutf/internal/sql"
+	"github.com/tofutf/tofutf/internal/sql/pggen"
+)
+
+type (
+	// pgdb is a schedule database on postgres
+	pgdb struct {
+		*sql.Pool // provides access to generated SQL queries
+	}
+
+	// pgresult is the result of a database query for a schedule.
+	pgresult struct {
+		ScheduleID     pgtype.Text        `json:"schedule_id"`
+		CreatedAt      pgtype.Timestamptz `json:"created_at"`
+		UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+		WorkspaceID    pgtype.Text        `json:"workspace_id"`
+		CronExpression pgtype.Text        `json:"cron_expression"`
+		Operation      pgtype.Text        `json:"operation"`
+		AutoApply      pgtype.Bool        `json:"auto_apply"`
+		NextRunAt      pgtype.Timestamptz `json:"next_run_at"`
+		LastRunAt      pgtype.Timestamptz `json:"last_run_at"`
+		LastRunID      pgtype.Text        `json:"last_run_id"`
+	}
+)
+
+func (r pgresult) toSchedule() *Schedule {
+	sched := &Schedule{
+		ID:          r.ScheduleID.String,
+		CreatedAt:   r.CreatedAt.Time.UTC(),
+		UpdatedAt:   r.UpdatedAt.Time.UTC(),
+		WorkspaceID: r.WorkspaceID.String,
+		Cron:        r.CronExpression.String,
+		Operation:   run.Operation(r.Operation.String),
+		NextRunAt:   r.NextRunAt.Time.UTC(),
+	}
+	if r.AutoApply.Valid {
+		sched.AutoApply = &r.AutoApply.Bool
+	}
+	if r.LastRunAt.Valid {
+		lastRunAt := r.LastRunAt.Time.UTC()
+		sched.LastRunAt = &lastRunAt
+	}
+	if r.LastRunID.Valid {
+		sched.LastRunID = &r.LastRunID.String
+	}
+	return sched
+}
+
+func (db *pgdb) create(ctx context.Context, sched *Schedule) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.InsertSchedule(ctx, pggen.InsertScheduleParams{
+			ScheduleID:     sql.String(sched.ID),
+			CreatedAt:      sql.Timestamptz(sched.CreatedAt),
+			UpdatedAt:      sql.Timestamptz(sched.UpdatedAt),
+			WorkspaceID:    sql.String(sched.WorkspaceID),
+			CronExpression: sql.String(sched.Cron),
+			Operation:      sql.String(string(sched.Operation)),
+			AutoApply:      sql.BoolPtr(sched.AutoApply),
+			NextRunAt:      sql.Timestamptz(sched.NextRunAt),
+		})
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) get(ctx context.Context, id string) (*Schedule, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*Schedule, error) {
+		row, err := q.FindSchedule(ctx, sql.String(id))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return pgresult(row).toSchedule(), nil
+	})
+}
+
+func (db *pgdb) list(ctx context.Context, workspaceID string) ([]*Schedule, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]*Schedule, error) {
+		rows, err := q.FindSchedulesByWorkspaceID(ctx, sql.String(workspaceID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		schedules := make([]*Schedule, len(rows))
+		for i, row := range rows {
+			schedules[i] = pgresult(row).toSchedule()
+		}
+		return schedules, nil
+	})
+}
+
+// listDue lists schedules that are due at or before the given time.
+func (db *pgdb) listDue(ctx context.Context, due time.Time) ([]*Schedule, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]*Schedule, error) {
+		rows, err := q.FindDueSchedules(ctx, sql.Timestamptz(due))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		schedules := make([]*Schedule, len(rows))
+		for i, row := range rows {
+			schedules[i] = pgresult(row).toSchedule()
+		}
+		return schedules, nil
+	})
+}
+
+func (db *pgdb) update(ctx context.Context, id string, updateFunc func(*Schedule) error) (*Schedule, error) {
+	return sql.Tx(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*Schedule, error) {
+		row, err := q.FindScheduleForUpdate(ctx, sql.String(id))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		sched := pgresult(row).toSchedule()
+		if err := updateFunc(sched); err != nil {
+			return nil, err
+		}
+		_, err = q.UpdateSchedule(ctx, pggen.UpdateScheduleParams{
+			UpdatedAt:      sql.Timestamptz(sched.UpdatedAt),
+			CronExpression: sql.String(sched.Cron),
+			Operation:      sql.String(string(sched.Operation)),
+			AutoApply:      sql.BoolPtr(sched.AutoApply),
+			NextRunAt:      sql.Timestamptz(sched.NextRunAt),
+			LastRunAt:      sql.TimestamptzPtr(sched.LastRunAt),
+			LastRunID:      sql.StringPtr(sched.LastRunID),
+			ScheduleID:     sql.String(sched.ID),
+		})
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return sched, nil
+	})
+}
+
+func (db *pgdb) delete(ctx context.Context, id string) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.DeleteSchedule(ctx, sql.String(id))
+		return sql.Error(err)
+	})
+}
@@ -0,0 +1,92 @@
+package schedule
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/run"
+)
+
+// LockID guarantees only one dispatcher on a cluster is running at any time.
+const LockID int64 = 5577006791947779416
+
+// defaultCheckInterval is how often the dispatcher checks for schedules that
+// are due. It matches the granularity of cron expressions.
+var defaultCheckInterval = time.Minute
+
+type (
+	// Dispatcher periodically queues runs for schedules that are due.
+	Dispatcher struct {
+		Logger    *slog.Logger
+		Runs      dispatcherRunClient
+		Schedules dispatcherScheduleClient
+
+		// frequency with which the dispatcher checks for due schedules
+		checkInterval time.Duration
+	}
+
+	dispatcherRunClient interface {
+		Create(ctx context.Context, workspaceID string, opts run.CreateOptions) (*run.Run, error)
+	}
+
+	dispatcherScheduleClient interface {
+		listDue(ctx context.Context, due time.Time) ([]*Schedule, error)
+		update(ctx context.Context, id string, updateFunc func(*Schedule) error) (*Schedule, error)
+	}
+)
+
+// Start starts the dispatcher daemon. Should be invoked in a go routine.
+func (d *Dispatcher) Start(ctx context.Context) error {
+	// run at startup and then every check interval
+	d.dispatchAll(ctx)
+	ticker := time.NewTicker(d.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			d.dispatchAll(ctx)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (d *Dispatcher) dispatchAll(ctx context.Context) {
+	now := internal.CurrentTimestamp(nil)
+	schedules, err := d.Schedules.listDue(ctx, now)
+	if err != nil {
+		d.Logger.Error("listing due schedules", "err", err)
+		return
+	}
+	for _, sched := range schedules {
+		if err := d.dispatch(ctx, sched, now); err != nil {
+			// carry on dispatching other schedules
+			d.Logger.Error("dispatching schedule", "schedule", sched, "err", err)
+		}
+	}
+}
+
+// dispatch queues a run for the schedule and advances the schedule to its
+// next due time. The schedule is advanced even if the run cannot be queued,
+// to avoid retrying a failing schedule on every check.
+func (d *Dispatcher) dispatch(ctx context.Context, sched *Schedule, now time.Time) error {
+	created, runErr := d.Runs.Create(ctx, sched.WorkspaceID, sched.runOptions())
+	_, err := d.Schedules.update(ctx, sched.ID, func(sched *Schedule) error {
+		if runErr != nil {
+			sched.advance(now)
+		} else {
+			sched.recordRun(created.ID, now)
+		}
+		return nil
+	})
+	if runErr != nil {
+		return runErr
+	}
+	if err != nil {
+		return err
+	}
+	d.Logger.Info("queued scheduled run", "schedule", sched, "run", created.ID)
+	return nil
+}
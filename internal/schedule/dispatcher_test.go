@@ -0,0 +1,109 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tofutf/tofutf/internal/run"
+)
+
+func TestDispatcher_dispatchAll(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		schedules []*Schedule
+		// workspaces in which a run fails to be created
+		failures []string
+		want     []string
+	}{
+		{
+			name: "due schedules",
+			schedules: []*Schedule{
+				{ID: "sched-1", WorkspaceID: "ws-1", Cron: "@hourly", Operation: run.PlanOnlyOperation},
+				{ID: "sched-2", WorkspaceID: "ws-2", Cron: "@daily", Operation: run.DestroyAllOperation},
+			},
+			want: []string{"ws-1", "ws-2"},
+		},
+		{
+			name: "skip schedule that fails",
+			schedules: []*Schedule{
+				{ID: "sched-1", WorkspaceID: "ws-1", Cron: "@hourly", Operation: run.PlanOnlyOperation},
+				{ID: "sched-2", WorkspaceID: "ws-2", Cron: "@daily", Operation: run.DestroyAllOperation},
+			},
+			failures: []string{"ws-1"},
+			want:     []string{"ws-2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runs := &fakeDispatcherRunClient{failures: tt.failures}
+			schedules := &fakeDispatcherScheduleClient{schedules: tt.schedules}
+			dispatcher := &Dispatcher{
+				Logger:    slog.Default(),
+				Runs:      runs,
+				Schedules: schedules,
+			}
+
+			dispatcher.dispatchAll(ctx)
+
+			assert.Equal(t, tt.want, runs.created)
+			for _, opts := range runs.opts {
+				assert.Equal(t, run.SourceSchedule, opts.Source)
+			}
+			// every schedule is advanced, including those that fail
+			for _, sched := range tt.schedules {
+				assert.True(t, sched.NextRunAt.After(schedules.due), "schedule %s not advanced", sched.ID)
+			}
+			// only schedules that queued a run record it
+			for _, sched := range tt.schedules {
+				if sched.LastRunID != nil {
+					assert.Equal(t, "run-"+sched.WorkspaceID, *sched.LastRunID)
+				}
+			}
+		})
+	}
+}
+
+type fakeDispatcherRunClient struct {
+	failures []string
+	created  []string
+	opts     []run.CreateOptions
+}
+
+func (f *fakeDispatcherRunClient) Create(ctx context.Context, workspaceID string, opts run.CreateOptions) (*run.Run, error) {
+	for _, failure := range f.failures {
+		if failure == workspaceID {
+			return nil, errors.New("cannot create run")
+		}
+	}
+	f.created = append(f.created, workspaceID)
+	f.opts = append(f.opts, opts)
+	return &run.Run{ID: "run-" + workspaceID, WorkspaceID: workspaceID}, nil
+}
+
+type fakeDispatcherScheduleClient struct {
+	schedules []*Schedule
+	due       time.Time
+}
+
+func (f *fakeDispatcherScheduleClient) listDue(ctx context.Context, due time.Time) ([]*Schedule, error) {
+	f.due = due
+	return f.schedules, nil
+}
+
+func (f *fakeDispatcherScheduleClient) update(ctx context.Context, id string, updateFunc func(*Schedule) error) (*Schedule, error) {
+	for _, sched := range f.schedules {
+		if sched.ID == id {
+			if err := updateFunc(sched); err != nil {
+				return nil, err
+			}
+			return sched, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
@@ -0,0 +1,186 @@
+// Package schedule provides workspace schedules, which queue runs in a
+// workspace according to a cron expression.
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/run"
+)
+
+var (
+	ErrInvalidCron       = errors.New("invalid cron expression")
+	ErrInvalidOperation  = errors.New("invalid operation: must be one of plan-only, plan-and-apply, or destroy-all")
+	ErrAutoApplyPlanOnly = errors.New("auto-apply cannot be enabled for a plan-only schedule")
+)
+
+type (
+	// Schedule queues a run in a workspace whenever its cron expression is
+	// due. Cron expressions are evaluated in UTC.
+	Schedule struct {
+		ID          string        `jsonapi:"primary,schedules"`
+		CreatedAt   time.Time     `jsonapi:"attribute" json:"created_at"`
+		UpdatedAt   time.Time     `jsonapi:"attribute" json:"updated_at"`
+		WorkspaceID string        `jsonapi:"attribute" json:"workspace_id"`
+		Cron        string        `jsonapi:"attribute" json:"cron"`
+		Operation   run.Operation `jsonapi:"attribute" json:"operation"`
+		// AutoApply determines whether plan-and-apply and destroy-all runs are
+		// applied automatically, regardless of the workspace's auto-apply
+		// setting. If nil then the workspace's setting applies.
+		AutoApply *bool `jsonapi:"attribute" json:"auto_apply"`
+		// NextRunAt is when the schedule is next due.
+		NextRunAt time.Time `jsonapi:"attribute" json:"next_run_at"`
+		// LastRunAt and LastRunID are nil if the schedule has yet to queue a
+		// run.
+		LastRunAt *time.Time `jsonapi:"attribute" json:"last_run_at"`
+		LastRunID *string    `jsonapi:"attribute" json:"last_run_id"`
+	}
+
+	CreateOptions struct {
+		// Cron is a standard five-field cron expression, or a descriptor such
+		// as @daily.
+		Cron *string `json:"cron" schema:"cron,required"`
+		// Operation defaults to plan-only.
+		Operation *run.Operation `json:"operation,omitempty"`
+		AutoApply *bool          `json:"auto_apply,omitempty" schema:"auto_apply"`
+	}
+
+	UpdateOptions struct {
+		Cron      *string        `json:"cron,omitempty"`
+		Operation *run.Operation `json:"operation,omitempty"`
+		AutoApply *bool          `json:"auto_apply,omitempty" schema:"auto_apply"`
+		// ClearAutoApply unsets AutoApply, deferring to the workspace's
+		// auto-apply setting. It is invalid to specify true along with a
+		// non-nil AutoApply.
+		ClearAutoApply bool `json:"clear_auto_apply,omitempty" schema:"clear_auto_apply"`
+	}
+)
+
+func newSchedule(workspaceID string, opts CreateOptions) (*Schedule, error) {
+	if opts.Cron == nil {
+		return nil, &internal.MissingParameterError{Parameter: "cron"}
+	}
+	now := internal.CurrentTimestamp(nil)
+	sched := &Schedule{
+		ID:          internal.NewID("sched"),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		WorkspaceID: workspaceID,
+		Cron:        *opts.Cron,
+		Operation:   run.PlanOnlyOperation,
+	}
+	if opts.Operation != nil {
+		sched.Operation = *opts.Operation
+	}
+	sched.AutoApply = opts.AutoApply
+	if err := sched.validate(); err != nil {
+		return nil, err
+	}
+	sched.NextRunAt = sched.next(now)
+	return sched, nil
+}
+
+func (s *Schedule) update(opts UpdateOptions) error {
+	if opts.Cron != nil {
+		s.Cron = *opts.Cron
+	}
+	if opts.Operation != nil {
+		s.Operation = *opts.Operation
+	}
+	if opts.ClearAutoApply {
+		if opts.AutoApply != nil {
+			return errors.New("auto-apply must be nil if clear auto-apply is true")
+		}
+		s.AutoApply = nil
+	}
+	if opts.AutoApply != nil {
+		s.AutoApply = opts.AutoApply
+	}
+	if err := s.validate(); err != nil {
+		return err
+	}
+	s.UpdatedAt = internal.CurrentTimestamp(nil)
+	// the cron expression may have changed so re-calculate when it is due
+	s.NextRunAt = s.next(s.UpdatedAt)
+	return nil
+}
+
+// recordRun records that the schedule has queued a run, and advances the
+// schedule to its next due time.
+func (s *Schedule) recordRun(runID string, now time.Time) {
+	s.LastRunAt = &now
+	s.LastRunID = &runID
+	s.advance(now)
+}
+
+// advance the schedule to its next due time after now. Any occurrences missed
+// in the meantime, e.g. because the server was down, are skipped.
+func (s *Schedule) advance(now time.Time) {
+	s.NextRunAt = s.next(now)
+}
+
+// next returns the first time the schedule is due after t.
+func (s *Schedule) next(t time.Time) time.Time {
+	// cron expression has already been validated
+	sched, _ := cron.ParseStandard(s.Cron)
+	return sched.Next(t.UTC())
+}
+
+func (s *Schedule) validate() error {
+	if _, err := cron.ParseStandard(s.Cron); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidCron, err.Error())
+	}
+	switch s.Operation {
+	case run.PlanOnlyOperation:
+		if s.AutoApply != nil && *s.AutoApply {
+			return ErrAutoApplyPlanOnly
+		}
+	case run.PlanAndApplyOperation, run.DestroyAllOperation:
+	default:
+		return ErrInvalidOperation
+	}
+	return nil
+}
+
+// runOptions returns options for creating a run according to the schedule's
+// operation.
+func (s *Schedule) runOptions() run.CreateOptions {
+	opts := run.CreateOptions{
+		Source:  run.SourceSchedule,
+		Message: internal.String(fmt.Sprintf("Triggered by schedule %s (%s)", s.ID, s.Cron)),
+	}
+	switch s.Operation {
+	case run.PlanOnlyOperation:
+		opts.PlanOnly = internal.Bool(true)
+	case run.PlanAndApplyOperation:
+		opts.AutoApply = s.AutoApply
+	case run.DestroyAllOperation:
+		opts.IsDestroy = internal.Bool(true)
+		opts.AutoApply = s.AutoApply
+	}
+	return opts
+}
+
+// AutoApplyString returns "true" or "false" if the schedule's auto-apply
+// setting is set, or an empty string if it defers to the workspace.
+func (s *Schedule) AutoApplyString() string {
+	if s.AutoApply == nil {
+		return ""
+	}
+	return strconv.FormatBool(*s.AutoApply)
+}
+
+func (s *Schedule) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", s.ID),
+		slog.String("workspace_id", s.WorkspaceID),
+		slog.String("cron", s.Cron),
+		slog.String("operation", string(s.Operation)),
+	)
+}
@@ -0,0 +1,159 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/run"
+)
+
+func TestNewSchedule(t *testing.T) {
+	planAndApply := run.PlanAndApplyOperation
+
+	tests := []struct {
+		name    string
+		opts    CreateOptions
+		want    func(*testing.T, *Schedule)
+		wantErr error
+	}{
+		{
+			name: "defaults",
+			opts: CreateOptions{Cron: internal.String("0 2 * * *")},
+			want: func(t *testing.T, got *Schedule) {
+				assert.Equal(t, "ws-123", got.WorkspaceID)
+				assert.Equal(t, run.PlanOnlyOperation, got.Operation)
+				assert.Nil(t, got.AutoApply)
+				assert.Equal(t, 2, got.NextRunAt.Hour())
+				assert.Equal(t, 0, got.NextRunAt.Minute())
+				assert.True(t, got.NextRunAt.After(got.CreatedAt))
+				assert.Nil(t, got.LastRunAt)
+			},
+		},
+		{
+			name: "plan and apply with auto-apply",
+			opts: CreateOptions{Cron: internal.String("@weekly"), Operation: &planAndApply, AutoApply: internal.Bool(true)},
+			want: func(t *testing.T, got *Schedule) {
+				assert.Equal(t, run.PlanAndApplyOperation, got.Operation)
+				assert.True(t, *got.AutoApply)
+				assert.Equal(t, time.Sunday, got.NextRunAt.Weekday())
+			},
+		},
+		{
+			name:    "invalid cron expression",
+			opts:    CreateOptions{Cron: internal.String("every tuesday")},
+			wantErr: ErrInvalidCron,
+		},
+		{
+			name:    "invalid operation",
+			opts:    CreateOptions{Cron: internal.String("0 2 * * *"), Operation: (*run.Operation)(internal.String("refresh"))},
+			wantErr: ErrInvalidOperation,
+		},
+		{
+			name:    "auto-apply plan-only",
+			opts:    CreateOptions{Cron: internal.String("0 2 * * *"), AutoApply: internal.Bool(true)},
+			wantErr: ErrAutoApplyPlanOnly,
+		},
+		{
+			name:    "missing cron expression",
+			opts:    CreateOptions{},
+			wantErr: &internal.MissingParameterError{Parameter: "cron"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newSchedule("ws-123", tt.opts)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					assert.Equal(t, tt.wantErr, err)
+				}
+				return
+			}
+			require.NoError(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestSchedule_Update(t *testing.T) {
+	sched, err := newSchedule("ws-123", CreateOptions{Cron: internal.String("0 2 * * *")})
+	require.NoError(t, err)
+
+	t.Run("change cron expression", func(t *testing.T) {
+		err := sched.update(UpdateOptions{Cron: internal.String("30 4 * * *")})
+		require.NoError(t, err)
+
+		assert.Equal(t, 4, sched.NextRunAt.Hour())
+		assert.Equal(t, 30, sched.NextRunAt.Minute())
+	})
+
+	t.Run("invalid operation", func(t *testing.T) {
+		err := sched.update(UpdateOptions{Operation: (*run.Operation)(internal.String("refresh"))})
+		assert.Equal(t, ErrInvalidOperation, err)
+	})
+
+	t.Run("clear auto-apply", func(t *testing.T) {
+		sched, err := newSchedule("ws-123", CreateOptions{Cron: internal.String("0 2 * * *"), Operation: (*run.Operation)(internal.String("destroy-all")), AutoApply: internal.Bool(false)})
+		require.NoError(t, err)
+
+		err = sched.update(UpdateOptions{ClearAutoApply: true})
+		require.NoError(t, err)
+
+		assert.Nil(t, sched.AutoApply)
+	})
+}
+
+func TestSchedule_RecordRun(t *testing.T) {
+	sched := &Schedule{Cron: "0 * * * *"}
+	now := time.Date(2024, 5, 11, 9, 42, 0, 0, time.UTC)
+
+	sched.recordRun("run-123", now)
+
+	assert.Equal(t, &now, sched.LastRunAt)
+	assert.Equal(t, internal.String("run-123"), sched.LastRunID)
+	// missed occurrences are skipped
+	assert.Equal(t, time.Date(2024, 5, 11, 10, 0, 0, 0, time.UTC), sched.NextRunAt)
+}
+
+func TestSchedule_RunOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule *Schedule
+		want     run.CreateOptions
+	}{
+		{
+			name:     "plan-only",
+			schedule: &Schedule{Operation: run.PlanOnlyOperation},
+			want:     run.CreateOptions{PlanOnly: internal.Bool(true)},
+		},
+		{
+			name:     "plan-and-apply",
+			schedule: &Schedule{Operation: run.PlanAndApplyOperation, AutoApply: internal.Bool(true)},
+			want:     run.CreateOptions{AutoApply: internal.Bool(true)},
+		},
+		{
+			name:     "destroy-all",
+			schedule: &Schedule{Operation: run.DestroyAllOperation, AutoApply: internal.Bool(false)},
+			want:     run.CreateOptions{IsDestroy: internal.Bool(true), AutoApply: internal.Bool(false)},
+		},
+		{
+			name:     "defer to workspace auto-apply",
+			schedule: &Schedule{Operation: run.PlanAndApplyOperation},
+			want:     run.CreateOptions{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.schedule.runOptions()
+
+			assert.Equal(t, run.SourceSchedule, got.Source)
+			assert.NotNil(t, got.Message)
+			assert.Equal(t, tt.want.PlanOnly, got.PlanOnly)
+			assert.Equal(t, tt.want.IsDestroy, got.IsDestroy)
+			assert.Equal(t, tt.want.AutoApply, got.AutoApply)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package schedule
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/http/html"
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/run"
+	"github.com/tofutf/tofutf/internal/sql"
+	"github.com/tofutf/tofutf/internal/tfeapi"
+	"github.com/tofutf/tofutf/internal/workspace"
+)
+
+type (
+	Service struct {
+		logger              *slog.Logger
+		workspaceAuthorizer internal.Authorizer // authorize workspaces actions
+		runs                *run.Service
+		db                  *pgdb
+		api                 *api
+		web                 *webHandlers
+	}
+
+	Options struct {
+		*sql.Pool
+		*tfeapi.Responder
+		html.Renderer
+		Logger *slog.Logger
+
+		WorkspaceService *workspace.Service
+		RunService       *run.Service
+	}
+)
+
+func NewService(opts Options) *Service {
+	svc := Service{
+		logger:              opts.Logger,
+		workspaceAuthorizer: opts.WorkspaceService,
+		runs:                opts.RunService,
+		db:                  &pgdb{opts.Pool},
+	}
+	svc.api = &api{
+		Service:   &svc,
+		Responder: opts.Responder,
+	}
+	svc.web = &webHandlers{
+		Renderer:   opts.Renderer,
+		Service:    &svc,
+		workspaces: opts.WorkspaceService,
+	}
+	return &svc
+}
+
+func (s *Service) AddHandlers(r *mux.Router) {
+	s.api.addHandlers(r)
+	s.web.addHandlers(r)
+}
+
+// NewDispatcher constructs a dispatcher, which queues runs for schedules that
+// are due.
+func (s *Service) NewDispatcher(logger *slog.Logger) *Dispatcher {
+	return &Dispatcher{
+		Logger:        logger.With("component", "schedule-dispatcher"),
+		Runs:          s.runs,
+		Schedules:     s.db,
+		checkInterval: defaultCheckInterval,
+	}
+}
+
+func (s *Service) Create(ctx context.Context, workspaceID string, opts CreateOptions) (*Schedule, error) {
+	subject, err := s.workspaceAuthorizer.CanAccess(ctx, rbac.CreateScheduleAction, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	sched, err := newSchedule(workspaceID, opts)
+	if err != nil {
+		s.logger.Error("constructing schedule", "subject", subject, "err", err)
+		return nil, err
+	}
+
+	if err := s.db.create(ctx, sched); err != nil {
+		s.logger.Error("creating schedule", "schedule", sched, "subject", subject, "err", err)
+		return nil, err
+	}
+
+	s.logger.Info("created schedule", "schedule", sched, "subject", subject)
+	return sched, nil
+}
+
+func (s *Service) Get(ctx context.Context, id string) (*Schedule, error) {
+	sched, err := s.db.get(ctx, id)
+	if err != nil {
+		s.logger.Error("retrieving schedule", "id", id, "err", err)
+		return nil, err
+	}
+
+	subject, err := s.workspaceAuthorizer.CanAccess(ctx, rbac.GetScheduleAction, sched.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("retrieved schedule", "schedule", sched, "subject", subject)
+	return sched, nil
+}
+
+func (s *Service) List(ctx context.Context, workspaceID string) ([]*Schedule, error) {
+	subject, err := s.workspaceAuthorizer.CanAccess(ctx, rbac.ListSchedulesAction, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules, err := s.db.list(ctx, workspaceID)
+	if err != nil {
+		s.logger.Error("listing schedules", "id", workspaceID, "err", err)
+		return nil, err
+	}
+	s.logger.Debug("listed schedules", "total", len(schedules), "subject", subject)
+	return schedules, nil
+}
+
+func (s *Service) Update(ctx context.Context, id string, opts UpdateOptions) (*Schedule, error) {
+	sched, err := s.db.get(ctx, id)
+	if err != nil {
+		s.logger.Error("retrieving schedule", "id", id, "err", err)
+		return nil, err
+	}
+
+	subject, err := s.workspaceAuthorizer.CanAccess(ctx, rbac.UpdateScheduleAction, sched.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.db.update(ctx, id, func(sched *Schedule) error {
+		return sched.update(opts)
+	})
+	if err != nil {
+		s.logger.Error("updating schedule", "id", id, "subject", subject, "err", err)
+		return nil, err
+	}
+
+	s.logger.Info("updated schedule", "schedule", updated, "subject", subject)
+	return updated, nil
+}
+
+func (s *Service) Delete(ctx context.Context, id string) (*Schedule, error) {
+	sched, err := s.db.get(ctx, id)
+	if err != nil {
+		s.logger.Error("retrieving schedule", "id", id, "err", err)
+		return nil, err
+	}
+
+	subject, err := s.workspaceAuthorizer.CanAccess(ctx, rbac.DeleteScheduleAction, sched.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.db.delete(ctx, id); err != nil {
+		s.logger.Error("deleting schedule", "id", id, "err", err)
+		return nil, err
+	}
+
+	s.logger.Info("deleted schedule", "schedule", sched, "subject", subject)
+	return sched, nil
+}
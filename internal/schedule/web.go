@@ -0,0 +1,237 @@
+package schedule
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/http/decode"
+	"github.com/tofutf/tofutf/internal/http/html"
+	"github.com/tofutf/tofutf/internal/http/html/paths"
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/run"
+	"github.com/tofutf/tofutf/internal/workspace"
+)
+
+// operations are the run operations a schedule can perform.
+var operations = []run.Operation{
+	run.PlanOnlyOperation,
+	run.PlanAndApplyOperation,
+	run.DestroyAllOperation,
+}
+
+type webHandlers struct {
+	html.Renderer
+	*Service
+
+	workspaces *workspace.Service
+}
+
+func (h *webHandlers) addHandlers(r *mux.Router) {
+	r = html.UIRouter(r)
+
+	r.HandleFunc("/workspaces/{workspace_id}/schedules", h.listSchedules).Methods("GET")
+	r.HandleFunc("/workspaces/{workspace_id}/schedules/new", h.newSchedule).Methods("GET")
+	r.HandleFunc("/workspaces/{workspace_id}/schedules/create", h.createSchedule).Methods("POST")
+	r.HandleFunc("/schedules/{schedule_id}/edit", h.editSchedule).Methods("GET")
+	r.HandleFunc("/schedules/{schedule_id}/update", h.updateSchedule).Methods("POST")
+	r.HandleFunc("/schedules/{schedule_id}/delete", h.deleteSchedule).Methods("POST")
+}
+
+func (h *webHandlers) listSchedules(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := decode.Param("workspace_id", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	schedules, err := h.List(r.Context(), workspaceID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	ws, err := h.workspaces.Get(r.Context(), workspaceID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	policy, err := h.workspaces.GetPolicy(r.Context(), workspaceID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	user, err := internal.SubjectFromContext(r.Context())
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.Render("schedule_list.tmpl", w, struct {
+		workspace.WorkspacePage
+		Schedules          []*Schedule
+		CanCreateSchedule  bool
+		CanDeleteSchedule  bool
+		CanUpdateWorkspace bool
+	}{
+		WorkspacePage:      workspace.NewPage(r, "schedules", ws),
+		Schedules:          schedules,
+		CanCreateSchedule:  user.CanAccessWorkspace(rbac.CreateScheduleAction, policy),
+		CanDeleteSchedule:  user.CanAccessWorkspace(rbac.DeleteScheduleAction, policy),
+		CanUpdateWorkspace: user.CanAccessWorkspace(rbac.UpdateWorkspaceAction, policy),
+	})
+}
+
+func (h *webHandlers) newSchedule(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := decode.Param("workspace_id", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	ws, err := h.workspaces.Get(r.Context(), workspaceID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.Render("schedule_new.tmpl", w, struct {
+		workspace.WorkspacePage
+		Schedule   *Schedule
+		Operations []run.Operation
+		FormAction string
+	}{
+		WorkspacePage: workspace.NewPage(r, "new schedule", ws),
+		Schedule:      &Schedule{Operation: run.PlanOnlyOperation},
+		Operations:    operations,
+		FormAction:    paths.CreateSchedule(workspaceID),
+	})
+}
+
+func (h *webHandlers) createSchedule(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		WorkspaceID string         `schema:"workspace_id,required"`
+		Cron        *string        `schema:"cron,required"`
+		Operation   *run.Operation `schema:"operation,required"`
+		// AutoApply is empty to defer to the workspace's setting
+		AutoApply string `schema:"auto_apply"`
+	}
+	if err := decode.All(&params, r); err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	autoApply, err := parseAutoApply(params.AutoApply)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	sched, err := h.Create(r.Context(), params.WorkspaceID, CreateOptions{
+		Cron:      params.Cron,
+		Operation: params.Operation,
+		AutoApply: autoApply,
+	})
+	if err != nil {
+		html.FlashError(w, err.Error())
+		http.Redirect(w, r, paths.NewSchedule(params.WorkspaceID), http.StatusFound)
+		return
+	}
+
+	html.FlashSuccess(w, "added schedule: "+sched.Cron)
+	http.Redirect(w, r, paths.Schedules(params.WorkspaceID), http.StatusFound)
+}
+
+func (h *webHandlers) editSchedule(w http.ResponseWriter, r *http.Request) {
+	scheduleID, err := decode.Param("schedule_id", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	sched, err := h.Get(r.Context(), scheduleID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	ws, err := h.workspaces.Get(r.Context(), sched.WorkspaceID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.Render("schedule_edit.tmpl", w, struct {
+		workspace.WorkspacePage
+		Schedule   *Schedule
+		Operations []run.Operation
+		FormAction string
+	}{
+		WorkspacePage: workspace.NewPage(r, "edit schedule", ws),
+		Schedule:      sched,
+		Operations:    operations,
+		FormAction:    paths.UpdateSchedule(sched.ID),
+	})
+}
+
+func (h *webHandlers) updateSchedule(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		ScheduleID string         `schema:"schedule_id,required"`
+		Cron       *string        `schema:"cron,required"`
+		Operation  *run.Operation `schema:"operation,required"`
+		// AutoApply is empty to defer to the workspace's setting
+		AutoApply string `schema:"auto_apply"`
+	}
+	if err := decode.All(&params, r); err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	autoApply, err := parseAutoApply(params.AutoApply)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	sched, err := h.Update(r.Context(), params.ScheduleID, UpdateOptions{
+		Cron:           params.Cron,
+		Operation:      params.Operation,
+		AutoApply:      autoApply,
+		ClearAutoApply: autoApply == nil,
+	})
+	if err != nil {
+		html.FlashError(w, err.Error())
+		http.Redirect(w, r, paths.EditSchedule(params.ScheduleID), http.StatusFound)
+		return
+	}
+
+	html.FlashSuccess(w, "updated schedule: "+sched.Cron)
+	http.Redirect(w, r, paths.Schedules(sched.WorkspaceID), http.StatusFound)
+}
+
+func (h *webHandlers) deleteSchedule(w http.ResponseWriter, r *http.Request) {
+	scheduleID, err := decode.Param("schedule_id", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	sched, err := h.Delete(r.Context(), scheduleID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	html.FlashSuccess(w, "deleted schedule: "+sched.Cron)
+	http.Redirect(w, r, paths.Schedules(sched.WorkspaceID), http.StatusFound)
+}
+
+// parseAutoApply parses the auto-apply form value, returning nil if it is empty.
+func parseAutoApply(value string) (*bool, error) {
+	if value == "" {
+		return nil, nil
+	}
+	autoApply, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auto-apply value: %s", value)
+	}
+	return &autoApply, nil
+}
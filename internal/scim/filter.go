@@ -0,0 +1,47 @@
+package scim
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// filterRegex matches the only filter expression supported, an equality
+// comparison of an attribute with a string value, e.g. userName eq "bobby".
+var filterRegex = regexp.MustCompile(`^\s*(\w+)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*")\s*$`)
+
+// filter is a parsed filter expression.
+type filter struct {
+	attribute string
+	value     string
+}
+
+// parseFilter parses the filter query parameter, checking the attribute is one
+// of those permitted. If there is no filter then nil is returned.
+func parseFilter(r *http.Request, permitted ...string) (*filter, error) {
+	expr := r.URL.Query().Get("filter")
+	if expr == "" {
+		return nil, nil
+	}
+	invalid := &Error{
+		Status:   http.StatusBadRequest,
+		ScimType: "invalidFilter",
+		Detail:   "unsupported filter: " + expr,
+	}
+	matches := filterRegex.FindStringSubmatch(expr)
+	if matches == nil {
+		return nil, invalid
+	}
+	value, err := strconv.Unquote(matches[2])
+	if err != nil {
+		return nil, invalid
+	}
+	for _, attr := range permitted {
+		// attribute names are case-insensitive
+		if strings.EqualFold(attr, matches[1]) {
+			return &filter{attribute: attr, value: value}, nil
+		}
+	}
+	return nil, invalid
+}
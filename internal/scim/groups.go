@@ -0,0 +1,361 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/team"
+	"github.com/tofutf/tofutf/internal/user"
+)
+
+// memberPathRegex matches a patch path selecting a single group member, e.g.
+// members[value eq "user-123"]
+var memberPathRegex = regexp.MustCompile(`^members\[\s*value\s+(?i:eq)\s+"([^"]*)"\s*\]$`)
+
+func (s *server) listGroups(w http.ResponseWriter, r *http.Request) {
+	f, err := parseFilter(r, "displayName")
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	teams, err := s.teams.List(r.Context(), s.organization)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if f != nil {
+		teams = slices.DeleteFunc(teams, func(t *team.Team) bool {
+			return t.Name != f.value
+		})
+	}
+	slices.SortFunc(teams, func(a, b *team.Team) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	resources := make([]*Group, len(teams))
+	for i, t := range teams {
+		group, err := s.toGroup(r, t)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		resources[i] = group
+	}
+	respond(w, http.StatusOK, newListResponse(r, resources))
+}
+
+func (s *server) createGroup(w http.ResponseWriter, r *http.Request) {
+	var params Group
+	if err := decode(r, &params); err != nil {
+		writeError(w, err)
+		return
+	}
+	opts := team.CreateTeamOptions{Name: &params.DisplayName}
+	if params.ExternalID != "" {
+		opts.SSOTeamID = &params.ExternalID
+	}
+	created, err := s.teams.Create(r.Context(), s.organization, opts)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := s.addMembers(r.Context(), created.ID, params.Members); err != nil {
+		writeError(w, err)
+		return
+	}
+	group, err := s.toGroup(r, created)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respond(w, http.StatusCreated, group)
+}
+
+func (s *server) getGroup(w http.ResponseWriter, r *http.Request) {
+	t, err := s.lookupTeam(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	group, err := s.toGroup(r, t)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respond(w, http.StatusOK, group)
+}
+
+// replaceGroup handles a PUT request, which replaces the group's attributes
+// and members.
+func (s *server) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	var params Group
+	if err := decode(r, &params); err != nil {
+		writeError(w, err)
+		return
+	}
+	t, err := s.lookupTeam(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	opts := team.UpdateTeamOptions{Name: &params.DisplayName}
+	// leave the team's SSO ID untouched if the identity provider does not
+	// send an external ID
+	if params.ExternalID != "" {
+		opts.SSOTeamID = &params.ExternalID
+	}
+	t, err = s.teams.Update(r.Context(), t.ID, opts)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := s.syncMembers(r.Context(), t.ID, params.Members); err != nil {
+		writeError(w, err)
+		return
+	}
+	group, err := s.toGroup(r, t)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respond(w, http.StatusOK, group)
+}
+
+// patchGroup handles a PATCH request, which modifies the group's attributes
+// and/or members.
+func (s *server) patchGroup(w http.ResponseWriter, r *http.Request) {
+	var params PatchRequest
+	if err := decode(r, &params); err != nil {
+		writeError(w, err)
+		return
+	}
+	t, err := s.lookupTeam(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	for _, op := range params.Operations {
+		if err := s.groupPatchOperation(r.Context(), t, op); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *server) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	t, err := s.lookupTeam(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := s.teams.Delete(r.Context(), t.ID); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// lookupTeam retrieves a team by ID, ensuring it belongs to the SCIM
+// organization.
+func (s *server) lookupTeam(ctx context.Context, id string) (*team.Team, error) {
+	t, err := s.teams.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.Organization != s.organization {
+		return nil, internal.ErrResourceNotFound
+	}
+	return t, nil
+}
+
+func (s *server) groupPatchOperation(ctx context.Context, t *team.Team, op PatchOperation) error {
+	path := strings.ToLower(op.Path)
+	switch strings.ToLower(op.Op) {
+	case "add":
+		if path != "members" {
+			break
+		}
+		members, err := parseMembers(op.Value)
+		if err != nil {
+			return err
+		}
+		return s.addMembers(ctx, t.ID, members)
+	case "remove":
+		if path == "members" {
+			members, err := parseMembers(op.Value)
+			if err != nil {
+				return err
+			}
+			return s.removeMembers(ctx, t.ID, members)
+		}
+		if matches := memberPathRegex.FindStringSubmatch(op.Path); matches != nil {
+			return s.removeMembers(ctx, t.ID, []Member{{Value: matches[1]}})
+		}
+	case "replace":
+		attrs := map[string]json.RawMessage{}
+		if path == "" {
+			values, err := parseAttributes(op.Value)
+			if err != nil {
+				return err
+			}
+			for k, v := range values {
+				attrs[strings.ToLower(k)] = v
+			}
+		} else {
+			attrs[path] = op.Value
+		}
+		var opts team.UpdateTeamOptions
+		for attr, value := range attrs {
+			switch attr {
+			case "displayname":
+				name, err := parseString(value)
+				if err != nil {
+					return err
+				}
+				opts.Name = &name
+			case "externalid":
+				externalID, err := parseString(value)
+				if err != nil {
+					return err
+				}
+				opts.SSOTeamID = &externalID
+			case "members":
+				members, err := parseMembers(value)
+				if err != nil {
+					return err
+				}
+				if err := s.syncMembers(ctx, t.ID, members); err != nil {
+					return err
+				}
+			default:
+				return &Error{
+					Status:   http.StatusBadRequest,
+					ScimType: "invalidPath",
+					Detail:   "unsupported attribute: " + attr,
+				}
+			}
+		}
+		if opts.Name == nil && opts.SSOTeamID == nil {
+			return nil
+		}
+		_, err := s.teams.Update(ctx, t.ID, opts)
+		return err
+	default:
+		return invalidValue("unsupported operation: " + op.Op)
+	}
+	return &Error{
+		Status:   http.StatusBadRequest,
+		ScimType: "invalidPath",
+		Detail:   "unsupported path: " + op.Path,
+	}
+}
+
+// addMembers adds members to a team, skipping those that are already members.
+func (s *server) addMembers(ctx context.Context, teamID string, members []Member) error {
+	if len(members) == 0 {
+		return nil
+	}
+	existing, err := s.users.ListTeamUsers(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	var usernames []string
+	for _, m := range members {
+		if slices.ContainsFunc(existing, func(u *user.User) bool { return u.ID == m.Value }) {
+			continue
+		}
+		u, err := s.lookupUser(ctx, m.Value)
+		if err != nil {
+			return err
+		}
+		usernames = append(usernames, u.Username)
+	}
+	if len(usernames) == 0 {
+		return nil
+	}
+	return s.users.AddTeamMembership(ctx, teamID, usernames)
+}
+
+// removeMembers removes members from a team, skipping those that are not
+// members.
+func (s *server) removeMembers(ctx context.Context, teamID string, members []Member) error {
+	existing, err := s.users.ListTeamUsers(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	var usernames []string
+	for _, u := range existing {
+		if slices.ContainsFunc(members, func(m Member) bool { return m.Value == u.ID }) {
+			usernames = append(usernames, u.Username)
+		}
+	}
+	if len(usernames) == 0 {
+		return nil
+	}
+	return s.users.RemoveTeamMembership(ctx, teamID, usernames)
+}
+
+// syncMembers makes the team's members match the given members, adding and
+// removing members accordingly.
+func (s *server) syncMembers(ctx context.Context, teamID string, members []Member) error {
+	existing, err := s.users.ListTeamUsers(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	var remove []Member
+	for _, u := range existing {
+		if !slices.ContainsFunc(members, func(m Member) bool { return m.Value == u.ID }) {
+			remove = append(remove, Member{Value: u.ID})
+		}
+	}
+	if err := s.addMembers(ctx, teamID, members); err != nil {
+		return err
+	}
+	return s.removeMembers(ctx, teamID, remove)
+}
+
+// parseMembers parses the value of a patch operation targeting members.
+func parseMembers(raw json.RawMessage) ([]Member, error) {
+	var members []Member
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	if err := json.Unmarshal(raw, &members); err != nil {
+		return nil, invalidValue("expected an array of members")
+	}
+	return members, nil
+}
+
+func (s *server) toGroup(r *http.Request, from *team.Team) (*Group, error) {
+	to := &Group{
+		Schemas:     []string{GroupSchema},
+		ID:          from.ID,
+		DisplayName: from.Name,
+		Meta: &Meta{
+			ResourceType: "Group",
+			Created:      from.CreatedAt,
+		},
+	}
+	if from.SSOTeamID != nil {
+		to.ExternalID = *from.SSOTeamID
+	}
+	// identity providers typically exclude members when listing groups, to
+	// avoid retrieving potentially large numbers of members.
+	if strings.Contains(r.URL.Query().Get("excludedAttributes"), "members") {
+		return to, nil
+	}
+	members, err := s.users.ListTeamUsers(r.Context(), from.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		to.Members = append(to.Members, Member{Value: m.ID, Display: m.Username})
+	}
+	return to, nil
+}
@@ -0,0 +1,174 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/team"
+	"github.com/tofutf/tofutf/internal/user"
+)
+
+func TestServer_CreateGroup(t *testing.T) {
+	bobby := user.NewUser("bobby")
+	users := newFakeUserService(bobby)
+	teams := &fakeTeamService{}
+	router := newTestRouter(t, users, teams)
+
+	body := `{"displayName":"devs","externalId":"grp-123","members":[{"value":"` + bobby.ID + `"}]}`
+	w := serve(router, "POST", "/scim/v2/Groups", body)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	var got Group
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	assert.Equal(t, "devs", got.DisplayName)
+	assert.Equal(t, "grp-123", got.ExternalID)
+	assert.Equal(t, []Member{{Value: bobby.ID, Display: "bobby"}}, got.Members)
+
+	require.Len(t, teams.teams, 1)
+	assert.Equal(t, "acme", teams.teams[0].Organization)
+	assert.Equal(t, []string{"bobby"}, users.members[got.ID])
+}
+
+func TestServer_ListGroups(t *testing.T) {
+	teams := &fakeTeamService{teams: []*team.Team{
+		{ID: "team-devs", Name: "devs", Organization: "acme"},
+		{ID: "team-ops", Name: "ops", Organization: "acme"},
+		{ID: "team-other", Name: "other", Organization: "other-org"},
+	}}
+	users := newFakeUserService(user.NewUser("bobby"))
+	users.members["team-devs"] = []string{"bobby"}
+	router := newTestRouter(t, users, teams)
+
+	t.Run("all", func(t *testing.T) {
+		got := listGroups(t, router, "")
+		require.Equal(t, 2, got.TotalResults)
+		assert.Equal(t, "devs", got.Resources[0].DisplayName)
+		assert.Len(t, got.Resources[0].Members, 1)
+		assert.Equal(t, "ops", got.Resources[1].DisplayName)
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		got := listGroups(t, router, `?filter=displayName+eq+"ops"`)
+		require.Equal(t, 1, got.TotalResults)
+		assert.Equal(t, "ops", got.Resources[0].DisplayName)
+	})
+
+	t.Run("exclude members", func(t *testing.T) {
+		got := listGroups(t, router, "?excludedAttributes=members")
+		require.Equal(t, 2, got.TotalResults)
+		assert.Empty(t, got.Resources[0].Members)
+	})
+
+	t.Run("group in another organization", func(t *testing.T) {
+		w := serve(router, "GET", "/scim/v2/Groups/team-other", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestServer_PatchGroup(t *testing.T) {
+	bobby := user.NewUser("bobby")
+	alice := user.NewUser("alice")
+	devs := &team.Team{ID: "team-devs", Name: "devs", Organization: "acme"}
+	users := newFakeUserService(bobby, alice)
+	router := newTestRouter(t, users, &fakeTeamService{teams: []*team.Team{devs}})
+
+	tests := []struct {
+		name        string
+		operations  string
+		wantMembers []string
+		wantName    string
+	}{
+		{
+			name:        "add members",
+			operations:  `[{"op":"add","path":"members","value":[{"value":"` + bobby.ID + `"},{"value":"` + alice.ID + `"}]}]`,
+			wantMembers: []string{"bobby", "alice"},
+			wantName:    "devs",
+		},
+		{
+			name:        "add existing member",
+			operations:  `[{"op":"add","path":"members","value":[{"value":"` + bobby.ID + `"}]}]`,
+			wantMembers: []string{"bobby", "alice"},
+			wantName:    "devs",
+		},
+		{
+			name:        "remove member by filter",
+			operations:  `[{"op":"remove","path":"members[value eq \"` + bobby.ID + `\"]"}]`,
+			wantMembers: []string{"alice"},
+			wantName:    "devs",
+		},
+		{
+			name:        "replace members",
+			operations:  `[{"op":"replace","path":"members","value":[{"value":"` + bobby.ID + `"}]}]`,
+			wantMembers: []string{"bobby"},
+			wantName:    "devs",
+		},
+		{
+			name:        "rename",
+			operations:  `[{"op":"replace","value":{"displayName":"developers"}}]`,
+			wantMembers: []string{"bobby"},
+			wantName:    "developers",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":` + tt.operations + `}`
+			w := serve(router, "PATCH", "/scim/v2/Groups/team-devs", body)
+			require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+			assert.Equal(t, tt.wantMembers, users.members["team-devs"])
+			assert.Equal(t, tt.wantName, devs.Name)
+		})
+	}
+}
+
+func TestServer_ReplaceGroup(t *testing.T) {
+	bobby := user.NewUser("bobby")
+	devs := &team.Team{ID: "team-devs", Name: "devs", Organization: "acme", SSOTeamID: internal.String("grp-123")}
+	users := newFakeUserService(bobby)
+	router := newTestRouter(t, users, &fakeTeamService{teams: []*team.Team{devs}})
+
+	t.Run("without external ID", func(t *testing.T) {
+		body := `{"displayName":"developers","members":[{"value":"` + bobby.ID + `"}]}`
+		w := serve(router, "PUT", "/scim/v2/Groups/team-devs", body)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		assert.Equal(t, "developers", devs.Name)
+		// the existing SSO ID is retained
+		assert.Equal(t, internal.String("grp-123"), devs.SSOTeamID)
+		assert.Equal(t, []string{"bobby"}, users.members["team-devs"])
+	})
+
+	t.Run("with external ID", func(t *testing.T) {
+		body := `{"displayName":"developers","externalId":"grp-456"}`
+		w := serve(router, "PUT", "/scim/v2/Groups/team-devs", body)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		assert.Equal(t, internal.String("grp-456"), devs.SSOTeamID)
+	})
+}
+
+func TestServer_DeleteGroup(t *testing.T) {
+	teams := &fakeTeamService{teams: []*team.Team{
+		{ID: "team-devs", Name: "devs", Organization: "acme"},
+	}}
+	router := newTestRouter(t, newFakeUserService(), teams)
+
+	w := serve(router, "DELETE", "/scim/v2/Groups/team-devs", "")
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Empty(t, teams.teams)
+}
+
+func listGroups(t *testing.T, router http.Handler, query string) (got struct {
+	ListResponse
+	Resources []Group `json:"Resources"`
+}) {
+	t.Helper()
+
+	w := serve(router, "GET", "/scim/v2/Groups"+query, "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	return got
+}
@@ -0,0 +1,170 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/team"
+	"github.com/tofutf/tofutf/internal/user"
+)
+
+const testToken = "scim-token"
+
+type (
+	fakeUserService struct {
+		users []*user.User
+		// members maps team IDs to usernames
+		members map[string][]string
+	}
+
+	fakeTeamService struct {
+		teams []*team.Team
+	}
+)
+
+// newTestRouter constructs a router with SCIM routes, with the given users
+// and teams, and groups mapped to teams in the acme organization.
+func newTestRouter(t *testing.T, users *fakeUserService, teams *fakeTeamService) *mux.Router {
+	t.Helper()
+
+	srv := &server{
+		token:        testToken,
+		organization: "acme",
+		users:        users,
+		teams:        teams,
+	}
+	r := mux.NewRouter()
+	srv.AddHandlers(r)
+	return r
+}
+
+func newFakeUserService(users ...*user.User) *fakeUserService {
+	return &fakeUserService{users: users, members: make(map[string][]string)}
+}
+
+func (f *fakeUserService) Create(ctx context.Context, username string, opts ...user.NewUserOption) (*user.User, error) {
+	if slices.ContainsFunc(f.users, func(u *user.User) bool { return u.Username == username }) {
+		return nil, internal.ErrResourceAlreadyExists
+	}
+	u := user.NewUser(username, opts...)
+	f.users = append(f.users, u)
+	return u, nil
+}
+
+func (f *fakeUserService) GetUser(ctx context.Context, spec user.UserSpec) (*user.User, error) {
+	for _, u := range f.users {
+		if spec.UserID != nil && u.ID == *spec.UserID {
+			return u, nil
+		}
+		if spec.Username != nil && u.Username == *spec.Username {
+			return u, nil
+		}
+	}
+	return nil, internal.ErrResourceNotFound
+}
+
+func (f *fakeUserService) List(ctx context.Context) ([]*user.User, error) {
+	return slices.Clone(f.users), nil
+}
+
+func (f *fakeUserService) Update(ctx context.Context, userID string, opts user.UpdateUserOptions) (*user.User, error) {
+	u, err := f.GetUser(ctx, user.UserSpec{UserID: &userID})
+	if err != nil {
+		return nil, err
+	}
+	if err := u.Update(opts); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (f *fakeUserService) Delete(ctx context.Context, username string) error {
+	f.users = slices.DeleteFunc(f.users, func(u *user.User) bool { return u.Username == username })
+	return nil
+}
+
+func (f *fakeUserService) ListTeamUsers(ctx context.Context, teamID string) ([]*user.User, error) {
+	var users []*user.User
+	for _, username := range f.members[teamID] {
+		u, err := f.GetUser(ctx, user.UserSpec{Username: &username})
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+func (f *fakeUserService) AddTeamMembership(ctx context.Context, teamID string, usernames []string) error {
+	f.members[teamID] = append(f.members[teamID], usernames...)
+	return nil
+}
+
+func (f *fakeUserService) RemoveTeamMembership(ctx context.Context, teamID string, usernames []string) error {
+	f.members[teamID] = slices.DeleteFunc(f.members[teamID], func(username string) bool {
+		return slices.Contains(usernames, username)
+	})
+	return nil
+}
+
+func (f *fakeTeamService) Create(ctx context.Context, organization string, opts team.CreateTeamOptions) (*team.Team, error) {
+	t := &team.Team{
+		ID:           internal.NewID("team"),
+		Name:         *opts.Name,
+		CreatedAt:    internal.CurrentTimestamp(nil),
+		Organization: organization,
+		SSOTeamID:    opts.SSOTeamID,
+	}
+	f.teams = append(f.teams, t)
+	return t, nil
+}
+
+func (f *fakeTeamService) GetByID(ctx context.Context, teamID string) (*team.Team, error) {
+	for _, t := range f.teams {
+		if t.ID == teamID {
+			return t, nil
+		}
+	}
+	return nil, internal.ErrResourceNotFound
+}
+
+func (f *fakeTeamService) List(ctx context.Context, organization string) ([]*team.Team, error) {
+	var teams []*team.Team
+	for _, t := range f.teams {
+		if t.Organization == organization {
+			teams = append(teams, t)
+		}
+	}
+	return teams, nil
+}
+
+func (f *fakeTeamService) Update(ctx context.Context, teamID string, opts team.UpdateTeamOptions) (*team.Team, error) {
+	t, err := f.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.Update(opts); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (f *fakeTeamService) Delete(ctx context.Context, teamID string) error {
+	f.teams = slices.DeleteFunc(f.teams, func(t *team.Team) bool { return t.ID == teamID })
+	return nil
+}
+
+// serve sends an authenticated request to the router.
+func serve(router http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	r.Header.Set("Authorization", "Bearer "+testToken)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+	return w
+}
@@ -0,0 +1,216 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/team"
+	"github.com/tofutf/tofutf/internal/user"
+)
+
+const (
+	UserSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	GroupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	ServiceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	ListResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	PatchOpSchema               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ErrorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	// maxResults is the maximum number of resources returned in a list
+	// response.
+	maxResults = 1000
+)
+
+type (
+	// User is a SCIM user resource.
+	User struct {
+		Schemas  []string `json:"schemas"`
+		ID       string   `json:"id,omitempty"`
+		UserName string   `json:"userName"`
+		// Active is nil if unspecified in a request.
+		Active *bool `json:"active,omitempty"`
+		// Groups lists the groups to which the user belongs. Read-only.
+		Groups []Member `json:"groups,omitempty"`
+		Meta   *Meta    `json:"meta,omitempty"`
+	}
+
+	// Group is a SCIM group resource.
+	Group struct {
+		Schemas     []string `json:"schemas"`
+		ID          string   `json:"id,omitempty"`
+		ExternalID  string   `json:"externalId,omitempty"`
+		DisplayName string   `json:"displayName"`
+		Members     []Member `json:"members,omitempty"`
+		Meta        *Meta    `json:"meta,omitempty"`
+	}
+
+	// Member is a member of a group, or a group to which a user belongs,
+	// identified by the ID of the user or group respectively.
+	Member struct {
+		Value   string `json:"value"`
+		Display string `json:"display,omitempty"`
+	}
+
+	// Meta is metadata common to all resources.
+	Meta struct {
+		ResourceType string     `json:"resourceType"`
+		Created      time.Time  `json:"created"`
+		LastModified *time.Time `json:"lastModified,omitempty"`
+	}
+
+	// ListResponse is the response to a query for resources.
+	ListResponse struct {
+		Schemas      []string `json:"schemas"`
+		TotalResults int      `json:"totalResults"`
+		StartIndex   int      `json:"startIndex"`
+		ItemsPerPage int      `json:"itemsPerPage"`
+		Resources    []any    `json:"Resources"`
+	}
+
+	// PatchRequest is a request to modify a resource.
+	PatchRequest struct {
+		Schemas    []string         `json:"schemas"`
+		Operations []PatchOperation `json:"Operations"`
+	}
+
+	// PatchOperation is a single modification to a resource. If Path is
+	// empty then Value is an object of attributes to modify.
+	PatchOperation struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path,omitempty"`
+		Value json.RawMessage `json:"value,omitempty"`
+	}
+
+	// Error is a SCIM error response.
+	Error struct {
+		Status int
+		// ScimType is an optional SCIM detail error keyword, e.g.
+		// uniqueness.
+		ScimType string
+		Detail   string
+	}
+)
+
+func (e *Error) Error() string { return e.Detail }
+
+func (e *Error) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Schemas  []string `json:"schemas"`
+		Status   string   `json:"status"`
+		ScimType string   `json:"scimType,omitempty"`
+		Detail   string   `json:"detail,omitempty"`
+	}{
+		Schemas:  []string{ErrorSchema},
+		Status:   strconv.Itoa(e.Status),
+		ScimType: e.ScimType,
+		Detail:   e.Detail,
+	})
+}
+
+func invalidValue(detail string) *Error {
+	return &Error{Status: http.StatusBadRequest, ScimType: "invalidValue", Detail: detail}
+}
+
+// toError converts an error into a SCIM error.
+func toError(err error) *Error {
+	var scimErr *Error
+	switch {
+	case errors.As(err, &scimErr):
+		return scimErr
+	case errors.Is(err, internal.ErrResourceNotFound):
+		return &Error{Status: http.StatusNotFound, Detail: "resource not found"}
+	case errors.Is(err, internal.ErrResourceAlreadyExists), errors.Is(err, internal.ErrConflict):
+		return &Error{Status: http.StatusConflict, ScimType: "uniqueness", Detail: err.Error()}
+	case errors.Is(err, internal.ErrAccessNotPermitted):
+		return &Error{Status: http.StatusForbidden, Detail: err.Error()}
+	case errors.Is(err, internal.ErrEmptyValue),
+		errors.Is(err, user.ErrCannotDeleteOnlyOwner),
+		errors.Is(err, team.ErrRemovingOwnersTeamNotPermitted):
+		return invalidValue(err.Error())
+	default:
+		return &Error{Status: http.StatusInternalServerError, Detail: err.Error()}
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	scimErr := toError(err)
+	respond(w, scimErr.Status, scimErr)
+}
+
+func respond(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v) //nolint:errcheck
+}
+
+func decode(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return &Error{Status: http.StatusBadRequest, ScimType: "invalidSyntax", Detail: err.Error()}
+	}
+	return nil
+}
+
+// newListResponse paginates resources according to the startIndex and count
+// query parameters.
+func newListResponse[T any](r *http.Request, resources []T) *ListResponse {
+	start, err := strconv.Atoi(r.URL.Query().Get("startIndex"))
+	if err != nil || start < 1 {
+		start = 1
+	}
+	count, err := strconv.Atoi(r.URL.Query().Get("count"))
+	if err != nil || count < 0 || count > maxResults {
+		count = maxResults
+	}
+	page := []any{}
+	for i := start - 1; i < len(resources) && len(page) < count; i++ {
+		page = append(page, resources[i])
+	}
+	return &ListResponse{
+		Schemas:      []string{ListResponseSchema},
+		TotalResults: len(resources),
+		StartIndex:   start,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}
+}
+
+// parseBool parses a boolean value, which some identity providers send as a
+// string, e.g. "False".
+func parseBool(raw json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return false, invalidValue("expected a boolean value")
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, invalidValue("expected a boolean value")
+	}
+	return b, nil
+}
+
+// parseString parses a string value.
+func parseString(raw json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return "", invalidValue("expected a string value")
+	}
+	return s, nil
+}
+
+// parseAttributes parses the value of a patch operation without a path, which
+// is an object of attribute names and values.
+func parseAttributes(raw json.RawMessage) (map[string]json.RawMessage, error) {
+	var attrs map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &attrs); err != nil {
+		return nil, invalidValue("expected an object of attributes")
+	}
+	return attrs, nil
+}
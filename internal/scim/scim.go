@@ -0,0 +1,166 @@
+// Package scim implements a SCIM 2.0 server, permitting an identity provider to
+// provision users and their team memberships:
+//
+// https://datatracker.ietf.org/doc/html/rfc7644
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/team"
+	"github.com/tofutf/tofutf/internal/user"
+)
+
+const (
+	// Prefix is the path prefix for SCIM endpoints.
+	Prefix = "/scim/v2"
+	// ContentType is the media type of SCIM requests and responses.
+	ContentType = "application/scim+json"
+)
+
+type (
+	server struct {
+		logger *slog.Logger
+		// token authenticates requests from the identity provider
+		token string
+		// organization in which groups are mapped to teams
+		organization string
+
+		users usersClient
+		teams teamsClient
+	}
+
+	usersClient interface {
+		Create(ctx context.Context, username string, opts ...user.NewUserOption) (*user.User, error)
+		GetUser(ctx context.Context, spec user.UserSpec) (*user.User, error)
+		List(ctx context.Context) ([]*user.User, error)
+		Update(ctx context.Context, userID string, opts user.UpdateUserOptions) (*user.User, error)
+		Delete(ctx context.Context, username string) error
+
+		ListTeamUsers(ctx context.Context, teamID string) ([]*user.User, error)
+		AddTeamMembership(ctx context.Context, teamID string, usernames []string) error
+		RemoveTeamMembership(ctx context.Context, teamID string, usernames []string) error
+	}
+
+	teamsClient interface {
+		Create(ctx context.Context, organization string, opts team.CreateTeamOptions) (*team.Team, error)
+		GetByID(ctx context.Context, teamID string) (*team.Team, error)
+		List(ctx context.Context, organization string) ([]*team.Team, error)
+		Update(ctx context.Context, teamID string, opts team.UpdateTeamOptions) (*team.Team, error)
+		Delete(ctx context.Context, teamID string) error
+	}
+
+	// Options for server constructor
+	Options struct {
+		Logger *slog.Logger
+		// Token authenticates requests from the identity provider. If empty
+		// then the SCIM server is disabled.
+		Token string
+		// Organization in which groups are mapped to teams. If empty then
+		// groups are not supported.
+		Organization string
+
+		UserService *user.Service
+		TeamService *team.Service
+	}
+)
+
+func NewServer(opts Options) *server {
+	return &server{
+		logger:       opts.Logger,
+		token:        opts.Token,
+		organization: opts.Organization,
+		users:        opts.UserService,
+		teams:        opts.TeamService,
+	}
+}
+
+func (s *server) AddHandlers(r *mux.Router) {
+	if s.token == "" {
+		// SCIM is disabled
+		return
+	}
+	r = r.PathPrefix(Prefix).Subrouter()
+	r.Use(s.authenticate)
+
+	r.HandleFunc("/ServiceProviderConfig", s.serviceProviderConfig).Methods("GET")
+
+	r.HandleFunc("/Users", s.listUsers).Methods("GET")
+	r.HandleFunc("/Users", s.createUser).Methods("POST")
+	r.HandleFunc("/Users/{id}", s.getUser).Methods("GET")
+	r.HandleFunc("/Users/{id}", s.replaceUser).Methods("PUT")
+	r.HandleFunc("/Users/{id}", s.patchUser).Methods("PATCH")
+	r.HandleFunc("/Users/{id}", s.deleteUser).Methods("DELETE")
+
+	if s.organization == "" {
+		// groups are not supported
+		return
+	}
+	r.HandleFunc("/Groups", s.listGroups).Methods("GET")
+	r.HandleFunc("/Groups", s.createGroup).Methods("POST")
+	r.HandleFunc("/Groups/{id}", s.getGroup).Methods("GET")
+	r.HandleFunc("/Groups/{id}", s.replaceGroup).Methods("PUT")
+	r.HandleFunc("/Groups/{id}", s.patchGroup).Methods("PATCH")
+	r.HandleFunc("/Groups/{id}", s.deleteGroup).Methods("DELETE")
+}
+
+// authenticate is middleware that checks requests possess the SCIM token. An
+// authenticated request is carried out with superuser privileges.
+func (s *server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			writeError(w, &Error{Status: http.StatusUnauthorized, Detail: "invalid or missing bearer token"})
+			return
+		}
+		ctx := internal.AddSubjectToContext(r.Context(), &internal.Superuser{Username: "scim"})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func (s *server) serviceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	type supported struct {
+		Supported bool `json:"supported"`
+	}
+	type filter struct {
+		Supported  bool `json:"supported"`
+		MaxResults int  `json:"maxResults"`
+	}
+	type bulk struct {
+		Supported      bool `json:"supported"`
+		MaxOperations  int  `json:"maxOperations"`
+		MaxPayloadSize int  `json:"maxPayloadSize"`
+	}
+	type authenticationScheme struct {
+		Type        string `json:"type"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	respond(w, http.StatusOK, struct {
+		Schemas               []string               `json:"schemas"`
+		Patch                 supported              `json:"patch"`
+		Bulk                  bulk                   `json:"bulk"`
+		Filter                filter                 `json:"filter"`
+		ChangePassword        supported              `json:"changePassword"`
+		Sort                  supported              `json:"sort"`
+		ETag                  supported              `json:"etag"`
+		AuthenticationSchemes []authenticationScheme `json:"authenticationSchemes"`
+	}{
+		Schemas: []string{ServiceProviderConfigSchema},
+		Patch:   supported{Supported: true},
+		Filter:  filter{Supported: true, MaxResults: maxResults},
+		AuthenticationSchemes: []authenticationScheme{
+			{
+				Type:        "oauthbearertoken",
+				Name:        "OAuth Bearer Token",
+				Description: "Authentication using the SCIM token",
+			},
+		},
+	})
+}
@@ -0,0 +1,211 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/user"
+)
+
+func (s *server) listUsers(w http.ResponseWriter, r *http.Request) {
+	f, err := parseFilter(r, "userName")
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	users, err := s.users.List(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	// the site admin is not an identity provider managed user
+	users = slices.DeleteFunc(users, func(u *user.User) bool {
+		return u.ID == user.SiteAdminID || (f != nil && u.Username != f.value)
+	})
+	slices.SortFunc(users, func(a, b *user.User) int {
+		return strings.Compare(a.Username, b.Username)
+	})
+	resources := make([]*User, len(users))
+	for i, u := range users {
+		resources[i] = s.toUser(u)
+	}
+	respond(w, http.StatusOK, newListResponse(r, resources))
+}
+
+func (s *server) createUser(w http.ResponseWriter, r *http.Request) {
+	var params User
+	if err := decode(r, &params); err != nil {
+		writeError(w, err)
+		return
+	}
+	if params.UserName == "" {
+		writeError(w, invalidValue("userName is required"))
+		return
+	}
+	var opts []user.NewUserOption
+	if params.Active != nil {
+		opts = append(opts, user.WithActive(*params.Active))
+	}
+	created, err := s.users.Create(r.Context(), params.UserName, opts...)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respond(w, http.StatusCreated, s.toUser(created))
+}
+
+func (s *server) getUser(w http.ResponseWriter, r *http.Request) {
+	u, err := s.lookupUser(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respond(w, http.StatusOK, s.toUser(u))
+}
+
+// replaceUser handles a PUT request, which replaces the user's attributes. If
+// active is unspecified then the user is activated.
+func (s *server) replaceUser(w http.ResponseWriter, r *http.Request) {
+	var params User
+	if err := decode(r, &params); err != nil {
+		writeError(w, err)
+		return
+	}
+	id := mux.Vars(r)["id"]
+	if _, err := s.lookupUser(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+	opts := user.UpdateUserOptions{
+		Username: &params.UserName,
+		Active:   internal.Bool(true),
+	}
+	if params.Active != nil {
+		opts.Active = params.Active
+	}
+	updated, err := s.users.Update(r.Context(), id, opts)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respond(w, http.StatusOK, s.toUser(updated))
+}
+
+// patchUser handles a PATCH request, which modifies the user's active status
+// and/or username.
+func (s *server) patchUser(w http.ResponseWriter, r *http.Request) {
+	var params PatchRequest
+	if err := decode(r, &params); err != nil {
+		writeError(w, err)
+		return
+	}
+	id := mux.Vars(r)["id"]
+	if _, err := s.lookupUser(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+	var opts user.UpdateUserOptions
+	for _, op := range params.Operations {
+		if err := userPatchOperation(op, &opts); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+	updated, err := s.users.Update(r.Context(), id, opts)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respond(w, http.StatusOK, s.toUser(updated))
+}
+
+func (s *server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	u, err := s.lookupUser(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := s.users.Delete(r.Context(), u.Username); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// lookupUser retrieves a user by ID, excluding the site admin, which cannot be
+// managed via SCIM.
+func (s *server) lookupUser(ctx context.Context, id string) (*user.User, error) {
+	if id == user.SiteAdminID {
+		return nil, internal.ErrResourceNotFound
+	}
+	return s.users.GetUser(ctx, user.UserSpec{UserID: &id})
+}
+
+// userPatchOperation applies a patch operation to update options.
+func userPatchOperation(op PatchOperation, opts *user.UpdateUserOptions) error {
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+	default:
+		return invalidValue("unsupported operation: " + op.Op)
+	}
+	attrs := map[string][]byte{}
+	if op.Path == "" {
+		values, err := parseAttributes(op.Value)
+		if err != nil {
+			return err
+		}
+		for k, v := range values {
+			attrs[strings.ToLower(k)] = v
+		}
+	} else {
+		attrs[strings.ToLower(op.Path)] = op.Value
+	}
+	for attr, value := range attrs {
+		switch attr {
+		case "active":
+			active, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+			opts.Active = &active
+		case "username":
+			username, err := parseString(value)
+			if err != nil {
+				return err
+			}
+			opts.Username = &username
+		default:
+			return &Error{
+				Status:   http.StatusBadRequest,
+				ScimType: "invalidPath",
+				Detail:   "unsupported attribute: " + attr,
+			}
+		}
+	}
+	return nil
+}
+
+func (s *server) toUser(from *user.User) *User {
+	to := &User{
+		Schemas:  []string{UserSchema},
+		ID:       from.ID,
+		UserName: from.Username,
+		Active:   internal.Bool(from.Active),
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      from.CreatedAt,
+			LastModified: &from.UpdatedAt,
+		},
+	}
+	for _, t := range from.Teams {
+		if s.organization == "" || t.Organization != s.organization {
+			continue
+		}
+		to.Groups = append(to.Groups, Member{Value: t.ID, Display: t.Name})
+	}
+	return to
+}
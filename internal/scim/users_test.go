@@ -0,0 +1,173 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal/team"
+	"github.com/tofutf/tofutf/internal/user"
+)
+
+func TestServer_Authenticate(t *testing.T) {
+	router := newTestRouter(t, newFakeUserService(), &fakeTeamService{})
+
+	tests := []struct {
+		name   string
+		header string
+		want   int
+	}{
+		{"valid token", "Bearer " + testToken, http.StatusOK},
+		{"invalid token", "Bearer invalid", http.StatusUnauthorized},
+		{"missing token", "", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/scim/v2/Users", nil)
+			r.Header.Set("Authorization", tt.header)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+			assert.Equal(t, tt.want, w.Code)
+		})
+	}
+}
+
+func TestServer_Disabled(t *testing.T) {
+	r := httptest.NewRequest("GET", "/scim/v2/Users", nil)
+	w := httptest.NewRecorder()
+	router := mux.NewRouter()
+	NewServer(Options{}).AddHandlers(router)
+	router.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestServer_ListUsers(t *testing.T) {
+	owners := &team.Team{ID: "team-owners", Name: "owners", Organization: "acme"}
+	users := newFakeUserService(
+		&user.SiteAdmin,
+		user.NewUser("bobby", user.WithTeams(owners)),
+		user.NewUser("alice"),
+	)
+	router := newTestRouter(t, users, &fakeTeamService{})
+
+	t.Run("all", func(t *testing.T) {
+		got := listUsers(t, router, "")
+		require.Equal(t, 2, got.TotalResults)
+		assert.Equal(t, "alice", got.Resources[0].UserName)
+		assert.Equal(t, "bobby", got.Resources[1].UserName)
+		assert.Equal(t, []Member{{Value: "team-owners", Display: "owners"}}, got.Resources[1].Groups)
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		got := listUsers(t, router, `?filter=userName+eq+"bobby"`)
+		require.Equal(t, 1, got.TotalResults)
+		assert.Equal(t, "bobby", got.Resources[0].UserName)
+	})
+
+	t.Run("paginate", func(t *testing.T) {
+		got := listUsers(t, router, "?startIndex=2&count=1")
+		assert.Equal(t, 2, got.TotalResults)
+		assert.Equal(t, 1, got.ItemsPerPage)
+		assert.Equal(t, "bobby", got.Resources[0].UserName)
+	})
+
+	t.Run("unsupported filter", func(t *testing.T) {
+		w := serve(router, "GET", `/scim/v2/Users?filter=userName+sw+"b"`, "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "invalidFilter")
+	})
+}
+
+func TestServer_CreateUser(t *testing.T) {
+	users := newFakeUserService()
+	router := newTestRouter(t, users, &fakeTeamService{})
+
+	body := `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"bobby","active":true}`
+	w := serve(router, "POST", "/scim/v2/Users", body)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	assert.Equal(t, ContentType, w.Header().Get("Content-Type"))
+
+	var got User
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	assert.Equal(t, "bobby", got.UserName)
+	assert.True(t, *got.Active)
+	assert.Len(t, users.users, 1)
+
+	t.Run("already exists", func(t *testing.T) {
+		w := serve(router, "POST", "/scim/v2/Users", body)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "uniqueness")
+	})
+}
+
+func TestServer_PatchUser(t *testing.T) {
+	bobby := user.NewUser("bobby")
+	users := newFakeUserService(bobby)
+	router := newTestRouter(t, users, &fakeTeamService{})
+
+	tests := []struct {
+		name       string
+		operations string
+		wantActive bool
+		wantName   string
+	}{
+		{
+			name:       "deactivate with path",
+			operations: `[{"op":"replace","path":"active","value":false}]`,
+			wantActive: false,
+			wantName:   "bobby",
+		},
+		{
+			name:       "activate with string value",
+			operations: `[{"op":"Replace","path":"active","value":"True"}]`,
+			wantActive: true,
+			wantName:   "bobby",
+		},
+		{
+			name:       "without path",
+			operations: `[{"op":"replace","value":{"active":false,"userName":"robert"}}]`,
+			wantActive: false,
+			wantName:   "robert",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":` + tt.operations + `}`
+			w := serve(router, "PATCH", "/scim/v2/Users/"+bobby.ID, body)
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+			assert.Equal(t, tt.wantActive, bobby.Active)
+			assert.Equal(t, tt.wantName, bobby.Username)
+		})
+	}
+}
+
+func TestServer_DeleteUser(t *testing.T) {
+	bobby := user.NewUser("bobby")
+	users := newFakeUserService(&user.SiteAdmin, bobby)
+	router := newTestRouter(t, users, &fakeTeamService{})
+
+	w := serve(router, "DELETE", "/scim/v2/Users/"+bobby.ID, "")
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Len(t, users.users, 1)
+
+	t.Run("site admin", func(t *testing.T) {
+		w := serve(router, "DELETE", "/scim/v2/Users/"+user.SiteAdminID, "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func listUsers(t *testing.T, router http.Handler, query string) (got struct {
+	ListResponse
+	Resources []User `json:"Resources"`
+}) {
+	t.Helper()
+
+	w := serve(router, "GET", "/scim/v2/Users"+query, "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	return got
+}
@@ -0,0 +1,52 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type (
+	// fileProvider resolves secrets from files in a directory, e.g. secrets
+	// mounted into a container. A reference's path is relative to the
+	// directory.
+	fileProvider struct {
+		dir string
+	}
+
+	// envProvider resolves secrets from environment variables prefixed with
+	// EnvPrefix. A reference's path, <organization>/<name>, resolves to the
+	// variable <EnvPrefix><organization><EnvSeparator><name>.
+	envProvider struct{}
+)
+
+func (p *fileProvider) Resolve(_ context.Context, ref Reference) (string, error) {
+	// prevent references from reading files outside of the directory
+	if !filepath.IsLocal(ref.Path) {
+		return "", fmt.Errorf("path must be relative to the secrets directory and must not contain ..")
+	}
+	contents, err := os.ReadFile(filepath.Join(p.dir, ref.Path))
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", ErrSecretNotFound
+	} else if err != nil {
+		return "", err
+	}
+	// files commonly end with a newline that is not part of the secret
+	return strings.TrimSuffix(string(contents), "\n"), nil
+}
+
+func (p *envProvider) Resolve(_ context.Context, ref Reference) (string, error) {
+	organization, name, found := strings.Cut(ref.Path, "/")
+	if !found {
+		return "", fmt.Errorf("path must be in the format <organization>/<name>")
+	}
+	value, ok := os.LookupEnv(EnvPrefix + organization + EnvSeparator + name)
+	if !ok {
+		return "", ErrSecretNotFound
+	}
+	return value, nil
+}
@@ -0,0 +1,198 @@
+// Package secrets resolves variable values that reference secrets held
+// outside of tofutf, e.g. in HashiCorp Vault, permitting secrets to be used in
+// runs without being persisted in the database.
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	VaultScheme = "vault"
+	FileScheme  = "file"
+	EnvScheme   = "env"
+
+	// EnvPrefix is the prefix of environment variables that env references
+	// can resolve: env://acme/DB_PASSWORD resolves to the value of
+	// TOFUTF_SECRET_acme__DB_PASSWORD. The prefix prevents references from
+	// retrieving the agent's own environment, which includes its credentials.
+	EnvPrefix = "TOFUTF_SECRET_"
+	// EnvSeparator separates the organization from the name of the secret in
+	// the name of an environment variable.
+	EnvSeparator = "__"
+
+	// VaultPathPrefix is the path segment, following the mount, beneath which
+	// each organization's vault secrets are held, e.g.
+	// secret/data/tofutf/acme/app.
+	VaultPathPrefix = "tofutf"
+)
+
+var (
+	// envNameRegex matches the name of a secret in an env reference. Names
+	// must neither contain EnvSeparator nor begin or end with an underscore,
+	// which guarantees that each organization's environment variables are
+	// distinct from every other organization's.
+	envNameRegex = regexp.MustCompile(`^[a-zA-Z0-9]+(_[a-zA-Z0-9]+)*$`)
+
+	ErrMalformedReference = errors.New("malformed secret reference")
+	ErrSecretNotFound     = errors.New("secret not found")
+	ErrOutOfScope         = errors.New("secret reference is outside of the organization's scope")
+)
+
+type (
+	// Reference identifies a secret held by a secret provider. It takes the
+	// form <scheme>://<path>[#<key>], e.g.
+	// vault://secret/data/tofutf/acme/app#password.
+	Reference struct {
+		// Scheme identifies the provider holding the secret.
+		Scheme string
+		// Path to the secret within the provider.
+		Path string
+		// Key identifies a field within the secret. Only vault references
+		// have a key.
+		Key string
+	}
+
+	// Provider retrieves secrets from a secret store.
+	Provider interface {
+		// Resolve retrieves the value of the referenced secret. If the secret
+		// does not exist then ErrSecretNotFound is returned.
+		Resolve(ctx context.Context, ref Reference) (string, error)
+	}
+
+	// Config configures secret providers.
+	Config struct {
+		VaultAddress   string // address of vault server; vault references are unsupported if empty
+		VaultToken     string // token with which to authenticate with vault
+		VaultNamespace string // vault enterprise namespace; optional
+		Dir            string // directory containing secret files; file references are unsupported if empty
+	}
+
+	// Resolver resolves references, delegating each reference to the
+	// provider for its scheme.
+	Resolver struct {
+		providers map[string]Provider
+	}
+)
+
+// ParseReference parses a reference from a variable value. An error is returned
+// if the value is not a well-formed reference.
+func ParseReference(value string) (Reference, error) {
+	scheme, rest, found := strings.Cut(value, "://")
+	if !found {
+		return Reference{}, fmt.Errorf("%w: %s: must be in the format <provider>://<path>", ErrMalformedReference, value)
+	}
+	switch scheme {
+	case VaultScheme, FileScheme, EnvScheme:
+	default:
+		return Reference{}, fmt.Errorf("%w: %s: unknown provider: %s", ErrMalformedReference, value, scheme)
+	}
+	ref := Reference{Scheme: scheme}
+	ref.Path, ref.Key, _ = strings.Cut(rest, "#")
+	if ref.Path == "" {
+		return Reference{}, fmt.Errorf("%w: %s: missing path", ErrMalformedReference, value)
+	}
+	switch scheme {
+	case VaultScheme:
+		if ref.Key == "" {
+			return Reference{}, fmt.Errorf("%w: %s: missing key, e.g. vault://secret/data/tofutf/acme/app#password", ErrMalformedReference, value)
+		}
+	default:
+		if ref.Key != "" {
+			return Reference{}, fmt.Errorf("%w: %s: only vault references have a key", ErrMalformedReference, value)
+		}
+	}
+	if scheme == EnvScheme {
+		_, name, _ := strings.Cut(ref.Path, "/")
+		if !envNameRegex.MatchString(name) {
+			return Reference{}, fmt.Errorf("%w: %s: must be in the format env://<organization>/<name>, where name consists of letters, digits and single underscores, e.g. env://acme/DB_PASSWORD", ErrMalformedReference, value)
+		}
+	}
+	return ref, nil
+}
+
+// CheckScope checks that the reference is restricted to secrets belonging to
+// the organization, preventing an organization from referencing another
+// organization's secrets:
+//
+// * a vault reference's path must be of the form
+// <mount>/[data/]tofutf/<organization>/<path>
+// * a file reference's path must be of the form <organization>/<path>
+// * an env reference's path must be of the form <organization>/<name>
+func (r Reference) CheckScope(organization string) error {
+	segments := strings.Split(r.Path, "/")
+	// reject relative segments, which could otherwise be used to escape the
+	// scope, e.g. secret/data/tofutf/acme/../../other/app
+	for _, s := range segments {
+		if s == "" || s == "." || s == ".." {
+			return fmt.Errorf("%w: %s: path must not contain empty, . or .. segments", ErrOutOfScope, r)
+		}
+	}
+	var want []string
+	switch r.Scheme {
+	case VaultScheme:
+		// skip mount
+		segments = segments[1:]
+		// version 2 of the KV engine includes the data/ segment
+		if len(segments) > 0 && segments[0] == "data" {
+			segments = segments[1:]
+		}
+		want = []string{VaultPathPrefix, organization}
+	case FileScheme, EnvScheme:
+		want = []string{organization}
+	}
+	if len(segments) <= len(want) || !slices.Equal(segments[:len(want)], want) {
+		return fmt.Errorf("%w: %s: path must be within %s/", ErrOutOfScope, r, strings.Join(want, "/"))
+	}
+	return nil
+}
+
+func (r Reference) String() string {
+	s := r.Scheme + "://" + r.Path
+	if r.Key != "" {
+		s += "#" + r.Key
+	}
+	return s
+}
+
+// NewResolver constructs a resolver with providers configured according to the
+// config. The env provider is always enabled.
+func NewResolver(cfg Config) (*Resolver, error) {
+	r := &Resolver{providers: map[string]Provider{
+		EnvScheme: &envProvider{},
+	}}
+	if cfg.VaultAddress != "" {
+		vault, err := newVaultProvider(cfg.VaultAddress, cfg.VaultToken, cfg.VaultNamespace)
+		if err != nil {
+			return nil, err
+		}
+		r.providers[VaultScheme] = vault
+	}
+	if cfg.Dir != "" {
+		r.providers[FileScheme] = &fileProvider{dir: cfg.Dir}
+	}
+	return r, nil
+}
+
+// Resolve retrieves the value of a secret referenced by a variable belonging
+// to the organization. An error is returned if the reference is outside of the
+// organization's scope.
+func (r *Resolver) Resolve(ctx context.Context, organization string, ref Reference) (string, error) {
+	if err := ref.CheckScope(organization); err != nil {
+		return "", err
+	}
+	provider, ok := r.providers[ref.Scheme]
+	if !ok {
+		return "", fmt.Errorf("%s secret provider has not been configured", ref.Scheme)
+	}
+	resolved, err := provider.Resolve(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("resolving %s: %w", ref, err)
+	}
+	return resolved, nil
+}
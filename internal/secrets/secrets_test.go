@@ -0,0 +1,137 @@
+package secrets
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  Reference
+		err   bool
+	}{
+		{"plain value", "foo", Reference{}, true},
+		{"unknown scheme", "https://example.com", Reference{}, true},
+		{"vault", "vault://secret/data/tofutf/acme/app#password", Reference{Scheme: "vault", Path: "secret/data/tofutf/acme/app", Key: "password"}, false},
+		{"vault without key", "vault://secret/data/tofutf/acme/app", Reference{}, true},
+		{"file", "file://acme/db/password", Reference{Scheme: "file", Path: "acme/db/password"}, false},
+		{"file with key", "file://acme/db#password", Reference{}, true},
+		{"env", "env://acme/DB_PASSWORD", Reference{Scheme: "env", Path: "acme/DB_PASSWORD"}, false},
+		{"env without organization", "env://DB_PASSWORD", Reference{}, true},
+		{"env name with separator", "env://acme/DB__PASSWORD", Reference{}, true},
+		{"env name with leading underscore", "env://acme/_PASSWORD", Reference{}, true},
+		{"env nested name", "env://acme/db/password", Reference{}, true},
+		{"missing path", "env://", Reference{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseReference(tt.value)
+			if tt.err {
+				assert.ErrorIs(t, err, ErrMalformedReference)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReference_CheckScope(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		err   bool
+	}{
+		{"vault kv version 2", "vault://secret/data/tofutf/acme/app#password", false},
+		{"vault kv version 1", "vault://kv/tofutf/acme/app#password", false},
+		{"vault other organization", "vault://secret/data/tofutf/other/app#password", true},
+		{"vault outside prefix", "vault://secret/data/app#password", true},
+		{"vault organization root", "vault://secret/data/tofutf/acme#password", true},
+		{"vault escapes prefix", "vault://secret/data/tofutf/acme/../other/app#password", true},
+		{"vault leading slash", "vault:///secret/data/tofutf/acme/app#password", true},
+		{"vault other endpoint", "vault://auth/token/lookup-self#id", true},
+		{"file", "file://acme/db/password", false},
+		{"file other organization", "file://other/db/password", true},
+		{"file escapes organization", "file://acme/../other/password", true},
+		{"env", "env://acme/DB_PASSWORD", false},
+		{"env other organization", "env://other/DB_PASSWORD", true},
+		{"env organization prefix", "env://acm/DB_PASSWORD", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, err := ParseReference(tt.value)
+			require.NoError(t, err)
+			err = ref.CheckScope("acme")
+			if tt.err {
+				assert.ErrorIs(t, err, ErrOutOfScope)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestResolver(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "acme"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "acme", "password"), []byte("s3cr3t\n"), 0o600))
+	t.Setenv(EnvPrefix+"acme__DB_PASSWORD", "hunter2")
+	t.Setenv(EnvPrefix+"other__DB_PASSWORD", "other-secret")
+	t.Setenv("OTF_TOKEN", "agent-token")
+
+	resolver, err := NewResolver(Config{Dir: dir})
+	require.NoError(t, err)
+
+	t.Run("file", func(t *testing.T) {
+		got, err := resolve(t, resolver, "file://acme/password")
+		require.NoError(t, err)
+		assert.Equal(t, "s3cr3t", got)
+	})
+
+	t.Run("file belonging to other organization", func(t *testing.T) {
+		_, err := resolve(t, resolver, "file://other/password")
+		assert.ErrorIs(t, err, ErrOutOfScope)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := resolve(t, resolver, "file://acme/missing")
+		assert.ErrorIs(t, err, ErrSecretNotFound)
+	})
+
+	t.Run("env", func(t *testing.T) {
+		got, err := resolve(t, resolver, "env://acme/DB_PASSWORD")
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", got)
+	})
+
+	t.Run("env belonging to other organization", func(t *testing.T) {
+		_, err := resolve(t, resolver, "env://other/DB_PASSWORD")
+		assert.ErrorIs(t, err, ErrOutOfScope)
+	})
+
+	t.Run("unprefixed env", func(t *testing.T) {
+		_, err := resolve(t, resolver, "env://acme/OTF_TOKEN")
+		assert.ErrorIs(t, err, ErrSecretNotFound)
+	})
+
+	t.Run("unconfigured provider", func(t *testing.T) {
+		_, err := resolve(t, resolver, "vault://secret/data/tofutf/acme/app#password")
+		assert.Error(t, err)
+	})
+}
+
+// resolve resolves a reference on behalf of the acme organization
+func resolve(t *testing.T, resolver *Resolver, value string) (string, error) {
+	t.Helper()
+
+	ref, err := ParseReference(value)
+	require.NoError(t, err)
+	return resolver.Resolve(context.Background(), "acme", ref)
+}
@@ -0,0 +1,102 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const vaultRequestTimeout = 30 * time.Second
+
+// vaultProvider resolves secrets from the KV secrets engine of a HashiCorp
+// Vault server. Both versions of the engine are supported: a reference's path
+// is the path of the API endpoint, which for version 2 includes the data/
+// segment, e.g. vault://secret/data/tofutf/acme/app#password.
+type vaultProvider struct {
+	address   *url.URL
+	token     string
+	namespace string
+	client    *http.Client
+}
+
+// vaultSecret is the response to a request to read a secret.
+type vaultSecret struct {
+	Data map[string]any `json:"data"`
+}
+
+func newVaultProvider(address, token, namespace string) (*vaultProvider, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vault address: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid vault address: scheme must be http or https: %s", address)
+	}
+	return &vaultProvider{
+		address:   u,
+		token:     token,
+		namespace: namespace,
+		client:    &http.Client{Timeout: vaultRequestTimeout},
+	}, nil
+}
+
+func (p *vaultProvider) Resolve(ctx context.Context, ref Reference) (string, error) {
+	u := p.address.JoinPath("v1", ref.Path)
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if p.token != "" {
+		req.Header.Set("X-Vault-Token", p.token)
+	}
+	if p.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.namespace)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return "", ErrSecretNotFound
+	default:
+		// vault error responses contain an array of error messages but not
+		// the secret itself, so it is safe to relay the response.
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("vault responded with %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var secret vaultSecret
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return "", fmt.Errorf("decoding vault response: %w", err)
+	}
+	data := secret.Data
+	// version 2 of the KV engine nests the secret's fields alongside its
+	// metadata.
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, ok := data["metadata"]; ok {
+			data = nested
+		}
+	}
+	value, ok := data[ref.Key]
+	if !ok {
+		return "", ErrSecretNotFound
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	// non-string fields, e.g. numbers and objects, are returned as JSON
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
@@ -0,0 +1,87 @@
+package secrets
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestVault starts a stand-in for a vault dev server, serving a version 2
+// KV engine mounted at secret/ and a version 1 KV engine mounted at kv/.
+func newTestVault(t *testing.T, token string) string {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/secret/data/tofutf/acme/app", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"data":{"password":"s3cr3t","port":5432},"metadata":{"version":1}}}`)) //nolint:errcheck
+	})
+	mux.HandleFunc("/v1/kv/tofutf/acme/app", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"password":"hunter2"}}`)) //nolint:errcheck
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != token {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errors":["permission denied"]}`)) //nolint:errcheck
+			return
+		}
+		if _, pattern := mux.Handler(r); pattern == "" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[]}`)) //nolint:errcheck
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func TestVault(t *testing.T) {
+	address := newTestVault(t, "root")
+
+	resolver, err := NewResolver(Config{VaultAddress: address, VaultToken: "root"})
+	require.NoError(t, err)
+
+	t.Run("kv version 2", func(t *testing.T) {
+		got, err := resolve(t, resolver, "vault://secret/data/tofutf/acme/app#password")
+		require.NoError(t, err)
+		assert.Equal(t, "s3cr3t", got)
+	})
+
+	t.Run("non-string field", func(t *testing.T) {
+		got, err := resolve(t, resolver, "vault://secret/data/tofutf/acme/app#port")
+		require.NoError(t, err)
+		assert.Equal(t, "5432", got)
+	})
+
+	t.Run("kv version 1", func(t *testing.T) {
+		got, err := resolve(t, resolver, "vault://kv/tofutf/acme/app#password")
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", got)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		_, err := resolve(t, resolver, "vault://secret/data/tofutf/acme/app#username")
+		assert.ErrorIs(t, err, ErrSecretNotFound)
+	})
+
+	t.Run("missing secret", func(t *testing.T) {
+		_, err := resolve(t, resolver, "vault://secret/data/tofutf/acme/missing#password")
+		assert.ErrorIs(t, err, ErrSecretNotFound)
+	})
+
+	t.Run("out of scope", func(t *testing.T) {
+		_, err := resolve(t, resolver, "vault://secret/data/tofutf/other/app#password")
+		assert.ErrorIs(t, err, ErrOutOfScope)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		unauthorized, err := NewResolver(Config{VaultAddress: address, VaultToken: "invalid"})
+		require.NoError(t, err)
+
+		_, err = resolve(t, unauthorized, "vault://secret/data/tofutf/acme/app#password")
+		assert.ErrorContains(t, err, "permission denied")
+	})
+}
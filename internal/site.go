@@ -12,12 +12,19 @@ type SiteAuthorizer struct {
 	Logger *slog.Logger
 }
 
-func (a *SiteAuthorizer) CanAccess(ctx context.Context, action rbac.Action, _ string) (Subject, error) {
+// CanAccess determines whether the subject in the context can carry out the
+// site-wide action. The id identifies the resource affected by the action, if
+// any, and is recorded with the audit event. An action without a resource is
+// not audited: the caller is expected to record the resources it affects.
+func (a *SiteAuthorizer) CanAccess(ctx context.Context, action rbac.Action, id string) (Subject, error) {
 	subj, err := SubjectFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 	if subj.CanAccessSite(action) {
+		if id != "" {
+			RecordAuthorized(ctx, subj, action, id, "")
+		}
 		return subj, nil
 	}
 
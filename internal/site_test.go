@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal/rbac"
+)
+
+func TestSiteAuthorizer_CanAccess(t *testing.T) {
+	auditor := &fakeAuditor{}
+	ctx := AddSubjectToContext(context.Background(), &Superuser{Username: "bob"})
+	ctx = AddAuditorToContext(ctx, auditor)
+	authorizer := &SiteAuthorizer{Logger: slog.Default()}
+
+	_, err := authorizer.CanAccess(ctx, rbac.DeleteUserAction, "alice")
+	require.NoError(t, err)
+	// actions without a resource are left to the caller to record
+	_, err = authorizer.CanAccess(ctx, rbac.RevokeStaleTokensAction, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"alice"}, auditor.resources)
+}
+
+type fakeAuditor struct {
+	resources []string
+}
+
+func (f *fakeAuditor) Record(_ context.Context, _ Subject, _ rbac.Action, resourceID, _ string) {
+	f.resources = append(f.resources, resourceID)
+}
@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE latest_terraform_version ADD COLUMN engine TEXT;
+
+UPDATE latest_terraform_version SET engine = 'terraform';
+
+ALTER TABLE latest_terraform_version
+    ALTER COLUMN engine SET NOT NULL,
+    ADD CONSTRAINT latest_terraform_version_engine_key UNIQUE (engine);
+
+ALTER TABLE workspaces ADD COLUMN engine TEXT NOT NULL DEFAULT 'terraform';
+
+ALTER TABLE runs ADD COLUMN engine TEXT NOT NULL DEFAULT 'terraform';
+
+-- +goose Down
+ALTER TABLE runs DROP COLUMN engine;
+
+ALTER TABLE workspaces DROP COLUMN engine;
+
+DELETE FROM latest_terraform_version WHERE engine != 'terraform';
+
+ALTER TABLE latest_terraform_version DROP COLUMN engine;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE logs ALTER COLUMN chunk DROP NOT NULL;
+ALTER TABLE module_tarballs ALTER COLUMN tarball DROP NOT NULL;
+
+-- +goose Down
+DELETE FROM logs WHERE chunk IS NULL;
+DELETE FROM module_tarballs WHERE tarball IS NULL;
+ALTER TABLE module_tarballs ALTER COLUMN tarball SET NOT NULL;
+ALTER TABLE logs ALTER COLUMN chunk SET NOT NULL;
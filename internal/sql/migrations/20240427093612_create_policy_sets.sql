@@ -0,0 +1,54 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS policy_sets (
+    policy_set_id TEXT,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL,
+    organization_name TEXT REFERENCES organizations (name) ON UPDATE CASCADE ON DELETE CASCADE NOT NULL,
+    PRIMARY KEY (policy_set_id),
+    UNIQUE (organization_name, name)
+);
+
+CREATE TABLE IF NOT EXISTS policy_enforcement_levels (
+    level TEXT PRIMARY KEY
+);
+
+INSERT INTO policy_enforcement_levels (level) VALUES
+    ('advisory'),
+    ('soft-mandatory'),
+    ('hard-mandatory');
+
+CREATE TABLE IF NOT EXISTS policies (
+    policy_id TEXT,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    name TEXT NOT NULL,
+    enforcement_level TEXT REFERENCES policy_enforcement_levels (level) NOT NULL,
+    source TEXT NOT NULL,
+    policy_set_id TEXT REFERENCES policy_sets ON UPDATE CASCADE ON DELETE CASCADE NOT NULL,
+    PRIMARY KEY (policy_id),
+    UNIQUE (policy_set_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS policy_checks (
+    policy_check_id TEXT,
+    created_at TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL,
+    results BYTEA NOT NULL,
+    overridden_by TEXT,
+    run_id TEXT REFERENCES runs ON UPDATE CASCADE ON DELETE CASCADE NOT NULL,
+    PRIMARY KEY (policy_check_id),
+    UNIQUE (run_id)
+);
+
+INSERT INTO run_statuses (status) VALUES
+    ('policy_checked'),
+    ('policy_soft_failed');
+
+-- +goose Down
+DELETE FROM run_statuses WHERE status IN ('policy_checked', 'policy_soft_failed');
+DROP TABLE IF EXISTS policy_checks;
+DROP TABLE IF EXISTS policies;
+DROP TABLE IF EXISTS policy_enforcement_levels;
+DROP TABLE IF EXISTS policy_sets;
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE workspaces
+    ADD COLUMN assessments_enabled BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN latest_assessment_run_id TEXT,
+    ADD COLUMN latest_assessment_status TEXT,
+    ADD COLUMN latest_assessment_created_at TIMESTAMPTZ,
+    ADD CONSTRAINT latest_assessment_run_id_fk FOREIGN KEY (latest_assessment_run_id)
+        REFERENCES runs ON UPDATE CASCADE ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE workspaces
+    DROP COLUMN latest_assessment_created_at,
+    DROP COLUMN latest_assessment_status,
+    DROP COLUMN latest_assessment_run_id,
+    DROP COLUMN assessments_enabled;
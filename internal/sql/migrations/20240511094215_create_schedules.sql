@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS schedules (
+    schedule_id TEXT,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    workspace_id TEXT REFERENCES workspaces ON UPDATE CASCADE ON DELETE CASCADE NOT NULL,
+    cron_expression TEXT NOT NULL,
+    operation TEXT NOT NULL,
+    auto_apply BOOLEAN NOT NULL,
+    next_run_at TIMESTAMPTZ NOT NULL,
+    last_run_at TIMESTAMPTZ,
+    last_run_id TEXT REFERENCES runs (run_id) ON UPDATE CASCADE ON DELETE SET NULL,
+    PRIMARY KEY (schedule_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS schedules;
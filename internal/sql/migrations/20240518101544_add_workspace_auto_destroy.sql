@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE workspaces
+    ADD COLUMN auto_destroy_at TIMESTAMPTZ,
+    ADD COLUMN auto_destroy_activity_duration TEXT,
+    ADD COLUMN delete_after_auto_destroy BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN auto_destroy_run_id TEXT,
+    ADD CONSTRAINT auto_destroy_run_id_fk FOREIGN KEY (auto_destroy_run_id)
+        REFERENCES runs ON UPDATE CASCADE ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE workspaces
+    DROP COLUMN auto_destroy_run_id,
+    DROP COLUMN delete_after_auto_destroy,
+    DROP COLUMN auto_destroy_activity_duration,
+    DROP COLUMN auto_destroy_at;
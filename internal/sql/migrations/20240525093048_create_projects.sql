@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS projects (
+    project_id TEXT,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL,
+    organization_name TEXT REFERENCES organizations ON UPDATE CASCADE ON DELETE CASCADE NOT NULL,
+    PRIMARY KEY (project_id),
+    UNIQUE (organization_name, name)
+);
+
+CREATE TABLE IF NOT EXISTS project_permissions (
+    project_permission_id TEXT,
+    project_id TEXT REFERENCES projects ON UPDATE CASCADE ON DELETE CASCADE NOT NULL,
+    team_id TEXT REFERENCES teams ON UPDATE CASCADE ON DELETE CASCADE NOT NULL,
+    role TEXT REFERENCES workspace_roles ON UPDATE CASCADE ON DELETE CASCADE NOT NULL,
+    PRIMARY KEY (project_permission_id),
+    UNIQUE (project_id, team_id)
+);
+
+ALTER TABLE workspaces
+    ADD COLUMN project_id TEXT,
+    ADD CONSTRAINT project_id_fk FOREIGN KEY (project_id)
+        REFERENCES projects ON UPDATE CASCADE;
+
+-- +goose Down
+ALTER TABLE workspaces DROP COLUMN project_id;
+DROP TABLE IF EXISTS project_permissions;
+DROP TABLE IF EXISTS projects;
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS audit_events (
+    audit_event_id TEXT,
+    time TIMESTAMPTZ NOT NULL,
+    subject TEXT NOT NULL,
+    action TEXT NOT NULL,
+    resource_id TEXT,
+    organization_name TEXT,
+    client_ip TEXT,
+    user_agent TEXT,
+    method TEXT,
+    path TEXT,
+    status_code INTEGER,
+    PRIMARY KEY (audit_event_id)
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_time_idx ON audit_events (time);
+CREATE INDEX IF NOT EXISTS audit_events_organization_name_idx ON audit_events (organization_name);
+
+-- +goose Down
+DROP TABLE IF EXISTS audit_events;
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS pull_request_comments (
+    workspace_id TEXT REFERENCES workspaces ON UPDATE CASCADE ON DELETE CASCADE NOT NULL,
+    repo TEXT NOT NULL,
+    pull_request_number INTEGER NOT NULL,
+    comment_id TEXT NOT NULL,
+    PRIMARY KEY (workspace_id, repo, pull_request_number)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS pull_request_comments;
@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS provider_mirror_versions (
+    provider_mirror_version_id TEXT,
+    created_at                 TIMESTAMPTZ NOT NULL,
+    hostname                   TEXT NOT NULL,
+    namespace                  TEXT NOT NULL,
+    type                       TEXT NOT NULL,
+    version                    TEXT NOT NULL,
+    -- shasums is the upstream SHA256SUMS file, the signature of which has been
+    -- verified.
+    shasums                    BYTEA NOT NULL,
+                               PRIMARY KEY (provider_mirror_version_id),
+                               UNIQUE (hostname, namespace, type, version)
+);
+
+CREATE TABLE IF NOT EXISTS provider_mirror_archives (
+    provider_mirror_version_id TEXT REFERENCES provider_mirror_versions ON UPDATE CASCADE ON DELETE CASCADE NOT NULL,
+    created_at                 TIMESTAMPTZ NOT NULL,
+    os                         TEXT NOT NULL,
+    arch                       TEXT NOT NULL,
+    filename                   TEXT NOT NULL,
+    shasum                     TEXT NOT NULL,
+    -- archive is null if it has been persisted to a blob store.
+    archive                    BYTEA,
+                               PRIMARY KEY (provider_mirror_version_id, os, arch)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS provider_mirror_archives;
+DROP TABLE IF EXISTS provider_mirror_versions;
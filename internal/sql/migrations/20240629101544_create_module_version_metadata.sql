@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS module_version_metadata (
+    module_version_id TEXT REFERENCES module_versions ON UPDATE CASCADE ON DELETE CASCADE NOT NULL,
+    -- metadata is the JSON-encoded inputs, outputs, resources, etc, of the
+    -- module version, parsed when the version is published.
+    metadata          BYTEA NOT NULL,
+    -- readme_html is the module's README rendered as HTML.
+    readme_html       TEXT NOT NULL,
+                      PRIMARY KEY (module_version_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS module_version_metadata;
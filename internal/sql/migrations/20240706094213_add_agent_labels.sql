@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE agents ADD COLUMN labels TEXT[];
+ALTER TABLE workspaces ADD COLUMN agent_selector TEXT;
+
+-- +goose Down
+ALTER TABLE workspaces DROP COLUMN agent_selector;
+ALTER TABLE agents DROP COLUMN labels;
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE organizations
+    ADD COLUMN plan_timeout INT,
+    ADD COLUMN apply_timeout INT;
+ALTER TABLE workspaces
+    ADD COLUMN plan_timeout INT,
+    ADD COLUMN apply_timeout INT;
+ALTER TABLE jobs ADD COLUMN started_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN started_at;
+ALTER TABLE workspaces
+    DROP COLUMN apply_timeout,
+    DROP COLUMN plan_timeout;
+ALTER TABLE organizations
+    DROP COLUMN apply_timeout,
+    DROP COLUMN plan_timeout;
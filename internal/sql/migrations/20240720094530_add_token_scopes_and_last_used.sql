@@ -0,0 +1,23 @@
+-- +goose Up
+ALTER TABLE tokens
+    ADD COLUMN expiry TIMESTAMPTZ,
+    ADD COLUMN scope TEXT NOT NULL DEFAULT 'read-write',
+    ADD COLUMN last_used_at TIMESTAMPTZ;
+ALTER TABLE team_tokens
+    ADD COLUMN scope TEXT NOT NULL DEFAULT 'read-write',
+    ADD COLUMN last_used_at TIMESTAMPTZ;
+ALTER TABLE organization_tokens
+    ADD COLUMN scope TEXT NOT NULL DEFAULT 'read-write',
+    ADD COLUMN last_used_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE organization_tokens
+    DROP COLUMN last_used_at,
+    DROP COLUMN scope;
+ALTER TABLE team_tokens
+    DROP COLUMN last_used_at,
+    DROP COLUMN scope;
+ALTER TABLE tokens
+    DROP COLUMN last_used_at,
+    DROP COLUMN scope,
+    DROP COLUMN expiry;
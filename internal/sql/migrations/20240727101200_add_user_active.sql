@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN active BOOLEAN NOT NULL DEFAULT true;
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN active;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE variables ADD COLUMN secret_ref BOOLEAN NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE variables DROP COLUMN secret_ref;
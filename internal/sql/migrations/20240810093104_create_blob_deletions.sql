@@ -0,0 +1,86 @@
+-- +goose Up
+-- blob_deletions records the keys of blobs whose rows have been deleted, so
+-- that the blobs can then be removed from the blob store. Rows are recorded by
+-- triggers, which catches rows deleted by cascading deletes too.
+CREATE TABLE IF NOT EXISTS blob_deletions (
+    key        TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+               PRIMARY KEY (key)
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION record_blob_deletion() RETURNS TRIGGER AS $$
+BEGIN
+    CASE TG_TABLE_NAME
+    WHEN 'state_versions' THEN
+        INSERT INTO blob_deletions (key) VALUES ('state/' || OLD.state_version_id)
+        ON CONFLICT DO NOTHING;
+    WHEN 'configuration_versions' THEN
+        INSERT INTO blob_deletions (key) VALUES ('configs/' || OLD.configuration_version_id)
+        ON CONFLICT DO NOTHING;
+    WHEN 'plans' THEN
+        IF OLD.plan_bin IS NULL THEN
+            INSERT INTO blob_deletions (key) VALUES ('plans/' || OLD.run_id || '/bin')
+            ON CONFLICT DO NOTHING;
+        END IF;
+        IF OLD.plan_json IS NULL THEN
+            INSERT INTO blob_deletions (key) VALUES ('plans/' || OLD.run_id || '/json')
+            ON CONFLICT DO NOTHING;
+        END IF;
+    WHEN 'logs' THEN
+        INSERT INTO blob_deletions (key) VALUES ('logs/' || OLD.run_id || '/' || OLD.phase || '/' || OLD.chunk_id)
+        ON CONFLICT DO NOTHING;
+    WHEN 'module_tarballs' THEN
+        INSERT INTO blob_deletions (key) VALUES ('modules/' || OLD.module_version_id)
+        ON CONFLICT DO NOTHING;
+    WHEN 'registry_provider_platforms' THEN
+        INSERT INTO blob_deletions (key) VALUES ('providers/' || OLD.registry_provider_platform_id)
+        ON CONFLICT DO NOTHING;
+    WHEN 'provider_mirror_archives' THEN
+        INSERT INTO blob_deletions (key) VALUES ('mirror/' || OLD.provider_mirror_version_id || '/' || OLD.os || '_' || OLD.arch)
+        ON CONFLICT DO NOTHING;
+    END CASE;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- A blob is only in the blob store if it is absent from the database.
+CREATE TRIGGER record_blob_deletion
+AFTER DELETE ON state_versions
+    FOR EACH ROW WHEN (OLD.state IS NULL) EXECUTE FUNCTION record_blob_deletion();
+
+CREATE TRIGGER record_blob_deletion
+AFTER DELETE ON configuration_versions
+    FOR EACH ROW WHEN (OLD.config IS NULL) EXECUTE FUNCTION record_blob_deletion();
+
+CREATE TRIGGER record_blob_deletion
+AFTER DELETE ON plans
+    FOR EACH ROW WHEN (OLD.plan_bin IS NULL OR OLD.plan_json IS NULL) EXECUTE FUNCTION record_blob_deletion();
+
+CREATE TRIGGER record_blob_deletion
+AFTER DELETE ON logs
+    FOR EACH ROW WHEN (OLD.chunk IS NULL) EXECUTE FUNCTION record_blob_deletion();
+
+CREATE TRIGGER record_blob_deletion
+AFTER DELETE ON module_tarballs
+    FOR EACH ROW WHEN (OLD.tarball IS NULL) EXECUTE FUNCTION record_blob_deletion();
+
+CREATE TRIGGER record_blob_deletion
+AFTER DELETE ON registry_provider_platforms
+    FOR EACH ROW WHEN (OLD.provider_binary IS NULL) EXECUTE FUNCTION record_blob_deletion();
+
+CREATE TRIGGER record_blob_deletion
+AFTER DELETE ON provider_mirror_archives
+    FOR EACH ROW WHEN (OLD.archive IS NULL) EXECUTE FUNCTION record_blob_deletion();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS record_blob_deletion ON provider_mirror_archives;
+DROP TRIGGER IF EXISTS record_blob_deletion ON registry_provider_platforms;
+DROP TRIGGER IF EXISTS record_blob_deletion ON module_tarballs;
+DROP TRIGGER IF EXISTS record_blob_deletion ON logs;
+DROP TRIGGER IF EXISTS record_blob_deletion ON plans;
+DROP TRIGGER IF EXISTS record_blob_deletion ON configuration_versions;
+DROP TRIGGER IF EXISTS record_blob_deletion ON state_versions;
+DROP FUNCTION IF EXISTS record_blob_deletion;
+DROP TABLE IF EXISTS blob_deletions;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- A null auto_apply defers to the workspace's auto-apply setting. Previously
+-- an unset auto-apply was persisted as false, so existing false values are
+-- taken to be unset.
+ALTER TABLE schedules ALTER COLUMN auto_apply DROP NOT NULL;
+UPDATE schedules SET auto_apply = NULL WHERE auto_apply = false;
+
+-- +goose Down
+UPDATE schedules SET auto_apply = false WHERE auto_apply IS NULL;
+ALTER TABLE schedules ALTER COLUMN auto_apply SET NOT NULL;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- The agent manager periodically lists running jobs; the index spares it from
+-- scanning every job ever created.
+CREATE INDEX IF NOT EXISTS jobs_running_idx ON jobs (run_id, phase) WHERE status = 'running';
+
+-- +goose Down
+DROP INDEX IF EXISTS jobs_running_idx;
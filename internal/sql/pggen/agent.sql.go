@@ -72,6 +72,60 @@ type Querier interface {
 
 	UpdateApplyStatusByID(ctx context.Context, status pgtype.Text, runID pgtype.Text) (pgtype.Text, error)
 
+	InsertAuditEvent(ctx context.Context, params InsertAuditEventParams) (pgconn.CommandTag, error)
+
+	FindAuditEvents(ctx context.Context, params FindAuditEventsParams) ([]FindAuditEventsRow, error)
+
+	CountAuditEvents(ctx context.Context, params CountAuditEventsParams) (pgtype.Int8, error)
+
+	DeleteAuditEventsBefore(ctx context.Context, before pgtype.Timestamptz) (pgconn.CommandTag, error)
+
+	FindSensitiveVariablesForReencryption(ctx context.Context, after pgtype.Text, limit pgtype.Int8) ([]FindSensitiveVariablesForReencryptionRow, error)
+
+	UpdateVariableValue(ctx context.Context, value pgtype.Text, variableID pgtype.Text) (pgconn.CommandTag, error)
+
+	FindStateVersionsForReencryption(ctx context.Context, after pgtype.Text, limit pgtype.Int8) ([]FindStateVersionsForReencryptionRow, error)
+
+	UpdateStateVersionState(ctx context.Context, state []byte, stateVersionID pgtype.Text) (pgconn.CommandTag, error)
+
+	FindSensitiveStateVersionOutputsForReencryption(ctx context.Context, after pgtype.Text, limit pgtype.Int8) ([]FindSensitiveStateVersionOutputsForReencryptionRow, error)
+
+	UpdateStateVersionOutputValue(ctx context.Context, value []byte, stateVersionOutputID pgtype.Text) (pgconn.CommandTag, error)
+
+	FindPlanFilesForReencryption(ctx context.Context, after pgtype.Text, limit pgtype.Int8) ([]FindPlanFilesForReencryptionRow, error)
+
+	FindInlineStateVersions(ctx context.Context, limit pgtype.Int8) ([]FindInlineStateVersionsRow, error)
+
+	ClearStateVersionState(ctx context.Context, stateVersionID pgtype.Text) (pgconn.CommandTag, error)
+
+	FindInlineConfigurationVersions(ctx context.Context, limit pgtype.Int8) ([]FindInlineConfigurationVersionsRow, error)
+
+	ClearConfigurationVersionConfig(ctx context.Context, configurationVersionID pgtype.Text) (pgconn.CommandTag, error)
+
+	FindInlinePlanFiles(ctx context.Context, limit pgtype.Int8) ([]FindInlinePlanFilesRow, error)
+
+	ClearPlanFiles(ctx context.Context, runID pgtype.Text) (pgconn.CommandTag, error)
+
+	FindInlineModuleTarballs(ctx context.Context, limit pgtype.Int8) ([]FindInlineModuleTarballsRow, error)
+
+	ClearModuleTarball(ctx context.Context, moduleVersionID pgtype.Text) (pgconn.CommandTag, error)
+
+	FindInlineRegistryProviderBinaries(ctx context.Context, limit pgtype.Int8) ([]FindInlineRegistryProviderBinariesRow, error)
+
+	ClearRegistryProviderBinary(ctx context.Context, registryProviderPlatformID pgtype.Text) (pgconn.CommandTag, error)
+
+	FindInlineProviderMirrorArchives(ctx context.Context, limit pgtype.Int8) ([]FindInlineProviderMirrorArchivesRow, error)
+
+	ClearProviderMirrorArchive(ctx context.Context, params ClearProviderMirrorArchiveParams) (pgconn.CommandTag, error)
+
+	FindInlineLogChunks(ctx context.Context, limit pgtype.Int8) ([]FindInlineLogChunksRow, error)
+
+	ClearLogChunk(ctx context.Context, chunkID pgtype.Int4) (pgconn.CommandTag, error)
+
+	FindBlobDeletions(ctx context.Context, limit pgtype.Int8) ([]pgtype.Text, error)
+
+	DeleteBlobDeletions(ctx context.Context, keys []string) (pgconn.CommandTag, error)
+
 	InsertConfigurationVersion(ctx context.Context, params InsertConfigurationVersionParams) (pgconn.CommandTag, error)
 
 	InsertConfigurationVersionStatusTimestamp(ctx context.Context, params InsertConfigurationVersionStatusTimestampParams) (InsertConfigurationVersionStatusTimestampRow, error)
@@ -126,6 +180,8 @@ type Querier interface {
 
 	FindJobs(ctx context.Context) ([]FindJobsRow, error)
 
+	FindRunningJobs(ctx context.Context) ([]FindRunningJobsRow, error)
+
 	FindJob(ctx context.Context, runID pgtype.Text, phase pgtype.Text) (FindJobRow, error)
 
 	FindJobForUpdate(ctx context.Context, runID pgtype.Text, phase pgtype.Text) (FindJobForUpdateRow, error)
@@ -164,6 +220,10 @@ type Querier interface {
 
 	DeleteModuleVersionByID(ctx context.Context, moduleVersionID pgtype.Text) (pgtype.Text, error)
 
+	UpsertModuleVersionMetadata(ctx context.Context, params UpsertModuleVersionMetadataParams) (pgconn.CommandTag, error)
+
+	FindModuleVersionMetadata(ctx context.Context, moduleVersionID pgtype.Text) (FindModuleVersionMetadataRow, error)
+
 	InsertNotificationConfiguration(ctx context.Context, params InsertNotificationConfigurationParams) (pgconn.CommandTag, error)
 
 	FindNotificationConfigurationsByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) ([]FindNotificationConfigurationsByWorkspaceIDRow, error)
@@ -206,13 +266,20 @@ type Querier interface {
 
 	DeleteOrganiationTokenByName(ctx context.Context, organizationName pgtype.Text) (pgtype.Text, error)
 
+	UpdateOrganizationTokenLastUsedAt(ctx context.Context, lastUsedAt pgtype.Timestamptz, organizationTokenID pgtype.Text) (pgconn.CommandTag, error)
+
+	FindStaleOrganizationTokens(ctx context.Context, cutoff pgtype.Timestamptz) ([]FindStaleOrganizationTokensRow, error)
+
+	DeleteStaleOrganizationTokens(ctx context.Context, cutoff pgtype.Timestamptz) ([]DeleteStaleOrganizationTokensRow, error)
+
 	InsertPhaseStatusTimestamp(ctx context.Context, params InsertPhaseStatusTimestampParams) (pgconn.CommandTag, error)
 
 	InsertLogChunk(ctx context.Context, params InsertLogChunkParams) (pgtype.Int4, error)
 
-	// FindLogs retrieves all the logs for the given run and phase.
+	// FindLogChunks retrieves all the chunks of logs for the given run and phase,
+	// in the order in which they were written.
 	//
-	FindLogs(ctx context.Context, runID pgtype.Text, phase pgtype.Text) ([]byte, error)
+	FindLogChunks(ctx context.Context, runID pgtype.Text, phase pgtype.Text) ([]FindLogChunksRow, error)
 
 	FindLogChunkByID(ctx context.Context, chunkID pgtype.Int4) (FindLogChunkByIDRow, error)
 
@@ -230,11 +297,134 @@ type Querier interface {
 
 	UpdatePlanJSONByID(ctx context.Context, planJSON []byte, runID pgtype.Text) (pgtype.Text, error)
 
-	InsertLatestTerraformVersion(ctx context.Context, version pgtype.Text) (pgconn.CommandTag, error)
+	InsertPolicySet(ctx context.Context, params InsertPolicySetParams) (pgconn.CommandTag, error)
+
+	FindPolicySetsByOrganization(ctx context.Context, organizationName pgtype.Text) ([]FindPolicySetsByOrganizationRow, error)
+
+	FindPolicySet(ctx context.Context, policySetID pgtype.Text) (FindPolicySetRow, error)
+
+	FindPolicySetForUpdate(ctx context.Context, policySetID pgtype.Text) (FindPolicySetForUpdateRow, error)
+
+	UpdatePolicySet(ctx context.Context, params UpdatePolicySetParams) (pgtype.Text, error)
+
+	DeletePolicySet(ctx context.Context, policySetID pgtype.Text) (pgtype.Text, error)
+
+	InsertPolicy(ctx context.Context, params InsertPolicyParams) (pgconn.CommandTag, error)
+
+	FindPoliciesByPolicySetID(ctx context.Context, policySetID pgtype.Text) ([]FindPoliciesByPolicySetIDRow, error)
+
+	// FindPoliciesByOrganization finds the policies belonging to all policy sets
+	// in an organization.
+	//
+	FindPoliciesByOrganization(ctx context.Context, organizationName pgtype.Text) ([]FindPoliciesByOrganizationRow, error)
+
+	FindPolicy(ctx context.Context, policyID pgtype.Text) (FindPolicyRow, error)
+
+	FindPolicyForUpdate(ctx context.Context, policyID pgtype.Text) (FindPolicyForUpdateRow, error)
+
+	UpdatePolicy(ctx context.Context, params UpdatePolicyParams) (pgtype.Text, error)
+
+	DeletePolicy(ctx context.Context, policyID pgtype.Text) (pgtype.Text, error)
+
+	InsertPolicyCheck(ctx context.Context, params InsertPolicyCheckParams) (pgconn.CommandTag, error)
+
+	FindPolicyCheck(ctx context.Context, policyCheckID pgtype.Text) (FindPolicyCheckRow, error)
+
+	FindPolicyCheckByRunID(ctx context.Context, runID pgtype.Text) (FindPolicyCheckByRunIDRow, error)
+
+	UpdatePolicyCheckStatus(ctx context.Context, params UpdatePolicyCheckStatusParams) (pgtype.Text, error)
+
+	InsertProject(ctx context.Context, params InsertProjectParams) (pgconn.CommandTag, error)
+
+	FindProjects(ctx context.Context, params FindProjectsParams) ([]FindProjectsRow, error)
+
+	CountProjects(ctx context.Context, organizationName pgtype.Text, search pgtype.Text) (pgtype.Int8, error)
+
+	FindProjectByID(ctx context.Context, projectID pgtype.Text) (FindProjectByIDRow, error)
+
+	FindProjectByIDForUpdate(ctx context.Context, projectID pgtype.Text) (FindProjectByIDForUpdateRow, error)
+
+	UpdateProject(ctx context.Context, params UpdateProjectParams) (pgtype.Text, error)
+
+	DeleteProject(ctx context.Context, projectID pgtype.Text) (pgtype.Text, error)
+
+	UpsertProjectPermission(ctx context.Context, params UpsertProjectPermissionParams) (pgconn.CommandTag, error)
+
+	FindProjectPermissionByID(ctx context.Context, projectPermissionID pgtype.Text) (FindProjectPermissionByIDRow, error)
+
+	FindProjectPermissionByTeamID(ctx context.Context, projectID pgtype.Text, teamID pgtype.Text) (FindProjectPermissionByTeamIDRow, error)
+
+	FindProjectPermissionsByProjectID(ctx context.Context, projectID pgtype.Text) ([]FindProjectPermissionsByProjectIDRow, error)
+
+	FindProjectPermissionsByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) ([]FindProjectPermissionsByWorkspaceIDRow, error)
+
+	DeleteProjectPermission(ctx context.Context, projectID pgtype.Text, teamID pgtype.Text) (pgconn.CommandTag, error)
+
+	FindPullRequestCommentID(ctx context.Context, params FindPullRequestCommentIDParams) (pgtype.Text, error)
+
+	UpsertPullRequestComment(ctx context.Context, params UpsertPullRequestCommentParams) (pgconn.CommandTag, error)
+
+	InsertRegistryProvider(ctx context.Context, params InsertRegistryProviderParams) (pgconn.CommandTag, error)
+
+	FindRegistryProviders(ctx context.Context, organizationName pgtype.Text) ([]FindRegistryProvidersRow, error)
+
+	FindRegistryProvider(ctx context.Context, organizationName pgtype.Text, name pgtype.Text) (FindRegistryProviderRow, error)
 
-	UpdateLatestTerraformVersion(ctx context.Context, version pgtype.Text) (pgconn.CommandTag, error)
+	FindRegistryProviderByID(ctx context.Context, registryProviderID pgtype.Text) (FindRegistryProviderByIDRow, error)
 
-	FindLatestTerraformVersion(ctx context.Context) ([]FindLatestTerraformVersionRow, error)
+	DeleteRegistryProvider(ctx context.Context, registryProviderID pgtype.Text) (pgconn.CommandTag, error)
+
+	InsertRegistryProviderVersion(ctx context.Context, params InsertRegistryProviderVersionParams) (pgconn.CommandTag, error)
+
+	FindRegistryProviderVersions(ctx context.Context, registryProviderID pgtype.Text) ([]FindRegistryProviderVersionsRow, error)
+
+	FindRegistryProviderVersion(ctx context.Context, registryProviderID pgtype.Text, version pgtype.Text) (FindRegistryProviderVersionRow, error)
+
+	FindRegistryProviderVersionByID(ctx context.Context, registryProviderVersionID pgtype.Text) (FindRegistryProviderVersionByIDRow, error)
+
+	UpdateRegistryProviderVersionShasums(ctx context.Context, params UpdateRegistryProviderVersionShasumsParams) (pgconn.CommandTag, error)
+
+	UpdateRegistryProviderVersionShasumsSig(ctx context.Context, params UpdateRegistryProviderVersionShasumsSigParams) (pgconn.CommandTag, error)
+
+	FindRegistryProviderVersionShasums(ctx context.Context, registryProviderVersionID pgtype.Text) ([]byte, error)
+
+	FindRegistryProviderVersionShasumsSig(ctx context.Context, registryProviderVersionID pgtype.Text) ([]byte, error)
+
+	DeleteRegistryProviderVersion(ctx context.Context, registryProviderVersionID pgtype.Text) (pgconn.CommandTag, error)
+
+	InsertRegistryProviderPlatform(ctx context.Context, params InsertRegistryProviderPlatformParams) (pgconn.CommandTag, error)
+
+	FindRegistryProviderPlatforms(ctx context.Context, registryProviderVersionID pgtype.Text) ([]FindRegistryProviderPlatformsRow, error)
+
+	FindRegistryProviderPlatform(ctx context.Context, params FindRegistryProviderPlatformParams) (FindRegistryProviderPlatformRow, error)
+
+	FindRegistryProviderPlatformByID(ctx context.Context, registryProviderPlatformID pgtype.Text) (FindRegistryProviderPlatformByIDRow, error)
+
+	UpdateRegistryProviderPlatformBinary(ctx context.Context, params UpdateRegistryProviderPlatformBinaryParams) (pgconn.CommandTag, error)
+
+	FindRegistryProviderPlatformBinary(ctx context.Context, registryProviderPlatformID pgtype.Text) ([]byte, error)
+
+	DeleteRegistryProviderPlatform(ctx context.Context, registryProviderPlatformID pgtype.Text) (pgconn.CommandTag, error)
+
+	InsertProviderMirrorVersion(ctx context.Context, params InsertProviderMirrorVersionParams) (pgconn.CommandTag, error)
+
+	FindProviderMirrorVersions(ctx context.Context, params FindProviderMirrorVersionsParams) ([]FindProviderMirrorVersionsRow, error)
+
+	FindProviderMirrorVersion(ctx context.Context, params FindProviderMirrorVersionParams) (FindProviderMirrorVersionRow, error)
+
+	FindProviderMirrorVersionByID(ctx context.Context, providerMirrorVersionID pgtype.Text) (FindProviderMirrorVersionByIDRow, error)
+
+	InsertProviderMirrorArchive(ctx context.Context, params InsertProviderMirrorArchiveParams) (pgconn.CommandTag, error)
+
+	FindProviderMirrorArchive(ctx context.Context, params FindProviderMirrorArchiveParams) (FindProviderMirrorArchiveRow, error)
+
+	DeleteProviderMirrorVersionsBefore(ctx context.Context, before pgtype.Timestamptz) (pgconn.CommandTag, error)
+
+	InsertLatestTerraformVersion(ctx context.Context, version pgtype.Text, engine pgtype.Text) (pgconn.CommandTag, error)
+
+	UpdateLatestTerraformVersion(ctx context.Context, version pgtype.Text, engine pgtype.Text) (pgconn.CommandTag, error)
+
+	FindLatestTerraformVersion(ctx context.Context, engine pgtype.Text) ([]FindLatestTerraformVersionRow, error)
 
 	InsertRepoConnection(ctx context.Context, params InsertRepoConnectionParams) (pgconn.CommandTag, error)
 
@@ -280,6 +470,36 @@ type Querier interface {
 
 	DeleteRunByID(ctx context.Context, runID pgtype.Text) (pgtype.Text, error)
 
+	InsertRunTrigger(ctx context.Context, params InsertRunTriggerParams) (pgconn.CommandTag, error)
+
+	FindRunTrigger(ctx context.Context, runTriggerID pgtype.Text) (FindRunTriggerRow, error)
+
+	// FindRunTriggersByWorkspaceID finds the inbound run triggers for a workspace,
+	// i.e. those that trigger runs in the workspace.
+	//
+	FindRunTriggersByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) ([]FindRunTriggersByWorkspaceIDRow, error)
+
+	// FindRunTriggersBySourceableWorkspaceID finds the outbound run triggers for a
+	// workspace, i.e. those that trigger runs in other workspaces.
+	//
+	FindRunTriggersBySourceableWorkspaceID(ctx context.Context, sourceableWorkspaceID pgtype.Text) ([]FindRunTriggersBySourceableWorkspaceIDRow, error)
+
+	DeleteRunTrigger(ctx context.Context, runTriggerID pgtype.Text) (pgtype.Text, error)
+
+	InsertSchedule(ctx context.Context, params InsertScheduleParams) (pgconn.CommandTag, error)
+
+	FindSchedule(ctx context.Context, scheduleID pgtype.Text) (FindScheduleRow, error)
+
+	FindScheduleForUpdate(ctx context.Context, scheduleID pgtype.Text) (FindScheduleForUpdateRow, error)
+
+	FindSchedulesByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) ([]FindSchedulesByWorkspaceIDRow, error)
+
+	FindDueSchedules(ctx context.Context, due pgtype.Timestamptz) ([]FindDueSchedulesRow, error)
+
+	UpdateSchedule(ctx context.Context, params UpdateScheduleParams) (pgtype.Text, error)
+
+	DeleteSchedule(ctx context.Context, scheduleID pgtype.Text) (pgtype.Text, error)
+
 	InsertStateVersion(ctx context.Context, params InsertStateVersionParams) (pgconn.CommandTag, error)
 
 	UpdateState(ctx context.Context, state []byte, stateVersionID pgtype.Text) (pgconn.CommandTag, error)
@@ -352,6 +572,12 @@ type Querier interface {
 
 	DeleteTeamTokenByID(ctx context.Context, teamID pgtype.Text) (pgtype.Text, error)
 
+	UpdateTeamTokenLastUsedAt(ctx context.Context, lastUsedAt pgtype.Timestamptz, teamTokenID pgtype.Text) (pgconn.CommandTag, error)
+
+	FindStaleTeamTokens(ctx context.Context, cutoff pgtype.Timestamptz) ([]FindStaleTeamTokensRow, error)
+
+	DeleteStaleTeamTokens(ctx context.Context, cutoff pgtype.Timestamptz) ([]DeleteStaleTeamTokensRow, error)
+
 	InsertToken(ctx context.Context, params InsertTokenParams) (pgconn.CommandTag, error)
 
 	FindTokensByUsername(ctx context.Context, username pgtype.Text) ([]FindTokensByUsernameRow, error)
@@ -360,6 +586,14 @@ type Querier interface {
 
 	DeleteTokenByID(ctx context.Context, tokenID pgtype.Text) (pgtype.Text, error)
 
+	DeleteTokensByUsername(ctx context.Context, username pgtype.Text) (pgconn.CommandTag, error)
+
+	UpdateTokenLastUsedAt(ctx context.Context, lastUsedAt pgtype.Timestamptz, tokenID pgtype.Text) (pgconn.CommandTag, error)
+
+	FindStaleTokens(ctx context.Context, cutoff pgtype.Timestamptz) ([]FindStaleTokensRow, error)
+
+	DeleteStaleTokens(ctx context.Context, cutoff pgtype.Timestamptz) ([]DeleteStaleTokensRow, error)
+
 	InsertUser(ctx context.Context, params InsertUserParams) (pgconn.CommandTag, error)
 
 	FindUsers(ctx context.Context) ([]FindUsersRow, error)
@@ -382,6 +616,8 @@ type Querier interface {
 
 	DeleteUserByUsername(ctx context.Context, username pgtype.Text) (pgtype.Text, error)
 
+	UpdateUser(ctx context.Context, params UpdateUserParams) (pgconn.CommandTag, error)
+
 	InsertVariable(ctx context.Context, params InsertVariableParams) (pgconn.CommandTag, error)
 
 	FindVariable(ctx context.Context, variableID pgtype.Text) (FindVariableRow, error)
@@ -452,6 +688,14 @@ type Querier interface {
 
 	UpdateWorkspaceByID(ctx context.Context, params UpdateWorkspaceByIDParams) (pgtype.Text, error)
 
+	FindWorkspacesForAssessment(ctx context.Context, dueBefore pgtype.Timestamptz) ([]FindWorkspacesForAssessmentRow, error)
+
+	UpdateWorkspaceLatestAssessment(ctx context.Context, params UpdateWorkspaceLatestAssessmentParams) (pgconn.CommandTag, error)
+
+	FindWorkspacesForAutoDestroy(ctx context.Context) ([]FindWorkspacesForAutoDestroyRow, error)
+
+	UpdateWorkspaceAutoDestroyRun(ctx context.Context, runID pgtype.Text, workspaceID pgtype.Text) (pgconn.CommandTag, error)
+
 	UpdateWorkspaceLockByID(ctx context.Context, params UpdateWorkspaceLockByIDParams) (pgconn.CommandTag, error)
 
 	UpdateWorkspaceLatestRun(ctx context.Context, runID pgtype.Text, workspaceID pgtype.Text) (pgconn.CommandTag, error)
@@ -631,6 +875,7 @@ type Runs struct {
 	Source                 pgtype.Text        `json:"source"`
 	TerraformVersion       pgtype.Text        `json:"terraform_version"`
 	AllowEmptyApply        pgtype.Bool        `json:"allow_empty_apply"`
+	Engine                 pgtype.Text        `json:"engine"`
 }
 
 // StateVersionOutputs represents the Postgres composite type "state_version_outputs".
@@ -666,6 +911,7 @@ type Users struct {
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 	SiteAdmin pgtype.Bool        `json:"site_admin"`
+	Active    pgtype.Bool        `json:"active"`
 }
 
 // Variables represents the Postgres composite type "variables".
@@ -678,6 +924,7 @@ type Variables struct {
 	Sensitive   pgtype.Bool `json:"sensitive"`
 	HCL         pgtype.Bool `json:"hcl"`
 	VersionID   pgtype.Text `json:"version_id"`
+	SecretRef   pgtype.Bool `json:"secret_ref"`
 }
 
 // codec_newConfigurationVersionStatusTimestamps is a codec for the composite type of the same name
@@ -1588,6 +1835,11 @@ func codec_newRuns(conn RegisterConn) (pgtype.Codec, error) {
 		return nil, fmt.Errorf("type not found: bool")
 	}
 
+	field19, ok := conn.TypeMap().TypeForName("text")
+	if !ok {
+		return nil, fmt.Errorf("type not found: text")
+	}
+
 	return &pgtype.CompositeCodec{
 		Fields: []pgtype.CompositeCodecField{
 
@@ -1685,6 +1937,11 @@ func codec_newRuns(conn RegisterConn) (pgtype.Codec, error) {
 				Name: "allow_empty_apply",
 				Type: field18,
 			},
+
+			{
+				Name: "engine",
+				Type: field19,
+			},
 		},
 	}, nil
 }
@@ -2073,6 +2330,11 @@ func codec_newVariables(conn RegisterConn) (pgtype.Codec, error) {
 		return nil, fmt.Errorf("type not found: text")
 	}
 
+	field8, ok := conn.TypeMap().TypeForName("bool")
+	if !ok {
+		return nil, fmt.Errorf("type not found: bool")
+	}
+
 	return &pgtype.CompositeCodec{
 		Fields: []pgtype.CompositeCodecField{
 
@@ -2115,6 +2377,11 @@ func codec_newVariables(conn RegisterConn) (pgtype.Codec, error) {
 				Name: "version_id",
 				Type: field7,
 			},
+
+			{
+				Name: "secret_ref",
+				Type: field8,
+			},
 		},
 	}, nil
 }
@@ -2413,7 +2680,8 @@ const insertAgentSQL = `INSERT INTO agents (
     last_ping_at,
     last_status_at,
     status,
-    agent_pool_id
+    agent_pool_id,
+    labels
 ) VALUES (
     $1,
     $2,
@@ -2423,7 +2691,8 @@ const insertAgentSQL = `INSERT INTO agents (
     $6,
     $7,
     $8,
-    $9
+    $9,
+    $10
 );`
 
 type InsertAgentParams struct {
@@ -2436,12 +2705,13 @@ type InsertAgentParams struct {
 	LastStatusAt pgtype.Timestamptz `json:"last_status_at"`
 	Status       pgtype.Text        `json:"status"`
 	AgentPoolID  pgtype.Text        `json:"agent_pool_id"`
+	Labels       []string           `json:"labels"`
 }
 
 // InsertAgent implements Querier.InsertAgent.
 func (q *DBQuerier) InsertAgent(ctx context.Context, params InsertAgentParams) (pgconn.CommandTag, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "InsertAgent")
-	cmdTag, err := q.conn.Exec(ctx, insertAgentSQL, params.AgentID, params.Name, params.Version, params.MaxJobs, params.IPAddress, params.LastPingAt, params.LastStatusAt, params.Status, params.AgentPoolID)
+	cmdTag, err := q.conn.Exec(ctx, insertAgentSQL, params.AgentID, params.Name, params.Version, params.MaxJobs, params.IPAddress, params.LastPingAt, params.LastStatusAt, params.Status, params.AgentPoolID, params.Labels)
 	if err != nil {
 		return pgconn.CommandTag{}, fmt.Errorf("exec query InsertAgent: %w", err)
 	}
@@ -2472,6 +2742,7 @@ type UpdateAgentRow struct {
 	LastStatusAt pgtype.Timestamptz `json:"last_status_at"`
 	Status       pgtype.Text        `json:"status"`
 	AgentPoolID  pgtype.Text        `json:"agent_pool_id"`
+	Labels       []string           `json:"labels"`
 }
 
 // UpdateAgent implements Querier.UpdateAgent.
@@ -2493,6 +2764,7 @@ func (q *DBQuerier) UpdateAgent(ctx context.Context, params UpdateAgentParams) (
 			&item.LastStatusAt, // 'last_status_at', 'LastStatusAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.Status,       // 'status', 'Status', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.AgentPoolID,  // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Labels,       // 'labels', 'Labels', '[]string', '', '[]string'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -2521,6 +2793,7 @@ type FindAgentsRow struct {
 	LastStatusAt pgtype.Timestamptz `json:"last_status_at"`
 	Status       pgtype.Text        `json:"status"`
 	AgentPoolID  pgtype.Text        `json:"agent_pool_id"`
+	Labels       []string           `json:"labels"`
 	CurrentJobs  pgtype.Int8        `json:"current_jobs"`
 }
 
@@ -2543,6 +2816,7 @@ func (q *DBQuerier) FindAgents(ctx context.Context) ([]FindAgentsRow, error) {
 			&item.LastStatusAt, // 'last_status_at', 'LastStatusAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.Status,       // 'status', 'Status', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.AgentPoolID,  // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Labels,       // 'labels', 'Labels', '[]string', '', '[]string'
 			&item.CurrentJobs,  // 'current_jobs', 'CurrentJobs', 'pgtype.Int8', 'github.com/jackc/pgx/v5/pgtype', 'Int8'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
@@ -2574,6 +2848,7 @@ type FindAgentsByOrganizationRow struct {
 	LastStatusAt pgtype.Timestamptz `json:"last_status_at"`
 	Status       pgtype.Text        `json:"status"`
 	AgentPoolID  pgtype.Text        `json:"agent_pool_id"`
+	Labels       []string           `json:"labels"`
 	CurrentJobs  pgtype.Int8        `json:"current_jobs"`
 }
 
@@ -2596,6 +2871,7 @@ func (q *DBQuerier) FindAgentsByOrganization(ctx context.Context, organizationNa
 			&item.LastStatusAt, // 'last_status_at', 'LastStatusAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.Status,       // 'status', 'Status', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.AgentPoolID,  // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Labels,       // 'labels', 'Labels', '[]string', '', '[]string'
 			&item.CurrentJobs,  // 'current_jobs', 'CurrentJobs', 'pgtype.Int8', 'github.com/jackc/pgx/v5/pgtype', 'Int8'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
@@ -2627,6 +2903,7 @@ type FindAgentsByPoolIDRow struct {
 	LastStatusAt pgtype.Timestamptz `json:"last_status_at"`
 	Status       pgtype.Text        `json:"status"`
 	AgentPoolID  pgtype.Text        `json:"agent_pool_id"`
+	Labels       []string           `json:"labels"`
 	CurrentJobs  pgtype.Int8        `json:"current_jobs"`
 }
 
@@ -2649,6 +2926,7 @@ func (q *DBQuerier) FindAgentsByPoolID(ctx context.Context, agentPoolID pgtype.T
 			&item.LastStatusAt, // 'last_status_at', 'LastStatusAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.Status,       // 'status', 'Status', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.AgentPoolID,  // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Labels,       // 'labels', 'Labels', '[]string', '', '[]string'
 			&item.CurrentJobs,  // 'current_jobs', 'CurrentJobs', 'pgtype.Int8', 'github.com/jackc/pgx/v5/pgtype', 'Int8'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
@@ -2679,6 +2957,7 @@ type FindServerAgentsRow struct {
 	LastStatusAt pgtype.Timestamptz `json:"last_status_at"`
 	Status       pgtype.Text        `json:"status"`
 	AgentPoolID  pgtype.Text        `json:"agent_pool_id"`
+	Labels       []string           `json:"labels"`
 	CurrentJobs  pgtype.Int8        `json:"current_jobs"`
 }
 
@@ -2701,6 +2980,7 @@ func (q *DBQuerier) FindServerAgents(ctx context.Context) ([]FindServerAgentsRow
 			&item.LastStatusAt, // 'last_status_at', 'LastStatusAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.Status,       // 'status', 'Status', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.AgentPoolID,  // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Labels,       // 'labels', 'Labels', '[]string', '', '[]string'
 			&item.CurrentJobs,  // 'current_jobs', 'CurrentJobs', 'pgtype.Int8', 'github.com/jackc/pgx/v5/pgtype', 'Int8'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
@@ -2731,6 +3011,7 @@ type FindAgentByIDRow struct {
 	LastStatusAt pgtype.Timestamptz `json:"last_status_at"`
 	Status       pgtype.Text        `json:"status"`
 	AgentPoolID  pgtype.Text        `json:"agent_pool_id"`
+	Labels       []string           `json:"labels"`
 	CurrentJobs  pgtype.Int8        `json:"current_jobs"`
 }
 
@@ -2753,6 +3034,7 @@ func (q *DBQuerier) FindAgentByID(ctx context.Context, agentID pgtype.Text) (Fin
 			&item.LastStatusAt, // 'last_status_at', 'LastStatusAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.Status,       // 'status', 'Status', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.AgentPoolID,  // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Labels,       // 'labels', 'Labels', '[]string', '', '[]string'
 			&item.CurrentJobs,  // 'current_jobs', 'CurrentJobs', 'pgtype.Int8', 'github.com/jackc/pgx/v5/pgtype', 'Int8'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
@@ -2782,6 +3064,7 @@ type FindAgentByIDForUpdateRow struct {
 	LastStatusAt pgtype.Timestamptz `json:"last_status_at"`
 	Status       pgtype.Text        `json:"status"`
 	AgentPoolID  pgtype.Text        `json:"agent_pool_id"`
+	Labels       []string           `json:"labels"`
 	CurrentJobs  pgtype.Int8        `json:"current_jobs"`
 }
 
@@ -2804,6 +3087,7 @@ func (q *DBQuerier) FindAgentByIDForUpdate(ctx context.Context, agentID pgtype.T
 			&item.LastStatusAt, // 'last_status_at', 'LastStatusAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.Status,       // 'status', 'Status', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.AgentPoolID,  // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Labels,       // 'labels', 'Labels', '[]string', '', '[]string'
 			&item.CurrentJobs,  // 'current_jobs', 'CurrentJobs', 'pgtype.Int8', 'github.com/jackc/pgx/v5/pgtype', 'Int8'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
@@ -2827,6 +3111,7 @@ type DeleteAgentRow struct {
 	LastStatusAt pgtype.Timestamptz `json:"last_status_at"`
 	Status       pgtype.Text        `json:"status"`
 	AgentPoolID  pgtype.Text        `json:"agent_pool_id"`
+	Labels       []string           `json:"labels"`
 }
 
 // DeleteAgent implements Querier.DeleteAgent.
@@ -2848,6 +3133,7 @@ func (q *DBQuerier) DeleteAgent(ctx context.Context, agentID pgtype.Text) (Delet
 			&item.LastStatusAt, // 'last_status_at', 'LastStatusAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.Status,       // 'status', 'Status', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.AgentPoolID,  // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Labels,       // 'labels', 'Labels', '[]string', '', '[]string'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
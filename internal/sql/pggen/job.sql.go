@@ -46,23 +46,33 @@ const findJobsSQL = `SELECT
     j.status,
     j.signaled,
     j.agent_id,
+    j.started_at,
     w.agent_pool_id,
+    w.agent_selector,
+    CASE j.phase
+        WHEN 'plan' THEN COALESCE(w.plan_timeout, o.plan_timeout)
+        ELSE COALESCE(w.apply_timeout, o.apply_timeout)
+    END AS timeout,
     r.workspace_id,
     w.organization_name
 FROM jobs j
 JOIN runs r USING (run_id)
 JOIN workspaces w USING (workspace_id)
+JOIN organizations o ON o.name = w.organization_name
 ;`
 
 type FindJobsRow struct {
-	RunID            pgtype.Text `json:"run_id"`
-	Phase            pgtype.Text `json:"phase"`
-	Status           pgtype.Text `json:"status"`
-	Signaled         pgtype.Bool `json:"signaled"`
-	AgentID          pgtype.Text `json:"agent_id"`
-	AgentPoolID      pgtype.Text `json:"agent_pool_id"`
-	WorkspaceID      pgtype.Text `json:"workspace_id"`
-	OrganizationName pgtype.Text `json:"organization_name"`
+	RunID            pgtype.Text        `json:"run_id"`
+	Phase            pgtype.Text        `json:"phase"`
+	Status           pgtype.Text        `json:"status"`
+	Signaled         pgtype.Bool        `json:"signaled"`
+	AgentID          pgtype.Text        `json:"agent_id"`
+	StartedAt        pgtype.Timestamptz `json:"started_at"`
+	AgentPoolID      pgtype.Text        `json:"agent_pool_id"`
+	AgentSelector    pgtype.Text        `json:"agent_selector"`
+	Timeout          pgtype.Int4        `json:"timeout"`
+	WorkspaceID      pgtype.Text        `json:"workspace_id"`
+	OrganizationName pgtype.Text        `json:"organization_name"`
 }
 
 // FindJobs implements Querier.FindJobs.
@@ -80,7 +90,75 @@ func (q *DBQuerier) FindJobs(ctx context.Context) ([]FindJobsRow, error) {
 			&item.Status,           // 'status', 'Status', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.Signaled,         // 'signaled', 'Signaled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.AgentID,          // 'agent_id', 'AgentID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.StartedAt,        // 'started_at', 'StartedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.AgentPoolID,      // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AgentSelector,    // 'agent_selector', 'AgentSelector', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Timeout,          // 'timeout', 'Timeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.WorkspaceID,      // 'workspace_id', 'WorkspaceID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.OrganizationName, // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+		); err != nil {
+			return item, fmt.Errorf("failed to scan: %w", err)
+		}
+		return item, nil
+	})
+}
+
+const findRunningJobsSQL = `SELECT
+    j.run_id,
+    j.phase,
+    j.status,
+    j.signaled,
+    j.agent_id,
+    j.started_at,
+    w.agent_pool_id,
+    w.agent_selector,
+    CASE j.phase
+        WHEN 'plan' THEN COALESCE(w.plan_timeout, o.plan_timeout)
+        ELSE COALESCE(w.apply_timeout, o.apply_timeout)
+    END AS timeout,
+    r.workspace_id,
+    w.organization_name
+FROM jobs j
+JOIN runs r USING (run_id)
+JOIN workspaces w USING (workspace_id)
+JOIN organizations o ON o.name = w.organization_name
+WHERE j.status = 'running'
+AND   j.started_at IS NOT NULL
+;`
+
+type FindRunningJobsRow struct {
+	RunID            pgtype.Text        `json:"run_id"`
+	Phase            pgtype.Text        `json:"phase"`
+	Status           pgtype.Text        `json:"status"`
+	Signaled         pgtype.Bool        `json:"signaled"`
+	AgentID          pgtype.Text        `json:"agent_id"`
+	StartedAt        pgtype.Timestamptz `json:"started_at"`
+	AgentPoolID      pgtype.Text        `json:"agent_pool_id"`
+	AgentSelector    pgtype.Text        `json:"agent_selector"`
+	Timeout          pgtype.Int4        `json:"timeout"`
+	WorkspaceID      pgtype.Text        `json:"workspace_id"`
+	OrganizationName pgtype.Text        `json:"organization_name"`
+}
+
+// FindRunningJobs implements Querier.FindRunningJobs.
+func (q *DBQuerier) FindRunningJobs(ctx context.Context) ([]FindRunningJobsRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "FindRunningJobs")
+	rows, err := q.conn.Query(ctx, findRunningJobsSQL)
+	if err != nil {
+		return nil, fmt.Errorf("query FindRunningJobs: %w", err)
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (FindRunningJobsRow, error) {
+		var item FindRunningJobsRow
+		if err := row.Scan(&item.RunID, // 'run_id', 'RunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Phase,            // 'phase', 'Phase', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Status,           // 'status', 'Status', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Signaled,         // 'signaled', 'Signaled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AgentID,          // 'agent_id', 'AgentID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.StartedAt,        // 'started_at', 'StartedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AgentPoolID,      // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AgentSelector,    // 'agent_selector', 'AgentSelector', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Timeout,          // 'timeout', 'Timeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
 			&item.WorkspaceID,      // 'workspace_id', 'WorkspaceID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.OrganizationName, // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 		); err != nil {
@@ -96,25 +174,35 @@ const findJobSQL = `SELECT
     j.status,
     j.signaled,
     j.agent_id,
+    j.started_at,
     w.agent_pool_id,
+    w.agent_selector,
+    CASE j.phase
+        WHEN 'plan' THEN COALESCE(w.plan_timeout, o.plan_timeout)
+        ELSE COALESCE(w.apply_timeout, o.apply_timeout)
+    END AS timeout,
     r.workspace_id,
     w.organization_name
 FROM jobs j
 JOIN runs r USING (run_id)
 JOIN workspaces w USING (workspace_id)
+JOIN organizations o ON o.name = w.organization_name
 WHERE run_id = $1
 AND   phase = $2
 ;`
 
 type FindJobRow struct {
-	RunID            pgtype.Text `json:"run_id"`
-	Phase            pgtype.Text `json:"phase"`
-	Status           pgtype.Text `json:"status"`
-	Signaled         pgtype.Bool `json:"signaled"`
-	AgentID          pgtype.Text `json:"agent_id"`
-	AgentPoolID      pgtype.Text `json:"agent_pool_id"`
-	WorkspaceID      pgtype.Text `json:"workspace_id"`
-	OrganizationName pgtype.Text `json:"organization_name"`
+	RunID            pgtype.Text        `json:"run_id"`
+	Phase            pgtype.Text        `json:"phase"`
+	Status           pgtype.Text        `json:"status"`
+	Signaled         pgtype.Bool        `json:"signaled"`
+	AgentID          pgtype.Text        `json:"agent_id"`
+	StartedAt        pgtype.Timestamptz `json:"started_at"`
+	AgentPoolID      pgtype.Text        `json:"agent_pool_id"`
+	AgentSelector    pgtype.Text        `json:"agent_selector"`
+	Timeout          pgtype.Int4        `json:"timeout"`
+	WorkspaceID      pgtype.Text        `json:"workspace_id"`
+	OrganizationName pgtype.Text        `json:"organization_name"`
 }
 
 // FindJob implements Querier.FindJob.
@@ -132,7 +220,10 @@ func (q *DBQuerier) FindJob(ctx context.Context, runID pgtype.Text, phase pgtype
 			&item.Status,           // 'status', 'Status', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.Signaled,         // 'signaled', 'Signaled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.AgentID,          // 'agent_id', 'AgentID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.StartedAt,        // 'started_at', 'StartedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.AgentPoolID,      // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AgentSelector,    // 'agent_selector', 'AgentSelector', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Timeout,          // 'timeout', 'Timeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
 			&item.WorkspaceID,      // 'workspace_id', 'WorkspaceID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.OrganizationName, // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 		); err != nil {
@@ -148,26 +239,36 @@ const findJobForUpdateSQL = `SELECT
     j.status,
     j.signaled,
     j.agent_id,
+    j.started_at,
     w.agent_pool_id,
+    w.agent_selector,
+    CASE j.phase
+        WHEN 'plan' THEN COALESCE(w.plan_timeout, o.plan_timeout)
+        ELSE COALESCE(w.apply_timeout, o.apply_timeout)
+    END AS timeout,
     r.workspace_id,
     w.organization_name
 FROM jobs j
 JOIN runs r USING (run_id)
 JOIN workspaces w USING (workspace_id)
+JOIN organizations o ON o.name = w.organization_name
 WHERE run_id = $1
 AND   phase = $2
 FOR UPDATE OF j
 ;`
 
 type FindJobForUpdateRow struct {
-	RunID            pgtype.Text `json:"run_id"`
-	Phase            pgtype.Text `json:"phase"`
-	Status           pgtype.Text `json:"status"`
-	Signaled         pgtype.Bool `json:"signaled"`
-	AgentID          pgtype.Text `json:"agent_id"`
-	AgentPoolID      pgtype.Text `json:"agent_pool_id"`
-	WorkspaceID      pgtype.Text `json:"workspace_id"`
-	OrganizationName pgtype.Text `json:"organization_name"`
+	RunID            pgtype.Text        `json:"run_id"`
+	Phase            pgtype.Text        `json:"phase"`
+	Status           pgtype.Text        `json:"status"`
+	Signaled         pgtype.Bool        `json:"signaled"`
+	AgentID          pgtype.Text        `json:"agent_id"`
+	StartedAt        pgtype.Timestamptz `json:"started_at"`
+	AgentPoolID      pgtype.Text        `json:"agent_pool_id"`
+	AgentSelector    pgtype.Text        `json:"agent_selector"`
+	Timeout          pgtype.Int4        `json:"timeout"`
+	WorkspaceID      pgtype.Text        `json:"workspace_id"`
+	OrganizationName pgtype.Text        `json:"organization_name"`
 }
 
 // FindJobForUpdate implements Querier.FindJobForUpdate.
@@ -185,7 +286,10 @@ func (q *DBQuerier) FindJobForUpdate(ctx context.Context, runID pgtype.Text, pha
 			&item.Status,           // 'status', 'Status', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.Signaled,         // 'signaled', 'Signaled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.AgentID,          // 'agent_id', 'AgentID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.StartedAt,        // 'started_at', 'StartedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.AgentPoolID,      // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AgentSelector,    // 'agent_selector', 'AgentSelector', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Timeout,          // 'timeout', 'Timeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
 			&item.WorkspaceID,      // 'workspace_id', 'WorkspaceID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.OrganizationName, // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 		); err != nil {
@@ -201,24 +305,34 @@ const findAllocatedJobsSQL = `SELECT
     j.status,
     j.signaled,
     j.agent_id,
+    j.started_at,
     w.agent_pool_id,
+    w.agent_selector,
+    CASE j.phase
+        WHEN 'plan' THEN COALESCE(w.plan_timeout, o.plan_timeout)
+        ELSE COALESCE(w.apply_timeout, o.apply_timeout)
+    END AS timeout,
     r.workspace_id,
     w.organization_name
 FROM jobs j
 JOIN runs r USING (run_id)
 JOIN workspaces w USING (workspace_id)
+JOIN organizations o ON o.name = w.organization_name
 WHERE j.agent_id = $1
 AND   j.status = 'allocated';`
 
 type FindAllocatedJobsRow struct {
-	RunID            pgtype.Text `json:"run_id"`
-	Phase            pgtype.Text `json:"phase"`
-	Status           pgtype.Text `json:"status"`
-	Signaled         pgtype.Bool `json:"signaled"`
-	AgentID          pgtype.Text `json:"agent_id"`
-	AgentPoolID      pgtype.Text `json:"agent_pool_id"`
-	WorkspaceID      pgtype.Text `json:"workspace_id"`
-	OrganizationName pgtype.Text `json:"organization_name"`
+	RunID            pgtype.Text        `json:"run_id"`
+	Phase            pgtype.Text        `json:"phase"`
+	Status           pgtype.Text        `json:"status"`
+	Signaled         pgtype.Bool        `json:"signaled"`
+	AgentID          pgtype.Text        `json:"agent_id"`
+	StartedAt        pgtype.Timestamptz `json:"started_at"`
+	AgentPoolID      pgtype.Text        `json:"agent_pool_id"`
+	AgentSelector    pgtype.Text        `json:"agent_selector"`
+	Timeout          pgtype.Int4        `json:"timeout"`
+	WorkspaceID      pgtype.Text        `json:"workspace_id"`
+	OrganizationName pgtype.Text        `json:"organization_name"`
 }
 
 // FindAllocatedJobs implements Querier.FindAllocatedJobs.
@@ -236,7 +350,10 @@ func (q *DBQuerier) FindAllocatedJobs(ctx context.Context, agentID pgtype.Text)
 			&item.Status,           // 'status', 'Status', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.Signaled,         // 'signaled', 'Signaled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.AgentID,          // 'agent_id', 'AgentID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.StartedAt,        // 'started_at', 'StartedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.AgentPoolID,      // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AgentSelector,    // 'agent_selector', 'AgentSelector', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Timeout,          // 'timeout', 'Timeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
 			&item.WorkspaceID,      // 'workspace_id', 'WorkspaceID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.OrganizationName, // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 		); err != nil {
@@ -248,9 +365,10 @@ func (q *DBQuerier) FindAllocatedJobs(ctx context.Context, agentID pgtype.Text)
 
 const findAndUpdateSignaledJobsSQL = `UPDATE jobs AS j
 SET signaled = NULL
-FROM runs r, workspaces w
+FROM runs r, workspaces w, organizations o
 WHERE j.run_id = r.run_id
 AND   r.workspace_id = w.workspace_id
+AND   w.organization_name = o.name
 AND   j.agent_id = $1
 AND   j.status = 'running'
 AND   j.signaled IS NOT NULL
@@ -260,20 +378,29 @@ RETURNING
     j.status,
     j.signaled,
     j.agent_id,
+    j.started_at,
     w.agent_pool_id,
+    w.agent_selector,
+    CASE j.phase
+        WHEN 'plan' THEN COALESCE(w.plan_timeout, o.plan_timeout)
+        ELSE COALESCE(w.apply_timeout, o.apply_timeout)
+    END AS timeout,
     r.workspace_id,
     w.organization_name
 ;`
 
 type FindAndUpdateSignaledJobsRow struct {
-	RunID            pgtype.Text `json:"run_id"`
-	Phase            pgtype.Text `json:"phase"`
-	Status           pgtype.Text `json:"status"`
-	Signaled         pgtype.Bool `json:"signaled"`
-	AgentID          pgtype.Text `json:"agent_id"`
-	AgentPoolID      pgtype.Text `json:"agent_pool_id"`
-	WorkspaceID      pgtype.Text `json:"workspace_id"`
-	OrganizationName pgtype.Text `json:"organization_name"`
+	RunID            pgtype.Text        `json:"run_id"`
+	Phase            pgtype.Text        `json:"phase"`
+	Status           pgtype.Text        `json:"status"`
+	Signaled         pgtype.Bool        `json:"signaled"`
+	AgentID          pgtype.Text        `json:"agent_id"`
+	StartedAt        pgtype.Timestamptz `json:"started_at"`
+	AgentPoolID      pgtype.Text        `json:"agent_pool_id"`
+	AgentSelector    pgtype.Text        `json:"agent_selector"`
+	Timeout          pgtype.Int4        `json:"timeout"`
+	WorkspaceID      pgtype.Text        `json:"workspace_id"`
+	OrganizationName pgtype.Text        `json:"organization_name"`
 }
 
 // FindAndUpdateSignaledJobs implements Querier.FindAndUpdateSignaledJobs.
@@ -291,7 +418,10 @@ func (q *DBQuerier) FindAndUpdateSignaledJobs(ctx context.Context, agentID pgtyp
 			&item.Status,           // 'status', 'Status', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.Signaled,         // 'signaled', 'Signaled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.AgentID,          // 'agent_id', 'AgentID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.StartedAt,        // 'started_at', 'StartedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.AgentPoolID,      // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AgentSelector,    // 'agent_selector', 'AgentSelector', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Timeout,          // 'timeout', 'Timeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
 			&item.WorkspaceID,      // 'workspace_id', 'WorkspaceID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.OrganizationName, // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 		); err != nil {
@@ -304,31 +434,34 @@ func (q *DBQuerier) FindAndUpdateSignaledJobs(ctx context.Context, agentID pgtyp
 const updateJobSQL = `UPDATE jobs
 SET status   = $1,
     signaled = $2,
-    agent_id = $3
-WHERE run_id = $4
-AND   phase = $5
+    agent_id = $3,
+    started_at = $4
+WHERE run_id = $5
+AND   phase = $6
 RETURNING *;`
 
 type UpdateJobParams struct {
-	Status   pgtype.Text `json:"status"`
-	Signaled pgtype.Bool `json:"signaled"`
-	AgentID  pgtype.Text `json:"agent_id"`
-	RunID    pgtype.Text `json:"run_id"`
-	Phase    pgtype.Text `json:"phase"`
+	Status    pgtype.Text        `json:"status"`
+	Signaled  pgtype.Bool        `json:"signaled"`
+	AgentID   pgtype.Text        `json:"agent_id"`
+	StartedAt pgtype.Timestamptz `json:"started_at"`
+	RunID     pgtype.Text        `json:"run_id"`
+	Phase     pgtype.Text        `json:"phase"`
 }
 
 type UpdateJobRow struct {
-	RunID    pgtype.Text `json:"run_id"`
-	Phase    pgtype.Text `json:"phase"`
-	Status   pgtype.Text `json:"status"`
-	AgentID  pgtype.Text `json:"agent_id"`
-	Signaled pgtype.Bool `json:"signaled"`
+	RunID     pgtype.Text        `json:"run_id"`
+	Phase     pgtype.Text        `json:"phase"`
+	Status    pgtype.Text        `json:"status"`
+	AgentID   pgtype.Text        `json:"agent_id"`
+	Signaled  pgtype.Bool        `json:"signaled"`
+	StartedAt pgtype.Timestamptz `json:"started_at"`
 }
 
 // UpdateJob implements Querier.UpdateJob.
 func (q *DBQuerier) UpdateJob(ctx context.Context, params UpdateJobParams) (UpdateJobRow, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "UpdateJob")
-	rows, err := q.conn.Query(ctx, updateJobSQL, params.Status, params.Signaled, params.AgentID, params.RunID, params.Phase)
+	rows, err := q.conn.Query(ctx, updateJobSQL, params.Status, params.Signaled, params.AgentID, params.StartedAt, params.RunID, params.Phase)
 	if err != nil {
 		return UpdateJobRow{}, fmt.Errorf("query UpdateJob: %w", err)
 	}
@@ -336,10 +469,11 @@ func (q *DBQuerier) UpdateJob(ctx context.Context, params UpdateJobParams) (Upda
 	return pgx.CollectOneRow(rows, func(row pgx.CollectableRow) (UpdateJobRow, error) {
 		var item UpdateJobRow
 		if err := row.Scan(&item.RunID, // 'run_id', 'RunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Phase,    // 'phase', 'Phase', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Status,   // 'status', 'Status', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.AgentID,  // 'agent_id', 'AgentID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Signaled, // 'signaled', 'Signaled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Phase,     // 'phase', 'Phase', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Status,    // 'status', 'Status', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AgentID,   // 'agent_id', 'AgentID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Signaled,  // 'signaled', 'Signaled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.StartedAt, // 'started_at', 'StartedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -37,6 +37,190 @@ func NewQuerierWithTracing(base Querier, instance string, spanDecorator ...func(
 	return d
 }
 
+// ClearConfigurationVersionConfig implements Querier
+func (_d QuerierWithTracing) ClearConfigurationVersionConfig(ctx context.Context, configurationVersionID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.ClearConfigurationVersionConfig")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":                    ctx,
+				"configurationVersionID": configurationVersionID}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.ClearConfigurationVersionConfig(ctx, configurationVersionID)
+}
+
+// ClearLogChunk implements Querier
+func (_d QuerierWithTracing) ClearLogChunk(ctx context.Context, chunkID pgtype.Int4) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.ClearLogChunk")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":     ctx,
+				"chunkID": chunkID}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.ClearLogChunk(ctx, chunkID)
+}
+
+// ClearModuleTarball implements Querier
+func (_d QuerierWithTracing) ClearModuleTarball(ctx context.Context, moduleVersionID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.ClearModuleTarball")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":             ctx,
+				"moduleVersionID": moduleVersionID}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.ClearModuleTarball(ctx, moduleVersionID)
+}
+
+// ClearPlanFiles implements Querier
+func (_d QuerierWithTracing) ClearPlanFiles(ctx context.Context, runID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.ClearPlanFiles")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":   ctx,
+				"runID": runID}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.ClearPlanFiles(ctx, runID)
+}
+
+// ClearProviderMirrorArchive implements Querier
+func (_d QuerierWithTracing) ClearProviderMirrorArchive(ctx context.Context, params ClearProviderMirrorArchiveParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.ClearProviderMirrorArchive")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.ClearProviderMirrorArchive(ctx, params)
+}
+
+// ClearRegistryProviderBinary implements Querier
+func (_d QuerierWithTracing) ClearRegistryProviderBinary(ctx context.Context, registryProviderPlatformID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.ClearRegistryProviderBinary")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":                        ctx,
+				"registryProviderPlatformID": registryProviderPlatformID}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.ClearRegistryProviderBinary(ctx, registryProviderPlatformID)
+}
+
+// ClearStateVersionState implements Querier
+func (_d QuerierWithTracing) ClearStateVersionState(ctx context.Context, stateVersionID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.ClearStateVersionState")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":            ctx,
+				"stateVersionID": stateVersionID}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.ClearStateVersionState(ctx, stateVersionID)
+}
+
+// CountAuditEvents implements Querier
+func (_d QuerierWithTracing) CountAuditEvents(ctx context.Context, params CountAuditEventsParams) (i1 pgtype.Int8, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.CountAuditEvents")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"i1":  i1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.CountAuditEvents(ctx, params)
+}
+
 // CountConfigurationVersionsByWorkspaceID implements Querier
 func (_d QuerierWithTracing) CountConfigurationVersionsByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (i1 pgtype.Int8, err error) {
 	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.CountConfigurationVersionsByWorkspaceID")
@@ -83,6 +267,30 @@ func (_d QuerierWithTracing) CountOrganizations(ctx context.Context, names []str
 	return _d.Querier.CountOrganizations(ctx, names)
 }
 
+// CountProjects implements Querier
+func (_d QuerierWithTracing) CountProjects(ctx context.Context, organizationName pgtype.Text, search pgtype.Text) (i1 pgtype.Int8, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.CountProjects")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":              ctx,
+				"organizationName": organizationName,
+				"search":           search}, map[string]interface{}{
+				"i1":  i1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.CountProjects(ctx, organizationName, search)
+}
+
 // CountRuns implements Querier
 func (_d QuerierWithTracing) CountRuns(ctx context.Context, params CountRunsParams) (i1 pgtype.Int8, err error) {
 	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.CountRuns")
@@ -315,6 +523,52 @@ func (_d QuerierWithTracing) DeleteAgentTokenByID(ctx context.Context, agentToke
 	return _d.Querier.DeleteAgentTokenByID(ctx, agentTokenID)
 }
 
+// DeleteAuditEventsBefore implements Querier
+func (_d QuerierWithTracing) DeleteAuditEventsBefore(ctx context.Context, before pgtype.Timestamptz) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteAuditEventsBefore")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"before": before}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.DeleteAuditEventsBefore(ctx, before)
+}
+
+// DeleteBlobDeletions implements Querier
+func (_d QuerierWithTracing) DeleteBlobDeletions(ctx context.Context, keys []string) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteBlobDeletions")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":  ctx,
+				"keys": keys}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.DeleteBlobDeletions(ctx, keys)
+}
+
 // DeleteConfigurationVersionByID implements Querier
 func (_d QuerierWithTracing) DeleteConfigurationVersionByID(ctx context.Context, id pgtype.Text) (t1 pgtype.Text, err error) {
 	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteConfigurationVersionByID")
@@ -523,15 +777,15 @@ func (_d QuerierWithTracing) DeleteOrganizationByName(ctx context.Context, name
 	return _d.Querier.DeleteOrganizationByName(ctx, name)
 }
 
-// DeleteRepohookByID implements Querier
-func (_d QuerierWithTracing) DeleteRepohookByID(ctx context.Context, repohookID pgtype.UUID) (d1 DeleteRepohookByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteRepohookByID")
+// DeletePolicy implements Querier
+func (_d QuerierWithTracing) DeletePolicy(ctx context.Context, policyID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeletePolicy")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":        ctx,
-				"repohookID": repohookID}, map[string]interface{}{
-				"d1":  d1,
+				"ctx":      ctx,
+				"policyID": policyID}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -543,17 +797,17 @@ func (_d QuerierWithTracing) DeleteRepohookByID(ctx context.Context, repohookID
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteRepohookByID(ctx, repohookID)
+	return _d.Querier.DeletePolicy(ctx, policyID)
 }
 
-// DeleteRunByID implements Querier
-func (_d QuerierWithTracing) DeleteRunByID(ctx context.Context, runID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteRunByID")
+// DeletePolicySet implements Querier
+func (_d QuerierWithTracing) DeletePolicySet(ctx context.Context, policySetID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeletePolicySet")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":   ctx,
-				"runID": runID}, map[string]interface{}{
+				"ctx":         ctx,
+				"policySetID": policySetID}, map[string]interface{}{
 				"t1":  t1,
 				"err": err})
 		} else if err != nil {
@@ -566,17 +820,17 @@ func (_d QuerierWithTracing) DeleteRunByID(ctx context.Context, runID pgtype.Tex
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteRunByID(ctx, runID)
+	return _d.Querier.DeletePolicySet(ctx, policySetID)
 }
 
-// DeleteStateVersionByID implements Querier
-func (_d QuerierWithTracing) DeleteStateVersionByID(ctx context.Context, stateVersionID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteStateVersionByID")
+// DeleteProject implements Querier
+func (_d QuerierWithTracing) DeleteProject(ctx context.Context, projectID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteProject")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":            ctx,
-				"stateVersionID": stateVersionID}, map[string]interface{}{
+				"ctx":       ctx,
+				"projectID": projectID}, map[string]interface{}{
 				"t1":  t1,
 				"err": err})
 		} else if err != nil {
@@ -589,19 +843,19 @@ func (_d QuerierWithTracing) DeleteStateVersionByID(ctx context.Context, stateVe
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteStateVersionByID(ctx, stateVersionID)
+	return _d.Querier.DeleteProject(ctx, projectID)
 }
 
-// DeleteTag implements Querier
-func (_d QuerierWithTracing) DeleteTag(ctx context.Context, tagID pgtype.Text, organizationName pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteTag")
+// DeleteProjectPermission implements Querier
+func (_d QuerierWithTracing) DeleteProjectPermission(ctx context.Context, projectID pgtype.Text, teamID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteProjectPermission")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":              ctx,
-				"tagID":            tagID,
-				"organizationName": organizationName}, map[string]interface{}{
-				"t1":  t1,
+				"ctx":       ctx,
+				"projectID": projectID,
+				"teamID":    teamID}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -613,18 +867,18 @@ func (_d QuerierWithTracing) DeleteTag(ctx context.Context, tagID pgtype.Text, o
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteTag(ctx, tagID, organizationName)
+	return _d.Querier.DeleteProjectPermission(ctx, projectID, teamID)
 }
 
-// DeleteTeamByID implements Querier
-func (_d QuerierWithTracing) DeleteTeamByID(ctx context.Context, teamID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteTeamByID")
+// DeleteProviderMirrorVersionsBefore implements Querier
+func (_d QuerierWithTracing) DeleteProviderMirrorVersionsBefore(ctx context.Context, before pgtype.Timestamptz) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteProviderMirrorVersionsBefore")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
-				"teamID": teamID}, map[string]interface{}{
-				"t1":  t1,
+				"before": before}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -636,19 +890,18 @@ func (_d QuerierWithTracing) DeleteTeamByID(ctx context.Context, teamID pgtype.T
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteTeamByID(ctx, teamID)
+	return _d.Querier.DeleteProviderMirrorVersionsBefore(ctx, before)
 }
 
-// DeleteTeamMembership implements Querier
-func (_d QuerierWithTracing) DeleteTeamMembership(ctx context.Context, usernames []string, teamID pgtype.Text) (ta1 []pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteTeamMembership")
+// DeleteRegistryProvider implements Querier
+func (_d QuerierWithTracing) DeleteRegistryProvider(ctx context.Context, registryProviderID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteRegistryProvider")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":       ctx,
-				"usernames": usernames,
-				"teamID":    teamID}, map[string]interface{}{
-				"ta1": ta1,
+				"ctx":                ctx,
+				"registryProviderID": registryProviderID}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -660,18 +913,18 @@ func (_d QuerierWithTracing) DeleteTeamMembership(ctx context.Context, usernames
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteTeamMembership(ctx, usernames, teamID)
+	return _d.Querier.DeleteRegistryProvider(ctx, registryProviderID)
 }
 
-// DeleteTeamTokenByID implements Querier
-func (_d QuerierWithTracing) DeleteTeamTokenByID(ctx context.Context, teamID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteTeamTokenByID")
+// DeleteRegistryProviderPlatform implements Querier
+func (_d QuerierWithTracing) DeleteRegistryProviderPlatform(ctx context.Context, registryProviderPlatformID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteRegistryProviderPlatform")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"teamID": teamID}, map[string]interface{}{
-				"t1":  t1,
+				"ctx":                        ctx,
+				"registryProviderPlatformID": registryProviderPlatformID}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -683,18 +936,18 @@ func (_d QuerierWithTracing) DeleteTeamTokenByID(ctx context.Context, teamID pgt
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteTeamTokenByID(ctx, teamID)
+	return _d.Querier.DeleteRegistryProviderPlatform(ctx, registryProviderPlatformID)
 }
 
-// DeleteTokenByID implements Querier
-func (_d QuerierWithTracing) DeleteTokenByID(ctx context.Context, tokenID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteTokenByID")
+// DeleteRegistryProviderVersion implements Querier
+func (_d QuerierWithTracing) DeleteRegistryProviderVersion(ctx context.Context, registryProviderVersionID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteRegistryProviderVersion")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":     ctx,
-				"tokenID": tokenID}, map[string]interface{}{
-				"t1":  t1,
+				"ctx":                       ctx,
+				"registryProviderVersionID": registryProviderVersionID}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -706,18 +959,18 @@ func (_d QuerierWithTracing) DeleteTokenByID(ctx context.Context, tokenID pgtype
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteTokenByID(ctx, tokenID)
+	return _d.Querier.DeleteRegistryProviderVersion(ctx, registryProviderVersionID)
 }
 
-// DeleteUserByID implements Querier
-func (_d QuerierWithTracing) DeleteUserByID(ctx context.Context, userID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteUserByID")
+// DeleteRepohookByID implements Querier
+func (_d QuerierWithTracing) DeleteRepohookByID(ctx context.Context, repohookID pgtype.UUID) (d1 DeleteRepohookByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteRepohookByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"userID": userID}, map[string]interface{}{
-				"t1":  t1,
+				"ctx":        ctx,
+				"repohookID": repohookID}, map[string]interface{}{
+				"d1":  d1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -729,17 +982,17 @@ func (_d QuerierWithTracing) DeleteUserByID(ctx context.Context, userID pgtype.T
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteUserByID(ctx, userID)
+	return _d.Querier.DeleteRepohookByID(ctx, repohookID)
 }
 
-// DeleteUserByUsername implements Querier
-func (_d QuerierWithTracing) DeleteUserByUsername(ctx context.Context, username pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteUserByUsername")
+// DeleteRunByID implements Querier
+func (_d QuerierWithTracing) DeleteRunByID(ctx context.Context, runID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteRunByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":      ctx,
-				"username": username}, map[string]interface{}{
+				"ctx":   ctx,
+				"runID": runID}, map[string]interface{}{
 				"t1":  t1,
 				"err": err})
 		} else if err != nil {
@@ -752,17 +1005,17 @@ func (_d QuerierWithTracing) DeleteUserByUsername(ctx context.Context, username
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteUserByUsername(ctx, username)
+	return _d.Querier.DeleteRunByID(ctx, runID)
 }
 
-// DeleteVCSProviderByID implements Querier
-func (_d QuerierWithTracing) DeleteVCSProviderByID(ctx context.Context, vcsProviderID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteVCSProviderByID")
+// DeleteRunTrigger implements Querier
+func (_d QuerierWithTracing) DeleteRunTrigger(ctx context.Context, runTriggerID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteRunTrigger")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":           ctx,
-				"vcsProviderID": vcsProviderID}, map[string]interface{}{
+				"ctx":          ctx,
+				"runTriggerID": runTriggerID}, map[string]interface{}{
 				"t1":  t1,
 				"err": err})
 		} else if err != nil {
@@ -775,18 +1028,18 @@ func (_d QuerierWithTracing) DeleteVCSProviderByID(ctx context.Context, vcsProvi
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteVCSProviderByID(ctx, vcsProviderID)
+	return _d.Querier.DeleteRunTrigger(ctx, runTriggerID)
 }
 
-// DeleteVariableByID implements Querier
-func (_d QuerierWithTracing) DeleteVariableByID(ctx context.Context, variableID pgtype.Text) (d1 DeleteVariableByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteVariableByID")
+// DeleteSchedule implements Querier
+func (_d QuerierWithTracing) DeleteSchedule(ctx context.Context, scheduleID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteSchedule")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":        ctx,
-				"variableID": variableID}, map[string]interface{}{
-				"d1":  d1,
+				"scheduleID": scheduleID}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -798,18 +1051,18 @@ func (_d QuerierWithTracing) DeleteVariableByID(ctx context.Context, variableID
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteVariableByID(ctx, variableID)
+	return _d.Querier.DeleteSchedule(ctx, scheduleID)
 }
 
-// DeleteVariableSetByID implements Querier
-func (_d QuerierWithTracing) DeleteVariableSetByID(ctx context.Context, variableSetID pgtype.Text) (d1 DeleteVariableSetByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteVariableSetByID")
+// DeleteStaleOrganizationTokens implements Querier
+func (_d QuerierWithTracing) DeleteStaleOrganizationTokens(ctx context.Context, cutoff pgtype.Timestamptz) (fa1 []DeleteStaleOrganizationTokensRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteStaleOrganizationTokens")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":           ctx,
-				"variableSetID": variableSetID}, map[string]interface{}{
-				"d1":  d1,
+				"ctx":    ctx,
+				"cutoff": cutoff}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -821,19 +1074,18 @@ func (_d QuerierWithTracing) DeleteVariableSetByID(ctx context.Context, variable
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteVariableSetByID(ctx, variableSetID)
+	return _d.Querier.DeleteStaleOrganizationTokens(ctx, cutoff)
 }
 
-// DeleteVariableSetVariable implements Querier
-func (_d QuerierWithTracing) DeleteVariableSetVariable(ctx context.Context, variableSetID pgtype.Text, variableID pgtype.Text) (d1 DeleteVariableSetVariableRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteVariableSetVariable")
+// DeleteStaleTeamTokens implements Querier
+func (_d QuerierWithTracing) DeleteStaleTeamTokens(ctx context.Context, cutoff pgtype.Timestamptz) (fa1 []DeleteStaleTeamTokensRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteStaleTeamTokens")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":           ctx,
-				"variableSetID": variableSetID,
-				"variableID":    variableID}, map[string]interface{}{
-				"d1":  d1,
+				"ctx":    ctx,
+				"cutoff": cutoff}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -845,19 +1097,18 @@ func (_d QuerierWithTracing) DeleteVariableSetVariable(ctx context.Context, vari
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteVariableSetVariable(ctx, variableSetID, variableID)
+	return _d.Querier.DeleteStaleTeamTokens(ctx, cutoff)
 }
 
-// DeleteVariableSetWorkspace implements Querier
-func (_d QuerierWithTracing) DeleteVariableSetWorkspace(ctx context.Context, variableSetID pgtype.Text, workspaceID pgtype.Text) (d1 DeleteVariableSetWorkspaceRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteVariableSetWorkspace")
+// DeleteStaleTokens implements Querier
+func (_d QuerierWithTracing) DeleteStaleTokens(ctx context.Context, cutoff pgtype.Timestamptz) (fa1 []DeleteStaleTokensRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteStaleTokens")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":           ctx,
-				"variableSetID": variableSetID,
-				"workspaceID":   workspaceID}, map[string]interface{}{
-				"d1":  d1,
+				"ctx":    ctx,
+				"cutoff": cutoff}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -869,18 +1120,18 @@ func (_d QuerierWithTracing) DeleteVariableSetWorkspace(ctx context.Context, var
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteVariableSetWorkspace(ctx, variableSetID, workspaceID)
+	return _d.Querier.DeleteStaleTokens(ctx, cutoff)
 }
 
-// DeleteVariableSetWorkspaces implements Querier
-func (_d QuerierWithTracing) DeleteVariableSetWorkspaces(ctx context.Context, variableSetID pgtype.Text) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteVariableSetWorkspaces")
+// DeleteStateVersionByID implements Querier
+func (_d QuerierWithTracing) DeleteStateVersionByID(ctx context.Context, stateVersionID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteStateVersionByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":           ctx,
-				"variableSetID": variableSetID}, map[string]interface{}{
-				"c2":  c2,
+				"ctx":            ctx,
+				"stateVersionID": stateVersionID}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -892,18 +1143,19 @@ func (_d QuerierWithTracing) DeleteVariableSetWorkspaces(ctx context.Context, va
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteVariableSetWorkspaces(ctx, variableSetID)
+	return _d.Querier.DeleteStateVersionByID(ctx, stateVersionID)
 }
 
-// DeleteWorkspaceByID implements Querier
-func (_d QuerierWithTracing) DeleteWorkspaceByID(ctx context.Context, workspaceID pgtype.Text) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteWorkspaceByID")
+// DeleteTag implements Querier
+func (_d QuerierWithTracing) DeleteTag(ctx context.Context, tagID pgtype.Text, organizationName pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteTag")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"workspaceID": workspaceID}, map[string]interface{}{
-				"c2":  c2,
+				"ctx":              ctx,
+				"tagID":            tagID,
+				"organizationName": organizationName}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -915,18 +1167,18 @@ func (_d QuerierWithTracing) DeleteWorkspaceByID(ctx context.Context, workspaceI
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteWorkspaceByID(ctx, workspaceID)
+	return _d.Querier.DeleteTag(ctx, tagID, organizationName)
 }
 
-// DeleteWorkspaceConnectionByID implements Querier
-func (_d QuerierWithTracing) DeleteWorkspaceConnectionByID(ctx context.Context, workspaceID pgtype.Text) (d1 DeleteWorkspaceConnectionByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteWorkspaceConnectionByID")
+// DeleteTeamByID implements Querier
+func (_d QuerierWithTracing) DeleteTeamByID(ctx context.Context, teamID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteTeamByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"workspaceID": workspaceID}, map[string]interface{}{
-				"d1":  d1,
+				"ctx":    ctx,
+				"teamID": teamID}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -938,19 +1190,19 @@ func (_d QuerierWithTracing) DeleteWorkspaceConnectionByID(ctx context.Context,
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteWorkspaceConnectionByID(ctx, workspaceID)
+	return _d.Querier.DeleteTeamByID(ctx, teamID)
 }
 
-// DeleteWorkspacePermissionByID implements Querier
-func (_d QuerierWithTracing) DeleteWorkspacePermissionByID(ctx context.Context, workspaceID pgtype.Text, teamID pgtype.Text) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteWorkspacePermissionByID")
+// DeleteTeamMembership implements Querier
+func (_d QuerierWithTracing) DeleteTeamMembership(ctx context.Context, usernames []string, teamID pgtype.Text) (ta1 []pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteTeamMembership")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"workspaceID": workspaceID,
-				"teamID":      teamID}, map[string]interface{}{
-				"c2":  c2,
+				"ctx":       ctx,
+				"usernames": usernames,
+				"teamID":    teamID}, map[string]interface{}{
+				"ta1": ta1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -962,18 +1214,17 @@ func (_d QuerierWithTracing) DeleteWorkspacePermissionByID(ctx context.Context,
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteWorkspacePermissionByID(ctx, workspaceID, teamID)
+	return _d.Querier.DeleteTeamMembership(ctx, usernames, teamID)
 }
 
-// DeleteWorkspaceTag implements Querier
-func (_d QuerierWithTracing) DeleteWorkspaceTag(ctx context.Context, workspaceID pgtype.Text, tagID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteWorkspaceTag")
+// DeleteTeamTokenByID implements Querier
+func (_d QuerierWithTracing) DeleteTeamTokenByID(ctx context.Context, teamID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteTeamTokenByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"workspaceID": workspaceID,
-				"tagID":       tagID}, map[string]interface{}{
+				"ctx":    ctx,
+				"teamID": teamID}, map[string]interface{}{
 				"t1":  t1,
 				"err": err})
 		} else if err != nil {
@@ -986,18 +1237,18 @@ func (_d QuerierWithTracing) DeleteWorkspaceTag(ctx context.Context, workspaceID
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteWorkspaceTag(ctx, workspaceID, tagID)
+	return _d.Querier.DeleteTeamTokenByID(ctx, teamID)
 }
 
-// DeleteWorkspaceVariableByID implements Querier
-func (_d QuerierWithTracing) DeleteWorkspaceVariableByID(ctx context.Context, variableID pgtype.Text) (d1 DeleteWorkspaceVariableByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteWorkspaceVariableByID")
+// DeleteTokenByID implements Querier
+func (_d QuerierWithTracing) DeleteTokenByID(ctx context.Context, tokenID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteTokenByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":        ctx,
-				"variableID": variableID}, map[string]interface{}{
-				"d1":  d1,
+				"ctx":     ctx,
+				"tokenID": tokenID}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1009,17 +1260,17 @@ func (_d QuerierWithTracing) DeleteWorkspaceVariableByID(ctx context.Context, va
 
 		_span.End()
 	}()
-	return _d.Querier.DeleteWorkspaceVariableByID(ctx, variableID)
+	return _d.Querier.DeleteTokenByID(ctx, tokenID)
 }
 
-// DiscardPendingStateVersionsByWorkspaceID implements Querier
-func (_d QuerierWithTracing) DiscardPendingStateVersionsByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DiscardPendingStateVersionsByWorkspaceID")
+// DeleteTokensByUsername implements Querier
+func (_d QuerierWithTracing) DeleteTokensByUsername(ctx context.Context, username pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteTokensByUsername")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"workspaceID": workspaceID}, map[string]interface{}{
+				"ctx":      ctx,
+				"username": username}, map[string]interface{}{
 				"c2":  c2,
 				"err": err})
 		} else if err != nil {
@@ -1032,18 +1283,18 @@ func (_d QuerierWithTracing) DiscardPendingStateVersionsByWorkspaceID(ctx contex
 
 		_span.End()
 	}()
-	return _d.Querier.DiscardPendingStateVersionsByWorkspaceID(ctx, workspaceID)
+	return _d.Querier.DeleteTokensByUsername(ctx, username)
 }
 
-// DownloadConfigurationVersion implements Querier
-func (_d QuerierWithTracing) DownloadConfigurationVersion(ctx context.Context, configurationVersionID pgtype.Text) (ba1 []byte, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DownloadConfigurationVersion")
+// DeleteUserByID implements Querier
+func (_d QuerierWithTracing) DeleteUserByID(ctx context.Context, userID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteUserByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":                    ctx,
-				"configurationVersionID": configurationVersionID}, map[string]interface{}{
-				"ba1": ba1,
+				"ctx":    ctx,
+				"userID": userID}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1055,18 +1306,18 @@ func (_d QuerierWithTracing) DownloadConfigurationVersion(ctx context.Context, c
 
 		_span.End()
 	}()
-	return _d.Querier.DownloadConfigurationVersion(ctx, configurationVersionID)
+	return _d.Querier.DeleteUserByID(ctx, userID)
 }
 
-// FindAgentByID implements Querier
-func (_d QuerierWithTracing) FindAgentByID(ctx context.Context, agentID pgtype.Text) (f1 FindAgentByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentByID")
+// DeleteUserByUsername implements Querier
+func (_d QuerierWithTracing) DeleteUserByUsername(ctx context.Context, username pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteUserByUsername")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":     ctx,
-				"agentID": agentID}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":      ctx,
+				"username": username}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1078,18 +1329,18 @@ func (_d QuerierWithTracing) FindAgentByID(ctx context.Context, agentID pgtype.T
 
 		_span.End()
 	}()
-	return _d.Querier.FindAgentByID(ctx, agentID)
+	return _d.Querier.DeleteUserByUsername(ctx, username)
 }
 
-// FindAgentByIDForUpdate implements Querier
-func (_d QuerierWithTracing) FindAgentByIDForUpdate(ctx context.Context, agentID pgtype.Text) (f1 FindAgentByIDForUpdateRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentByIDForUpdate")
+// DeleteVCSProviderByID implements Querier
+func (_d QuerierWithTracing) DeleteVCSProviderByID(ctx context.Context, vcsProviderID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteVCSProviderByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":     ctx,
-				"agentID": agentID}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":           ctx,
+				"vcsProviderID": vcsProviderID}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1101,18 +1352,18 @@ func (_d QuerierWithTracing) FindAgentByIDForUpdate(ctx context.Context, agentID
 
 		_span.End()
 	}()
-	return _d.Querier.FindAgentByIDForUpdate(ctx, agentID)
+	return _d.Querier.DeleteVCSProviderByID(ctx, vcsProviderID)
 }
 
-// FindAgentPool implements Querier
-func (_d QuerierWithTracing) FindAgentPool(ctx context.Context, poolID pgtype.Text) (f1 FindAgentPoolRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentPool")
+// DeleteVariableByID implements Querier
+func (_d QuerierWithTracing) DeleteVariableByID(ctx context.Context, variableID pgtype.Text) (d1 DeleteVariableByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteVariableByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"poolID": poolID}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":        ctx,
+				"variableID": variableID}, map[string]interface{}{
+				"d1":  d1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1124,18 +1375,18 @@ func (_d QuerierWithTracing) FindAgentPool(ctx context.Context, poolID pgtype.Te
 
 		_span.End()
 	}()
-	return _d.Querier.FindAgentPool(ctx, poolID)
+	return _d.Querier.DeleteVariableByID(ctx, variableID)
 }
 
-// FindAgentPoolByAgentTokenID implements Querier
-func (_d QuerierWithTracing) FindAgentPoolByAgentTokenID(ctx context.Context, agentTokenID pgtype.Text) (f1 FindAgentPoolByAgentTokenIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentPoolByAgentTokenID")
+// DeleteVariableSetByID implements Querier
+func (_d QuerierWithTracing) DeleteVariableSetByID(ctx context.Context, variableSetID pgtype.Text) (d1 DeleteVariableSetByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteVariableSetByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":          ctx,
-				"agentTokenID": agentTokenID}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":           ctx,
+				"variableSetID": variableSetID}, map[string]interface{}{
+				"d1":  d1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1147,17 +1398,19 @@ func (_d QuerierWithTracing) FindAgentPoolByAgentTokenID(ctx context.Context, ag
 
 		_span.End()
 	}()
-	return _d.Querier.FindAgentPoolByAgentTokenID(ctx, agentTokenID)
+	return _d.Querier.DeleteVariableSetByID(ctx, variableSetID)
 }
 
-// FindAgentPools implements Querier
-func (_d QuerierWithTracing) FindAgentPools(ctx context.Context) (fa1 []FindAgentPoolsRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentPools")
+// DeleteVariableSetVariable implements Querier
+func (_d QuerierWithTracing) DeleteVariableSetVariable(ctx context.Context, variableSetID pgtype.Text, variableID pgtype.Text) (d1 DeleteVariableSetVariableRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteVariableSetVariable")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":           ctx,
+				"variableSetID": variableSetID,
+				"variableID":    variableID}, map[string]interface{}{
+				"d1":  d1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1169,18 +1422,19 @@ func (_d QuerierWithTracing) FindAgentPools(ctx context.Context) (fa1 []FindAgen
 
 		_span.End()
 	}()
-	return _d.Querier.FindAgentPools(ctx)
+	return _d.Querier.DeleteVariableSetVariable(ctx, variableSetID, variableID)
 }
 
-// FindAgentPoolsByOrganization implements Querier
-func (_d QuerierWithTracing) FindAgentPoolsByOrganization(ctx context.Context, params FindAgentPoolsByOrganizationParams) (fa1 []FindAgentPoolsByOrganizationRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentPoolsByOrganization")
+// DeleteVariableSetWorkspace implements Querier
+func (_d QuerierWithTracing) DeleteVariableSetWorkspace(ctx context.Context, variableSetID pgtype.Text, workspaceID pgtype.Text) (d1 DeleteVariableSetWorkspaceRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteVariableSetWorkspace")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":           ctx,
+				"variableSetID": variableSetID,
+				"workspaceID":   workspaceID}, map[string]interface{}{
+				"d1":  d1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1192,18 +1446,18 @@ func (_d QuerierWithTracing) FindAgentPoolsByOrganization(ctx context.Context, p
 
 		_span.End()
 	}()
-	return _d.Querier.FindAgentPoolsByOrganization(ctx, params)
+	return _d.Querier.DeleteVariableSetWorkspace(ctx, variableSetID, workspaceID)
 }
 
-// FindAgentTokenByID implements Querier
-func (_d QuerierWithTracing) FindAgentTokenByID(ctx context.Context, agentTokenID pgtype.Text) (f1 FindAgentTokenByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentTokenByID")
+// DeleteVariableSetWorkspaces implements Querier
+func (_d QuerierWithTracing) DeleteVariableSetWorkspaces(ctx context.Context, variableSetID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteVariableSetWorkspaces")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":          ctx,
-				"agentTokenID": agentTokenID}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":           ctx,
+				"variableSetID": variableSetID}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1215,18 +1469,18 @@ func (_d QuerierWithTracing) FindAgentTokenByID(ctx context.Context, agentTokenI
 
 		_span.End()
 	}()
-	return _d.Querier.FindAgentTokenByID(ctx, agentTokenID)
+	return _d.Querier.DeleteVariableSetWorkspaces(ctx, variableSetID)
 }
 
-// FindAgentTokensByAgentPoolID implements Querier
-func (_d QuerierWithTracing) FindAgentTokensByAgentPoolID(ctx context.Context, agentPoolID pgtype.Text) (fa1 []FindAgentTokensByAgentPoolIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentTokensByAgentPoolID")
+// DeleteWorkspaceByID implements Querier
+func (_d QuerierWithTracing) DeleteWorkspaceByID(ctx context.Context, workspaceID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteWorkspaceByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":         ctx,
-				"agentPoolID": agentPoolID}, map[string]interface{}{
-				"fa1": fa1,
+				"workspaceID": workspaceID}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1238,17 +1492,18 @@ func (_d QuerierWithTracing) FindAgentTokensByAgentPoolID(ctx context.Context, a
 
 		_span.End()
 	}()
-	return _d.Querier.FindAgentTokensByAgentPoolID(ctx, agentPoolID)
+	return _d.Querier.DeleteWorkspaceByID(ctx, workspaceID)
 }
 
-// FindAgents implements Querier
-func (_d QuerierWithTracing) FindAgents(ctx context.Context) (fa1 []FindAgentsRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgents")
+// DeleteWorkspaceConnectionByID implements Querier
+func (_d QuerierWithTracing) DeleteWorkspaceConnectionByID(ctx context.Context, workspaceID pgtype.Text) (d1 DeleteWorkspaceConnectionByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteWorkspaceConnectionByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":         ctx,
+				"workspaceID": workspaceID}, map[string]interface{}{
+				"d1":  d1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1260,18 +1515,19 @@ func (_d QuerierWithTracing) FindAgents(ctx context.Context) (fa1 []FindAgentsRo
 
 		_span.End()
 	}()
-	return _d.Querier.FindAgents(ctx)
+	return _d.Querier.DeleteWorkspaceConnectionByID(ctx, workspaceID)
 }
 
-// FindAgentsByOrganization implements Querier
-func (_d QuerierWithTracing) FindAgentsByOrganization(ctx context.Context, organizationName pgtype.Text) (fa1 []FindAgentsByOrganizationRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentsByOrganization")
+// DeleteWorkspacePermissionByID implements Querier
+func (_d QuerierWithTracing) DeleteWorkspacePermissionByID(ctx context.Context, workspaceID pgtype.Text, teamID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteWorkspacePermissionByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":              ctx,
-				"organizationName": organizationName}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":         ctx,
+				"workspaceID": workspaceID,
+				"teamID":      teamID}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1283,18 +1539,19 @@ func (_d QuerierWithTracing) FindAgentsByOrganization(ctx context.Context, organ
 
 		_span.End()
 	}()
-	return _d.Querier.FindAgentsByOrganization(ctx, organizationName)
+	return _d.Querier.DeleteWorkspacePermissionByID(ctx, workspaceID, teamID)
 }
 
-// FindAgentsByPoolID implements Querier
-func (_d QuerierWithTracing) FindAgentsByPoolID(ctx context.Context, agentPoolID pgtype.Text) (fa1 []FindAgentsByPoolIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentsByPoolID")
+// DeleteWorkspaceTag implements Querier
+func (_d QuerierWithTracing) DeleteWorkspaceTag(ctx context.Context, workspaceID pgtype.Text, tagID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteWorkspaceTag")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":         ctx,
-				"agentPoolID": agentPoolID}, map[string]interface{}{
-				"fa1": fa1,
+				"workspaceID": workspaceID,
+				"tagID":       tagID}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1306,17 +1563,18 @@ func (_d QuerierWithTracing) FindAgentsByPoolID(ctx context.Context, agentPoolID
 
 		_span.End()
 	}()
-	return _d.Querier.FindAgentsByPoolID(ctx, agentPoolID)
+	return _d.Querier.DeleteWorkspaceTag(ctx, workspaceID, tagID)
 }
 
-// FindAllNotificationConfigurations implements Querier
-func (_d QuerierWithTracing) FindAllNotificationConfigurations(ctx context.Context) (fa1 []FindAllNotificationConfigurationsRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAllNotificationConfigurations")
+// DeleteWorkspaceVariableByID implements Querier
+func (_d QuerierWithTracing) DeleteWorkspaceVariableByID(ctx context.Context, variableID pgtype.Text) (d1 DeleteWorkspaceVariableByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DeleteWorkspaceVariableByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":        ctx,
+				"variableID": variableID}, map[string]interface{}{
+				"d1":  d1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1328,18 +1586,18 @@ func (_d QuerierWithTracing) FindAllNotificationConfigurations(ctx context.Conte
 
 		_span.End()
 	}()
-	return _d.Querier.FindAllNotificationConfigurations(ctx)
+	return _d.Querier.DeleteWorkspaceVariableByID(ctx, variableID)
 }
 
-// FindAllocatedJobs implements Querier
-func (_d QuerierWithTracing) FindAllocatedJobs(ctx context.Context, agentID pgtype.Text) (fa1 []FindAllocatedJobsRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAllocatedJobs")
+// DiscardPendingStateVersionsByWorkspaceID implements Querier
+func (_d QuerierWithTracing) DiscardPendingStateVersionsByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DiscardPendingStateVersionsByWorkspaceID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":     ctx,
-				"agentID": agentID}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":         ctx,
+				"workspaceID": workspaceID}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1351,18 +1609,18 @@ func (_d QuerierWithTracing) FindAllocatedJobs(ctx context.Context, agentID pgty
 
 		_span.End()
 	}()
-	return _d.Querier.FindAllocatedJobs(ctx, agentID)
+	return _d.Querier.DiscardPendingStateVersionsByWorkspaceID(ctx, workspaceID)
 }
 
-// FindAndUpdateSignaledJobs implements Querier
-func (_d QuerierWithTracing) FindAndUpdateSignaledJobs(ctx context.Context, agentID pgtype.Text) (fa1 []FindAndUpdateSignaledJobsRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAndUpdateSignaledJobs")
+// DownloadConfigurationVersion implements Querier
+func (_d QuerierWithTracing) DownloadConfigurationVersion(ctx context.Context, configurationVersionID pgtype.Text) (ba1 []byte, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.DownloadConfigurationVersion")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":     ctx,
-				"agentID": agentID}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":                    ctx,
+				"configurationVersionID": configurationVersionID}, map[string]interface{}{
+				"ba1": ba1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1374,17 +1632,17 @@ func (_d QuerierWithTracing) FindAndUpdateSignaledJobs(ctx context.Context, agen
 
 		_span.End()
 	}()
-	return _d.Querier.FindAndUpdateSignaledJobs(ctx, agentID)
+	return _d.Querier.DownloadConfigurationVersion(ctx, configurationVersionID)
 }
 
-// FindConfigurationVersionByID implements Querier
-func (_d QuerierWithTracing) FindConfigurationVersionByID(ctx context.Context, configurationVersionID pgtype.Text) (f1 FindConfigurationVersionByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindConfigurationVersionByID")
+// FindAgentByID implements Querier
+func (_d QuerierWithTracing) FindAgentByID(ctx context.Context, agentID pgtype.Text) (f1 FindAgentByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":                    ctx,
-				"configurationVersionID": configurationVersionID}, map[string]interface{}{
+				"ctx":     ctx,
+				"agentID": agentID}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -1397,17 +1655,17 @@ func (_d QuerierWithTracing) FindConfigurationVersionByID(ctx context.Context, c
 
 		_span.End()
 	}()
-	return _d.Querier.FindConfigurationVersionByID(ctx, configurationVersionID)
+	return _d.Querier.FindAgentByID(ctx, agentID)
 }
 
-// FindConfigurationVersionByIDForUpdate implements Querier
-func (_d QuerierWithTracing) FindConfigurationVersionByIDForUpdate(ctx context.Context, configurationVersionID pgtype.Text) (f1 FindConfigurationVersionByIDForUpdateRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindConfigurationVersionByIDForUpdate")
+// FindAgentByIDForUpdate implements Querier
+func (_d QuerierWithTracing) FindAgentByIDForUpdate(ctx context.Context, agentID pgtype.Text) (f1 FindAgentByIDForUpdateRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentByIDForUpdate")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":                    ctx,
-				"configurationVersionID": configurationVersionID}, map[string]interface{}{
+				"ctx":     ctx,
+				"agentID": agentID}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -1420,17 +1678,17 @@ func (_d QuerierWithTracing) FindConfigurationVersionByIDForUpdate(ctx context.C
 
 		_span.End()
 	}()
-	return _d.Querier.FindConfigurationVersionByIDForUpdate(ctx, configurationVersionID)
+	return _d.Querier.FindAgentByIDForUpdate(ctx, agentID)
 }
 
-// FindConfigurationVersionLatestByWorkspaceID implements Querier
-func (_d QuerierWithTracing) FindConfigurationVersionLatestByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (f1 FindConfigurationVersionLatestByWorkspaceIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindConfigurationVersionLatestByWorkspaceID")
+// FindAgentPool implements Querier
+func (_d QuerierWithTracing) FindAgentPool(ctx context.Context, poolID pgtype.Text) (f1 FindAgentPoolRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentPool")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"workspaceID": workspaceID}, map[string]interface{}{
+				"ctx":    ctx,
+				"poolID": poolID}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -1443,18 +1701,18 @@ func (_d QuerierWithTracing) FindConfigurationVersionLatestByWorkspaceID(ctx con
 
 		_span.End()
 	}()
-	return _d.Querier.FindConfigurationVersionLatestByWorkspaceID(ctx, workspaceID)
+	return _d.Querier.FindAgentPool(ctx, poolID)
 }
 
-// FindConfigurationVersionsByWorkspaceID implements Querier
-func (_d QuerierWithTracing) FindConfigurationVersionsByWorkspaceID(ctx context.Context, params FindConfigurationVersionsByWorkspaceIDParams) (fa1 []FindConfigurationVersionsByWorkspaceIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindConfigurationVersionsByWorkspaceID")
+// FindAgentPoolByAgentTokenID implements Querier
+func (_d QuerierWithTracing) FindAgentPoolByAgentTokenID(ctx context.Context, agentTokenID pgtype.Text) (f1 FindAgentPoolByAgentTokenIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentPoolByAgentTokenID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":          ctx,
+				"agentTokenID": agentTokenID}, map[string]interface{}{
+				"f1":  f1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1466,18 +1724,17 @@ func (_d QuerierWithTracing) FindConfigurationVersionsByWorkspaceID(ctx context.
 
 		_span.End()
 	}()
-	return _d.Querier.FindConfigurationVersionsByWorkspaceID(ctx, params)
+	return _d.Querier.FindAgentPoolByAgentTokenID(ctx, agentTokenID)
 }
 
-// FindCurrentStateVersionByWorkspaceID implements Querier
-func (_d QuerierWithTracing) FindCurrentStateVersionByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (f1 FindCurrentStateVersionByWorkspaceIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindCurrentStateVersionByWorkspaceID")
+// FindAgentPools implements Querier
+func (_d QuerierWithTracing) FindAgentPools(ctx context.Context) (fa1 []FindAgentPoolsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentPools")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"workspaceID": workspaceID}, map[string]interface{}{
-				"f1":  f1,
+				"ctx": ctx}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1489,17 +1746,18 @@ func (_d QuerierWithTracing) FindCurrentStateVersionByWorkspaceID(ctx context.Co
 
 		_span.End()
 	}()
-	return _d.Querier.FindCurrentStateVersionByWorkspaceID(ctx, workspaceID)
+	return _d.Querier.FindAgentPools(ctx)
 }
 
-// FindGithubApp implements Querier
-func (_d QuerierWithTracing) FindGithubApp(ctx context.Context) (f1 FindGithubAppRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindGithubApp")
+// FindAgentPoolsByOrganization implements Querier
+func (_d QuerierWithTracing) FindAgentPoolsByOrganization(ctx context.Context, params FindAgentPoolsByOrganizationParams) (fa1 []FindAgentPoolsByOrganizationRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentPoolsByOrganization")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1511,18 +1769,17 @@ func (_d QuerierWithTracing) FindGithubApp(ctx context.Context) (f1 FindGithubAp
 
 		_span.End()
 	}()
-	return _d.Querier.FindGithubApp(ctx)
+	return _d.Querier.FindAgentPoolsByOrganization(ctx, params)
 }
 
-// FindJob implements Querier
-func (_d QuerierWithTracing) FindJob(ctx context.Context, runID pgtype.Text, phase pgtype.Text) (f1 FindJobRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindJob")
+// FindAgentTokenByID implements Querier
+func (_d QuerierWithTracing) FindAgentTokenByID(ctx context.Context, agentTokenID pgtype.Text) (f1 FindAgentTokenByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentTokenByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":   ctx,
-				"runID": runID,
-				"phase": phase}, map[string]interface{}{
+				"ctx":          ctx,
+				"agentTokenID": agentTokenID}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -1535,19 +1792,18 @@ func (_d QuerierWithTracing) FindJob(ctx context.Context, runID pgtype.Text, pha
 
 		_span.End()
 	}()
-	return _d.Querier.FindJob(ctx, runID, phase)
+	return _d.Querier.FindAgentTokenByID(ctx, agentTokenID)
 }
 
-// FindJobForUpdate implements Querier
-func (_d QuerierWithTracing) FindJobForUpdate(ctx context.Context, runID pgtype.Text, phase pgtype.Text) (f1 FindJobForUpdateRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindJobForUpdate")
+// FindAgentTokensByAgentPoolID implements Querier
+func (_d QuerierWithTracing) FindAgentTokensByAgentPoolID(ctx context.Context, agentPoolID pgtype.Text) (fa1 []FindAgentTokensByAgentPoolIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentTokensByAgentPoolID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":   ctx,
-				"runID": runID,
-				"phase": phase}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":         ctx,
+				"agentPoolID": agentPoolID}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1559,12 +1815,12 @@ func (_d QuerierWithTracing) FindJobForUpdate(ctx context.Context, runID pgtype.
 
 		_span.End()
 	}()
-	return _d.Querier.FindJobForUpdate(ctx, runID, phase)
+	return _d.Querier.FindAgentTokensByAgentPoolID(ctx, agentPoolID)
 }
 
-// FindJobs implements Querier
-func (_d QuerierWithTracing) FindJobs(ctx context.Context) (fa1 []FindJobsRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindJobs")
+// FindAgents implements Querier
+func (_d QuerierWithTracing) FindAgents(ctx context.Context) (fa1 []FindAgentsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgents")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
@@ -1581,16 +1837,17 @@ func (_d QuerierWithTracing) FindJobs(ctx context.Context) (fa1 []FindJobsRow, e
 
 		_span.End()
 	}()
-	return _d.Querier.FindJobs(ctx)
+	return _d.Querier.FindAgents(ctx)
 }
 
-// FindLatestTerraformVersion implements Querier
-func (_d QuerierWithTracing) FindLatestTerraformVersion(ctx context.Context) (fa1 []FindLatestTerraformVersionRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindLatestTerraformVersion")
+// FindAgentsByOrganization implements Querier
+func (_d QuerierWithTracing) FindAgentsByOrganization(ctx context.Context, organizationName pgtype.Text) (fa1 []FindAgentsByOrganizationRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentsByOrganization")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx}, map[string]interface{}{
+				"ctx":              ctx,
+				"organizationName": organizationName}, map[string]interface{}{
 				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
@@ -1603,18 +1860,18 @@ func (_d QuerierWithTracing) FindLatestTerraformVersion(ctx context.Context) (fa
 
 		_span.End()
 	}()
-	return _d.Querier.FindLatestTerraformVersion(ctx)
+	return _d.Querier.FindAgentsByOrganization(ctx, organizationName)
 }
 
-// FindLogChunkByID implements Querier
-func (_d QuerierWithTracing) FindLogChunkByID(ctx context.Context, chunkID pgtype.Int4) (f1 FindLogChunkByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindLogChunkByID")
+// FindAgentsByPoolID implements Querier
+func (_d QuerierWithTracing) FindAgentsByPoolID(ctx context.Context, agentPoolID pgtype.Text) (fa1 []FindAgentsByPoolIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAgentsByPoolID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":     ctx,
-				"chunkID": chunkID}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":         ctx,
+				"agentPoolID": agentPoolID}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1626,19 +1883,17 @@ func (_d QuerierWithTracing) FindLogChunkByID(ctx context.Context, chunkID pgtyp
 
 		_span.End()
 	}()
-	return _d.Querier.FindLogChunkByID(ctx, chunkID)
+	return _d.Querier.FindAgentsByPoolID(ctx, agentPoolID)
 }
 
-// FindLogs implements Querier
-func (_d QuerierWithTracing) FindLogs(ctx context.Context, runID pgtype.Text, phase pgtype.Text) (ba1 []byte, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindLogs")
+// FindAllNotificationConfigurations implements Querier
+func (_d QuerierWithTracing) FindAllNotificationConfigurations(ctx context.Context) (fa1 []FindAllNotificationConfigurationsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAllNotificationConfigurations")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":   ctx,
-				"runID": runID,
-				"phase": phase}, map[string]interface{}{
-				"ba1": ba1,
+				"ctx": ctx}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1650,19 +1905,18 @@ func (_d QuerierWithTracing) FindLogs(ctx context.Context, runID pgtype.Text, ph
 
 		_span.End()
 	}()
-	return _d.Querier.FindLogs(ctx, runID, phase)
+	return _d.Querier.FindAllNotificationConfigurations(ctx)
 }
 
-// FindModuleByConnection implements Querier
-func (_d QuerierWithTracing) FindModuleByConnection(ctx context.Context, vcsProviderID pgtype.Text, repoPath pgtype.Text) (f1 FindModuleByConnectionRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindModuleByConnection")
+// FindAllocatedJobs implements Querier
+func (_d QuerierWithTracing) FindAllocatedJobs(ctx context.Context, agentID pgtype.Text) (fa1 []FindAllocatedJobsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAllocatedJobs")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":           ctx,
-				"vcsProviderID": vcsProviderID,
-				"repoPath":      repoPath}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":     ctx,
+				"agentID": agentID}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1674,18 +1928,18 @@ func (_d QuerierWithTracing) FindModuleByConnection(ctx context.Context, vcsProv
 
 		_span.End()
 	}()
-	return _d.Querier.FindModuleByConnection(ctx, vcsProviderID, repoPath)
+	return _d.Querier.FindAllocatedJobs(ctx, agentID)
 }
 
-// FindModuleByID implements Querier
-func (_d QuerierWithTracing) FindModuleByID(ctx context.Context, id pgtype.Text) (f1 FindModuleByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindModuleByID")
+// FindAndUpdateSignaledJobs implements Querier
+func (_d QuerierWithTracing) FindAndUpdateSignaledJobs(ctx context.Context, agentID pgtype.Text) (fa1 []FindAndUpdateSignaledJobsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAndUpdateSignaledJobs")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx,
-				"id":  id}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":     ctx,
+				"agentID": agentID}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1697,18 +1951,18 @@ func (_d QuerierWithTracing) FindModuleByID(ctx context.Context, id pgtype.Text)
 
 		_span.End()
 	}()
-	return _d.Querier.FindModuleByID(ctx, id)
+	return _d.Querier.FindAndUpdateSignaledJobs(ctx, agentID)
 }
 
-// FindModuleByModuleVersionID implements Querier
-func (_d QuerierWithTracing) FindModuleByModuleVersionID(ctx context.Context, moduleVersionID pgtype.Text) (f1 FindModuleByModuleVersionIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindModuleByModuleVersionID")
+// FindAuditEvents implements Querier
+func (_d QuerierWithTracing) FindAuditEvents(ctx context.Context, params FindAuditEventsParams) (fa1 []FindAuditEventsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindAuditEvents")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":             ctx,
-				"moduleVersionID": moduleVersionID}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1720,18 +1974,18 @@ func (_d QuerierWithTracing) FindModuleByModuleVersionID(ctx context.Context, mo
 
 		_span.End()
 	}()
-	return _d.Querier.FindModuleByModuleVersionID(ctx, moduleVersionID)
+	return _d.Querier.FindAuditEvents(ctx, params)
 }
 
-// FindModuleByName implements Querier
-func (_d QuerierWithTracing) FindModuleByName(ctx context.Context, params FindModuleByNameParams) (f1 FindModuleByNameRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindModuleByName")
+// FindBlobDeletions implements Querier
+func (_d QuerierWithTracing) FindBlobDeletions(ctx context.Context, limit pgtype.Int8) (ta1 []pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindBlobDeletions")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":   ctx,
+				"limit": limit}, map[string]interface{}{
+				"ta1": ta1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1743,18 +1997,18 @@ func (_d QuerierWithTracing) FindModuleByName(ctx context.Context, params FindMo
 
 		_span.End()
 	}()
-	return _d.Querier.FindModuleByName(ctx, params)
+	return _d.Querier.FindBlobDeletions(ctx, limit)
 }
 
-// FindModuleTarball implements Querier
-func (_d QuerierWithTracing) FindModuleTarball(ctx context.Context, moduleVersionID pgtype.Text) (ba1 []byte, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindModuleTarball")
+// FindConfigurationVersionByID implements Querier
+func (_d QuerierWithTracing) FindConfigurationVersionByID(ctx context.Context, configurationVersionID pgtype.Text) (f1 FindConfigurationVersionByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindConfigurationVersionByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":             ctx,
-				"moduleVersionID": moduleVersionID}, map[string]interface{}{
-				"ba1": ba1,
+				"ctx":                    ctx,
+				"configurationVersionID": configurationVersionID}, map[string]interface{}{
+				"f1":  f1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1766,17 +2020,17 @@ func (_d QuerierWithTracing) FindModuleTarball(ctx context.Context, moduleVersio
 
 		_span.End()
 	}()
-	return _d.Querier.FindModuleTarball(ctx, moduleVersionID)
+	return _d.Querier.FindConfigurationVersionByID(ctx, configurationVersionID)
 }
 
-// FindNotificationConfiguration implements Querier
-func (_d QuerierWithTracing) FindNotificationConfiguration(ctx context.Context, notificationConfigurationID pgtype.Text) (f1 FindNotificationConfigurationRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindNotificationConfiguration")
+// FindConfigurationVersionByIDForUpdate implements Querier
+func (_d QuerierWithTracing) FindConfigurationVersionByIDForUpdate(ctx context.Context, configurationVersionID pgtype.Text) (f1 FindConfigurationVersionByIDForUpdateRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindConfigurationVersionByIDForUpdate")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":                         ctx,
-				"notificationConfigurationID": notificationConfigurationID}, map[string]interface{}{
+				"ctx":                    ctx,
+				"configurationVersionID": configurationVersionID}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -1789,17 +2043,17 @@ func (_d QuerierWithTracing) FindNotificationConfiguration(ctx context.Context,
 
 		_span.End()
 	}()
-	return _d.Querier.FindNotificationConfiguration(ctx, notificationConfigurationID)
+	return _d.Querier.FindConfigurationVersionByIDForUpdate(ctx, configurationVersionID)
 }
 
-// FindNotificationConfigurationForUpdate implements Querier
-func (_d QuerierWithTracing) FindNotificationConfigurationForUpdate(ctx context.Context, notificationConfigurationID pgtype.Text) (f1 FindNotificationConfigurationForUpdateRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindNotificationConfigurationForUpdate")
+// FindConfigurationVersionLatestByWorkspaceID implements Querier
+func (_d QuerierWithTracing) FindConfigurationVersionLatestByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (f1 FindConfigurationVersionLatestByWorkspaceIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindConfigurationVersionLatestByWorkspaceID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":                         ctx,
-				"notificationConfigurationID": notificationConfigurationID}, map[string]interface{}{
+				"ctx":         ctx,
+				"workspaceID": workspaceID}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -1812,17 +2066,17 @@ func (_d QuerierWithTracing) FindNotificationConfigurationForUpdate(ctx context.
 
 		_span.End()
 	}()
-	return _d.Querier.FindNotificationConfigurationForUpdate(ctx, notificationConfigurationID)
+	return _d.Querier.FindConfigurationVersionLatestByWorkspaceID(ctx, workspaceID)
 }
 
-// FindNotificationConfigurationsByWorkspaceID implements Querier
-func (_d QuerierWithTracing) FindNotificationConfigurationsByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (fa1 []FindNotificationConfigurationsByWorkspaceIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindNotificationConfigurationsByWorkspaceID")
+// FindConfigurationVersionsByWorkspaceID implements Querier
+func (_d QuerierWithTracing) FindConfigurationVersionsByWorkspaceID(ctx context.Context, params FindConfigurationVersionsByWorkspaceIDParams) (fa1 []FindConfigurationVersionsByWorkspaceIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindConfigurationVersionsByWorkspaceID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"workspaceID": workspaceID}, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
 				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
@@ -1835,17 +2089,17 @@ func (_d QuerierWithTracing) FindNotificationConfigurationsByWorkspaceID(ctx con
 
 		_span.End()
 	}()
-	return _d.Querier.FindNotificationConfigurationsByWorkspaceID(ctx, workspaceID)
+	return _d.Querier.FindConfigurationVersionsByWorkspaceID(ctx, params)
 }
 
-// FindOrganizationByID implements Querier
-func (_d QuerierWithTracing) FindOrganizationByID(ctx context.Context, organizationID pgtype.Text) (f1 FindOrganizationByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindOrganizationByID")
+// FindCurrentStateVersionByWorkspaceID implements Querier
+func (_d QuerierWithTracing) FindCurrentStateVersionByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (f1 FindCurrentStateVersionByWorkspaceIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindCurrentStateVersionByWorkspaceID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":            ctx,
-				"organizationID": organizationID}, map[string]interface{}{
+				"ctx":         ctx,
+				"workspaceID": workspaceID}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -1858,18 +2112,18 @@ func (_d QuerierWithTracing) FindOrganizationByID(ctx context.Context, organizat
 
 		_span.End()
 	}()
-	return _d.Querier.FindOrganizationByID(ctx, organizationID)
+	return _d.Querier.FindCurrentStateVersionByWorkspaceID(ctx, workspaceID)
 }
 
-// FindOrganizationByName implements Querier
-func (_d QuerierWithTracing) FindOrganizationByName(ctx context.Context, name pgtype.Text) (f1 FindOrganizationByNameRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindOrganizationByName")
+// FindDueSchedules implements Querier
+func (_d QuerierWithTracing) FindDueSchedules(ctx context.Context, due pgtype.Timestamptz) (fa1 []FindDueSchedulesRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindDueSchedules")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":  ctx,
-				"name": name}, map[string]interface{}{
-				"f1":  f1,
+				"ctx": ctx,
+				"due": due}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1881,17 +2135,16 @@ func (_d QuerierWithTracing) FindOrganizationByName(ctx context.Context, name pg
 
 		_span.End()
 	}()
-	return _d.Querier.FindOrganizationByName(ctx, name)
+	return _d.Querier.FindDueSchedules(ctx, due)
 }
 
-// FindOrganizationByNameForUpdate implements Querier
-func (_d QuerierWithTracing) FindOrganizationByNameForUpdate(ctx context.Context, name pgtype.Text) (f1 FindOrganizationByNameForUpdateRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindOrganizationByNameForUpdate")
+// FindGithubApp implements Querier
+func (_d QuerierWithTracing) FindGithubApp(ctx context.Context) (f1 FindGithubAppRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindGithubApp")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":  ctx,
-				"name": name}, map[string]interface{}{
+				"ctx": ctx}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -1904,18 +2157,18 @@ func (_d QuerierWithTracing) FindOrganizationByNameForUpdate(ctx context.Context
 
 		_span.End()
 	}()
-	return _d.Querier.FindOrganizationByNameForUpdate(ctx, name)
+	return _d.Querier.FindGithubApp(ctx)
 }
 
-// FindOrganizationNameByWorkspaceID implements Querier
-func (_d QuerierWithTracing) FindOrganizationNameByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindOrganizationNameByWorkspaceID")
+// FindInlineConfigurationVersions implements Querier
+func (_d QuerierWithTracing) FindInlineConfigurationVersions(ctx context.Context, limit pgtype.Int8) (fa1 []FindInlineConfigurationVersionsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindInlineConfigurationVersions")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"workspaceID": workspaceID}, map[string]interface{}{
-				"t1":  t1,
+				"ctx":   ctx,
+				"limit": limit}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1927,17 +2180,17 @@ func (_d QuerierWithTracing) FindOrganizationNameByWorkspaceID(ctx context.Conte
 
 		_span.End()
 	}()
-	return _d.Querier.FindOrganizationNameByWorkspaceID(ctx, workspaceID)
+	return _d.Querier.FindInlineConfigurationVersions(ctx, limit)
 }
 
-// FindOrganizationTokens implements Querier
-func (_d QuerierWithTracing) FindOrganizationTokens(ctx context.Context, organizationName pgtype.Text) (fa1 []FindOrganizationTokensRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindOrganizationTokens")
+// FindInlineLogChunks implements Querier
+func (_d QuerierWithTracing) FindInlineLogChunks(ctx context.Context, limit pgtype.Int8) (fa1 []FindInlineLogChunksRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindInlineLogChunks")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":              ctx,
-				"organizationName": organizationName}, map[string]interface{}{
+				"ctx":   ctx,
+				"limit": limit}, map[string]interface{}{
 				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
@@ -1950,18 +2203,18 @@ func (_d QuerierWithTracing) FindOrganizationTokens(ctx context.Context, organiz
 
 		_span.End()
 	}()
-	return _d.Querier.FindOrganizationTokens(ctx, organizationName)
+	return _d.Querier.FindInlineLogChunks(ctx, limit)
 }
 
-// FindOrganizationTokensByID implements Querier
-func (_d QuerierWithTracing) FindOrganizationTokensByID(ctx context.Context, organizationTokenID pgtype.Text) (f1 FindOrganizationTokensByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindOrganizationTokensByID")
+// FindInlineModuleTarballs implements Querier
+func (_d QuerierWithTracing) FindInlineModuleTarballs(ctx context.Context, limit pgtype.Int8) (fa1 []FindInlineModuleTarballsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindInlineModuleTarballs")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":                 ctx,
-				"organizationTokenID": organizationTokenID}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":   ctx,
+				"limit": limit}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1973,18 +2226,18 @@ func (_d QuerierWithTracing) FindOrganizationTokensByID(ctx context.Context, org
 
 		_span.End()
 	}()
-	return _d.Querier.FindOrganizationTokensByID(ctx, organizationTokenID)
+	return _d.Querier.FindInlineModuleTarballs(ctx, limit)
 }
 
-// FindOrganizationTokensByName implements Querier
-func (_d QuerierWithTracing) FindOrganizationTokensByName(ctx context.Context, organizationName pgtype.Text) (f1 FindOrganizationTokensByNameRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindOrganizationTokensByName")
+// FindInlinePlanFiles implements Querier
+func (_d QuerierWithTracing) FindInlinePlanFiles(ctx context.Context, limit pgtype.Int8) (fa1 []FindInlinePlanFilesRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindInlinePlanFiles")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":              ctx,
-				"organizationName": organizationName}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":   ctx,
+				"limit": limit}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -1996,17 +2249,17 @@ func (_d QuerierWithTracing) FindOrganizationTokensByName(ctx context.Context, o
 
 		_span.End()
 	}()
-	return _d.Querier.FindOrganizationTokensByName(ctx, organizationName)
+	return _d.Querier.FindInlinePlanFiles(ctx, limit)
 }
 
-// FindOrganizations implements Querier
-func (_d QuerierWithTracing) FindOrganizations(ctx context.Context, params FindOrganizationsParams) (fa1 []FindOrganizationsRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindOrganizations")
+// FindInlineProviderMirrorArchives implements Querier
+func (_d QuerierWithTracing) FindInlineProviderMirrorArchives(ctx context.Context, limit pgtype.Int8) (fa1 []FindInlineProviderMirrorArchivesRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindInlineProviderMirrorArchives")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
+				"ctx":   ctx,
+				"limit": limit}, map[string]interface{}{
 				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
@@ -2019,18 +2272,18 @@ func (_d QuerierWithTracing) FindOrganizations(ctx context.Context, params FindO
 
 		_span.End()
 	}()
-	return _d.Querier.FindOrganizations(ctx, params)
+	return _d.Querier.FindInlineProviderMirrorArchives(ctx, limit)
 }
 
-// FindRepohookByID implements Querier
-func (_d QuerierWithTracing) FindRepohookByID(ctx context.Context, repohookID pgtype.UUID) (f1 FindRepohookByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRepohookByID")
+// FindInlineRegistryProviderBinaries implements Querier
+func (_d QuerierWithTracing) FindInlineRegistryProviderBinaries(ctx context.Context, limit pgtype.Int8) (fa1 []FindInlineRegistryProviderBinariesRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindInlineRegistryProviderBinaries")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":        ctx,
-				"repohookID": repohookID}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":   ctx,
+				"limit": limit}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2042,18 +2295,17 @@ func (_d QuerierWithTracing) FindRepohookByID(ctx context.Context, repohookID pg
 
 		_span.End()
 	}()
-	return _d.Querier.FindRepohookByID(ctx, repohookID)
+	return _d.Querier.FindInlineRegistryProviderBinaries(ctx, limit)
 }
 
-// FindRepohookByRepoAndProvider implements Querier
-func (_d QuerierWithTracing) FindRepohookByRepoAndProvider(ctx context.Context, repoPath pgtype.Text, vcsProviderID pgtype.Text) (fa1 []FindRepohookByRepoAndProviderRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRepohookByRepoAndProvider")
+// FindInlineStateVersions implements Querier
+func (_d QuerierWithTracing) FindInlineStateVersions(ctx context.Context, limit pgtype.Int8) (fa1 []FindInlineStateVersionsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindInlineStateVersions")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":           ctx,
-				"repoPath":      repoPath,
-				"vcsProviderID": vcsProviderID}, map[string]interface{}{
+				"ctx":   ctx,
+				"limit": limit}, map[string]interface{}{
 				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
@@ -2066,17 +2318,19 @@ func (_d QuerierWithTracing) FindRepohookByRepoAndProvider(ctx context.Context,
 
 		_span.End()
 	}()
-	return _d.Querier.FindRepohookByRepoAndProvider(ctx, repoPath, vcsProviderID)
+	return _d.Querier.FindInlineStateVersions(ctx, limit)
 }
 
-// FindRepohooks implements Querier
-func (_d QuerierWithTracing) FindRepohooks(ctx context.Context) (fa1 []FindRepohooksRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRepohooks")
+// FindJob implements Querier
+func (_d QuerierWithTracing) FindJob(ctx context.Context, runID pgtype.Text, phase pgtype.Text) (f1 FindJobRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindJob")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":   ctx,
+				"runID": runID,
+				"phase": phase}, map[string]interface{}{
+				"f1":  f1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2088,17 +2342,18 @@ func (_d QuerierWithTracing) FindRepohooks(ctx context.Context) (fa1 []FindRepoh
 
 		_span.End()
 	}()
-	return _d.Querier.FindRepohooks(ctx)
+	return _d.Querier.FindJob(ctx, runID, phase)
 }
 
-// FindRunByID implements Querier
-func (_d QuerierWithTracing) FindRunByID(ctx context.Context, runID pgtype.Text) (f1 FindRunByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRunByID")
+// FindJobForUpdate implements Querier
+func (_d QuerierWithTracing) FindJobForUpdate(ctx context.Context, runID pgtype.Text, phase pgtype.Text) (f1 FindJobForUpdateRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindJobForUpdate")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":   ctx,
-				"runID": runID}, map[string]interface{}{
+				"runID": runID,
+				"phase": phase}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -2111,18 +2366,17 @@ func (_d QuerierWithTracing) FindRunByID(ctx context.Context, runID pgtype.Text)
 
 		_span.End()
 	}()
-	return _d.Querier.FindRunByID(ctx, runID)
+	return _d.Querier.FindJobForUpdate(ctx, runID, phase)
 }
 
-// FindRunByIDForUpdate implements Querier
-func (_d QuerierWithTracing) FindRunByIDForUpdate(ctx context.Context, runID pgtype.Text) (f1 FindRunByIDForUpdateRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRunByIDForUpdate")
+// FindJobs implements Querier
+func (_d QuerierWithTracing) FindJobs(ctx context.Context) (fa1 []FindJobsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindJobs")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":   ctx,
-				"runID": runID}, map[string]interface{}{
-				"f1":  f1,
+				"ctx": ctx}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2134,17 +2388,17 @@ func (_d QuerierWithTracing) FindRunByIDForUpdate(ctx context.Context, runID pgt
 
 		_span.End()
 	}()
-	return _d.Querier.FindRunByIDForUpdate(ctx, runID)
+	return _d.Querier.FindJobs(ctx)
 }
 
-// FindRuns implements Querier
-func (_d QuerierWithTracing) FindRuns(ctx context.Context, params FindRunsParams) (fa1 []FindRunsRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRuns")
+// FindLatestTerraformVersion implements Querier
+func (_d QuerierWithTracing) FindLatestTerraformVersion(ctx context.Context, engine pgtype.Text) (fa1 []FindLatestTerraformVersionRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindLatestTerraformVersion")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
+				"engine": engine}, map[string]interface{}{
 				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
@@ -2157,17 +2411,18 @@ func (_d QuerierWithTracing) FindRuns(ctx context.Context, params FindRunsParams
 
 		_span.End()
 	}()
-	return _d.Querier.FindRuns(ctx, params)
+	return _d.Querier.FindLatestTerraformVersion(ctx, engine)
 }
 
-// FindServerAgents implements Querier
-func (_d QuerierWithTracing) FindServerAgents(ctx context.Context) (fa1 []FindServerAgentsRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindServerAgents")
-	defer func() {
-		if _d._spanDecorator != nil {
+// FindLogChunkByID implements Querier
+func (_d QuerierWithTracing) FindLogChunkByID(ctx context.Context, chunkID pgtype.Int4) (f1 FindLogChunkByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindLogChunkByID")
+	defer func() {
+		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":     ctx,
+				"chunkID": chunkID}, map[string]interface{}{
+				"f1":  f1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2179,18 +2434,19 @@ func (_d QuerierWithTracing) FindServerAgents(ctx context.Context) (fa1 []FindSe
 
 		_span.End()
 	}()
-	return _d.Querier.FindServerAgents(ctx)
+	return _d.Querier.FindLogChunkByID(ctx, chunkID)
 }
 
-// FindStateVersionByID implements Querier
-func (_d QuerierWithTracing) FindStateVersionByID(ctx context.Context, id pgtype.Text) (f1 FindStateVersionByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindStateVersionByID")
+// FindLogChunks implements Querier
+func (_d QuerierWithTracing) FindLogChunks(ctx context.Context, runID pgtype.Text, phase pgtype.Text) (fa1 []FindLogChunksRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindLogChunks")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx,
-				"id":  id}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":   ctx,
+				"runID": runID,
+				"phase": phase}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2202,17 +2458,18 @@ func (_d QuerierWithTracing) FindStateVersionByID(ctx context.Context, id pgtype
 
 		_span.End()
 	}()
-	return _d.Querier.FindStateVersionByID(ctx, id)
+	return _d.Querier.FindLogChunks(ctx, runID, phase)
 }
 
-// FindStateVersionByIDForUpdate implements Querier
-func (_d QuerierWithTracing) FindStateVersionByIDForUpdate(ctx context.Context, id pgtype.Text) (f1 FindStateVersionByIDForUpdateRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindStateVersionByIDForUpdate")
+// FindModuleByConnection implements Querier
+func (_d QuerierWithTracing) FindModuleByConnection(ctx context.Context, vcsProviderID pgtype.Text, repoPath pgtype.Text) (f1 FindModuleByConnectionRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindModuleByConnection")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx,
-				"id":  id}, map[string]interface{}{
+				"ctx":           ctx,
+				"vcsProviderID": vcsProviderID,
+				"repoPath":      repoPath}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -2225,12 +2482,12 @@ func (_d QuerierWithTracing) FindStateVersionByIDForUpdate(ctx context.Context,
 
 		_span.End()
 	}()
-	return _d.Querier.FindStateVersionByIDForUpdate(ctx, id)
+	return _d.Querier.FindModuleByConnection(ctx, vcsProviderID, repoPath)
 }
 
-// FindStateVersionOutputByID implements Querier
-func (_d QuerierWithTracing) FindStateVersionOutputByID(ctx context.Context, id pgtype.Text) (f1 FindStateVersionOutputByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindStateVersionOutputByID")
+// FindModuleByID implements Querier
+func (_d QuerierWithTracing) FindModuleByID(ctx context.Context, id pgtype.Text) (f1 FindModuleByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindModuleByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
@@ -2248,18 +2505,18 @@ func (_d QuerierWithTracing) FindStateVersionOutputByID(ctx context.Context, id
 
 		_span.End()
 	}()
-	return _d.Querier.FindStateVersionOutputByID(ctx, id)
+	return _d.Querier.FindModuleByID(ctx, id)
 }
 
-// FindStateVersionStateByID implements Querier
-func (_d QuerierWithTracing) FindStateVersionStateByID(ctx context.Context, id pgtype.Text) (ba1 []byte, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindStateVersionStateByID")
+// FindModuleByModuleVersionID implements Querier
+func (_d QuerierWithTracing) FindModuleByModuleVersionID(ctx context.Context, moduleVersionID pgtype.Text) (f1 FindModuleByModuleVersionIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindModuleByModuleVersionID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx,
-				"id":  id}, map[string]interface{}{
-				"ba1": ba1,
+				"ctx":             ctx,
+				"moduleVersionID": moduleVersionID}, map[string]interface{}{
+				"f1":  f1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2271,18 +2528,18 @@ func (_d QuerierWithTracing) FindStateVersionStateByID(ctx context.Context, id p
 
 		_span.End()
 	}()
-	return _d.Querier.FindStateVersionStateByID(ctx, id)
+	return _d.Querier.FindModuleByModuleVersionID(ctx, moduleVersionID)
 }
 
-// FindStateVersionsByWorkspaceID implements Querier
-func (_d QuerierWithTracing) FindStateVersionsByWorkspaceID(ctx context.Context, params FindStateVersionsByWorkspaceIDParams) (fa1 []FindStateVersionsByWorkspaceIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindStateVersionsByWorkspaceID")
+// FindModuleByName implements Querier
+func (_d QuerierWithTracing) FindModuleByName(ctx context.Context, params FindModuleByNameParams) (f1 FindModuleByNameRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindModuleByName")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"fa1": fa1,
+				"f1":  f1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2294,19 +2551,18 @@ func (_d QuerierWithTracing) FindStateVersionsByWorkspaceID(ctx context.Context,
 
 		_span.End()
 	}()
-	return _d.Querier.FindStateVersionsByWorkspaceID(ctx, params)
+	return _d.Querier.FindModuleByName(ctx, params)
 }
 
-// FindTagByID implements Querier
-func (_d QuerierWithTracing) FindTagByID(ctx context.Context, tagID pgtype.Text, organizationName pgtype.Text) (f1 FindTagByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTagByID")
+// FindModuleTarball implements Querier
+func (_d QuerierWithTracing) FindModuleTarball(ctx context.Context, moduleVersionID pgtype.Text) (ba1 []byte, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindModuleTarball")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":              ctx,
-				"tagID":            tagID,
-				"organizationName": organizationName}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":             ctx,
+				"moduleVersionID": moduleVersionID}, map[string]interface{}{
+				"ba1": ba1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2318,18 +2574,17 @@ func (_d QuerierWithTracing) FindTagByID(ctx context.Context, tagID pgtype.Text,
 
 		_span.End()
 	}()
-	return _d.Querier.FindTagByID(ctx, tagID, organizationName)
+	return _d.Querier.FindModuleTarball(ctx, moduleVersionID)
 }
 
-// FindTagByName implements Querier
-func (_d QuerierWithTracing) FindTagByName(ctx context.Context, name pgtype.Text, organizationName pgtype.Text) (f1 FindTagByNameRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTagByName")
+// FindModuleVersionMetadata implements Querier
+func (_d QuerierWithTracing) FindModuleVersionMetadata(ctx context.Context, moduleVersionID pgtype.Text) (f1 FindModuleVersionMetadataRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindModuleVersionMetadata")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":              ctx,
-				"name":             name,
-				"organizationName": organizationName}, map[string]interface{}{
+				"ctx":             ctx,
+				"moduleVersionID": moduleVersionID}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -2342,18 +2597,18 @@ func (_d QuerierWithTracing) FindTagByName(ctx context.Context, name pgtype.Text
 
 		_span.End()
 	}()
-	return _d.Querier.FindTagByName(ctx, name, organizationName)
+	return _d.Querier.FindModuleVersionMetadata(ctx, moduleVersionID)
 }
 
-// FindTags implements Querier
-func (_d QuerierWithTracing) FindTags(ctx context.Context, params FindTagsParams) (fa1 []FindTagsRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTags")
+// FindNotificationConfiguration implements Querier
+func (_d QuerierWithTracing) FindNotificationConfiguration(ctx context.Context, notificationConfigurationID pgtype.Text) (f1 FindNotificationConfigurationRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindNotificationConfiguration")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":                         ctx,
+				"notificationConfigurationID": notificationConfigurationID}, map[string]interface{}{
+				"f1":  f1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2365,17 +2620,17 @@ func (_d QuerierWithTracing) FindTags(ctx context.Context, params FindTagsParams
 
 		_span.End()
 	}()
-	return _d.Querier.FindTags(ctx, params)
+	return _d.Querier.FindNotificationConfiguration(ctx, notificationConfigurationID)
 }
 
-// FindTeamByID implements Querier
-func (_d QuerierWithTracing) FindTeamByID(ctx context.Context, teamID pgtype.Text) (f1 FindTeamByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTeamByID")
+// FindNotificationConfigurationForUpdate implements Querier
+func (_d QuerierWithTracing) FindNotificationConfigurationForUpdate(ctx context.Context, notificationConfigurationID pgtype.Text) (f1 FindNotificationConfigurationForUpdateRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindNotificationConfigurationForUpdate")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"teamID": teamID}, map[string]interface{}{
+				"ctx":                         ctx,
+				"notificationConfigurationID": notificationConfigurationID}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -2388,17 +2643,40 @@ func (_d QuerierWithTracing) FindTeamByID(ctx context.Context, teamID pgtype.Tex
 
 		_span.End()
 	}()
-	return _d.Querier.FindTeamByID(ctx, teamID)
+	return _d.Querier.FindNotificationConfigurationForUpdate(ctx, notificationConfigurationID)
 }
 
-// FindTeamByIDForUpdate implements Querier
-func (_d QuerierWithTracing) FindTeamByIDForUpdate(ctx context.Context, teamID pgtype.Text) (f1 FindTeamByIDForUpdateRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTeamByIDForUpdate")
+// FindNotificationConfigurationsByWorkspaceID implements Querier
+func (_d QuerierWithTracing) FindNotificationConfigurationsByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (fa1 []FindNotificationConfigurationsByWorkspaceIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindNotificationConfigurationsByWorkspaceID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"teamID": teamID}, map[string]interface{}{
+				"ctx":         ctx,
+				"workspaceID": workspaceID}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindNotificationConfigurationsByWorkspaceID(ctx, workspaceID)
+}
+
+// FindOrganizationByID implements Querier
+func (_d QuerierWithTracing) FindOrganizationByID(ctx context.Context, organizationID pgtype.Text) (f1 FindOrganizationByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindOrganizationByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":            ctx,
+				"organizationID": organizationID}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -2411,18 +2689,17 @@ func (_d QuerierWithTracing) FindTeamByIDForUpdate(ctx context.Context, teamID p
 
 		_span.End()
 	}()
-	return _d.Querier.FindTeamByIDForUpdate(ctx, teamID)
+	return _d.Querier.FindOrganizationByID(ctx, organizationID)
 }
 
-// FindTeamByName implements Querier
-func (_d QuerierWithTracing) FindTeamByName(ctx context.Context, name pgtype.Text, organizationName pgtype.Text) (f1 FindTeamByNameRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTeamByName")
+// FindOrganizationByName implements Querier
+func (_d QuerierWithTracing) FindOrganizationByName(ctx context.Context, name pgtype.Text) (f1 FindOrganizationByNameRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindOrganizationByName")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":              ctx,
-				"name":             name,
-				"organizationName": organizationName}, map[string]interface{}{
+				"ctx":  ctx,
+				"name": name}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -2435,17 +2712,17 @@ func (_d QuerierWithTracing) FindTeamByName(ctx context.Context, name pgtype.Tex
 
 		_span.End()
 	}()
-	return _d.Querier.FindTeamByName(ctx, name, organizationName)
+	return _d.Querier.FindOrganizationByName(ctx, name)
 }
 
-// FindTeamByTokenID implements Querier
-func (_d QuerierWithTracing) FindTeamByTokenID(ctx context.Context, tokenID pgtype.Text) (f1 FindTeamByTokenIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTeamByTokenID")
+// FindOrganizationByNameForUpdate implements Querier
+func (_d QuerierWithTracing) FindOrganizationByNameForUpdate(ctx context.Context, name pgtype.Text) (f1 FindOrganizationByNameForUpdateRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindOrganizationByNameForUpdate")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":     ctx,
-				"tokenID": tokenID}, map[string]interface{}{
+				"ctx":  ctx,
+				"name": name}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -2458,18 +2735,18 @@ func (_d QuerierWithTracing) FindTeamByTokenID(ctx context.Context, tokenID pgty
 
 		_span.End()
 	}()
-	return _d.Querier.FindTeamByTokenID(ctx, tokenID)
+	return _d.Querier.FindOrganizationByNameForUpdate(ctx, name)
 }
 
-// FindTeamTokensByID implements Querier
-func (_d QuerierWithTracing) FindTeamTokensByID(ctx context.Context, teamID pgtype.Text) (fa1 []FindTeamTokensByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTeamTokensByID")
+// FindOrganizationNameByWorkspaceID implements Querier
+func (_d QuerierWithTracing) FindOrganizationNameByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindOrganizationNameByWorkspaceID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"teamID": teamID}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":         ctx,
+				"workspaceID": workspaceID}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2481,12 +2758,12 @@ func (_d QuerierWithTracing) FindTeamTokensByID(ctx context.Context, teamID pgty
 
 		_span.End()
 	}()
-	return _d.Querier.FindTeamTokensByID(ctx, teamID)
+	return _d.Querier.FindOrganizationNameByWorkspaceID(ctx, workspaceID)
 }
 
-// FindTeamsByOrg implements Querier
-func (_d QuerierWithTracing) FindTeamsByOrg(ctx context.Context, organizationName pgtype.Text) (fa1 []FindTeamsByOrgRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTeamsByOrg")
+// FindOrganizationTokens implements Querier
+func (_d QuerierWithTracing) FindOrganizationTokens(ctx context.Context, organizationName pgtype.Text) (fa1 []FindOrganizationTokensRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindOrganizationTokens")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
@@ -2504,17 +2781,17 @@ func (_d QuerierWithTracing) FindTeamsByOrg(ctx context.Context, organizationNam
 
 		_span.End()
 	}()
-	return _d.Querier.FindTeamsByOrg(ctx, organizationName)
+	return _d.Querier.FindOrganizationTokens(ctx, organizationName)
 }
 
-// FindTokenByID implements Querier
-func (_d QuerierWithTracing) FindTokenByID(ctx context.Context, tokenID pgtype.Text) (f1 FindTokenByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTokenByID")
+// FindOrganizationTokensByID implements Querier
+func (_d QuerierWithTracing) FindOrganizationTokensByID(ctx context.Context, organizationTokenID pgtype.Text) (f1 FindOrganizationTokensByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindOrganizationTokensByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":     ctx,
-				"tokenID": tokenID}, map[string]interface{}{
+				"ctx":                 ctx,
+				"organizationTokenID": organizationTokenID}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -2527,18 +2804,18 @@ func (_d QuerierWithTracing) FindTokenByID(ctx context.Context, tokenID pgtype.T
 
 		_span.End()
 	}()
-	return _d.Querier.FindTokenByID(ctx, tokenID)
+	return _d.Querier.FindOrganizationTokensByID(ctx, organizationTokenID)
 }
 
-// FindTokensByUsername implements Querier
-func (_d QuerierWithTracing) FindTokensByUsername(ctx context.Context, username pgtype.Text) (fa1 []FindTokensByUsernameRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTokensByUsername")
+// FindOrganizationTokensByName implements Querier
+func (_d QuerierWithTracing) FindOrganizationTokensByName(ctx context.Context, organizationName pgtype.Text) (f1 FindOrganizationTokensByNameRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindOrganizationTokensByName")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":      ctx,
-				"username": username}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":              ctx,
+				"organizationName": organizationName}, map[string]interface{}{
+				"f1":  f1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2550,16 +2827,17 @@ func (_d QuerierWithTracing) FindTokensByUsername(ctx context.Context, username
 
 		_span.End()
 	}()
-	return _d.Querier.FindTokensByUsername(ctx, username)
+	return _d.Querier.FindOrganizationTokensByName(ctx, organizationName)
 }
 
-// FindUnreferencedRepohooks implements Querier
-func (_d QuerierWithTracing) FindUnreferencedRepohooks(ctx context.Context) (fa1 []FindUnreferencedRepohooksRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindUnreferencedRepohooks")
+// FindOrganizations implements Querier
+func (_d QuerierWithTracing) FindOrganizations(ctx context.Context, params FindOrganizationsParams) (fa1 []FindOrganizationsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindOrganizations")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx}, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
 				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
@@ -2572,18 +2850,18 @@ func (_d QuerierWithTracing) FindUnreferencedRepohooks(ctx context.Context) (fa1
 
 		_span.End()
 	}()
-	return _d.Querier.FindUnreferencedRepohooks(ctx)
+	return _d.Querier.FindOrganizations(ctx, params)
 }
 
-// FindUserByAuthenticationTokenID implements Querier
-func (_d QuerierWithTracing) FindUserByAuthenticationTokenID(ctx context.Context, tokenID pgtype.Text) (f1 FindUserByAuthenticationTokenIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindUserByAuthenticationTokenID")
+// FindPoliciesByOrganization implements Querier
+func (_d QuerierWithTracing) FindPoliciesByOrganization(ctx context.Context, organizationName pgtype.Text) (fa1 []FindPoliciesByOrganizationRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindPoliciesByOrganization")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":     ctx,
-				"tokenID": tokenID}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":              ctx,
+				"organizationName": organizationName}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2595,18 +2873,18 @@ func (_d QuerierWithTracing) FindUserByAuthenticationTokenID(ctx context.Context
 
 		_span.End()
 	}()
-	return _d.Querier.FindUserByAuthenticationTokenID(ctx, tokenID)
+	return _d.Querier.FindPoliciesByOrganization(ctx, organizationName)
 }
 
-// FindUserByID implements Querier
-func (_d QuerierWithTracing) FindUserByID(ctx context.Context, userID pgtype.Text) (f1 FindUserByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindUserByID")
+// FindPoliciesByPolicySetID implements Querier
+func (_d QuerierWithTracing) FindPoliciesByPolicySetID(ctx context.Context, policySetID pgtype.Text) (fa1 []FindPoliciesByPolicySetIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindPoliciesByPolicySetID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"userID": userID}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":         ctx,
+				"policySetID": policySetID}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2618,17 +2896,17 @@ func (_d QuerierWithTracing) FindUserByID(ctx context.Context, userID pgtype.Tex
 
 		_span.End()
 	}()
-	return _d.Querier.FindUserByID(ctx, userID)
+	return _d.Querier.FindPoliciesByPolicySetID(ctx, policySetID)
 }
 
-// FindUserByUsername implements Querier
-func (_d QuerierWithTracing) FindUserByUsername(ctx context.Context, username pgtype.Text) (f1 FindUserByUsernameRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindUserByUsername")
+// FindPolicy implements Querier
+func (_d QuerierWithTracing) FindPolicy(ctx context.Context, policyID pgtype.Text) (f1 FindPolicyRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindPolicy")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":      ctx,
-				"username": username}, map[string]interface{}{
+				"policyID": policyID}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -2641,17 +2919,18 @@ func (_d QuerierWithTracing) FindUserByUsername(ctx context.Context, username pg
 
 		_span.End()
 	}()
-	return _d.Querier.FindUserByUsername(ctx, username)
+	return _d.Querier.FindPolicy(ctx, policyID)
 }
 
-// FindUsers implements Querier
-func (_d QuerierWithTracing) FindUsers(ctx context.Context) (fa1 []FindUsersRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindUsers")
+// FindPolicyCheck implements Querier
+func (_d QuerierWithTracing) FindPolicyCheck(ctx context.Context, policyCheckID pgtype.Text) (f1 FindPolicyCheckRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindPolicyCheck")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":           ctx,
+				"policyCheckID": policyCheckID}, map[string]interface{}{
+				"f1":  f1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2663,12 +2942,104 @@ func (_d QuerierWithTracing) FindUsers(ctx context.Context) (fa1 []FindUsersRow,
 
 		_span.End()
 	}()
-	return _d.Querier.FindUsers(ctx)
+	return _d.Querier.FindPolicyCheck(ctx, policyCheckID)
 }
 
-// FindUsersByOrganization implements Querier
-func (_d QuerierWithTracing) FindUsersByOrganization(ctx context.Context, organizationName pgtype.Text) (fa1 []FindUsersByOrganizationRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindUsersByOrganization")
+// FindPolicyCheckByRunID implements Querier
+func (_d QuerierWithTracing) FindPolicyCheckByRunID(ctx context.Context, runID pgtype.Text) (f1 FindPolicyCheckByRunIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindPolicyCheckByRunID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":   ctx,
+				"runID": runID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindPolicyCheckByRunID(ctx, runID)
+}
+
+// FindPolicyForUpdate implements Querier
+func (_d QuerierWithTracing) FindPolicyForUpdate(ctx context.Context, policyID pgtype.Text) (f1 FindPolicyForUpdateRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindPolicyForUpdate")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"policyID": policyID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindPolicyForUpdate(ctx, policyID)
+}
+
+// FindPolicySet implements Querier
+func (_d QuerierWithTracing) FindPolicySet(ctx context.Context, policySetID pgtype.Text) (f1 FindPolicySetRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindPolicySet")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":         ctx,
+				"policySetID": policySetID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindPolicySet(ctx, policySetID)
+}
+
+// FindPolicySetForUpdate implements Querier
+func (_d QuerierWithTracing) FindPolicySetForUpdate(ctx context.Context, policySetID pgtype.Text) (f1 FindPolicySetForUpdateRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindPolicySetForUpdate")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":         ctx,
+				"policySetID": policySetID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindPolicySetForUpdate(ctx, policySetID)
+}
+
+// FindPolicySetsByOrganization implements Querier
+func (_d QuerierWithTracing) FindPolicySetsByOrganization(ctx context.Context, organizationName pgtype.Text) (fa1 []FindPolicySetsByOrganizationRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindPolicySetsByOrganization")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
@@ -2686,18 +3057,18 @@ func (_d QuerierWithTracing) FindUsersByOrganization(ctx context.Context, organi
 
 		_span.End()
 	}()
-	return _d.Querier.FindUsersByOrganization(ctx, organizationName)
+	return _d.Querier.FindPolicySetsByOrganization(ctx, organizationName)
 }
 
-// FindUsersByTeamID implements Querier
-func (_d QuerierWithTracing) FindUsersByTeamID(ctx context.Context, teamID pgtype.Text) (fa1 []FindUsersByTeamIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindUsersByTeamID")
+// FindProjectByID implements Querier
+func (_d QuerierWithTracing) FindProjectByID(ctx context.Context, projectID pgtype.Text) (f1 FindProjectByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindProjectByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"teamID": teamID}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":       ctx,
+				"projectID": projectID}, map[string]interface{}{
+				"f1":  f1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2709,17 +3080,17 @@ func (_d QuerierWithTracing) FindUsersByTeamID(ctx context.Context, teamID pgtyp
 
 		_span.End()
 	}()
-	return _d.Querier.FindUsersByTeamID(ctx, teamID)
+	return _d.Querier.FindProjectByID(ctx, projectID)
 }
 
-// FindVCSProvider implements Querier
-func (_d QuerierWithTracing) FindVCSProvider(ctx context.Context, vcsProviderID pgtype.Text) (f1 FindVCSProviderRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVCSProvider")
+// FindProjectByIDForUpdate implements Querier
+func (_d QuerierWithTracing) FindProjectByIDForUpdate(ctx context.Context, projectID pgtype.Text) (f1 FindProjectByIDForUpdateRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindProjectByIDForUpdate")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":           ctx,
-				"vcsProviderID": vcsProviderID}, map[string]interface{}{
+				"ctx":       ctx,
+				"projectID": projectID}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -2732,17 +3103,17 @@ func (_d QuerierWithTracing) FindVCSProvider(ctx context.Context, vcsProviderID
 
 		_span.End()
 	}()
-	return _d.Querier.FindVCSProvider(ctx, vcsProviderID)
+	return _d.Querier.FindProjectByIDForUpdate(ctx, projectID)
 }
 
-// FindVCSProviderForUpdate implements Querier
-func (_d QuerierWithTracing) FindVCSProviderForUpdate(ctx context.Context, vcsProviderID pgtype.Text) (f1 FindVCSProviderForUpdateRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVCSProviderForUpdate")
+// FindProjectPermissionByID implements Querier
+func (_d QuerierWithTracing) FindProjectPermissionByID(ctx context.Context, projectPermissionID pgtype.Text) (f1 FindProjectPermissionByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindProjectPermissionByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":           ctx,
-				"vcsProviderID": vcsProviderID}, map[string]interface{}{
+				"ctx":                 ctx,
+				"projectPermissionID": projectPermissionID}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -2755,17 +3126,19 @@ func (_d QuerierWithTracing) FindVCSProviderForUpdate(ctx context.Context, vcsPr
 
 		_span.End()
 	}()
-	return _d.Querier.FindVCSProviderForUpdate(ctx, vcsProviderID)
+	return _d.Querier.FindProjectPermissionByID(ctx, projectPermissionID)
 }
 
-// FindVCSProviders implements Querier
-func (_d QuerierWithTracing) FindVCSProviders(ctx context.Context) (fa1 []FindVCSProvidersRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVCSProviders")
+// FindProjectPermissionByTeamID implements Querier
+func (_d QuerierWithTracing) FindProjectPermissionByTeamID(ctx context.Context, projectID pgtype.Text, teamID pgtype.Text) (f1 FindProjectPermissionByTeamIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindProjectPermissionByTeamID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":       ctx,
+				"projectID": projectID,
+				"teamID":    teamID}, map[string]interface{}{
+				"f1":  f1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2777,17 +3150,17 @@ func (_d QuerierWithTracing) FindVCSProviders(ctx context.Context) (fa1 []FindVC
 
 		_span.End()
 	}()
-	return _d.Querier.FindVCSProviders(ctx)
+	return _d.Querier.FindProjectPermissionByTeamID(ctx, projectID, teamID)
 }
 
-// FindVCSProvidersByGithubAppInstallID implements Querier
-func (_d QuerierWithTracing) FindVCSProvidersByGithubAppInstallID(ctx context.Context, installID pgtype.Int8) (fa1 []FindVCSProvidersByGithubAppInstallIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVCSProvidersByGithubAppInstallID")
+// FindProjectPermissionsByProjectID implements Querier
+func (_d QuerierWithTracing) FindProjectPermissionsByProjectID(ctx context.Context, projectID pgtype.Text) (fa1 []FindProjectPermissionsByProjectIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindProjectPermissionsByProjectID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":       ctx,
-				"installID": installID}, map[string]interface{}{
+				"projectID": projectID}, map[string]interface{}{
 				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
@@ -2800,17 +3173,17 @@ func (_d QuerierWithTracing) FindVCSProvidersByGithubAppInstallID(ctx context.Co
 
 		_span.End()
 	}()
-	return _d.Querier.FindVCSProvidersByGithubAppInstallID(ctx, installID)
+	return _d.Querier.FindProjectPermissionsByProjectID(ctx, projectID)
 }
 
-// FindVCSProvidersByOrganization implements Querier
-func (_d QuerierWithTracing) FindVCSProvidersByOrganization(ctx context.Context, organizationName pgtype.Text) (fa1 []FindVCSProvidersByOrganizationRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVCSProvidersByOrganization")
+// FindProjectPermissionsByWorkspaceID implements Querier
+func (_d QuerierWithTracing) FindProjectPermissionsByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (fa1 []FindProjectPermissionsByWorkspaceIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindProjectPermissionsByWorkspaceID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":              ctx,
-				"organizationName": organizationName}, map[string]interface{}{
+				"ctx":         ctx,
+				"workspaceID": workspaceID}, map[string]interface{}{
 				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
@@ -2823,18 +3196,18 @@ func (_d QuerierWithTracing) FindVCSProvidersByOrganization(ctx context.Context,
 
 		_span.End()
 	}()
-	return _d.Querier.FindVCSProvidersByOrganization(ctx, organizationName)
+	return _d.Querier.FindProjectPermissionsByWorkspaceID(ctx, workspaceID)
 }
 
-// FindVariable implements Querier
-func (_d QuerierWithTracing) FindVariable(ctx context.Context, variableID pgtype.Text) (f1 FindVariableRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVariable")
+// FindProjects implements Querier
+func (_d QuerierWithTracing) FindProjects(ctx context.Context, params FindProjectsParams) (fa1 []FindProjectsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindProjects")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":        ctx,
-				"variableID": variableID}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2846,17 +3219,17 @@ func (_d QuerierWithTracing) FindVariable(ctx context.Context, variableID pgtype
 
 		_span.End()
 	}()
-	return _d.Querier.FindVariable(ctx, variableID)
+	return _d.Querier.FindProjects(ctx, params)
 }
 
-// FindVariableSetBySetID implements Querier
-func (_d QuerierWithTracing) FindVariableSetBySetID(ctx context.Context, variableSetID pgtype.Text) (f1 FindVariableSetBySetIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVariableSetBySetID")
+// FindProviderMirrorArchive implements Querier
+func (_d QuerierWithTracing) FindProviderMirrorArchive(ctx context.Context, params FindProviderMirrorArchiveParams) (f1 FindProviderMirrorArchiveRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindProviderMirrorArchive")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":           ctx,
-				"variableSetID": variableSetID}, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -2869,17 +3242,17 @@ func (_d QuerierWithTracing) FindVariableSetBySetID(ctx context.Context, variabl
 
 		_span.End()
 	}()
-	return _d.Querier.FindVariableSetBySetID(ctx, variableSetID)
+	return _d.Querier.FindProviderMirrorArchive(ctx, params)
 }
 
-// FindVariableSetByVariableID implements Querier
-func (_d QuerierWithTracing) FindVariableSetByVariableID(ctx context.Context, variableID pgtype.Text) (f1 FindVariableSetByVariableIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVariableSetByVariableID")
+// FindProviderMirrorVersion implements Querier
+func (_d QuerierWithTracing) FindProviderMirrorVersion(ctx context.Context, params FindProviderMirrorVersionParams) (f1 FindProviderMirrorVersionRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindProviderMirrorVersion")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":        ctx,
-				"variableID": variableID}, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -2892,17 +3265,17 @@ func (_d QuerierWithTracing) FindVariableSetByVariableID(ctx context.Context, va
 
 		_span.End()
 	}()
-	return _d.Querier.FindVariableSetByVariableID(ctx, variableID)
+	return _d.Querier.FindProviderMirrorVersion(ctx, params)
 }
 
-// FindVariableSetForUpdate implements Querier
-func (_d QuerierWithTracing) FindVariableSetForUpdate(ctx context.Context, variableSetID pgtype.Text) (f1 FindVariableSetForUpdateRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVariableSetForUpdate")
+// FindProviderMirrorVersionByID implements Querier
+func (_d QuerierWithTracing) FindProviderMirrorVersionByID(ctx context.Context, providerMirrorVersionID pgtype.Text) (f1 FindProviderMirrorVersionByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindProviderMirrorVersionByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":           ctx,
-				"variableSetID": variableSetID}, map[string]interface{}{
+				"ctx":                     ctx,
+				"providerMirrorVersionID": providerMirrorVersionID}, map[string]interface{}{
 				"f1":  f1,
 				"err": err})
 		} else if err != nil {
@@ -2915,17 +3288,17 @@ func (_d QuerierWithTracing) FindVariableSetForUpdate(ctx context.Context, varia
 
 		_span.End()
 	}()
-	return _d.Querier.FindVariableSetForUpdate(ctx, variableSetID)
+	return _d.Querier.FindProviderMirrorVersionByID(ctx, providerMirrorVersionID)
 }
 
-// FindVariableSetsByOrganization implements Querier
-func (_d QuerierWithTracing) FindVariableSetsByOrganization(ctx context.Context, organizationName pgtype.Text) (fa1 []FindVariableSetsByOrganizationRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVariableSetsByOrganization")
+// FindProviderMirrorVersions implements Querier
+func (_d QuerierWithTracing) FindProviderMirrorVersions(ctx context.Context, params FindProviderMirrorVersionsParams) (fa1 []FindProviderMirrorVersionsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindProviderMirrorVersions")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":              ctx,
-				"organizationName": organizationName}, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
 				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
@@ -2938,18 +3311,2348 @@ func (_d QuerierWithTracing) FindVariableSetsByOrganization(ctx context.Context,
 
 		_span.End()
 	}()
-	return _d.Querier.FindVariableSetsByOrganization(ctx, organizationName)
+	return _d.Querier.FindProviderMirrorVersions(ctx, params)
+}
+
+// FindPullRequestCommentID implements Querier
+func (_d QuerierWithTracing) FindPullRequestCommentID(ctx context.Context, params FindPullRequestCommentIDParams) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindPullRequestCommentID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"t1":  t1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindPullRequestCommentID(ctx, params)
+}
+
+// FindRegistryProvider implements Querier
+func (_d QuerierWithTracing) FindRegistryProvider(ctx context.Context, organizationName pgtype.Text, name pgtype.Text) (f1 FindRegistryProviderRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRegistryProvider")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":              ctx,
+				"organizationName": organizationName,
+				"name":             name}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRegistryProvider(ctx, organizationName, name)
+}
+
+// FindRegistryProviderByID implements Querier
+func (_d QuerierWithTracing) FindRegistryProviderByID(ctx context.Context, registryProviderID pgtype.Text) (f1 FindRegistryProviderByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRegistryProviderByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":                ctx,
+				"registryProviderID": registryProviderID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRegistryProviderByID(ctx, registryProviderID)
+}
+
+// FindRegistryProviderPlatform implements Querier
+func (_d QuerierWithTracing) FindRegistryProviderPlatform(ctx context.Context, params FindRegistryProviderPlatformParams) (f1 FindRegistryProviderPlatformRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRegistryProviderPlatform")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRegistryProviderPlatform(ctx, params)
+}
+
+// FindRegistryProviderPlatformBinary implements Querier
+func (_d QuerierWithTracing) FindRegistryProviderPlatformBinary(ctx context.Context, registryProviderPlatformID pgtype.Text) (ba1 []byte, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRegistryProviderPlatformBinary")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":                        ctx,
+				"registryProviderPlatformID": registryProviderPlatformID}, map[string]interface{}{
+				"ba1": ba1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRegistryProviderPlatformBinary(ctx, registryProviderPlatformID)
+}
+
+// FindRegistryProviderPlatformByID implements Querier
+func (_d QuerierWithTracing) FindRegistryProviderPlatformByID(ctx context.Context, registryProviderPlatformID pgtype.Text) (f1 FindRegistryProviderPlatformByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRegistryProviderPlatformByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":                        ctx,
+				"registryProviderPlatformID": registryProviderPlatformID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRegistryProviderPlatformByID(ctx, registryProviderPlatformID)
+}
+
+// FindRegistryProviderPlatforms implements Querier
+func (_d QuerierWithTracing) FindRegistryProviderPlatforms(ctx context.Context, registryProviderVersionID pgtype.Text) (fa1 []FindRegistryProviderPlatformsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRegistryProviderPlatforms")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":                       ctx,
+				"registryProviderVersionID": registryProviderVersionID}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRegistryProviderPlatforms(ctx, registryProviderVersionID)
+}
+
+// FindRegistryProviderVersion implements Querier
+func (_d QuerierWithTracing) FindRegistryProviderVersion(ctx context.Context, registryProviderID pgtype.Text, version pgtype.Text) (f1 FindRegistryProviderVersionRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRegistryProviderVersion")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":                ctx,
+				"registryProviderID": registryProviderID,
+				"version":            version}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRegistryProviderVersion(ctx, registryProviderID, version)
+}
+
+// FindRegistryProviderVersionByID implements Querier
+func (_d QuerierWithTracing) FindRegistryProviderVersionByID(ctx context.Context, registryProviderVersionID pgtype.Text) (f1 FindRegistryProviderVersionByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRegistryProviderVersionByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":                       ctx,
+				"registryProviderVersionID": registryProviderVersionID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRegistryProviderVersionByID(ctx, registryProviderVersionID)
+}
+
+// FindRegistryProviderVersionShasums implements Querier
+func (_d QuerierWithTracing) FindRegistryProviderVersionShasums(ctx context.Context, registryProviderVersionID pgtype.Text) (ba1 []byte, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRegistryProviderVersionShasums")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":                       ctx,
+				"registryProviderVersionID": registryProviderVersionID}, map[string]interface{}{
+				"ba1": ba1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRegistryProviderVersionShasums(ctx, registryProviderVersionID)
+}
+
+// FindRegistryProviderVersionShasumsSig implements Querier
+func (_d QuerierWithTracing) FindRegistryProviderVersionShasumsSig(ctx context.Context, registryProviderVersionID pgtype.Text) (ba1 []byte, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRegistryProviderVersionShasumsSig")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":                       ctx,
+				"registryProviderVersionID": registryProviderVersionID}, map[string]interface{}{
+				"ba1": ba1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRegistryProviderVersionShasumsSig(ctx, registryProviderVersionID)
+}
+
+// FindRegistryProviderVersions implements Querier
+func (_d QuerierWithTracing) FindRegistryProviderVersions(ctx context.Context, registryProviderID pgtype.Text) (fa1 []FindRegistryProviderVersionsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRegistryProviderVersions")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":                ctx,
+				"registryProviderID": registryProviderID}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRegistryProviderVersions(ctx, registryProviderID)
+}
+
+// FindRegistryProviders implements Querier
+func (_d QuerierWithTracing) FindRegistryProviders(ctx context.Context, organizationName pgtype.Text) (fa1 []FindRegistryProvidersRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRegistryProviders")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":              ctx,
+				"organizationName": organizationName}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRegistryProviders(ctx, organizationName)
+}
+
+// FindRepohookByID implements Querier
+func (_d QuerierWithTracing) FindRepohookByID(ctx context.Context, repohookID pgtype.UUID) (f1 FindRepohookByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRepohookByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":        ctx,
+				"repohookID": repohookID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRepohookByID(ctx, repohookID)
+}
+
+// FindRepohookByRepoAndProvider implements Querier
+func (_d QuerierWithTracing) FindRepohookByRepoAndProvider(ctx context.Context, repoPath pgtype.Text, vcsProviderID pgtype.Text) (fa1 []FindRepohookByRepoAndProviderRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRepohookByRepoAndProvider")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":           ctx,
+				"repoPath":      repoPath,
+				"vcsProviderID": vcsProviderID}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRepohookByRepoAndProvider(ctx, repoPath, vcsProviderID)
+}
+
+// FindRepohooks implements Querier
+func (_d QuerierWithTracing) FindRepohooks(ctx context.Context) (fa1 []FindRepohooksRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRepohooks")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRepohooks(ctx)
+}
+
+// FindRunByID implements Querier
+func (_d QuerierWithTracing) FindRunByID(ctx context.Context, runID pgtype.Text) (f1 FindRunByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRunByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":   ctx,
+				"runID": runID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRunByID(ctx, runID)
+}
+
+// FindRunByIDForUpdate implements Querier
+func (_d QuerierWithTracing) FindRunByIDForUpdate(ctx context.Context, runID pgtype.Text) (f1 FindRunByIDForUpdateRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRunByIDForUpdate")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":   ctx,
+				"runID": runID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRunByIDForUpdate(ctx, runID)
+}
+
+// FindRunTrigger implements Querier
+func (_d QuerierWithTracing) FindRunTrigger(ctx context.Context, runTriggerID pgtype.Text) (f1 FindRunTriggerRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRunTrigger")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":          ctx,
+				"runTriggerID": runTriggerID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRunTrigger(ctx, runTriggerID)
+}
+
+// FindRunTriggersBySourceableWorkspaceID implements Querier
+func (_d QuerierWithTracing) FindRunTriggersBySourceableWorkspaceID(ctx context.Context, sourceableWorkspaceID pgtype.Text) (fa1 []FindRunTriggersBySourceableWorkspaceIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRunTriggersBySourceableWorkspaceID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":                   ctx,
+				"sourceableWorkspaceID": sourceableWorkspaceID}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRunTriggersBySourceableWorkspaceID(ctx, sourceableWorkspaceID)
+}
+
+// FindRunTriggersByWorkspaceID implements Querier
+func (_d QuerierWithTracing) FindRunTriggersByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (fa1 []FindRunTriggersByWorkspaceIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRunTriggersByWorkspaceID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":         ctx,
+				"workspaceID": workspaceID}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRunTriggersByWorkspaceID(ctx, workspaceID)
+}
+
+// FindRunningJobs implements Querier
+func (_d QuerierWithTracing) FindRunningJobs(ctx context.Context) (fa1 []FindRunningJobsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRunningJobs")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRunningJobs(ctx)
+}
+
+// FindRuns implements Querier
+func (_d QuerierWithTracing) FindRuns(ctx context.Context, params FindRunsParams) (fa1 []FindRunsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindRuns")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindRuns(ctx, params)
+}
+
+// FindSchedule implements Querier
+func (_d QuerierWithTracing) FindSchedule(ctx context.Context, scheduleID pgtype.Text) (f1 FindScheduleRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindSchedule")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":        ctx,
+				"scheduleID": scheduleID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindSchedule(ctx, scheduleID)
+}
+
+// FindScheduleForUpdate implements Querier
+func (_d QuerierWithTracing) FindScheduleForUpdate(ctx context.Context, scheduleID pgtype.Text) (f1 FindScheduleForUpdateRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindScheduleForUpdate")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":        ctx,
+				"scheduleID": scheduleID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindScheduleForUpdate(ctx, scheduleID)
+}
+
+// FindSchedulesByWorkspaceID implements Querier
+func (_d QuerierWithTracing) FindSchedulesByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (fa1 []FindSchedulesByWorkspaceIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindSchedulesByWorkspaceID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":         ctx,
+				"workspaceID": workspaceID}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindSchedulesByWorkspaceID(ctx, workspaceID)
+}
+
+// FindServerAgents implements Querier
+func (_d QuerierWithTracing) FindServerAgents(ctx context.Context) (fa1 []FindServerAgentsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindServerAgents")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindServerAgents(ctx)
+}
+
+// FindStaleOrganizationTokens implements Querier
+func (_d QuerierWithTracing) FindStaleOrganizationTokens(ctx context.Context, cutoff pgtype.Timestamptz) (fa1 []FindStaleOrganizationTokensRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindStaleOrganizationTokens")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"cutoff": cutoff}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindStaleOrganizationTokens(ctx, cutoff)
+}
+
+// FindStaleTeamTokens implements Querier
+func (_d QuerierWithTracing) FindStaleTeamTokens(ctx context.Context, cutoff pgtype.Timestamptz) (fa1 []FindStaleTeamTokensRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindStaleTeamTokens")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"cutoff": cutoff}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindStaleTeamTokens(ctx, cutoff)
+}
+
+// FindStaleTokens implements Querier
+func (_d QuerierWithTracing) FindStaleTokens(ctx context.Context, cutoff pgtype.Timestamptz) (fa1 []FindStaleTokensRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindStaleTokens")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"cutoff": cutoff}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindStaleTokens(ctx, cutoff)
+}
+
+// FindStateVersionByID implements Querier
+func (_d QuerierWithTracing) FindStateVersionByID(ctx context.Context, id pgtype.Text) (f1 FindStateVersionByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindStateVersionByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx,
+				"id":  id}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindStateVersionByID(ctx, id)
+}
+
+// FindStateVersionByIDForUpdate implements Querier
+func (_d QuerierWithTracing) FindStateVersionByIDForUpdate(ctx context.Context, id pgtype.Text) (f1 FindStateVersionByIDForUpdateRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindStateVersionByIDForUpdate")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx,
+				"id":  id}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindStateVersionByIDForUpdate(ctx, id)
+}
+
+// FindStateVersionOutputByID implements Querier
+func (_d QuerierWithTracing) FindStateVersionOutputByID(ctx context.Context, id pgtype.Text) (f1 FindStateVersionOutputByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindStateVersionOutputByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx,
+				"id":  id}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindStateVersionOutputByID(ctx, id)
+}
+
+// FindStateVersionStateByID implements Querier
+func (_d QuerierWithTracing) FindStateVersionStateByID(ctx context.Context, id pgtype.Text) (ba1 []byte, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindStateVersionStateByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx,
+				"id":  id}, map[string]interface{}{
+				"ba1": ba1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindStateVersionStateByID(ctx, id)
+}
+
+// FindStateVersionsByWorkspaceID implements Querier
+func (_d QuerierWithTracing) FindStateVersionsByWorkspaceID(ctx context.Context, params FindStateVersionsByWorkspaceIDParams) (fa1 []FindStateVersionsByWorkspaceIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindStateVersionsByWorkspaceID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindStateVersionsByWorkspaceID(ctx, params)
+}
+
+// FindTagByID implements Querier
+func (_d QuerierWithTracing) FindTagByID(ctx context.Context, tagID pgtype.Text, organizationName pgtype.Text) (f1 FindTagByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTagByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":              ctx,
+				"tagID":            tagID,
+				"organizationName": organizationName}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindTagByID(ctx, tagID, organizationName)
+}
+
+// FindTagByName implements Querier
+func (_d QuerierWithTracing) FindTagByName(ctx context.Context, name pgtype.Text, organizationName pgtype.Text) (f1 FindTagByNameRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTagByName")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":              ctx,
+				"name":             name,
+				"organizationName": organizationName}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindTagByName(ctx, name, organizationName)
+}
+
+// FindTags implements Querier
+func (_d QuerierWithTracing) FindTags(ctx context.Context, params FindTagsParams) (fa1 []FindTagsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTags")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindTags(ctx, params)
+}
+
+// FindTeamByID implements Querier
+func (_d QuerierWithTracing) FindTeamByID(ctx context.Context, teamID pgtype.Text) (f1 FindTeamByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTeamByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"teamID": teamID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindTeamByID(ctx, teamID)
+}
+
+// FindTeamByIDForUpdate implements Querier
+func (_d QuerierWithTracing) FindTeamByIDForUpdate(ctx context.Context, teamID pgtype.Text) (f1 FindTeamByIDForUpdateRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTeamByIDForUpdate")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"teamID": teamID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindTeamByIDForUpdate(ctx, teamID)
+}
+
+// FindTeamByName implements Querier
+func (_d QuerierWithTracing) FindTeamByName(ctx context.Context, name pgtype.Text, organizationName pgtype.Text) (f1 FindTeamByNameRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTeamByName")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":              ctx,
+				"name":             name,
+				"organizationName": organizationName}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindTeamByName(ctx, name, organizationName)
+}
+
+// FindTeamByTokenID implements Querier
+func (_d QuerierWithTracing) FindTeamByTokenID(ctx context.Context, tokenID pgtype.Text) (f1 FindTeamByTokenIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTeamByTokenID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":     ctx,
+				"tokenID": tokenID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindTeamByTokenID(ctx, tokenID)
+}
+
+// FindTeamTokensByID implements Querier
+func (_d QuerierWithTracing) FindTeamTokensByID(ctx context.Context, teamID pgtype.Text) (fa1 []FindTeamTokensByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTeamTokensByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"teamID": teamID}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindTeamTokensByID(ctx, teamID)
+}
+
+// FindTeamsByOrg implements Querier
+func (_d QuerierWithTracing) FindTeamsByOrg(ctx context.Context, organizationName pgtype.Text) (fa1 []FindTeamsByOrgRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTeamsByOrg")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":              ctx,
+				"organizationName": organizationName}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindTeamsByOrg(ctx, organizationName)
+}
+
+// FindTokenByID implements Querier
+func (_d QuerierWithTracing) FindTokenByID(ctx context.Context, tokenID pgtype.Text) (f1 FindTokenByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTokenByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":     ctx,
+				"tokenID": tokenID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindTokenByID(ctx, tokenID)
+}
+
+// FindTokensByUsername implements Querier
+func (_d QuerierWithTracing) FindTokensByUsername(ctx context.Context, username pgtype.Text) (fa1 []FindTokensByUsernameRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindTokensByUsername")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"username": username}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindTokensByUsername(ctx, username)
+}
+
+// FindUnreferencedRepohooks implements Querier
+func (_d QuerierWithTracing) FindUnreferencedRepohooks(ctx context.Context) (fa1 []FindUnreferencedRepohooksRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindUnreferencedRepohooks")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindUnreferencedRepohooks(ctx)
+}
+
+// FindUserByAuthenticationTokenID implements Querier
+func (_d QuerierWithTracing) FindUserByAuthenticationTokenID(ctx context.Context, tokenID pgtype.Text) (f1 FindUserByAuthenticationTokenIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindUserByAuthenticationTokenID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":     ctx,
+				"tokenID": tokenID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindUserByAuthenticationTokenID(ctx, tokenID)
+}
+
+// FindUserByID implements Querier
+func (_d QuerierWithTracing) FindUserByID(ctx context.Context, userID pgtype.Text) (f1 FindUserByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindUserByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"userID": userID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindUserByID(ctx, userID)
+}
+
+// FindUserByUsername implements Querier
+func (_d QuerierWithTracing) FindUserByUsername(ctx context.Context, username pgtype.Text) (f1 FindUserByUsernameRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindUserByUsername")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"username": username}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindUserByUsername(ctx, username)
+}
+
+// FindUsers implements Querier
+func (_d QuerierWithTracing) FindUsers(ctx context.Context) (fa1 []FindUsersRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindUsers")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindUsers(ctx)
+}
+
+// FindUsersByOrganization implements Querier
+func (_d QuerierWithTracing) FindUsersByOrganization(ctx context.Context, organizationName pgtype.Text) (fa1 []FindUsersByOrganizationRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindUsersByOrganization")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":              ctx,
+				"organizationName": organizationName}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindUsersByOrganization(ctx, organizationName)
+}
+
+// FindUsersByTeamID implements Querier
+func (_d QuerierWithTracing) FindUsersByTeamID(ctx context.Context, teamID pgtype.Text) (fa1 []FindUsersByTeamIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindUsersByTeamID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"teamID": teamID}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindUsersByTeamID(ctx, teamID)
+}
+
+// FindVCSProvider implements Querier
+func (_d QuerierWithTracing) FindVCSProvider(ctx context.Context, vcsProviderID pgtype.Text) (f1 FindVCSProviderRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVCSProvider")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":           ctx,
+				"vcsProviderID": vcsProviderID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindVCSProvider(ctx, vcsProviderID)
+}
+
+// FindVCSProviderForUpdate implements Querier
+func (_d QuerierWithTracing) FindVCSProviderForUpdate(ctx context.Context, vcsProviderID pgtype.Text) (f1 FindVCSProviderForUpdateRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVCSProviderForUpdate")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":           ctx,
+				"vcsProviderID": vcsProviderID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindVCSProviderForUpdate(ctx, vcsProviderID)
+}
+
+// FindVCSProviders implements Querier
+func (_d QuerierWithTracing) FindVCSProviders(ctx context.Context) (fa1 []FindVCSProvidersRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVCSProviders")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindVCSProviders(ctx)
+}
+
+// FindVCSProvidersByGithubAppInstallID implements Querier
+func (_d QuerierWithTracing) FindVCSProvidersByGithubAppInstallID(ctx context.Context, installID pgtype.Int8) (fa1 []FindVCSProvidersByGithubAppInstallIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVCSProvidersByGithubAppInstallID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":       ctx,
+				"installID": installID}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindVCSProvidersByGithubAppInstallID(ctx, installID)
+}
+
+// FindVCSProvidersByOrganization implements Querier
+func (_d QuerierWithTracing) FindVCSProvidersByOrganization(ctx context.Context, organizationName pgtype.Text) (fa1 []FindVCSProvidersByOrganizationRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVCSProvidersByOrganization")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":              ctx,
+				"organizationName": organizationName}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindVCSProvidersByOrganization(ctx, organizationName)
+}
+
+// FindVariable implements Querier
+func (_d QuerierWithTracing) FindVariable(ctx context.Context, variableID pgtype.Text) (f1 FindVariableRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVariable")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":        ctx,
+				"variableID": variableID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindVariable(ctx, variableID)
+}
+
+// FindVariableSetBySetID implements Querier
+func (_d QuerierWithTracing) FindVariableSetBySetID(ctx context.Context, variableSetID pgtype.Text) (f1 FindVariableSetBySetIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVariableSetBySetID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":           ctx,
+				"variableSetID": variableSetID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindVariableSetBySetID(ctx, variableSetID)
+}
+
+// FindVariableSetByVariableID implements Querier
+func (_d QuerierWithTracing) FindVariableSetByVariableID(ctx context.Context, variableID pgtype.Text) (f1 FindVariableSetByVariableIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVariableSetByVariableID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":        ctx,
+				"variableID": variableID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindVariableSetByVariableID(ctx, variableID)
+}
+
+// FindVariableSetForUpdate implements Querier
+func (_d QuerierWithTracing) FindVariableSetForUpdate(ctx context.Context, variableSetID pgtype.Text) (f1 FindVariableSetForUpdateRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVariableSetForUpdate")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":           ctx,
+				"variableSetID": variableSetID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindVariableSetForUpdate(ctx, variableSetID)
+}
+
+// FindVariableSetsByOrganization implements Querier
+func (_d QuerierWithTracing) FindVariableSetsByOrganization(ctx context.Context, organizationName pgtype.Text) (fa1 []FindVariableSetsByOrganizationRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVariableSetsByOrganization")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":              ctx,
+				"organizationName": organizationName}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindVariableSetsByOrganization(ctx, organizationName)
+}
+
+// FindVariableSetsByWorkspace implements Querier
+func (_d QuerierWithTracing) FindVariableSetsByWorkspace(ctx context.Context, workspaceID pgtype.Text) (fa1 []FindVariableSetsByWorkspaceRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVariableSetsByWorkspace")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":         ctx,
+				"workspaceID": workspaceID}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindVariableSetsByWorkspace(ctx, workspaceID)
+}
+
+// FindWorkspaceByID implements Querier
+func (_d QuerierWithTracing) FindWorkspaceByID(ctx context.Context, id pgtype.Text) (f1 FindWorkspaceByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspaceByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx,
+				"id":  id}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindWorkspaceByID(ctx, id)
+}
+
+// FindWorkspaceByIDForUpdate implements Querier
+func (_d QuerierWithTracing) FindWorkspaceByIDForUpdate(ctx context.Context, id pgtype.Text) (f1 FindWorkspaceByIDForUpdateRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspaceByIDForUpdate")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx,
+				"id":  id}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindWorkspaceByIDForUpdate(ctx, id)
+}
+
+// FindWorkspaceByName implements Querier
+func (_d QuerierWithTracing) FindWorkspaceByName(ctx context.Context, name pgtype.Text, organizationName pgtype.Text) (f1 FindWorkspaceByNameRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspaceByName")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":              ctx,
+				"name":             name,
+				"organizationName": organizationName}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindWorkspaceByName(ctx, name, organizationName)
+}
+
+// FindWorkspacePermissionsByWorkspaceID implements Querier
+func (_d QuerierWithTracing) FindWorkspacePermissionsByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (fa1 []FindWorkspacePermissionsByWorkspaceIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspacePermissionsByWorkspaceID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":         ctx,
+				"workspaceID": workspaceID}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindWorkspacePermissionsByWorkspaceID(ctx, workspaceID)
+}
+
+// FindWorkspaceTags implements Querier
+func (_d QuerierWithTracing) FindWorkspaceTags(ctx context.Context, params FindWorkspaceTagsParams) (fa1 []FindWorkspaceTagsRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspaceTags")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindWorkspaceTags(ctx, params)
+}
+
+// FindWorkspaceVariableByVariableID implements Querier
+func (_d QuerierWithTracing) FindWorkspaceVariableByVariableID(ctx context.Context, variableID pgtype.Text) (f1 FindWorkspaceVariableByVariableIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspaceVariableByVariableID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":        ctx,
+				"variableID": variableID}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindWorkspaceVariableByVariableID(ctx, variableID)
+}
+
+// FindWorkspaceVariablesByWorkspaceID implements Querier
+func (_d QuerierWithTracing) FindWorkspaceVariablesByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (fa1 []FindWorkspaceVariablesByWorkspaceIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspaceVariablesByWorkspaceID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":         ctx,
+				"workspaceID": workspaceID}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindWorkspaceVariablesByWorkspaceID(ctx, workspaceID)
+}
+
+// FindWorkspaces implements Querier
+func (_d QuerierWithTracing) FindWorkspaces(ctx context.Context, params FindWorkspacesParams) (fa1 []FindWorkspacesRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspaces")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindWorkspaces(ctx, params)
+}
+
+// FindWorkspacesByConnection implements Querier
+func (_d QuerierWithTracing) FindWorkspacesByConnection(ctx context.Context, vcsProviderID pgtype.Text, repoPath pgtype.Text) (fa1 []FindWorkspacesByConnectionRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspacesByConnection")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":           ctx,
+				"vcsProviderID": vcsProviderID,
+				"repoPath":      repoPath}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindWorkspacesByConnection(ctx, vcsProviderID, repoPath)
+}
+
+// FindWorkspacesByUsername implements Querier
+func (_d QuerierWithTracing) FindWorkspacesByUsername(ctx context.Context, params FindWorkspacesByUsernameParams) (fa1 []FindWorkspacesByUsernameRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspacesByUsername")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindWorkspacesByUsername(ctx, params)
+}
+
+// FindWorkspacesForAssessment implements Querier
+func (_d QuerierWithTracing) FindWorkspacesForAssessment(ctx context.Context, dueBefore pgtype.Timestamptz) (fa1 []FindWorkspacesForAssessmentRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspacesForAssessment")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":       ctx,
+				"dueBefore": dueBefore}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindWorkspacesForAssessment(ctx, dueBefore)
+}
+
+// FindWorkspacesForAutoDestroy implements Querier
+func (_d QuerierWithTracing) FindWorkspacesForAutoDestroy(ctx context.Context) (fa1 []FindWorkspacesForAutoDestroyRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspacesForAutoDestroy")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.FindWorkspacesForAutoDestroy(ctx)
+}
+
+// GetGPGKey implements Querier
+func (_d QuerierWithTracing) GetGPGKey(ctx context.Context, keyID pgtype.Text, organizationName pgtype.Text) (g1 GetGPGKeyRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.GetGPGKey")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":              ctx,
+				"keyID":            keyID,
+				"organizationName": organizationName}, map[string]interface{}{
+				"g1":  g1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.GetGPGKey(ctx, keyID, organizationName)
+}
+
+// GetLockFileByID implements Querier
+func (_d QuerierWithTracing) GetLockFileByID(ctx context.Context, runID pgtype.Text) (ba1 []byte, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.GetLockFileByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":   ctx,
+				"runID": runID}, map[string]interface{}{
+				"ba1": ba1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.GetLockFileByID(ctx, runID)
+}
+
+// GetPlanBinByID implements Querier
+func (_d QuerierWithTracing) GetPlanBinByID(ctx context.Context, runID pgtype.Text) (ba1 []byte, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.GetPlanBinByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":   ctx,
+				"runID": runID}, map[string]interface{}{
+				"ba1": ba1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.GetPlanBinByID(ctx, runID)
+}
+
+// GetPlanJSONByID implements Querier
+func (_d QuerierWithTracing) GetPlanJSONByID(ctx context.Context, runID pgtype.Text) (ba1 []byte, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.GetPlanJSONByID")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":   ctx,
+				"runID": runID}, map[string]interface{}{
+				"ba1": ba1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.GetPlanJSONByID(ctx, runID)
+}
+
+// InsertAgent implements Querier
+func (_d QuerierWithTracing) InsertAgent(ctx context.Context, params InsertAgentParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertAgent")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertAgent(ctx, params)
+}
+
+// InsertAgentPool implements Querier
+func (_d QuerierWithTracing) InsertAgentPool(ctx context.Context, params InsertAgentPoolParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertAgentPool")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertAgentPool(ctx, params)
+}
+
+// InsertAgentPoolAllowedWorkspace implements Querier
+func (_d QuerierWithTracing) InsertAgentPoolAllowedWorkspace(ctx context.Context, poolID pgtype.Text, workspaceID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertAgentPoolAllowedWorkspace")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":         ctx,
+				"poolID":      poolID,
+				"workspaceID": workspaceID}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertAgentPoolAllowedWorkspace(ctx, poolID, workspaceID)
+}
+
+// InsertAgentToken implements Querier
+func (_d QuerierWithTracing) InsertAgentToken(ctx context.Context, params InsertAgentTokenParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertAgentToken")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertAgentToken(ctx, params)
+}
+
+// InsertApply implements Querier
+func (_d QuerierWithTracing) InsertApply(ctx context.Context, runID pgtype.Text, status pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertApply")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"runID":  runID,
+				"status": status}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertApply(ctx, runID, status)
+}
+
+// InsertAuditEvent implements Querier
+func (_d QuerierWithTracing) InsertAuditEvent(ctx context.Context, params InsertAuditEventParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertAuditEvent")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertAuditEvent(ctx, params)
+}
+
+// InsertConfigurationVersion implements Querier
+func (_d QuerierWithTracing) InsertConfigurationVersion(ctx context.Context, params InsertConfigurationVersionParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertConfigurationVersion")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertConfigurationVersion(ctx, params)
+}
+
+// InsertConfigurationVersionStatusTimestamp implements Querier
+func (_d QuerierWithTracing) InsertConfigurationVersionStatusTimestamp(ctx context.Context, params InsertConfigurationVersionStatusTimestampParams) (i1 InsertConfigurationVersionStatusTimestampRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertConfigurationVersionStatusTimestamp")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"i1":  i1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertConfigurationVersionStatusTimestamp(ctx, params)
+}
+
+// InsertGPGKey implements Querier
+func (_d QuerierWithTracing) InsertGPGKey(ctx context.Context, params InsertGPGKeyParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertGPGKey")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertGPGKey(ctx, params)
+}
+
+// InsertGithubApp implements Querier
+func (_d QuerierWithTracing) InsertGithubApp(ctx context.Context, params InsertGithubAppParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertGithubApp")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertGithubApp(ctx, params)
+}
+
+// InsertGithubAppInstall implements Querier
+func (_d QuerierWithTracing) InsertGithubAppInstall(ctx context.Context, params InsertGithubAppInstallParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertGithubAppInstall")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertGithubAppInstall(ctx, params)
+}
+
+// InsertIngressAttributes implements Querier
+func (_d QuerierWithTracing) InsertIngressAttributes(ctx context.Context, params InsertIngressAttributesParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertIngressAttributes")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertIngressAttributes(ctx, params)
+}
+
+// InsertJob implements Querier
+func (_d QuerierWithTracing) InsertJob(ctx context.Context, params InsertJobParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertJob")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertJob(ctx, params)
+}
+
+// InsertLatestTerraformVersion implements Querier
+func (_d QuerierWithTracing) InsertLatestTerraformVersion(ctx context.Context, version pgtype.Text, engine pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertLatestTerraformVersion")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":     ctx,
+				"version": version,
+				"engine":  engine}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertLatestTerraformVersion(ctx, version, engine)
+}
+
+// InsertLogChunk implements Querier
+func (_d QuerierWithTracing) InsertLogChunk(ctx context.Context, params InsertLogChunkParams) (i1 pgtype.Int4, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertLogChunk")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"i1":  i1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertLogChunk(ctx, params)
+}
+
+// InsertModule implements Querier
+func (_d QuerierWithTracing) InsertModule(ctx context.Context, params InsertModuleParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertModule")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertModule(ctx, params)
+}
+
+// InsertModuleTarball implements Querier
+func (_d QuerierWithTracing) InsertModuleTarball(ctx context.Context, tarball []byte, moduleVersionID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertModuleTarball")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":             ctx,
+				"tarball":         tarball,
+				"moduleVersionID": moduleVersionID}, map[string]interface{}{
+				"t1":  t1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertModuleTarball(ctx, tarball, moduleVersionID)
+}
+
+// InsertModuleVersion implements Querier
+func (_d QuerierWithTracing) InsertModuleVersion(ctx context.Context, params InsertModuleVersionParams) (i1 InsertModuleVersionRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertModuleVersion")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"i1":  i1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertModuleVersion(ctx, params)
+}
+
+// InsertNotificationConfiguration implements Querier
+func (_d QuerierWithTracing) InsertNotificationConfiguration(ctx context.Context, params InsertNotificationConfigurationParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertNotificationConfiguration")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertNotificationConfiguration(ctx, params)
+}
+
+// InsertOrganization implements Querier
+func (_d QuerierWithTracing) InsertOrganization(ctx context.Context, params InsertOrganizationParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertOrganization")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertOrganization(ctx, params)
+}
+
+// InsertPhaseStatusTimestamp implements Querier
+func (_d QuerierWithTracing) InsertPhaseStatusTimestamp(ctx context.Context, params InsertPhaseStatusTimestampParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertPhaseStatusTimestamp")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.Querier.InsertPhaseStatusTimestamp(ctx, params)
 }
 
-// FindVariableSetsByWorkspace implements Querier
-func (_d QuerierWithTracing) FindVariableSetsByWorkspace(ctx context.Context, workspaceID pgtype.Text) (fa1 []FindVariableSetsByWorkspaceRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindVariableSetsByWorkspace")
+// InsertPlan implements Querier
+func (_d QuerierWithTracing) InsertPlan(ctx context.Context, runID pgtype.Text, status pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertPlan")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"workspaceID": workspaceID}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":    ctx,
+				"runID":  runID,
+				"status": status}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2961,18 +5664,18 @@ func (_d QuerierWithTracing) FindVariableSetsByWorkspace(ctx context.Context, wo
 
 		_span.End()
 	}()
-	return _d.Querier.FindVariableSetsByWorkspace(ctx, workspaceID)
+	return _d.Querier.InsertPlan(ctx, runID, status)
 }
 
-// FindWorkspaceByID implements Querier
-func (_d QuerierWithTracing) FindWorkspaceByID(ctx context.Context, id pgtype.Text) (f1 FindWorkspaceByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspaceByID")
+// InsertPolicy implements Querier
+func (_d QuerierWithTracing) InsertPolicy(ctx context.Context, params InsertPolicyParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertPolicy")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx,
-				"id":  id}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -2984,18 +5687,18 @@ func (_d QuerierWithTracing) FindWorkspaceByID(ctx context.Context, id pgtype.Te
 
 		_span.End()
 	}()
-	return _d.Querier.FindWorkspaceByID(ctx, id)
+	return _d.Querier.InsertPolicy(ctx, params)
 }
 
-// FindWorkspaceByIDForUpdate implements Querier
-func (_d QuerierWithTracing) FindWorkspaceByIDForUpdate(ctx context.Context, id pgtype.Text) (f1 FindWorkspaceByIDForUpdateRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspaceByIDForUpdate")
+// InsertPolicyCheck implements Querier
+func (_d QuerierWithTracing) InsertPolicyCheck(ctx context.Context, params InsertPolicyCheckParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertPolicyCheck")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx,
-				"id":  id}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3007,19 +5710,18 @@ func (_d QuerierWithTracing) FindWorkspaceByIDForUpdate(ctx context.Context, id
 
 		_span.End()
 	}()
-	return _d.Querier.FindWorkspaceByIDForUpdate(ctx, id)
+	return _d.Querier.InsertPolicyCheck(ctx, params)
 }
 
-// FindWorkspaceByName implements Querier
-func (_d QuerierWithTracing) FindWorkspaceByName(ctx context.Context, name pgtype.Text, organizationName pgtype.Text) (f1 FindWorkspaceByNameRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspaceByName")
+// InsertPolicySet implements Querier
+func (_d QuerierWithTracing) InsertPolicySet(ctx context.Context, params InsertPolicySetParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertPolicySet")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":              ctx,
-				"name":             name,
-				"organizationName": organizationName}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3031,18 +5733,18 @@ func (_d QuerierWithTracing) FindWorkspaceByName(ctx context.Context, name pgtyp
 
 		_span.End()
 	}()
-	return _d.Querier.FindWorkspaceByName(ctx, name, organizationName)
+	return _d.Querier.InsertPolicySet(ctx, params)
 }
 
-// FindWorkspacePermissionsByWorkspaceID implements Querier
-func (_d QuerierWithTracing) FindWorkspacePermissionsByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (fa1 []FindWorkspacePermissionsByWorkspaceIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspacePermissionsByWorkspaceID")
+// InsertProject implements Querier
+func (_d QuerierWithTracing) InsertProject(ctx context.Context, params InsertProjectParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertProject")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"workspaceID": workspaceID}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3054,18 +5756,18 @@ func (_d QuerierWithTracing) FindWorkspacePermissionsByWorkspaceID(ctx context.C
 
 		_span.End()
 	}()
-	return _d.Querier.FindWorkspacePermissionsByWorkspaceID(ctx, workspaceID)
+	return _d.Querier.InsertProject(ctx, params)
 }
 
-// FindWorkspaceTags implements Querier
-func (_d QuerierWithTracing) FindWorkspaceTags(ctx context.Context, params FindWorkspaceTagsParams) (fa1 []FindWorkspaceTagsRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspaceTags")
+// InsertProviderMirrorArchive implements Querier
+func (_d QuerierWithTracing) InsertProviderMirrorArchive(ctx context.Context, params InsertProviderMirrorArchiveParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertProviderMirrorArchive")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"fa1": fa1,
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3077,18 +5779,18 @@ func (_d QuerierWithTracing) FindWorkspaceTags(ctx context.Context, params FindW
 
 		_span.End()
 	}()
-	return _d.Querier.FindWorkspaceTags(ctx, params)
+	return _d.Querier.InsertProviderMirrorArchive(ctx, params)
 }
 
-// FindWorkspaceVariableByVariableID implements Querier
-func (_d QuerierWithTracing) FindWorkspaceVariableByVariableID(ctx context.Context, variableID pgtype.Text) (f1 FindWorkspaceVariableByVariableIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspaceVariableByVariableID")
+// InsertProviderMirrorVersion implements Querier
+func (_d QuerierWithTracing) InsertProviderMirrorVersion(ctx context.Context, params InsertProviderMirrorVersionParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertProviderMirrorVersion")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":        ctx,
-				"variableID": variableID}, map[string]interface{}{
-				"f1":  f1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3100,18 +5802,18 @@ func (_d QuerierWithTracing) FindWorkspaceVariableByVariableID(ctx context.Conte
 
 		_span.End()
 	}()
-	return _d.Querier.FindWorkspaceVariableByVariableID(ctx, variableID)
+	return _d.Querier.InsertProviderMirrorVersion(ctx, params)
 }
 
-// FindWorkspaceVariablesByWorkspaceID implements Querier
-func (_d QuerierWithTracing) FindWorkspaceVariablesByWorkspaceID(ctx context.Context, workspaceID pgtype.Text) (fa1 []FindWorkspaceVariablesByWorkspaceIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspaceVariablesByWorkspaceID")
+// InsertRegistryProvider implements Querier
+func (_d QuerierWithTracing) InsertRegistryProvider(ctx context.Context, params InsertRegistryProviderParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertRegistryProvider")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"workspaceID": workspaceID}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3123,18 +5825,18 @@ func (_d QuerierWithTracing) FindWorkspaceVariablesByWorkspaceID(ctx context.Con
 
 		_span.End()
 	}()
-	return _d.Querier.FindWorkspaceVariablesByWorkspaceID(ctx, workspaceID)
+	return _d.Querier.InsertRegistryProvider(ctx, params)
 }
 
-// FindWorkspaces implements Querier
-func (_d QuerierWithTracing) FindWorkspaces(ctx context.Context, params FindWorkspacesParams) (fa1 []FindWorkspacesRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspaces")
+// InsertRegistryProviderPlatform implements Querier
+func (_d QuerierWithTracing) InsertRegistryProviderPlatform(ctx context.Context, params InsertRegistryProviderPlatformParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertRegistryProviderPlatform")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"fa1": fa1,
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3146,19 +5848,18 @@ func (_d QuerierWithTracing) FindWorkspaces(ctx context.Context, params FindWork
 
 		_span.End()
 	}()
-	return _d.Querier.FindWorkspaces(ctx, params)
+	return _d.Querier.InsertRegistryProviderPlatform(ctx, params)
 }
 
-// FindWorkspacesByConnection implements Querier
-func (_d QuerierWithTracing) FindWorkspacesByConnection(ctx context.Context, vcsProviderID pgtype.Text, repoPath pgtype.Text) (fa1 []FindWorkspacesByConnectionRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspacesByConnection")
+// InsertRegistryProviderVersion implements Querier
+func (_d QuerierWithTracing) InsertRegistryProviderVersion(ctx context.Context, params InsertRegistryProviderVersionParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertRegistryProviderVersion")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":           ctx,
-				"vcsProviderID": vcsProviderID,
-				"repoPath":      repoPath}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3170,18 +5871,18 @@ func (_d QuerierWithTracing) FindWorkspacesByConnection(ctx context.Context, vcs
 
 		_span.End()
 	}()
-	return _d.Querier.FindWorkspacesByConnection(ctx, vcsProviderID, repoPath)
+	return _d.Querier.InsertRegistryProviderVersion(ctx, params)
 }
 
-// FindWorkspacesByUsername implements Querier
-func (_d QuerierWithTracing) FindWorkspacesByUsername(ctx context.Context, params FindWorkspacesByUsernameParams) (fa1 []FindWorkspacesByUsernameRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindWorkspacesByUsername")
+// InsertRepoConnection implements Querier
+func (_d QuerierWithTracing) InsertRepoConnection(ctx context.Context, params InsertRepoConnectionParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertRepoConnection")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"fa1": fa1,
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3193,19 +5894,18 @@ func (_d QuerierWithTracing) FindWorkspacesByUsername(ctx context.Context, param
 
 		_span.End()
 	}()
-	return _d.Querier.FindWorkspacesByUsername(ctx, params)
+	return _d.Querier.InsertRepoConnection(ctx, params)
 }
 
-// GetGPGKey implements Querier
-func (_d QuerierWithTracing) GetGPGKey(ctx context.Context, keyID pgtype.Text, organizationName pgtype.Text) (g1 GetGPGKeyRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.GetGPGKey")
+// InsertRepohook implements Querier
+func (_d QuerierWithTracing) InsertRepohook(ctx context.Context, params InsertRepohookParams) (i1 InsertRepohookRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertRepohook")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":              ctx,
-				"keyID":            keyID,
-				"organizationName": organizationName}, map[string]interface{}{
-				"g1":  g1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"i1":  i1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3217,18 +5917,18 @@ func (_d QuerierWithTracing) GetGPGKey(ctx context.Context, keyID pgtype.Text, o
 
 		_span.End()
 	}()
-	return _d.Querier.GetGPGKey(ctx, keyID, organizationName)
+	return _d.Querier.InsertRepohook(ctx, params)
 }
 
-// GetLockFileByID implements Querier
-func (_d QuerierWithTracing) GetLockFileByID(ctx context.Context, runID pgtype.Text) (ba1 []byte, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.GetLockFileByID")
+// InsertRun implements Querier
+func (_d QuerierWithTracing) InsertRun(ctx context.Context, params InsertRunParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertRun")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":   ctx,
-				"runID": runID}, map[string]interface{}{
-				"ba1": ba1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3240,18 +5940,18 @@ func (_d QuerierWithTracing) GetLockFileByID(ctx context.Context, runID pgtype.T
 
 		_span.End()
 	}()
-	return _d.Querier.GetLockFileByID(ctx, runID)
+	return _d.Querier.InsertRun(ctx, params)
 }
 
-// GetPlanBinByID implements Querier
-func (_d QuerierWithTracing) GetPlanBinByID(ctx context.Context, runID pgtype.Text) (ba1 []byte, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.GetPlanBinByID")
+// InsertRunStatusTimestamp implements Querier
+func (_d QuerierWithTracing) InsertRunStatusTimestamp(ctx context.Context, params InsertRunStatusTimestampParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertRunStatusTimestamp")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":   ctx,
-				"runID": runID}, map[string]interface{}{
-				"ba1": ba1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3263,18 +5963,18 @@ func (_d QuerierWithTracing) GetPlanBinByID(ctx context.Context, runID pgtype.Te
 
 		_span.End()
 	}()
-	return _d.Querier.GetPlanBinByID(ctx, runID)
+	return _d.Querier.InsertRunStatusTimestamp(ctx, params)
 }
 
-// GetPlanJSONByID implements Querier
-func (_d QuerierWithTracing) GetPlanJSONByID(ctx context.Context, runID pgtype.Text) (ba1 []byte, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.GetPlanJSONByID")
+// InsertRunTrigger implements Querier
+func (_d QuerierWithTracing) InsertRunTrigger(ctx context.Context, params InsertRunTriggerParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertRunTrigger")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":   ctx,
-				"runID": runID}, map[string]interface{}{
-				"ba1": ba1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3286,12 +5986,12 @@ func (_d QuerierWithTracing) GetPlanJSONByID(ctx context.Context, runID pgtype.T
 
 		_span.End()
 	}()
-	return _d.Querier.GetPlanJSONByID(ctx, runID)
+	return _d.Querier.InsertRunTrigger(ctx, params)
 }
 
-// InsertAgent implements Querier
-func (_d QuerierWithTracing) InsertAgent(ctx context.Context, params InsertAgentParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertAgent")
+// InsertRunVariable implements Querier
+func (_d QuerierWithTracing) InsertRunVariable(ctx context.Context, params InsertRunVariableParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertRunVariable")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
@@ -3309,12 +6009,12 @@ func (_d QuerierWithTracing) InsertAgent(ctx context.Context, params InsertAgent
 
 		_span.End()
 	}()
-	return _d.Querier.InsertAgent(ctx, params)
+	return _d.Querier.InsertRunVariable(ctx, params)
 }
 
-// InsertAgentPool implements Querier
-func (_d QuerierWithTracing) InsertAgentPool(ctx context.Context, params InsertAgentPoolParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertAgentPool")
+// InsertSchedule implements Querier
+func (_d QuerierWithTracing) InsertSchedule(ctx context.Context, params InsertScheduleParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertSchedule")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
@@ -3332,18 +6032,17 @@ func (_d QuerierWithTracing) InsertAgentPool(ctx context.Context, params InsertA
 
 		_span.End()
 	}()
-	return _d.Querier.InsertAgentPool(ctx, params)
+	return _d.Querier.InsertSchedule(ctx, params)
 }
 
-// InsertAgentPoolAllowedWorkspace implements Querier
-func (_d QuerierWithTracing) InsertAgentPoolAllowedWorkspace(ctx context.Context, poolID pgtype.Text, workspaceID pgtype.Text) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertAgentPoolAllowedWorkspace")
+// InsertStateVersion implements Querier
+func (_d QuerierWithTracing) InsertStateVersion(ctx context.Context, params InsertStateVersionParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertStateVersion")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"poolID":      poolID,
-				"workspaceID": workspaceID}, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
 				"c2":  c2,
 				"err": err})
 		} else if err != nil {
@@ -3356,12 +6055,12 @@ func (_d QuerierWithTracing) InsertAgentPoolAllowedWorkspace(ctx context.Context
 
 		_span.End()
 	}()
-	return _d.Querier.InsertAgentPoolAllowedWorkspace(ctx, poolID, workspaceID)
+	return _d.Querier.InsertStateVersion(ctx, params)
 }
 
-// InsertAgentToken implements Querier
-func (_d QuerierWithTracing) InsertAgentToken(ctx context.Context, params InsertAgentTokenParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertAgentToken")
+// InsertStateVersionOutput implements Querier
+func (_d QuerierWithTracing) InsertStateVersionOutput(ctx context.Context, params InsertStateVersionOutputParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertStateVersionOutput")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
@@ -3379,18 +6078,17 @@ func (_d QuerierWithTracing) InsertAgentToken(ctx context.Context, params Insert
 
 		_span.End()
 	}()
-	return _d.Querier.InsertAgentToken(ctx, params)
+	return _d.Querier.InsertStateVersionOutput(ctx, params)
 }
 
-// InsertApply implements Querier
-func (_d QuerierWithTracing) InsertApply(ctx context.Context, runID pgtype.Text, status pgtype.Text) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertApply")
+// InsertTag implements Querier
+func (_d QuerierWithTracing) InsertTag(ctx context.Context, params InsertTagParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertTag")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
-				"runID":  runID,
-				"status": status}, map[string]interface{}{
+				"params": params}, map[string]interface{}{
 				"c2":  c2,
 				"err": err})
 		} else if err != nil {
@@ -3403,12 +6101,12 @@ func (_d QuerierWithTracing) InsertApply(ctx context.Context, runID pgtype.Text,
 
 		_span.End()
 	}()
-	return _d.Querier.InsertApply(ctx, runID, status)
+	return _d.Querier.InsertTag(ctx, params)
 }
 
-// InsertConfigurationVersion implements Querier
-func (_d QuerierWithTracing) InsertConfigurationVersion(ctx context.Context, params InsertConfigurationVersionParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertConfigurationVersion")
+// InsertTeam implements Querier
+func (_d QuerierWithTracing) InsertTeam(ctx context.Context, params InsertTeamParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertTeam")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
@@ -3426,18 +6124,19 @@ func (_d QuerierWithTracing) InsertConfigurationVersion(ctx context.Context, par
 
 		_span.End()
 	}()
-	return _d.Querier.InsertConfigurationVersion(ctx, params)
+	return _d.Querier.InsertTeam(ctx, params)
 }
 
-// InsertConfigurationVersionStatusTimestamp implements Querier
-func (_d QuerierWithTracing) InsertConfigurationVersionStatusTimestamp(ctx context.Context, params InsertConfigurationVersionStatusTimestampParams) (i1 InsertConfigurationVersionStatusTimestampRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertConfigurationVersionStatusTimestamp")
+// InsertTeamMembership implements Querier
+func (_d QuerierWithTracing) InsertTeamMembership(ctx context.Context, usernames []string, teamID pgtype.Text) (ta1 []pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertTeamMembership")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"i1":  i1,
+				"ctx":       ctx,
+				"usernames": usernames,
+				"teamID":    teamID}, map[string]interface{}{
+				"ta1": ta1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3449,12 +6148,12 @@ func (_d QuerierWithTracing) InsertConfigurationVersionStatusTimestamp(ctx conte
 
 		_span.End()
 	}()
-	return _d.Querier.InsertConfigurationVersionStatusTimestamp(ctx, params)
+	return _d.Querier.InsertTeamMembership(ctx, usernames, teamID)
 }
 
-// InsertGPGKey implements Querier
-func (_d QuerierWithTracing) InsertGPGKey(ctx context.Context, params InsertGPGKeyParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertGPGKey")
+// InsertTeamToken implements Querier
+func (_d QuerierWithTracing) InsertTeamToken(ctx context.Context, params InsertTeamTokenParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertTeamToken")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
@@ -3472,12 +6171,12 @@ func (_d QuerierWithTracing) InsertGPGKey(ctx context.Context, params InsertGPGK
 
 		_span.End()
 	}()
-	return _d.Querier.InsertGPGKey(ctx, params)
+	return _d.Querier.InsertTeamToken(ctx, params)
 }
 
-// InsertGithubApp implements Querier
-func (_d QuerierWithTracing) InsertGithubApp(ctx context.Context, params InsertGithubAppParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertGithubApp")
+// InsertToken implements Querier
+func (_d QuerierWithTracing) InsertToken(ctx context.Context, params InsertTokenParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertToken")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
@@ -3495,12 +6194,12 @@ func (_d QuerierWithTracing) InsertGithubApp(ctx context.Context, params InsertG
 
 		_span.End()
 	}()
-	return _d.Querier.InsertGithubApp(ctx, params)
+	return _d.Querier.InsertToken(ctx, params)
 }
 
-// InsertGithubAppInstall implements Querier
-func (_d QuerierWithTracing) InsertGithubAppInstall(ctx context.Context, params InsertGithubAppInstallParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertGithubAppInstall")
+// InsertUser implements Querier
+func (_d QuerierWithTracing) InsertUser(ctx context.Context, params InsertUserParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertUser")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
@@ -3518,12 +6217,12 @@ func (_d QuerierWithTracing) InsertGithubAppInstall(ctx context.Context, params
 
 		_span.End()
 	}()
-	return _d.Querier.InsertGithubAppInstall(ctx, params)
+	return _d.Querier.InsertUser(ctx, params)
 }
 
-// InsertIngressAttributes implements Querier
-func (_d QuerierWithTracing) InsertIngressAttributes(ctx context.Context, params InsertIngressAttributesParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertIngressAttributes")
+// InsertVCSProvider implements Querier
+func (_d QuerierWithTracing) InsertVCSProvider(ctx context.Context, params InsertVCSProviderParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertVCSProvider")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
@@ -3541,12 +6240,12 @@ func (_d QuerierWithTracing) InsertIngressAttributes(ctx context.Context, params
 
 		_span.End()
 	}()
-	return _d.Querier.InsertIngressAttributes(ctx, params)
+	return _d.Querier.InsertVCSProvider(ctx, params)
 }
 
-// InsertJob implements Querier
-func (_d QuerierWithTracing) InsertJob(ctx context.Context, params InsertJobParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertJob")
+// InsertVariable implements Querier
+func (_d QuerierWithTracing) InsertVariable(ctx context.Context, params InsertVariableParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertVariable")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
@@ -3564,17 +6263,17 @@ func (_d QuerierWithTracing) InsertJob(ctx context.Context, params InsertJobPara
 
 		_span.End()
 	}()
-	return _d.Querier.InsertJob(ctx, params)
+	return _d.Querier.InsertVariable(ctx, params)
 }
 
-// InsertLatestTerraformVersion implements Querier
-func (_d QuerierWithTracing) InsertLatestTerraformVersion(ctx context.Context, version pgtype.Text) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertLatestTerraformVersion")
+// InsertVariableSet implements Querier
+func (_d QuerierWithTracing) InsertVariableSet(ctx context.Context, params InsertVariableSetParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertVariableSet")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":     ctx,
-				"version": version}, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
 				"c2":  c2,
 				"err": err})
 		} else if err != nil {
@@ -3587,18 +6286,19 @@ func (_d QuerierWithTracing) InsertLatestTerraformVersion(ctx context.Context, v
 
 		_span.End()
 	}()
-	return _d.Querier.InsertLatestTerraformVersion(ctx, version)
+	return _d.Querier.InsertVariableSet(ctx, params)
 }
 
-// InsertLogChunk implements Querier
-func (_d QuerierWithTracing) InsertLogChunk(ctx context.Context, params InsertLogChunkParams) (i1 pgtype.Int4, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertLogChunk")
+// InsertVariableSetVariable implements Querier
+func (_d QuerierWithTracing) InsertVariableSetVariable(ctx context.Context, variableSetID pgtype.Text, variableID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertVariableSetVariable")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"i1":  i1,
+				"ctx":           ctx,
+				"variableSetID": variableSetID,
+				"variableID":    variableID}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3610,17 +6310,18 @@ func (_d QuerierWithTracing) InsertLogChunk(ctx context.Context, params InsertLo
 
 		_span.End()
 	}()
-	return _d.Querier.InsertLogChunk(ctx, params)
+	return _d.Querier.InsertVariableSetVariable(ctx, variableSetID, variableID)
 }
 
-// InsertModule implements Querier
-func (_d QuerierWithTracing) InsertModule(ctx context.Context, params InsertModuleParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertModule")
+// InsertVariableSetWorkspace implements Querier
+func (_d QuerierWithTracing) InsertVariableSetWorkspace(ctx context.Context, variableSetID pgtype.Text, workspaceID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertVariableSetWorkspace")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
+				"ctx":           ctx,
+				"variableSetID": variableSetID,
+				"workspaceID":   workspaceID}, map[string]interface{}{
 				"c2":  c2,
 				"err": err})
 		} else if err != nil {
@@ -3633,19 +6334,18 @@ func (_d QuerierWithTracing) InsertModule(ctx context.Context, params InsertModu
 
 		_span.End()
 	}()
-	return _d.Querier.InsertModule(ctx, params)
+	return _d.Querier.InsertVariableSetWorkspace(ctx, variableSetID, workspaceID)
 }
 
-// InsertModuleTarball implements Querier
-func (_d QuerierWithTracing) InsertModuleTarball(ctx context.Context, tarball []byte, moduleVersionID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertModuleTarball")
+// InsertWorkspace implements Querier
+func (_d QuerierWithTracing) InsertWorkspace(ctx context.Context, params InsertWorkspaceParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertWorkspace")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":             ctx,
-				"tarball":         tarball,
-				"moduleVersionID": moduleVersionID}, map[string]interface{}{
-				"t1":  t1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3657,18 +6357,19 @@ func (_d QuerierWithTracing) InsertModuleTarball(ctx context.Context, tarball []
 
 		_span.End()
 	}()
-	return _d.Querier.InsertModuleTarball(ctx, tarball, moduleVersionID)
+	return _d.Querier.InsertWorkspace(ctx, params)
 }
 
-// InsertModuleVersion implements Querier
-func (_d QuerierWithTracing) InsertModuleVersion(ctx context.Context, params InsertModuleVersionParams) (i1 InsertModuleVersionRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertModuleVersion")
+// InsertWorkspaceTag implements Querier
+func (_d QuerierWithTracing) InsertWorkspaceTag(ctx context.Context, tagID pgtype.Text, workspaceID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertWorkspaceTag")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"i1":  i1,
+				"ctx":         ctx,
+				"tagID":       tagID,
+				"workspaceID": workspaceID}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3680,18 +6381,19 @@ func (_d QuerierWithTracing) InsertModuleVersion(ctx context.Context, params Ins
 
 		_span.End()
 	}()
-	return _d.Querier.InsertModuleVersion(ctx, params)
+	return _d.Querier.InsertWorkspaceTag(ctx, tagID, workspaceID)
 }
 
-// InsertNotificationConfiguration implements Querier
-func (_d QuerierWithTracing) InsertNotificationConfiguration(ctx context.Context, params InsertNotificationConfigurationParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertNotificationConfiguration")
+// InsertWorkspaceTagByName implements Querier
+func (_d QuerierWithTracing) InsertWorkspaceTagByName(ctx context.Context, workspaceID pgtype.Text, tagName pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertWorkspaceTagByName")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"ctx":         ctx,
+				"workspaceID": workspaceID,
+				"tagName":     tagName}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3703,17 +6405,18 @@ func (_d QuerierWithTracing) InsertNotificationConfiguration(ctx context.Context
 
 		_span.End()
 	}()
-	return _d.Querier.InsertNotificationConfiguration(ctx, params)
+	return _d.Querier.InsertWorkspaceTagByName(ctx, workspaceID, tagName)
 }
 
-// InsertOrganization implements Querier
-func (_d QuerierWithTracing) InsertOrganization(ctx context.Context, params InsertOrganizationParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertOrganization")
+// InsertWorkspaceVariable implements Querier
+func (_d QuerierWithTracing) InsertWorkspaceVariable(ctx context.Context, variableID pgtype.Text, workspaceID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertWorkspaceVariable")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
+				"ctx":         ctx,
+				"variableID":  variableID,
+				"workspaceID": workspaceID}, map[string]interface{}{
 				"c2":  c2,
 				"err": err})
 		} else if err != nil {
@@ -3726,18 +6429,18 @@ func (_d QuerierWithTracing) InsertOrganization(ctx context.Context, params Inse
 
 		_span.End()
 	}()
-	return _d.Querier.InsertOrganization(ctx, params)
+	return _d.Querier.InsertWorkspaceVariable(ctx, variableID, workspaceID)
 }
 
-// InsertPhaseStatusTimestamp implements Querier
-func (_d QuerierWithTracing) InsertPhaseStatusTimestamp(ctx context.Context, params InsertPhaseStatusTimestampParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertPhaseStatusTimestamp")
+// ListGPGKeys implements Querier
+func (_d QuerierWithTracing) ListGPGKeys(ctx context.Context, organizationNames []string) (la1 []ListGPGKeysRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.ListGPGKeys")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"ctx":               ctx,
+				"organizationNames": organizationNames}, map[string]interface{}{
+				"la1": la1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3749,19 +6452,18 @@ func (_d QuerierWithTracing) InsertPhaseStatusTimestamp(ctx context.Context, par
 
 		_span.End()
 	}()
-	return _d.Querier.InsertPhaseStatusTimestamp(ctx, params)
+	return _d.Querier.ListGPGKeys(ctx, organizationNames)
 }
 
-// InsertPlan implements Querier
-func (_d QuerierWithTracing) InsertPlan(ctx context.Context, runID pgtype.Text, status pgtype.Text) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertPlan")
+// ListModulesByOrganization implements Querier
+func (_d QuerierWithTracing) ListModulesByOrganization(ctx context.Context, organizationName pgtype.Text) (la1 []ListModulesByOrganizationRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.ListModulesByOrganization")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"runID":  runID,
-				"status": status}, map[string]interface{}{
-				"c2":  c2,
+				"ctx":              ctx,
+				"organizationName": organizationName}, map[string]interface{}{
+				"la1": la1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3773,18 +6475,19 @@ func (_d QuerierWithTracing) InsertPlan(ctx context.Context, runID pgtype.Text,
 
 		_span.End()
 	}()
-	return _d.Querier.InsertPlan(ctx, runID, status)
+	return _d.Querier.ListModulesByOrganization(ctx, organizationName)
 }
 
-// InsertRepoConnection implements Querier
-func (_d QuerierWithTracing) InsertRepoConnection(ctx context.Context, params InsertRepoConnectionParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertRepoConnection")
+// PutLockFile implements Querier
+func (_d QuerierWithTracing) PutLockFile(ctx context.Context, lockFile []byte, runID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.PutLockFile")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"ctx":      ctx,
+				"lockFile": lockFile,
+				"runID":    runID}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3796,18 +6499,17 @@ func (_d QuerierWithTracing) InsertRepoConnection(ctx context.Context, params In
 
 		_span.End()
 	}()
-	return _d.Querier.InsertRepoConnection(ctx, params)
+	return _d.Querier.PutLockFile(ctx, lockFile, runID)
 }
 
-// InsertRepohook implements Querier
-func (_d QuerierWithTracing) InsertRepohook(ctx context.Context, params InsertRepohookParams) (i1 InsertRepohookRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertRepohook")
+// ResetUserSiteAdmins implements Querier
+func (_d QuerierWithTracing) ResetUserSiteAdmins(ctx context.Context) (ta1 []pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.ResetUserSiteAdmins")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"i1":  i1,
+				"ctx": ctx}, map[string]interface{}{
+				"ta1": ta1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3819,18 +6521,18 @@ func (_d QuerierWithTracing) InsertRepohook(ctx context.Context, params InsertRe
 
 		_span.End()
 	}()
-	return _d.Querier.InsertRepohook(ctx, params)
+	return _d.Querier.ResetUserSiteAdmins(ctx)
 }
 
-// InsertRun implements Querier
-func (_d QuerierWithTracing) InsertRun(ctx context.Context, params InsertRunParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertRun")
+// UpdateAgent implements Querier
+func (_d QuerierWithTracing) UpdateAgent(ctx context.Context, params UpdateAgentParams) (u1 UpdateAgentRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateAgent")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"u1":  u1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3842,18 +6544,18 @@ func (_d QuerierWithTracing) InsertRun(ctx context.Context, params InsertRunPara
 
 		_span.End()
 	}()
-	return _d.Querier.InsertRun(ctx, params)
+	return _d.Querier.UpdateAgent(ctx, params)
 }
 
-// InsertRunStatusTimestamp implements Querier
-func (_d QuerierWithTracing) InsertRunStatusTimestamp(ctx context.Context, params InsertRunStatusTimestampParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertRunStatusTimestamp")
+// UpdateAgentPool implements Querier
+func (_d QuerierWithTracing) UpdateAgentPool(ctx context.Context, params UpdateAgentPoolParams) (u1 UpdateAgentPoolRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateAgentPool")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"u1":  u1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3865,18 +6567,18 @@ func (_d QuerierWithTracing) InsertRunStatusTimestamp(ctx context.Context, param
 
 		_span.End()
 	}()
-	return _d.Querier.InsertRunStatusTimestamp(ctx, params)
+	return _d.Querier.UpdateAgentPool(ctx, params)
 }
 
-// InsertRunVariable implements Querier
-func (_d QuerierWithTracing) InsertRunVariable(ctx context.Context, params InsertRunVariableParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertRunVariable")
+// UpdateAppliedChangesByID implements Querier
+func (_d QuerierWithTracing) UpdateAppliedChangesByID(ctx context.Context, params UpdateAppliedChangesByIDParams) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateAppliedChangesByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3888,18 +6590,19 @@ func (_d QuerierWithTracing) InsertRunVariable(ctx context.Context, params Inser
 
 		_span.End()
 	}()
-	return _d.Querier.InsertRunVariable(ctx, params)
+	return _d.Querier.UpdateAppliedChangesByID(ctx, params)
 }
 
-// InsertStateVersion implements Querier
-func (_d QuerierWithTracing) InsertStateVersion(ctx context.Context, params InsertStateVersionParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertStateVersion")
+// UpdateApplyStatusByID implements Querier
+func (_d QuerierWithTracing) UpdateApplyStatusByID(ctx context.Context, status pgtype.Text, runID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateApplyStatusByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"status": status,
+				"runID":  runID}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3911,18 +6614,19 @@ func (_d QuerierWithTracing) InsertStateVersion(ctx context.Context, params Inse
 
 		_span.End()
 	}()
-	return _d.Querier.InsertStateVersion(ctx, params)
+	return _d.Querier.UpdateApplyStatusByID(ctx, status, runID)
 }
 
-// InsertStateVersionOutput implements Querier
-func (_d QuerierWithTracing) InsertStateVersionOutput(ctx context.Context, params InsertStateVersionOutputParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertStateVersionOutput")
+// UpdateCancelSignaledAt implements Querier
+func (_d QuerierWithTracing) UpdateCancelSignaledAt(ctx context.Context, cancelSignaledAt pgtype.Timestamptz, id pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateCancelSignaledAt")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"ctx":              ctx,
+				"cancelSignaledAt": cancelSignaledAt,
+				"id":               id}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3934,18 +6638,19 @@ func (_d QuerierWithTracing) InsertStateVersionOutput(ctx context.Context, param
 
 		_span.End()
 	}()
-	return _d.Querier.InsertStateVersionOutput(ctx, params)
+	return _d.Querier.UpdateCancelSignaledAt(ctx, cancelSignaledAt, id)
 }
 
-// InsertTag implements Querier
-func (_d QuerierWithTracing) InsertTag(ctx context.Context, params InsertTagParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertTag")
+// UpdateConfigurationVersionConfigByID implements Querier
+func (_d QuerierWithTracing) UpdateConfigurationVersionConfigByID(ctx context.Context, config []byte, id pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateConfigurationVersionConfigByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"config": config,
+				"id":     id}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3957,18 +6662,18 @@ func (_d QuerierWithTracing) InsertTag(ctx context.Context, params InsertTagPara
 
 		_span.End()
 	}()
-	return _d.Querier.InsertTag(ctx, params)
+	return _d.Querier.UpdateConfigurationVersionConfigByID(ctx, config, id)
 }
 
-// InsertTeam implements Querier
-func (_d QuerierWithTracing) InsertTeam(ctx context.Context, params InsertTeamParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertTeam")
+// UpdateConfigurationVersionErroredByID implements Querier
+func (_d QuerierWithTracing) UpdateConfigurationVersionErroredByID(ctx context.Context, id pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateConfigurationVersionErroredByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"ctx": ctx,
+				"id":  id}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -3980,19 +6685,18 @@ func (_d QuerierWithTracing) InsertTeam(ctx context.Context, params InsertTeamPa
 
 		_span.End()
 	}()
-	return _d.Querier.InsertTeam(ctx, params)
+	return _d.Querier.UpdateConfigurationVersionErroredByID(ctx, id)
 }
 
-// InsertTeamMembership implements Querier
-func (_d QuerierWithTracing) InsertTeamMembership(ctx context.Context, usernames []string, teamID pgtype.Text) (ta1 []pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertTeamMembership")
+// UpdateGPGKey implements Querier
+func (_d QuerierWithTracing) UpdateGPGKey(ctx context.Context, params UpdateGPGKeyParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateGPGKey")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":       ctx,
-				"usernames": usernames,
-				"teamID":    teamID}, map[string]interface{}{
-				"ta1": ta1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4004,18 +6708,18 @@ func (_d QuerierWithTracing) InsertTeamMembership(ctx context.Context, usernames
 
 		_span.End()
 	}()
-	return _d.Querier.InsertTeamMembership(ctx, usernames, teamID)
+	return _d.Querier.UpdateGPGKey(ctx, params)
 }
 
-// InsertTeamToken implements Querier
-func (_d QuerierWithTracing) InsertTeamToken(ctx context.Context, params InsertTeamTokenParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertTeamToken")
+// UpdateJob implements Querier
+func (_d QuerierWithTracing) UpdateJob(ctx context.Context, params UpdateJobParams) (u1 UpdateJobRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateJob")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"u1":  u1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4027,17 +6731,18 @@ func (_d QuerierWithTracing) InsertTeamToken(ctx context.Context, params InsertT
 
 		_span.End()
 	}()
-	return _d.Querier.InsertTeamToken(ctx, params)
+	return _d.Querier.UpdateJob(ctx, params)
 }
 
-// InsertToken implements Querier
-func (_d QuerierWithTracing) InsertToken(ctx context.Context, params InsertTokenParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertToken")
+// UpdateLatestTerraformVersion implements Querier
+func (_d QuerierWithTracing) UpdateLatestTerraformVersion(ctx context.Context, version pgtype.Text, engine pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateLatestTerraformVersion")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
+				"ctx":     ctx,
+				"version": version,
+				"engine":  engine}, map[string]interface{}{
 				"c2":  c2,
 				"err": err})
 		} else if err != nil {
@@ -4050,18 +6755,19 @@ func (_d QuerierWithTracing) InsertToken(ctx context.Context, params InsertToken
 
 		_span.End()
 	}()
-	return _d.Querier.InsertToken(ctx, params)
+	return _d.Querier.UpdateLatestTerraformVersion(ctx, version, engine)
 }
 
-// InsertUser implements Querier
-func (_d QuerierWithTracing) InsertUser(ctx context.Context, params InsertUserParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertUser")
+// UpdateModuleStatusByID implements Querier
+func (_d QuerierWithTracing) UpdateModuleStatusByID(ctx context.Context, status pgtype.Text, moduleID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateModuleStatusByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"ctx":      ctx,
+				"status":   status,
+				"moduleID": moduleID}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4073,18 +6779,18 @@ func (_d QuerierWithTracing) InsertUser(ctx context.Context, params InsertUserPa
 
 		_span.End()
 	}()
-	return _d.Querier.InsertUser(ctx, params)
+	return _d.Querier.UpdateModuleStatusByID(ctx, status, moduleID)
 }
 
-// InsertVCSProvider implements Querier
-func (_d QuerierWithTracing) InsertVCSProvider(ctx context.Context, params InsertVCSProviderParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertVCSProvider")
+// UpdateModuleVersionStatusByID implements Querier
+func (_d QuerierWithTracing) UpdateModuleVersionStatusByID(ctx context.Context, params UpdateModuleVersionStatusByIDParams) (u1 UpdateModuleVersionStatusByIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateModuleVersionStatusByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"u1":  u1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4096,18 +6802,18 @@ func (_d QuerierWithTracing) InsertVCSProvider(ctx context.Context, params Inser
 
 		_span.End()
 	}()
-	return _d.Querier.InsertVCSProvider(ctx, params)
+	return _d.Querier.UpdateModuleVersionStatusByID(ctx, params)
 }
 
-// InsertVariable implements Querier
-func (_d QuerierWithTracing) InsertVariable(ctx context.Context, params InsertVariableParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertVariable")
+// UpdateNotificationConfigurationByID implements Querier
+func (_d QuerierWithTracing) UpdateNotificationConfigurationByID(ctx context.Context, params UpdateNotificationConfigurationByIDParams) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateNotificationConfigurationByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4119,18 +6825,18 @@ func (_d QuerierWithTracing) InsertVariable(ctx context.Context, params InsertVa
 
 		_span.End()
 	}()
-	return _d.Querier.InsertVariable(ctx, params)
+	return _d.Querier.UpdateNotificationConfigurationByID(ctx, params)
 }
 
-// InsertVariableSet implements Querier
-func (_d QuerierWithTracing) InsertVariableSet(ctx context.Context, params InsertVariableSetParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertVariableSet")
+// UpdateOrganizationByName implements Querier
+func (_d QuerierWithTracing) UpdateOrganizationByName(ctx context.Context, params UpdateOrganizationByNameParams) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateOrganizationByName")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4142,18 +6848,18 @@ func (_d QuerierWithTracing) InsertVariableSet(ctx context.Context, params Inser
 
 		_span.End()
 	}()
-	return _d.Querier.InsertVariableSet(ctx, params)
+	return _d.Querier.UpdateOrganizationByName(ctx, params)
 }
 
-// InsertVariableSetVariable implements Querier
-func (_d QuerierWithTracing) InsertVariableSetVariable(ctx context.Context, variableSetID pgtype.Text, variableID pgtype.Text) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertVariableSetVariable")
+// UpdateOrganizationTokenLastUsedAt implements Querier
+func (_d QuerierWithTracing) UpdateOrganizationTokenLastUsedAt(ctx context.Context, lastUsedAt pgtype.Timestamptz, organizationTokenID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateOrganizationTokenLastUsedAt")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":           ctx,
-				"variableSetID": variableSetID,
-				"variableID":    variableID}, map[string]interface{}{
+				"ctx":                 ctx,
+				"lastUsedAt":          lastUsedAt,
+				"organizationTokenID": organizationTokenID}, map[string]interface{}{
 				"c2":  c2,
 				"err": err})
 		} else if err != nil {
@@ -4166,19 +6872,19 @@ func (_d QuerierWithTracing) InsertVariableSetVariable(ctx context.Context, vari
 
 		_span.End()
 	}()
-	return _d.Querier.InsertVariableSetVariable(ctx, variableSetID, variableID)
+	return _d.Querier.UpdateOrganizationTokenLastUsedAt(ctx, lastUsedAt, organizationTokenID)
 }
 
-// InsertVariableSetWorkspace implements Querier
-func (_d QuerierWithTracing) InsertVariableSetWorkspace(ctx context.Context, variableSetID pgtype.Text, workspaceID pgtype.Text) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertVariableSetWorkspace")
+// UpdatePlanBinByID implements Querier
+func (_d QuerierWithTracing) UpdatePlanBinByID(ctx context.Context, planBin []byte, runID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdatePlanBinByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":           ctx,
-				"variableSetID": variableSetID,
-				"workspaceID":   workspaceID}, map[string]interface{}{
-				"c2":  c2,
+				"ctx":     ctx,
+				"planBin": planBin,
+				"runID":   runID}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4190,18 +6896,19 @@ func (_d QuerierWithTracing) InsertVariableSetWorkspace(ctx context.Context, var
 
 		_span.End()
 	}()
-	return _d.Querier.InsertVariableSetWorkspace(ctx, variableSetID, workspaceID)
+	return _d.Querier.UpdatePlanBinByID(ctx, planBin, runID)
 }
 
-// InsertWorkspace implements Querier
-func (_d QuerierWithTracing) InsertWorkspace(ctx context.Context, params InsertWorkspaceParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertWorkspace")
+// UpdatePlanJSONByID implements Querier
+func (_d QuerierWithTracing) UpdatePlanJSONByID(ctx context.Context, planJSON []byte, runID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdatePlanJSONByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"ctx":      ctx,
+				"planJSON": planJSON,
+				"runID":    runID}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4213,18 +6920,18 @@ func (_d QuerierWithTracing) InsertWorkspace(ctx context.Context, params InsertW
 
 		_span.End()
 	}()
-	return _d.Querier.InsertWorkspace(ctx, params)
+	return _d.Querier.UpdatePlanJSONByID(ctx, planJSON, runID)
 }
 
-// InsertWorkspaceTag implements Querier
-func (_d QuerierWithTracing) InsertWorkspaceTag(ctx context.Context, tagID pgtype.Text, workspaceID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertWorkspaceTag")
+// UpdatePlanStatusByID implements Querier
+func (_d QuerierWithTracing) UpdatePlanStatusByID(ctx context.Context, status pgtype.Text, runID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdatePlanStatusByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"tagID":       tagID,
-				"workspaceID": workspaceID}, map[string]interface{}{
+				"ctx":    ctx,
+				"status": status,
+				"runID":  runID}, map[string]interface{}{
 				"t1":  t1,
 				"err": err})
 		} else if err != nil {
@@ -4237,18 +6944,17 @@ func (_d QuerierWithTracing) InsertWorkspaceTag(ctx context.Context, tagID pgtyp
 
 		_span.End()
 	}()
-	return _d.Querier.InsertWorkspaceTag(ctx, tagID, workspaceID)
+	return _d.Querier.UpdatePlanStatusByID(ctx, status, runID)
 }
 
-// InsertWorkspaceTagByName implements Querier
-func (_d QuerierWithTracing) InsertWorkspaceTagByName(ctx context.Context, workspaceID pgtype.Text, tagName pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertWorkspaceTagByName")
+// UpdatePlannedChangesByID implements Querier
+func (_d QuerierWithTracing) UpdatePlannedChangesByID(ctx context.Context, params UpdatePlannedChangesByIDParams) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdatePlannedChangesByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"workspaceID": workspaceID,
-				"tagName":     tagName}, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
 				"t1":  t1,
 				"err": err})
 		} else if err != nil {
@@ -4261,19 +6967,18 @@ func (_d QuerierWithTracing) InsertWorkspaceTagByName(ctx context.Context, works
 
 		_span.End()
 	}()
-	return _d.Querier.InsertWorkspaceTagByName(ctx, workspaceID, tagName)
+	return _d.Querier.UpdatePlannedChangesByID(ctx, params)
 }
 
-// InsertWorkspaceVariable implements Querier
-func (_d QuerierWithTracing) InsertWorkspaceVariable(ctx context.Context, variableID pgtype.Text, workspaceID pgtype.Text) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.InsertWorkspaceVariable")
+// UpdatePolicy implements Querier
+func (_d QuerierWithTracing) UpdatePolicy(ctx context.Context, params UpdatePolicyParams) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdatePolicy")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"variableID":  variableID,
-				"workspaceID": workspaceID}, map[string]interface{}{
-				"c2":  c2,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4285,18 +6990,18 @@ func (_d QuerierWithTracing) InsertWorkspaceVariable(ctx context.Context, variab
 
 		_span.End()
 	}()
-	return _d.Querier.InsertWorkspaceVariable(ctx, variableID, workspaceID)
+	return _d.Querier.UpdatePolicy(ctx, params)
 }
 
-// ListGPGKeys implements Querier
-func (_d QuerierWithTracing) ListGPGKeys(ctx context.Context, organizationNames []string) (la1 []ListGPGKeysRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.ListGPGKeys")
+// UpdatePolicyCheckStatus implements Querier
+func (_d QuerierWithTracing) UpdatePolicyCheckStatus(ctx context.Context, params UpdatePolicyCheckStatusParams) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdatePolicyCheckStatus")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":               ctx,
-				"organizationNames": organizationNames}, map[string]interface{}{
-				"la1": la1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4308,18 +7013,18 @@ func (_d QuerierWithTracing) ListGPGKeys(ctx context.Context, organizationNames
 
 		_span.End()
 	}()
-	return _d.Querier.ListGPGKeys(ctx, organizationNames)
+	return _d.Querier.UpdatePolicyCheckStatus(ctx, params)
 }
 
-// ListModulesByOrganization implements Querier
-func (_d QuerierWithTracing) ListModulesByOrganization(ctx context.Context, organizationName pgtype.Text) (la1 []ListModulesByOrganizationRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.ListModulesByOrganization")
+// UpdatePolicySet implements Querier
+func (_d QuerierWithTracing) UpdatePolicySet(ctx context.Context, params UpdatePolicySetParams) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdatePolicySet")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":              ctx,
-				"organizationName": organizationName}, map[string]interface{}{
-				"la1": la1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4331,18 +7036,17 @@ func (_d QuerierWithTracing) ListModulesByOrganization(ctx context.Context, orga
 
 		_span.End()
 	}()
-	return _d.Querier.ListModulesByOrganization(ctx, organizationName)
+	return _d.Querier.UpdatePolicySet(ctx, params)
 }
 
-// PutLockFile implements Querier
-func (_d QuerierWithTracing) PutLockFile(ctx context.Context, lockFile []byte, runID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.PutLockFile")
+// UpdateProject implements Querier
+func (_d QuerierWithTracing) UpdateProject(ctx context.Context, params UpdateProjectParams) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateProject")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":      ctx,
-				"lockFile": lockFile,
-				"runID":    runID}, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
 				"t1":  t1,
 				"err": err})
 		} else if err != nil {
@@ -4355,17 +7059,18 @@ func (_d QuerierWithTracing) PutLockFile(ctx context.Context, lockFile []byte, r
 
 		_span.End()
 	}()
-	return _d.Querier.PutLockFile(ctx, lockFile, runID)
+	return _d.Querier.UpdateProject(ctx, params)
 }
 
-// ResetUserSiteAdmins implements Querier
-func (_d QuerierWithTracing) ResetUserSiteAdmins(ctx context.Context) (ta1 []pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.ResetUserSiteAdmins")
+// UpdateRegistryProviderPlatformBinary implements Querier
+func (_d QuerierWithTracing) UpdateRegistryProviderPlatformBinary(ctx context.Context, params UpdateRegistryProviderPlatformBinaryParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateRegistryProviderPlatformBinary")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx}, map[string]interface{}{
-				"ta1": ta1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4377,18 +7082,18 @@ func (_d QuerierWithTracing) ResetUserSiteAdmins(ctx context.Context) (ta1 []pgt
 
 		_span.End()
 	}()
-	return _d.Querier.ResetUserSiteAdmins(ctx)
+	return _d.Querier.UpdateRegistryProviderPlatformBinary(ctx, params)
 }
 
-// UpdateAgent implements Querier
-func (_d QuerierWithTracing) UpdateAgent(ctx context.Context, params UpdateAgentParams) (u1 UpdateAgentRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateAgent")
+// UpdateRegistryProviderVersionShasums implements Querier
+func (_d QuerierWithTracing) UpdateRegistryProviderVersionShasums(ctx context.Context, params UpdateRegistryProviderVersionShasumsParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateRegistryProviderVersionShasums")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"u1":  u1,
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4400,18 +7105,18 @@ func (_d QuerierWithTracing) UpdateAgent(ctx context.Context, params UpdateAgent
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateAgent(ctx, params)
+	return _d.Querier.UpdateRegistryProviderVersionShasums(ctx, params)
 }
 
-// UpdateAgentPool implements Querier
-func (_d QuerierWithTracing) UpdateAgentPool(ctx context.Context, params UpdateAgentPoolParams) (u1 UpdateAgentPoolRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateAgentPool")
+// UpdateRegistryProviderVersionShasumsSig implements Querier
+func (_d QuerierWithTracing) UpdateRegistryProviderVersionShasumsSig(ctx context.Context, params UpdateRegistryProviderVersionShasumsSigParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateRegistryProviderVersionShasumsSig")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"u1":  u1,
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4423,18 +7128,19 @@ func (_d QuerierWithTracing) UpdateAgentPool(ctx context.Context, params UpdateA
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateAgentPool(ctx, params)
+	return _d.Querier.UpdateRegistryProviderVersionShasumsSig(ctx, params)
 }
 
-// UpdateAppliedChangesByID implements Querier
-func (_d QuerierWithTracing) UpdateAppliedChangesByID(ctx context.Context, params UpdateAppliedChangesByIDParams) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateAppliedChangesByID")
+// UpdateRepohookVCSID implements Querier
+func (_d QuerierWithTracing) UpdateRepohookVCSID(ctx context.Context, vcsID pgtype.Text, repohookID pgtype.UUID) (u1 UpdateRepohookVCSIDRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateRepohookVCSID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"t1":  t1,
+				"ctx":        ctx,
+				"vcsID":      vcsID,
+				"repohookID": repohookID}, map[string]interface{}{
+				"u1":  u1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4446,18 +7152,18 @@ func (_d QuerierWithTracing) UpdateAppliedChangesByID(ctx context.Context, param
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateAppliedChangesByID(ctx, params)
+	return _d.Querier.UpdateRepohookVCSID(ctx, vcsID, repohookID)
 }
 
-// UpdateApplyStatusByID implements Querier
-func (_d QuerierWithTracing) UpdateApplyStatusByID(ctx context.Context, status pgtype.Text, runID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateApplyStatusByID")
+// UpdateRunStatus implements Querier
+func (_d QuerierWithTracing) UpdateRunStatus(ctx context.Context, status pgtype.Text, id pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateRunStatus")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"status": status,
-				"runID":  runID}, map[string]interface{}{
+				"id":     id}, map[string]interface{}{
 				"t1":  t1,
 				"err": err})
 		} else if err != nil {
@@ -4470,18 +7176,17 @@ func (_d QuerierWithTracing) UpdateApplyStatusByID(ctx context.Context, status p
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateApplyStatusByID(ctx, status, runID)
+	return _d.Querier.UpdateRunStatus(ctx, status, id)
 }
 
-// UpdateCancelSignaledAt implements Querier
-func (_d QuerierWithTracing) UpdateCancelSignaledAt(ctx context.Context, cancelSignaledAt pgtype.Timestamptz, id pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateCancelSignaledAt")
+// UpdateSchedule implements Querier
+func (_d QuerierWithTracing) UpdateSchedule(ctx context.Context, params UpdateScheduleParams) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateSchedule")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":              ctx,
-				"cancelSignaledAt": cancelSignaledAt,
-				"id":               id}, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
 				"t1":  t1,
 				"err": err})
 		} else if err != nil {
@@ -4494,19 +7199,19 @@ func (_d QuerierWithTracing) UpdateCancelSignaledAt(ctx context.Context, cancelS
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateCancelSignaledAt(ctx, cancelSignaledAt, id)
+	return _d.Querier.UpdateSchedule(ctx, params)
 }
 
-// UpdateConfigurationVersionConfigByID implements Querier
-func (_d QuerierWithTracing) UpdateConfigurationVersionConfigByID(ctx context.Context, config []byte, id pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateConfigurationVersionConfigByID")
+// UpdateState implements Querier
+func (_d QuerierWithTracing) UpdateState(ctx context.Context, state []byte, stateVersionID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateState")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"config": config,
-				"id":     id}, map[string]interface{}{
-				"t1":  t1,
+				"ctx":            ctx,
+				"state":          state,
+				"stateVersionID": stateVersionID}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4518,17 +7223,17 @@ func (_d QuerierWithTracing) UpdateConfigurationVersionConfigByID(ctx context.Co
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateConfigurationVersionConfigByID(ctx, config, id)
+	return _d.Querier.UpdateState(ctx, state, stateVersionID)
 }
 
-// UpdateConfigurationVersionErroredByID implements Querier
-func (_d QuerierWithTracing) UpdateConfigurationVersionErroredByID(ctx context.Context, id pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateConfigurationVersionErroredByID")
+// UpdateTeamByID implements Querier
+func (_d QuerierWithTracing) UpdateTeamByID(ctx context.Context, params UpdateTeamByIDParams) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateTeamByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx": ctx,
-				"id":  id}, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
 				"t1":  t1,
 				"err": err})
 		} else if err != nil {
@@ -4541,17 +7246,18 @@ func (_d QuerierWithTracing) UpdateConfigurationVersionErroredByID(ctx context.C
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateConfigurationVersionErroredByID(ctx, id)
+	return _d.Querier.UpdateTeamByID(ctx, params)
 }
 
-// UpdateGPGKey implements Querier
-func (_d QuerierWithTracing) UpdateGPGKey(ctx context.Context, params UpdateGPGKeyParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateGPGKey")
+// UpdateTeamTokenLastUsedAt implements Querier
+func (_d QuerierWithTracing) UpdateTeamTokenLastUsedAt(ctx context.Context, lastUsedAt pgtype.Timestamptz, teamTokenID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateTeamTokenLastUsedAt")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
+				"ctx":         ctx,
+				"lastUsedAt":  lastUsedAt,
+				"teamTokenID": teamTokenID}, map[string]interface{}{
 				"c2":  c2,
 				"err": err})
 		} else if err != nil {
@@ -4564,18 +7270,19 @@ func (_d QuerierWithTracing) UpdateGPGKey(ctx context.Context, params UpdateGPGK
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateGPGKey(ctx, params)
+	return _d.Querier.UpdateTeamTokenLastUsedAt(ctx, lastUsedAt, teamTokenID)
 }
 
-// UpdateJob implements Querier
-func (_d QuerierWithTracing) UpdateJob(ctx context.Context, params UpdateJobParams) (u1 UpdateJobRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateJob")
+// UpdateTokenLastUsedAt implements Querier
+func (_d QuerierWithTracing) UpdateTokenLastUsedAt(ctx context.Context, lastUsedAt pgtype.Timestamptz, tokenID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateTokenLastUsedAt")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"u1":  u1,
+				"ctx":        ctx,
+				"lastUsedAt": lastUsedAt,
+				"tokenID":    tokenID}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4587,17 +7294,17 @@ func (_d QuerierWithTracing) UpdateJob(ctx context.Context, params UpdateJobPara
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateJob(ctx, params)
+	return _d.Querier.UpdateTokenLastUsedAt(ctx, lastUsedAt, tokenID)
 }
 
-// UpdateLatestTerraformVersion implements Querier
-func (_d QuerierWithTracing) UpdateLatestTerraformVersion(ctx context.Context, version pgtype.Text) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateLatestTerraformVersion")
+// UpdateUser implements Querier
+func (_d QuerierWithTracing) UpdateUser(ctx context.Context, params UpdateUserParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateUser")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":     ctx,
-				"version": version}, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
 				"c2":  c2,
 				"err": err})
 		} else if err != nil {
@@ -4610,19 +7317,18 @@ func (_d QuerierWithTracing) UpdateLatestTerraformVersion(ctx context.Context, v
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateLatestTerraformVersion(ctx, version)
+	return _d.Querier.UpdateUser(ctx, params)
 }
 
-// UpdateModuleStatusByID implements Querier
-func (_d QuerierWithTracing) UpdateModuleStatusByID(ctx context.Context, status pgtype.Text, moduleID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateModuleStatusByID")
+// UpdateUserSiteAdmins implements Querier
+func (_d QuerierWithTracing) UpdateUserSiteAdmins(ctx context.Context, usernames []string) (ta1 []pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateUserSiteAdmins")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":      ctx,
-				"status":   status,
-				"moduleID": moduleID}, map[string]interface{}{
-				"t1":  t1,
+				"ctx":       ctx,
+				"usernames": usernames}, map[string]interface{}{
+				"ta1": ta1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4634,12 +7340,12 @@ func (_d QuerierWithTracing) UpdateModuleStatusByID(ctx context.Context, status
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateModuleStatusByID(ctx, status, moduleID)
+	return _d.Querier.UpdateUserSiteAdmins(ctx, usernames)
 }
 
-// UpdateModuleVersionStatusByID implements Querier
-func (_d QuerierWithTracing) UpdateModuleVersionStatusByID(ctx context.Context, params UpdateModuleVersionStatusByIDParams) (u1 UpdateModuleVersionStatusByIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateModuleVersionStatusByID")
+// UpdateVCSProvider implements Querier
+func (_d QuerierWithTracing) UpdateVCSProvider(ctx context.Context, params UpdateVCSProviderParams) (u1 UpdateVCSProviderRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateVCSProvider")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
@@ -4657,12 +7363,12 @@ func (_d QuerierWithTracing) UpdateModuleVersionStatusByID(ctx context.Context,
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateModuleVersionStatusByID(ctx, params)
+	return _d.Querier.UpdateVCSProvider(ctx, params)
 }
 
-// UpdateNotificationConfigurationByID implements Querier
-func (_d QuerierWithTracing) UpdateNotificationConfigurationByID(ctx context.Context, params UpdateNotificationConfigurationByIDParams) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateNotificationConfigurationByID")
+// UpdateVariableByID implements Querier
+func (_d QuerierWithTracing) UpdateVariableByID(ctx context.Context, params UpdateVariableByIDParams) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateVariableByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
@@ -4680,12 +7386,12 @@ func (_d QuerierWithTracing) UpdateNotificationConfigurationByID(ctx context.Con
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateNotificationConfigurationByID(ctx, params)
+	return _d.Querier.UpdateVariableByID(ctx, params)
 }
 
-// UpdateOrganizationByName implements Querier
-func (_d QuerierWithTracing) UpdateOrganizationByName(ctx context.Context, params UpdateOrganizationByNameParams) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateOrganizationByName")
+// UpdateVariableSetByID implements Querier
+func (_d QuerierWithTracing) UpdateVariableSetByID(ctx context.Context, params UpdateVariableSetByIDParams) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateVariableSetByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
@@ -4703,19 +7409,19 @@ func (_d QuerierWithTracing) UpdateOrganizationByName(ctx context.Context, param
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateOrganizationByName(ctx, params)
+	return _d.Querier.UpdateVariableSetByID(ctx, params)
 }
 
-// UpdatePlanBinByID implements Querier
-func (_d QuerierWithTracing) UpdatePlanBinByID(ctx context.Context, planBin []byte, runID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdatePlanBinByID")
+// UpdateWorkspaceAutoDestroyRun implements Querier
+func (_d QuerierWithTracing) UpdateWorkspaceAutoDestroyRun(ctx context.Context, runID pgtype.Text, workspaceID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateWorkspaceAutoDestroyRun")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":     ctx,
-				"planBin": planBin,
-				"runID":   runID}, map[string]interface{}{
-				"t1":  t1,
+				"ctx":         ctx,
+				"runID":       runID,
+				"workspaceID": workspaceID}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4727,18 +7433,17 @@ func (_d QuerierWithTracing) UpdatePlanBinByID(ctx context.Context, planBin []by
 
 		_span.End()
 	}()
-	return _d.Querier.UpdatePlanBinByID(ctx, planBin, runID)
+	return _d.Querier.UpdateWorkspaceAutoDestroyRun(ctx, runID, workspaceID)
 }
 
-// UpdatePlanJSONByID implements Querier
-func (_d QuerierWithTracing) UpdatePlanJSONByID(ctx context.Context, planJSON []byte, runID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdatePlanJSONByID")
+// UpdateWorkspaceByID implements Querier
+func (_d QuerierWithTracing) UpdateWorkspaceByID(ctx context.Context, params UpdateWorkspaceByIDParams) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateWorkspaceByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":      ctx,
-				"planJSON": planJSON,
-				"runID":    runID}, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
 				"t1":  t1,
 				"err": err})
 		} else if err != nil {
@@ -4751,18 +7456,18 @@ func (_d QuerierWithTracing) UpdatePlanJSONByID(ctx context.Context, planJSON []
 
 		_span.End()
 	}()
-	return _d.Querier.UpdatePlanJSONByID(ctx, planJSON, runID)
+	return _d.Querier.UpdateWorkspaceByID(ctx, params)
 }
 
-// UpdatePlanStatusByID implements Querier
-func (_d QuerierWithTracing) UpdatePlanStatusByID(ctx context.Context, status pgtype.Text, runID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdatePlanStatusByID")
+// UpdateWorkspaceCurrentStateVersionID implements Querier
+func (_d QuerierWithTracing) UpdateWorkspaceCurrentStateVersionID(ctx context.Context, stateVersionID pgtype.Text, workspaceID pgtype.Text) (t1 pgtype.Text, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateWorkspaceCurrentStateVersionID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"status": status,
-				"runID":  runID}, map[string]interface{}{
+				"ctx":            ctx,
+				"stateVersionID": stateVersionID,
+				"workspaceID":    workspaceID}, map[string]interface{}{
 				"t1":  t1,
 				"err": err})
 		} else if err != nil {
@@ -4775,18 +7480,18 @@ func (_d QuerierWithTracing) UpdatePlanStatusByID(ctx context.Context, status pg
 
 		_span.End()
 	}()
-	return _d.Querier.UpdatePlanStatusByID(ctx, status, runID)
+	return _d.Querier.UpdateWorkspaceCurrentStateVersionID(ctx, stateVersionID, workspaceID)
 }
 
-// UpdatePlannedChangesByID implements Querier
-func (_d QuerierWithTracing) UpdatePlannedChangesByID(ctx context.Context, params UpdatePlannedChangesByIDParams) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdatePlannedChangesByID")
+// UpdateWorkspaceLatestAssessment implements Querier
+func (_d QuerierWithTracing) UpdateWorkspaceLatestAssessment(ctx context.Context, params UpdateWorkspaceLatestAssessmentParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateWorkspaceLatestAssessment")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"t1":  t1,
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4798,19 +7503,19 @@ func (_d QuerierWithTracing) UpdatePlannedChangesByID(ctx context.Context, param
 
 		_span.End()
 	}()
-	return _d.Querier.UpdatePlannedChangesByID(ctx, params)
+	return _d.Querier.UpdateWorkspaceLatestAssessment(ctx, params)
 }
 
-// UpdateRepohookVCSID implements Querier
-func (_d QuerierWithTracing) UpdateRepohookVCSID(ctx context.Context, vcsID pgtype.Text, repohookID pgtype.UUID) (u1 UpdateRepohookVCSIDRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateRepohookVCSID")
+// UpdateWorkspaceLatestRun implements Querier
+func (_d QuerierWithTracing) UpdateWorkspaceLatestRun(ctx context.Context, runID pgtype.Text, workspaceID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateWorkspaceLatestRun")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":        ctx,
-				"vcsID":      vcsID,
-				"repohookID": repohookID}, map[string]interface{}{
-				"u1":  u1,
+				"ctx":         ctx,
+				"runID":       runID,
+				"workspaceID": workspaceID}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4822,19 +7527,18 @@ func (_d QuerierWithTracing) UpdateRepohookVCSID(ctx context.Context, vcsID pgty
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateRepohookVCSID(ctx, vcsID, repohookID)
+	return _d.Querier.UpdateWorkspaceLatestRun(ctx, runID, workspaceID)
 }
 
-// UpdateRunStatus implements Querier
-func (_d QuerierWithTracing) UpdateRunStatus(ctx context.Context, status pgtype.Text, id pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateRunStatus")
+// UpdateWorkspaceLockByID implements Querier
+func (_d QuerierWithTracing) UpdateWorkspaceLockByID(ctx context.Context, params UpdateWorkspaceLockByIDParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateWorkspaceLockByID")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
-				"status": status,
-				"id":     id}, map[string]interface{}{
-				"t1":  t1,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4846,18 +7550,17 @@ func (_d QuerierWithTracing) UpdateRunStatus(ctx context.Context, status pgtype.
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateRunStatus(ctx, status, id)
+	return _d.Querier.UpdateWorkspaceLockByID(ctx, params)
 }
 
-// UpdateState implements Querier
-func (_d QuerierWithTracing) UpdateState(ctx context.Context, state []byte, stateVersionID pgtype.Text) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateState")
+// UpsertModuleVersionMetadata implements Querier
+func (_d QuerierWithTracing) UpsertModuleVersionMetadata(ctx context.Context, params UpsertModuleVersionMetadataParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpsertModuleVersionMetadata")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":            ctx,
-				"state":          state,
-				"stateVersionID": stateVersionID}, map[string]interface{}{
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
 				"c2":  c2,
 				"err": err})
 		} else if err != nil {
@@ -4870,18 +7573,18 @@ func (_d QuerierWithTracing) UpdateState(ctx context.Context, state []byte, stat
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateState(ctx, state, stateVersionID)
+	return _d.Querier.UpsertModuleVersionMetadata(ctx, params)
 }
 
-// UpdateTeamByID implements Querier
-func (_d QuerierWithTracing) UpdateTeamByID(ctx context.Context, params UpdateTeamByIDParams) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateTeamByID")
+// UpsertOrganizationToken implements Querier
+func (_d QuerierWithTracing) UpsertOrganizationToken(ctx context.Context, params UpsertOrganizationTokenParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpsertOrganizationToken")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"t1":  t1,
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4893,18 +7596,18 @@ func (_d QuerierWithTracing) UpdateTeamByID(ctx context.Context, params UpdateTe
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateTeamByID(ctx, params)
+	return _d.Querier.UpsertOrganizationToken(ctx, params)
 }
 
-// UpdateUserSiteAdmins implements Querier
-func (_d QuerierWithTracing) UpdateUserSiteAdmins(ctx context.Context, usernames []string) (ta1 []pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateUserSiteAdmins")
+// UpsertProjectPermission implements Querier
+func (_d QuerierWithTracing) UpsertProjectPermission(ctx context.Context, params UpsertProjectPermissionParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpsertProjectPermission")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":       ctx,
-				"usernames": usernames}, map[string]interface{}{
-				"ta1": ta1,
+				"ctx":    ctx,
+				"params": params}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4916,18 +7619,18 @@ func (_d QuerierWithTracing) UpdateUserSiteAdmins(ctx context.Context, usernames
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateUserSiteAdmins(ctx, usernames)
+	return _d.Querier.UpsertProjectPermission(ctx, params)
 }
 
-// UpdateVCSProvider implements Querier
-func (_d QuerierWithTracing) UpdateVCSProvider(ctx context.Context, params UpdateVCSProviderParams) (u1 UpdateVCSProviderRow, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateVCSProvider")
+// UpsertPullRequestComment implements Querier
+func (_d QuerierWithTracing) UpsertPullRequestComment(ctx context.Context, params UpsertPullRequestCommentParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpsertPullRequestComment")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"u1":  u1,
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4939,18 +7642,18 @@ func (_d QuerierWithTracing) UpdateVCSProvider(ctx context.Context, params Updat
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateVCSProvider(ctx, params)
+	return _d.Querier.UpsertPullRequestComment(ctx, params)
 }
 
-// UpdateVariableByID implements Querier
-func (_d QuerierWithTracing) UpdateVariableByID(ctx context.Context, params UpdateVariableByIDParams) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateVariableByID")
+// UpsertWorkspacePermission implements Querier
+func (_d QuerierWithTracing) UpsertWorkspacePermission(ctx context.Context, params UpsertWorkspacePermissionParams) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpsertWorkspacePermission")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"params": params}, map[string]interface{}{
-				"t1":  t1,
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4962,18 +7665,19 @@ func (_d QuerierWithTracing) UpdateVariableByID(ctx context.Context, params Upda
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateVariableByID(ctx, params)
+	return _d.Querier.UpsertWorkspacePermission(ctx, params)
 }
 
-// UpdateVariableSetByID implements Querier
-func (_d QuerierWithTracing) UpdateVariableSetByID(ctx context.Context, params UpdateVariableSetByIDParams) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateVariableSetByID")
+// FindSensitiveVariablesForReencryption implements Querier
+func (_d QuerierWithTracing) FindSensitiveVariablesForReencryption(ctx context.Context, after pgtype.Text, limit pgtype.Int8) (fa1 []FindSensitiveVariablesForReencryptionRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindSensitiveVariablesForReencryption")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"t1":  t1,
+				"ctx":   ctx,
+				"after": after,
+				"limit": limit}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -4985,18 +7689,19 @@ func (_d QuerierWithTracing) UpdateVariableSetByID(ctx context.Context, params U
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateVariableSetByID(ctx, params)
+	return _d.Querier.FindSensitiveVariablesForReencryption(ctx, after, limit)
 }
 
-// UpdateWorkspaceByID implements Querier
-func (_d QuerierWithTracing) UpdateWorkspaceByID(ctx context.Context, params UpdateWorkspaceByIDParams) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateWorkspaceByID")
+// UpdateVariableValue implements Querier
+func (_d QuerierWithTracing) UpdateVariableValue(ctx context.Context, value pgtype.Text, variableID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateVariableValue")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"t1":  t1,
+				"ctx":        ctx,
+				"value":      value,
+				"variableID": variableID}, map[string]interface{}{
+				"c2":  c2,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -5008,19 +7713,19 @@ func (_d QuerierWithTracing) UpdateWorkspaceByID(ctx context.Context, params Upd
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateWorkspaceByID(ctx, params)
+	return _d.Querier.UpdateVariableValue(ctx, value, variableID)
 }
 
-// UpdateWorkspaceCurrentStateVersionID implements Querier
-func (_d QuerierWithTracing) UpdateWorkspaceCurrentStateVersionID(ctx context.Context, stateVersionID pgtype.Text, workspaceID pgtype.Text) (t1 pgtype.Text, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateWorkspaceCurrentStateVersionID")
+// FindStateVersionsForReencryption implements Querier
+func (_d QuerierWithTracing) FindStateVersionsForReencryption(ctx context.Context, after pgtype.Text, limit pgtype.Int8) (fa1 []FindStateVersionsForReencryptionRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindStateVersionsForReencryption")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":            ctx,
-				"stateVersionID": stateVersionID,
-				"workspaceID":    workspaceID}, map[string]interface{}{
-				"t1":  t1,
+				"ctx":   ctx,
+				"after": after,
+				"limit": limit}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -5032,18 +7737,18 @@ func (_d QuerierWithTracing) UpdateWorkspaceCurrentStateVersionID(ctx context.Co
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateWorkspaceCurrentStateVersionID(ctx, stateVersionID, workspaceID)
+	return _d.Querier.FindStateVersionsForReencryption(ctx, after, limit)
 }
 
-// UpdateWorkspaceLatestRun implements Querier
-func (_d QuerierWithTracing) UpdateWorkspaceLatestRun(ctx context.Context, runID pgtype.Text, workspaceID pgtype.Text) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateWorkspaceLatestRun")
+// UpdateStateVersionState implements Querier
+func (_d QuerierWithTracing) UpdateStateVersionState(ctx context.Context, state []byte, stateVersionID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateStateVersionState")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"runID":       runID,
-				"workspaceID": workspaceID}, map[string]interface{}{
+				"ctx":            ctx,
+				"state":          state,
+				"stateVersionID": stateVersionID}, map[string]interface{}{
 				"c2":  c2,
 				"err": err})
 		} else if err != nil {
@@ -5056,18 +7761,19 @@ func (_d QuerierWithTracing) UpdateWorkspaceLatestRun(ctx context.Context, runID
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateWorkspaceLatestRun(ctx, runID, workspaceID)
+	return _d.Querier.UpdateStateVersionState(ctx, state, stateVersionID)
 }
 
-// UpdateWorkspaceLockByID implements Querier
-func (_d QuerierWithTracing) UpdateWorkspaceLockByID(ctx context.Context, params UpdateWorkspaceLockByIDParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateWorkspaceLockByID")
+// FindSensitiveStateVersionOutputsForReencryption implements Querier
+func (_d QuerierWithTracing) FindSensitiveStateVersionOutputsForReencryption(ctx context.Context, after pgtype.Text, limit pgtype.Int8) (fa1 []FindSensitiveStateVersionOutputsForReencryptionRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindSensitiveStateVersionOutputsForReencryption")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"ctx":   ctx,
+				"after": after,
+				"limit": limit}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -5079,17 +7785,18 @@ func (_d QuerierWithTracing) UpdateWorkspaceLockByID(ctx context.Context, params
 
 		_span.End()
 	}()
-	return _d.Querier.UpdateWorkspaceLockByID(ctx, params)
+	return _d.Querier.FindSensitiveStateVersionOutputsForReencryption(ctx, after, limit)
 }
 
-// UpsertOrganizationToken implements Querier
-func (_d QuerierWithTracing) UpsertOrganizationToken(ctx context.Context, params UpsertOrganizationTokenParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpsertOrganizationToken")
+// UpdateStateVersionOutputValue implements Querier
+func (_d QuerierWithTracing) UpdateStateVersionOutputValue(ctx context.Context, value []byte, stateVersionOutputID pgtype.Text) (c2 pgconn.CommandTag, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpdateStateVersionOutputValue")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
+				"ctx":                  ctx,
+				"value":                value,
+				"stateVersionOutputID": stateVersionOutputID}, map[string]interface{}{
 				"c2":  c2,
 				"err": err})
 		} else if err != nil {
@@ -5102,18 +7809,19 @@ func (_d QuerierWithTracing) UpsertOrganizationToken(ctx context.Context, params
 
 		_span.End()
 	}()
-	return _d.Querier.UpsertOrganizationToken(ctx, params)
+	return _d.Querier.UpdateStateVersionOutputValue(ctx, value, stateVersionOutputID)
 }
 
-// UpsertWorkspacePermission implements Querier
-func (_d QuerierWithTracing) UpsertWorkspacePermission(ctx context.Context, params UpsertWorkspacePermissionParams) (c2 pgconn.CommandTag, err error) {
-	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.UpsertWorkspacePermission")
+// FindPlanFilesForReencryption implements Querier
+func (_d QuerierWithTracing) FindPlanFilesForReencryption(ctx context.Context, after pgtype.Text, limit pgtype.Int8) (fa1 []FindPlanFilesForReencryptionRow, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "Querier.FindPlanFilesForReencryption")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":    ctx,
-				"params": params}, map[string]interface{}{
-				"c2":  c2,
+				"ctx":   ctx,
+				"after": after,
+				"limit": limit}, map[string]interface{}{
+				"fa1": fa1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -5125,5 +7833,5 @@ func (_d QuerierWithTracing) UpsertWorkspacePermission(ctx context.Context, para
 
 		_span.End()
 	}()
-	return _d.Querier.UpsertWorkspacePermission(ctx, params)
+	return _d.Querier.FindPlanFilesForReencryption(ctx, after, limit)
 }
@@ -24,7 +24,9 @@ const insertOrganizationSQL = `INSERT INTO organizations (
     cost_estimation_enabled,
     session_remember,
     session_timeout,
-    allow_force_delete_workspaces
+    allow_force_delete_workspaces,
+    plan_timeout,
+    apply_timeout
 ) VALUES (
     $1,
     $2,
@@ -35,7 +37,9 @@ const insertOrganizationSQL = `INSERT INTO organizations (
     $7,
     $8,
     $9,
-    $10
+    $10,
+    $11,
+    $12
 );`
 
 type InsertOrganizationParams struct {
@@ -49,12 +53,14 @@ type InsertOrganizationParams struct {
 	SessionRemember            pgtype.Int4        `json:"session_remember"`
 	SessionTimeout             pgtype.Int4        `json:"session_timeout"`
 	AllowForceDeleteWorkspaces pgtype.Bool        `json:"allow_force_delete_workspaces"`
+	PlanTimeout                pgtype.Int4        `json:"plan_timeout"`
+	ApplyTimeout               pgtype.Int4        `json:"apply_timeout"`
 }
 
 // InsertOrganization implements Querier.InsertOrganization.
 func (q *DBQuerier) InsertOrganization(ctx context.Context, params InsertOrganizationParams) (pgconn.CommandTag, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "InsertOrganization")
-	cmdTag, err := q.conn.Exec(ctx, insertOrganizationSQL, params.ID, params.CreatedAt, params.UpdatedAt, params.Name, params.Email, params.CollaboratorAuthPolicy, params.CostEstimationEnabled, params.SessionRemember, params.SessionTimeout, params.AllowForceDeleteWorkspaces)
+	cmdTag, err := q.conn.Exec(ctx, insertOrganizationSQL, params.ID, params.CreatedAt, params.UpdatedAt, params.Name, params.Email, params.CollaboratorAuthPolicy, params.CostEstimationEnabled, params.SessionRemember, params.SessionTimeout, params.AllowForceDeleteWorkspaces, params.PlanTimeout, params.ApplyTimeout)
 	if err != nil {
 		return pgconn.CommandTag{}, fmt.Errorf("exec query InsertOrganization: %w", err)
 	}
@@ -96,6 +102,8 @@ type FindOrganizationByNameRow struct {
 	CollaboratorAuthPolicy     pgtype.Text        `json:"collaborator_auth_policy"`
 	AllowForceDeleteWorkspaces pgtype.Bool        `json:"allow_force_delete_workspaces"`
 	CostEstimationEnabled      pgtype.Bool        `json:"cost_estimation_enabled"`
+	PlanTimeout                pgtype.Int4        `json:"plan_timeout"`
+	ApplyTimeout               pgtype.Int4        `json:"apply_timeout"`
 }
 
 // FindOrganizationByName implements Querier.FindOrganizationByName.
@@ -118,6 +126,8 @@ func (q *DBQuerier) FindOrganizationByName(ctx context.Context, name pgtype.Text
 			&item.CollaboratorAuthPolicy,     // 'collaborator_auth_policy', 'CollaboratorAuthPolicy', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.AllowForceDeleteWorkspaces, // 'allow_force_delete_workspaces', 'AllowForceDeleteWorkspaces', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.CostEstimationEnabled,      // 'cost_estimation_enabled', 'CostEstimationEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.PlanTimeout,                // 'plan_timeout', 'PlanTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.ApplyTimeout,               // 'apply_timeout', 'ApplyTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -138,6 +148,8 @@ type FindOrganizationByIDRow struct {
 	CollaboratorAuthPolicy     pgtype.Text        `json:"collaborator_auth_policy"`
 	AllowForceDeleteWorkspaces pgtype.Bool        `json:"allow_force_delete_workspaces"`
 	CostEstimationEnabled      pgtype.Bool        `json:"cost_estimation_enabled"`
+	PlanTimeout                pgtype.Int4        `json:"plan_timeout"`
+	ApplyTimeout               pgtype.Int4        `json:"apply_timeout"`
 }
 
 // FindOrganizationByID implements Querier.FindOrganizationByID.
@@ -160,6 +172,8 @@ func (q *DBQuerier) FindOrganizationByID(ctx context.Context, organizationID pgt
 			&item.CollaboratorAuthPolicy,     // 'collaborator_auth_policy', 'CollaboratorAuthPolicy', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.AllowForceDeleteWorkspaces, // 'allow_force_delete_workspaces', 'AllowForceDeleteWorkspaces', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.CostEstimationEnabled,      // 'cost_estimation_enabled', 'CostEstimationEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.PlanTimeout,                // 'plan_timeout', 'PlanTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.ApplyTimeout,               // 'apply_timeout', 'ApplyTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -184,6 +198,8 @@ type FindOrganizationByNameForUpdateRow struct {
 	CollaboratorAuthPolicy     pgtype.Text        `json:"collaborator_auth_policy"`
 	AllowForceDeleteWorkspaces pgtype.Bool        `json:"allow_force_delete_workspaces"`
 	CostEstimationEnabled      pgtype.Bool        `json:"cost_estimation_enabled"`
+	PlanTimeout                pgtype.Int4        `json:"plan_timeout"`
+	ApplyTimeout               pgtype.Int4        `json:"apply_timeout"`
 }
 
 // FindOrganizationByNameForUpdate implements Querier.FindOrganizationByNameForUpdate.
@@ -206,6 +222,8 @@ func (q *DBQuerier) FindOrganizationByNameForUpdate(ctx context.Context, name pg
 			&item.CollaboratorAuthPolicy,     // 'collaborator_auth_policy', 'CollaboratorAuthPolicy', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.AllowForceDeleteWorkspaces, // 'allow_force_delete_workspaces', 'AllowForceDeleteWorkspaces', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.CostEstimationEnabled,      // 'cost_estimation_enabled', 'CostEstimationEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.PlanTimeout,                // 'plan_timeout', 'PlanTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.ApplyTimeout,               // 'apply_timeout', 'ApplyTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -237,6 +255,8 @@ type FindOrganizationsRow struct {
 	CollaboratorAuthPolicy     pgtype.Text        `json:"collaborator_auth_policy"`
 	AllowForceDeleteWorkspaces pgtype.Bool        `json:"allow_force_delete_workspaces"`
 	CostEstimationEnabled      pgtype.Bool        `json:"cost_estimation_enabled"`
+	PlanTimeout                pgtype.Int4        `json:"plan_timeout"`
+	ApplyTimeout               pgtype.Int4        `json:"apply_timeout"`
 }
 
 // FindOrganizations implements Querier.FindOrganizations.
@@ -259,6 +279,8 @@ func (q *DBQuerier) FindOrganizations(ctx context.Context, params FindOrganizati
 			&item.CollaboratorAuthPolicy,     // 'collaborator_auth_policy', 'CollaboratorAuthPolicy', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.AllowForceDeleteWorkspaces, // 'allow_force_delete_workspaces', 'AllowForceDeleteWorkspaces', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.CostEstimationEnabled,      // 'cost_estimation_enabled', 'CostEstimationEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.PlanTimeout,                // 'plan_timeout', 'PlanTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.ApplyTimeout,               // 'apply_timeout', 'ApplyTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -297,8 +319,10 @@ SET
     session_remember = $5,
     session_timeout = $6,
     allow_force_delete_workspaces = $7,
-    updated_at = $8
-WHERE name = $9
+    plan_timeout = $8,
+    apply_timeout = $9,
+    updated_at = $10
+WHERE name = $11
 RETURNING organization_id;`
 
 type UpdateOrganizationByNameParams struct {
@@ -309,6 +333,8 @@ type UpdateOrganizationByNameParams struct {
 	SessionRemember            pgtype.Int4        `json:"session_remember"`
 	SessionTimeout             pgtype.Int4        `json:"session_timeout"`
 	AllowForceDeleteWorkspaces pgtype.Bool        `json:"allow_force_delete_workspaces"`
+	PlanTimeout                pgtype.Int4        `json:"plan_timeout"`
+	ApplyTimeout               pgtype.Int4        `json:"apply_timeout"`
 	UpdatedAt                  pgtype.Timestamptz `json:"updated_at"`
 	Name                       pgtype.Text        `json:"name"`
 }
@@ -316,7 +342,7 @@ type UpdateOrganizationByNameParams struct {
 // UpdateOrganizationByName implements Querier.UpdateOrganizationByName.
 func (q *DBQuerier) UpdateOrganizationByName(ctx context.Context, params UpdateOrganizationByNameParams) (pgtype.Text, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "UpdateOrganizationByName")
-	rows, err := q.conn.Query(ctx, updateOrganizationByNameSQL, params.NewName, params.Email, params.CollaboratorAuthPolicy, params.CostEstimationEnabled, params.SessionRemember, params.SessionTimeout, params.AllowForceDeleteWorkspaces, params.UpdatedAt, params.Name)
+	rows, err := q.conn.Query(ctx, updateOrganizationByNameSQL, params.NewName, params.Email, params.CollaboratorAuthPolicy, params.CostEstimationEnabled, params.SessionRemember, params.SessionTimeout, params.AllowForceDeleteWorkspaces, params.PlanTimeout, params.ApplyTimeout, params.UpdatedAt, params.Name)
 	if err != nil {
 		return pgtype.Text{}, fmt.Errorf("query UpdateOrganizationByName: %w", err)
 	}
@@ -18,28 +18,33 @@ const upsertOrganizationTokenSQL = `INSERT INTO organization_tokens (
     organization_token_id,
     created_at,
     organization_name,
-    expiry
+    expiry,
+    scope
 ) VALUES (
     $1,
     $2,
     $3,
-    $4
+    $4,
+    $5
 ) ON CONFLICT (organization_name) DO UPDATE
   SET created_at            = $2,
       organization_token_id = $1,
-      expiry                = $4;`
+      expiry                = $4,
+      scope                 = $5,
+      last_used_at          = NULL;`
 
 type UpsertOrganizationTokenParams struct {
 	OrganizationTokenID pgtype.Text        `json:"organization_token_id"`
 	CreatedAt           pgtype.Timestamptz `json:"created_at"`
 	OrganizationName    pgtype.Text        `json:"organization_name"`
 	Expiry              pgtype.Timestamptz `json:"expiry"`
+	Scope               pgtype.Text        `json:"scope"`
 }
 
 // UpsertOrganizationToken implements Querier.UpsertOrganizationToken.
 func (q *DBQuerier) UpsertOrganizationToken(ctx context.Context, params UpsertOrganizationTokenParams) (pgconn.CommandTag, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "UpsertOrganizationToken")
-	cmdTag, err := q.conn.Exec(ctx, upsertOrganizationTokenSQL, params.OrganizationTokenID, params.CreatedAt, params.OrganizationName, params.Expiry)
+	cmdTag, err := q.conn.Exec(ctx, upsertOrganizationTokenSQL, params.OrganizationTokenID, params.CreatedAt, params.OrganizationName, params.Expiry, params.Scope)
 	if err != nil {
 		return pgconn.CommandTag{}, fmt.Errorf("exec query UpsertOrganizationToken: %w", err)
 	}
@@ -55,6 +60,8 @@ type FindOrganizationTokensRow struct {
 	CreatedAt           pgtype.Timestamptz `json:"created_at"`
 	OrganizationName    pgtype.Text        `json:"organization_name"`
 	Expiry              pgtype.Timestamptz `json:"expiry"`
+	Scope               pgtype.Text        `json:"scope"`
+	LastUsedAt          pgtype.Timestamptz `json:"last_used_at"`
 }
 
 // FindOrganizationTokens implements Querier.FindOrganizationTokens.
@@ -71,6 +78,8 @@ func (q *DBQuerier) FindOrganizationTokens(ctx context.Context, organizationName
 			&item.CreatedAt,        // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.OrganizationName, // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.Expiry,           // 'expiry', 'Expiry', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.Scope,            // 'scope', 'Scope', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LastUsedAt,       // 'last_used_at', 'LastUsedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -87,6 +96,8 @@ type FindOrganizationTokensByNameRow struct {
 	CreatedAt           pgtype.Timestamptz `json:"created_at"`
 	OrganizationName    pgtype.Text        `json:"organization_name"`
 	Expiry              pgtype.Timestamptz `json:"expiry"`
+	Scope               pgtype.Text        `json:"scope"`
+	LastUsedAt          pgtype.Timestamptz `json:"last_used_at"`
 }
 
 // FindOrganizationTokensByName implements Querier.FindOrganizationTokensByName.
@@ -103,6 +114,8 @@ func (q *DBQuerier) FindOrganizationTokensByName(ctx context.Context, organizati
 			&item.CreatedAt,        // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.OrganizationName, // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.Expiry,           // 'expiry', 'Expiry', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.Scope,            // 'scope', 'Scope', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LastUsedAt,       // 'last_used_at', 'LastUsedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -119,6 +132,8 @@ type FindOrganizationTokensByIDRow struct {
 	CreatedAt           pgtype.Timestamptz `json:"created_at"`
 	OrganizationName    pgtype.Text        `json:"organization_name"`
 	Expiry              pgtype.Timestamptz `json:"expiry"`
+	Scope               pgtype.Text        `json:"scope"`
+	LastUsedAt          pgtype.Timestamptz `json:"last_used_at"`
 }
 
 // FindOrganizationTokensByID implements Querier.FindOrganizationTokensByID.
@@ -135,6 +150,8 @@ func (q *DBQuerier) FindOrganizationTokensByID(ctx context.Context, organization
 			&item.CreatedAt,        // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.OrganizationName, // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.Expiry,           // 'expiry', 'Expiry', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.Scope,            // 'scope', 'Scope', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LastUsedAt,       // 'last_used_at', 'LastUsedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -163,3 +180,92 @@ func (q *DBQuerier) DeleteOrganiationTokenByName(ctx context.Context, organizati
 		return item, nil
 	})
 }
+
+const updateOrganizationTokenLastUsedAtSQL = `UPDATE organization_tokens
+SET last_used_at = $1
+WHERE organization_token_id = $2;`
+
+// UpdateOrganizationTokenLastUsedAt implements Querier.UpdateOrganizationTokenLastUsedAt.
+func (q *DBQuerier) UpdateOrganizationTokenLastUsedAt(ctx context.Context, lastUsedAt pgtype.Timestamptz, organizationTokenID pgtype.Text) (pgconn.CommandTag, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "UpdateOrganizationTokenLastUsedAt")
+	cmdTag, err := q.conn.Exec(ctx, updateOrganizationTokenLastUsedAtSQL, lastUsedAt, organizationTokenID)
+	if err != nil {
+		return pgconn.CommandTag{}, fmt.Errorf("exec query UpdateOrganizationTokenLastUsedAt: %w", err)
+	}
+	return cmdTag, err
+}
+
+const findStaleOrganizationTokensSQL = `SELECT *
+FROM organization_tokens
+WHERE expiry < now()
+OR COALESCE(last_used_at, created_at) < $1;`
+
+type FindStaleOrganizationTokensRow struct {
+	OrganizationTokenID pgtype.Text        `json:"organization_token_id"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	OrganizationName    pgtype.Text        `json:"organization_name"`
+	Expiry              pgtype.Timestamptz `json:"expiry"`
+	Scope               pgtype.Text        `json:"scope"`
+	LastUsedAt          pgtype.Timestamptz `json:"last_used_at"`
+}
+
+// FindStaleOrganizationTokens implements Querier.FindStaleOrganizationTokens.
+func (q *DBQuerier) FindStaleOrganizationTokens(ctx context.Context, cutoff pgtype.Timestamptz) ([]FindStaleOrganizationTokensRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "FindStaleOrganizationTokens")
+	rows, err := q.conn.Query(ctx, findStaleOrganizationTokensSQL, cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("query FindStaleOrganizationTokens: %w", err)
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (FindStaleOrganizationTokensRow, error) {
+		var item FindStaleOrganizationTokensRow
+		if err := row.Scan(&item.OrganizationTokenID, // 'organization_token_id', 'OrganizationTokenID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.CreatedAt,        // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.OrganizationName, // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Expiry,           // 'expiry', 'Expiry', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.Scope,            // 'scope', 'Scope', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LastUsedAt,       // 'last_used_at', 'LastUsedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+		); err != nil {
+			return item, fmt.Errorf("failed to scan: %w", err)
+		}
+		return item, nil
+	})
+}
+
+const deleteStaleOrganizationTokensSQL = `DELETE
+FROM organization_tokens
+WHERE expiry < now()
+OR COALESCE(last_used_at, created_at) < $1
+RETURNING *;`
+
+type DeleteStaleOrganizationTokensRow struct {
+	OrganizationTokenID pgtype.Text        `json:"organization_token_id"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	OrganizationName    pgtype.Text        `json:"organization_name"`
+	Expiry              pgtype.Timestamptz `json:"expiry"`
+	Scope               pgtype.Text        `json:"scope"`
+	LastUsedAt          pgtype.Timestamptz `json:"last_used_at"`
+}
+
+// DeleteStaleOrganizationTokens implements Querier.DeleteStaleOrganizationTokens.
+func (q *DBQuerier) DeleteStaleOrganizationTokens(ctx context.Context, cutoff pgtype.Timestamptz) ([]DeleteStaleOrganizationTokensRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "DeleteStaleOrganizationTokens")
+	rows, err := q.conn.Query(ctx, deleteStaleOrganizationTokensSQL, cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("query DeleteStaleOrganizationTokens: %w", err)
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (DeleteStaleOrganizationTokensRow, error) {
+		var item DeleteStaleOrganizationTokensRow
+		if err := row.Scan(&item.OrganizationTokenID, // 'organization_token_id', 'OrganizationTokenID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.CreatedAt,        // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.OrganizationName, // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Expiry,           // 'expiry', 'Expiry', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.Scope,            // 'scope', 'Scope', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LastUsedAt,       // 'last_used_at', 'LastUsedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+		); err != nil {
+			return item, fmt.Errorf("failed to scan: %w", err)
+		}
+		return item, nil
+	})
+}
@@ -81,29 +81,33 @@ func (q *DBQuerier) InsertLogChunk(ctx context.Context, params InsertLogChunkPar
 	})
 }
 
-const findLogsSQL = `SELECT
-    string_agg(chunk, '')
-FROM (
-    SELECT run_id, phase, chunk
-    FROM logs
-    WHERE run_id = $1
-    AND   phase  = $2
-    ORDER BY chunk_id
-) c
-GROUP BY run_id, phase
+const findLogChunksSQL = `SELECT
+    chunk_id,
+    chunk
+FROM logs
+WHERE run_id = $1
+AND   phase  = $2
+ORDER BY chunk_id
 ;`
 
-// FindLogs implements Querier.FindLogs.
-func (q *DBQuerier) FindLogs(ctx context.Context, runID pgtype.Text, phase pgtype.Text) ([]byte, error) {
-	ctx = context.WithValue(ctx, "pggen_query_name", "FindLogs")
-	rows, err := q.conn.Query(ctx, findLogsSQL, runID, phase)
+type FindLogChunksRow struct {
+	ChunkID pgtype.Int4 `json:"chunk_id"`
+	Chunk   []byte      `json:"chunk"`
+}
+
+// FindLogChunks implements Querier.FindLogChunks.
+func (q *DBQuerier) FindLogChunks(ctx context.Context, runID pgtype.Text, phase pgtype.Text) ([]FindLogChunksRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "FindLogChunks")
+	rows, err := q.conn.Query(ctx, findLogChunksSQL, runID, phase)
 	if err != nil {
-		return nil, fmt.Errorf("query FindLogs: %w", err)
+		return nil, fmt.Errorf("query FindLogChunks: %w", err)
 	}
 
-	return pgx.CollectOneRow(rows, func(row pgx.CollectableRow) ([]byte, error) {
-		var item []byte
-		if err := row.Scan(&item); err != nil {
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (FindLogChunksRow, error) {
+		var item FindLogChunksRow
+		if err := row.Scan(&item.ChunkID, // 'chunk_id', 'ChunkID', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.Chunk, // 'chunk', 'Chunk', '[]byte', '', '[]byte'
+		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
 		return item, nil
@@ -16,16 +16,18 @@ var _ RegisterConn = (*pgx.Conn)(nil)
 
 const insertLatestTerraformVersionSQL = `INSERT INTO latest_terraform_version (
     version,
-    checkpoint
+    checkpoint,
+    engine
 ) VALUES (
     $1,
-    current_timestamp
+    current_timestamp,
+    $2
 );`
 
 // InsertLatestTerraformVersion implements Querier.InsertLatestTerraformVersion.
-func (q *DBQuerier) InsertLatestTerraformVersion(ctx context.Context, version pgtype.Text) (pgconn.CommandTag, error) {
+func (q *DBQuerier) InsertLatestTerraformVersion(ctx context.Context, version pgtype.Text, engine pgtype.Text) (pgconn.CommandTag, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "InsertLatestTerraformVersion")
-	cmdTag, err := q.conn.Exec(ctx, insertLatestTerraformVersionSQL, version)
+	cmdTag, err := q.conn.Exec(ctx, insertLatestTerraformVersionSQL, version, engine)
 	if err != nil {
 		return pgconn.CommandTag{}, fmt.Errorf("exec query InsertLatestTerraformVersion: %w", err)
 	}
@@ -34,12 +36,13 @@ func (q *DBQuerier) InsertLatestTerraformVersion(ctx context.Context, version pg
 
 const updateLatestTerraformVersionSQL = `UPDATE latest_terraform_version
 SET version = $1,
-    checkpoint = current_timestamp;`
+    checkpoint = current_timestamp
+WHERE engine = $2;`
 
 // UpdateLatestTerraformVersion implements Querier.UpdateLatestTerraformVersion.
-func (q *DBQuerier) UpdateLatestTerraformVersion(ctx context.Context, version pgtype.Text) (pgconn.CommandTag, error) {
+func (q *DBQuerier) UpdateLatestTerraformVersion(ctx context.Context, version pgtype.Text, engine pgtype.Text) (pgconn.CommandTag, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "UpdateLatestTerraformVersion")
-	cmdTag, err := q.conn.Exec(ctx, updateLatestTerraformVersionSQL, version)
+	cmdTag, err := q.conn.Exec(ctx, updateLatestTerraformVersionSQL, version, engine)
 	if err != nil {
 		return pgconn.CommandTag{}, fmt.Errorf("exec query UpdateLatestTerraformVersion: %w", err)
 	}
@@ -47,17 +50,19 @@ func (q *DBQuerier) UpdateLatestTerraformVersion(ctx context.Context, version pg
 }
 
 const findLatestTerraformVersionSQL = `SELECT *
-FROM latest_terraform_version;`
+FROM latest_terraform_version
+WHERE engine = $1;`
 
 type FindLatestTerraformVersionRow struct {
 	Version    pgtype.Text        `json:"version"`
 	Checkpoint pgtype.Timestamptz `json:"checkpoint"`
+	Engine     pgtype.Text        `json:"engine"`
 }
 
 // FindLatestTerraformVersion implements Querier.FindLatestTerraformVersion.
-func (q *DBQuerier) FindLatestTerraformVersion(ctx context.Context) ([]FindLatestTerraformVersionRow, error) {
+func (q *DBQuerier) FindLatestTerraformVersion(ctx context.Context, engine pgtype.Text) ([]FindLatestTerraformVersionRow, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "FindLatestTerraformVersion")
-	rows, err := q.conn.Query(ctx, findLatestTerraformVersionSQL)
+	rows, err := q.conn.Query(ctx, findLatestTerraformVersionSQL, engine)
 	if err != nil {
 		return nil, fmt.Errorf("query FindLatestTerraformVersion: %w", err)
 	}
@@ -66,6 +71,7 @@ func (q *DBQuerier) FindLatestTerraformVersion(ctx context.Context) ([]FindLates
 		var item FindLatestTerraformVersionRow
 		if err := row.Scan(&item.Version, // 'version', 'Version', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.Checkpoint, // 'checkpoint', 'Checkpoint', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.Engine,     // 'engine', 'Engine', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
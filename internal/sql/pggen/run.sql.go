@@ -31,7 +31,8 @@ const insertRunSQL = `INSERT INTO runs (
     workspace_id,
     created_by,
     terraform_version,
-    allow_empty_apply
+    allow_empty_apply,
+    engine
 ) VALUES (
     $1,
     $2,
@@ -49,7 +50,8 @@ const insertRunSQL = `INSERT INTO runs (
     $14,
     $15,
     $16,
-    $17
+    $17,
+    $18
 );`
 
 type InsertRunParams struct {
@@ -70,12 +72,13 @@ type InsertRunParams struct {
 	CreatedBy              pgtype.Text        `json:"created_by"`
 	TerraformVersion       pgtype.Text        `json:"terraform_version"`
 	AllowEmptyApply        pgtype.Bool        `json:"allow_empty_apply"`
+	Engine                 pgtype.Text        `json:"engine"`
 }
 
 // InsertRun implements Querier.InsertRun.
 func (q *DBQuerier) InsertRun(ctx context.Context, params InsertRunParams) (pgconn.CommandTag, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "InsertRun")
-	cmdTag, err := q.conn.Exec(ctx, insertRunSQL, params.ID, params.CreatedAt, params.IsDestroy, params.PositionInQueue, params.Refresh, params.RefreshOnly, params.Source, params.Status, params.ReplaceAddrs, params.TargetAddrs, params.AutoApply, params.PlanOnly, params.ConfigurationVersionID, params.WorkspaceID, params.CreatedBy, params.TerraformVersion, params.AllowEmptyApply)
+	cmdTag, err := q.conn.Exec(ctx, insertRunSQL, params.ID, params.CreatedAt, params.IsDestroy, params.PositionInQueue, params.Refresh, params.RefreshOnly, params.Source, params.Status, params.ReplaceAddrs, params.TargetAddrs, params.AutoApply, params.PlanOnly, params.ConfigurationVersionID, params.WorkspaceID, params.CreatedBy, params.TerraformVersion, params.AllowEmptyApply, params.Engine)
 	if err != nil {
 		return pgconn.CommandTag{}, fmt.Errorf("exec query InsertRun: %w", err)
 	}
@@ -158,6 +161,7 @@ const findRunsSQL = `SELECT
     runs.created_by,
     runs.terraform_version,
     runs.allow_empty_apply,
+    runs.engine,
     workspaces.execution_mode AS execution_mode,
     CASE WHEN workspaces.latest_run_id = runs.run_id THEN true
          ELSE false
@@ -247,6 +251,7 @@ type FindRunsRow struct {
 	CreatedBy              pgtype.Text              `json:"created_by"`
 	TerraformVersion       pgtype.Text              `json:"terraform_version"`
 	AllowEmptyApply        pgtype.Bool              `json:"allow_empty_apply"`
+	Engine                 pgtype.Text              `json:"engine"`
 	ExecutionMode          pgtype.Text              `json:"execution_mode"`
 	Latest                 pgtype.Bool              `json:"latest"`
 	OrganizationName       pgtype.Text              `json:"organization_name"`
@@ -291,6 +296,7 @@ func (q *DBQuerier) FindRuns(ctx context.Context, params FindRunsParams) ([]Find
 			&item.CreatedBy,              // 'created_by', 'CreatedBy', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.TerraformVersion,       // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.AllowEmptyApply,        // 'allow_empty_apply', 'AllowEmptyApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Engine,                 // 'engine', 'Engine', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.ExecutionMode,          // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.Latest,                 // 'latest', 'Latest', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.OrganizationName,       // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
@@ -374,6 +380,7 @@ const findRunByIDSQL = `SELECT
     runs.created_by,
     runs.terraform_version,
     runs.allow_empty_apply,
+    runs.engine,
     workspaces.execution_mode AS execution_mode,
     CASE WHEN workspaces.latest_run_id = runs.run_id THEN true
          ELSE false
@@ -440,6 +447,7 @@ type FindRunByIDRow struct {
 	CreatedBy              pgtype.Text              `json:"created_by"`
 	TerraformVersion       pgtype.Text              `json:"terraform_version"`
 	AllowEmptyApply        pgtype.Bool              `json:"allow_empty_apply"`
+	Engine                 pgtype.Text              `json:"engine"`
 	ExecutionMode          pgtype.Text              `json:"execution_mode"`
 	Latest                 pgtype.Bool              `json:"latest"`
 	OrganizationName       pgtype.Text              `json:"organization_name"`
@@ -484,6 +492,7 @@ func (q *DBQuerier) FindRunByID(ctx context.Context, runID pgtype.Text) (FindRun
 			&item.CreatedBy,              // 'created_by', 'CreatedBy', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.TerraformVersion,       // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.AllowEmptyApply,        // 'allow_empty_apply', 'AllowEmptyApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Engine,                 // 'engine', 'Engine', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.ExecutionMode,          // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.Latest,                 // 'latest', 'Latest', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.OrganizationName,       // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
@@ -524,6 +533,7 @@ const findRunByIDForUpdateSQL = `SELECT
     runs.created_by,
     runs.terraform_version,
     runs.allow_empty_apply,
+    runs.engine,
     workspaces.execution_mode AS execution_mode,
     CASE WHEN workspaces.latest_run_id = runs.run_id THEN true
          ELSE false
@@ -591,6 +601,7 @@ type FindRunByIDForUpdateRow struct {
 	CreatedBy              pgtype.Text              `json:"created_by"`
 	TerraformVersion       pgtype.Text              `json:"terraform_version"`
 	AllowEmptyApply        pgtype.Bool              `json:"allow_empty_apply"`
+	Engine                 pgtype.Text              `json:"engine"`
 	ExecutionMode          pgtype.Text              `json:"execution_mode"`
 	Latest                 pgtype.Bool              `json:"latest"`
 	OrganizationName       pgtype.Text              `json:"organization_name"`
@@ -635,6 +646,7 @@ func (q *DBQuerier) FindRunByIDForUpdate(ctx context.Context, runID pgtype.Text)
 			&item.CreatedBy,              // 'created_by', 'CreatedBy', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.TerraformVersion,       // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.AllowEmptyApply,        // 'allow_empty_apply', 'AllowEmptyApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Engine,                 // 'engine', 'Engine', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.ExecutionMode,          // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.Latest,                 // 'latest', 'Latest', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.OrganizationName,       // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
@@ -79,7 +79,12 @@ func (q *DBQuerier) DiscardPendingStateVersionsByWorkspaceID(ctx context.Context
 }
 
 const findStateVersionsByWorkspaceIDSQL = `SELECT
-    sv.*,
+    sv.state_version_id,
+    sv.created_at,
+    sv.serial,
+    NULL::bytea AS state,
+    sv.workspace_id,
+    sv.status,
     array_remove(array_agg(state_version_outputs), NULL) AS state_version_outputs
 FROM state_versions sv
 LEFT JOIN state_version_outputs USING (state_version_id)
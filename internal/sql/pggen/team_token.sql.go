@@ -18,28 +18,33 @@ const insertTeamTokenSQL = `INSERT INTO team_tokens (
     team_token_id,
     created_at,
     team_id,
-    expiry
+    expiry,
+    scope
 ) VALUES (
     $1,
     $2,
     $3,
-    $4
+    $4,
+    $5
 ) ON CONFLICT (team_id) DO UPDATE
   SET team_token_id = $1,
       created_at    = $2,
-      expiry        = $4;`
+      expiry        = $4,
+      scope         = $5,
+      last_used_at  = NULL;`
 
 type InsertTeamTokenParams struct {
 	TeamTokenID pgtype.Text        `json:"team_token_id"`
 	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 	TeamID      pgtype.Text        `json:"team_id"`
 	Expiry      pgtype.Timestamptz `json:"expiry"`
+	Scope       pgtype.Text        `json:"scope"`
 }
 
 // InsertTeamToken implements Querier.InsertTeamToken.
 func (q *DBQuerier) InsertTeamToken(ctx context.Context, params InsertTeamTokenParams) (pgconn.CommandTag, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "InsertTeamToken")
-	cmdTag, err := q.conn.Exec(ctx, insertTeamTokenSQL, params.TeamTokenID, params.CreatedAt, params.TeamID, params.Expiry)
+	cmdTag, err := q.conn.Exec(ctx, insertTeamTokenSQL, params.TeamTokenID, params.CreatedAt, params.TeamID, params.Expiry, params.Scope)
 	if err != nil {
 		return pgconn.CommandTag{}, fmt.Errorf("exec query InsertTeamToken: %w", err)
 	}
@@ -57,6 +62,8 @@ type FindTeamTokensByIDRow struct {
 	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 	TeamID      pgtype.Text        `json:"team_id"`
 	Expiry      pgtype.Timestamptz `json:"expiry"`
+	Scope       pgtype.Text        `json:"scope"`
+	LastUsedAt  pgtype.Timestamptz `json:"last_used_at"`
 }
 
 // FindTeamTokensByID implements Querier.FindTeamTokensByID.
@@ -74,6 +81,8 @@ func (q *DBQuerier) FindTeamTokensByID(ctx context.Context, teamID pgtype.Text)
 			&item.CreatedAt,   // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.TeamID,      // 'team_id', 'TeamID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.Expiry,      // 'expiry', 'Expiry', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.Scope,       // 'scope', 'Scope', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LastUsedAt,  // 'last_used_at', 'LastUsedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -103,3 +112,112 @@ func (q *DBQuerier) DeleteTeamTokenByID(ctx context.Context, teamID pgtype.Text)
 		return item, nil
 	})
 }
+
+const updateTeamTokenLastUsedAtSQL = `UPDATE team_tokens
+SET last_used_at = $1
+WHERE team_token_id = $2
+;`
+
+// UpdateTeamTokenLastUsedAt implements Querier.UpdateTeamTokenLastUsedAt.
+func (q *DBQuerier) UpdateTeamTokenLastUsedAt(ctx context.Context, lastUsedAt pgtype.Timestamptz, teamTokenID pgtype.Text) (pgconn.CommandTag, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "UpdateTeamTokenLastUsedAt")
+	cmdTag, err := q.conn.Exec(ctx, updateTeamTokenLastUsedAtSQL, lastUsedAt, teamTokenID)
+	if err != nil {
+		return pgconn.CommandTag{}, fmt.Errorf("exec query UpdateTeamTokenLastUsedAt: %w", err)
+	}
+	return cmdTag, err
+}
+
+const findStaleTeamTokensSQL = `SELECT tt.*, t.name AS team_name, t.organization_name
+FROM team_tokens tt
+JOIN teams t USING (team_id)
+WHERE tt.expiry < now()
+OR COALESCE(tt.last_used_at, tt.created_at) < $1
+;`
+
+type FindStaleTeamTokensRow struct {
+	TeamTokenID      pgtype.Text        `json:"team_token_id"`
+	Description      pgtype.Text        `json:"description"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	TeamID           pgtype.Text        `json:"team_id"`
+	Expiry           pgtype.Timestamptz `json:"expiry"`
+	Scope            pgtype.Text        `json:"scope"`
+	LastUsedAt       pgtype.Timestamptz `json:"last_used_at"`
+	TeamName         pgtype.Text        `json:"team_name"`
+	OrganizationName pgtype.Text        `json:"organization_name"`
+}
+
+// FindStaleTeamTokens implements Querier.FindStaleTeamTokens.
+func (q *DBQuerier) FindStaleTeamTokens(ctx context.Context, cutoff pgtype.Timestamptz) ([]FindStaleTeamTokensRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "FindStaleTeamTokens")
+	rows, err := q.conn.Query(ctx, findStaleTeamTokensSQL, cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("query FindStaleTeamTokens: %w", err)
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (FindStaleTeamTokensRow, error) {
+		var item FindStaleTeamTokensRow
+		if err := row.Scan(&item.TeamTokenID, // 'team_token_id', 'TeamTokenID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Description,      // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.CreatedAt,        // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.TeamID,           // 'team_id', 'TeamID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Expiry,           // 'expiry', 'Expiry', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.Scope,            // 'scope', 'Scope', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LastUsedAt,       // 'last_used_at', 'LastUsedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.TeamName,         // 'team_name', 'TeamName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.OrganizationName, // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+		); err != nil {
+			return item, fmt.Errorf("failed to scan: %w", err)
+		}
+		return item, nil
+	})
+}
+
+const deleteStaleTeamTokensSQL = `DELETE
+FROM team_tokens tt
+USING teams t
+WHERE tt.team_id = t.team_id
+AND (
+    tt.expiry < now()
+    OR COALESCE(tt.last_used_at, tt.created_at) < $1
+)
+RETURNING tt.*, t.name AS team_name, t.organization_name
+;`
+
+type DeleteStaleTeamTokensRow struct {
+	TeamTokenID      pgtype.Text        `json:"team_token_id"`
+	Description      pgtype.Text        `json:"description"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	TeamID           pgtype.Text        `json:"team_id"`
+	Expiry           pgtype.Timestamptz `json:"expiry"`
+	Scope            pgtype.Text        `json:"scope"`
+	LastUsedAt       pgtype.Timestamptz `json:"last_used_at"`
+	TeamName         pgtype.Text        `json:"team_name"`
+	OrganizationName pgtype.Text        `json:"organization_name"`
+}
+
+// DeleteStaleTeamTokens implements Querier.DeleteStaleTeamTokens.
+func (q *DBQuerier) DeleteStaleTeamTokens(ctx context.Context, cutoff pgtype.Timestamptz) ([]DeleteStaleTeamTokensRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "DeleteStaleTeamTokens")
+	rows, err := q.conn.Query(ctx, deleteStaleTeamTokensSQL, cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("query DeleteStaleTeamTokens: %w", err)
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (DeleteStaleTeamTokensRow, error) {
+		var item DeleteStaleTeamTokensRow
+		if err := row.Scan(&item.TeamTokenID, // 'team_token_id', 'TeamTokenID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Description,      // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.CreatedAt,        // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.TeamID,           // 'team_id', 'TeamID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Expiry,           // 'expiry', 'Expiry', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.Scope,            // 'scope', 'Scope', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LastUsedAt,       // 'last_used_at', 'LastUsedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.TeamName,         // 'team_name', 'TeamName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.OrganizationName, // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+		); err != nil {
+			return item, fmt.Errorf("failed to scan: %w", err)
+		}
+		return item, nil
+	})
+}
@@ -18,12 +18,16 @@ const insertTokenSQL = `INSERT INTO tokens (
     token_id,
     created_at,
     description,
-    username
+    username,
+    expiry,
+    scope
 ) VALUES (
     $1,
     $2,
     $3,
-    $4
+    $4,
+    $5,
+    $6
 );`
 
 type InsertTokenParams struct {
@@ -31,12 +35,14 @@ type InsertTokenParams struct {
 	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 	Description pgtype.Text        `json:"description"`
 	Username    pgtype.Text        `json:"username"`
+	Expiry      pgtype.Timestamptz `json:"expiry"`
+	Scope       pgtype.Text        `json:"scope"`
 }
 
 // InsertToken implements Querier.InsertToken.
 func (q *DBQuerier) InsertToken(ctx context.Context, params InsertTokenParams) (pgconn.CommandTag, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "InsertToken")
-	cmdTag, err := q.conn.Exec(ctx, insertTokenSQL, params.TokenID, params.CreatedAt, params.Description, params.Username)
+	cmdTag, err := q.conn.Exec(ctx, insertTokenSQL, params.TokenID, params.CreatedAt, params.Description, params.Username, params.Expiry, params.Scope)
 	if err != nil {
 		return pgconn.CommandTag{}, fmt.Errorf("exec query InsertToken: %w", err)
 	}
@@ -53,6 +59,9 @@ type FindTokensByUsernameRow struct {
 	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 	Description pgtype.Text        `json:"description"`
 	Username    pgtype.Text        `json:"username"`
+	Expiry      pgtype.Timestamptz `json:"expiry"`
+	Scope       pgtype.Text        `json:"scope"`
+	LastUsedAt  pgtype.Timestamptz `json:"last_used_at"`
 }
 
 // FindTokensByUsername implements Querier.FindTokensByUsername.
@@ -69,6 +78,9 @@ func (q *DBQuerier) FindTokensByUsername(ctx context.Context, username pgtype.Te
 			&item.CreatedAt,   // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.Description, // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.Username,    // 'username', 'Username', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Expiry,      // 'expiry', 'Expiry', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.Scope,       // 'scope', 'Scope', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LastUsedAt,  // 'last_used_at', 'LastUsedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -86,6 +98,9 @@ type FindTokenByIDRow struct {
 	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 	Description pgtype.Text        `json:"description"`
 	Username    pgtype.Text        `json:"username"`
+	Expiry      pgtype.Timestamptz `json:"expiry"`
+	Scope       pgtype.Text        `json:"scope"`
+	LastUsedAt  pgtype.Timestamptz `json:"last_used_at"`
 }
 
 // FindTokenByID implements Querier.FindTokenByID.
@@ -102,6 +117,9 @@ func (q *DBQuerier) FindTokenByID(ctx context.Context, tokenID pgtype.Text) (Fin
 			&item.CreatedAt,   // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.Description, // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
 			&item.Username,    // 'username', 'Username', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Expiry,      // 'expiry', 'Expiry', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.Scope,       // 'scope', 'Scope', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LastUsedAt,  // 'last_used_at', 'LastUsedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -131,3 +149,114 @@ func (q *DBQuerier) DeleteTokenByID(ctx context.Context, tokenID pgtype.Text) (p
 		return item, nil
 	})
 }
+
+const deleteTokensByUsernameSQL = `DELETE
+FROM tokens
+WHERE username = $1
+;`
+
+// DeleteTokensByUsername implements Querier.DeleteTokensByUsername.
+func (q *DBQuerier) DeleteTokensByUsername(ctx context.Context, username pgtype.Text) (pgconn.CommandTag, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "DeleteTokensByUsername")
+	cmdTag, err := q.conn.Exec(ctx, deleteTokensByUsernameSQL, username)
+	if err != nil {
+		return pgconn.CommandTag{}, fmt.Errorf("exec query DeleteTokensByUsername: %w", err)
+	}
+	return cmdTag, err
+}
+
+const updateTokenLastUsedAtSQL = `UPDATE tokens
+SET last_used_at = $1
+WHERE token_id = $2
+;`
+
+// UpdateTokenLastUsedAt implements Querier.UpdateTokenLastUsedAt.
+func (q *DBQuerier) UpdateTokenLastUsedAt(ctx context.Context, lastUsedAt pgtype.Timestamptz, tokenID pgtype.Text) (pgconn.CommandTag, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "UpdateTokenLastUsedAt")
+	cmdTag, err := q.conn.Exec(ctx, updateTokenLastUsedAtSQL, lastUsedAt, tokenID)
+	if err != nil {
+		return pgconn.CommandTag{}, fmt.Errorf("exec query UpdateTokenLastUsedAt: %w", err)
+	}
+	return cmdTag, err
+}
+
+const findStaleTokensSQL = `SELECT *
+FROM tokens
+WHERE expiry < now()
+OR COALESCE(last_used_at, created_at) < $1
+;`
+
+type FindStaleTokensRow struct {
+	TokenID     pgtype.Text        `json:"token_id"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	Description pgtype.Text        `json:"description"`
+	Username    pgtype.Text        `json:"username"`
+	Expiry      pgtype.Timestamptz `json:"expiry"`
+	Scope       pgtype.Text        `json:"scope"`
+	LastUsedAt  pgtype.Timestamptz `json:"last_used_at"`
+}
+
+// FindStaleTokens implements Querier.FindStaleTokens.
+func (q *DBQuerier) FindStaleTokens(ctx context.Context, cutoff pgtype.Timestamptz) ([]FindStaleTokensRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "FindStaleTokens")
+	rows, err := q.conn.Query(ctx, findStaleTokensSQL, cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("query FindStaleTokens: %w", err)
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (FindStaleTokensRow, error) {
+		var item FindStaleTokensRow
+		if err := row.Scan(&item.TokenID, // 'token_id', 'TokenID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.CreatedAt,   // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.Description, // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Username,    // 'username', 'Username', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Expiry,      // 'expiry', 'Expiry', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.Scope,       // 'scope', 'Scope', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LastUsedAt,  // 'last_used_at', 'LastUsedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+		); err != nil {
+			return item, fmt.Errorf("failed to scan: %w", err)
+		}
+		return item, nil
+	})
+}
+
+const deleteStaleTokensSQL = `DELETE
+FROM tokens
+WHERE expiry < now()
+OR COALESCE(last_used_at, created_at) < $1
+RETURNING *
+;`
+
+type DeleteStaleTokensRow struct {
+	TokenID     pgtype.Text        `json:"token_id"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	Description pgtype.Text        `json:"description"`
+	Username    pgtype.Text        `json:"username"`
+	Expiry      pgtype.Timestamptz `json:"expiry"`
+	Scope       pgtype.Text        `json:"scope"`
+	LastUsedAt  pgtype.Timestamptz `json:"last_used_at"`
+}
+
+// DeleteStaleTokens implements Querier.DeleteStaleTokens.
+func (q *DBQuerier) DeleteStaleTokens(ctx context.Context, cutoff pgtype.Timestamptz) ([]DeleteStaleTokensRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "DeleteStaleTokens")
+	rows, err := q.conn.Query(ctx, deleteStaleTokensSQL, cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("query DeleteStaleTokens: %w", err)
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (DeleteStaleTokensRow, error) {
+		var item DeleteStaleTokensRow
+		if err := row.Scan(&item.TokenID, // 'token_id', 'TokenID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.CreatedAt,   // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.Description, // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Username,    // 'username', 'Username', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Expiry,      // 'expiry', 'Expiry', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.Scope,       // 'scope', 'Scope', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LastUsedAt,  // 'last_used_at', 'LastUsedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+		); err != nil {
+			return item, fmt.Errorf("failed to scan: %w", err)
+		}
+		return item, nil
+	})
+}
@@ -18,12 +18,14 @@ const insertUserSQL = `INSERT INTO users (
     user_id,
     created_at,
     updated_at,
-    username
+    username,
+    active
 ) VALUES (
     $1,
     $2,
     $3,
-    $4
+    $4,
+    $5
 );`
 
 type InsertUserParams struct {
@@ -31,12 +33,13 @@ type InsertUserParams struct {
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 	Username  pgtype.Text        `json:"username"`
+	Active    pgtype.Bool        `json:"active"`
 }
 
 // InsertUser implements Querier.InsertUser.
 func (q *DBQuerier) InsertUser(ctx context.Context, params InsertUserParams) (pgconn.CommandTag, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "InsertUser")
-	cmdTag, err := q.conn.Exec(ctx, insertUserSQL, params.ID, params.CreatedAt, params.UpdatedAt, params.Username)
+	cmdTag, err := q.conn.Exec(ctx, insertUserSQL, params.ID, params.CreatedAt, params.UpdatedAt, params.Username, params.Active)
 	if err != nil {
 		return pgconn.CommandTag{}, fmt.Errorf("exec query InsertUser: %w", err)
 	}
@@ -59,6 +62,7 @@ type FindUsersRow struct {
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 	SiteAdmin pgtype.Bool        `json:"site_admin"`
+	Active    pgtype.Bool        `json:"active"`
 	Teams     []*Teams           `json:"teams"`
 }
 
@@ -77,6 +81,7 @@ func (q *DBQuerier) FindUsers(ctx context.Context) ([]FindUsersRow, error) {
 			&item.CreatedAt, // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.UpdatedAt, // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.SiteAdmin, // 'site_admin', 'SiteAdmin', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Active,    // 'active', 'Active', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.Teams,     // 'teams', 'Teams', '[]*Teams', '', '[]*Teams'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
@@ -105,6 +110,7 @@ type FindUsersByOrganizationRow struct {
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 	SiteAdmin pgtype.Bool        `json:"site_admin"`
+	Active    pgtype.Bool        `json:"active"`
 	Teams     []*Teams           `json:"teams"`
 }
 
@@ -123,6 +129,7 @@ func (q *DBQuerier) FindUsersByOrganization(ctx context.Context, organizationNam
 			&item.CreatedAt, // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.UpdatedAt, // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.SiteAdmin, // 'site_admin', 'SiteAdmin', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Active,    // 'active', 'Active', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.Teams,     // 'teams', 'Teams', '[]*Teams', '', '[]*Teams'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
@@ -151,6 +158,7 @@ type FindUsersByTeamIDRow struct {
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 	SiteAdmin pgtype.Bool        `json:"site_admin"`
+	Active    pgtype.Bool        `json:"active"`
 	Teams     []*Teams           `json:"teams"`
 }
 
@@ -169,6 +177,7 @@ func (q *DBQuerier) FindUsersByTeamID(ctx context.Context, teamID pgtype.Text) (
 			&item.CreatedAt, // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.UpdatedAt, // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.SiteAdmin, // 'site_admin', 'SiteAdmin', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Active,    // 'active', 'Active', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.Teams,     // 'teams', 'Teams', '[]*Teams', '', '[]*Teams'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
@@ -194,6 +203,7 @@ type FindUserByIDRow struct {
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 	SiteAdmin pgtype.Bool        `json:"site_admin"`
+	Active    pgtype.Bool        `json:"active"`
 	Teams     []*Teams           `json:"teams"`
 }
 
@@ -212,6 +222,7 @@ func (q *DBQuerier) FindUserByID(ctx context.Context, userID pgtype.Text) (FindU
 			&item.CreatedAt, // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.UpdatedAt, // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.SiteAdmin, // 'site_admin', 'SiteAdmin', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Active,    // 'active', 'Active', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.Teams,     // 'teams', 'Teams', '[]*Teams', '', '[]*Teams'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
@@ -237,6 +248,7 @@ type FindUserByUsernameRow struct {
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 	SiteAdmin pgtype.Bool        `json:"site_admin"`
+	Active    pgtype.Bool        `json:"active"`
 	Teams     []*Teams           `json:"teams"`
 }
 
@@ -255,6 +267,7 @@ func (q *DBQuerier) FindUserByUsername(ctx context.Context, username pgtype.Text
 			&item.CreatedAt, // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.UpdatedAt, // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.SiteAdmin, // 'site_admin', 'SiteAdmin', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Active,    // 'active', 'Active', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.Teams,     // 'teams', 'Teams', '[]*Teams', '', '[]*Teams'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
@@ -281,6 +294,7 @@ type FindUserByAuthenticationTokenIDRow struct {
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 	SiteAdmin pgtype.Bool        `json:"site_admin"`
+	Active    pgtype.Bool        `json:"active"`
 	Teams     []*Teams           `json:"teams"`
 }
 
@@ -299,6 +313,7 @@ func (q *DBQuerier) FindUserByAuthenticationTokenID(ctx context.Context, tokenID
 			&item.CreatedAt, // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.UpdatedAt, // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
 			&item.SiteAdmin, // 'site_admin', 'SiteAdmin', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Active,    // 'active', 'Active', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.Teams,     // 'teams', 'Teams', '[]*Teams', '', '[]*Teams'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
@@ -398,3 +413,27 @@ func (q *DBQuerier) DeleteUserByUsername(ctx context.Context, username pgtype.Te
 		return item, nil
 	})
 }
+
+const updateUserSQL = `UPDATE users
+SET username = $1,
+    active = $2,
+    updated_at = $3
+WHERE user_id = $4
+;`
+
+type UpdateUserParams struct {
+	Username  pgtype.Text        `json:"username"`
+	Active    pgtype.Bool        `json:"active"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	UserID    pgtype.Text        `json:"user_id"`
+}
+
+// UpdateUser implements Querier.UpdateUser.
+func (q *DBQuerier) UpdateUser(ctx context.Context, params UpdateUserParams) (pgconn.CommandTag, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "UpdateUser")
+	cmdTag, err := q.conn.Exec(ctx, updateUserSQL, params.Username, params.Active, params.UpdatedAt, params.UserID)
+	if err != nil {
+		return pgconn.CommandTag{}, fmt.Errorf("exec query UpdateUser: %w", err)
+	}
+	return cmdTag, err
+}
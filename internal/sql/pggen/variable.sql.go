@@ -22,7 +22,8 @@ const insertVariableSQL = `INSERT INTO variables (
     category,
     sensitive,
     hcl,
-    version_id
+    version_id,
+    secret_ref
 ) VALUES (
     $1,
     $2,
@@ -31,7 +32,8 @@ const insertVariableSQL = `INSERT INTO variables (
     $5,
     $6,
     $7,
-    $8
+    $8,
+    $9
 );`
 
 type InsertVariableParams struct {
@@ -43,12 +45,13 @@ type InsertVariableParams struct {
 	Sensitive   pgtype.Bool `json:"sensitive"`
 	HCL         pgtype.Bool `json:"hcl"`
 	VersionID   pgtype.Text `json:"version_id"`
+	SecretRef   pgtype.Bool `json:"secret_ref"`
 }
 
 // InsertVariable implements Querier.InsertVariable.
 func (q *DBQuerier) InsertVariable(ctx context.Context, params InsertVariableParams) (pgconn.CommandTag, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "InsertVariable")
-	cmdTag, err := q.conn.Exec(ctx, insertVariableSQL, params.VariableID, params.Key, params.Value, params.Description, params.Category, params.Sensitive, params.HCL, params.VersionID)
+	cmdTag, err := q.conn.Exec(ctx, insertVariableSQL, params.VariableID, params.Key, params.Value, params.Description, params.Category, params.Sensitive, params.HCL, params.VersionID, params.SecretRef)
 	if err != nil {
 		return pgconn.CommandTag{}, fmt.Errorf("exec query InsertVariable: %w", err)
 	}
@@ -69,6 +72,7 @@ type FindVariableRow struct {
 	Sensitive   pgtype.Bool `json:"sensitive"`
 	HCL         pgtype.Bool `json:"hcl"`
 	VersionID   pgtype.Text `json:"version_id"`
+	SecretRef   pgtype.Bool `json:"secret_ref"`
 }
 
 // FindVariable implements Querier.FindVariable.
@@ -89,6 +93,7 @@ func (q *DBQuerier) FindVariable(ctx context.Context, variableID pgtype.Text) (F
 			&item.Sensitive,   // 'sensitive', 'Sensitive', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.HCL,         // 'hcl', 'HCL', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.VersionID,   // 'version_id', 'VersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.SecretRef,   // 'secret_ref', 'SecretRef', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -104,8 +109,9 @@ SET
     category = $4,
     sensitive = $5,
     version_id = $6,
-    hcl = $7
-WHERE variable_id = $8
+    hcl = $7,
+    secret_ref = $8
+WHERE variable_id = $9
 RETURNING variable_id
 ;`
 
@@ -117,13 +123,14 @@ type UpdateVariableByIDParams struct {
 	Sensitive   pgtype.Bool `json:"sensitive"`
 	VersionID   pgtype.Text `json:"version_id"`
 	HCL         pgtype.Bool `json:"hcl"`
+	SecretRef   pgtype.Bool `json:"secret_ref"`
 	VariableID  pgtype.Text `json:"variable_id"`
 }
 
 // UpdateVariableByID implements Querier.UpdateVariableByID.
 func (q *DBQuerier) UpdateVariableByID(ctx context.Context, params UpdateVariableByIDParams) (pgtype.Text, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "UpdateVariableByID")
-	rows, err := q.conn.Query(ctx, updateVariableByIDSQL, params.Key, params.Value, params.Description, params.Category, params.Sensitive, params.VersionID, params.HCL, params.VariableID)
+	rows, err := q.conn.Query(ctx, updateVariableByIDSQL, params.Key, params.Value, params.Description, params.Category, params.Sensitive, params.VersionID, params.HCL, params.SecretRef, params.VariableID)
 	if err != nil {
 		return pgtype.Text{}, fmt.Errorf("query UpdateVariableByID: %w", err)
 	}
@@ -152,6 +159,7 @@ type DeleteVariableByIDRow struct {
 	Sensitive   pgtype.Bool `json:"sensitive"`
 	HCL         pgtype.Bool `json:"hcl"`
 	VersionID   pgtype.Text `json:"version_id"`
+	SecretRef   pgtype.Bool `json:"secret_ref"`
 }
 
 // DeleteVariableByID implements Querier.DeleteVariableByID.
@@ -172,6 +180,7 @@ func (q *DBQuerier) DeleteVariableByID(ctx context.Context, variableID pgtype.Te
 			&item.Sensitive,   // 'sensitive', 'Sensitive', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.HCL,         // 'hcl', 'HCL', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.VersionID,   // 'version_id', 'VersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.SecretRef,   // 'secret_ref', 'SecretRef', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -40,7 +40,16 @@ const insertWorkspaceSQL = `INSERT INTO workspaces (
     trigger_patterns,
     vcs_tags_regex,
     working_directory,
-    organization_name
+    organization_name,
+    engine,
+    assessments_enabled,
+    auto_destroy_at,
+    auto_destroy_activity_duration,
+    delete_after_auto_destroy,
+    project_id,
+    agent_selector,
+    plan_timeout,
+    apply_timeout
 ) VALUES (
     $1,
     $2,
@@ -67,42 +76,60 @@ const insertWorkspaceSQL = `INSERT INTO workspaces (
     $23,
     $24,
     $25,
-    $26
+    $26,
+    $27,
+    $28,
+    $29,
+    $30,
+    $31,
+    $32,
+    $33,
+    $34,
+    $35
 );`
 
 type InsertWorkspaceParams struct {
-	ID                         pgtype.Text        `json:"id"`
-	CreatedAt                  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt                  pgtype.Timestamptz `json:"updated_at"`
-	AgentPoolID                pgtype.Text        `json:"agent_pool_id"`
-	AllowCLIApply              pgtype.Bool        `json:"allow_cli_apply"`
-	AllowDestroyPlan           pgtype.Bool        `json:"allow_destroy_plan"`
-	AutoApply                  pgtype.Bool        `json:"auto_apply"`
-	Branch                     pgtype.Text        `json:"branch"`
-	CanQueueDestroyPlan        pgtype.Bool        `json:"can_queue_destroy_plan"`
-	Description                pgtype.Text        `json:"description"`
-	Environment                pgtype.Text        `json:"environment"`
-	ExecutionMode              pgtype.Text        `json:"execution_mode"`
-	GlobalRemoteState          pgtype.Bool        `json:"global_remote_state"`
-	MigrationEnvironment       pgtype.Text        `json:"migration_environment"`
-	Name                       pgtype.Text        `json:"name"`
-	QueueAllRuns               pgtype.Bool        `json:"queue_all_runs"`
-	SpeculativeEnabled         pgtype.Bool        `json:"speculative_enabled"`
-	SourceName                 pgtype.Text        `json:"source_name"`
-	SourceURL                  pgtype.Text        `json:"source_url"`
-	StructuredRunOutputEnabled pgtype.Bool        `json:"structured_run_output_enabled"`
-	TerraformVersion           pgtype.Text        `json:"terraform_version"`
-	TriggerPrefixes            []string           `json:"trigger_prefixes"`
-	TriggerPatterns            []string           `json:"trigger_patterns"`
-	VCSTagsRegex               pgtype.Text        `json:"vcs_tags_regex"`
-	WorkingDirectory           pgtype.Text        `json:"working_directory"`
-	OrganizationName           pgtype.Text        `json:"organization_name"`
+	ID                          pgtype.Text        `json:"id"`
+	CreatedAt                   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt                   pgtype.Timestamptz `json:"updated_at"`
+	AgentPoolID                 pgtype.Text        `json:"agent_pool_id"`
+	AllowCLIApply               pgtype.Bool        `json:"allow_cli_apply"`
+	AllowDestroyPlan            pgtype.Bool        `json:"allow_destroy_plan"`
+	AutoApply                   pgtype.Bool        `json:"auto_apply"`
+	Branch                      pgtype.Text        `json:"branch"`
+	CanQueueDestroyPlan         pgtype.Bool        `json:"can_queue_destroy_plan"`
+	Description                 pgtype.Text        `json:"description"`
+	Environment                 pgtype.Text        `json:"environment"`
+	ExecutionMode               pgtype.Text        `json:"execution_mode"`
+	GlobalRemoteState           pgtype.Bool        `json:"global_remote_state"`
+	MigrationEnvironment        pgtype.Text        `json:"migration_environment"`
+	Name                        pgtype.Text        `json:"name"`
+	QueueAllRuns                pgtype.Bool        `json:"queue_all_runs"`
+	SpeculativeEnabled          pgtype.Bool        `json:"speculative_enabled"`
+	SourceName                  pgtype.Text        `json:"source_name"`
+	SourceURL                   pgtype.Text        `json:"source_url"`
+	StructuredRunOutputEnabled  pgtype.Bool        `json:"structured_run_output_enabled"`
+	TerraformVersion            pgtype.Text        `json:"terraform_version"`
+	TriggerPrefixes             []string           `json:"trigger_prefixes"`
+	TriggerPatterns             []string           `json:"trigger_patterns"`
+	VCSTagsRegex                pgtype.Text        `json:"vcs_tags_regex"`
+	WorkingDirectory            pgtype.Text        `json:"working_directory"`
+	OrganizationName            pgtype.Text        `json:"organization_name"`
+	Engine                      pgtype.Text        `json:"engine"`
+	AssessmentsEnabled          pgtype.Bool        `json:"assessments_enabled"`
+	AutoDestroyAt               pgtype.Timestamptz `json:"auto_destroy_at"`
+	AutoDestroyActivityDuration pgtype.Text        `json:"auto_destroy_activity_duration"`
+	DeleteAfterAutoDestroy      pgtype.Bool        `json:"delete_after_auto_destroy"`
+	ProjectID                   pgtype.Text        `json:"project_id"`
+	AgentSelector               pgtype.Text        `json:"agent_selector"`
+	PlanTimeout                 pgtype.Int4        `json:"plan_timeout"`
+	ApplyTimeout                pgtype.Int4        `json:"apply_timeout"`
 }
 
 // InsertWorkspace implements Querier.InsertWorkspace.
 func (q *DBQuerier) InsertWorkspace(ctx context.Context, params InsertWorkspaceParams) (pgconn.CommandTag, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "InsertWorkspace")
-	cmdTag, err := q.conn.Exec(ctx, insertWorkspaceSQL, params.ID, params.CreatedAt, params.UpdatedAt, params.AgentPoolID, params.AllowCLIApply, params.AllowDestroyPlan, params.AutoApply, params.Branch, params.CanQueueDestroyPlan, params.Description, params.Environment, params.ExecutionMode, params.GlobalRemoteState, params.MigrationEnvironment, params.Name, params.QueueAllRuns, params.SpeculativeEnabled, params.SourceName, params.SourceURL, params.StructuredRunOutputEnabled, params.TerraformVersion, params.TriggerPrefixes, params.TriggerPatterns, params.VCSTagsRegex, params.WorkingDirectory, params.OrganizationName)
+	cmdTag, err := q.conn.Exec(ctx, insertWorkspaceSQL, params.ID, params.CreatedAt, params.UpdatedAt, params.AgentPoolID, params.AllowCLIApply, params.AllowDestroyPlan, params.AutoApply, params.Branch, params.CanQueueDestroyPlan, params.Description, params.Environment, params.ExecutionMode, params.GlobalRemoteState, params.MigrationEnvironment, params.Name, params.QueueAllRuns, params.SpeculativeEnabled, params.SourceName, params.SourceURL, params.StructuredRunOutputEnabled, params.TerraformVersion, params.TriggerPrefixes, params.TriggerPatterns, params.VCSTagsRegex, params.WorkingDirectory, params.OrganizationName, params.Engine, params.AssessmentsEnabled, params.AutoDestroyAt, params.AutoDestroyActivityDuration, params.DeleteAfterAutoDestroy, params.ProjectID, params.AgentSelector, params.PlanTimeout, params.ApplyTimeout)
 	if err != nil {
 		return pgconn.CommandTag{}, fmt.Errorf("exec query InsertWorkspace: %w", err)
 	}
@@ -138,63 +165,78 @@ LEFT JOIN runs r ON w.latest_run_id = r.run_id
 LEFT JOIN (workspace_tags wt JOIN tags t USING (tag_id)) ON wt.workspace_id = w.workspace_id
 WHERE w.name                LIKE '%' || $1 || '%'
 AND   w.organization_name   LIKE ANY($2)
+AND   ($3::text IS NULL OR w.project_id = $3)
 GROUP BY w.workspace_id, r.status
-HAVING array_agg(t.name) @> $3
+HAVING array_agg(t.name) @> $4
 ORDER BY w.updated_at DESC
-LIMIT $4
-OFFSET $5
+LIMIT $5
+OFFSET $6
 ;`
 
 type FindWorkspacesParams struct {
 	Search            pgtype.Text `json:"search"`
 	OrganizationNames []string    `json:"organization_names"`
+	ProjectID         pgtype.Text `json:"project_id"`
 	Tags              []string    `json:"tags"`
 	Limit             pgtype.Int8 `json:"limit"`
 	Offset            pgtype.Int8 `json:"offset"`
 }
 
 type FindWorkspacesRow struct {
-	WorkspaceID                pgtype.Text        `json:"workspace_id"`
-	CreatedAt                  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt                  pgtype.Timestamptz `json:"updated_at"`
-	AllowDestroyPlan           pgtype.Bool        `json:"allow_destroy_plan"`
-	AutoApply                  pgtype.Bool        `json:"auto_apply"`
-	CanQueueDestroyPlan        pgtype.Bool        `json:"can_queue_destroy_plan"`
-	Description                pgtype.Text        `json:"description"`
-	Environment                pgtype.Text        `json:"environment"`
-	ExecutionMode              pgtype.Text        `json:"execution_mode"`
-	GlobalRemoteState          pgtype.Bool        `json:"global_remote_state"`
-	MigrationEnvironment       pgtype.Text        `json:"migration_environment"`
-	Name                       pgtype.Text        `json:"name"`
-	QueueAllRuns               pgtype.Bool        `json:"queue_all_runs"`
-	SpeculativeEnabled         pgtype.Bool        `json:"speculative_enabled"`
-	SourceName                 pgtype.Text        `json:"source_name"`
-	SourceURL                  pgtype.Text        `json:"source_url"`
-	StructuredRunOutputEnabled pgtype.Bool        `json:"structured_run_output_enabled"`
-	TerraformVersion           pgtype.Text        `json:"terraform_version"`
-	TriggerPrefixes            []string           `json:"trigger_prefixes"`
-	WorkingDirectory           pgtype.Text        `json:"working_directory"`
-	LockRunID                  pgtype.Text        `json:"lock_run_id"`
-	LatestRunID                pgtype.Text        `json:"latest_run_id"`
-	OrganizationName           pgtype.Text        `json:"organization_name"`
-	Branch                     pgtype.Text        `json:"branch"`
-	LockUsername               pgtype.Text        `json:"lock_username"`
-	CurrentStateVersionID      pgtype.Text        `json:"current_state_version_id"`
-	TriggerPatterns            []string           `json:"trigger_patterns"`
-	VCSTagsRegex               pgtype.Text        `json:"vcs_tags_regex"`
-	AllowCLIApply              pgtype.Bool        `json:"allow_cli_apply"`
-	AgentPoolID                pgtype.Text        `json:"agent_pool_id"`
-	Tags                       []string           `json:"tags"`
-	LatestRunStatus            pgtype.Text        `json:"latest_run_status"`
-	UserLock                   *Users             `json:"user_lock"`
-	RunLock                    *Runs              `json:"run_lock"`
-	WorkspaceConnection        *RepoConnections   `json:"workspace_connection"`
+	WorkspaceID                 pgtype.Text        `json:"workspace_id"`
+	CreatedAt                   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt                   pgtype.Timestamptz `json:"updated_at"`
+	AllowDestroyPlan            pgtype.Bool        `json:"allow_destroy_plan"`
+	AutoApply                   pgtype.Bool        `json:"auto_apply"`
+	CanQueueDestroyPlan         pgtype.Bool        `json:"can_queue_destroy_plan"`
+	Description                 pgtype.Text        `json:"description"`
+	Environment                 pgtype.Text        `json:"environment"`
+	ExecutionMode               pgtype.Text        `json:"execution_mode"`
+	GlobalRemoteState           pgtype.Bool        `json:"global_remote_state"`
+	MigrationEnvironment        pgtype.Text        `json:"migration_environment"`
+	Name                        pgtype.Text        `json:"name"`
+	QueueAllRuns                pgtype.Bool        `json:"queue_all_runs"`
+	SpeculativeEnabled          pgtype.Bool        `json:"speculative_enabled"`
+	SourceName                  pgtype.Text        `json:"source_name"`
+	SourceURL                   pgtype.Text        `json:"source_url"`
+	StructuredRunOutputEnabled  pgtype.Bool        `json:"structured_run_output_enabled"`
+	TerraformVersion            pgtype.Text        `json:"terraform_version"`
+	TriggerPrefixes             []string           `json:"trigger_prefixes"`
+	WorkingDirectory            pgtype.Text        `json:"working_directory"`
+	LockRunID                   pgtype.Text        `json:"lock_run_id"`
+	LatestRunID                 pgtype.Text        `json:"latest_run_id"`
+	OrganizationName            pgtype.Text        `json:"organization_name"`
+	Branch                      pgtype.Text        `json:"branch"`
+	LockUsername                pgtype.Text        `json:"lock_username"`
+	CurrentStateVersionID       pgtype.Text        `json:"current_state_version_id"`
+	TriggerPatterns             []string           `json:"trigger_patterns"`
+	VCSTagsRegex                pgtype.Text        `json:"vcs_tags_regex"`
+	AllowCLIApply               pgtype.Bool        `json:"allow_cli_apply"`
+	AgentPoolID                 pgtype.Text        `json:"agent_pool_id"`
+	Engine                      pgtype.Text        `json:"engine"`
+	AssessmentsEnabled          pgtype.Bool        `json:"assessments_enabled"`
+	LatestAssessmentRunID       pgtype.Text        `json:"latest_assessment_run_id"`
+	LatestAssessmentStatus      pgtype.Text        `json:"latest_assessment_status"`
+	LatestAssessmentCreatedAt   pgtype.Timestamptz `json:"latest_assessment_created_at"`
+	AutoDestroyAt               pgtype.Timestamptz `json:"auto_destroy_at"`
+	AutoDestroyActivityDuration pgtype.Text        `json:"auto_destroy_activity_duration"`
+	DeleteAfterAutoDestroy      pgtype.Bool        `json:"delete_after_auto_destroy"`
+	AutoDestroyRunID            pgtype.Text        `json:"auto_destroy_run_id"`
+	ProjectID                   pgtype.Text        `json:"project_id"`
+	AgentSelector               pgtype.Text        `json:"agent_selector"`
+	PlanTimeout                 pgtype.Int4        `json:"plan_timeout"`
+	ApplyTimeout                pgtype.Int4        `json:"apply_timeout"`
+	Tags                        []string           `json:"tags"`
+	LatestRunStatus             pgtype.Text        `json:"latest_run_status"`
+	UserLock                    *Users             `json:"user_lock"`
+	RunLock                     *Runs              `json:"run_lock"`
+	WorkspaceConnection         *RepoConnections   `json:"workspace_connection"`
 }
 
 // FindWorkspaces implements Querier.FindWorkspaces.
 func (q *DBQuerier) FindWorkspaces(ctx context.Context, params FindWorkspacesParams) ([]FindWorkspacesRow, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "FindWorkspaces")
-	rows, err := q.conn.Query(ctx, findWorkspacesSQL, params.Search, params.OrganizationNames, params.Tags, params.Limit, params.Offset)
+	rows, err := q.conn.Query(ctx, findWorkspacesSQL, params.Search, params.OrganizationNames, params.ProjectID, params.Tags, params.Limit, params.Offset)
 	if err != nil {
 		return nil, fmt.Errorf("query FindWorkspaces: %w", err)
 	}
@@ -202,40 +244,53 @@ func (q *DBQuerier) FindWorkspaces(ctx context.Context, params FindWorkspacesPar
 	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (FindWorkspacesRow, error) {
 		var item FindWorkspacesRow
 		if err := row.Scan(&item.WorkspaceID, // 'workspace_id', 'WorkspaceID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.CreatedAt,                  // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
-			&item.UpdatedAt,                  // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
-			&item.AllowDestroyPlan,           // 'allow_destroy_plan', 'AllowDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.AutoApply,                  // 'auto_apply', 'AutoApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.CanQueueDestroyPlan,        // 'can_queue_destroy_plan', 'CanQueueDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.Description,                // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Environment,                // 'environment', 'Environment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.ExecutionMode,              // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.GlobalRemoteState,          // 'global_remote_state', 'GlobalRemoteState', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.MigrationEnvironment,       // 'migration_environment', 'MigrationEnvironment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Name,                       // 'name', 'Name', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.QueueAllRuns,               // 'queue_all_runs', 'QueueAllRuns', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.SpeculativeEnabled,         // 'speculative_enabled', 'SpeculativeEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.SourceName,                 // 'source_name', 'SourceName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.SourceURL,                  // 'source_url', 'SourceURL', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.StructuredRunOutputEnabled, // 'structured_run_output_enabled', 'StructuredRunOutputEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.TerraformVersion,           // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.TriggerPrefixes,            // 'trigger_prefixes', 'TriggerPrefixes', '[]string', '', '[]string'
-			&item.WorkingDirectory,           // 'working_directory', 'WorkingDirectory', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LockRunID,                  // 'lock_run_id', 'LockRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LatestRunID,                // 'latest_run_id', 'LatestRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.OrganizationName,           // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Branch,                     // 'branch', 'Branch', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LockUsername,               // 'lock_username', 'LockUsername', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.CurrentStateVersionID,      // 'current_state_version_id', 'CurrentStateVersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.TriggerPatterns,            // 'trigger_patterns', 'TriggerPatterns', '[]string', '', '[]string'
-			&item.VCSTagsRegex,               // 'vcs_tags_regex', 'VCSTagsRegex', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.AllowCLIApply,              // 'allow_cli_apply', 'AllowCLIApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.AgentPoolID,                // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Tags,                       // 'tags', 'Tags', '[]string', '', '[]string'
-			&item.LatestRunStatus,            // 'latest_run_status', 'LatestRunStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.UserLock,                   // 'user_lock', 'UserLock', '*Users', '', '*Users'
-			&item.RunLock,                    // 'run_lock', 'RunLock', '*Runs', '', '*Runs'
-			&item.WorkspaceConnection,        // 'workspace_connection', 'WorkspaceConnection', '*RepoConnections', '', '*RepoConnections'
+			&item.CreatedAt,                   // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.UpdatedAt,                   // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AllowDestroyPlan,            // 'allow_destroy_plan', 'AllowDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AutoApply,                   // 'auto_apply', 'AutoApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.CanQueueDestroyPlan,         // 'can_queue_destroy_plan', 'CanQueueDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Description,                 // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Environment,                 // 'environment', 'Environment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.ExecutionMode,               // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.GlobalRemoteState,           // 'global_remote_state', 'GlobalRemoteState', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.MigrationEnvironment,        // 'migration_environment', 'MigrationEnvironment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Name,                        // 'name', 'Name', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.QueueAllRuns,                // 'queue_all_runs', 'QueueAllRuns', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.SpeculativeEnabled,          // 'speculative_enabled', 'SpeculativeEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.SourceName,                  // 'source_name', 'SourceName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.SourceURL,                   // 'source_url', 'SourceURL', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.StructuredRunOutputEnabled,  // 'structured_run_output_enabled', 'StructuredRunOutputEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.TerraformVersion,            // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.TriggerPrefixes,             // 'trigger_prefixes', 'TriggerPrefixes', '[]string', '', '[]string'
+			&item.WorkingDirectory,            // 'working_directory', 'WorkingDirectory', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LockRunID,                   // 'lock_run_id', 'LockRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestRunID,                 // 'latest_run_id', 'LatestRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.OrganizationName,            // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Branch,                      // 'branch', 'Branch', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LockUsername,                // 'lock_username', 'LockUsername', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.CurrentStateVersionID,       // 'current_state_version_id', 'CurrentStateVersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.TriggerPatterns,             // 'trigger_patterns', 'TriggerPatterns', '[]string', '', '[]string'
+			&item.VCSTagsRegex,                // 'vcs_tags_regex', 'VCSTagsRegex', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AllowCLIApply,               // 'allow_cli_apply', 'AllowCLIApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AgentPoolID,                 // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Engine,                      // 'engine', 'Engine', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AssessmentsEnabled,          // 'assessments_enabled', 'AssessmentsEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.LatestAssessmentRunID,       // 'latest_assessment_run_id', 'LatestAssessmentRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestAssessmentStatus,      // 'latest_assessment_status', 'LatestAssessmentStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestAssessmentCreatedAt,   // 'latest_assessment_created_at', 'LatestAssessmentCreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AutoDestroyAt,               // 'auto_destroy_at', 'AutoDestroyAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AutoDestroyActivityDuration, // 'auto_destroy_activity_duration', 'AutoDestroyActivityDuration', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.DeleteAfterAutoDestroy,      // 'delete_after_auto_destroy', 'DeleteAfterAutoDestroy', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AutoDestroyRunID,            // 'auto_destroy_run_id', 'AutoDestroyRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.ProjectID,                   // 'project_id', 'ProjectID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AgentSelector,               // 'agent_selector', 'AgentSelector', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.PlanTimeout,                 // 'plan_timeout', 'PlanTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.ApplyTimeout,                // 'apply_timeout', 'ApplyTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.Tags,                        // 'tags', 'Tags', '[]string', '', '[]string'
+			&item.LatestRunStatus,             // 'latest_run_status', 'LatestRunStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.UserLock,                    // 'user_lock', 'UserLock', '*Users', '', '*Users'
+			&item.RunLock,                     // 'run_lock', 'RunLock', '*Runs', '', '*Runs'
+			&item.WorkspaceConnection,         // 'workspace_connection', 'WorkspaceConnection', '*RepoConnections', '', '*RepoConnections'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -250,8 +305,9 @@ const countWorkspacesSQL = `WITH
         LEFT JOIN (workspace_tags wt JOIN tags t USING (tag_id)) ON w.workspace_id = wt.workspace_id
         WHERE w.name              LIKE '%' || $1 || '%'
         AND   w.organization_name LIKE ANY($2)
+        AND   ($3::text IS NULL OR w.project_id = $3)
         GROUP BY w.workspace_id
-        HAVING array_agg(t.name) @> $3
+        HAVING array_agg(t.name) @> $4
     )
 SELECT count(*)
 FROM workspaces
@@ -260,13 +316,14 @@ FROM workspaces
 type CountWorkspacesParams struct {
 	Search            pgtype.Text `json:"search"`
 	OrganizationNames []string    `json:"organization_names"`
+	ProjectID         pgtype.Text `json:"project_id"`
 	Tags              []string    `json:"tags"`
 }
 
 // CountWorkspaces implements Querier.CountWorkspaces.
 func (q *DBQuerier) CountWorkspaces(ctx context.Context, params CountWorkspacesParams) (pgtype.Int8, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "CountWorkspaces")
-	rows, err := q.conn.Query(ctx, countWorkspacesSQL, params.Search, params.OrganizationNames, params.Tags)
+	rows, err := q.conn.Query(ctx, countWorkspacesSQL, params.Search, params.OrganizationNames, params.ProjectID, params.Tags)
 	if err != nil {
 		return pgtype.Int8{}, fmt.Errorf("query CountWorkspaces: %w", err)
 	}
@@ -302,41 +359,54 @@ AND   rc.repo_path = $2
 ;`
 
 type FindWorkspacesByConnectionRow struct {
-	WorkspaceID                pgtype.Text        `json:"workspace_id"`
-	CreatedAt                  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt                  pgtype.Timestamptz `json:"updated_at"`
-	AllowDestroyPlan           pgtype.Bool        `json:"allow_destroy_plan"`
-	AutoApply                  pgtype.Bool        `json:"auto_apply"`
-	CanQueueDestroyPlan        pgtype.Bool        `json:"can_queue_destroy_plan"`
-	Description                pgtype.Text        `json:"description"`
-	Environment                pgtype.Text        `json:"environment"`
-	ExecutionMode              pgtype.Text        `json:"execution_mode"`
-	GlobalRemoteState          pgtype.Bool        `json:"global_remote_state"`
-	MigrationEnvironment       pgtype.Text        `json:"migration_environment"`
-	Name                       pgtype.Text        `json:"name"`
-	QueueAllRuns               pgtype.Bool        `json:"queue_all_runs"`
-	SpeculativeEnabled         pgtype.Bool        `json:"speculative_enabled"`
-	SourceName                 pgtype.Text        `json:"source_name"`
-	SourceURL                  pgtype.Text        `json:"source_url"`
-	StructuredRunOutputEnabled pgtype.Bool        `json:"structured_run_output_enabled"`
-	TerraformVersion           pgtype.Text        `json:"terraform_version"`
-	TriggerPrefixes            []string           `json:"trigger_prefixes"`
-	WorkingDirectory           pgtype.Text        `json:"working_directory"`
-	LockRunID                  pgtype.Text        `json:"lock_run_id"`
-	LatestRunID                pgtype.Text        `json:"latest_run_id"`
-	OrganizationName           pgtype.Text        `json:"organization_name"`
-	Branch                     pgtype.Text        `json:"branch"`
-	LockUsername               pgtype.Text        `json:"lock_username"`
-	CurrentStateVersionID      pgtype.Text        `json:"current_state_version_id"`
-	TriggerPatterns            []string           `json:"trigger_patterns"`
-	VCSTagsRegex               pgtype.Text        `json:"vcs_tags_regex"`
-	AllowCLIApply              pgtype.Bool        `json:"allow_cli_apply"`
-	AgentPoolID                pgtype.Text        `json:"agent_pool_id"`
-	Tags                       []string           `json:"tags"`
-	LatestRunStatus            pgtype.Text        `json:"latest_run_status"`
-	UserLock                   *Users             `json:"user_lock"`
-	RunLock                    *Runs              `json:"run_lock"`
-	WorkspaceConnection        *RepoConnections   `json:"workspace_connection"`
+	WorkspaceID                 pgtype.Text        `json:"workspace_id"`
+	CreatedAt                   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt                   pgtype.Timestamptz `json:"updated_at"`
+	AllowDestroyPlan            pgtype.Bool        `json:"allow_destroy_plan"`
+	AutoApply                   pgtype.Bool        `json:"auto_apply"`
+	CanQueueDestroyPlan         pgtype.Bool        `json:"can_queue_destroy_plan"`
+	Description                 pgtype.Text        `json:"description"`
+	Environment                 pgtype.Text        `json:"environment"`
+	ExecutionMode               pgtype.Text        `json:"execution_mode"`
+	GlobalRemoteState           pgtype.Bool        `json:"global_remote_state"`
+	MigrationEnvironment        pgtype.Text        `json:"migration_environment"`
+	Name                        pgtype.Text        `json:"name"`
+	QueueAllRuns                pgtype.Bool        `json:"queue_all_runs"`
+	SpeculativeEnabled          pgtype.Bool        `json:"speculative_enabled"`
+	SourceName                  pgtype.Text        `json:"source_name"`
+	SourceURL                   pgtype.Text        `json:"source_url"`
+	StructuredRunOutputEnabled  pgtype.Bool        `json:"structured_run_output_enabled"`
+	TerraformVersion            pgtype.Text        `json:"terraform_version"`
+	TriggerPrefixes             []string           `json:"trigger_prefixes"`
+	WorkingDirectory            pgtype.Text        `json:"working_directory"`
+	LockRunID                   pgtype.Text        `json:"lock_run_id"`
+	LatestRunID                 pgtype.Text        `json:"latest_run_id"`
+	OrganizationName            pgtype.Text        `json:"organization_name"`
+	Branch                      pgtype.Text        `json:"branch"`
+	LockUsername                pgtype.Text        `json:"lock_username"`
+	CurrentStateVersionID       pgtype.Text        `json:"current_state_version_id"`
+	TriggerPatterns             []string           `json:"trigger_patterns"`
+	VCSTagsRegex                pgtype.Text        `json:"vcs_tags_regex"`
+	AllowCLIApply               pgtype.Bool        `json:"allow_cli_apply"`
+	AgentPoolID                 pgtype.Text        `json:"agent_pool_id"`
+	Engine                      pgtype.Text        `json:"engine"`
+	AssessmentsEnabled          pgtype.Bool        `json:"assessments_enabled"`
+	LatestAssessmentRunID       pgtype.Text        `json:"latest_assessment_run_id"`
+	LatestAssessmentStatus      pgtype.Text        `json:"latest_assessment_status"`
+	LatestAssessmentCreatedAt   pgtype.Timestamptz `json:"latest_assessment_created_at"`
+	AutoDestroyAt               pgtype.Timestamptz `json:"auto_destroy_at"`
+	AutoDestroyActivityDuration pgtype.Text        `json:"auto_destroy_activity_duration"`
+	DeleteAfterAutoDestroy      pgtype.Bool        `json:"delete_after_auto_destroy"`
+	AutoDestroyRunID            pgtype.Text        `json:"auto_destroy_run_id"`
+	ProjectID                   pgtype.Text        `json:"project_id"`
+	AgentSelector               pgtype.Text        `json:"agent_selector"`
+	PlanTimeout                 pgtype.Int4        `json:"plan_timeout"`
+	ApplyTimeout                pgtype.Int4        `json:"apply_timeout"`
+	Tags                        []string           `json:"tags"`
+	LatestRunStatus             pgtype.Text        `json:"latest_run_status"`
+	UserLock                    *Users             `json:"user_lock"`
+	RunLock                     *Runs              `json:"run_lock"`
+	WorkspaceConnection         *RepoConnections   `json:"workspace_connection"`
 }
 
 // FindWorkspacesByConnection implements Querier.FindWorkspacesByConnection.
@@ -350,40 +420,53 @@ func (q *DBQuerier) FindWorkspacesByConnection(ctx context.Context, vcsProviderI
 	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (FindWorkspacesByConnectionRow, error) {
 		var item FindWorkspacesByConnectionRow
 		if err := row.Scan(&item.WorkspaceID, // 'workspace_id', 'WorkspaceID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.CreatedAt,                  // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
-			&item.UpdatedAt,                  // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
-			&item.AllowDestroyPlan,           // 'allow_destroy_plan', 'AllowDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.AutoApply,                  // 'auto_apply', 'AutoApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.CanQueueDestroyPlan,        // 'can_queue_destroy_plan', 'CanQueueDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.Description,                // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Environment,                // 'environment', 'Environment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.ExecutionMode,              // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.GlobalRemoteState,          // 'global_remote_state', 'GlobalRemoteState', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.MigrationEnvironment,       // 'migration_environment', 'MigrationEnvironment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Name,                       // 'name', 'Name', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.QueueAllRuns,               // 'queue_all_runs', 'QueueAllRuns', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.SpeculativeEnabled,         // 'speculative_enabled', 'SpeculativeEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.SourceName,                 // 'source_name', 'SourceName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.SourceURL,                  // 'source_url', 'SourceURL', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.StructuredRunOutputEnabled, // 'structured_run_output_enabled', 'StructuredRunOutputEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.TerraformVersion,           // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.TriggerPrefixes,            // 'trigger_prefixes', 'TriggerPrefixes', '[]string', '', '[]string'
-			&item.WorkingDirectory,           // 'working_directory', 'WorkingDirectory', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LockRunID,                  // 'lock_run_id', 'LockRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LatestRunID,                // 'latest_run_id', 'LatestRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.OrganizationName,           // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Branch,                     // 'branch', 'Branch', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LockUsername,               // 'lock_username', 'LockUsername', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.CurrentStateVersionID,      // 'current_state_version_id', 'CurrentStateVersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.TriggerPatterns,            // 'trigger_patterns', 'TriggerPatterns', '[]string', '', '[]string'
-			&item.VCSTagsRegex,               // 'vcs_tags_regex', 'VCSTagsRegex', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.AllowCLIApply,              // 'allow_cli_apply', 'AllowCLIApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.AgentPoolID,                // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Tags,                       // 'tags', 'Tags', '[]string', '', '[]string'
-			&item.LatestRunStatus,            // 'latest_run_status', 'LatestRunStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.UserLock,                   // 'user_lock', 'UserLock', '*Users', '', '*Users'
-			&item.RunLock,                    // 'run_lock', 'RunLock', '*Runs', '', '*Runs'
-			&item.WorkspaceConnection,        // 'workspace_connection', 'WorkspaceConnection', '*RepoConnections', '', '*RepoConnections'
+			&item.CreatedAt,                   // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.UpdatedAt,                   // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AllowDestroyPlan,            // 'allow_destroy_plan', 'AllowDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AutoApply,                   // 'auto_apply', 'AutoApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.CanQueueDestroyPlan,         // 'can_queue_destroy_plan', 'CanQueueDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Description,                 // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Environment,                 // 'environment', 'Environment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.ExecutionMode,               // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.GlobalRemoteState,           // 'global_remote_state', 'GlobalRemoteState', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.MigrationEnvironment,        // 'migration_environment', 'MigrationEnvironment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Name,                        // 'name', 'Name', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.QueueAllRuns,                // 'queue_all_runs', 'QueueAllRuns', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.SpeculativeEnabled,          // 'speculative_enabled', 'SpeculativeEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.SourceName,                  // 'source_name', 'SourceName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.SourceURL,                   // 'source_url', 'SourceURL', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.StructuredRunOutputEnabled,  // 'structured_run_output_enabled', 'StructuredRunOutputEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.TerraformVersion,            // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.TriggerPrefixes,             // 'trigger_prefixes', 'TriggerPrefixes', '[]string', '', '[]string'
+			&item.WorkingDirectory,            // 'working_directory', 'WorkingDirectory', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LockRunID,                   // 'lock_run_id', 'LockRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestRunID,                 // 'latest_run_id', 'LatestRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.OrganizationName,            // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Branch,                      // 'branch', 'Branch', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LockUsername,                // 'lock_username', 'LockUsername', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.CurrentStateVersionID,       // 'current_state_version_id', 'CurrentStateVersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.TriggerPatterns,             // 'trigger_patterns', 'TriggerPatterns', '[]string', '', '[]string'
+			&item.VCSTagsRegex,                // 'vcs_tags_regex', 'VCSTagsRegex', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AllowCLIApply,               // 'allow_cli_apply', 'AllowCLIApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AgentPoolID,                 // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Engine,                      // 'engine', 'Engine', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AssessmentsEnabled,          // 'assessments_enabled', 'AssessmentsEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.LatestAssessmentRunID,       // 'latest_assessment_run_id', 'LatestAssessmentRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestAssessmentStatus,      // 'latest_assessment_status', 'LatestAssessmentStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestAssessmentCreatedAt,   // 'latest_assessment_created_at', 'LatestAssessmentCreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AutoDestroyAt,               // 'auto_destroy_at', 'AutoDestroyAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AutoDestroyActivityDuration, // 'auto_destroy_activity_duration', 'AutoDestroyActivityDuration', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.DeleteAfterAutoDestroy,      // 'delete_after_auto_destroy', 'DeleteAfterAutoDestroy', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AutoDestroyRunID,            // 'auto_destroy_run_id', 'AutoDestroyRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.ProjectID,                   // 'project_id', 'ProjectID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AgentSelector,               // 'agent_selector', 'AgentSelector', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.PlanTimeout,                 // 'plan_timeout', 'PlanTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.ApplyTimeout,                // 'apply_timeout', 'ApplyTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.Tags,                        // 'tags', 'Tags', '[]string', '', '[]string'
+			&item.LatestRunStatus,             // 'latest_run_status', 'LatestRunStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.UserLock,                    // 'user_lock', 'UserLock', '*Users', '', '*Users'
+			&item.RunLock,                     // 'run_lock', 'RunLock', '*Runs', '', '*Runs'
+			&item.WorkspaceConnection,         // 'workspace_connection', 'WorkspaceConnection', '*RepoConnections', '', '*RepoConnections'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -404,16 +487,23 @@ const findWorkspacesByUsernameSQL = `SELECT
     (rl.*)::"runs" AS run_lock,
     (rc.*)::"repo_connections" AS workspace_connection
 FROM workspaces w
-JOIN workspace_permissions p USING (workspace_id)
 LEFT JOIN users ul ON w.lock_username = ul.username
 LEFT JOIN runs rl ON w.lock_run_id = rl.run_id
 LEFT JOIN runs r ON w.latest_run_id = r.run_id
 LEFT JOIN repo_connections rc ON w.workspace_id = rc.workspace_id
-JOIN teams t USING (team_id)
-JOIN team_memberships tm USING (team_id)
-JOIN users u ON tm.username = u.username
 WHERE w.organization_name  = $1
-AND   u.username           = $2
+AND   w.workspace_id IN (
+    SELECT p.workspace_id
+    FROM workspace_permissions p
+    JOIN team_memberships tm USING (team_id)
+    WHERE tm.username = $2
+    UNION
+    SELECT pw.workspace_id
+    FROM workspaces pw
+    JOIN project_permissions pp USING (project_id)
+    JOIN team_memberships tm USING (team_id)
+    WHERE tm.username = $2
+)
 ORDER BY w.updated_at DESC
 LIMIT $3
 OFFSET $4
@@ -427,41 +517,54 @@ type FindWorkspacesByUsernameParams struct {
 }
 
 type FindWorkspacesByUsernameRow struct {
-	WorkspaceID                pgtype.Text        `json:"workspace_id"`
-	CreatedAt                  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt                  pgtype.Timestamptz `json:"updated_at"`
-	AllowDestroyPlan           pgtype.Bool        `json:"allow_destroy_plan"`
-	AutoApply                  pgtype.Bool        `json:"auto_apply"`
-	CanQueueDestroyPlan        pgtype.Bool        `json:"can_queue_destroy_plan"`
-	Description                pgtype.Text        `json:"description"`
-	Environment                pgtype.Text        `json:"environment"`
-	ExecutionMode              pgtype.Text        `json:"execution_mode"`
-	GlobalRemoteState          pgtype.Bool        `json:"global_remote_state"`
-	MigrationEnvironment       pgtype.Text        `json:"migration_environment"`
-	Name                       pgtype.Text        `json:"name"`
-	QueueAllRuns               pgtype.Bool        `json:"queue_all_runs"`
-	SpeculativeEnabled         pgtype.Bool        `json:"speculative_enabled"`
-	SourceName                 pgtype.Text        `json:"source_name"`
-	SourceURL                  pgtype.Text        `json:"source_url"`
-	StructuredRunOutputEnabled pgtype.Bool        `json:"structured_run_output_enabled"`
-	TerraformVersion           pgtype.Text        `json:"terraform_version"`
-	TriggerPrefixes            []string           `json:"trigger_prefixes"`
-	WorkingDirectory           pgtype.Text        `json:"working_directory"`
-	LockRunID                  pgtype.Text        `json:"lock_run_id"`
-	LatestRunID                pgtype.Text        `json:"latest_run_id"`
-	OrganizationName           pgtype.Text        `json:"organization_name"`
-	Branch                     pgtype.Text        `json:"branch"`
-	LockUsername               pgtype.Text        `json:"lock_username"`
-	CurrentStateVersionID      pgtype.Text        `json:"current_state_version_id"`
-	TriggerPatterns            []string           `json:"trigger_patterns"`
-	VCSTagsRegex               pgtype.Text        `json:"vcs_tags_regex"`
-	AllowCLIApply              pgtype.Bool        `json:"allow_cli_apply"`
-	AgentPoolID                pgtype.Text        `json:"agent_pool_id"`
-	Tags                       []string           `json:"tags"`
-	LatestRunStatus            pgtype.Text        `json:"latest_run_status"`
-	UserLock                   *Users             `json:"user_lock"`
-	RunLock                    *Runs              `json:"run_lock"`
-	WorkspaceConnection        *RepoConnections   `json:"workspace_connection"`
+	WorkspaceID                 pgtype.Text        `json:"workspace_id"`
+	CreatedAt                   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt                   pgtype.Timestamptz `json:"updated_at"`
+	AllowDestroyPlan            pgtype.Bool        `json:"allow_destroy_plan"`
+	AutoApply                   pgtype.Bool        `json:"auto_apply"`
+	CanQueueDestroyPlan         pgtype.Bool        `json:"can_queue_destroy_plan"`
+	Description                 pgtype.Text        `json:"description"`
+	Environment                 pgtype.Text        `json:"environment"`
+	ExecutionMode               pgtype.Text        `json:"execution_mode"`
+	GlobalRemoteState           pgtype.Bool        `json:"global_remote_state"`
+	MigrationEnvironment        pgtype.Text        `json:"migration_environment"`
+	Name                        pgtype.Text        `json:"name"`
+	QueueAllRuns                pgtype.Bool        `json:"queue_all_runs"`
+	SpeculativeEnabled          pgtype.Bool        `json:"speculative_enabled"`
+	SourceName                  pgtype.Text        `json:"source_name"`
+	SourceURL                   pgtype.Text        `json:"source_url"`
+	StructuredRunOutputEnabled  pgtype.Bool        `json:"structured_run_output_enabled"`
+	TerraformVersion            pgtype.Text        `json:"terraform_version"`
+	TriggerPrefixes             []string           `json:"trigger_prefixes"`
+	WorkingDirectory            pgtype.Text        `json:"working_directory"`
+	LockRunID                   pgtype.Text        `json:"lock_run_id"`
+	LatestRunID                 pgtype.Text        `json:"latest_run_id"`
+	OrganizationName            pgtype.Text        `json:"organization_name"`
+	Branch                      pgtype.Text        `json:"branch"`
+	LockUsername                pgtype.Text        `json:"lock_username"`
+	CurrentStateVersionID       pgtype.Text        `json:"current_state_version_id"`
+	TriggerPatterns             []string           `json:"trigger_patterns"`
+	VCSTagsRegex                pgtype.Text        `json:"vcs_tags_regex"`
+	AllowCLIApply               pgtype.Bool        `json:"allow_cli_apply"`
+	AgentPoolID                 pgtype.Text        `json:"agent_pool_id"`
+	Engine                      pgtype.Text        `json:"engine"`
+	AssessmentsEnabled          pgtype.Bool        `json:"assessments_enabled"`
+	LatestAssessmentRunID       pgtype.Text        `json:"latest_assessment_run_id"`
+	LatestAssessmentStatus      pgtype.Text        `json:"latest_assessment_status"`
+	LatestAssessmentCreatedAt   pgtype.Timestamptz `json:"latest_assessment_created_at"`
+	AutoDestroyAt               pgtype.Timestamptz `json:"auto_destroy_at"`
+	AutoDestroyActivityDuration pgtype.Text        `json:"auto_destroy_activity_duration"`
+	DeleteAfterAutoDestroy      pgtype.Bool        `json:"delete_after_auto_destroy"`
+	AutoDestroyRunID            pgtype.Text        `json:"auto_destroy_run_id"`
+	ProjectID                   pgtype.Text        `json:"project_id"`
+	AgentSelector               pgtype.Text        `json:"agent_selector"`
+	PlanTimeout                 pgtype.Int4        `json:"plan_timeout"`
+	ApplyTimeout                pgtype.Int4        `json:"apply_timeout"`
+	Tags                        []string           `json:"tags"`
+	LatestRunStatus             pgtype.Text        `json:"latest_run_status"`
+	UserLock                    *Users             `json:"user_lock"`
+	RunLock                     *Runs              `json:"run_lock"`
+	WorkspaceConnection         *RepoConnections   `json:"workspace_connection"`
 }
 
 // FindWorkspacesByUsername implements Querier.FindWorkspacesByUsername.
@@ -475,40 +578,53 @@ func (q *DBQuerier) FindWorkspacesByUsername(ctx context.Context, params FindWor
 	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (FindWorkspacesByUsernameRow, error) {
 		var item FindWorkspacesByUsernameRow
 		if err := row.Scan(&item.WorkspaceID, // 'workspace_id', 'WorkspaceID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.CreatedAt,                  // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
-			&item.UpdatedAt,                  // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
-			&item.AllowDestroyPlan,           // 'allow_destroy_plan', 'AllowDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.AutoApply,                  // 'auto_apply', 'AutoApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.CanQueueDestroyPlan,        // 'can_queue_destroy_plan', 'CanQueueDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.Description,                // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Environment,                // 'environment', 'Environment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.ExecutionMode,              // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.GlobalRemoteState,          // 'global_remote_state', 'GlobalRemoteState', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.MigrationEnvironment,       // 'migration_environment', 'MigrationEnvironment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Name,                       // 'name', 'Name', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.QueueAllRuns,               // 'queue_all_runs', 'QueueAllRuns', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.SpeculativeEnabled,         // 'speculative_enabled', 'SpeculativeEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.SourceName,                 // 'source_name', 'SourceName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.SourceURL,                  // 'source_url', 'SourceURL', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.StructuredRunOutputEnabled, // 'structured_run_output_enabled', 'StructuredRunOutputEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.TerraformVersion,           // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.TriggerPrefixes,            // 'trigger_prefixes', 'TriggerPrefixes', '[]string', '', '[]string'
-			&item.WorkingDirectory,           // 'working_directory', 'WorkingDirectory', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LockRunID,                  // 'lock_run_id', 'LockRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LatestRunID,                // 'latest_run_id', 'LatestRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.OrganizationName,           // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Branch,                     // 'branch', 'Branch', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LockUsername,               // 'lock_username', 'LockUsername', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.CurrentStateVersionID,      // 'current_state_version_id', 'CurrentStateVersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.TriggerPatterns,            // 'trigger_patterns', 'TriggerPatterns', '[]string', '', '[]string'
-			&item.VCSTagsRegex,               // 'vcs_tags_regex', 'VCSTagsRegex', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.AllowCLIApply,              // 'allow_cli_apply', 'AllowCLIApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.AgentPoolID,                // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Tags,                       // 'tags', 'Tags', '[]string', '', '[]string'
-			&item.LatestRunStatus,            // 'latest_run_status', 'LatestRunStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.UserLock,                   // 'user_lock', 'UserLock', '*Users', '', '*Users'
-			&item.RunLock,                    // 'run_lock', 'RunLock', '*Runs', '', '*Runs'
-			&item.WorkspaceConnection,        // 'workspace_connection', 'WorkspaceConnection', '*RepoConnections', '', '*RepoConnections'
+			&item.CreatedAt,                   // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.UpdatedAt,                   // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AllowDestroyPlan,            // 'allow_destroy_plan', 'AllowDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AutoApply,                   // 'auto_apply', 'AutoApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.CanQueueDestroyPlan,         // 'can_queue_destroy_plan', 'CanQueueDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Description,                 // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Environment,                 // 'environment', 'Environment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.ExecutionMode,               // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.GlobalRemoteState,           // 'global_remote_state', 'GlobalRemoteState', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.MigrationEnvironment,        // 'migration_environment', 'MigrationEnvironment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Name,                        // 'name', 'Name', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.QueueAllRuns,                // 'queue_all_runs', 'QueueAllRuns', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.SpeculativeEnabled,          // 'speculative_enabled', 'SpeculativeEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.SourceName,                  // 'source_name', 'SourceName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.SourceURL,                   // 'source_url', 'SourceURL', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.StructuredRunOutputEnabled,  // 'structured_run_output_enabled', 'StructuredRunOutputEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.TerraformVersion,            // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.TriggerPrefixes,             // 'trigger_prefixes', 'TriggerPrefixes', '[]string', '', '[]string'
+			&item.WorkingDirectory,            // 'working_directory', 'WorkingDirectory', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LockRunID,                   // 'lock_run_id', 'LockRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestRunID,                 // 'latest_run_id', 'LatestRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.OrganizationName,            // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Branch,                      // 'branch', 'Branch', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LockUsername,                // 'lock_username', 'LockUsername', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.CurrentStateVersionID,       // 'current_state_version_id', 'CurrentStateVersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.TriggerPatterns,             // 'trigger_patterns', 'TriggerPatterns', '[]string', '', '[]string'
+			&item.VCSTagsRegex,                // 'vcs_tags_regex', 'VCSTagsRegex', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AllowCLIApply,               // 'allow_cli_apply', 'AllowCLIApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AgentPoolID,                 // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Engine,                      // 'engine', 'Engine', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AssessmentsEnabled,          // 'assessments_enabled', 'AssessmentsEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.LatestAssessmentRunID,       // 'latest_assessment_run_id', 'LatestAssessmentRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestAssessmentStatus,      // 'latest_assessment_status', 'LatestAssessmentStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestAssessmentCreatedAt,   // 'latest_assessment_created_at', 'LatestAssessmentCreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AutoDestroyAt,               // 'auto_destroy_at', 'AutoDestroyAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AutoDestroyActivityDuration, // 'auto_destroy_activity_duration', 'AutoDestroyActivityDuration', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.DeleteAfterAutoDestroy,      // 'delete_after_auto_destroy', 'DeleteAfterAutoDestroy', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AutoDestroyRunID,            // 'auto_destroy_run_id', 'AutoDestroyRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.ProjectID,                   // 'project_id', 'ProjectID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AgentSelector,               // 'agent_selector', 'AgentSelector', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.PlanTimeout,                 // 'plan_timeout', 'PlanTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.ApplyTimeout,                // 'apply_timeout', 'ApplyTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.Tags,                        // 'tags', 'Tags', '[]string', '', '[]string'
+			&item.LatestRunStatus,             // 'latest_run_status', 'LatestRunStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.UserLock,                    // 'user_lock', 'UserLock', '*Users', '', '*Users'
+			&item.RunLock,                     // 'run_lock', 'RunLock', '*Runs', '', '*Runs'
+			&item.WorkspaceConnection,         // 'workspace_connection', 'WorkspaceConnection', '*RepoConnections', '', '*RepoConnections'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -518,12 +634,19 @@ func (q *DBQuerier) FindWorkspacesByUsername(ctx context.Context, params FindWor
 
 const countWorkspacesByUsernameSQL = `SELECT count(*)
 FROM workspaces w
-JOIN workspace_permissions p USING (workspace_id)
-JOIN teams t USING (team_id)
-JOIN team_memberships tm USING (team_id)
-JOIN users u USING (username)
 WHERE w.organization_name = $1
-AND   u.username          = $2
+AND   w.workspace_id IN (
+    SELECT p.workspace_id
+    FROM workspace_permissions p
+    JOIN team_memberships tm USING (team_id)
+    WHERE tm.username = $2
+    UNION
+    SELECT pw.workspace_id
+    FROM workspaces pw
+    JOIN project_permissions pp USING (project_id)
+    JOIN team_memberships tm USING (team_id)
+    WHERE tm.username = $2
+)
 ;`
 
 // CountWorkspacesByUsername implements Querier.CountWorkspacesByUsername.
@@ -564,41 +687,54 @@ AND   w.organization_name = $2
 ;`
 
 type FindWorkspaceByNameRow struct {
-	WorkspaceID                pgtype.Text        `json:"workspace_id"`
-	CreatedAt                  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt                  pgtype.Timestamptz `json:"updated_at"`
-	AllowDestroyPlan           pgtype.Bool        `json:"allow_destroy_plan"`
-	AutoApply                  pgtype.Bool        `json:"auto_apply"`
-	CanQueueDestroyPlan        pgtype.Bool        `json:"can_queue_destroy_plan"`
-	Description                pgtype.Text        `json:"description"`
-	Environment                pgtype.Text        `json:"environment"`
-	ExecutionMode              pgtype.Text        `json:"execution_mode"`
-	GlobalRemoteState          pgtype.Bool        `json:"global_remote_state"`
-	MigrationEnvironment       pgtype.Text        `json:"migration_environment"`
-	Name                       pgtype.Text        `json:"name"`
-	QueueAllRuns               pgtype.Bool        `json:"queue_all_runs"`
-	SpeculativeEnabled         pgtype.Bool        `json:"speculative_enabled"`
-	SourceName                 pgtype.Text        `json:"source_name"`
-	SourceURL                  pgtype.Text        `json:"source_url"`
-	StructuredRunOutputEnabled pgtype.Bool        `json:"structured_run_output_enabled"`
-	TerraformVersion           pgtype.Text        `json:"terraform_version"`
-	TriggerPrefixes            []string           `json:"trigger_prefixes"`
-	WorkingDirectory           pgtype.Text        `json:"working_directory"`
-	LockRunID                  pgtype.Text        `json:"lock_run_id"`
-	LatestRunID                pgtype.Text        `json:"latest_run_id"`
-	OrganizationName           pgtype.Text        `json:"organization_name"`
-	Branch                     pgtype.Text        `json:"branch"`
-	LockUsername               pgtype.Text        `json:"lock_username"`
-	CurrentStateVersionID      pgtype.Text        `json:"current_state_version_id"`
-	TriggerPatterns            []string           `json:"trigger_patterns"`
-	VCSTagsRegex               pgtype.Text        `json:"vcs_tags_regex"`
-	AllowCLIApply              pgtype.Bool        `json:"allow_cli_apply"`
-	AgentPoolID                pgtype.Text        `json:"agent_pool_id"`
-	Tags                       []string           `json:"tags"`
-	LatestRunStatus            pgtype.Text        `json:"latest_run_status"`
-	UserLock                   *Users             `json:"user_lock"`
-	RunLock                    *Runs              `json:"run_lock"`
-	WorkspaceConnection        *RepoConnections   `json:"workspace_connection"`
+	WorkspaceID                 pgtype.Text        `json:"workspace_id"`
+	CreatedAt                   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt                   pgtype.Timestamptz `json:"updated_at"`
+	AllowDestroyPlan            pgtype.Bool        `json:"allow_destroy_plan"`
+	AutoApply                   pgtype.Bool        `json:"auto_apply"`
+	CanQueueDestroyPlan         pgtype.Bool        `json:"can_queue_destroy_plan"`
+	Description                 pgtype.Text        `json:"description"`
+	Environment                 pgtype.Text        `json:"environment"`
+	ExecutionMode               pgtype.Text        `json:"execution_mode"`
+	GlobalRemoteState           pgtype.Bool        `json:"global_remote_state"`
+	MigrationEnvironment        pgtype.Text        `json:"migration_environment"`
+	Name                        pgtype.Text        `json:"name"`
+	QueueAllRuns                pgtype.Bool        `json:"queue_all_runs"`
+	SpeculativeEnabled          pgtype.Bool        `json:"speculative_enabled"`
+	SourceName                  pgtype.Text        `json:"source_name"`
+	SourceURL                   pgtype.Text        `json:"source_url"`
+	StructuredRunOutputEnabled  pgtype.Bool        `json:"structured_run_output_enabled"`
+	TerraformVersion            pgtype.Text        `json:"terraform_version"`
+	TriggerPrefixes             []string           `json:"trigger_prefixes"`
+	WorkingDirectory            pgtype.Text        `json:"working_directory"`
+	LockRunID                   pgtype.Text        `json:"lock_run_id"`
+	LatestRunID                 pgtype.Text        `json:"latest_run_id"`
+	OrganizationName            pgtype.Text        `json:"organization_name"`
+	Branch                      pgtype.Text        `json:"branch"`
+	LockUsername                pgtype.Text        `json:"lock_username"`
+	CurrentStateVersionID       pgtype.Text        `json:"current_state_version_id"`
+	TriggerPatterns             []string           `json:"trigger_patterns"`
+	VCSTagsRegex                pgtype.Text        `json:"vcs_tags_regex"`
+	AllowCLIApply               pgtype.Bool        `json:"allow_cli_apply"`
+	AgentPoolID                 pgtype.Text        `json:"agent_pool_id"`
+	Engine                      pgtype.Text        `json:"engine"`
+	AssessmentsEnabled          pgtype.Bool        `json:"assessments_enabled"`
+	LatestAssessmentRunID       pgtype.Text        `json:"latest_assessment_run_id"`
+	LatestAssessmentStatus      pgtype.Text        `json:"latest_assessment_status"`
+	LatestAssessmentCreatedAt   pgtype.Timestamptz `json:"latest_assessment_created_at"`
+	AutoDestroyAt               pgtype.Timestamptz `json:"auto_destroy_at"`
+	AutoDestroyActivityDuration pgtype.Text        `json:"auto_destroy_activity_duration"`
+	DeleteAfterAutoDestroy      pgtype.Bool        `json:"delete_after_auto_destroy"`
+	AutoDestroyRunID            pgtype.Text        `json:"auto_destroy_run_id"`
+	ProjectID                   pgtype.Text        `json:"project_id"`
+	AgentSelector               pgtype.Text        `json:"agent_selector"`
+	PlanTimeout                 pgtype.Int4        `json:"plan_timeout"`
+	ApplyTimeout                pgtype.Int4        `json:"apply_timeout"`
+	Tags                        []string           `json:"tags"`
+	LatestRunStatus             pgtype.Text        `json:"latest_run_status"`
+	UserLock                    *Users             `json:"user_lock"`
+	RunLock                     *Runs              `json:"run_lock"`
+	WorkspaceConnection         *RepoConnections   `json:"workspace_connection"`
 }
 
 // FindWorkspaceByName implements Querier.FindWorkspaceByName.
@@ -612,40 +748,53 @@ func (q *DBQuerier) FindWorkspaceByName(ctx context.Context, name pgtype.Text, o
 	return pgx.CollectOneRow(rows, func(row pgx.CollectableRow) (FindWorkspaceByNameRow, error) {
 		var item FindWorkspaceByNameRow
 		if err := row.Scan(&item.WorkspaceID, // 'workspace_id', 'WorkspaceID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.CreatedAt,                  // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
-			&item.UpdatedAt,                  // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
-			&item.AllowDestroyPlan,           // 'allow_destroy_plan', 'AllowDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.AutoApply,                  // 'auto_apply', 'AutoApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.CanQueueDestroyPlan,        // 'can_queue_destroy_plan', 'CanQueueDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.Description,                // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Environment,                // 'environment', 'Environment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.ExecutionMode,              // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.GlobalRemoteState,          // 'global_remote_state', 'GlobalRemoteState', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.MigrationEnvironment,       // 'migration_environment', 'MigrationEnvironment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Name,                       // 'name', 'Name', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.QueueAllRuns,               // 'queue_all_runs', 'QueueAllRuns', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.SpeculativeEnabled,         // 'speculative_enabled', 'SpeculativeEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.SourceName,                 // 'source_name', 'SourceName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.SourceURL,                  // 'source_url', 'SourceURL', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.StructuredRunOutputEnabled, // 'structured_run_output_enabled', 'StructuredRunOutputEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.TerraformVersion,           // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.TriggerPrefixes,            // 'trigger_prefixes', 'TriggerPrefixes', '[]string', '', '[]string'
-			&item.WorkingDirectory,           // 'working_directory', 'WorkingDirectory', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LockRunID,                  // 'lock_run_id', 'LockRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LatestRunID,                // 'latest_run_id', 'LatestRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.OrganizationName,           // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Branch,                     // 'branch', 'Branch', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LockUsername,               // 'lock_username', 'LockUsername', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.CurrentStateVersionID,      // 'current_state_version_id', 'CurrentStateVersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.TriggerPatterns,            // 'trigger_patterns', 'TriggerPatterns', '[]string', '', '[]string'
-			&item.VCSTagsRegex,               // 'vcs_tags_regex', 'VCSTagsRegex', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.AllowCLIApply,              // 'allow_cli_apply', 'AllowCLIApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.AgentPoolID,                // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Tags,                       // 'tags', 'Tags', '[]string', '', '[]string'
-			&item.LatestRunStatus,            // 'latest_run_status', 'LatestRunStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.UserLock,                   // 'user_lock', 'UserLock', '*Users', '', '*Users'
-			&item.RunLock,                    // 'run_lock', 'RunLock', '*Runs', '', '*Runs'
-			&item.WorkspaceConnection,        // 'workspace_connection', 'WorkspaceConnection', '*RepoConnections', '', '*RepoConnections'
+			&item.CreatedAt,                   // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.UpdatedAt,                   // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AllowDestroyPlan,            // 'allow_destroy_plan', 'AllowDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AutoApply,                   // 'auto_apply', 'AutoApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.CanQueueDestroyPlan,         // 'can_queue_destroy_plan', 'CanQueueDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Description,                 // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Environment,                 // 'environment', 'Environment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.ExecutionMode,               // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.GlobalRemoteState,           // 'global_remote_state', 'GlobalRemoteState', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.MigrationEnvironment,        // 'migration_environment', 'MigrationEnvironment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Name,                        // 'name', 'Name', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.QueueAllRuns,                // 'queue_all_runs', 'QueueAllRuns', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.SpeculativeEnabled,          // 'speculative_enabled', 'SpeculativeEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.SourceName,                  // 'source_name', 'SourceName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.SourceURL,                   // 'source_url', 'SourceURL', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.StructuredRunOutputEnabled,  // 'structured_run_output_enabled', 'StructuredRunOutputEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.TerraformVersion,            // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.TriggerPrefixes,             // 'trigger_prefixes', 'TriggerPrefixes', '[]string', '', '[]string'
+			&item.WorkingDirectory,            // 'working_directory', 'WorkingDirectory', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LockRunID,                   // 'lock_run_id', 'LockRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestRunID,                 // 'latest_run_id', 'LatestRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.OrganizationName,            // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Branch,                      // 'branch', 'Branch', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LockUsername,                // 'lock_username', 'LockUsername', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.CurrentStateVersionID,       // 'current_state_version_id', 'CurrentStateVersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.TriggerPatterns,             // 'trigger_patterns', 'TriggerPatterns', '[]string', '', '[]string'
+			&item.VCSTagsRegex,                // 'vcs_tags_regex', 'VCSTagsRegex', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AllowCLIApply,               // 'allow_cli_apply', 'AllowCLIApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AgentPoolID,                 // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Engine,                      // 'engine', 'Engine', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AssessmentsEnabled,          // 'assessments_enabled', 'AssessmentsEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.LatestAssessmentRunID,       // 'latest_assessment_run_id', 'LatestAssessmentRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestAssessmentStatus,      // 'latest_assessment_status', 'LatestAssessmentStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestAssessmentCreatedAt,   // 'latest_assessment_created_at', 'LatestAssessmentCreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AutoDestroyAt,               // 'auto_destroy_at', 'AutoDestroyAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AutoDestroyActivityDuration, // 'auto_destroy_activity_duration', 'AutoDestroyActivityDuration', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.DeleteAfterAutoDestroy,      // 'delete_after_auto_destroy', 'DeleteAfterAutoDestroy', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AutoDestroyRunID,            // 'auto_destroy_run_id', 'AutoDestroyRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.ProjectID,                   // 'project_id', 'ProjectID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AgentSelector,               // 'agent_selector', 'AgentSelector', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.PlanTimeout,                 // 'plan_timeout', 'PlanTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.ApplyTimeout,                // 'apply_timeout', 'ApplyTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.Tags,                        // 'tags', 'Tags', '[]string', '', '[]string'
+			&item.LatestRunStatus,             // 'latest_run_status', 'LatestRunStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.UserLock,                    // 'user_lock', 'UserLock', '*Users', '', '*Users'
+			&item.RunLock,                     // 'run_lock', 'RunLock', '*Runs', '', '*Runs'
+			&item.WorkspaceConnection,         // 'workspace_connection', 'WorkspaceConnection', '*RepoConnections', '', '*RepoConnections'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -673,41 +822,54 @@ WHERE w.workspace_id = $1
 ;`
 
 type FindWorkspaceByIDRow struct {
-	WorkspaceID                pgtype.Text        `json:"workspace_id"`
-	CreatedAt                  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt                  pgtype.Timestamptz `json:"updated_at"`
-	AllowDestroyPlan           pgtype.Bool        `json:"allow_destroy_plan"`
-	AutoApply                  pgtype.Bool        `json:"auto_apply"`
-	CanQueueDestroyPlan        pgtype.Bool        `json:"can_queue_destroy_plan"`
-	Description                pgtype.Text        `json:"description"`
-	Environment                pgtype.Text        `json:"environment"`
-	ExecutionMode              pgtype.Text        `json:"execution_mode"`
-	GlobalRemoteState          pgtype.Bool        `json:"global_remote_state"`
-	MigrationEnvironment       pgtype.Text        `json:"migration_environment"`
-	Name                       pgtype.Text        `json:"name"`
-	QueueAllRuns               pgtype.Bool        `json:"queue_all_runs"`
-	SpeculativeEnabled         pgtype.Bool        `json:"speculative_enabled"`
-	SourceName                 pgtype.Text        `json:"source_name"`
-	SourceURL                  pgtype.Text        `json:"source_url"`
-	StructuredRunOutputEnabled pgtype.Bool        `json:"structured_run_output_enabled"`
-	TerraformVersion           pgtype.Text        `json:"terraform_version"`
-	TriggerPrefixes            []string           `json:"trigger_prefixes"`
-	WorkingDirectory           pgtype.Text        `json:"working_directory"`
-	LockRunID                  pgtype.Text        `json:"lock_run_id"`
-	LatestRunID                pgtype.Text        `json:"latest_run_id"`
-	OrganizationName           pgtype.Text        `json:"organization_name"`
-	Branch                     pgtype.Text        `json:"branch"`
-	LockUsername               pgtype.Text        `json:"lock_username"`
-	CurrentStateVersionID      pgtype.Text        `json:"current_state_version_id"`
-	TriggerPatterns            []string           `json:"trigger_patterns"`
-	VCSTagsRegex               pgtype.Text        `json:"vcs_tags_regex"`
-	AllowCLIApply              pgtype.Bool        `json:"allow_cli_apply"`
-	AgentPoolID                pgtype.Text        `json:"agent_pool_id"`
-	Tags                       []string           `json:"tags"`
-	LatestRunStatus            pgtype.Text        `json:"latest_run_status"`
-	UserLock                   *Users             `json:"user_lock"`
-	RunLock                    *Runs              `json:"run_lock"`
-	WorkspaceConnection        *RepoConnections   `json:"workspace_connection"`
+	WorkspaceID                 pgtype.Text        `json:"workspace_id"`
+	CreatedAt                   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt                   pgtype.Timestamptz `json:"updated_at"`
+	AllowDestroyPlan            pgtype.Bool        `json:"allow_destroy_plan"`
+	AutoApply                   pgtype.Bool        `json:"auto_apply"`
+	CanQueueDestroyPlan         pgtype.Bool        `json:"can_queue_destroy_plan"`
+	Description                 pgtype.Text        `json:"description"`
+	Environment                 pgtype.Text        `json:"environment"`
+	ExecutionMode               pgtype.Text        `json:"execution_mode"`
+	GlobalRemoteState           pgtype.Bool        `json:"global_remote_state"`
+	MigrationEnvironment        pgtype.Text        `json:"migration_environment"`
+	Name                        pgtype.Text        `json:"name"`
+	QueueAllRuns                pgtype.Bool        `json:"queue_all_runs"`
+	SpeculativeEnabled          pgtype.Bool        `json:"speculative_enabled"`
+	SourceName                  pgtype.Text        `json:"source_name"`
+	SourceURL                   pgtype.Text        `json:"source_url"`
+	StructuredRunOutputEnabled  pgtype.Bool        `json:"structured_run_output_enabled"`
+	TerraformVersion            pgtype.Text        `json:"terraform_version"`
+	TriggerPrefixes             []string           `json:"trigger_prefixes"`
+	WorkingDirectory            pgtype.Text        `json:"working_directory"`
+	LockRunID                   pgtype.Text        `json:"lock_run_id"`
+	LatestRunID                 pgtype.Text        `json:"latest_run_id"`
+	OrganizationName            pgtype.Text        `json:"organization_name"`
+	Branch                      pgtype.Text        `json:"branch"`
+	LockUsername                pgtype.Text        `json:"lock_username"`
+	CurrentStateVersionID       pgtype.Text        `json:"current_state_version_id"`
+	TriggerPatterns             []string           `json:"trigger_patterns"`
+	VCSTagsRegex                pgtype.Text        `json:"vcs_tags_regex"`
+	AllowCLIApply               pgtype.Bool        `json:"allow_cli_apply"`
+	AgentPoolID                 pgtype.Text        `json:"agent_pool_id"`
+	Engine                      pgtype.Text        `json:"engine"`
+	AssessmentsEnabled          pgtype.Bool        `json:"assessments_enabled"`
+	LatestAssessmentRunID       pgtype.Text        `json:"latest_assessment_run_id"`
+	LatestAssessmentStatus      pgtype.Text        `json:"latest_assessment_status"`
+	LatestAssessmentCreatedAt   pgtype.Timestamptz `json:"latest_assessment_created_at"`
+	AutoDestroyAt               pgtype.Timestamptz `json:"auto_destroy_at"`
+	AutoDestroyActivityDuration pgtype.Text        `json:"auto_destroy_activity_duration"`
+	DeleteAfterAutoDestroy      pgtype.Bool        `json:"delete_after_auto_destroy"`
+	AutoDestroyRunID            pgtype.Text        `json:"auto_destroy_run_id"`
+	ProjectID                   pgtype.Text        `json:"project_id"`
+	AgentSelector               pgtype.Text        `json:"agent_selector"`
+	PlanTimeout                 pgtype.Int4        `json:"plan_timeout"`
+	ApplyTimeout                pgtype.Int4        `json:"apply_timeout"`
+	Tags                        []string           `json:"tags"`
+	LatestRunStatus             pgtype.Text        `json:"latest_run_status"`
+	UserLock                    *Users             `json:"user_lock"`
+	RunLock                     *Runs              `json:"run_lock"`
+	WorkspaceConnection         *RepoConnections   `json:"workspace_connection"`
 }
 
 // FindWorkspaceByID implements Querier.FindWorkspaceByID.
@@ -721,40 +883,53 @@ func (q *DBQuerier) FindWorkspaceByID(ctx context.Context, id pgtype.Text) (Find
 	return pgx.CollectOneRow(rows, func(row pgx.CollectableRow) (FindWorkspaceByIDRow, error) {
 		var item FindWorkspaceByIDRow
 		if err := row.Scan(&item.WorkspaceID, // 'workspace_id', 'WorkspaceID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.CreatedAt,                  // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
-			&item.UpdatedAt,                  // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
-			&item.AllowDestroyPlan,           // 'allow_destroy_plan', 'AllowDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.AutoApply,                  // 'auto_apply', 'AutoApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.CanQueueDestroyPlan,        // 'can_queue_destroy_plan', 'CanQueueDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.Description,                // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Environment,                // 'environment', 'Environment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.ExecutionMode,              // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.GlobalRemoteState,          // 'global_remote_state', 'GlobalRemoteState', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.MigrationEnvironment,       // 'migration_environment', 'MigrationEnvironment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Name,                       // 'name', 'Name', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.QueueAllRuns,               // 'queue_all_runs', 'QueueAllRuns', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.SpeculativeEnabled,         // 'speculative_enabled', 'SpeculativeEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.SourceName,                 // 'source_name', 'SourceName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.SourceURL,                  // 'source_url', 'SourceURL', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.StructuredRunOutputEnabled, // 'structured_run_output_enabled', 'StructuredRunOutputEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.TerraformVersion,           // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.TriggerPrefixes,            // 'trigger_prefixes', 'TriggerPrefixes', '[]string', '', '[]string'
-			&item.WorkingDirectory,           // 'working_directory', 'WorkingDirectory', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LockRunID,                  // 'lock_run_id', 'LockRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LatestRunID,                // 'latest_run_id', 'LatestRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.OrganizationName,           // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Branch,                     // 'branch', 'Branch', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LockUsername,               // 'lock_username', 'LockUsername', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.CurrentStateVersionID,      // 'current_state_version_id', 'CurrentStateVersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.TriggerPatterns,            // 'trigger_patterns', 'TriggerPatterns', '[]string', '', '[]string'
-			&item.VCSTagsRegex,               // 'vcs_tags_regex', 'VCSTagsRegex', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.AllowCLIApply,              // 'allow_cli_apply', 'AllowCLIApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.AgentPoolID,                // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Tags,                       // 'tags', 'Tags', '[]string', '', '[]string'
-			&item.LatestRunStatus,            // 'latest_run_status', 'LatestRunStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.UserLock,                   // 'user_lock', 'UserLock', '*Users', '', '*Users'
-			&item.RunLock,                    // 'run_lock', 'RunLock', '*Runs', '', '*Runs'
-			&item.WorkspaceConnection,        // 'workspace_connection', 'WorkspaceConnection', '*RepoConnections', '', '*RepoConnections'
+			&item.CreatedAt,                   // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.UpdatedAt,                   // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AllowDestroyPlan,            // 'allow_destroy_plan', 'AllowDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AutoApply,                   // 'auto_apply', 'AutoApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.CanQueueDestroyPlan,         // 'can_queue_destroy_plan', 'CanQueueDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Description,                 // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Environment,                 // 'environment', 'Environment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.ExecutionMode,               // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.GlobalRemoteState,           // 'global_remote_state', 'GlobalRemoteState', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.MigrationEnvironment,        // 'migration_environment', 'MigrationEnvironment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Name,                        // 'name', 'Name', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.QueueAllRuns,                // 'queue_all_runs', 'QueueAllRuns', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.SpeculativeEnabled,          // 'speculative_enabled', 'SpeculativeEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.SourceName,                  // 'source_name', 'SourceName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.SourceURL,                   // 'source_url', 'SourceURL', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.StructuredRunOutputEnabled,  // 'structured_run_output_enabled', 'StructuredRunOutputEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.TerraformVersion,            // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.TriggerPrefixes,             // 'trigger_prefixes', 'TriggerPrefixes', '[]string', '', '[]string'
+			&item.WorkingDirectory,            // 'working_directory', 'WorkingDirectory', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LockRunID,                   // 'lock_run_id', 'LockRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestRunID,                 // 'latest_run_id', 'LatestRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.OrganizationName,            // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Branch,                      // 'branch', 'Branch', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LockUsername,                // 'lock_username', 'LockUsername', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.CurrentStateVersionID,       // 'current_state_version_id', 'CurrentStateVersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.TriggerPatterns,             // 'trigger_patterns', 'TriggerPatterns', '[]string', '', '[]string'
+			&item.VCSTagsRegex,                // 'vcs_tags_regex', 'VCSTagsRegex', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AllowCLIApply,               // 'allow_cli_apply', 'AllowCLIApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AgentPoolID,                 // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Engine,                      // 'engine', 'Engine', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AssessmentsEnabled,          // 'assessments_enabled', 'AssessmentsEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.LatestAssessmentRunID,       // 'latest_assessment_run_id', 'LatestAssessmentRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestAssessmentStatus,      // 'latest_assessment_status', 'LatestAssessmentStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestAssessmentCreatedAt,   // 'latest_assessment_created_at', 'LatestAssessmentCreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AutoDestroyAt,               // 'auto_destroy_at', 'AutoDestroyAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AutoDestroyActivityDuration, // 'auto_destroy_activity_duration', 'AutoDestroyActivityDuration', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.DeleteAfterAutoDestroy,      // 'delete_after_auto_destroy', 'DeleteAfterAutoDestroy', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AutoDestroyRunID,            // 'auto_destroy_run_id', 'AutoDestroyRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.ProjectID,                   // 'project_id', 'ProjectID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AgentSelector,               // 'agent_selector', 'AgentSelector', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.PlanTimeout,                 // 'plan_timeout', 'PlanTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.ApplyTimeout,                // 'apply_timeout', 'ApplyTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.Tags,                        // 'tags', 'Tags', '[]string', '', '[]string'
+			&item.LatestRunStatus,             // 'latest_run_status', 'LatestRunStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.UserLock,                    // 'user_lock', 'UserLock', '*Users', '', '*Users'
+			&item.RunLock,                     // 'run_lock', 'RunLock', '*Runs', '', '*Runs'
+			&item.WorkspaceConnection,         // 'workspace_connection', 'WorkspaceConnection', '*RepoConnections', '', '*RepoConnections'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -782,41 +957,54 @@ WHERE w.workspace_id = $1
 FOR UPDATE OF w;`
 
 type FindWorkspaceByIDForUpdateRow struct {
-	WorkspaceID                pgtype.Text        `json:"workspace_id"`
-	CreatedAt                  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt                  pgtype.Timestamptz `json:"updated_at"`
-	AllowDestroyPlan           pgtype.Bool        `json:"allow_destroy_plan"`
-	AutoApply                  pgtype.Bool        `json:"auto_apply"`
-	CanQueueDestroyPlan        pgtype.Bool        `json:"can_queue_destroy_plan"`
-	Description                pgtype.Text        `json:"description"`
-	Environment                pgtype.Text        `json:"environment"`
-	ExecutionMode              pgtype.Text        `json:"execution_mode"`
-	GlobalRemoteState          pgtype.Bool        `json:"global_remote_state"`
-	MigrationEnvironment       pgtype.Text        `json:"migration_environment"`
-	Name                       pgtype.Text        `json:"name"`
-	QueueAllRuns               pgtype.Bool        `json:"queue_all_runs"`
-	SpeculativeEnabled         pgtype.Bool        `json:"speculative_enabled"`
-	SourceName                 pgtype.Text        `json:"source_name"`
-	SourceURL                  pgtype.Text        `json:"source_url"`
-	StructuredRunOutputEnabled pgtype.Bool        `json:"structured_run_output_enabled"`
-	TerraformVersion           pgtype.Text        `json:"terraform_version"`
-	TriggerPrefixes            []string           `json:"trigger_prefixes"`
-	WorkingDirectory           pgtype.Text        `json:"working_directory"`
-	LockRunID                  pgtype.Text        `json:"lock_run_id"`
-	LatestRunID                pgtype.Text        `json:"latest_run_id"`
-	OrganizationName           pgtype.Text        `json:"organization_name"`
-	Branch                     pgtype.Text        `json:"branch"`
-	LockUsername               pgtype.Text        `json:"lock_username"`
-	CurrentStateVersionID      pgtype.Text        `json:"current_state_version_id"`
-	TriggerPatterns            []string           `json:"trigger_patterns"`
-	VCSTagsRegex               pgtype.Text        `json:"vcs_tags_regex"`
-	AllowCLIApply              pgtype.Bool        `json:"allow_cli_apply"`
-	AgentPoolID                pgtype.Text        `json:"agent_pool_id"`
-	Tags                       []string           `json:"tags"`
-	LatestRunStatus            pgtype.Text        `json:"latest_run_status"`
-	UserLock                   *Users             `json:"user_lock"`
-	RunLock                    *Runs              `json:"run_lock"`
-	WorkspaceConnection        *RepoConnections   `json:"workspace_connection"`
+	WorkspaceID                 pgtype.Text        `json:"workspace_id"`
+	CreatedAt                   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt                   pgtype.Timestamptz `json:"updated_at"`
+	AllowDestroyPlan            pgtype.Bool        `json:"allow_destroy_plan"`
+	AutoApply                   pgtype.Bool        `json:"auto_apply"`
+	CanQueueDestroyPlan         pgtype.Bool        `json:"can_queue_destroy_plan"`
+	Description                 pgtype.Text        `json:"description"`
+	Environment                 pgtype.Text        `json:"environment"`
+	ExecutionMode               pgtype.Text        `json:"execution_mode"`
+	GlobalRemoteState           pgtype.Bool        `json:"global_remote_state"`
+	MigrationEnvironment        pgtype.Text        `json:"migration_environment"`
+	Name                        pgtype.Text        `json:"name"`
+	QueueAllRuns                pgtype.Bool        `json:"queue_all_runs"`
+	SpeculativeEnabled          pgtype.Bool        `json:"speculative_enabled"`
+	SourceName                  pgtype.Text        `json:"source_name"`
+	SourceURL                   pgtype.Text        `json:"source_url"`
+	StructuredRunOutputEnabled  pgtype.Bool        `json:"structured_run_output_enabled"`
+	TerraformVersion            pgtype.Text        `json:"terraform_version"`
+	TriggerPrefixes             []string           `json:"trigger_prefixes"`
+	WorkingDirectory            pgtype.Text        `json:"working_directory"`
+	LockRunID                   pgtype.Text        `json:"lock_run_id"`
+	LatestRunID                 pgtype.Text        `json:"latest_run_id"`
+	OrganizationName            pgtype.Text        `json:"organization_name"`
+	Branch                      pgtype.Text        `json:"branch"`
+	LockUsername                pgtype.Text        `json:"lock_username"`
+	CurrentStateVersionID       pgtype.Text        `json:"current_state_version_id"`
+	TriggerPatterns             []string           `json:"trigger_patterns"`
+	VCSTagsRegex                pgtype.Text        `json:"vcs_tags_regex"`
+	AllowCLIApply               pgtype.Bool        `json:"allow_cli_apply"`
+	AgentPoolID                 pgtype.Text        `json:"agent_pool_id"`
+	Engine                      pgtype.Text        `json:"engine"`
+	AssessmentsEnabled          pgtype.Bool        `json:"assessments_enabled"`
+	LatestAssessmentRunID       pgtype.Text        `json:"latest_assessment_run_id"`
+	LatestAssessmentStatus      pgtype.Text        `json:"latest_assessment_status"`
+	LatestAssessmentCreatedAt   pgtype.Timestamptz `json:"latest_assessment_created_at"`
+	AutoDestroyAt               pgtype.Timestamptz `json:"auto_destroy_at"`
+	AutoDestroyActivityDuration pgtype.Text        `json:"auto_destroy_activity_duration"`
+	DeleteAfterAutoDestroy      pgtype.Bool        `json:"delete_after_auto_destroy"`
+	AutoDestroyRunID            pgtype.Text        `json:"auto_destroy_run_id"`
+	ProjectID                   pgtype.Text        `json:"project_id"`
+	AgentSelector               pgtype.Text        `json:"agent_selector"`
+	PlanTimeout                 pgtype.Int4        `json:"plan_timeout"`
+	ApplyTimeout                pgtype.Int4        `json:"apply_timeout"`
+	Tags                        []string           `json:"tags"`
+	LatestRunStatus             pgtype.Text        `json:"latest_run_status"`
+	UserLock                    *Users             `json:"user_lock"`
+	RunLock                     *Runs              `json:"run_lock"`
+	WorkspaceConnection         *RepoConnections   `json:"workspace_connection"`
 }
 
 // FindWorkspaceByIDForUpdate implements Querier.FindWorkspaceByIDForUpdate.
@@ -830,40 +1018,53 @@ func (q *DBQuerier) FindWorkspaceByIDForUpdate(ctx context.Context, id pgtype.Te
 	return pgx.CollectOneRow(rows, func(row pgx.CollectableRow) (FindWorkspaceByIDForUpdateRow, error) {
 		var item FindWorkspaceByIDForUpdateRow
 		if err := row.Scan(&item.WorkspaceID, // 'workspace_id', 'WorkspaceID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.CreatedAt,                  // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
-			&item.UpdatedAt,                  // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
-			&item.AllowDestroyPlan,           // 'allow_destroy_plan', 'AllowDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.AutoApply,                  // 'auto_apply', 'AutoApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.CanQueueDestroyPlan,        // 'can_queue_destroy_plan', 'CanQueueDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.Description,                // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Environment,                // 'environment', 'Environment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.ExecutionMode,              // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.GlobalRemoteState,          // 'global_remote_state', 'GlobalRemoteState', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.MigrationEnvironment,       // 'migration_environment', 'MigrationEnvironment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Name,                       // 'name', 'Name', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.QueueAllRuns,               // 'queue_all_runs', 'QueueAllRuns', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.SpeculativeEnabled,         // 'speculative_enabled', 'SpeculativeEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.SourceName,                 // 'source_name', 'SourceName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.SourceURL,                  // 'source_url', 'SourceURL', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.StructuredRunOutputEnabled, // 'structured_run_output_enabled', 'StructuredRunOutputEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.TerraformVersion,           // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.TriggerPrefixes,            // 'trigger_prefixes', 'TriggerPrefixes', '[]string', '', '[]string'
-			&item.WorkingDirectory,           // 'working_directory', 'WorkingDirectory', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LockRunID,                  // 'lock_run_id', 'LockRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LatestRunID,                // 'latest_run_id', 'LatestRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.OrganizationName,           // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Branch,                     // 'branch', 'Branch', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.LockUsername,               // 'lock_username', 'LockUsername', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.CurrentStateVersionID,      // 'current_state_version_id', 'CurrentStateVersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.TriggerPatterns,            // 'trigger_patterns', 'TriggerPatterns', '[]string', '', '[]string'
-			&item.VCSTagsRegex,               // 'vcs_tags_regex', 'VCSTagsRegex', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.AllowCLIApply,              // 'allow_cli_apply', 'AllowCLIApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
-			&item.AgentPoolID,                // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.Tags,                       // 'tags', 'Tags', '[]string', '', '[]string'
-			&item.LatestRunStatus,            // 'latest_run_status', 'LatestRunStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
-			&item.UserLock,                   // 'user_lock', 'UserLock', '*Users', '', '*Users'
-			&item.RunLock,                    // 'run_lock', 'RunLock', '*Runs', '', '*Runs'
-			&item.WorkspaceConnection,        // 'workspace_connection', 'WorkspaceConnection', '*RepoConnections', '', '*RepoConnections'
+			&item.CreatedAt,                   // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.UpdatedAt,                   // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AllowDestroyPlan,            // 'allow_destroy_plan', 'AllowDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AutoApply,                   // 'auto_apply', 'AutoApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.CanQueueDestroyPlan,         // 'can_queue_destroy_plan', 'CanQueueDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Description,                 // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Environment,                 // 'environment', 'Environment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.ExecutionMode,               // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.GlobalRemoteState,           // 'global_remote_state', 'GlobalRemoteState', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.MigrationEnvironment,        // 'migration_environment', 'MigrationEnvironment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Name,                        // 'name', 'Name', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.QueueAllRuns,                // 'queue_all_runs', 'QueueAllRuns', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.SpeculativeEnabled,          // 'speculative_enabled', 'SpeculativeEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.SourceName,                  // 'source_name', 'SourceName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.SourceURL,                   // 'source_url', 'SourceURL', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.StructuredRunOutputEnabled,  // 'structured_run_output_enabled', 'StructuredRunOutputEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.TerraformVersion,            // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.TriggerPrefixes,             // 'trigger_prefixes', 'TriggerPrefixes', '[]string', '', '[]string'
+			&item.WorkingDirectory,            // 'working_directory', 'WorkingDirectory', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LockRunID,                   // 'lock_run_id', 'LockRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestRunID,                 // 'latest_run_id', 'LatestRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.OrganizationName,            // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Branch,                      // 'branch', 'Branch', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LockUsername,                // 'lock_username', 'LockUsername', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.CurrentStateVersionID,       // 'current_state_version_id', 'CurrentStateVersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.TriggerPatterns,             // 'trigger_patterns', 'TriggerPatterns', '[]string', '', '[]string'
+			&item.VCSTagsRegex,                // 'vcs_tags_regex', 'VCSTagsRegex', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AllowCLIApply,               // 'allow_cli_apply', 'AllowCLIApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AgentPoolID,                 // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Engine,                      // 'engine', 'Engine', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AssessmentsEnabled,          // 'assessments_enabled', 'AssessmentsEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.LatestAssessmentRunID,       // 'latest_assessment_run_id', 'LatestAssessmentRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestAssessmentStatus,      // 'latest_assessment_status', 'LatestAssessmentStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestAssessmentCreatedAt,   // 'latest_assessment_created_at', 'LatestAssessmentCreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AutoDestroyAt,               // 'auto_destroy_at', 'AutoDestroyAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AutoDestroyActivityDuration, // 'auto_destroy_activity_duration', 'AutoDestroyActivityDuration', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.DeleteAfterAutoDestroy,      // 'delete_after_auto_destroy', 'DeleteAfterAutoDestroy', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AutoDestroyRunID,            // 'auto_destroy_run_id', 'AutoDestroyRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.ProjectID,                   // 'project_id', 'ProjectID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AgentSelector,               // 'agent_selector', 'AgentSelector', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.PlanTimeout,                 // 'plan_timeout', 'PlanTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.ApplyTimeout,                // 'apply_timeout', 'ApplyTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.Tags,                        // 'tags', 'Tags', '[]string', '', '[]string'
+			&item.LatestRunStatus,             // 'latest_run_status', 'LatestRunStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.UserLock,                    // 'user_lock', 'UserLock', '*Users', '', '*Users'
+			&item.RunLock,                     // 'run_lock', 'RunLock', '*Runs', '', '*Runs'
+			&item.WorkspaceConnection,         // 'workspace_connection', 'WorkspaceConnection', '*RepoConnections', '', '*RepoConnections'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
@@ -873,53 +1074,71 @@ func (q *DBQuerier) FindWorkspaceByIDForUpdate(ctx context.Context, id pgtype.Te
 
 const updateWorkspaceByIDSQL = `UPDATE workspaces
 SET
-    agent_pool_id                 = $1,
-    allow_destroy_plan            = $2,
-    allow_cli_apply               = $3,
-    auto_apply                    = $4,
-    branch                        = $5,
-    description                   = $6,
-    execution_mode                = $7,
-    global_remote_state           = $8,
-    name                          = $9,
-    queue_all_runs                = $10,
-    speculative_enabled           = $11,
-    structured_run_output_enabled = $12,
-    terraform_version             = $13,
-    trigger_prefixes              = $14,
-    trigger_patterns              = $15,
-    vcs_tags_regex                = $16,
-    working_directory             = $17,
-    updated_at                    = $18
-WHERE workspace_id = $19
+    agent_pool_id                  = $1,
+    agent_selector                 = $2,
+    allow_destroy_plan             = $3,
+    allow_cli_apply                = $4,
+    apply_timeout                  = $5,
+    assessments_enabled            = $6,
+    auto_apply                     = $7,
+    auto_destroy_at                = $8,
+    auto_destroy_activity_duration = $9,
+    branch                         = $10,
+    delete_after_auto_destroy      = $11,
+    description                    = $12,
+    engine                         = $13,
+    execution_mode                 = $14,
+    global_remote_state            = $15,
+    name                           = $16,
+    plan_timeout                   = $17,
+    project_id                     = $18,
+    queue_all_runs                 = $19,
+    speculative_enabled            = $20,
+    structured_run_output_enabled  = $21,
+    terraform_version              = $22,
+    trigger_prefixes               = $23,
+    trigger_patterns               = $24,
+    vcs_tags_regex                 = $25,
+    working_directory              = $26,
+    updated_at                     = $27
+WHERE workspace_id = $28
 RETURNING workspace_id;`
 
 type UpdateWorkspaceByIDParams struct {
-	AgentPoolID                pgtype.Text        `json:"agent_pool_id"`
-	AllowDestroyPlan           pgtype.Bool        `json:"allow_destroy_plan"`
-	AllowCLIApply              pgtype.Bool        `json:"allow_cli_apply"`
-	AutoApply                  pgtype.Bool        `json:"auto_apply"`
-	Branch                     pgtype.Text        `json:"branch"`
-	Description                pgtype.Text        `json:"description"`
-	ExecutionMode              pgtype.Text        `json:"execution_mode"`
-	GlobalRemoteState          pgtype.Bool        `json:"global_remote_state"`
-	Name                       pgtype.Text        `json:"name"`
-	QueueAllRuns               pgtype.Bool        `json:"queue_all_runs"`
-	SpeculativeEnabled         pgtype.Bool        `json:"speculative_enabled"`
-	StructuredRunOutputEnabled pgtype.Bool        `json:"structured_run_output_enabled"`
-	TerraformVersion           pgtype.Text        `json:"terraform_version"`
-	TriggerPrefixes            []string           `json:"trigger_prefixes"`
-	TriggerPatterns            []string           `json:"trigger_patterns"`
-	VCSTagsRegex               pgtype.Text        `json:"vcs_tags_regex"`
-	WorkingDirectory           pgtype.Text        `json:"working_directory"`
-	UpdatedAt                  pgtype.Timestamptz `json:"updated_at"`
-	ID                         pgtype.Text        `json:"id"`
+	AgentPoolID                 pgtype.Text        `json:"agent_pool_id"`
+	AgentSelector               pgtype.Text        `json:"agent_selector"`
+	AllowDestroyPlan            pgtype.Bool        `json:"allow_destroy_plan"`
+	AllowCLIApply               pgtype.Bool        `json:"allow_cli_apply"`
+	ApplyTimeout                pgtype.Int4        `json:"apply_timeout"`
+	AssessmentsEnabled          pgtype.Bool        `json:"assessments_enabled"`
+	AutoApply                   pgtype.Bool        `json:"auto_apply"`
+	AutoDestroyAt               pgtype.Timestamptz `json:"auto_destroy_at"`
+	AutoDestroyActivityDuration pgtype.Text        `json:"auto_destroy_activity_duration"`
+	Branch                      pgtype.Text        `json:"branch"`
+	DeleteAfterAutoDestroy      pgtype.Bool        `json:"delete_after_auto_destroy"`
+	Description                 pgtype.Text        `json:"description"`
+	Engine                      pgtype.Text        `json:"engine"`
+	ExecutionMode               pgtype.Text        `json:"execution_mode"`
+	GlobalRemoteState           pgtype.Bool        `json:"global_remote_state"`
+	Name                        pgtype.Text        `json:"name"`
+	PlanTimeout                 pgtype.Int4        `json:"plan_timeout"`
+	ProjectID                   pgtype.Text        `json:"project_id"`
+	QueueAllRuns                pgtype.Bool        `json:"queue_all_runs"`
+	SpeculativeEnabled          pgtype.Bool        `json:"speculative_enabled"`
+	StructuredRunOutputEnabled  pgtype.Bool        `json:"structured_run_output_enabled"`
+	TerraformVersion            pgtype.Text        `json:"terraform_version"`
+	TriggerPrefixes             []string           `json:"trigger_prefixes"`
+	TriggerPatterns             []string           `json:"trigger_patterns"`
+	VCSTagsRegex                pgtype.Text        `json:"vcs_tags_regex"`
+	WorkingDirectory            pgtype.Text        `json:"working_directory"`
+	UpdatedAt                   pgtype.Timestamptz `json:"updated_at"`
+	ID                          pgtype.Text        `json:"id"`
 }
 
 // UpdateWorkspaceByID implements Querier.UpdateWorkspaceByID.
 func (q *DBQuerier) UpdateWorkspaceByID(ctx context.Context, params UpdateWorkspaceByIDParams) (pgtype.Text, error) {
 	ctx = context.WithValue(ctx, "pggen_query_name", "UpdateWorkspaceByID")
-	rows, err := q.conn.Query(ctx, updateWorkspaceByIDSQL, params.AgentPoolID, params.AllowDestroyPlan, params.AllowCLIApply, params.AutoApply, params.Branch, params.Description, params.ExecutionMode, params.GlobalRemoteState, params.Name, params.QueueAllRuns, params.SpeculativeEnabled, params.StructuredRunOutputEnabled, params.TerraformVersion, params.TriggerPrefixes, params.TriggerPatterns, params.VCSTagsRegex, params.WorkingDirectory, params.UpdatedAt, params.ID)
+	rows, err := q.conn.Query(ctx, updateWorkspaceByIDSQL, params.AgentPoolID, params.AgentSelector, params.AllowDestroyPlan, params.AllowCLIApply, params.ApplyTimeout, params.AssessmentsEnabled, params.AutoApply, params.AutoDestroyAt, params.AutoDestroyActivityDuration, params.Branch, params.DeleteAfterAutoDestroy, params.Description, params.Engine, params.ExecutionMode, params.GlobalRemoteState, params.Name, params.PlanTimeout, params.ProjectID, params.QueueAllRuns, params.SpeculativeEnabled, params.StructuredRunOutputEnabled, params.TerraformVersion, params.TriggerPrefixes, params.TriggerPatterns, params.VCSTagsRegex, params.WorkingDirectory, params.UpdatedAt, params.ID)
 	if err != nil {
 		return pgtype.Text{}, fmt.Errorf("query UpdateWorkspaceByID: %w", err)
 	}
@@ -933,6 +1152,317 @@ func (q *DBQuerier) UpdateWorkspaceByID(ctx context.Context, params UpdateWorksp
 	})
 }
 
+const findWorkspacesForAssessmentSQL = `SELECT w.*,
+    (
+        SELECT array_agg(name)
+        FROM tags
+        JOIN workspace_tags wt USING (tag_id)
+        WHERE wt.workspace_id = w.workspace_id
+    ) AS tags,
+    r.status AS latest_run_status,
+    (ul.*)::"users" AS user_lock,
+    (rl.*)::"runs" AS run_lock,
+    (rc.*)::"repo_connections" AS workspace_connection
+FROM workspaces w
+LEFT JOIN users ul ON w.lock_username = ul.username
+LEFT JOIN runs rl ON w.lock_run_id = rl.run_id
+LEFT JOIN runs r ON w.latest_run_id = r.run_id
+LEFT JOIN repo_connections rc ON w.workspace_id = rc.workspace_id
+WHERE w.assessments_enabled
+AND   w.execution_mode <> 'local'
+AND   (w.latest_assessment_created_at IS NULL OR w.latest_assessment_created_at < $1)
+;`
+
+type FindWorkspacesForAssessmentRow struct {
+	WorkspaceID                 pgtype.Text        `json:"workspace_id"`
+	CreatedAt                   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt                   pgtype.Timestamptz `json:"updated_at"`
+	AllowDestroyPlan            pgtype.Bool        `json:"allow_destroy_plan"`
+	AutoApply                   pgtype.Bool        `json:"auto_apply"`
+	CanQueueDestroyPlan         pgtype.Bool        `json:"can_queue_destroy_plan"`
+	Description                 pgtype.Text        `json:"description"`
+	Environment                 pgtype.Text        `json:"environment"`
+	ExecutionMode               pgtype.Text        `json:"execution_mode"`
+	GlobalRemoteState           pgtype.Bool        `json:"global_remote_state"`
+	MigrationEnvironment        pgtype.Text        `json:"migration_environment"`
+	Name                        pgtype.Text        `json:"name"`
+	QueueAllRuns                pgtype.Bool        `json:"queue_all_runs"`
+	SpeculativeEnabled          pgtype.Bool        `json:"speculative_enabled"`
+	SourceName                  pgtype.Text        `json:"source_name"`
+	SourceURL                   pgtype.Text        `json:"source_url"`
+	StructuredRunOutputEnabled  pgtype.Bool        `json:"structured_run_output_enabled"`
+	TerraformVersion            pgtype.Text        `json:"terraform_version"`
+	TriggerPrefixes             []string           `json:"trigger_prefixes"`
+	WorkingDirectory            pgtype.Text        `json:"working_directory"`
+	LockRunID                   pgtype.Text        `json:"lock_run_id"`
+	LatestRunID                 pgtype.Text        `json:"latest_run_id"`
+	OrganizationName            pgtype.Text        `json:"organization_name"`
+	Branch                      pgtype.Text        `json:"branch"`
+	LockUsername                pgtype.Text        `json:"lock_username"`
+	CurrentStateVersionID       pgtype.Text        `json:"current_state_version_id"`
+	TriggerPatterns             []string           `json:"trigger_patterns"`
+	VCSTagsRegex                pgtype.Text        `json:"vcs_tags_regex"`
+	AllowCLIApply               pgtype.Bool        `json:"allow_cli_apply"`
+	AgentPoolID                 pgtype.Text        `json:"agent_pool_id"`
+	Engine                      pgtype.Text        `json:"engine"`
+	AssessmentsEnabled          pgtype.Bool        `json:"assessments_enabled"`
+	LatestAssessmentRunID       pgtype.Text        `json:"latest_assessment_run_id"`
+	LatestAssessmentStatus      pgtype.Text        `json:"latest_assessment_status"`
+	LatestAssessmentCreatedAt   pgtype.Timestamptz `json:"latest_assessment_created_at"`
+	AutoDestroyAt               pgtype.Timestamptz `json:"auto_destroy_at"`
+	AutoDestroyActivityDuration pgtype.Text        `json:"auto_destroy_activity_duration"`
+	DeleteAfterAutoDestroy      pgtype.Bool        `json:"delete_after_auto_destroy"`
+	AutoDestroyRunID            pgtype.Text        `json:"auto_destroy_run_id"`
+	ProjectID                   pgtype.Text        `json:"project_id"`
+	AgentSelector               pgtype.Text        `json:"agent_selector"`
+	PlanTimeout                 pgtype.Int4        `json:"plan_timeout"`
+	ApplyTimeout                pgtype.Int4        `json:"apply_timeout"`
+	Tags                        []string           `json:"tags"`
+	LatestRunStatus             pgtype.Text        `json:"latest_run_status"`
+	UserLock                    *Users             `json:"user_lock"`
+	RunLock                     *Runs              `json:"run_lock"`
+	WorkspaceConnection         *RepoConnections   `json:"workspace_connection"`
+}
+
+// FindWorkspacesForAssessment implements Querier.FindWorkspacesForAssessment.
+func (q *DBQuerier) FindWorkspacesForAssessment(ctx context.Context, dueBefore pgtype.Timestamptz) ([]FindWorkspacesForAssessmentRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "FindWorkspacesForAssessment")
+	rows, err := q.conn.Query(ctx, findWorkspacesForAssessmentSQL, dueBefore)
+	if err != nil {
+		return nil, fmt.Errorf("query FindWorkspacesForAssessment: %w", err)
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (FindWorkspacesForAssessmentRow, error) {
+		var item FindWorkspacesForAssessmentRow
+		if err := row.Scan(&item.WorkspaceID, // 'workspace_id', 'WorkspaceID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.CreatedAt,                   // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.UpdatedAt,                   // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AllowDestroyPlan,            // 'allow_destroy_plan', 'AllowDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AutoApply,                   // 'auto_apply', 'AutoApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.CanQueueDestroyPlan,         // 'can_queue_destroy_plan', 'CanQueueDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Description,                 // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Environment,                 // 'environment', 'Environment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.ExecutionMode,               // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.GlobalRemoteState,           // 'global_remote_state', 'GlobalRemoteState', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.MigrationEnvironment,        // 'migration_environment', 'MigrationEnvironment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Name,                        // 'name', 'Name', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.QueueAllRuns,                // 'queue_all_runs', 'QueueAllRuns', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.SpeculativeEnabled,          // 'speculative_enabled', 'SpeculativeEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.SourceName,                  // 'source_name', 'SourceName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.SourceURL,                   // 'source_url', 'SourceURL', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.StructuredRunOutputEnabled,  // 'structured_run_output_enabled', 'StructuredRunOutputEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.TerraformVersion,            // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.TriggerPrefixes,             // 'trigger_prefixes', 'TriggerPrefixes', '[]string', '', '[]string'
+			&item.WorkingDirectory,            // 'working_directory', 'WorkingDirectory', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LockRunID,                   // 'lock_run_id', 'LockRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestRunID,                 // 'latest_run_id', 'LatestRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.OrganizationName,            // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Branch,                      // 'branch', 'Branch', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LockUsername,                // 'lock_username', 'LockUsername', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.CurrentStateVersionID,       // 'current_state_version_id', 'CurrentStateVersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.TriggerPatterns,             // 'trigger_patterns', 'TriggerPatterns', '[]string', '', '[]string'
+			&item.VCSTagsRegex,                // 'vcs_tags_regex', 'VCSTagsRegex', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AllowCLIApply,               // 'allow_cli_apply', 'AllowCLIApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AgentPoolID,                 // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Engine,                      // 'engine', 'Engine', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AssessmentsEnabled,          // 'assessments_enabled', 'AssessmentsEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.LatestAssessmentRunID,       // 'latest_assessment_run_id', 'LatestAssessmentRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestAssessmentStatus,      // 'latest_assessment_status', 'LatestAssessmentStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestAssessmentCreatedAt,   // 'latest_assessment_created_at', 'LatestAssessmentCreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AutoDestroyAt,               // 'auto_destroy_at', 'AutoDestroyAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AutoDestroyActivityDuration, // 'auto_destroy_activity_duration', 'AutoDestroyActivityDuration', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.DeleteAfterAutoDestroy,      // 'delete_after_auto_destroy', 'DeleteAfterAutoDestroy', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AutoDestroyRunID,            // 'auto_destroy_run_id', 'AutoDestroyRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.ProjectID,                   // 'project_id', 'ProjectID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AgentSelector,               // 'agent_selector', 'AgentSelector', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.PlanTimeout,                 // 'plan_timeout', 'PlanTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.ApplyTimeout,                // 'apply_timeout', 'ApplyTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.Tags,                        // 'tags', 'Tags', '[]string', '', '[]string'
+			&item.LatestRunStatus,             // 'latest_run_status', 'LatestRunStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.UserLock,                    // 'user_lock', 'UserLock', '*Users', '', '*Users'
+			&item.RunLock,                     // 'run_lock', 'RunLock', '*Runs', '', '*Runs'
+			&item.WorkspaceConnection,         // 'workspace_connection', 'WorkspaceConnection', '*RepoConnections', '', '*RepoConnections'
+		); err != nil {
+			return item, fmt.Errorf("failed to scan: %w", err)
+		}
+		return item, nil
+	})
+}
+
+const updateWorkspaceLatestAssessmentSQL = `UPDATE workspaces
+SET
+    latest_assessment_run_id     = $1,
+    latest_assessment_status     = $2,
+    latest_assessment_created_at = $3
+WHERE workspace_id = $4;`
+
+type UpdateWorkspaceLatestAssessmentParams struct {
+	RunID       pgtype.Text        `json:"run_id"`
+	Status      pgtype.Text        `json:"status"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	WorkspaceID pgtype.Text        `json:"workspace_id"`
+}
+
+// UpdateWorkspaceLatestAssessment implements Querier.UpdateWorkspaceLatestAssessment.
+func (q *DBQuerier) UpdateWorkspaceLatestAssessment(ctx context.Context, params UpdateWorkspaceLatestAssessmentParams) (pgconn.CommandTag, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "UpdateWorkspaceLatestAssessment")
+	cmdTag, err := q.conn.Exec(ctx, updateWorkspaceLatestAssessmentSQL, params.RunID, params.Status, params.CreatedAt, params.WorkspaceID)
+	if err != nil {
+		return pgconn.CommandTag{}, fmt.Errorf("exec query UpdateWorkspaceLatestAssessment: %w", err)
+	}
+	return cmdTag, err
+}
+
+const findWorkspacesForAutoDestroySQL = `SELECT w.*,
+    (
+        SELECT array_agg(name)
+        FROM tags
+        JOIN workspace_tags wt USING (tag_id)
+        WHERE wt.workspace_id = w.workspace_id
+    ) AS tags,
+    r.status AS latest_run_status,
+    (ul.*)::"users" AS user_lock,
+    (rl.*)::"runs" AS run_lock,
+    (rc.*)::"repo_connections" AS workspace_connection
+FROM workspaces w
+LEFT JOIN users ul ON w.lock_username = ul.username
+LEFT JOIN runs rl ON w.lock_run_id = rl.run_id
+LEFT JOIN runs r ON w.latest_run_id = r.run_id
+LEFT JOIN repo_connections rc ON w.workspace_id = rc.workspace_id
+WHERE (w.auto_destroy_at IS NOT NULL OR w.auto_destroy_activity_duration IS NOT NULL)
+AND   w.execution_mode <> 'local'
+;`
+
+type FindWorkspacesForAutoDestroyRow struct {
+	WorkspaceID                 pgtype.Text        `json:"workspace_id"`
+	CreatedAt                   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt                   pgtype.Timestamptz `json:"updated_at"`
+	AllowDestroyPlan            pgtype.Bool        `json:"allow_destroy_plan"`
+	AutoApply                   pgtype.Bool        `json:"auto_apply"`
+	CanQueueDestroyPlan         pgtype.Bool        `json:"can_queue_destroy_plan"`
+	Description                 pgtype.Text        `json:"description"`
+	Environment                 pgtype.Text        `json:"environment"`
+	ExecutionMode               pgtype.Text        `json:"execution_mode"`
+	GlobalRemoteState           pgtype.Bool        `json:"global_remote_state"`
+	MigrationEnvironment        pgtype.Text        `json:"migration_environment"`
+	Name                        pgtype.Text        `json:"name"`
+	QueueAllRuns                pgtype.Bool        `json:"queue_all_runs"`
+	SpeculativeEnabled          pgtype.Bool        `json:"speculative_enabled"`
+	SourceName                  pgtype.Text        `json:"source_name"`
+	SourceURL                   pgtype.Text        `json:"source_url"`
+	StructuredRunOutputEnabled  pgtype.Bool        `json:"structured_run_output_enabled"`
+	TerraformVersion            pgtype.Text        `json:"terraform_version"`
+	TriggerPrefixes             []string           `json:"trigger_prefixes"`
+	WorkingDirectory            pgtype.Text        `json:"working_directory"`
+	LockRunID                   pgtype.Text        `json:"lock_run_id"`
+	LatestRunID                 pgtype.Text        `json:"latest_run_id"`
+	OrganizationName            pgtype.Text        `json:"organization_name"`
+	Branch                      pgtype.Text        `json:"branch"`
+	LockUsername                pgtype.Text        `json:"lock_username"`
+	CurrentStateVersionID       pgtype.Text        `json:"current_state_version_id"`
+	TriggerPatterns             []string           `json:"trigger_patterns"`
+	VCSTagsRegex                pgtype.Text        `json:"vcs_tags_regex"`
+	AllowCLIApply               pgtype.Bool        `json:"allow_cli_apply"`
+	AgentPoolID                 pgtype.Text        `json:"agent_pool_id"`
+	Engine                      pgtype.Text        `json:"engine"`
+	AssessmentsEnabled          pgtype.Bool        `json:"assessments_enabled"`
+	LatestAssessmentRunID       pgtype.Text        `json:"latest_assessment_run_id"`
+	LatestAssessmentStatus      pgtype.Text        `json:"latest_assessment_status"`
+	LatestAssessmentCreatedAt   pgtype.Timestamptz `json:"latest_assessment_created_at"`
+	AutoDestroyAt               pgtype.Timestamptz `json:"auto_destroy_at"`
+	AutoDestroyActivityDuration pgtype.Text        `json:"auto_destroy_activity_duration"`
+	DeleteAfterAutoDestroy      pgtype.Bool        `json:"delete_after_auto_destroy"`
+	AutoDestroyRunID            pgtype.Text        `json:"auto_destroy_run_id"`
+	ProjectID                   pgtype.Text        `json:"project_id"`
+	AgentSelector               pgtype.Text        `json:"agent_selector"`
+	PlanTimeout                 pgtype.Int4        `json:"plan_timeout"`
+	ApplyTimeout                pgtype.Int4        `json:"apply_timeout"`
+	Tags                        []string           `json:"tags"`
+	LatestRunStatus             pgtype.Text        `json:"latest_run_status"`
+	UserLock                    *Users             `json:"user_lock"`
+	RunLock                     *Runs              `json:"run_lock"`
+	WorkspaceConnection         *RepoConnections   `json:"workspace_connection"`
+}
+
+// FindWorkspacesForAutoDestroy implements Querier.FindWorkspacesForAutoDestroy.
+func (q *DBQuerier) FindWorkspacesForAutoDestroy(ctx context.Context) ([]FindWorkspacesForAutoDestroyRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "FindWorkspacesForAutoDestroy")
+	rows, err := q.conn.Query(ctx, findWorkspacesForAutoDestroySQL)
+	if err != nil {
+		return nil, fmt.Errorf("query FindWorkspacesForAutoDestroy: %w", err)
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (FindWorkspacesForAutoDestroyRow, error) {
+		var item FindWorkspacesForAutoDestroyRow
+		if err := row.Scan(&item.WorkspaceID, // 'workspace_id', 'WorkspaceID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.CreatedAt,                   // 'created_at', 'CreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.UpdatedAt,                   // 'updated_at', 'UpdatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AllowDestroyPlan,            // 'allow_destroy_plan', 'AllowDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AutoApply,                   // 'auto_apply', 'AutoApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.CanQueueDestroyPlan,         // 'can_queue_destroy_plan', 'CanQueueDestroyPlan', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.Description,                 // 'description', 'Description', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Environment,                 // 'environment', 'Environment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.ExecutionMode,               // 'execution_mode', 'ExecutionMode', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.GlobalRemoteState,           // 'global_remote_state', 'GlobalRemoteState', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.MigrationEnvironment,        // 'migration_environment', 'MigrationEnvironment', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Name,                        // 'name', 'Name', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.QueueAllRuns,                // 'queue_all_runs', 'QueueAllRuns', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.SpeculativeEnabled,          // 'speculative_enabled', 'SpeculativeEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.SourceName,                  // 'source_name', 'SourceName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.SourceURL,                   // 'source_url', 'SourceURL', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.StructuredRunOutputEnabled,  // 'structured_run_output_enabled', 'StructuredRunOutputEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.TerraformVersion,            // 'terraform_version', 'TerraformVersion', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.TriggerPrefixes,             // 'trigger_prefixes', 'TriggerPrefixes', '[]string', '', '[]string'
+			&item.WorkingDirectory,            // 'working_directory', 'WorkingDirectory', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LockRunID,                   // 'lock_run_id', 'LockRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestRunID,                 // 'latest_run_id', 'LatestRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.OrganizationName,            // 'organization_name', 'OrganizationName', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Branch,                      // 'branch', 'Branch', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LockUsername,                // 'lock_username', 'LockUsername', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.CurrentStateVersionID,       // 'current_state_version_id', 'CurrentStateVersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.TriggerPatterns,             // 'trigger_patterns', 'TriggerPatterns', '[]string', '', '[]string'
+			&item.VCSTagsRegex,                // 'vcs_tags_regex', 'VCSTagsRegex', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AllowCLIApply,               // 'allow_cli_apply', 'AllowCLIApply', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AgentPoolID,                 // 'agent_pool_id', 'AgentPoolID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.Engine,                      // 'engine', 'Engine', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AssessmentsEnabled,          // 'assessments_enabled', 'AssessmentsEnabled', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.LatestAssessmentRunID,       // 'latest_assessment_run_id', 'LatestAssessmentRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestAssessmentStatus,      // 'latest_assessment_status', 'LatestAssessmentStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.LatestAssessmentCreatedAt,   // 'latest_assessment_created_at', 'LatestAssessmentCreatedAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AutoDestroyAt,               // 'auto_destroy_at', 'AutoDestroyAt', 'pgtype.Timestamptz', 'github.com/jackc/pgx/v5/pgtype', 'Timestamptz'
+			&item.AutoDestroyActivityDuration, // 'auto_destroy_activity_duration', 'AutoDestroyActivityDuration', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.DeleteAfterAutoDestroy,      // 'delete_after_auto_destroy', 'DeleteAfterAutoDestroy', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
+			&item.AutoDestroyRunID,            // 'auto_destroy_run_id', 'AutoDestroyRunID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.ProjectID,                   // 'project_id', 'ProjectID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.AgentSelector,               // 'agent_selector', 'AgentSelector', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.PlanTimeout,                 // 'plan_timeout', 'PlanTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.ApplyTimeout,                // 'apply_timeout', 'ApplyTimeout', 'pgtype.Int4', 'github.com/jackc/pgx/v5/pgtype', 'Int4'
+			&item.Tags,                        // 'tags', 'Tags', '[]string', '', '[]string'
+			&item.LatestRunStatus,             // 'latest_run_status', 'LatestRunStatus', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.UserLock,                    // 'user_lock', 'UserLock', '*Users', '', '*Users'
+			&item.RunLock,                     // 'run_lock', 'RunLock', '*Runs', '', '*Runs'
+			&item.WorkspaceConnection,         // 'workspace_connection', 'WorkspaceConnection', '*RepoConnections', '', '*RepoConnections'
+		); err != nil {
+			return item, fmt.Errorf("failed to scan: %w", err)
+		}
+		return item, nil
+	})
+}
+
+const updateWorkspaceAutoDestroyRunSQL = `UPDATE workspaces
+SET auto_destroy_run_id = $1
+WHERE workspace_id = $2;`
+
+// UpdateWorkspaceAutoDestroyRun implements Querier.UpdateWorkspaceAutoDestroyRun.
+func (q *DBQuerier) UpdateWorkspaceAutoDestroyRun(ctx context.Context, runID pgtype.Text, workspaceID pgtype.Text) (pgconn.CommandTag, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "UpdateWorkspaceAutoDestroyRun")
+	cmdTag, err := q.conn.Exec(ctx, updateWorkspaceAutoDestroyRunSQL, runID, workspaceID)
+	if err != nil {
+		return pgconn.CommandTag{}, fmt.Errorf("exec query UpdateWorkspaceAutoDestroyRun: %w", err)
+	}
+	return cmdTag, err
+}
+
 const updateWorkspaceLockByIDSQL = `UPDATE workspaces
 SET
     lock_username = $1,
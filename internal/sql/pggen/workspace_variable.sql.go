@@ -46,6 +46,7 @@ type FindWorkspaceVariablesByWorkspaceIDRow struct {
 	Sensitive   pgtype.Bool `json:"sensitive"`
 	HCL         pgtype.Bool `json:"hcl"`
 	VersionID   pgtype.Text `json:"version_id"`
+	SecretRef   pgtype.Bool `json:"secret_ref"`
 }
 
 // FindWorkspaceVariablesByWorkspaceID implements Querier.FindWorkspaceVariablesByWorkspaceID.
@@ -66,6 +67,7 @@ func (q *DBQuerier) FindWorkspaceVariablesByWorkspaceID(ctx context.Context, wor
 			&item.Sensitive,   // 'sensitive', 'Sensitive', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.HCL,         // 'hcl', 'HCL', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 			&item.VersionID,   // 'version_id', 'VersionID', 'pgtype.Text', 'github.com/jackc/pgx/v5/pgtype', 'Text'
+			&item.SecretRef,   // 'secret_ref', 'SecretRef', 'pgtype.Bool', 'github.com/jackc/pgx/v5/pgtype', 'Bool'
 		); err != nil {
 			return item, fmt.Errorf("failed to scan: %w", err)
 		}
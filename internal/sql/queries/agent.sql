@@ -8,7 +8,8 @@ INSERT INTO agents (
     last_ping_at,
     last_status_at,
     status,
-    agent_pool_id
+    agent_pool_id,
+    labels
 ) VALUES (
     pggen.arg('agent_id'),
     pggen.arg('name'),
@@ -18,7 +19,8 @@ INSERT INTO agents (
     pggen.arg('last_ping_at'),
     pggen.arg('last_status_at'),
     pggen.arg('status'),
-    pggen.arg('agent_pool_id')
+    pggen.arg('agent_pool_id'),
+    pggen.arg('labels')
 );
 
 -- name: UpdateAgent :one
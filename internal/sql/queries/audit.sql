@@ -0,0 +1,53 @@
+-- name: InsertAuditEvent :exec
+INSERT INTO audit_events (
+    audit_event_id,
+    time,
+    subject,
+    action,
+    resource_id,
+    organization_name,
+    client_ip,
+    user_agent,
+    method,
+    path,
+    status_code
+) VALUES (
+    pggen.arg('audit_event_id'),
+    pggen.arg('time'),
+    pggen.arg('subject'),
+    pggen.arg('action'),
+    pggen.arg('resource_id'),
+    pggen.arg('organization_name'),
+    pggen.arg('client_ip'),
+    pggen.arg('user_agent'),
+    pggen.arg('method'),
+    pggen.arg('path'),
+    pggen.arg('status_code')
+);
+
+-- name: FindAuditEvents :many
+SELECT *
+FROM audit_events
+WHERE (pggen.arg('organization_name')::text IS NULL OR organization_name = pggen.arg('organization_name'))
+AND   (pggen.arg('subject')::text IS NULL OR subject = pggen.arg('subject'))
+AND   (pggen.arg('action')::text IS NULL OR action = pggen.arg('action'))
+AND   (pggen.arg('resource_id')::text IS NULL OR resource_id = pggen.arg('resource_id'))
+ORDER BY time DESC
+LIMIT pggen.arg('limit')
+OFFSET pggen.arg('offset')
+;
+
+-- name: CountAuditEvents :one
+SELECT count(*)
+FROM audit_events
+WHERE (pggen.arg('organization_name')::text IS NULL OR organization_name = pggen.arg('organization_name'))
+AND   (pggen.arg('subject')::text IS NULL OR subject = pggen.arg('subject'))
+AND   (pggen.arg('action')::text IS NULL OR action = pggen.arg('action'))
+AND   (pggen.arg('resource_id')::text IS NULL OR resource_id = pggen.arg('resource_id'))
+;
+
+-- name: DeleteAuditEventsBefore :exec
+DELETE
+FROM audit_events
+WHERE time < pggen.arg('before')
+;
@@ -0,0 +1,109 @@
+-- Queries for migrating blobs from the database to a blob store.
+
+-- name: FindInlineStateVersions :many
+SELECT state_version_id, state
+FROM state_versions
+WHERE state IS NOT NULL
+LIMIT pggen.arg('limit')
+;
+
+-- name: ClearStateVersionState :exec
+UPDATE state_versions
+SET state = NULL
+WHERE state_version_id = pggen.arg('state_version_id')
+;
+
+-- name: FindInlineConfigurationVersions :many
+SELECT configuration_version_id, config
+FROM configuration_versions
+WHERE config IS NOT NULL
+LIMIT pggen.arg('limit')
+;
+
+-- name: ClearConfigurationVersionConfig :exec
+UPDATE configuration_versions
+SET config = NULL
+WHERE configuration_version_id = pggen.arg('configuration_version_id')
+;
+
+-- name: FindInlinePlanFiles :many
+SELECT run_id, plan_bin, plan_json
+FROM plans
+WHERE plan_bin IS NOT NULL
+OR    plan_json IS NOT NULL
+LIMIT pggen.arg('limit')
+;
+
+-- name: ClearPlanFiles :exec
+UPDATE plans
+SET plan_bin = NULL,
+    plan_json = NULL
+WHERE run_id = pggen.arg('run_id')
+;
+
+-- name: FindInlineModuleTarballs :many
+SELECT module_version_id, tarball
+FROM module_tarballs
+WHERE tarball IS NOT NULL
+LIMIT pggen.arg('limit')
+;
+
+-- name: ClearModuleTarball :exec
+UPDATE module_tarballs
+SET tarball = NULL
+WHERE module_version_id = pggen.arg('module_version_id')
+;
+
+-- name: FindInlineRegistryProviderBinaries :many
+SELECT registry_provider_platform_id, provider_binary
+FROM registry_provider_platforms
+WHERE provider_binary IS NOT NULL
+LIMIT pggen.arg('limit')
+;
+
+-- name: ClearRegistryProviderBinary :exec
+UPDATE registry_provider_platforms
+SET provider_binary = NULL
+WHERE registry_provider_platform_id = pggen.arg('registry_provider_platform_id')
+;
+
+-- name: FindInlineProviderMirrorArchives :many
+SELECT provider_mirror_version_id, os, arch, archive
+FROM provider_mirror_archives
+WHERE archive IS NOT NULL
+LIMIT pggen.arg('limit')
+;
+
+-- name: ClearProviderMirrorArchive :exec
+UPDATE provider_mirror_archives
+SET archive = NULL
+WHERE provider_mirror_version_id = pggen.arg('provider_mirror_version_id')
+AND   os = pggen.arg('os')
+AND   arch = pggen.arg('arch')
+;
+
+-- name: FindInlineLogChunks :many
+SELECT chunk_id, run_id, phase, chunk
+FROM logs
+WHERE chunk IS NOT NULL
+LIMIT pggen.arg('limit')
+;
+
+-- name: ClearLogChunk :exec
+UPDATE logs
+SET chunk = NULL
+WHERE chunk_id = pggen.arg('chunk_id')
+;
+
+-- name: FindBlobDeletions :many
+SELECT key
+FROM blob_deletions
+ORDER BY created_at
+LIMIT pggen.arg('limit')
+;
+
+-- name: DeleteBlobDeletions :exec
+DELETE
+FROM blob_deletions
+WHERE key = ANY(pggen.arg('keys')::TEXT[])
+;
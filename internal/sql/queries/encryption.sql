@@ -0,0 +1,53 @@
+-- Queries for re-encrypting sensitive data with the primary encryption key.
+
+-- name: FindSensitiveVariablesForReencryption :many
+SELECT variable_id, value
+FROM variables
+WHERE sensitive
+AND   variable_id > pggen.arg('after')
+ORDER BY variable_id
+LIMIT pggen.arg('limit')
+;
+
+-- name: UpdateVariableValue :exec
+UPDATE variables
+SET value = pggen.arg('value')
+WHERE variable_id = pggen.arg('variable_id')
+;
+
+-- name: FindStateVersionsForReencryption :many
+SELECT state_version_id, state
+FROM state_versions
+WHERE state_version_id > pggen.arg('after')
+ORDER BY state_version_id
+LIMIT pggen.arg('limit')
+;
+
+-- name: UpdateStateVersionState :exec
+UPDATE state_versions
+SET state = pggen.arg('state')
+WHERE state_version_id = pggen.arg('state_version_id')
+;
+
+-- name: FindSensitiveStateVersionOutputsForReencryption :many
+SELECT state_version_output_id, value
+FROM state_version_outputs
+WHERE sensitive
+AND   state_version_output_id > pggen.arg('after')
+ORDER BY state_version_output_id
+LIMIT pggen.arg('limit')
+;
+
+-- name: UpdateStateVersionOutputValue :exec
+UPDATE state_version_outputs
+SET value = pggen.arg('value')
+WHERE state_version_output_id = pggen.arg('state_version_output_id')
+;
+
+-- name: FindPlanFilesForReencryption :many
+SELECT run_id, plan_bin, plan_json
+FROM plans
+WHERE run_id > pggen.arg('after')
+ORDER BY run_id
+LIMIT pggen.arg('limit')
+;
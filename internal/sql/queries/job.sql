@@ -16,12 +16,43 @@ SELECT
     j.status,
     j.signaled,
     j.agent_id,
+    j.started_at,
     w.agent_pool_id,
+    w.agent_selector,
+    CASE j.phase
+        WHEN 'plan' THEN COALESCE(w.plan_timeout, o.plan_timeout)
+        ELSE COALESCE(w.apply_timeout, o.apply_timeout)
+    END AS timeout,
     r.workspace_id,
     w.organization_name
 FROM jobs j
 JOIN runs r USING (run_id)
 JOIN workspaces w USING (workspace_id)
+JOIN organizations o ON o.name = w.organization_name
+;
+
+-- name: FindRunningJobs :many
+SELECT
+    j.run_id,
+    j.phase,
+    j.status,
+    j.signaled,
+    j.agent_id,
+    j.started_at,
+    w.agent_pool_id,
+    w.agent_selector,
+    CASE j.phase
+        WHEN 'plan' THEN COALESCE(w.plan_timeout, o.plan_timeout)
+        ELSE COALESCE(w.apply_timeout, o.apply_timeout)
+    END AS timeout,
+    r.workspace_id,
+    w.organization_name
+FROM jobs j
+JOIN runs r USING (run_id)
+JOIN workspaces w USING (workspace_id)
+JOIN organizations o ON o.name = w.organization_name
+WHERE j.status = 'running'
+AND   j.started_at IS NOT NULL
 ;
 
 -- name: FindJob :one
@@ -31,12 +62,19 @@ SELECT
     j.status,
     j.signaled,
     j.agent_id,
+    j.started_at,
     w.agent_pool_id,
+    w.agent_selector,
+    CASE j.phase
+        WHEN 'plan' THEN COALESCE(w.plan_timeout, o.plan_timeout)
+        ELSE COALESCE(w.apply_timeout, o.apply_timeout)
+    END AS timeout,
     r.workspace_id,
     w.organization_name
 FROM jobs j
 JOIN runs r USING (run_id)
 JOIN workspaces w USING (workspace_id)
+JOIN organizations o ON o.name = w.organization_name
 WHERE run_id = pggen.arg('run_id')
 AND   phase = pggen.arg('phase')
 ;
@@ -48,12 +86,19 @@ SELECT
     j.status,
     j.signaled,
     j.agent_id,
+    j.started_at,
     w.agent_pool_id,
+    w.agent_selector,
+    CASE j.phase
+        WHEN 'plan' THEN COALESCE(w.plan_timeout, o.plan_timeout)
+        ELSE COALESCE(w.apply_timeout, o.apply_timeout)
+    END AS timeout,
     r.workspace_id,
     w.organization_name
 FROM jobs j
 JOIN runs r USING (run_id)
 JOIN workspaces w USING (workspace_id)
+JOIN organizations o ON o.name = w.organization_name
 WHERE run_id = pggen.arg('run_id')
 AND   phase = pggen.arg('phase')
 FOR UPDATE OF j
@@ -66,12 +111,19 @@ SELECT
     j.status,
     j.signaled,
     j.agent_id,
+    j.started_at,
     w.agent_pool_id,
+    w.agent_selector,
+    CASE j.phase
+        WHEN 'plan' THEN COALESCE(w.plan_timeout, o.plan_timeout)
+        ELSE COALESCE(w.apply_timeout, o.apply_timeout)
+    END AS timeout,
     r.workspace_id,
     w.organization_name
 FROM jobs j
 JOIN runs r USING (run_id)
 JOIN workspaces w USING (workspace_id)
+JOIN organizations o ON o.name = w.organization_name
 WHERE j.agent_id = pggen.arg('agent_id')
 AND   j.status = 'allocated';
 
@@ -80,9 +132,10 @@ AND   j.status = 'allocated';
 -- name: FindAndUpdateSignaledJobs :many
 UPDATE jobs AS j
 SET signaled = NULL
-FROM runs r, workspaces w
+FROM runs r, workspaces w, organizations o
 WHERE j.run_id = r.run_id
 AND   r.workspace_id = w.workspace_id
+AND   w.organization_name = o.name
 AND   j.agent_id = pggen.arg('agent_id')
 AND   j.status = 'running'
 AND   j.signaled IS NOT NULL
@@ -92,7 +145,13 @@ RETURNING
     j.status,
     j.signaled,
     j.agent_id,
+    j.started_at,
     w.agent_pool_id,
+    w.agent_selector,
+    CASE j.phase
+        WHEN 'plan' THEN COALESCE(w.plan_timeout, o.plan_timeout)
+        ELSE COALESCE(w.apply_timeout, o.apply_timeout)
+    END AS timeout,
     r.workspace_id,
     w.organization_name
 ;
@@ -101,7 +160,8 @@ RETURNING
 UPDATE jobs
 SET status   = pggen.arg('status'),
     signaled = pggen.arg('signaled'),
-    agent_id = pggen.arg('agent_id')
+    agent_id = pggen.arg('agent_id'),
+    started_at = pggen.arg('started_at')
 WHERE run_id = pggen.arg('run_id')
 AND   phase = pggen.arg('phase')
 RETURNING *;
@@ -0,0 +1,18 @@
+-- name: UpsertModuleVersionMetadata :exec
+INSERT INTO module_version_metadata (
+    module_version_id,
+    metadata,
+    readme_html
+) VALUES (
+    pggen.arg('module_version_id'),
+    pggen.arg('metadata'),
+    pggen.arg('readme_html')
+) ON CONFLICT (module_version_id) DO UPDATE
+SET metadata    = excluded.metadata,
+    readme_html = excluded.readme_html;
+
+-- name: FindModuleVersionMetadata :one
+SELECT *
+FROM module_version_metadata
+WHERE module_version_id = pggen.arg('module_version_id')
+;
@@ -3,16 +3,20 @@ INSERT INTO organization_tokens (
     organization_token_id,
     created_at,
     organization_name,
-    expiry
+    expiry,
+    scope
 ) VALUES (
     pggen.arg('organization_token_id'),
     pggen.arg('created_at'),
     pggen.arg('organization_name'),
-    pggen.arg('expiry')
+    pggen.arg('expiry'),
+    pggen.arg('scope')
 ) ON CONFLICT (organization_name) DO UPDATE
   SET created_at            = pggen.arg('created_at'),
       organization_token_id = pggen.arg('organization_token_id'),
-      expiry                = pggen.arg('expiry');
+      expiry                = pggen.arg('expiry'),
+      scope                 = pggen.arg('scope'),
+      last_used_at          = NULL;
 
 -- name: FindOrganizationTokens :many
 SELECT *
@@ -34,3 +38,21 @@ DELETE
 FROM organization_tokens
 WHERE organization_name = pggen.arg('organization_name')
 RETURNING organization_token_id;
+
+-- name: UpdateOrganizationTokenLastUsedAt :exec
+UPDATE organization_tokens
+SET last_used_at = pggen.arg('last_used_at')
+WHERE organization_token_id = pggen.arg('organization_token_id');
+
+-- name: FindStaleOrganizationTokens :many
+SELECT *
+FROM organization_tokens
+WHERE expiry < now()
+OR COALESCE(last_used_at, created_at) < pggen.arg('cutoff');
+
+-- name: DeleteStaleOrganizationTokens :many
+DELETE
+FROM organization_tokens
+WHERE expiry < now()
+OR COALESCE(last_used_at, created_at) < pggen.arg('cutoff')
+RETURNING *;
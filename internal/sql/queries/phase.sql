@@ -26,19 +26,17 @@ INSERT INTO logs (
 RETURNING chunk_id
 ;
 
--- FindLogs retrieves all the logs for the given run and phase.
+-- FindLogChunks retrieves all the chunks of logs for the given run and phase,
+-- in the order in which they were written.
 --
--- name: FindLogs :one
+-- name: FindLogChunks :many
 SELECT
-    string_agg(chunk, '')
-FROM (
-    SELECT run_id, phase, chunk
-    FROM logs
-    WHERE run_id = pggen.arg('run_id')
-    AND   phase  = pggen.arg('phase')
-    ORDER BY chunk_id
-) c
-GROUP BY run_id, phase
+    chunk_id,
+    chunk
+FROM logs
+WHERE run_id = pggen.arg('run_id')
+AND   phase  = pggen.arg('phase')
+ORDER BY chunk_id
 ;
 
 -- name: FindLogChunkByID :one
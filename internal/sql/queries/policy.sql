@@ -0,0 +1,118 @@
+-- name: InsertPolicySet :exec
+INSERT INTO policy_sets (
+    policy_set_id,
+    created_at,
+    updated_at,
+    name,
+    description,
+    organization_name
+) VALUES (
+    pggen.arg('policy_set_id'),
+    pggen.arg('created_at'),
+    pggen.arg('updated_at'),
+    pggen.arg('name'),
+    pggen.arg('description'),
+    pggen.arg('organization_name')
+);
+
+-- name: FindPolicySetsByOrganization :many
+SELECT *
+FROM policy_sets
+WHERE organization_name = pggen.arg('organization_name')
+ORDER BY name
+;
+
+-- name: FindPolicySet :one
+SELECT *
+FROM policy_sets
+WHERE policy_set_id = pggen.arg('policy_set_id')
+;
+
+-- name: FindPolicySetForUpdate :one
+SELECT *
+FROM policy_sets
+WHERE policy_set_id = pggen.arg('policy_set_id')
+FOR UPDATE
+;
+
+-- name: UpdatePolicySet :one
+UPDATE policy_sets
+SET
+    updated_at  = pggen.arg('updated_at'),
+    name        = pggen.arg('name'),
+    description = pggen.arg('description')
+WHERE policy_set_id = pggen.arg('policy_set_id')
+RETURNING policy_set_id
+;
+
+-- name: DeletePolicySet :one
+DELETE FROM policy_sets
+WHERE policy_set_id = pggen.arg('policy_set_id')
+RETURNING policy_set_id
+;
+
+-- name: InsertPolicy :exec
+INSERT INTO policies (
+    policy_id,
+    created_at,
+    updated_at,
+    name,
+    enforcement_level,
+    source,
+    policy_set_id
+) VALUES (
+    pggen.arg('policy_id'),
+    pggen.arg('created_at'),
+    pggen.arg('updated_at'),
+    pggen.arg('name'),
+    pggen.arg('enforcement_level'),
+    pggen.arg('source'),
+    pggen.arg('policy_set_id')
+);
+
+-- name: FindPoliciesByPolicySetID :many
+SELECT *
+FROM policies
+WHERE policy_set_id = pggen.arg('policy_set_id')
+ORDER BY name
+;
+
+-- FindPoliciesByOrganization finds the policies belonging to all policy sets
+-- in an organization.
+--
+-- name: FindPoliciesByOrganization :many
+SELECT p.*
+FROM policies p
+JOIN policy_sets ps USING (policy_set_id)
+WHERE ps.organization_name = pggen.arg('organization_name')
+ORDER BY ps.name, p.name
+;
+
+-- name: FindPolicy :one
+SELECT *
+FROM policies
+WHERE policy_id = pggen.arg('policy_id')
+;
+
+-- name: FindPolicyForUpdate :one
+SELECT *
+FROM policies
+WHERE policy_id = pggen.arg('policy_id')
+FOR UPDATE
+;
+
+-- name: UpdatePolicy :one
+UPDATE policies
+SET
+    updated_at        = pggen.arg('updated_at'),
+    enforcement_level = pggen.arg('enforcement_level'),
+    source            = pggen.arg('source')
+WHERE policy_id = pggen.arg('policy_id')
+RETURNING policy_id
+;
+
+-- name: DeletePolicy :one
+DELETE FROM policies
+WHERE policy_id = pggen.arg('policy_id')
+RETURNING policy_id
+;
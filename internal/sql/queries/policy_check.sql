@@ -0,0 +1,35 @@
+-- name: InsertPolicyCheck :exec
+INSERT INTO policy_checks (
+    policy_check_id,
+    created_at,
+    status,
+    results,
+    run_id
+) VALUES (
+    pggen.arg('policy_check_id'),
+    pggen.arg('created_at'),
+    pggen.arg('status'),
+    pggen.arg('results'),
+    pggen.arg('run_id')
+);
+
+-- name: FindPolicyCheck :one
+SELECT *
+FROM policy_checks
+WHERE policy_check_id = pggen.arg('policy_check_id')
+;
+
+-- name: FindPolicyCheckByRunID :one
+SELECT *
+FROM policy_checks
+WHERE run_id = pggen.arg('run_id')
+;
+
+-- name: UpdatePolicyCheckStatus :one
+UPDATE policy_checks
+SET
+    status        = pggen.arg('status'),
+    overridden_by = pggen.arg('overridden_by')
+WHERE policy_check_id = pggen.arg('policy_check_id')
+RETURNING policy_check_id
+;
@@ -0,0 +1,108 @@
+-- name: InsertProject :exec
+INSERT INTO projects (
+    project_id,
+    created_at,
+    updated_at,
+    name,
+    description,
+    organization_name
+) VALUES (
+    pggen.arg('project_id'),
+    pggen.arg('created_at'),
+    pggen.arg('updated_at'),
+    pggen.arg('name'),
+    pggen.arg('description'),
+    pggen.arg('organization_name')
+);
+
+-- name: FindProjects :many
+SELECT *
+FROM projects
+WHERE organization_name = pggen.arg('organization_name')
+AND   name LIKE '%' || pggen.arg('search') || '%'
+ORDER BY name
+LIMIT pggen.arg('limit')
+OFFSET pggen.arg('offset')
+;
+
+-- name: CountProjects :one
+SELECT count(*)
+FROM projects
+WHERE organization_name = pggen.arg('organization_name')
+AND   name LIKE '%' || pggen.arg('search') || '%'
+;
+
+-- name: FindProjectByID :one
+SELECT *
+FROM projects
+WHERE project_id = pggen.arg('project_id')
+;
+
+-- name: FindProjectByIDForUpdate :one
+SELECT *
+FROM projects
+WHERE project_id = pggen.arg('project_id')
+FOR UPDATE
+;
+
+-- name: UpdateProject :one
+UPDATE projects
+SET name        = pggen.arg('name'),
+    description = pggen.arg('description'),
+    updated_at  = pggen.arg('updated_at')
+WHERE project_id = pggen.arg('project_id')
+RETURNING project_id
+;
+
+-- name: DeleteProject :one
+DELETE
+FROM projects
+WHERE project_id = pggen.arg('project_id')
+RETURNING project_id
+;
+
+-- name: UpsertProjectPermission :exec
+INSERT INTO project_permissions (
+    project_permission_id,
+    project_id,
+    team_id,
+    role
+) VALUES (
+    pggen.arg('project_permission_id'),
+    pggen.arg('project_id'),
+    pggen.arg('team_id'),
+    pggen.arg('role')
+) ON CONFLICT (project_id, team_id) DO UPDATE SET role = pggen.arg('role');
+
+-- name: FindProjectPermissionByID :one
+SELECT *
+FROM project_permissions
+WHERE project_permission_id = pggen.arg('project_permission_id')
+;
+
+-- name: FindProjectPermissionByTeamID :one
+SELECT *
+FROM project_permissions
+WHERE project_id = pggen.arg('project_id')
+AND   team_id = pggen.arg('team_id')
+;
+
+-- name: FindProjectPermissionsByProjectID :many
+SELECT *
+FROM project_permissions
+WHERE project_id = pggen.arg('project_id')
+;
+
+-- name: FindProjectPermissionsByWorkspaceID :many
+SELECT pp.*
+FROM project_permissions pp
+JOIN workspaces w USING (project_id)
+WHERE w.workspace_id = pggen.arg('workspace_id')
+;
+
+-- name: DeleteProjectPermission :exec
+DELETE
+FROM project_permissions
+WHERE project_id = pggen.arg('project_id')
+AND   team_id = pggen.arg('team_id')
+;
@@ -0,0 +1,74 @@
+-- name: InsertProviderMirrorVersion :exec
+INSERT INTO provider_mirror_versions (
+    provider_mirror_version_id,
+    created_at,
+    hostname,
+    namespace,
+    type,
+    version,
+    shasums
+) VALUES (
+    pggen.arg('provider_mirror_version_id'),
+    pggen.arg('created_at'),
+    pggen.arg('hostname'),
+    pggen.arg('namespace'),
+    pggen.arg('type'),
+    pggen.arg('version'),
+    pggen.arg('shasums')
+) ON CONFLICT (hostname, namespace, type, version) DO NOTHING;
+
+-- name: FindProviderMirrorVersions :many
+SELECT *
+FROM provider_mirror_versions
+WHERE hostname = pggen.arg('hostname')
+AND   namespace = pggen.arg('namespace')
+AND   type = pggen.arg('type')
+;
+
+-- name: FindProviderMirrorVersion :one
+SELECT *
+FROM provider_mirror_versions
+WHERE hostname = pggen.arg('hostname')
+AND   namespace = pggen.arg('namespace')
+AND   type = pggen.arg('type')
+AND   version = pggen.arg('version')
+;
+
+-- name: FindProviderMirrorVersionByID :one
+SELECT *
+FROM provider_mirror_versions
+WHERE provider_mirror_version_id = pggen.arg('provider_mirror_version_id')
+;
+
+-- name: InsertProviderMirrorArchive :exec
+INSERT INTO provider_mirror_archives (
+    provider_mirror_version_id,
+    created_at,
+    os,
+    arch,
+    filename,
+    shasum,
+    archive
+) VALUES (
+    pggen.arg('provider_mirror_version_id'),
+    pggen.arg('created_at'),
+    pggen.arg('os'),
+    pggen.arg('arch'),
+    pggen.arg('filename'),
+    pggen.arg('shasum'),
+    pggen.arg('archive')
+) ON CONFLICT (provider_mirror_version_id, os, arch) DO NOTHING;
+
+-- name: FindProviderMirrorArchive :one
+SELECT *
+FROM provider_mirror_archives
+WHERE provider_mirror_version_id = pggen.arg('provider_mirror_version_id')
+AND   os = pggen.arg('os')
+AND   arch = pggen.arg('arch')
+;
+
+-- name: DeleteProviderMirrorVersionsBefore :exec
+DELETE
+FROM provider_mirror_versions
+WHERE created_at < pggen.arg('before')
+;
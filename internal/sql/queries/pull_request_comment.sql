@@ -0,0 +1,23 @@
+-- name: FindPullRequestCommentID :one
+SELECT comment_id
+FROM pull_request_comments
+WHERE workspace_id = pggen.arg('workspace_id')
+AND   repo = pggen.arg('repo')
+AND   pull_request_number = pggen.arg('pull_request_number')
+;
+
+-- name: UpsertPullRequestComment :exec
+INSERT INTO pull_request_comments (
+    workspace_id,
+    repo,
+    pull_request_number,
+    comment_id
+) VALUES (
+    pggen.arg('workspace_id'),
+    pggen.arg('repo'),
+    pggen.arg('pull_request_number'),
+    pggen.arg('comment_id')
+)
+ON CONFLICT (workspace_id, repo, pull_request_number) DO UPDATE
+SET comment_id = EXCLUDED.comment_id
+;
@@ -0,0 +1,228 @@
+-- name: InsertRegistryProvider :exec
+INSERT INTO registry_providers (
+    registry_provider_id,
+    created_at,
+    updated_at,
+    name,
+    organization_name
+) VALUES (
+    pggen.arg('registry_provider_id'),
+    pggen.arg('created_at'),
+    pggen.arg('updated_at'),
+    pggen.arg('name'),
+    pggen.arg('organization_name')
+);
+
+-- name: FindRegistryProviders :many
+SELECT *
+FROM registry_providers
+WHERE organization_name = pggen.arg('organization_name')
+ORDER BY name ASC
+;
+
+-- name: FindRegistryProvider :one
+SELECT *
+FROM registry_providers
+WHERE organization_name = pggen.arg('organization_name')
+AND   name = pggen.arg('name')
+;
+
+-- name: FindRegistryProviderByID :one
+SELECT *
+FROM registry_providers
+WHERE registry_provider_id = pggen.arg('registry_provider_id')
+;
+
+-- name: DeleteRegistryProvider :exec
+DELETE
+FROM registry_providers
+WHERE registry_provider_id = pggen.arg('registry_provider_id')
+;
+
+-- name: InsertRegistryProviderVersion :exec
+INSERT INTO registry_provider_versions (
+    registry_provider_version_id,
+    created_at,
+    updated_at,
+    version,
+    key_id,
+    protocols,
+    registry_provider_id
+) VALUES (
+    pggen.arg('registry_provider_version_id'),
+    pggen.arg('created_at'),
+    pggen.arg('updated_at'),
+    pggen.arg('version'),
+    pggen.arg('key_id'),
+    pggen.arg('protocols'),
+    pggen.arg('registry_provider_id')
+);
+
+-- name: FindRegistryProviderVersions :many
+SELECT
+    registry_provider_version_id,
+    created_at,
+    updated_at,
+    version,
+    key_id,
+    protocols,
+    registry_provider_id,
+    shasums IS NOT NULL AS shasums_uploaded,
+    shasums_sig IS NOT NULL AS shasums_sig_uploaded
+FROM registry_provider_versions
+WHERE registry_provider_id = pggen.arg('registry_provider_id')
+ORDER BY created_at ASC
+;
+
+-- name: FindRegistryProviderVersion :one
+SELECT
+    registry_provider_version_id,
+    created_at,
+    updated_at,
+    version,
+    key_id,
+    protocols,
+    registry_provider_id,
+    shasums IS NOT NULL AS shasums_uploaded,
+    shasums_sig IS NOT NULL AS shasums_sig_uploaded
+FROM registry_provider_versions
+WHERE registry_provider_id = pggen.arg('registry_provider_id')
+AND   version = pggen.arg('version')
+;
+
+-- name: FindRegistryProviderVersionByID :one
+SELECT
+    registry_provider_version_id,
+    created_at,
+    updated_at,
+    version,
+    key_id,
+    protocols,
+    registry_provider_id,
+    shasums IS NOT NULL AS shasums_uploaded,
+    shasums_sig IS NOT NULL AS shasums_sig_uploaded
+FROM registry_provider_versions
+WHERE registry_provider_version_id = pggen.arg('registry_provider_version_id')
+;
+
+-- name: UpdateRegistryProviderVersionShasums :exec
+UPDATE registry_provider_versions
+SET shasums = pggen.arg('shasums'),
+    shasums_sig = NULL,
+    updated_at = pggen.arg('updated_at')
+WHERE registry_provider_version_id = pggen.arg('registry_provider_version_id')
+;
+
+-- name: UpdateRegistryProviderVersionShasumsSig :exec
+UPDATE registry_provider_versions
+SET shasums_sig = pggen.arg('shasums_sig'),
+    updated_at = pggen.arg('updated_at')
+WHERE registry_provider_version_id = pggen.arg('registry_provider_version_id')
+;
+
+-- name: FindRegistryProviderVersionShasums :one
+SELECT shasums
+FROM registry_provider_versions
+WHERE registry_provider_version_id = pggen.arg('registry_provider_version_id')
+;
+
+-- name: FindRegistryProviderVersionShasumsSig :one
+SELECT shasums_sig
+FROM registry_provider_versions
+WHERE registry_provider_version_id = pggen.arg('registry_provider_version_id')
+;
+
+-- name: DeleteRegistryProviderVersion :exec
+DELETE
+FROM registry_provider_versions
+WHERE registry_provider_version_id = pggen.arg('registry_provider_version_id')
+;
+
+-- name: InsertRegistryProviderPlatform :exec
+INSERT INTO registry_provider_platforms (
+    registry_provider_platform_id,
+    created_at,
+    updated_at,
+    os,
+    arch,
+    shasum,
+    filename,
+    registry_provider_version_id
+) VALUES (
+    pggen.arg('registry_provider_platform_id'),
+    pggen.arg('created_at'),
+    pggen.arg('updated_at'),
+    pggen.arg('os'),
+    pggen.arg('arch'),
+    pggen.arg('shasum'),
+    pggen.arg('filename'),
+    pggen.arg('registry_provider_version_id')
+);
+
+-- name: FindRegistryProviderPlatforms :many
+SELECT
+    registry_provider_platform_id,
+    created_at,
+    updated_at,
+    os,
+    arch,
+    shasum,
+    filename,
+    binary_uploaded,
+    registry_provider_version_id
+FROM registry_provider_platforms
+WHERE registry_provider_version_id = pggen.arg('registry_provider_version_id')
+ORDER BY os ASC, arch ASC
+;
+
+-- name: FindRegistryProviderPlatform :one
+SELECT
+    registry_provider_platform_id,
+    created_at,
+    updated_at,
+    os,
+    arch,
+    shasum,
+    filename,
+    binary_uploaded,
+    registry_provider_version_id
+FROM registry_provider_platforms
+WHERE registry_provider_version_id = pggen.arg('registry_provider_version_id')
+AND   os = pggen.arg('os')
+AND   arch = pggen.arg('arch')
+;
+
+-- name: FindRegistryProviderPlatformByID :one
+SELECT
+    registry_provider_platform_id,
+    created_at,
+    updated_at,
+    os,
+    arch,
+    shasum,
+    filename,
+    binary_uploaded,
+    registry_provider_version_id
+FROM registry_provider_platforms
+WHERE registry_provider_platform_id = pggen.arg('registry_provider_platform_id')
+;
+
+-- name: UpdateRegistryProviderPlatformBinary :exec
+UPDATE registry_provider_platforms
+SET provider_binary = pggen.arg('provider_binary'),
+    binary_uploaded = true,
+    updated_at = pggen.arg('updated_at')
+WHERE registry_provider_platform_id = pggen.arg('registry_provider_platform_id')
+;
+
+-- name: FindRegistryProviderPlatformBinary :one
+SELECT provider_binary
+FROM registry_provider_platforms
+WHERE registry_provider_platform_id = pggen.arg('registry_provider_platform_id')
+;
+
+-- name: DeleteRegistryProviderPlatform :exec
+DELETE
+FROM registry_provider_platforms
+WHERE registry_provider_platform_id = pggen.arg('registry_provider_platform_id')
+;
@@ -1,17 +1,21 @@
 -- name: InsertLatestTerraformVersion :exec
 INSERT INTO latest_terraform_version (
     version,
-    checkpoint
+    checkpoint,
+    engine
 ) VALUES (
     pggen.arg('version'),
-    current_timestamp
+    current_timestamp,
+    pggen.arg('engine')
 );
 
 -- name: UpdateLatestTerraformVersion :exec
 UPDATE latest_terraform_version
 SET version = pggen.arg('version'),
-    checkpoint = current_timestamp;
+    checkpoint = current_timestamp
+WHERE engine = pggen.arg('engine');
 
 -- name: FindLatestTerraformVersion :many
 SELECT *
-FROM latest_terraform_version;
+FROM latest_terraform_version
+WHERE engine = pggen.arg('engine');
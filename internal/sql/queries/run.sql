@@ -16,7 +16,8 @@ INSERT INTO runs (
     workspace_id,
     created_by,
     terraform_version,
-    allow_empty_apply
+    allow_empty_apply,
+    engine
 ) VALUES (
     pggen.arg('id'),
     pggen.arg('created_at'),
@@ -34,7 +35,8 @@ INSERT INTO runs (
     pggen.arg('workspace_id'),
     pggen.arg('created_by'),
     pggen.arg('terraform_version'),
-    pggen.arg('allow_empty_apply')
+    pggen.arg('allow_empty_apply'),
+    pggen.arg('engine')
 );
 
 -- name: InsertRunStatusTimestamp :exec
@@ -84,6 +86,7 @@ SELECT
     runs.created_by,
     runs.terraform_version,
     runs.allow_empty_apply,
+    runs.engine,
     workspaces.execution_mode AS execution_mode,
     CASE WHEN workspaces.latest_run_id = runs.run_id THEN true
          ELSE false
@@ -177,6 +180,7 @@ SELECT
     runs.created_by,
     runs.terraform_version,
     runs.allow_empty_apply,
+    runs.engine,
     workspaces.execution_mode AS execution_mode,
     CASE WHEN workspaces.latest_run_id = runs.run_id THEN true
          ELSE false
@@ -244,6 +248,7 @@ SELECT
     runs.created_by,
     runs.terraform_version,
     runs.allow_empty_apply,
+    runs.engine,
     workspaces.execution_mode AS execution_mode,
     CASE WHEN workspaces.latest_run_id = runs.run_id THEN true
          ELSE false
@@ -0,0 +1,70 @@
+-- name: InsertSchedule :exec
+INSERT INTO schedules (
+    schedule_id,
+    created_at,
+    updated_at,
+    workspace_id,
+    cron_expression,
+    operation,
+    auto_apply,
+    next_run_at
+) VALUES (
+    pggen.arg('schedule_id'),
+    pggen.arg('created_at'),
+    pggen.arg('updated_at'),
+    pggen.arg('workspace_id'),
+    pggen.arg('cron_expression'),
+    pggen.arg('operation'),
+    pggen.arg('auto_apply'),
+    pggen.arg('next_run_at')
+);
+
+-- name: FindSchedule :one
+SELECT *
+FROM schedules
+WHERE schedule_id = pggen.arg('schedule_id')
+;
+
+-- name: FindScheduleForUpdate :one
+SELECT *
+FROM schedules
+WHERE schedule_id = pggen.arg('schedule_id')
+FOR UPDATE
+;
+
+-- name: FindSchedulesByWorkspaceID :many
+SELECT *
+FROM schedules
+WHERE workspace_id = pggen.arg('workspace_id')
+ORDER BY created_at
+;
+
+-- FindDueSchedules finds schedules whose next run is due at or before the
+-- given time.
+--
+-- name: FindDueSchedules :many
+SELECT *
+FROM schedules
+WHERE next_run_at <= pggen.arg('due')
+ORDER BY next_run_at
+;
+
+-- name: UpdateSchedule :one
+UPDATE schedules
+SET
+    updated_at      = pggen.arg('updated_at'),
+    cron_expression = pggen.arg('cron_expression'),
+    operation       = pggen.arg('operation'),
+    auto_apply      = pggen.arg('auto_apply'),
+    next_run_at     = pggen.arg('next_run_at'),
+    last_run_at     = pggen.arg('last_run_at'),
+    last_run_id     = pggen.arg('last_run_id')
+WHERE schedule_id = pggen.arg('schedule_id')
+RETURNING schedule_id
+;
+
+-- name: DeleteSchedule :one
+DELETE FROM schedules
+WHERE schedule_id = pggen.arg('schedule_id')
+RETURNING schedule_id
+;
@@ -3,16 +3,20 @@ INSERT INTO team_tokens (
     team_token_id,
     created_at,
     team_id,
-    expiry
+    expiry,
+    scope
 ) VALUES (
     pggen.arg('team_token_id'),
     pggen.arg('created_at'),
     pggen.arg('team_id'),
-    pggen.arg('expiry')
+    pggen.arg('expiry'),
+    pggen.arg('scope')
 ) ON CONFLICT (team_id) DO UPDATE
   SET team_token_id = pggen.arg('team_token_id'),
       created_at    = pggen.arg('created_at'),
-      expiry        = pggen.arg('expiry');
+      expiry        = pggen.arg('expiry'),
+      scope         = pggen.arg('scope'),
+      last_used_at  = NULL;
 
 --name: FindTeamTokensByID :many
 SELECT *
@@ -26,3 +30,29 @@ FROM team_tokens
 WHERE team_id = pggen.arg('team_id')
 RETURNING team_token_id
 ;
+
+-- name: UpdateTeamTokenLastUsedAt :exec
+UPDATE team_tokens
+SET last_used_at = pggen.arg('last_used_at')
+WHERE team_token_id = pggen.arg('team_token_id')
+;
+
+-- name: FindStaleTeamTokens :many
+SELECT tt.*, t.name AS team_name, t.organization_name
+FROM team_tokens tt
+JOIN teams t USING (team_id)
+WHERE tt.expiry < now()
+OR COALESCE(tt.last_used_at, tt.created_at) < pggen.arg('cutoff')
+;
+
+-- name: DeleteStaleTeamTokens :many
+DELETE
+FROM team_tokens tt
+USING teams t
+WHERE tt.team_id = t.team_id
+AND (
+    tt.expiry < now()
+    OR COALESCE(tt.last_used_at, tt.created_at) < pggen.arg('cutoff')
+)
+RETURNING tt.*, t.name AS team_name, t.organization_name
+;
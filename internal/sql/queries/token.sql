@@ -3,12 +3,16 @@ INSERT INTO tokens (
     token_id,
     created_at,
     description,
-    username
+    username,
+    expiry,
+    scope
 ) VALUES (
     pggen.arg('token_id'),
     pggen.arg('created_at'),
     pggen.arg('description'),
-    pggen.arg('username')
+    pggen.arg('username'),
+    pggen.arg('expiry'),
+    pggen.arg('scope')
 );
 
 -- name: FindTokensByUsername :many
@@ -29,3 +33,30 @@ FROM tokens
 WHERE token_id = pggen.arg('token_id')
 RETURNING token_id
 ;
+
+-- name: DeleteTokensByUsername :exec
+DELETE
+FROM tokens
+WHERE username = pggen.arg('username')
+;
+
+-- name: UpdateTokenLastUsedAt :exec
+UPDATE tokens
+SET last_used_at = pggen.arg('last_used_at')
+WHERE token_id = pggen.arg('token_id')
+;
+
+-- name: FindStaleTokens :many
+SELECT *
+FROM tokens
+WHERE expiry < now()
+OR COALESCE(last_used_at, created_at) < pggen.arg('cutoff')
+;
+
+-- name: DeleteStaleTokens :many
+DELETE
+FROM tokens
+WHERE expiry < now()
+OR COALESCE(last_used_at, created_at) < pggen.arg('cutoff')
+RETURNING *
+;
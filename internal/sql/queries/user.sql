@@ -3,12 +3,14 @@ INSERT INTO users (
     user_id,
     created_at,
     updated_at,
-    username
+    username,
+    active
 ) VALUES (
     pggen.arg('id'),
     pggen.arg('created_at'),
     pggen.arg('updated_at'),
-    pggen.arg('username')
+    pggen.arg('username'),
+    pggen.arg('active')
 );
 
 -- name: FindUsers :many
@@ -116,3 +118,11 @@ FROM users
 WHERE username = pggen.arg('username')
 RETURNING user_id
 ;
+
+-- name: UpdateUser :exec
+UPDATE users
+SET username = pggen.arg('username'),
+    active = pggen.arg('active'),
+    updated_at = pggen.arg('updated_at')
+WHERE user_id = pggen.arg('user_id')
+;
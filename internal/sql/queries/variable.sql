@@ -7,7 +7,8 @@ INSERT INTO variables (
     category,
     sensitive,
     hcl,
-    version_id
+    version_id,
+    secret_ref
 ) VALUES (
     pggen.arg('variable_id'),
     pggen.arg('key'),
@@ -16,7 +17,8 @@ INSERT INTO variables (
     pggen.arg('category'),
     pggen.arg('sensitive'),
     pggen.arg('hcl'),
-    pggen.arg('version_id')
+    pggen.arg('version_id'),
+    pggen.arg('secret_ref')
 );
 
 -- name: FindVariable :one
@@ -34,7 +36,8 @@ SET
     category = pggen.arg('category'),
     sensitive = pggen.arg('sensitive'),
     version_id = pggen.arg('version_id'),
-    hcl = pggen.arg('hcl')
+    hcl = pggen.arg('hcl'),
+    secret_ref = pggen.arg('secret_ref')
 WHERE variable_id = pggen.arg('variable_id')
 RETURNING variable_id
 ;
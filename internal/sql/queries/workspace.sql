@@ -25,7 +25,16 @@ INSERT INTO workspaces (
     trigger_patterns,
     vcs_tags_regex,
     working_directory,
-    organization_name
+    organization_name,
+    engine,
+    assessments_enabled,
+    auto_destroy_at,
+    auto_destroy_activity_duration,
+    delete_after_auto_destroy,
+    project_id,
+    agent_selector,
+    plan_timeout,
+    apply_timeout
 ) VALUES (
     pggen.arg('id'),
     pggen.arg('created_at'),
@@ -52,7 +61,16 @@ INSERT INTO workspaces (
     pggen.arg('trigger_patterns'),
     pggen.arg('vcs_tags_regex'),
     pggen.arg('working_directory'),
-    pggen.arg('organization_name')
+    pggen.arg('organization_name'),
+    pggen.arg('engine'),
+    pggen.arg('assessments_enabled'),
+    pggen.arg('auto_destroy_at'),
+    pggen.arg('auto_destroy_activity_duration'),
+    pggen.arg('delete_after_auto_destroy'),
+    pggen.arg('project_id'),
+    pggen.arg('agent_selector'),
+    pggen.arg('plan_timeout'),
+    pggen.arg('apply_timeout')
 );
 
 -- name: FindWorkspaces :many
@@ -85,6 +103,7 @@ LEFT JOIN runs r ON w.latest_run_id = r.run_id
 LEFT JOIN (workspace_tags wt JOIN tags t USING (tag_id)) ON wt.workspace_id = w.workspace_id
 WHERE w.name                LIKE '%' || pggen.arg('search') || '%'
 AND   w.organization_name   LIKE ANY(pggen.arg('organization_names'))
+AND   (pggen.arg('project_id')::text IS NULL OR w.project_id = pggen.arg('project_id'))
 GROUP BY w.workspace_id, r.status
 HAVING array_agg(t.name) @> pggen.arg('tags')
 ORDER BY w.updated_at DESC
@@ -100,6 +119,7 @@ WITH
         LEFT JOIN (workspace_tags wt JOIN tags t USING (tag_id)) ON w.workspace_id = wt.workspace_id
         WHERE w.name              LIKE '%' || pggen.arg('search') || '%'
         AND   w.organization_name LIKE ANY(pggen.arg('organization_names'))
+        AND   (pggen.arg('project_id')::text IS NULL OR w.project_id = pggen.arg('project_id'))
         GROUP BY w.workspace_id
         HAVING array_agg(t.name) @> pggen.arg('tags')
     )
@@ -143,16 +163,23 @@ SELECT
     (rl.*)::"runs" AS run_lock,
     (rc.*)::"repo_connections" AS workspace_connection
 FROM workspaces w
-JOIN workspace_permissions p USING (workspace_id)
 LEFT JOIN users ul ON w.lock_username = ul.username
 LEFT JOIN runs rl ON w.lock_run_id = rl.run_id
 LEFT JOIN runs r ON w.latest_run_id = r.run_id
 LEFT JOIN repo_connections rc ON w.workspace_id = rc.workspace_id
-JOIN teams t USING (team_id)
-JOIN team_memberships tm USING (team_id)
-JOIN users u ON tm.username = u.username
 WHERE w.organization_name  = pggen.arg('organization_name')
-AND   u.username           = pggen.arg('username')
+AND   w.workspace_id IN (
+    SELECT p.workspace_id
+    FROM workspace_permissions p
+    JOIN team_memberships tm USING (team_id)
+    WHERE tm.username = pggen.arg('username')
+    UNION
+    SELECT pw.workspace_id
+    FROM workspaces pw
+    JOIN project_permissions pp USING (project_id)
+    JOIN team_memberships tm USING (team_id)
+    WHERE tm.username = pggen.arg('username')
+)
 ORDER BY w.updated_at DESC
 LIMIT pggen.arg('limit')
 OFFSET pggen.arg('offset')
@@ -161,12 +188,19 @@ OFFSET pggen.arg('offset')
 -- name: CountWorkspacesByUsername :one
 SELECT count(*)
 FROM workspaces w
-JOIN workspace_permissions p USING (workspace_id)
-JOIN teams t USING (team_id)
-JOIN team_memberships tm USING (team_id)
-JOIN users u USING (username)
 WHERE w.organization_name = pggen.arg('organization_name')
-AND   u.username          = pggen.arg('username')
+AND   w.workspace_id IN (
+    SELECT p.workspace_id
+    FROM workspace_permissions p
+    JOIN team_memberships tm USING (team_id)
+    WHERE tm.username = pggen.arg('username')
+    UNION
+    SELECT pw.workspace_id
+    FROM workspaces pw
+    JOIN project_permissions pp USING (project_id)
+    JOIN team_memberships tm USING (team_id)
+    WHERE tm.username = pggen.arg('username')
+)
 ;
 
 -- name: FindWorkspaceByName :one
@@ -233,27 +267,92 @@ FOR UPDATE OF w;
 -- name: UpdateWorkspaceByID :one
 UPDATE workspaces
 SET
-    agent_pool_id                 = pggen.arg('agent_pool_id'),
-    allow_destroy_plan            = pggen.arg('allow_destroy_plan'),
-    allow_cli_apply               = pggen.arg('allow_cli_apply'),
-    auto_apply                    = pggen.arg('auto_apply'),
-    branch                        = pggen.arg('branch'),
-    description                   = pggen.arg('description'),
-    execution_mode                = pggen.arg('execution_mode'),
-    global_remote_state           = pggen.arg('global_remote_state'),
-    name                          = pggen.arg('name'),
-    queue_all_runs                = pggen.arg('queue_all_runs'),
-    speculative_enabled           = pggen.arg('speculative_enabled'),
-    structured_run_output_enabled = pggen.arg('structured_run_output_enabled'),
-    terraform_version             = pggen.arg('terraform_version'),
-    trigger_prefixes              = pggen.arg('trigger_prefixes'),
-    trigger_patterns              = pggen.arg('trigger_patterns'),
-    vcs_tags_regex                = pggen.arg('vcs_tags_regex'),
-    working_directory             = pggen.arg('working_directory'),
-    updated_at                    = pggen.arg('updated_at')
+    agent_pool_id                  = pggen.arg('agent_pool_id'),
+    agent_selector                 = pggen.arg('agent_selector'),
+    allow_destroy_plan             = pggen.arg('allow_destroy_plan'),
+    allow_cli_apply                = pggen.arg('allow_cli_apply'),
+    apply_timeout                  = pggen.arg('apply_timeout'),
+    assessments_enabled            = pggen.arg('assessments_enabled'),
+    auto_apply                     = pggen.arg('auto_apply'),
+    auto_destroy_at                = pggen.arg('auto_destroy_at'),
+    auto_destroy_activity_duration = pggen.arg('auto_destroy_activity_duration'),
+    branch                         = pggen.arg('branch'),
+    delete_after_auto_destroy      = pggen.arg('delete_after_auto_destroy'),
+    description                    = pggen.arg('description'),
+    engine                         = pggen.arg('engine'),
+    execution_mode                 = pggen.arg('execution_mode'),
+    global_remote_state            = pggen.arg('global_remote_state'),
+    name                           = pggen.arg('name'),
+    plan_timeout                   = pggen.arg('plan_timeout'),
+    project_id                     = pggen.arg('project_id'),
+    queue_all_runs                 = pggen.arg('queue_all_runs'),
+    speculative_enabled            = pggen.arg('speculative_enabled'),
+    structured_run_output_enabled  = pggen.arg('structured_run_output_enabled'),
+    terraform_version              = pggen.arg('terraform_version'),
+    trigger_prefixes               = pggen.arg('trigger_prefixes'),
+    trigger_patterns               = pggen.arg('trigger_patterns'),
+    vcs_tags_regex                 = pggen.arg('vcs_tags_regex'),
+    working_directory              = pggen.arg('working_directory'),
+    updated_at                     = pggen.arg('updated_at')
 WHERE workspace_id = pggen.arg('id')
 RETURNING workspace_id;
 
+-- name: FindWorkspacesForAssessment :many
+SELECT w.*,
+    (
+        SELECT array_agg(name)
+        FROM tags
+        JOIN workspace_tags wt USING (tag_id)
+        WHERE wt.workspace_id = w.workspace_id
+    ) AS tags,
+    r.status AS latest_run_status,
+    (ul.*)::"users" AS user_lock,
+    (rl.*)::"runs" AS run_lock,
+    (rc.*)::"repo_connections" AS workspace_connection
+FROM workspaces w
+LEFT JOIN users ul ON w.lock_username = ul.username
+LEFT JOIN runs rl ON w.lock_run_id = rl.run_id
+LEFT JOIN runs r ON w.latest_run_id = r.run_id
+LEFT JOIN repo_connections rc ON w.workspace_id = rc.workspace_id
+WHERE w.assessments_enabled
+AND   w.execution_mode <> 'local'
+AND   (w.latest_assessment_created_at IS NULL OR w.latest_assessment_created_at < pggen.arg('due_before'))
+;
+
+-- name: UpdateWorkspaceLatestAssessment :exec
+UPDATE workspaces
+SET
+    latest_assessment_run_id     = pggen.arg('run_id'),
+    latest_assessment_status     = pggen.arg('status'),
+    latest_assessment_created_at = pggen.arg('created_at')
+WHERE workspace_id = pggen.arg('workspace_id');
+
+-- name: FindWorkspacesForAutoDestroy :many
+SELECT w.*,
+    (
+        SELECT array_agg(name)
+        FROM tags
+        JOIN workspace_tags wt USING (tag_id)
+        WHERE wt.workspace_id = w.workspace_id
+    ) AS tags,
+    r.status AS latest_run_status,
+    (ul.*)::"users" AS user_lock,
+    (rl.*)::"runs" AS run_lock,
+    (rc.*)::"repo_connections" AS workspace_connection
+FROM workspaces w
+LEFT JOIN users ul ON w.lock_username = ul.username
+LEFT JOIN runs rl ON w.lock_run_id = rl.run_id
+LEFT JOIN runs r ON w.latest_run_id = r.run_id
+LEFT JOIN repo_connections rc ON w.workspace_id = rc.workspace_id
+WHERE (w.auto_destroy_at IS NOT NULL OR w.auto_destroy_activity_duration IS NOT NULL)
+AND   w.execution_mode <> 'local'
+;
+
+-- name: UpdateWorkspaceAutoDestroyRun :exec
+UPDATE workspaces
+SET auto_destroy_run_id = pggen.arg('run_id')
+WHERE workspace_id = pggen.arg('workspace_id');
+
 -- name: UpdateWorkspaceLockByID :exec
 UPDATE workspaces
 SET
@@ -3,9 +3,12 @@ package state
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/blob"
+	"github.com/tofutf/tofutf/internal/encryption"
 	"github.com/tofutf/tofutf/internal/resource"
 	"github.com/tofutf/tofutf/internal/sql"
 	"github.com/tofutf/tofutf/internal/sql/pggen"
@@ -15,6 +18,9 @@ type (
 	// pgdb is a state/state-version database on postgres
 	pgdb struct {
 		*sql.Pool // provides access to generated SQL queries
+
+		blobs   blob.Store          // optional store for state files
+		keyring *encryption.Keyring // encrypts state files and sensitive outputs
 	}
 
 	// pgRow is a row from a postgres query for a state version.
@@ -45,13 +51,55 @@ func (row pgRow) toVersion() *Version {
 	return &sv
 }
 
+// toVersion converts a row into a state version, retrieving its state file
+// from the blob store if it is not persisted in the database.
+func (db *pgdb) toVersion(ctx context.Context, row pgRow) (*Version, error) {
+	sv := row.toVersion()
+	if sv.Status == Pending {
+		// state is yet to be uploaded
+		if err := db.decryptOutputs(sv); err != nil {
+			return nil, err
+		}
+		return sv, nil
+	}
+	state, err := blob.Load(ctx, db.blobs, blob.StateKey(sv.ID), sv.State)
+	if err != nil {
+		return nil, err
+	}
+	sv.State, err = db.keyring.Decrypt(state)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting state: %w", err)
+	}
+	if err := db.decryptOutputs(sv); err != nil {
+		return nil, err
+	}
+	return sv, nil
+}
+
+func (db *pgdb) decryptOutputs(sv *Version) error {
+	for _, out := range sv.Outputs {
+		if err := db.decryptOutput(out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (db *pgdb) createVersion(ctx context.Context, v *Version) error {
 	return db.Tx(ctx, func(ctx context.Context, q pggen.Querier) error {
-		_, err := q.InsertStateVersion(ctx, pggen.InsertStateVersionParams{
+		state, err := db.keyring.Encrypt(v.State)
+		if err != nil {
+			return fmt.Errorf("encrypting state: %w", err)
+		}
+		state, err = blob.Offload(ctx, db.blobs, blob.StateKey(v.ID), state)
+		if err != nil {
+			return err
+		}
+		_, err = q.InsertStateVersion(ctx, pggen.InsertStateVersionParams{
 			ID:          sql.String(v.ID),
 			CreatedAt:   sql.Timestamptz(v.CreatedAt),
 			Serial:      sql.Int4(int(v.Serial)),
-			State:       v.State,
+			State:       state,
 			Status:      sql.String(string(v.Status)),
 			WorkspaceID: sql.String(v.WorkspaceID),
 		})
@@ -60,12 +108,16 @@ func (db *pgdb) createVersion(ctx context.Context, v *Version) error {
 		}
 
 		for _, svo := range v.Outputs {
-			_, err := q.InsertStateVersionOutput(ctx, pggen.InsertStateVersionOutputParams{
+			value, err := db.encryptOutput(svo)
+			if err != nil {
+				return err
+			}
+			_, err = q.InsertStateVersionOutput(ctx, pggen.InsertStateVersionOutputParams{
 				ID:             sql.String(svo.ID),
 				Name:           sql.String(svo.Name),
 				Sensitive:      sql.Bool(svo.Sensitive),
 				Type:           sql.String(svo.Type),
-				Value:          svo.Value,
+				Value:          value,
 				StateVersionID: sql.String(v.ID),
 			})
 			if err != nil {
@@ -79,12 +131,16 @@ func (db *pgdb) createVersion(ctx context.Context, v *Version) error {
 func (db *pgdb) createOutputs(ctx context.Context, outputs []*Output) error {
 	return db.Tx(ctx, func(ctx context.Context, q pggen.Querier) error {
 		for _, svo := range outputs {
-			_, err := q.InsertStateVersionOutput(ctx, pggen.InsertStateVersionOutputParams{
+			value, err := db.encryptOutput(svo)
+			if err != nil {
+				return err
+			}
+			_, err = q.InsertStateVersionOutput(ctx, pggen.InsertStateVersionOutputParams{
 				ID:             sql.String(svo.ID),
 				Name:           sql.String(svo.Name),
 				Sensitive:      sql.Bool(svo.Sensitive),
 				Type:           sql.String(svo.Type),
-				Value:          svo.Value,
+				Value:          value,
 				StateVersionID: sql.String(svo.StateVersionID),
 			})
 			if err != nil {
@@ -97,7 +153,15 @@ func (db *pgdb) createOutputs(ctx context.Context, outputs []*Output) error {
 
 func (db *pgdb) uploadStateAndFinalize(ctx context.Context, svID string, state []byte) error {
 	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
-		_, err := q.UpdateState(ctx, state, sql.String(svID))
+		state, err := db.keyring.Encrypt(state)
+		if err != nil {
+			return fmt.Errorf("encrypting state: %w", err)
+		}
+		state, err = blob.Offload(ctx, db.blobs, blob.StateKey(svID), state)
+		if err != nil {
+			return err
+		}
+		_, err = q.UpdateState(ctx, state, sql.String(svID))
 		return sql.Error(err)
 	})
 }
@@ -117,9 +181,14 @@ func (db *pgdb) listVersions(ctx context.Context, workspaceID string, opts resou
 			return nil, err
 		}
 
+		// state files are not retrieved when listing state versions, leaving
+		// each version's state empty.
 		items := make([]*Version, len(rows))
 		for i, r := range rows {
 			items[i] = pgRow(r).toVersion()
+			if err := db.decryptOutputs(items[i]); err != nil {
+				return nil, err
+			}
 		}
 
 		return resource.NewPage(items, opts, internal.Int64(count.Int64)), nil
@@ -133,7 +202,7 @@ func (db *pgdb) getVersion(ctx context.Context, svID string) (*Version, error) {
 			return nil, sql.Error(err)
 		}
 
-		return pgRow(result).toVersion(), nil
+		return db.toVersion(ctx, pgRow(result))
 	})
 }
 
@@ -144,7 +213,7 @@ func (db *pgdb) getVersionForUpdate(ctx context.Context, svID string) (*Version,
 			return nil, sql.Error(err)
 		}
 
-		return pgRow(result).toVersion(), nil
+		return db.toVersion(ctx, pgRow(result))
 	})
 }
 
@@ -155,13 +224,21 @@ func (db *pgdb) getCurrentVersion(ctx context.Context, workspaceID string) (*Ver
 			return nil, sql.Error(err)
 		}
 
-		return pgRow(result).toVersion(), nil
+		return db.toVersion(ctx, pgRow(result))
 	})
 }
 
 func (db *pgdb) getState(ctx context.Context, id string) ([]byte, error) {
 	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]byte, error) {
-		return q.FindStateVersionStateByID(ctx, sql.String(id))
+		state, err := q.FindStateVersionStateByID(ctx, sql.String(id))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		state, err = blob.Load(ctx, db.blobs, blob.StateKey(id), state)
+		if err != nil {
+			return nil, err
+		}
+		return db.keyring.Decrypt(state)
 	})
 }
 
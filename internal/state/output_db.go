@@ -2,6 +2,7 @@ package state
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/tofutf/tofutf/internal/sql"
@@ -37,6 +38,34 @@ func (db *pgdb) getOutput(ctx context.Context, outputID string) (*Output, error)
 			return nil, sql.Error(err)
 		}
 
-		return outputRow(result).toOutput(), nil
+		out := outputRow(result).toOutput()
+		if err := db.decryptOutput(out); err != nil {
+			return nil, err
+		}
+		return out, nil
 	})
 }
+
+// encryptOutput returns the value of the output to persist, which is
+// encrypted if the output is sensitive.
+func (db *pgdb) encryptOutput(out *Output) ([]byte, error) {
+	if !out.Sensitive {
+		return out.Value, nil
+	}
+	value, err := db.keyring.Encrypt(out.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encrypting output %s: %w", out.Name, err)
+	}
+	return value, nil
+}
+
+// decryptOutput decrypts the value of an output that was encrypted when
+// persisted.
+func (db *pgdb) decryptOutput(out *Output) error {
+	value, err := db.keyring.Decrypt(out.Value)
+	if err != nil {
+		return fmt.Errorf("decrypting output %s: %w", out.Name, err)
+	}
+	out.Value = value
+	return nil
+}
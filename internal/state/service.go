@@ -9,6 +9,8 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/leg100/surl"
 	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/blob"
+	"github.com/tofutf/tofutf/internal/encryption"
 	"github.com/tofutf/tofutf/internal/http/html"
 	"github.com/tofutf/tofutf/internal/rbac"
 	"github.com/tofutf/tofutf/internal/resource"
@@ -46,6 +48,8 @@ type (
 		*surl.Signer
 
 		WorkspaceService *workspace.Service
+		BlobStore        blob.Store
+		Keyring          *encryption.Keyring
 	}
 
 	// StateVersionListOptions represents the options for listing state versions.
@@ -57,7 +61,7 @@ type (
 )
 
 func NewService(opts Options) *Service {
-	db := &pgdb{opts.Pool}
+	db := &pgdb{opts.Pool, opts.BlobStore, opts.Keyring}
 	svc := Service{
 		logger:    opts.Logger,
 		cache:     opts.Cache,
@@ -283,5 +287,6 @@ func (a *Service) CanAccess(ctx context.Context, action rbac.Action, svID string
 		return nil, err
 	}
 
+	ctx = internal.AddAuditResourceToContext(ctx, svID)
 	return a.workspace.CanAccess(ctx, action, sv.WorkspaceID)
 }
@@ -11,6 +11,8 @@ import (
 // authorizer authorizes access to a team
 type authorizer struct {
 	Logger *slog.Logger
+
+	db *pgdb
 }
 
 func (a *authorizer) CanAccess(ctx context.Context, action rbac.Action, teamID string) (internal.Subject, error) {
@@ -22,6 +24,14 @@ func (a *authorizer) CanAccess(ctx context.Context, action rbac.Action, teamID s
 		return subj, nil
 	}
 	if subj.CanAccessTeam(action, teamID) {
+		// only look up the team's organization if the action is to be audited
+		if action.IsMutation() {
+			team, err := a.db.getTeamByID(ctx, teamID)
+			if err != nil {
+				return nil, err
+			}
+			internal.RecordAuthorized(ctx, subj, action, teamID, team.Organization)
+		}
 		return subj, nil
 	}
 	a.Logger.Error("unauthorized action", "team_id", teamID, "action", action.String(), "subject", subj)
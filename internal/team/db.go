@@ -3,11 +3,13 @@ package team
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/sql"
 	"github.com/tofutf/tofutf/internal/sql/pggen"
+	"github.com/tofutf/tofutf/internal/tokens"
 )
 
 // TeamRow represents the result of a database query for a team.
@@ -175,6 +177,69 @@ func (db *pgdb) deleteTeam(ctx context.Context, teamID string) error {
 // Team tokens
 //
 
+// teamTokenRow is the row result of a database query for team tokens
+type teamTokenRow struct {
+	TeamTokenID pgtype.Text        `json:"team_token_id"`
+	Description pgtype.Text        `json:"description"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	TeamID      pgtype.Text        `json:"team_id"`
+	Expiry      pgtype.Timestamptz `json:"expiry"`
+	Scope       pgtype.Text        `json:"scope"`
+	LastUsedAt  pgtype.Timestamptz `json:"last_used_at"`
+}
+
+func (result teamTokenRow) toToken() *Token {
+	tt := &Token{
+		ID:        result.TeamTokenID.String,
+		CreatedAt: result.CreatedAt.Time.UTC(),
+		TeamID:    result.TeamID.String,
+		Scope:     tokens.Scope(result.Scope.String),
+	}
+	if result.Expiry.Valid {
+		tt.Expiry = internal.Time(result.Expiry.Time.UTC())
+	}
+	if result.LastUsedAt.Valid {
+		tt.LastUsedAt = internal.Time(result.LastUsedAt.Time.UTC())
+	}
+	return tt
+}
+
+// staleTeamTokenRow is the row result of a database query for stale team
+// tokens, including the name of the team and its organization.
+type staleTeamTokenRow struct {
+	TeamTokenID      pgtype.Text        `json:"team_token_id"`
+	Description      pgtype.Text        `json:"description"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	TeamID           pgtype.Text        `json:"team_id"`
+	Expiry           pgtype.Timestamptz `json:"expiry"`
+	Scope            pgtype.Text        `json:"scope"`
+	LastUsedAt       pgtype.Timestamptz `json:"last_used_at"`
+	TeamName         pgtype.Text        `json:"team_name"`
+	OrganizationName pgtype.Text        `json:"organization_name"`
+}
+
+func (result staleTeamTokenRow) toStaleToken() *tokens.StaleToken {
+	tt := teamTokenRow{
+		TeamTokenID: result.TeamTokenID,
+		Description: result.Description,
+		CreatedAt:   result.CreatedAt,
+		TeamID:      result.TeamID,
+		Expiry:      result.Expiry,
+		Scope:       result.Scope,
+		LastUsedAt:  result.LastUsedAt,
+	}.toToken()
+	return &tokens.StaleToken{
+		ID:          tt.ID,
+		Kind:        TeamTokenKind,
+		Owner:       result.OrganizationName.String + "/" + result.TeamName.String,
+		Description: result.Description.String,
+		Scope:       tt.Scope,
+		CreatedAt:   tt.CreatedAt,
+		Expiry:      tt.Expiry,
+		LastUsedAt:  tt.LastUsedAt,
+	}
+}
+
 func (db *pgdb) createTeamToken(ctx context.Context, token *Token) error {
 	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
 		_, err := q.InsertTeamToken(ctx, pggen.InsertTeamTokenParams{
@@ -182,6 +247,7 @@ func (db *pgdb) createTeamToken(ctx context.Context, token *Token) error {
 			TeamID:      sql.String(token.TeamID),
 			CreatedAt:   sql.Timestamptz(token.CreatedAt),
 			Expiry:      sql.TimestamptzPtr(token.Expiry),
+			Scope:       sql.String(string(token.Scope)),
 		})
 
 		return err
@@ -199,16 +265,48 @@ func (db *pgdb) getTeamTokenByTeamID(ctx context.Context, teamID string) (*Token
 		if len(result) == 0 {
 			return nil, nil
 		}
-		ot := &Token{
-			ID:        result[0].TeamTokenID.String,
-			CreatedAt: result[0].CreatedAt.Time.UTC(),
-			TeamID:    result[0].TeamID.String,
+		return teamTokenRow(result[0]).toToken(), nil
+	})
+}
+
+func (db *pgdb) updateTeamTokenLastUsedAt(ctx context.Context, tokenID string, lastUsedAt time.Time) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.UpdateTeamTokenLastUsedAt(ctx, sql.Timestamptz(lastUsedAt), sql.String(tokenID))
+		if err != nil {
+			return sql.Error(err)
 		}
-		if result[0].Expiry.Valid {
-			ot.Expiry = internal.Time(result[0].Expiry.Time.UTC())
+		return nil
+	})
+}
+
+// findStaleTeamTokens finds team tokens that have expired or have not been
+// used since the cutoff, deleting them too if revoke is true.
+func (db *pgdb) findStaleTeamTokens(ctx context.Context, cutoff time.Time, revoke bool) ([]*tokens.StaleToken, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]*tokens.StaleToken, error) {
+		var rows []staleTeamTokenRow
+		if revoke {
+			result, err := q.DeleteStaleTeamTokens(ctx, sql.Timestamptz(cutoff))
+			if err != nil {
+				return nil, sql.Error(err)
+			}
+			for _, r := range result {
+				rows = append(rows, staleTeamTokenRow(r))
+			}
+		} else {
+			result, err := q.FindStaleTeamTokens(ctx, sql.Timestamptz(cutoff))
+			if err != nil {
+				return nil, sql.Error(err)
+			}
+			for _, r := range result {
+				rows = append(rows, staleTeamTokenRow(r))
+			}
 		}
 
-		return ot, nil
+		stale := make([]*tokens.StaleToken, len(rows))
+		for i, r := range rows {
+			stale[i] = r.toStaleToken()
+		}
+		return stale, nil
 	})
 }
 
@@ -50,12 +50,12 @@ func NewService(opts Options) *Service {
 	svc := Service{
 		logger:       opts.Logger,
 		organization: &organization.Authorizer{Logger: opts.Logger},
-		team:         &authorizer{Logger: opts.Logger},
 		db:           &pgdb{opts.Pool, opts.Logger},
 		teamTokenFactory: &teamTokenFactory{
 			tokens: opts.TokensService,
 		},
 	}
+	svc.team = &authorizer{Logger: opts.Logger, db: svc.db}
 	svc.web = &webHandlers{
 		Renderer: opts.Renderer,
 		tokens:   opts.TokensService,
@@ -91,6 +91,8 @@ func NewService(opts Options) *Service {
 		return svc.GetTeamByTokenID(ctx, tokenID)
 
 	})
+	opts.TokensService.RegisterUsageRecorder(TeamTokenKind, svc.db.updateTeamTokenLastUsedAt)
+	opts.TokensService.RegisterStaleTokenFinder(TeamTokenKind, svc.db.findStaleTeamTokens)
 
 	return &svc
 }
@@ -52,10 +52,10 @@ type (
 		ManageWorkspaces bool // admin access on all workspaces
 		ManageVCS        bool // manage VCS providers
 		ManageModules    bool // manage module registry
+		ManageProviders  bool // manage provider registry
 
 		// TFE fields that OTF does not support but persists merely to pass the
 		// go-tfe integration tests
-		ManageProviders       bool
 		ManagePolicies        bool
 		ManagePolicyOverrides bool
 	}
@@ -66,10 +66,10 @@ type (
 		ManageWorkspaces *bool `schema:"manage_workspaces"`
 		ManageVCS        *bool `schema:"manage_vcs"`
 		ManageModules    *bool `schema:"manage_modules"`
+		ManageProviders  *bool `schema:"manage_providers"`
 
 		// TFE fields that OTF does not support but persists merely to pass the
 		// go-tfe integration tests
-		ManageProviders       *bool
 		ManagePolicies        *bool
 		ManagePolicyOverrides *bool
 	}
@@ -160,6 +160,11 @@ func (t *Team) CanAccessOrganization(action rbac.Action, org string) bool {
 				return true
 			}
 		}
+		if t.Access.ManageProviders {
+			if rbac.ProviderManagerRole.IsAllowed(action) {
+				return true
+			}
+		}
 	}
 	return false
 }
@@ -169,13 +174,18 @@ func (t *Team) CanAccessWorkspace(action rbac.Action, policy internal.WorkspaceP
 	if t.CanAccessOrganization(action, policy.Organization) {
 		return true
 	}
-	// fallback to checking finer-grained workspace perms
+	// fallback to checking finer-grained workspace and project perms
 	if t.Organization != policy.Organization {
 		return false
 	}
 	for _, perm := range policy.Permissions {
-		if t.ID == perm.TeamID {
-			return perm.Role.IsAllowed(action)
+		if t.ID == perm.TeamID && perm.Role.IsAllowed(action) {
+			return true
+		}
+	}
+	for _, perm := range policy.ProjectPermissions {
+		if t.ID == perm.TeamID && perm.Role.IsAllowed(action) {
+			return true
 		}
 	}
 	return false
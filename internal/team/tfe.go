@@ -250,6 +250,9 @@ func (a *tfe) getTeamToken(w http.ResponseWriter, r *http.Request) {
 		CreatedAt: ot.CreatedAt,
 		ExpiredAt: ot.Expiry,
 	}
+	if ot.LastUsedAt != nil {
+		to.LastUsedAt = *ot.LastUsedAt
+	}
 	a.Respond(w, r, to, http.StatusOK)
 }
 
@@ -23,6 +23,10 @@ type (
 		TeamID string
 		// Optional expiry.
 		Expiry *time.Time
+		Scope  tokens.Scope
+		// When the token was last used to authenticate a request. Nil if it
+		// has never been used.
+		LastUsedAt *time.Time
 	}
 
 	// CreateTokenOptions are options for creating an team token via the service
@@ -30,6 +34,8 @@ type (
 	CreateTokenOptions struct {
 		TeamID string
 		Expiry *time.Time
+		// Optional scope. Defaults to read-write.
+		Scope tokens.Scope
 	}
 
 	teamTokenFactory struct {
@@ -38,16 +44,22 @@ type (
 )
 
 func (f *teamTokenFactory) NewTeamToken(opts CreateTokenOptions) (*Token, []byte, error) {
+	scope, err := tokens.ResolveScope(opts.Scope)
+	if err != nil {
+		return nil, nil, err
+	}
 	tt := Token{
 		ID:        internal.NewID("tt"),
 		CreatedAt: internal.CurrentTimestamp(nil),
 		TeamID:    opts.TeamID,
 		Expiry:    opts.Expiry,
+		Scope:     scope,
 	}
 	token, err := f.tokens.NewToken(tokens.NewTokenOptions{
 		Subject: tt.ID,
 		Kind:    TeamTokenKind,
 		Expiry:  opts.Expiry,
+		Scope:   scope,
 	})
 	if err != nil {
 		return nil, nil, err
@@ -21,6 +21,10 @@ const (
 	// ModuleV1Prefix is the URL path prefix for module registry endpoints
 	ModuleV1Prefix = "/v1/modules/"
 
+	// RegistryModuleV1Prefix is the URL path prefix for TFE private module
+	// registry endpoints
+	RegistryModuleV1Prefix = "/api/registry/v1/modules/"
+
 	// ProviderV1Prefix is the URL path prefix for provider registry endpoints
 	ProviderV1Prefix = "/v1/providers/"
 )
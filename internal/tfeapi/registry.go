@@ -0,0 +1,25 @@
+package tfeapi
+
+import "errors"
+
+// PrivateRegistry is the name of the only registry supported for modules and
+// providers, the organization's private registry. The namespace of a module or
+// provider in the private registry is the name of its organization.
+const PrivateRegistry = "private"
+
+var (
+	ErrInvalidRegistryName = errors.New("only the private registry is supported")
+	ErrInvalidNamespace    = errors.New("namespace must be the name of the organization")
+)
+
+// CheckRegistry checks that a module or provider belongs to the private
+// registry of the organization.
+func CheckRegistry(organization, registryName, namespace string) error {
+	if registryName != PrivateRegistry {
+		return ErrInvalidRegistryName
+	}
+	if namespace != organization {
+		return ErrInvalidNamespace
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package tfeapi
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/DataDog/jsonapi"
@@ -43,7 +44,51 @@ func (res *Responder) Respond(w http.ResponseWriter, r *http.Request, payload an
 		Error(w, err)
 		return
 	}
+	res.write(w, b, status)
+}
+
+// RespondWithLinks responds with a single resource, adding the given links to
+// the resource object. Use this for links other than those supported by
+// jsonapi, i.e. self and related, such as the upload links TFE includes in
+// certain responses.
+func (res *Responder) RespondWithLinks(w http.ResponseWriter, r *http.Request, payload any, status int, links map[string]string) {
+	b, err := jsonapi.Marshal(payload)
+	if err != nil {
+		Error(w, err)
+		return
+	}
+	b, err = addLinks(b, links)
+	if err != nil {
+		Error(w, err)
+		return
+	}
+	res.write(w, b, status)
+}
+
+func (res *Responder) write(w http.ResponseWriter, b []byte, status int) {
 	w.Header().Set("Content-type", mediaType)
 	w.WriteHeader(status)
 	w.Write(b) //nolint:errcheck
 }
+
+// addLinks adds links to the resource object in a marshaled document.
+func addLinks(doc []byte, links map[string]string) ([]byte, error) {
+	var (
+		top  map[string]json.RawMessage
+		data map[string]json.RawMessage
+		err  error
+	)
+	if err := json.Unmarshal(doc, &top); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(top["data"], &data); err != nil {
+		return nil, err
+	}
+	if data["links"], err = json.Marshal(links); err != nil {
+		return nil, err
+	}
+	if top["data"], err = json.Marshal(data); err != nil {
+		return nil, err
+	}
+	return json.Marshal(top)
+}
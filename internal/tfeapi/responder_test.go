@@ -0,0 +1,37 @@
+package tfeapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponder_RespondWithLinks(t *testing.T) {
+	type widget struct {
+		ID   string `jsonapi:"primary,widgets"`
+		Name string `jsonapi:"attribute" json:"name"`
+	}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "/", nil)
+
+	NewResponder().RespondWithLinks(w, r, &widget{ID: "widget-123", Name: "foo"}, http.StatusCreated, map[string]string{
+		"upload": "https://tofutf.example.com/upload",
+	})
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var got struct {
+		Data struct {
+			ID         string            `json:"id"`
+			Attributes map[string]string `json:"attributes"`
+			Links      map[string]string `json:"links"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	assert.Equal(t, "widget-123", got.Data.ID)
+	assert.Equal(t, "foo", got.Data.Attributes["name"])
+	assert.Equal(t, "https://tofutf.example.com/upload", got.Data.Links["upload"])
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import "time"
+
+// PolicyScope represents the scope of a policy check.
+type PolicyScope string
+
+// List the available policy scopes.
+const (
+	PolicyScopeOrganization PolicyScope = "organization"
+	PolicyScopeWorkspace    PolicyScope = "workspace"
+)
+
+// PolicyStatus represents a policy check state.
+type PolicyStatus string
+
+// List all available policy check statuses.
+const (
+	PolicyCanceled    PolicyStatus = "canceled"
+	PolicyErrored     PolicyStatus = "errored"
+	PolicyHardFailed  PolicyStatus = "hard_failed"
+	PolicyOverridden  PolicyStatus = "overridden"
+	PolicyPasses      PolicyStatus = "passed"
+	PolicyPending     PolicyStatus = "pending"
+	PolicyQueued      PolicyStatus = "queued"
+	PolicySoftFailed  PolicyStatus = "soft_failed"
+	PolicyUnreachable PolicyStatus = "unreachable"
+)
+
+// PolicyCheck represents a Terraform Enterprise policy check.
+type PolicyCheck struct {
+	ID               string                  `jsonapi:"primary,policy-checks"`
+	Actions          *PolicyActions          `jsonapi:"attribute" json:"actions"`
+	Permissions      *PolicyPermissions      `jsonapi:"attribute" json:"permissions"`
+	Result           *PolicyResult           `jsonapi:"attribute" json:"result"`
+	Scope            PolicyScope             `jsonapi:"attribute" json:"scope"`
+	Status           PolicyStatus            `jsonapi:"attribute" json:"status"`
+	StatusTimestamps *PolicyStatusTimestamps `jsonapi:"attribute" json:"status-timestamps"`
+
+	// Relations
+	Run *Run `jsonapi:"relationship" json:"run"`
+}
+
+// PolicyActions represents the policy check actions.
+type PolicyActions struct {
+	IsOverridable bool `json:"is-overridable"`
+}
+
+// PolicyPermissions represents the policy check permissions.
+type PolicyPermissions struct {
+	CanOverride bool `json:"can-override"`
+}
+
+// PolicyResult represents the complete policy check result.
+type PolicyResult struct {
+	AdvisoryFailed int  `json:"advisory-failed"`
+	Duration       int  `json:"duration"`
+	HardFailed     int  `json:"hard-failed"`
+	Passed         int  `json:"passed"`
+	Result         bool `json:"result"`
+	SoftFailed     int  `json:"soft-failed"`
+	TotalFailed    int  `json:"total-failed"`
+}
+
+// PolicyStatusTimestamps holds the timestamps for individual policy check
+// statuses.
+type PolicyStatusTimestamps struct {
+	ErroredAt    *time.Time `json:"errored-at,omitempty"`
+	HardFailedAt *time.Time `json:"hard-failed-at,omitempty"`
+	PassedAt     *time.Time `json:"passed-at,omitempty"`
+	QueuedAt     *time.Time `json:"queued-at,omitempty"`
+	SoftFailedAt *time.Time `json:"soft-failed-at,omitempty"`
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+// Project represents a project, which groups workspaces within an
+// organization.
+type Project struct {
+	ID          string `jsonapi:"primary,projects"`
+	Name        string `jsonapi:"attribute" json:"name"`
+	Description string `jsonapi:"attribute" json:"description"`
+
+	// Relations
+	Organization *Organization `jsonapi:"relationship" json:"organization"`
+}
+
+// ProjectListOptions represents the options for listing projects.
+type ProjectListOptions struct {
+	ListOptions
+
+	// Optional: A query string to search projects by names.
+	Query string `schema:"q,omitempty"`
+}
+
+// ProjectCreateOptions represents the options for creating a project.
+type ProjectCreateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,projects"`
+
+	// Required: A name to identify the project.
+	Name *string `jsonapi:"attribute" json:"name"`
+
+	// Optional: A description for the project.
+	Description *string `jsonapi:"attribute" json:"description,omitempty"`
+}
+
+// ProjectUpdateOptions represents the options for updating a project.
+type ProjectUpdateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,projects"`
+
+	// Optional: A name to identify the project.
+	Name *string `jsonapi:"attribute" json:"name,omitempty"`
+
+	// Optional: A description for the project.
+	Description *string `jsonapi:"attribute" json:"description,omitempty"`
+}
+
+// TeamProjectAccessType represents a team's level of access to a project.
+type TeamProjectAccessType string
+
+const (
+	TeamProjectAccessAdmin    TeamProjectAccessType = "admin"
+	TeamProjectAccessMaintain TeamProjectAccessType = "maintain"
+	TeamProjectAccessWrite    TeamProjectAccessType = "write"
+	TeamProjectAccessRead     TeamProjectAccessType = "read"
+)
+
+// TeamProjectAccess represents a team's access to a project.
+type TeamProjectAccess struct {
+	ID     string                `jsonapi:"primary,team-projects"`
+	Access TeamProjectAccessType `jsonapi:"attribute" json:"access"`
+
+	// Relations
+	Team    *Team    `jsonapi:"relationship" json:"team"`
+	Project *Project `jsonapi:"relationship" json:"project"`
+}
+
+// TeamProjectAccessListOptions represents the options for listing team
+// project accesses.
+type TeamProjectAccessListOptions struct {
+	ListOptions
+
+	// Required: The ID of the project.
+	ProjectID string `schema:"filter[project][id],required"`
+}
+
+// TeamProjectAccessAddOptions represents the options for granting a team
+// access to a project.
+type TeamProjectAccessAddOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,team-projects"`
+
+	// Required: The type of access to grant.
+	Access *TeamProjectAccessType `jsonapi:"attribute" json:"access"`
+
+	// Required: The team to grant access to.
+	Team *Team `jsonapi:"relationship" json:"team"`
+
+	// Required: The project to which access is granted.
+	Project *Project `jsonapi:"relationship" json:"project"`
+}
+
+// TeamProjectAccessUpdateOptions represents the options for updating a team's
+// access to a project.
+type TeamProjectAccessUpdateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,team-projects"`
+
+	// Required: The type of access to grant.
+	Access *TeamProjectAccessType `jsonapi:"attribute" json:"access"`
+}
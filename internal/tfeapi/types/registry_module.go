@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import "time"
+
+// RegistryModule represents a module in an organization's private registry.
+type RegistryModule struct {
+	ID              string                          `jsonapi:"primary,registry-modules"`
+	Name            string                          `jsonapi:"attribute" json:"name"`
+	Provider        string                          `jsonapi:"attribute" json:"provider"`
+	RegistryName    string                          `jsonapi:"attribute" json:"registry-name"`
+	Namespace       string                          `jsonapi:"attribute" json:"namespace"`
+	Status          string                          `jsonapi:"attribute" json:"status"`
+	VersionStatuses []RegistryModuleVersionStatuses `jsonapi:"attribute" json:"version-statuses"`
+	CreatedAt       time.Time                       `jsonapi:"attribute" json:"created-at"`
+	UpdatedAt       time.Time                       `jsonapi:"attribute" json:"updated-at"`
+
+	// Relations
+	Organization *Organization `jsonapi:"relationship" json:"organization"`
+}
+
+// RegistryModuleVersionStatuses reports the status of a version of a module.
+type RegistryModuleVersionStatuses struct {
+	Version string `json:"version"`
+	Status  string `json:"status"`
+	Error   string `json:"error"`
+}
+
+// RegistryModuleCreateOptions represents the options for creating a module in
+// a private registry without a VCS repository.
+type RegistryModuleCreateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,registry-modules"`
+
+	// Required: The name of the module.
+	Name *string `jsonapi:"attribute" json:"name"`
+
+	// Required: The name of the provider of the module.
+	Provider *string `jsonapi:"attribute" json:"provider"`
+
+	// Optional: Only the private registry is supported.
+	RegistryName string `jsonapi:"attribute" json:"registry-name,omitempty"`
+
+	// Optional: The namespace of the module, which for a private registry
+	// must be the name of the organization.
+	Namespace string `jsonapi:"attribute" json:"namespace,omitempty"`
+}
+
+// RegistryModuleVersion represents a version of a module in a private
+// registry.
+type RegistryModuleVersion struct {
+	ID        string    `jsonapi:"primary,registry-module-versions"`
+	Source    string    `jsonapi:"attribute" json:"source"`
+	Status    string    `jsonapi:"attribute" json:"status"`
+	Version   string    `jsonapi:"attribute" json:"version"`
+	CreatedAt time.Time `jsonapi:"attribute" json:"created-at"`
+	UpdatedAt time.Time `jsonapi:"attribute" json:"updated-at"`
+
+	// Relations
+	RegistryModule *RegistryModule `jsonapi:"relationship" json:"registry-module"`
+}
+
+// RegistryModuleCreateVersionOptions represents the options for creating a
+// version of a module in a private registry.
+type RegistryModuleCreateVersionOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,registry-module-versions"`
+
+	// Required: A semantic version.
+	Version *string `jsonapi:"attribute" json:"version"`
+
+	// Optional: The SHA of the commit from which the version is built.
+	CommitSHA *string `jsonapi:"attribute" json:"commit-sha,omitempty"`
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import "time"
+
+// RegistryProvider represents a provider in an organization's private
+// registry.
+type RegistryProvider struct {
+	ID           string    `jsonapi:"primary,registry-providers"`
+	Name         string    `jsonapi:"attribute" json:"name"`
+	Namespace    string    `jsonapi:"attribute" json:"namespace"`
+	RegistryName string    `jsonapi:"attribute" json:"registry-name"`
+	CreatedAt    time.Time `jsonapi:"attribute" json:"created-at"`
+	UpdatedAt    time.Time `jsonapi:"attribute" json:"updated-at"`
+
+	// Relations
+	Organization *Organization `jsonapi:"relationship" json:"organization"`
+}
+
+// RegistryProviderCreateOptions represents the options for creating a
+// provider in a private registry.
+type RegistryProviderCreateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,registry-providers"`
+
+	// Required: The name of the provider.
+	Name string `jsonapi:"attribute" json:"name"`
+
+	// Required: The namespace of the provider, which for a private registry
+	// must be the name of the organization.
+	Namespace string `jsonapi:"attribute" json:"namespace"`
+
+	// Required: Only the private registry is supported.
+	RegistryName string `jsonapi:"attribute" json:"registry-name"`
+}
+
+// RegistryProviderVersion represents a version of a provider in a private
+// registry.
+type RegistryProviderVersion struct {
+	ID                 string    `jsonapi:"primary,registry-provider-versions"`
+	Version            string    `jsonapi:"attribute" json:"version"`
+	KeyID              string    `jsonapi:"attribute" json:"key-id"`
+	Protocols          []string  `jsonapi:"attribute" json:"protocols"`
+	ShasumsUploaded    bool      `jsonapi:"attribute" json:"shasums-uploaded"`
+	ShasumsSigUploaded bool      `jsonapi:"attribute" json:"shasums-sig-uploaded"`
+	CreatedAt          time.Time `jsonapi:"attribute" json:"created-at"`
+	UpdatedAt          time.Time `jsonapi:"attribute" json:"updated-at"`
+
+	// Relations
+	RegistryProvider *RegistryProvider `jsonapi:"relationship" json:"registry-provider"`
+}
+
+// RegistryProviderVersionCreateOptions represents the options for creating
+// a version of a provider in a private registry.
+type RegistryProviderVersionCreateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,registry-provider-versions"`
+
+	// Required: A semantic version.
+	Version string `jsonapi:"attribute" json:"version"`
+
+	// Required: The ID of the GPG key with which the SHA256SUMS file is
+	// signed.
+	KeyID string `jsonapi:"attribute" json:"key-id"`
+
+	// Required: The plugin protocol versions the provider supports, e.g.
+	// 5.0.
+	Protocols []string `jsonapi:"attribute" json:"protocols"`
+}
+
+// RegistryProviderPlatform represents a platform for which a version of a
+// provider in a private registry is built.
+type RegistryProviderPlatform struct {
+	ID                     string `jsonapi:"primary,registry-provider-platforms"`
+	OS                     string `jsonapi:"attribute" json:"os"`
+	Arch                   string `jsonapi:"attribute" json:"arch"`
+	Filename               string `jsonapi:"attribute" json:"filename"`
+	Shasum                 string `jsonapi:"attribute" json:"shasum"`
+	ProviderBinaryUploaded bool   `jsonapi:"attribute" json:"provider-binary-uploaded"`
+
+	// Relations
+	RegistryProviderVersion *RegistryProviderVersion `jsonapi:"relationship" json:"registry-provider-version"`
+}
+
+// RegistryProviderPlatformCreateOptions represents the options for creating
+// a platform for a version of a provider in a private registry.
+type RegistryProviderPlatformCreateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,registry-provider-platforms"`
+
+	// Required: The operating system, e.g. linux.
+	OS string `jsonapi:"attribute" json:"os"`
+
+	// Required: The architecture, e.g. amd64.
+	Arch string `jsonapi:"attribute" json:"arch"`
+
+	// Required: The SHA256 checksum of the provider's zip archive.
+	Shasum string `jsonapi:"attribute" json:"shasum"`
+
+	// Required: The filename of the provider's zip archive.
+	Filename string `jsonapi:"attribute" json:"filename"`
+}
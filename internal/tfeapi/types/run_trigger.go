@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import "time"
+
+// RunTriggerFilterOp represents the direction of run triggers to list.
+type RunTriggerFilterOp string
+
+const (
+	// RunTriggerOutbound lists run triggers that trigger runs in other
+	// workspaces.
+	RunTriggerOutbound RunTriggerFilterOp = "outbound"
+	// RunTriggerInbound lists run triggers that trigger runs in the
+	// workspace.
+	RunTriggerInbound RunTriggerFilterOp = "inbound"
+)
+
+// RunTrigger represents a run trigger.
+type RunTrigger struct {
+	ID             string    `jsonapi:"primary,run-triggers"`
+	CreatedAt      time.Time `jsonapi:"attribute" json:"created-at"`
+	SourceableName string    `jsonapi:"attribute" json:"sourceable-name"`
+	WorkspaceName  string    `jsonapi:"attribute" json:"workspace-name"`
+
+	// Relations
+	Sourceable *Workspace `jsonapi:"relationship" json:"sourceable"`
+	Workspace  *Workspace `jsonapi:"relationship" json:"workspace"`
+}
+
+// RunTriggerListOptions represents the options for listing run triggers.
+type RunTriggerListOptions struct {
+	ListOptions
+
+	// Required: The type of run triggers to list.
+	RunTriggerType RunTriggerFilterOp `schema:"filter[run-trigger][type],required"`
+}
+
+// RunTriggerCreateOptions represents the options for creating a new run
+// trigger.
+type RunTriggerCreateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,run-triggers"`
+
+	// Required: The source workspace
+	Sourceable *Workspace `jsonapi:"relationship" json:"sourceable"`
+}
@@ -10,6 +10,7 @@ type Variable struct {
 	HCL         bool   `jsonapi:"attribute" json:"hcl"`
 	Sensitive   bool   `jsonapi:"attribute" json:"sensitive"`
 	VersionID   string `jsonapi:"attribute" json:"version-id"`
+	SecretRef   bool   `jsonapi:"attribute" json:"secret-ref"`
 }
 
 // VariableList is a list of workspace variables
@@ -43,6 +44,9 @@ type VariableCreateOptions struct {
 
 	// Whether the value is sensitive.
 	Sensitive *bool `jsonapi:"attribute" json:"sensitive,omitempty"`
+
+	// Whether the value is a reference to a secret held outside of tofutf.
+	SecretRef *bool `jsonapi:"attribute" json:"secret-ref,omitempty"`
 }
 
 // VariableUpdateOptions represents the options for updating a variable.
@@ -70,4 +74,7 @@ type VariableUpdateOptions struct {
 
 	// Whether the value is sensitive.
 	Sensitive *bool `jsonapi:"attribute" json:"sensitive,omitempty"`
+
+	// Whether the value is a reference to a secret held outside of tofutf.
+	SecretRef *bool `jsonapi:"attribute" json:"secret-ref,omitempty"`
 }
@@ -90,6 +90,9 @@ type VariableSetVariableCreateOptions struct {
 
 	// Whether the value is sensitive.
 	Sensitive *bool `jsonapi:"attribute" json:"sensitive,omitempty"`
+
+	// Whether the value is a reference to a secret held outside of tofutf.
+	SecretRef *bool `jsonapi:"attribute" json:"secret-ref,omitempty"`
 }
 
 // VariableSetVariableUpdateOptions represents the options for updating a variable.
@@ -114,4 +117,7 @@ type VariableSetVariableUpdateOptions struct {
 
 	// Whether the value is sensitive.
 	Sensitive *bool `jsonapi:"attribute" json:"sensitive,omitempty"`
+
+	// Whether the value is a reference to a secret held outside of tofutf.
+	SecretRef *bool `jsonapi:"attribute" json:"secret-ref,omitempty"`
 }
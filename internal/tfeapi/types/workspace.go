@@ -13,46 +13,53 @@ var (
 
 // Workspace represents a Terraform Enterprise workspace.
 type Workspace struct {
-	ID                         string                `jsonapi:"primary,workspaces"`
-	Actions                    *WorkspaceActions     `jsonapi:"attribute" json:"actions"`
-	AgentPoolID                string                `jsonapi:"attribute" json:"agent-pool-id"`
-	AllowDestroyPlan           bool                  `jsonapi:"attribute" json:"allow-destroy-plan"`
-	AutoApply                  bool                  `jsonapi:"attribute" json:"auto-apply"`
-	CanQueueDestroyPlan        bool                  `jsonapi:"attribute" json:"can-queue-destroy-plan"`
-	CreatedAt                  time.Time             `jsonapi:"attribute" json:"created-at"`
-	Description                string                `jsonapi:"attribute" json:"description"`
-	Environment                string                `jsonapi:"attribute" json:"environment"`
-	ExecutionMode              string                `jsonapi:"attribute" json:"execution-mode"`
-	FileTriggersEnabled        bool                  `jsonapi:"attribute" json:"file-triggers-enabled"`
-	GlobalRemoteState          bool                  `jsonapi:"attribute" json:"global-remote-state"`
-	Locked                     bool                  `jsonapi:"attribute" json:"locked"`
-	MigrationEnvironment       string                `jsonapi:"attribute" json:"migration-environment"`
-	Name                       string                `jsonapi:"attribute" json:"name"`
-	Operations                 bool                  `jsonapi:"attribute" json:"operations"`
-	Permissions                *WorkspacePermissions `jsonapi:"attribute" json:"permissions"`
-	QueueAllRuns               bool                  `jsonapi:"attribute" json:"queue-all-runs"`
-	SpeculativeEnabled         bool                  `jsonapi:"attribute" json:"speculative-enabled"`
-	SourceName                 string                `jsonapi:"attribute" json:"source-name"`
-	SourceURL                  string                `jsonapi:"attribute" json:"source-url"`
-	StructuredRunOutputEnabled bool                  `jsonapi:"attribute" json:"structured-run-output-enabled"`
-	TerraformVersion           string                `jsonapi:"attribute" json:"terraform-version"`
-	TriggerPrefixes            []string              `jsonapi:"attribute" json:"trigger-prefixes"`
-	TriggerPatterns            []string              `jsonapi:"attribute" json:"trigger-patterns"`
-	VCSRepo                    *VCSRepo              `jsonapi:"attribute" json:"vcs-repo"`
-	WorkingDirectory           string                `jsonapi:"attribute" json:"working-directory"`
-	UpdatedAt                  time.Time             `jsonapi:"attribute" json:"updated-at"`
-	ResourceCount              int                   `jsonapi:"attribute" json:"resource-count"`
-	ApplyDurationAverage       time.Duration         `jsonapi:"attribute" json:"apply-duration-average"`
-	PlanDurationAverage        time.Duration         `jsonapi:"attribute" json:"plan-duration-average"`
-	PolicyCheckFailures        int                   `jsonapi:"attribute" json:"policy-check-failures"`
-	RunFailures                int                   `jsonapi:"attribute" json:"run-failures"`
-	RunsCount                  int                   `jsonapi:"attribute" json:"workspace-kpis-runs-count"`
-	TagNames                   []string              `jsonapi:"attribute" json:"tag-names"`
+	ID                          string                `jsonapi:"primary,workspaces"`
+	Actions                     *WorkspaceActions     `jsonapi:"attribute" json:"actions"`
+	AgentPoolID                 string                `jsonapi:"attribute" json:"agent-pool-id"`
+	AllowDestroyPlan            bool                  `jsonapi:"attribute" json:"allow-destroy-plan"`
+	AssessmentsEnabled          bool                  `jsonapi:"attribute" json:"assessments-enabled"`
+	AutoApply                   bool                  `jsonapi:"attribute" json:"auto-apply"`
+	AutoDestroyAt               *time.Time            `jsonapi:"attribute" json:"auto-destroy-at"`
+	AutoDestroyActivityDuration *string               `jsonapi:"attribute" json:"auto-destroy-activity-duration"`
+	CanQueueDestroyPlan         bool                  `jsonapi:"attribute" json:"can-queue-destroy-plan"`
+	CreatedAt                   time.Time             `jsonapi:"attribute" json:"created-at"`
+	DeleteAfterAutoDestroy      bool                  `jsonapi:"attribute" json:"delete-after-auto-destroy"`
+	Description                 string                `jsonapi:"attribute" json:"description"`
+	Drifted                     bool                  `jsonapi:"attribute" json:"drifted"`
+	Engine                      string                `jsonapi:"attribute" json:"engine"`
+	Environment                 string                `jsonapi:"attribute" json:"environment"`
+	ExecutionMode               string                `jsonapi:"attribute" json:"execution-mode"`
+	FileTriggersEnabled         bool                  `jsonapi:"attribute" json:"file-triggers-enabled"`
+	GlobalRemoteState           bool                  `jsonapi:"attribute" json:"global-remote-state"`
+	Locked                      bool                  `jsonapi:"attribute" json:"locked"`
+	MigrationEnvironment        string                `jsonapi:"attribute" json:"migration-environment"`
+	Name                        string                `jsonapi:"attribute" json:"name"`
+	Operations                  bool                  `jsonapi:"attribute" json:"operations"`
+	Permissions                 *WorkspacePermissions `jsonapi:"attribute" json:"permissions"`
+	QueueAllRuns                bool                  `jsonapi:"attribute" json:"queue-all-runs"`
+	SpeculativeEnabled          bool                  `jsonapi:"attribute" json:"speculative-enabled"`
+	SourceName                  string                `jsonapi:"attribute" json:"source-name"`
+	SourceURL                   string                `jsonapi:"attribute" json:"source-url"`
+	StructuredRunOutputEnabled  bool                  `jsonapi:"attribute" json:"structured-run-output-enabled"`
+	TerraformVersion            string                `jsonapi:"attribute" json:"terraform-version"`
+	TriggerPrefixes             []string              `jsonapi:"attribute" json:"trigger-prefixes"`
+	TriggerPatterns             []string              `jsonapi:"attribute" json:"trigger-patterns"`
+	VCSRepo                     *VCSRepo              `jsonapi:"attribute" json:"vcs-repo"`
+	WorkingDirectory            string                `jsonapi:"attribute" json:"working-directory"`
+	UpdatedAt                   time.Time             `jsonapi:"attribute" json:"updated-at"`
+	ResourceCount               int                   `jsonapi:"attribute" json:"resource-count"`
+	ApplyDurationAverage        time.Duration         `jsonapi:"attribute" json:"apply-duration-average"`
+	PlanDurationAverage         time.Duration         `jsonapi:"attribute" json:"plan-duration-average"`
+	PolicyCheckFailures         int                   `jsonapi:"attribute" json:"policy-check-failures"`
+	RunFailures                 int                   `jsonapi:"attribute" json:"run-failures"`
+	RunsCount                   int                   `jsonapi:"attribute" json:"workspace-kpis-runs-count"`
+	TagNames                    []string              `jsonapi:"attribute" json:"tag-names"`
 
 	// Relations
 	CurrentRun   *Run               `jsonapi:"relationship" json:"current-run"`
 	Organization *Organization      `jsonapi:"relationship" json:"organization"`
 	Outputs      []*WorkspaceOutput `jsonapi:"relationship" json:"outputs"`
+	Project      *Project           `jsonapi:"relationship" json:"project,omitempty"`
 }
 
 type WorkspaceOutput struct {
@@ -139,12 +146,31 @@ type WorkspaceCreateOptions struct {
 	// Whether destroy plans can be queued on the workspace.
 	AllowDestroyPlan *bool `jsonapi:"attribute" json:"allow-destroy-plan,omitempty"`
 
+	// Whether to periodically run health assessments to detect drift.
+	AssessmentsEnabled *bool `jsonapi:"attribute" json:"assessments-enabled,omitempty"`
+
 	// Whether to automatically apply changes when a Terraform plan is successful.
 	AutoApply *bool `jsonapi:"attribute" json:"auto-apply,omitempty"`
 
+	// The time at which the workspace's resources are automatically
+	// destroyed.
+	AutoDestroyAt *time.Time `jsonapi:"attribute" json:"auto-destroy-at,omitempty"`
+
+	// The period of inactivity after which the workspace's resources are
+	// automatically destroyed, e.g. 14d or 2h.
+	AutoDestroyActivityDuration *string `jsonapi:"attribute" json:"auto-destroy-activity-duration,omitempty"`
+
+	// Whether to delete the workspace once its resources have been
+	// successfully auto-destroyed. (Not supported by TFE).
+	DeleteAfterAutoDestroy *bool `jsonapi:"attribute" json:"delete-after-auto-destroy,omitempty"`
+
 	// A description for the workspace.
 	Description *string `jsonapi:"attribute" json:"description,omitempty"`
 
+	// Engine to execute runs: either terraform or tofu. (Not supported by
+	// TFE).
+	Engine *string `jsonapi:"attribute" json:"engine,omitempty"`
+
 	// Which execution mode to use. Valid values are remote, local, and agent.
 	// When set to local, the workspace will be used for state storage only.
 	// This value must not be specified if operations is specified.
@@ -227,6 +253,9 @@ type WorkspaceCreateOptions struct {
 	// A list of tags to attach to the workspace. If the tag does not already
 	// exist, it is created and added to the workspace.
 	Tags []*Tag `jsonapi:"relationship" json:"tags,omitempty"`
+
+	// Optional: The project to which the workspace belongs.
+	Project *Project `jsonapi:"relationship" json:"project,omitempty"`
 }
 
 // WorkspaceUpdateOptions represents the options for updating a workspace.
@@ -245,9 +274,21 @@ type WorkspaceUpdateOptions struct {
 	// Whether destroy plans can be queued on the workspace.
 	AllowDestroyPlan *bool `jsonapi:"attribute" json:"allow-destroy-plan,omitempty"`
 
+	// Whether to periodically run health assessments to detect drift.
+	AssessmentsEnabled *bool `jsonapi:"attribute" json:"assessments-enabled,omitempty"`
+
 	// Whether to automatically apply changes when a Terraform plan is successful.
 	AutoApply *bool `jsonapi:"attribute" json:"auto-apply,omitempty"`
 
+	// The time at which the workspace's resources are automatically
+	// destroyed. Specify null to remove the deadline.
+	AutoDestroyAt NullableTime `jsonapi:"attribute" json:"auto-destroy-at,omitempty"`
+
+	// The period of inactivity after which the workspace's resources are
+	// automatically destroyed, e.g. 14d or 2h. Specify null to stop
+	// destroying inactive resources.
+	AutoDestroyActivityDuration NullableString `jsonapi:"attribute" json:"auto-destroy-activity-duration,omitempty"`
+
 	// A new name for the workspace, which can only include letters, numbers, -,
 	// and _. This will be used as an identifier and must be unique in the
 	// organization. Warning: Changing a workspace's name changes its URL in the
@@ -257,6 +298,14 @@ type WorkspaceUpdateOptions struct {
 	// A description for the workspace.
 	Description *string `jsonapi:"attribute" json:"description,omitempty"`
 
+	// Whether to delete the workspace once its resources have been
+	// successfully auto-destroyed. (Not supported by TFE).
+	DeleteAfterAutoDestroy *bool `jsonapi:"attribute" json:"delete-after-auto-destroy,omitempty"`
+
+	// Engine to execute runs: either terraform or tofu. (Not supported by
+	// TFE).
+	Engine *string `jsonapi:"attribute" json:"engine,omitempty" schema:"engine"`
+
 	// Which execution mode to use. Valid values are remote, local, and agent.
 	// When set to local, the workspace will be used for state storage only.
 	// This value must not be specified if operations is specified.
@@ -314,6 +363,9 @@ type WorkspaceUpdateOptions struct {
 	// the environment when multiple environments exist within the same
 	// repository.
 	WorkingDirectory *string `jsonapi:"attribute" json:"working-directory,omitempty"`
+
+	// Optional: Move the workspace to this project.
+	Project *Project `jsonapi:"relationship" json:"project,omitempty"`
 }
 
 func (opts *WorkspaceUpdateOptions) Validate() error {
@@ -370,3 +422,55 @@ func (o *VCSRepoOptionsJSON) UnmarshalJSON(data []byte) error {
 	o.Valid = true
 	return nil
 }
+
+// NullableTime is a time attribute that differentiates between having been
+// explicitly set to null, and omitted.
+type NullableTime struct {
+	Time time.Time
+
+	Valid bool `json:"-"`
+	Set   bool `json:"-"`
+}
+
+// UnmarshalJSON differentiates between the time having been explicitly set
+// to null by the client, or the client has left it out.
+func (t *NullableTime) UnmarshalJSON(data []byte) error {
+	// If this method was called, the value was set.
+	t.Set = true
+
+	if string(data) == "null" {
+		t.Valid = false
+		return nil
+	}
+	if err := json.Unmarshal(data, &t.Time); err != nil {
+		return err
+	}
+	t.Valid = true
+	return nil
+}
+
+// NullableString is a string attribute that differentiates between having
+// been explicitly set to null, and omitted.
+type NullableString struct {
+	String string
+
+	Valid bool `json:"-"`
+	Set   bool `json:"-"`
+}
+
+// UnmarshalJSON differentiates between the string having been explicitly set
+// to null by the client, or the client has left it out.
+func (s *NullableString) UnmarshalJSON(data []byte) error {
+	// If this method was called, the value was set.
+	s.Set = true
+
+	if string(data) == "null" {
+		s.Valid = false
+		return nil
+	}
+	if err := json.Unmarshal(data, &s.String); err != nil {
+		return err
+	}
+	s.Valid = true
+	return nil
+}
@@ -0,0 +1,54 @@
+package tokens
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	otfapi "github.com/tofutf/tofutf/internal/api"
+	"github.com/tofutf/tofutf/internal/http/decode"
+	"github.com/tofutf/tofutf/internal/tfeapi"
+)
+
+type api struct {
+	*Service
+	*tfeapi.Responder
+}
+
+func (a *api) addHandlers(r *mux.Router) {
+	r = r.PathPrefix(otfapi.DefaultBasePath).Subrouter()
+
+	r.HandleFunc("/admin/stale-tokens", a.listStaleTokens).Methods("GET")
+	r.HandleFunc("/admin/stale-tokens", a.revokeStaleTokens).Methods("DELETE")
+}
+
+func (a *api) listStaleTokens(w http.ResponseWriter, r *http.Request) {
+	var opts StaleTokenOptions
+	if err := decode.Query(&opts, r.URL.Query()); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	stale, err := a.ListStaleTokens(r.Context(), opts)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	a.Respond(w, r, stale, http.StatusOK)
+}
+
+func (a *api) revokeStaleTokens(w http.ResponseWriter, r *http.Request) {
+	var opts StaleTokenOptions
+	if err := decode.Query(&opts, r.URL.Query()); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	revoked, err := a.RevokeStaleTokens(r.Context(), opts)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	a.Respond(w, r, revoked, http.StatusOK)
+}
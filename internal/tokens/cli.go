@@ -0,0 +1,96 @@
+package tokens
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	otfapi "github.com/tofutf/tofutf/internal/api"
+)
+
+type tokensCLI struct {
+	client cliClient
+}
+
+type cliClient interface {
+	ListStaleTokens(ctx context.Context, opts StaleTokenOptions) ([]*StaleToken, error)
+	RevokeStaleTokens(ctx context.Context, opts StaleTokenOptions) ([]*StaleToken, error)
+}
+
+func NewCommand(apiClient *otfapi.Client) *cobra.Command {
+	cli := &tokensCLI{}
+	cmd := &cobra.Command{
+		Use:   "tokens",
+		Short: "API token management",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Parent().PersistentPreRunE(cmd.Parent(), args); err != nil {
+				return err
+			}
+			cli.client = &Client{Client: apiClient}
+			return nil
+		},
+	}
+	cmd.AddCommand(cli.listStaleCommand())
+	cmd.AddCommand(cli.revokeStaleCommand())
+
+	return cmd
+}
+
+func (a *tokensCLI) listStaleCommand() *cobra.Command {
+	var opts StaleTokenOptions
+
+	cmd := &cobra.Command{
+		Use:           "list-stale",
+		Short:         "List user, team and organization tokens that have expired or have not been used recently",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stale, err := a.client.ListStaleTokens(cmd.Context(), opts)
+			if err != nil {
+				return err
+			}
+			printStaleTokens(cmd.OutOrStdout(), stale)
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.UnusedDays, "unused-days", DefaultUnusedDays, "Number of days a token must have gone unused to be stale")
+
+	return cmd
+}
+
+func (a *tokensCLI) revokeStaleCommand() *cobra.Command {
+	var opts StaleTokenOptions
+
+	cmd := &cobra.Command{
+		Use:           "revoke-stale",
+		Short:         "Revoke user, team and organization tokens that have expired or have not been used recently",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			revoked, err := a.client.RevokeStaleTokens(cmd.Context(), opts)
+			if err != nil {
+				return err
+			}
+			printStaleTokens(cmd.OutOrStdout(), revoked)
+			fmt.Fprintf(cmd.OutOrStdout(), "Successfully revoked %d stale tokens\n", len(revoked))
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.UnusedDays, "unused-days", DefaultUnusedDays, "Number of days a token must have gone unused to be stale")
+
+	return cmd
+}
+
+func printStaleTokens(w io.Writer, tokens []*StaleToken) {
+	for _, token := range tokens {
+		lastUsed := "never"
+		if token.LastUsedAt != nil {
+			lastUsed = token.LastUsedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", token.ID, token.Kind, token.Owner, lastUsed)
+	}
+}
@@ -0,0 +1,50 @@
+package tokens
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+)
+
+func TestListStaleTokensCommand(t *testing.T) {
+	lastUsed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	cli := &tokensCLI{
+		client: &fakeStaleTokenService{
+			stale: []*StaleToken{
+				{ID: "ut-1", Kind: "user_token", Owner: "bobby"},
+				{ID: "ot-1", Kind: "organization_token", Owner: "acme-corp", LastUsedAt: &lastUsed},
+			},
+		},
+	}
+	cmd := cli.listStaleCommand()
+
+	got := bytes.Buffer{}
+	cmd.SetOut(&got)
+	require.NoError(t, cmd.Execute())
+
+	want := "ut-1\tuser_token\tbobby\tnever\not-1\torganization_token\tacme-corp\t2024-01-02T03:04:05Z\n"
+	assert.Equal(t, want, got.String())
+}
+
+func TestRevokeStaleTokensCommand(t *testing.T) {
+	cli := &tokensCLI{
+		client: &fakeStaleTokenService{
+			stale: []*StaleToken{
+				{ID: "tt-1", Kind: "team_token", Owner: "acme-corp/owners", LastUsedAt: internal.Time(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))},
+			},
+		},
+	}
+	cmd := cli.revokeStaleCommand()
+
+	cmd.SetArgs([]string{"--unused-days", "30"})
+	got := bytes.Buffer{}
+	cmd.SetOut(&got)
+	require.NoError(t, cmd.Execute())
+
+	want := "tt-1\tteam_token\tacme-corp/owners\t2024-01-02T03:04:05Z\nSuccessfully revoked 1 stale tokens\n"
+	assert.Equal(t, want, got.String())
+}
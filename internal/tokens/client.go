@@ -0,0 +1,39 @@
+package tokens
+
+import (
+	"context"
+	"fmt"
+
+	otfapi "github.com/tofutf/tofutf/internal/api"
+)
+
+type Client struct {
+	*otfapi.Client
+}
+
+// ListStaleTokens lists stale tokens via HTTP/JSONAPI.
+func (c *Client) ListStaleTokens(ctx context.Context, opts StaleTokenOptions) ([]*StaleToken, error) {
+	req, err := c.NewRequest("GET", "admin/stale-tokens", &opts)
+	if err != nil {
+		return nil, err
+	}
+	var stale []*StaleToken
+	if err := c.Do(ctx, req, &stale); err != nil {
+		return nil, err
+	}
+	return stale, nil
+}
+
+// RevokeStaleTokens revokes stale tokens via HTTP/JSONAPI.
+func (c *Client) RevokeStaleTokens(ctx context.Context, opts StaleTokenOptions) ([]*StaleToken, error) {
+	u := fmt.Sprintf("admin/stale-tokens?unused_days=%d", opts.UnusedDays)
+	req, err := c.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	var revoked []*StaleToken
+	if err := c.Do(ctx, req, &revoked); err != nil {
+		return nil, err
+	}
+	return revoked, nil
+}
@@ -0,0 +1,211 @@
+package tokens
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/json"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	OIDCDiscoveryPath = "/.well-known/openid-configuration"
+	JWKSPath          = "/.well-known/jwks.json"
+
+	// info parameter for deriving the workload identity signing key from the
+	// secret, ensuring it differs from any other key derived from the secret.
+	identityKeyInfo = "tofutf workload identity signing key"
+)
+
+type (
+	// identityProvider is an OIDC identity provider that issues workload
+	// identity tokens, which third parties such as cloud providers can be
+	// configured to trust.
+	//
+	// Tokens are signed with an asymmetric key derived from the secret, and
+	// the public key is published for third parties to verify tokens. Every
+	// node in a cluster shares the secret and therefore signs tokens with the
+	// same key.
+	identityProvider struct {
+		hostnames *internal.HostnameService
+
+		key       jwk.Key
+		publicKey jwk.Key
+
+		// custom claims included in tokens, in addition to the registered
+		// claims
+		mu     sync.Mutex
+		claims []string
+	}
+
+	// WorkloadIdentityTokenOptions are options for constructing a workload
+	// identity token.
+	WorkloadIdentityTokenOptions struct {
+		Subject  string
+		Audience string
+		Expiry   time.Time
+		Claims   map[string]string
+	}
+
+	// oidcDiscovery is the subset of the OpenID provider metadata relevant to
+	// the verification of workload identity tokens.
+	oidcDiscovery struct {
+		Issuer                           string   `json:"issuer"`
+		JWKSURI                          string   `json:"jwks_uri"`
+		ResponseTypesSupported           []string `json:"response_types_supported"`
+		SubjectTypesSupported            []string `json:"subject_types_supported"`
+		IDTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported"`
+		ClaimsSupported                  []string `json:"claims_supported"`
+		ScopesSupported                  []string `json:"scopes_supported"`
+	}
+)
+
+func newIdentityProvider(secret []byte, hostnameService *internal.HostnameService) (*identityProvider, error) {
+	raw, err := deriveSigningKey(secret)
+	if err != nil {
+		return nil, fmt.Errorf("deriving workload identity signing key: %w", err)
+	}
+	key, err := jwk.FromRaw(raw)
+	if err != nil {
+		return nil, err
+	}
+	// identify key using its thumbprint
+	thumbprint, err := key.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+	kid := base64.RawURLEncoding.EncodeToString(thumbprint)
+	if err := key.Set(jwk.KeyIDKey, kid); err != nil {
+		return nil, err
+	}
+	if err := key.Set(jwk.AlgorithmKey, jwa.ES256); err != nil {
+		return nil, err
+	}
+	publicKey, err := key.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	if err := publicKey.Set(jwk.KeyUsageKey, jwk.ForSignature); err != nil {
+		return nil, err
+	}
+	return &identityProvider{
+		hostnames: hostnameService,
+		key:       key,
+		publicKey: publicKey,
+	}, nil
+}
+
+// deriveSigningKey deterministically derives an ECDSA P-256 private key from
+// the secret.
+func deriveSigningKey(secret []byte) (*ecdsa.PrivateKey, error) {
+	// A derived scalar is invalid if it is zero or not less than the order of
+	// the curve, which is astronomically unlikely; should it happen then a
+	// further scalar is derived.
+	for i := 0; i < 10; i++ {
+		scalar := make([]byte, 32)
+		if _, err := io.ReadFull(hkdf.New(sha256.New, secret, nil, []byte(fmt.Sprintf("%s %d", identityKeyInfo, i))), scalar); err != nil {
+			return nil, err
+		}
+		priv, err := ecdh.P256().NewPrivateKey(scalar)
+		if err != nil {
+			continue
+		}
+		// public key is encoded in uncompressed form: 0x04 || X || Y
+		pub := priv.PublicKey().Bytes()
+		return &ecdsa.PrivateKey{
+			PublicKey: ecdsa.PublicKey{
+				Curve: elliptic.P256(),
+				X:     new(big.Int).SetBytes(pub[1:33]),
+				Y:     new(big.Int).SetBytes(pub[33:]),
+			},
+			D: new(big.Int).SetBytes(scalar),
+		}, nil
+	}
+	return nil, fmt.Errorf("unable to derive valid key")
+}
+
+// Issuer returns the URL identifying the identity provider.
+func (p *identityProvider) Issuer() string {
+	return p.hostnames.URL("")
+}
+
+// NewWorkloadIdentityToken constructs a workload identity token, signed with
+// the identity provider's private key.
+func (p *identityProvider) NewWorkloadIdentityToken(opts WorkloadIdentityTokenOptions) ([]byte, error) {
+	now := time.Now()
+	builder := jwt.NewBuilder().
+		JwtID(internal.NewID("wit")).
+		Issuer(p.Issuer()).
+		Subject(opts.Subject).
+		Audience([]string{opts.Audience}).
+		IssuedAt(now).
+		NotBefore(now).
+		Expiration(opts.Expiry)
+	for k, v := range opts.Claims {
+		builder = builder.Claim(k, v)
+	}
+	token, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return jwt.Sign(token, jwt.WithKey(jwa.ES256, p.key))
+}
+
+// RegisterWorkloadIdentityClaims registers the names of custom claims that
+// are included in workload identity tokens, in order that they are advertised
+// to relying parties.
+func (p *identityProvider) RegisterWorkloadIdentityClaims(names ...string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.claims = append(p.claims, names...)
+}
+
+// AddHandlers adds handlers for the OIDC discovery document and the JSON web
+// key set, with which third parties verify workload identity tokens.
+func (p *identityProvider) AddHandlers(r *mux.Router) {
+	r.HandleFunc(OIDCDiscoveryPath, p.discovery).Methods("GET")
+	r.HandleFunc(JWKSPath, p.jwks).Methods("GET")
+}
+
+func (p *identityProvider) discovery(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	claims := append([]string{"sub", "aud", "iss", "iat", "nbf", "exp", "jti"}, p.claims...)
+	p.mu.Unlock()
+
+	w.Header().Set("Content-type", "application/json")
+	w.Write(json.MustMarshal(oidcDiscovery{ //nolint:errcheck
+		Issuer:                           p.Issuer(),
+		JWKSURI:                          p.hostnames.URL(JWKSPath),
+		ResponseTypesSupported:           []string{"id_token"},
+		SubjectTypesSupported:            []string{"public"},
+		IDTokenSigningAlgValuesSupported: []string{jwa.ES256.String()},
+		ClaimsSupported:                  claims,
+		ScopesSupported:                  []string{"openid"},
+	}))
+}
+
+func (p *identityProvider) jwks(w http.ResponseWriter, r *http.Request) {
+	set := jwk.NewSet()
+	if err := set.AddKey(p.publicKey); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-type", "application/json")
+	w.Write(json.MustMarshal(set)) //nolint:errcheck
+}
@@ -0,0 +1,83 @@
+package tokens
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+)
+
+func TestIdentityProvider(t *testing.T) {
+	hostnames := internal.NewHostnameService("tofutf.dev")
+	provider, err := newIdentityProvider([]byte("abcdef123"), hostnames)
+	require.NoError(t, err)
+	provider.RegisterWorkloadIdentityClaims("run_id")
+
+	r := mux.NewRouter()
+	provider.AddHandlers(r)
+
+	t.Run("same secret derives same key", func(t *testing.T) {
+		other, err := newIdentityProvider([]byte("abcdef123"), hostnames)
+		require.NoError(t, err)
+		assert.Equal(t, provider.publicKey.KeyID(), other.publicKey.KeyID())
+	})
+
+	t.Run("different secret derives different key", func(t *testing.T) {
+		other, err := newIdentityProvider([]byte("123abcdef"), hostnames)
+		require.NoError(t, err)
+		assert.NotEqual(t, provider.publicKey.KeyID(), other.publicKey.KeyID())
+	})
+
+	t.Run("discovery", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", OIDCDiscoveryPath, nil))
+		require.Equal(t, 200, w.Code)
+
+		var got oidcDiscovery
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+		assert.Equal(t, "https://tofutf.dev", got.Issuer)
+		assert.Equal(t, "https://tofutf.dev/.well-known/jwks.json", got.JWKSURI)
+		assert.Contains(t, got.ClaimsSupported, "sub")
+		assert.Contains(t, got.ClaimsSupported, "run_id")
+	})
+
+	t.Run("verify token using published key set", func(t *testing.T) {
+		token, err := provider.NewWorkloadIdentityToken(WorkloadIdentityTokenOptions{
+			Subject:  "organization:acme:workspace:dev:run_phase:plan",
+			Audience: "aws",
+			Expiry:   time.Now().Add(time.Hour),
+			Claims:   map[string]string{"run_id": "run-123"},
+		})
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", JWKSPath, nil))
+		require.Equal(t, 200, w.Code)
+		set, err := jwk.Parse(w.Body.Bytes())
+		require.NoError(t, err)
+
+		parsed, err := jwt.Parse(token,
+			jwt.WithKeySet(set),
+			jwt.WithIssuer("https://tofutf.dev"),
+			jwt.WithAudience("aws"),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "organization:acme:workspace:dev:run_phase:plan", parsed.Subject())
+		runID, ok := parsed.Get("run_id")
+		require.True(t, ok)
+		assert.Equal(t, "run-123", runID)
+	})
+
+	t.Run("published key set excludes private key", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", JWKSPath, nil))
+		assert.NotContains(t, w.Body.String(), `"d"`)
+	})
+}
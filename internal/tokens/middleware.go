@@ -34,6 +34,15 @@ var AuthenticatedPrefixes = []string{
 	paths.UIPrefix,
 }
 
+// OptionallyAuthenticatedPrefixes are those URL path prefixes for which
+// authentication is optional: a request without a token is permitted, but only
+// an authenticated request can access private resources. This permits the
+// provider registry to proxy the upstream registry for anonymous clients while
+// serving private providers to authenticated clients.
+var OptionallyAuthenticatedPrefixes = []string{
+	tfeapi.ProviderV1Prefix,
+}
+
 type (
 	middlewareOptions struct {
 		GoogleIAPConfig
@@ -50,6 +59,8 @@ type (
 
 	middleware struct {
 		middlewareOptions
+
+		usage *usageTracker
 	}
 )
 
@@ -60,16 +71,24 @@ type (
 // 2. If Google IAP header is present then authenticate its token and allow or deny
 // accordingly.
 // 3. If Bearer token is present then authenticate it and allow or deny accordingly.
+// A token with a read-only scope is restricted to actions that do not alter
+// resources.
 // 4. If requested path is for a UI endpoint then check for session cookie. If
 // present then authenticate its token. If cookie is missing or authentication fails
 // then redirect user to login page.
-// 5. Otherwise, return 401
+// 5. If authentication is optional for the requested path then allow the
+// request without an authenticated subject.
+// 6. Otherwise, return 401
 //
 // Where authentication succeeds, the authenticated subject is attached to the request
-// context and the upstream handler is called. If the authenticated subject is a
-// user and the user does not exist the user is first created.
+// context and the upstream handler is called. If the subject of a Google IAP
+// token is a user and the user does not exist the user is first created. The
+// user of a session is instead created when the session is started.
 func newMiddleware(opts middlewareOptions) mux.MiddlewareFunc {
-	mw := middleware{middlewareOptions: opts}
+	mw := middleware{
+		middlewareOptions: opts,
+		usage:             newUsageTracker(opts.logger, opts.registry),
+	}
 
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -84,7 +103,8 @@ func newMiddleware(opts middlewareOptions) mux.MiddlewareFunc {
 				Username: "auth",
 			})
 
-			if !isProtectedPath(r.URL.Path) {
+			optional := hasPrefix(r.URL.Path, OptionallyAuthenticatedPrefixes)
+			if !optional && !hasPrefix(r.URL.Path, AuthenticatedPrefixes) {
 				next.ServeHTTP(w, r)
 				return
 			}
@@ -108,6 +128,9 @@ func newMiddleware(opts middlewareOptions) mux.MiddlewareFunc {
 					html.SendUserToLoginPage(w, r)
 					return
 				}
+			} else if optional {
+				next.ServeHTTP(w, r)
+				return
 			} else {
 				http.Error(w, "no authentication token found", http.StatusUnauthorized)
 				return
@@ -151,7 +174,27 @@ func (m *middleware) validateBearer(ctx context.Context, bearer string) (interna
 		return nil, fmt.Errorf("missing claim: kind")
 	}
 	kind := Kind(kindClaim.(string))
-	return m.GetSubject(ctx, kind, parsed.Subject())
+	// tokens created before the introduction of scopes lack a scope claim and
+	// are treated as read-write.
+	var scope Scope
+	if scopeClaim, ok := parsed.Get("scope"); ok {
+		s, _ := scopeClaim.(string)
+		scope = Scope(s)
+	}
+	scope, err = ResolveScope(scope)
+	if err != nil {
+		return nil, err
+	}
+	subject, err := m.GetSubject(ctx, kind, parsed.Subject())
+	if err != nil {
+		return nil, err
+	}
+	m.usage.record(ctx, kind, parsed.Subject())
+
+	if scope == ReadOnlyScope {
+		return &internal.ReadOnlySubject{Subject: subject}, nil
+	}
+	return subject, nil
 }
 
 func (m *middleware) validateUIRequest(ctx context.Context, w http.ResponseWriter, r *http.Request) (internal.Subject, bool) {
@@ -171,7 +214,7 @@ func (m *middleware) validateUIRequest(ctx context.Context, w http.ResponseWrite
 		}
 		return nil, false
 	}
-	user, err := m.GetOrCreateUISubject(ctx, token.Subject())
+	user, err := m.GetUISessionSubject(ctx, token.Subject(), token.IssuedAt())
 	if err != nil {
 		html.FlashError(w, "unable to find user: "+err.Error())
 		return nil, false
@@ -179,8 +222,8 @@ func (m *middleware) validateUIRequest(ctx context.Context, w http.ResponseWrite
 	return user, true
 }
 
-func isProtectedPath(path string) bool {
-	for _, prefix := range AuthenticatedPrefixes {
+func hasPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
 		if strings.HasPrefix(path, prefix) {
 			return true
 		}
@@ -1,6 +1,8 @@
 package tokens
 
 import (
+	"context"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -9,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/testutils"
+	"github.com/tofutf/tofutf/internal/xslog"
 )
 
 func TestMiddleware(t *testing.T) {
@@ -28,6 +31,30 @@ func TestMiddleware(t *testing.T) {
 		assert.Equal(t, 401, w.Code)
 	})
 
+	t.Run("optionally protected path without token", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/v1/providers/hashicorp/aws/versions", nil)
+		w := httptest.NewRecorder()
+		fakeTokenMiddleware(t, secret)(emptyHandler).ServeHTTP(w, r)
+		assert.Equal(t, 200, w.Code)
+	})
+
+	t.Run("optionally protected path with token", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/v1/providers/acme/internal/versions", nil)
+		token := newTestJWT(t, secret, Kind("test-kind"), time.Hour)
+		r.Header.Add("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		fakeTokenMiddleware(t, secret)(wantSubjectHandler(t, &internal.Superuser{})).ServeHTTP(w, r)
+		assert.Equal(t, 200, w.Code, w.Body.String())
+	})
+
+	t.Run("optionally protected path with invalid token", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/v1/providers/acme/internal/versions", nil)
+		r.Header.Add("Authorization", "Bearer invalid")
+		w := httptest.NewRecorder()
+		fakeTokenMiddleware(t, secret)(emptyHandler).ServeHTTP(w, r)
+		assert.Equal(t, 401, w.Code)
+	})
+
 	t.Run("valid site token", func(t *testing.T) {
 		r := httptest.NewRequest("GET", "/api/v2/protected", nil)
 		r.Header.Add("Authorization", "Bearer site-token")
@@ -53,6 +80,55 @@ func TestMiddleware(t *testing.T) {
 		assert.Equal(t, 200, w.Code, w.Body.String())
 	})
 
+	t.Run("read-only API token", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/api/v2/protected", nil)
+		token := newTestJWT(t, secret, Kind("test-kind"), time.Hour, "scope", string(ReadOnlyScope))
+		r.Header.Add("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		fakeTokenMiddleware(t, secret)(wantSubjectHandler(t, &internal.ReadOnlySubject{})).ServeHTTP(w, r)
+		assert.Equal(t, 200, w.Code, w.Body.String())
+	})
+
+	t.Run("invalid API token scope", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/api/v2/protected", nil)
+		token := newTestJWT(t, secret, Kind("test-kind"), time.Hour, "scope", "superpowers")
+		r.Header.Add("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		fakeTokenMiddleware(t, secret)(emptyHandler).ServeHTTP(w, r)
+		assert.Equal(t, 401, w.Code)
+	})
+
+	t.Run("record API token usage", func(t *testing.T) {
+		var used []string
+		mw := newMiddleware(middlewareOptions{
+			logger: slog.New(&xslog.NoopHandler{}),
+			key:    newTestJWK(t, secret),
+			registry: &registry{
+				kinds: map[Kind]SubjectGetter{
+					"test-kind": func(context.Context, string) (internal.Subject, error) {
+						return &internal.Superuser{}, nil
+					},
+				},
+				usageRecorders: map[Kind]UsageRecorder{
+					"test-kind": func(_ context.Context, jwtSubject string, _ time.Time) error {
+						used = append(used, jwtSubject)
+						return nil
+					},
+				},
+			},
+		})
+		token := newTestJWT(t, secret, Kind("test-kind"), time.Hour, "sub", "token-123")
+		// successive uses within the record interval are only recorded once
+		for range 2 {
+			r := httptest.NewRequest("GET", "/api/v2/protected", nil)
+			r.Header.Add("Authorization", "Bearer "+token)
+			w := httptest.NewRecorder()
+			mw(emptyHandler).ServeHTTP(w, r)
+			assert.Equal(t, 200, w.Code)
+		}
+		assert.Equal(t, []string{"token-123"}, used)
+	})
+
 	t.Run("invalid jwt", func(t *testing.T) {
 		differentSecret := testutils.NewSecret(t)
 		token := newTestJWT(t, differentSecret, Kind("test-kind"), time.Hour)
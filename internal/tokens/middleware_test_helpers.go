@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
@@ -62,6 +63,9 @@ func fakeTokenMiddleware(t *testing.T, secret []byte) mux.MiddlewareFunc {
 			uiSubjectGetterOrCreator: func(context.Context, string) (internal.Subject, error) {
 				return &internal.Superuser{}, nil
 			},
+			uiSessionSubjectGetter: func(context.Context, string, time.Time) (internal.Subject, error) {
+				return &internal.Superuser{}, nil
+			},
 		},
 	})
 }
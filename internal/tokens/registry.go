@@ -3,7 +3,9 @@ package tokens
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/tofutf/tofutf/internal"
 )
@@ -21,18 +23,31 @@ type registry struct {
 	SiteAdmin internal.Subject
 
 	kinds                    map[Kind]SubjectGetter
+	usageRecorders           map[Kind]UsageRecorder
+	staleTokenFinders        map[Kind]StaleTokenFinder
 	mu                       sync.Mutex
 	uiSubjectGetterOrCreator UISubjectGetterOrCreator
+	uiSessionSubjectGetter   UISessionSubjectGetter
 }
 
 // SubjectGetter retrieves an OTF subject given the jwtSubject string, which is the
 // value of the 'subject' field parsed from a JWT.
 type SubjectGetter func(ctx context.Context, jwtSubject string) (internal.Subject, error)
 
+// UsageRecorder records that the token identified by the jwtSubject string was
+// used to authenticate a request at the given time.
+type UsageRecorder func(ctx context.Context, jwtSubject string, usedAt time.Time) error
+
 // UISubjectGetterOrCreator retrieves the OTF subject with the given login that
 // is attempting to access the UI. If the subject does not exist it is created.
 type UISubjectGetterOrCreator func(ctx context.Context, login string) (internal.Subject, error)
 
+// UISessionSubjectGetter retrieves the OTF subject with the given login whose
+// UI session started at the given time. Unlike UISubjectGetterOrCreator, the
+// subject is not created if it does not exist: a session cannot resurrect a
+// subject that has since been deleted.
+type UISessionSubjectGetter func(ctx context.Context, login string, startedAt time.Time) (internal.Subject, error)
+
 // RegisterKind registers a kind of authentication token, providing a func that
 // can retrieve the OTF subject indicated in the token.
 func (r *registry) RegisterKind(k Kind, fn SubjectGetter) {
@@ -52,6 +67,62 @@ func (r *registry) GetSubject(ctx context.Context, k Kind, jwtSubject string) (i
 	return subjectGetter(ctx, jwtSubject)
 }
 
+// RegisterUsageRecorder registers a func that records the use of a kind of
+// authentication token.
+func (r *registry) RegisterUsageRecorder(k Kind, fn UsageRecorder) {
+	r.mu.Lock()
+	r.usageRecorders[k] = fn
+	r.mu.Unlock()
+}
+
+// RecordUsage records the use of a token. It is a no-op if no usage recorder
+// has been registered for the kind of token.
+func (r *registry) RecordUsage(ctx context.Context, k Kind, jwtSubject string, usedAt time.Time) error {
+	r.mu.Lock()
+	recorder, ok := r.usageRecorders[k]
+	r.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+	return recorder(ctx, jwtSubject, usedAt)
+}
+
+// RegisterStaleTokenFinder registers a func that finds stale tokens of a kind
+// of authentication token.
+func (r *registry) RegisterStaleTokenFinder(k Kind, fn StaleTokenFinder) {
+	r.mu.Lock()
+	r.staleTokenFinders[k] = fn
+	r.mu.Unlock()
+}
+
+// findStaleTokens finds stale tokens of all registered kinds, revoking them
+// too if revoke is true.
+func (r *registry) findStaleTokens(ctx context.Context, cutoff time.Time, revoke bool) ([]*StaleToken, error) {
+	r.mu.Lock()
+	kinds := make([]Kind, 0, len(r.staleTokenFinders))
+	for k := range r.staleTokenFinders {
+		kinds = append(kinds, k)
+	}
+	// search kinds in a consistent order
+	slices.Sort(kinds)
+	finders := make([]StaleTokenFinder, len(kinds))
+	for i, k := range kinds {
+		finders[i] = r.staleTokenFinders[k]
+	}
+	r.mu.Unlock()
+
+	var stale []*StaleToken
+	for i, finder := range finders {
+		found, err := finder(ctx, cutoff, revoke)
+		if err != nil {
+			return nil, fmt.Errorf("finding stale tokens of kind %s: %w", kinds[i], err)
+		}
+		stale = append(stale, found...)
+	}
+	return stale, nil
+}
+
 // RegisterSiteToken registers a site token which the middleware, and the
 // subject to return as the site admin upon successful authentication.
 func (r *registry) RegisterSiteToken(token string, siteAdmin internal.Subject) {
@@ -66,3 +137,11 @@ func (r *registry) RegisterUISubjectGetterOrCreator(fn UISubjectGetterOrCreator)
 func (r *registry) GetOrCreateUISubject(ctx context.Context, login string) (internal.Subject, error) {
 	return r.uiSubjectGetterOrCreator(ctx, login)
 }
+
+func (r *registry) RegisterUISessionSubjectGetter(fn UISessionSubjectGetter) {
+	r.uiSessionSubjectGetter = fn
+}
+
+func (r *registry) GetUISessionSubject(ctx context.Context, login string, startedAt time.Time) (internal.Subject, error) {
+	return r.uiSessionSubjectGetter(ctx, login, startedAt)
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/lestrrat-go/jwx/v2/jwk"
 	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/http/html"
+	"github.com/tofutf/tofutf/internal/tfeapi"
 )
 
 type (
@@ -13,17 +15,24 @@ type (
 		*factory
 		*registry
 		*sessionFactory
+		*identityProvider
 
 		site       internal.Authorizer // authorizes site access
 		logger     *slog.Logger
 		middleware mux.MiddlewareFunc
+		web        *webHandlers
+		api        *api
 	}
 
 	Options struct {
 		GoogleIAPConfig
 
-		Logger *slog.Logger
-		Secret []byte
+		Logger          *slog.Logger
+		Secret          []byte
+		HostnameService *internal.HostnameService
+
+		*tfeapi.Responder
+		html.Renderer
 	}
 )
 
@@ -38,8 +47,14 @@ func NewService(opts Options) (*Service, error) {
 	}
 	svc.factory = &factory{key: key}
 	svc.sessionFactory = &sessionFactory{factory: svc.factory}
+	svc.identityProvider, err = newIdentityProvider(opts.Secret, opts.HostnameService)
+	if err != nil {
+		return nil, err
+	}
 	svc.registry = &registry{
-		kinds: make(map[Kind]SubjectGetter),
+		kinds:             make(map[Kind]SubjectGetter),
+		usageRecorders:    make(map[Kind]UsageRecorder),
+		staleTokenFinders: make(map[Kind]StaleTokenFinder),
 	}
 	svc.middleware = newMiddleware(middlewareOptions{
 		logger:          opts.Logger,
@@ -47,8 +62,22 @@ func NewService(opts Options) (*Service, error) {
 		key:             key,
 		registry:        svc.registry,
 	})
+	svc.web = &webHandlers{
+		Renderer: opts.Renderer,
+		svc:      &svc,
+	}
+	svc.api = &api{
+		Service:   &svc,
+		Responder: opts.Responder,
+	}
 	return &svc, nil
 }
 
+func (a *Service) AddHandlers(r *mux.Router) {
+	a.identityProvider.AddHandlers(r)
+	a.web.addHandlers(r)
+	a.api.addHandlers(r)
+}
+
 // Middleware returns middleware for authenticating tokens
 func (a *Service) Middleware() mux.MiddlewareFunc { return a.middleware }
@@ -44,6 +44,15 @@ func (a *Service) StartSession(w http.ResponseWriter, r *http.Request, opts Star
 	if opts.Username == nil {
 		return fmt.Errorf("missing username")
 	}
+	// The user is created upon starting a session rather than upon
+	// authenticating the session, so that a session cannot re-create a user
+	// that has since been deleted.
+	ctx := internal.AddSubjectToContext(r.Context(), &internal.Superuser{
+		Username: "auth",
+	})
+	if _, err := a.GetOrCreateUISubject(ctx, *opts.Username); err != nil {
+		return err
+	}
 	expiry := internal.CurrentTimestamp(nil).Add(defaultSessionExpiry)
 	if opts.Expiry != nil {
 		expiry = *opts.Expiry
@@ -1,6 +1,7 @@
 package tokens
 
 import (
+	"context"
 	"log/slog"
 	"net/http/httptest"
 	"testing"
@@ -18,11 +19,18 @@ import (
 func TestService_StartSession(t *testing.T) {
 	key, err := jwk.FromRaw([]byte("abcdef123"))
 	require.NoError(t, err)
+	var created []string
 	svc := Service{
 		logger: slog.New(&xslog.NoopHandler{}),
 		sessionFactory: &sessionFactory{
 			factory: &factory{key: key},
 		},
+		registry: &registry{
+			uiSubjectGetterOrCreator: func(_ context.Context, login string) (internal.Subject, error) {
+				created = append(created, login)
+				return &internal.Superuser{Username: login}, nil
+			},
+		},
 	}
 
 	w := httptest.NewRecorder()
@@ -39,6 +47,9 @@ func TestService_StartSession(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "bobby", token.Subject())
 
+	// user is created upon starting the session
+	assert.Equal(t, []string{"bobby"}, created)
+
 	// user is redirected to their profile page
 	assert.Equal(t, 302, w.Code)
 	loc, err := w.Result().Location()
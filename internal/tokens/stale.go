@@ -0,0 +1,111 @@
+package tokens
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/rbac"
+)
+
+// DefaultUnusedDays is the default number of days a token must have gone
+// unused before it is considered stale.
+const DefaultUnusedDays = 90
+
+type (
+	// StaleToken is a token that has either expired or has not been used
+	// recently.
+	StaleToken struct {
+		ID   string `jsonapi:"primary,stale-tokens"`
+		Kind Kind   `jsonapi:"attribute" json:"kind"`
+		// Owner identifies to whom the token belongs: a username, a team
+		// (<organization>/<team>), or an organization.
+		Owner       string     `jsonapi:"attribute" json:"owner"`
+		Description string     `jsonapi:"attribute" json:"description"`
+		Scope       Scope      `jsonapi:"attribute" json:"scope"`
+		CreatedAt   time.Time  `jsonapi:"attribute" json:"created-at"`
+		Expiry      *time.Time `jsonapi:"attribute" json:"expiry"`
+		LastUsedAt  *time.Time `jsonapi:"attribute" json:"last-used-at"`
+	}
+
+	// StaleTokenOptions are options for finding stale tokens.
+	StaleTokenOptions struct {
+		// UnusedDays is the number of days a token must have gone unused to
+		// be considered stale. A token that has never been used is considered
+		// stale if it was created before then. Defaults to DefaultUnusedDays.
+		UnusedDays int `schema:"unused_days"`
+	}
+
+	// StaleTokenFinder finds stale tokens of a kind of authentication token,
+	// i.e. tokens that have expired or have not been used since the cutoff. If
+	// revoke is true then the stale tokens are revoked too.
+	StaleTokenFinder func(ctx context.Context, cutoff time.Time, revoke bool) ([]*StaleToken, error)
+)
+
+// ListStaleTokens lists stale tokens of all kinds, least recently used first.
+func (a *Service) ListStaleTokens(ctx context.Context, opts StaleTokenOptions) ([]*StaleToken, error) {
+	subject, err := a.site.CanAccess(ctx, rbac.ListStaleTokensAction, "")
+	if err != nil {
+		return nil, err
+	}
+
+	stale, err := a.findStaleTokens(ctx, opts.cutoff(), false)
+	if err != nil {
+		a.logger.Error("listing stale tokens", "subject", subject, "err", err)
+		return nil, err
+	}
+	sortStaleTokens(stale)
+	return stale, nil
+}
+
+// RevokeStaleTokens revokes stale tokens of all kinds, returning the revoked
+// tokens.
+func (a *Service) RevokeStaleTokens(ctx context.Context, opts StaleTokenOptions) ([]*StaleToken, error) {
+	subject, err := a.site.CanAccess(ctx, rbac.RevokeStaleTokensAction, "")
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := a.findStaleTokens(ctx, opts.cutoff(), true)
+	if err != nil {
+		a.logger.Error("revoking stale tokens", "subject", subject, "err", err)
+		return nil, err
+	}
+	sortStaleTokens(revoked)
+	// the action affects many tokens, so each revoked token is recorded
+	// separately.
+	for _, token := range revoked {
+		internal.RecordAuthorized(ctx, subject, rbac.RevokeStaleTokensAction, token.ID, "")
+	}
+
+	a.logger.Info("revoked stale tokens", "count", len(revoked), "subject", subject)
+
+	return revoked, nil
+}
+
+// cutoff returns the time before which a token must have last been used to be
+// considered stale.
+func (opts StaleTokenOptions) cutoff() time.Time {
+	days := opts.UnusedDays
+	if days <= 0 {
+		days = DefaultUnusedDays
+	}
+	return internal.CurrentTimestamp(nil).AddDate(0, 0, -days)
+}
+
+// LastActive returns when the token was last used or, if it has never been
+// used, when it was created.
+func (t *StaleToken) LastActive() time.Time {
+	if t.LastUsedAt != nil {
+		return *t.LastUsedAt
+	}
+	return t.CreatedAt
+}
+
+// sortStaleTokens sorts tokens, least recently active first.
+func sortStaleTokens(tokens []*StaleToken) {
+	sort.SliceStable(tokens, func(i, j int) bool {
+		return tokens[i].LastActive().Before(tokens[j].LastActive())
+	})
+}
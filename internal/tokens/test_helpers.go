@@ -1,6 +1,7 @@
 package tokens
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -39,3 +40,15 @@ func newTestJWK(t *testing.T, secret []byte) jwk.Key {
 	require.NoError(t, err)
 	return key
 }
+
+type fakeStaleTokenService struct {
+	stale []*StaleToken
+}
+
+func (f *fakeStaleTokenService) ListStaleTokens(context.Context, StaleTokenOptions) ([]*StaleToken, error) {
+	return f.stale, nil
+}
+
+func (f *fakeStaleTokenService) RevokeStaleTokens(context.Context, StaleTokenOptions) ([]*StaleToken, error) {
+	return f.stale, nil
+}
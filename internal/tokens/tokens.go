@@ -2,6 +2,7 @@
 package tokens
 
 import (
+	"errors"
 	"time"
 
 	"github.com/lestrrat-go/jwx/v2/jwa"
@@ -9,15 +10,37 @@ import (
 	"github.com/lestrrat-go/jwx/v2/jwt"
 )
 
+const (
+	// ReadOnlyScope restricts a token to actions that do not alter resources.
+	ReadOnlyScope Scope = "read-only"
+	// ReadWriteScope permits a token to carry out any action permitted to its
+	// subject.
+	ReadWriteScope Scope = "read-write"
+)
+
+// ExpiryDateFormat is the format of an expiry date submitted via a web form.
+const ExpiryDateFormat = "2006-01-02"
+
+var (
+	ErrInvalidScope = errors.New("invalid token scope: must be either read-only or read-write")
+	ErrPastExpiry   = errors.New("token expiry must be in the future")
+)
+
 type (
 	// the Kind of authentication token: user session, user token, agent token, etc
 	Kind string
 
+	// Scope restricts the actions that can be carried out with a token.
+	Scope string
+
 	NewTokenOptions struct {
 		Kind    Kind
 		Subject string
 		Expiry  *time.Time
-		Claims  map[string]string
+		// Optional scope. If unset then the token is not restricted beyond
+		// the permissions of its subject.
+		Scope  Scope
+		Claims map[string]string
 	}
 
 	// factory constructs new tokens using a jwk
@@ -37,9 +60,44 @@ func (f *factory) NewToken(opts NewTokenOptions) ([]byte, error) {
 	if opts.Expiry != nil {
 		builder = builder.Expiration(*opts.Expiry)
 	}
+	if opts.Scope != "" {
+		if _, err := ResolveScope(opts.Scope); err != nil {
+			return nil, err
+		}
+		builder = builder.Claim("scope", string(opts.Scope))
+	}
 	token, err := builder.Build()
 	if err != nil {
 		return nil, err
 	}
 	return jwt.Sign(token, jwt.WithKey(jwa.HS256, f.key))
 }
+
+// ResolveScope validates the scope, returning the read-write scope if the scope
+// is empty.
+func ResolveScope(scope Scope) (Scope, error) {
+	switch scope {
+	case "":
+		return ReadWriteScope, nil
+	case ReadOnlyScope, ReadWriteScope:
+		return scope, nil
+	default:
+		return "", ErrInvalidScope
+	}
+}
+
+// ParseExpiry parses an expiry date submitted via a web form. An empty date
+// returns nil, i.e. the token does not expire.
+func ParseExpiry(date string) (*time.Time, error) {
+	if date == "" {
+		return nil, nil
+	}
+	expiry, err := time.Parse(ExpiryDateFormat, date)
+	if err != nil {
+		return nil, err
+	}
+	if !expiry.After(time.Now()) {
+		return nil, ErrPastExpiry
+	}
+	return &expiry, nil
+}
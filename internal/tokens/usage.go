@@ -0,0 +1,54 @@
+package tokens
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/tofutf/tofutf/internal"
+)
+
+// usageRecordInterval is the minimum interval between recording successive
+// uses of the same token, sparing the database a write on every request.
+const usageRecordInterval = time.Minute
+
+// usageTracker records the use of tokens to authenticate requests.
+type usageTracker struct {
+	*registry
+
+	logger *slog.Logger
+
+	mu sync.Mutex
+	// lastRecorded is when each token's use was last recorded, keyed by kind
+	// and jwt subject.
+	lastRecorded map[string]time.Time
+}
+
+func newUsageTracker(logger *slog.Logger, registry *registry) *usageTracker {
+	return &usageTracker{
+		registry:     registry,
+		logger:       logger,
+		lastRecorded: make(map[string]time.Time),
+	}
+}
+
+// record records the use of a token, unless its use has already been recorded
+// within the last interval. A failure to record is logged rather than
+// returned, so as not to fail the request.
+func (t *usageTracker) record(ctx context.Context, kind Kind, jwtSubject string) {
+	now := internal.CurrentTimestamp(nil)
+	key := string(kind) + "/" + jwtSubject
+
+	t.mu.Lock()
+	if last, ok := t.lastRecorded[key]; ok && now.Sub(last) < usageRecordInterval {
+		t.mu.Unlock()
+		return
+	}
+	t.lastRecorded[key] = now
+	t.mu.Unlock()
+
+	if err := t.RecordUsage(ctx, kind, jwtSubject, now); err != nil {
+		t.logger.Error("recording token usage", "kind", kind, "err", err)
+	}
+}
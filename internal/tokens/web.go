@@ -2,11 +2,82 @@ package tokens
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"net/http"
 
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal/http/decode"
 	"github.com/tofutf/tofutf/internal/http/html"
+	"github.com/tofutf/tofutf/internal/http/html/paths"
+	"github.com/tofutf/tofutf/internal/resource"
 )
 
+type webHandlers struct {
+	html.Renderer
+
+	svc webClient
+}
+
+type webClient interface {
+	ListStaleTokens(ctx context.Context, opts StaleTokenOptions) ([]*StaleToken, error)
+	RevokeStaleTokens(ctx context.Context, opts StaleTokenOptions) ([]*StaleToken, error)
+}
+
+func (h *webHandlers) addHandlers(r *mux.Router) {
+	r = html.UIRouter(r)
+
+	r.HandleFunc("/admin/stale-tokens", h.listStaleTokens).Methods("GET")
+	r.HandleFunc("/admin/stale-tokens/revoke", h.revokeStaleTokens).Methods("POST")
+}
+
+func (h *webHandlers) listStaleTokens(w http.ResponseWriter, r *http.Request) {
+	var opts StaleTokenOptions
+	if err := decode.All(&opts, r); err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	if opts.UnusedDays <= 0 {
+		opts.UnusedDays = DefaultUnusedDays
+	}
+
+	stale, err := h.svc.ListStaleTokens(r.Context(), opts)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.Render("stale_token_list.tmpl", w, struct {
+		html.SitePage
+		// list template expects pagination object
+		*resource.Pagination
+		Items      []*StaleToken
+		UnusedDays int
+	}{
+		SitePage:   html.NewSitePage(r, "stale tokens"),
+		Pagination: &resource.Pagination{},
+		Items:      stale,
+		UnusedDays: opts.UnusedDays,
+	})
+}
+
+func (h *webHandlers) revokeStaleTokens(w http.ResponseWriter, r *http.Request) {
+	var opts StaleTokenOptions
+	if err := decode.All(&opts, r); err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	revoked, err := h.svc.RevokeStaleTokens(r.Context(), opts)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	html.FlashSuccess(w, fmt.Sprintf("revoked %d stale tokens", len(revoked)))
+	http.Redirect(w, r, fmt.Sprintf("%s?unused_days=%d", paths.StaleTokens(), opts.UnusedDays), http.StatusFound)
+}
+
 // TokenFlashMessage is a helper for rendering a flash message with an
 // authentication token.
 func TokenFlashMessage(renderer html.Renderer, w http.ResponseWriter, token []byte) error {
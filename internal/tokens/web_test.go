@@ -0,0 +1,56 @@
+package tokens
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/http/html/paths"
+	"github.com/tofutf/tofutf/internal/testutils"
+)
+
+func TestWeb_StaleTokens(t *testing.T) {
+	ctx := internal.AddSubjectToContext(context.Background(), &internal.Superuser{})
+
+	t.Run("list", func(t *testing.T) {
+		h := &webHandlers{
+			Renderer: testutils.NewRenderer(t),
+			svc: &fakeStaleTokenService{
+				stale: []*StaleToken{
+					{ID: "ut-1", Kind: "user_token", Owner: "bobby", Scope: ReadOnlyScope},
+					{ID: "ot-1", Kind: "organization_token", Owner: "acme-corp", LastUsedAt: internal.Time(time.Now())},
+				},
+			},
+		}
+		r := httptest.NewRequest("GET", "/?unused_days=30", nil)
+		r = r.WithContext(ctx)
+		w := httptest.NewRecorder()
+
+		h.listStaleTokens(w, r)
+
+		assert.Equal(t, 200, w.Code, w.Body.String())
+		assert.Contains(t, w.Body.String(), "ut-1")
+		assert.Contains(t, w.Body.String(), "ot-1")
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		h := &webHandlers{
+			Renderer: testutils.NewRenderer(t),
+			svc:      &fakeStaleTokenService{stale: []*StaleToken{{ID: "ut-1"}}},
+		}
+		r := httptest.NewRequest("POST", "/?unused_days=30", nil)
+		r = r.WithContext(ctx)
+		w := httptest.NewRecorder()
+
+		h.revokeStaleTokens(w, r)
+
+		if assert.Equal(t, 302, w.Code) {
+			redirect, _ := w.Result().Location()
+			assert.Equal(t, paths.StaleTokens(), redirect.Path)
+			assert.Equal(t, "30", redirect.Query().Get("unused_days"))
+		}
+	})
+}
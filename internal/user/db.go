@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/sql"
 	"github.com/tofutf/tofutf/internal/sql/pggen"
 	"github.com/tofutf/tofutf/internal/team"
+	"github.com/tofutf/tofutf/internal/tokens"
 )
 
 // dbresult represents the result of a database query for a user.
@@ -18,6 +21,7 @@ type dbresult struct {
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 	SiteAdmin pgtype.Bool        `json:"site_admin"`
+	Active    pgtype.Bool        `json:"active"`
 	Teams     []*pggen.Teams     `json:"teams"`
 }
 
@@ -28,6 +32,7 @@ func (result dbresult) toUser() *User {
 		UpdatedAt: result.UpdatedAt.Time.UTC(),
 		Username:  result.Username.String,
 		SiteAdmin: result.SiteAdmin.Bool,
+		Active:    result.Active.Bool,
 	}
 	for _, tr := range result.Teams {
 		user.Teams = append(user.Teams, team.TeamRow(*tr).ToTeam())
@@ -49,6 +54,7 @@ func (db *pgdb) CreateUser(ctx context.Context, user *User) error {
 			Username:  sql.String(user.Username),
 			CreatedAt: sql.Timestamptz(user.CreatedAt),
 			UpdatedAt: sql.Timestamptz(user.UpdatedAt),
+			Active:    sql.Bool(user.Active),
 		})
 		if err != nil {
 			return sql.Error(err)
@@ -117,7 +123,7 @@ func (db *pgdb) getUser(ctx context.Context, spec UserSpec) (*User, error) {
 		if spec.UserID != nil {
 			result, err := q.FindUserByID(ctx, sql.String(*spec.UserID))
 			if err != nil {
-				return nil, err
+				return nil, sql.Error(err)
 			}
 			return dbresult(result).toUser(), nil
 		} else if spec.Username != nil {
@@ -138,6 +144,40 @@ func (db *pgdb) getUser(ctx context.Context, spec UserSpec) (*User, error) {
 	})
 }
 
+// updateUser updates a user, retrieving the user, calling fn to update it, and
+// persisting the update.
+func (db *pgdb) updateUser(ctx context.Context, userID string, fn func(*User) error) (*User, error) {
+	return sql.Tx(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*User, error) {
+		result, err := q.FindUserByID(ctx, sql.String(userID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		user := dbresult(result).toUser()
+		wasActive := user.Active
+
+		if err := fn(user); err != nil {
+			return nil, err
+		}
+
+		_, err = q.UpdateUser(ctx, pggen.UpdateUserParams{
+			UserID:    sql.String(user.ID),
+			Username:  sql.String(user.Username),
+			Active:    sql.Bool(user.Active),
+			UpdatedAt: sql.Timestamptz(user.UpdatedAt),
+		})
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		// revoke the tokens of a deactivated user
+		if wasActive && !user.Active {
+			if _, err := q.DeleteTokensByUsername(ctx, sql.String(user.Username)); err != nil {
+				return nil, sql.Error(err)
+			}
+		}
+		return user, nil
+	})
+}
+
 func (db *pgdb) addTeamMembership(ctx context.Context, teamID string, usernames ...string) error {
 	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
 		_, err := q.InsertTeamMembership(ctx, usernames, sql.String(teamID))
@@ -226,6 +266,48 @@ func pgtextSliceDiff(a, b []pgtype.Text) []string {
 // User tokens
 //
 
+// tokenRow is the row result of a database query for user tokens
+type tokenRow struct {
+	TokenID     pgtype.Text        `json:"token_id"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	Description pgtype.Text        `json:"description"`
+	Username    pgtype.Text        `json:"username"`
+	Expiry      pgtype.Timestamptz `json:"expiry"`
+	Scope       pgtype.Text        `json:"scope"`
+	LastUsedAt  pgtype.Timestamptz `json:"last_used_at"`
+}
+
+func (row tokenRow) toToken() *UserToken {
+	ut := &UserToken{
+		ID:          row.TokenID.String,
+		CreatedAt:   row.CreatedAt.Time.UTC(),
+		Description: row.Description.String,
+		Username:    row.Username.String,
+		Scope:       tokens.Scope(row.Scope.String),
+	}
+	if row.Expiry.Valid {
+		ut.Expiry = internal.Time(row.Expiry.Time.UTC())
+	}
+	if row.LastUsedAt.Valid {
+		ut.LastUsedAt = internal.Time(row.LastUsedAt.Time.UTC())
+	}
+	return ut
+}
+
+func (row tokenRow) toStaleToken() *tokens.StaleToken {
+	ut := row.toToken()
+	return &tokens.StaleToken{
+		ID:          ut.ID,
+		Kind:        UserTokenKind,
+		Owner:       ut.Username,
+		Description: ut.Description,
+		Scope:       ut.Scope,
+		CreatedAt:   ut.CreatedAt,
+		Expiry:      ut.Expiry,
+		LastUsedAt:  ut.LastUsedAt,
+	}
+}
+
 func (db *pgdb) createUserToken(ctx context.Context, token *UserToken) error {
 	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
 		_, err := q.InsertToken(ctx, pggen.InsertTokenParams{
@@ -233,6 +315,8 @@ func (db *pgdb) createUserToken(ctx context.Context, token *UserToken) error {
 			Description: sql.String(token.Description),
 			Username:    sql.String(token.Username),
 			CreatedAt:   sql.Timestamptz(token.CreatedAt),
+			Expiry:      sql.TimestamptzPtr(token.Expiry),
+			Scope:       sql.String(string(token.Scope)),
 		})
 		return err
 	})
@@ -247,12 +331,7 @@ func (db *pgdb) listUserTokens(ctx context.Context, username string) ([]*UserTok
 
 		tokens := make([]*UserToken, len(result))
 		for i, row := range result {
-			tokens[i] = &UserToken{
-				ID:          row.TokenID.String,
-				CreatedAt:   row.CreatedAt.Time.UTC(),
-				Description: row.Description.String,
-				Username:    row.Username.String,
-			}
+			tokens[i] = tokenRow(row).toToken()
 		}
 		return tokens, nil
 	})
@@ -264,12 +343,48 @@ func (db *pgdb) getUserToken(ctx context.Context, id string) (*UserToken, error)
 		if err != nil {
 			return nil, sql.Error(err)
 		}
-		return &UserToken{
-			ID:          row.TokenID.String,
-			CreatedAt:   row.CreatedAt.Time.UTC(),
-			Description: row.Description.String,
-			Username:    row.Username.String,
-		}, nil
+		return tokenRow(row).toToken(), nil
+	})
+}
+
+func (db *pgdb) updateUserTokenLastUsedAt(ctx context.Context, id string, lastUsedAt time.Time) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.UpdateTokenLastUsedAt(ctx, sql.Timestamptz(lastUsedAt), sql.String(id))
+		if err != nil {
+			return sql.Error(err)
+		}
+		return nil
+	})
+}
+
+// findStaleUserTokens finds user tokens that have expired or have not been
+// used since the cutoff, deleting them too if revoke is true.
+func (db *pgdb) findStaleUserTokens(ctx context.Context, cutoff time.Time, revoke bool) ([]*tokens.StaleToken, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]*tokens.StaleToken, error) {
+		var rows []tokenRow
+		if revoke {
+			result, err := q.DeleteStaleTokens(ctx, sql.Timestamptz(cutoff))
+			if err != nil {
+				return nil, sql.Error(err)
+			}
+			for _, r := range result {
+				rows = append(rows, tokenRow(r))
+			}
+		} else {
+			result, err := q.FindStaleTokens(ctx, sql.Timestamptz(cutoff))
+			if err != nil {
+				return nil, sql.Error(err)
+			}
+			for _, r := range result {
+				rows = append(rows, tokenRow(r))
+			}
+		}
+
+		stale := make([]*tokens.StaleToken, len(rows))
+		for i, r := range rows {
+			stale[i] = r.toStaleToken()
+		}
+		return stale, nil
 	})
 }
 
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/tofutf/tofutf/internal"
@@ -100,9 +101,17 @@ func NewService(opts Options) *Service {
 	// Register with auth middleware the user token kind and a means of
 	// retrieving user corresponding to token.
 	opts.TokensService.RegisterKind(UserTokenKind, func(ctx context.Context, tokenID string) (internal.Subject, error) {
-		return svc.GetUser(ctx, UserSpec{AuthenticationTokenID: internal.String(tokenID)})
-
+		user, err := svc.GetUser(ctx, UserSpec{AuthenticationTokenID: internal.String(tokenID)})
+		if err != nil {
+			return nil, err
+		}
+		if !user.Active {
+			return nil, ErrUserDeactivated
+		}
+		return user, nil
 	})
+	opts.TokensService.RegisterUsageRecorder(UserTokenKind, svc.db.updateUserTokenLastUsedAt)
+	opts.TokensService.RegisterStaleTokenFinder(UserTokenKind, svc.db.findStaleUserTokens)
 	// Register with auth middleware the ability to get or create a user given a
 	// username.
 	opts.TokensService.RegisterUISubjectGetterOrCreator(func(ctx context.Context, username string) (internal.Subject, error) {
@@ -110,10 +119,30 @@ func NewService(opts Options) *Service {
 		if errors.Is(err, internal.ErrResourceNotFound) {
 			user, err = svc.Create(ctx, username)
 		}
-
-		return user, err
+		if err != nil {
+			return nil, err
+		}
+		if !user.Active {
+			return nil, ErrUserDeactivated
+		}
+		return user, nil
 
 	})
+	// Register with auth middleware the ability to get the user of a session.
+	opts.TokensService.RegisterUISessionSubjectGetter(func(ctx context.Context, username string, startedAt time.Time) (internal.Subject, error) {
+		user, err := svc.GetUser(ctx, UserSpec{Username: &username})
+		if err != nil {
+			return nil, err
+		}
+		// session timestamps are only accurate to the second
+		if startedAt.Before(user.CreatedAt.Truncate(time.Second)) {
+			return nil, ErrStaleSession
+		}
+		if !user.Active {
+			return nil, ErrUserDeactivated
+		}
+		return user, nil
+	})
 
 	return &svc
 }
@@ -125,7 +154,7 @@ func (a *Service) AddHandlers(r *mux.Router) {
 }
 
 func (a *Service) Create(ctx context.Context, username string, opts ...NewUserOption) (*User, error) {
-	subject, err := a.site.CanAccess(ctx, rbac.CreateUserAction, "")
+	subject, err := a.site.CanAccess(ctx, rbac.CreateUserAction, username)
 	if err != nil {
 		return nil, err
 	}
@@ -204,8 +233,29 @@ func (a *Service) ListTeamUsers(ctx context.Context, teamID string) ([]*User, er
 	return members, nil
 }
 
+// Update updates a user. Deactivating a user prevents them from
+// authenticating and revokes their tokens.
+func (a *Service) Update(ctx context.Context, userID string, opts UpdateUserOptions) (*User, error) {
+	subject, err := a.site.CanAccess(ctx, rbac.UpdateUserAction, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := a.db.updateUser(ctx, userID, func(user *User) error {
+		return user.Update(opts)
+	})
+	if err != nil {
+		a.logger.Error("updating user", "user_id", userID, "subject", subject, "err", err)
+		return nil, err
+	}
+
+	a.logger.Info("updated user", "username", user.Username, "active", user.Active, "subject", subject)
+
+	return user, nil
+}
+
 func (a *Service) Delete(ctx context.Context, username string) error {
-	subject, err := a.site.CanAccess(ctx, rbac.DeleteUserAction, "")
+	subject, err := a.site.CanAccess(ctx, rbac.DeleteUserAction, username)
 	if err != nil {
 		return err
 	}
@@ -319,12 +369,16 @@ func (a *Service) SetSiteAdmins(ctx context.Context, usernames ...string) error
 // User API token endpoints
 
 // CreateToken creates a user token. Only users can create a user token, and
-// they can only create a token for themselves.
+// they can only create a token for themselves. A read-only token cannot be used
+// to create a token.
 func (a *Service) CreateToken(ctx context.Context, opts CreateUserTokenOptions) (*UserToken, []byte, error) {
 	user, err := UserFromContext(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
+	if subject, _ := internal.SubjectFromContext(ctx); internal.IsReadOnly(subject) {
+		return nil, nil, internal.ErrAccessNotPermitted
+	}
 
 	ut, token, err := a.NewUserToken(user.Username, opts)
 	if err != nil {
@@ -356,6 +410,9 @@ func (a *Service) DeleteToken(ctx context.Context, tokenID string) error {
 	if err != nil {
 		return err
 	}
+	if subject, _ := internal.SubjectFromContext(ctx); internal.IsReadOnly(subject) {
+		return internal.ErrAccessNotPermitted
+	}
 
 	token, err := a.db.getUserToken(ctx, tokenID)
 	if err != nil {
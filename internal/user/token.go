@@ -16,12 +16,22 @@ type (
 		CreatedAt   time.Time
 		Description string
 		Username    string // Token belongs to a user
+		// Optional expiry.
+		Expiry *time.Time
+		Scope  tokens.Scope
+		// When the token was last used to authenticate a request. Nil if it
+		// has never been used.
+		LastUsedAt *time.Time
 	}
 
 	// CreateUserTokenOptions are options for creating a user token via the service
 	// endpoint
 	CreateUserTokenOptions struct {
 		Description string
+		// Optional expiry.
+		Expiry *time.Time
+		// Optional scope. Defaults to read-write.
+		Scope tokens.Scope
 	}
 
 	userTokenFactory struct {
@@ -30,15 +40,23 @@ type (
 )
 
 func (f *userTokenFactory) NewUserToken(username string, opts CreateUserTokenOptions) (*UserToken, []byte, error) {
+	scope, err := tokens.ResolveScope(opts.Scope)
+	if err != nil {
+		return nil, nil, err
+	}
 	ut := UserToken{
 		ID:          internal.NewID("ut"),
 		CreatedAt:   internal.CurrentTimestamp(nil),
 		Description: opts.Description,
 		Username:    username,
+		Expiry:      opts.Expiry,
+		Scope:       scope,
 	}
 	token, err := f.tokens.NewToken(tokens.NewTokenOptions{
 		Subject: ut.ID,
 		Kind:    UserTokenKind,
+		Expiry:  opts.Expiry,
+		Scope:   scope,
 	})
 	if err != nil {
 		return nil, nil, err
@@ -3,6 +3,7 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -19,8 +20,17 @@ const (
 )
 
 var (
-	SiteAdmin                  = User{ID: SiteAdminID, Username: SiteAdminUsername}
+	SiteAdmin                  = User{ID: SiteAdminID, Username: SiteAdminUsername, Active: true}
 	_         internal.Subject = (*User)(nil)
+
+	// ErrUserDeactivated is returned when a deactivated user attempts to
+	// authenticate.
+	ErrUserDeactivated = errors.New("user account is deactivated")
+
+	// ErrStaleSession is returned when a session started before the user was
+	// created is used to authenticate, i.e. the session belongs to a previous
+	// user with the same username.
+	ErrStaleSession = errors.New("session was started before the user was created")
 )
 
 type (
@@ -30,6 +40,9 @@ type (
 		CreatedAt time.Time `jsonapi:"attribute" json:"created-at"`
 		UpdatedAt time.Time `jsonapi:"attribute" json:"updated-at"`
 		SiteAdmin bool      `jsonapi:"attribute" json:"site-admin"`
+		// Active is false if the user has been deactivated, in which case
+		// they cannot authenticate.
+		Active bool `jsonapi:"attribute" json:"active"`
 
 		// username is globally unique
 		Username string `jsonapi:"attribute" json:"username"`
@@ -50,6 +63,12 @@ type (
 		Username string `json:"username"`
 	}
 
+	// UpdateUserOptions are options for updating a user.
+	UpdateUserOptions struct {
+		Username *string
+		Active   *bool
+	}
+
 	UserSpec struct {
 		UserID                *string
 		Username              *string
@@ -63,6 +82,7 @@ func NewUser(username string, opts ...NewUserOption) *User {
 		Username:  username,
 		CreatedAt: internal.CurrentTimestamp(nil),
 		UpdatedAt: internal.CurrentTimestamp(nil),
+		Active:    true,
 	}
 	for _, fn := range opts {
 		fn(user)
@@ -76,8 +96,30 @@ func WithTeams(memberships ...*team.Team) NewUserOption {
 	}
 }
 
+// WithActive sets whether the user is active.
+func WithActive(active bool) NewUserOption {
+	return func(user *User) {
+		user.Active = active
+	}
+}
+
 func (u *User) String() string { return u.Username }
 
+// Update updates the user with the given options.
+func (u *User) Update(opts UpdateUserOptions) error {
+	if opts.Username != nil {
+		if *opts.Username == "" {
+			return internal.ErrEmptyValue
+		}
+		u.Username = *opts.Username
+	}
+	if opts.Active != nil {
+		u.Active = *opts.Active
+	}
+	u.UpdatedAt = internal.CurrentTimestamp(nil)
+	return nil
+}
+
 // IsTeamMember determines whether user is a member of the given team.
 func (u *User) IsTeamMember(teamID string) bool {
 	for _, t := range u.Teams {
@@ -208,7 +250,7 @@ func UserFromContext(ctx context.Context) (*User, error) {
 	if err != nil {
 		return nil, err
 	}
-	user, ok := subj.(*User)
+	user, ok := internal.UnwrapSubject(subj).(*User)
 	if !ok {
 		return nil, fmt.Errorf("no user in context")
 	}
@@ -297,12 +297,25 @@ func (h *webHandlers) newUserToken(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *webHandlers) createUserToken(w http.ResponseWriter, r *http.Request) {
-	var opts CreateUserTokenOptions
-	if err := decode.Form(&opts, r); err != nil {
+	var params struct {
+		Description string       `schema:"description"`
+		Expiry      string       `schema:"expiry"`
+		Scope       tokens.Scope `schema:"scope"`
+	}
+	if err := decode.Form(&params, r); err != nil {
 		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
 		return
 	}
-	_, token, err := h.users.CreateToken(r.Context(), opts)
+	expiry, err := tokens.ParseExpiry(params.Expiry)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	_, token, err := h.users.CreateToken(r.Context(), CreateUserTokenOptions{
+		Description: params.Description,
+		Expiry:      expiry,
+		Scope:       params.Scope,
+	})
 	if err != nil {
 		h.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -2,8 +2,10 @@ package variable
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tofutf/tofutf/internal/encryption"
 	"github.com/tofutf/tofutf/internal/sql"
 	"github.com/tofutf/tofutf/internal/sql/pggen"
 )
@@ -12,6 +14,8 @@ type (
 	// pgdb is a database of variables on postgres
 	pgdb struct {
 		*sql.Pool // provides access to generated SQL queries
+
+		keyring *encryption.Keyring // encrypts sensitive variable values
 	}
 
 	variableRow struct {
@@ -23,6 +27,7 @@ type (
 		Sensitive   pgtype.Bool `json:"sensitive"`
 		HCL         pgtype.Bool `json:"hcl"`
 		VersionID   pgtype.Text `json:"version_id"`
+		SecretRef   pgtype.Bool `json:"secret_ref"`
 	}
 
 	variableSetRow struct {
@@ -46,6 +51,7 @@ func (row variableRow) convert() *Variable {
 		Sensitive:   row.Sensitive.Bool,
 		HCL:         row.HCL.Bool,
 		VersionID:   row.VersionID.String,
+		SecretRef:   row.SecretRef.Bool,
 	}
 }
 
@@ -87,6 +93,9 @@ func (pdb *pgdb) listWorkspaceVariables(ctx context.Context, workspaceID string)
 		for i, row := range rows {
 			variables[i] = variableRow(row).convert()
 		}
+		if err := pdb.decrypt(variables...); err != nil {
+			return nil, err
+		}
 
 		return variables, nil
 	})
@@ -99,10 +108,14 @@ func (pdb *pgdb) getWorkspaceVariable(ctx context.Context, variableID string) (*
 			return nil, sql.Error(err)
 		}
 
-		return &WorkspaceVariable{
+		wv := &WorkspaceVariable{
 			WorkspaceID: row.WorkspaceID.String,
 			Variable:    variableRow(*row.Variable).convert(),
-		}, nil
+		}
+		if err := pdb.decrypt(wv.Variable); err != nil {
+			return nil, err
+		}
+		return wv, nil
 	})
 }
 
@@ -113,10 +126,14 @@ func (pdb *pgdb) deleteWorkspaceVariable(ctx context.Context, variableID string)
 			return nil, sql.Error(err)
 		}
 
-		return &WorkspaceVariable{
+		wv := &WorkspaceVariable{
 			WorkspaceID: row.WorkspaceID.String,
 			Variable:    variableRow(*row.Variable).convert(),
-		}, nil
+		}
+		if err := pdb.decrypt(wv.Variable); err != nil {
+			return nil, err
+		}
+		return wv, nil
 	})
 }
 
@@ -167,7 +184,11 @@ func (pdb *pgdb) getVariableSet(ctx context.Context, setID string) (*VariableSet
 			return nil, sql.Error(err)
 		}
 
-		return variableSetRow(row).convert(), nil
+		set := variableSetRow(row).convert()
+		if err := pdb.decrypt(set.Variables...); err != nil {
+			return nil, err
+		}
+		return set, nil
 	})
 }
 
@@ -178,7 +199,11 @@ func (pdb *pgdb) getVariableSetByVariableID(ctx context.Context, variableID stri
 			return nil, sql.Error(err)
 		}
 
-		return variableSetRow(row).convert(), nil
+		set := variableSetRow(row).convert()
+		if err := pdb.decrypt(set.Variables...); err != nil {
+			return nil, err
+		}
+		return set, nil
 	})
 }
 
@@ -192,6 +217,9 @@ func (pdb *pgdb) listVariableSets(ctx context.Context, organization string) ([]*
 		sets := make([]*VariableSet, len(rows))
 		for i, row := range rows {
 			sets[i] = variableSetRow(row).convert()
+			if err := pdb.decrypt(sets[i].Variables...); err != nil {
+				return nil, err
+			}
 		}
 
 		return sets, nil
@@ -208,6 +236,9 @@ func (pdb *pgdb) listVariableSetsByWorkspace(ctx context.Context, workspaceID st
 		sets := make([]*VariableSet, len(rows))
 		for i, row := range rows {
 			sets[i] = variableSetRow(row).convert()
+			if err := pdb.decrypt(sets[i].Variables...); err != nil {
+				return nil, err
+			}
 		}
 
 		return sets, nil
@@ -270,32 +301,42 @@ func (pdb *pgdb) deleteVariableSetWorkspaces(ctx context.Context, setID string,
 }
 
 func (pdb *pgdb) createVariable(ctx context.Context, v *Variable) error {
+	value, err := pdb.encrypt(v)
+	if err != nil {
+		return err
+	}
 	return pdb.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
 		_, err := q.InsertVariable(ctx, pggen.InsertVariableParams{
 			VariableID:  sql.String(v.ID),
 			Key:         sql.String(v.Key),
-			Value:       sql.String(v.Value),
+			Value:       sql.String(value),
 			Description: sql.String(v.Description),
 			Category:    sql.String(string(v.Category)),
 			Sensitive:   sql.Bool(v.Sensitive),
 			VersionID:   sql.String(v.VersionID),
 			HCL:         sql.Bool(v.HCL),
+			SecretRef:   sql.Bool(v.SecretRef),
 		})
 		return sql.Error(err)
 	})
 }
 
 func (pdb *pgdb) updateVariable(ctx context.Context, v *Variable) error {
+	value, err := pdb.encrypt(v)
+	if err != nil {
+		return err
+	}
 	return pdb.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
 		_, err := q.UpdateVariableByID(ctx, pggen.UpdateVariableByIDParams{
 			VariableID:  sql.String(v.ID),
 			Key:         sql.String(v.Key),
-			Value:       sql.String(v.Value),
+			Value:       sql.String(value),
 			Description: sql.String(v.Description),
 			Category:    sql.String(string(v.Category)),
 			Sensitive:   sql.Bool(v.Sensitive),
 			VersionID:   sql.String(v.VersionID),
 			HCL:         sql.Bool(v.HCL),
+			SecretRef:   sql.Bool(v.SecretRef),
 		})
 		return sql.Error(err)
 	})
@@ -307,3 +348,24 @@ func (pdb *pgdb) deleteVariable(ctx context.Context, variableID string) error {
 		return sql.Error(err)
 	})
 }
+
+// encrypt returns the value of the variable to persist, which is encrypted if
+// the variable is sensitive.
+func (pdb *pgdb) encrypt(v *Variable) (string, error) {
+	if !v.Sensitive {
+		return v.Value, nil
+	}
+	return pdb.keyring.EncryptString(v.Value)
+}
+
+// decrypt decrypts the values of variables that were encrypted when persisted.
+func (pdb *pgdb) decrypt(variables ...*Variable) error {
+	for _, v := range variables {
+		value, err := pdb.keyring.DecryptString(v.Value)
+		if err != nil {
+			return fmt.Errorf("decrypting variable %s: %w", v.ID, err)
+		}
+		v.Value = value
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/encryption"
 	"github.com/tofutf/tofutf/internal/http/html"
 	"github.com/tofutf/tofutf/internal/organization"
 	"github.com/tofutf/tofutf/internal/rbac"
@@ -25,6 +26,7 @@ type (
 		api          *api
 		workspace    internal.Authorizer
 		organization internal.Authorizer
+		workspaces   workspaceClient
 		runs         runClient
 	}
 
@@ -33,6 +35,7 @@ type (
 		WorkspaceService    *workspace.Service
 		RunClient           runClient
 		Logger              *slog.Logger
+		Keyring             *encryption.Keyring
 
 		*sql.Pool
 		*tfeapi.Responder
@@ -42,14 +45,19 @@ type (
 	runClient interface {
 		Get(ctx context.Context, runID string) (*run.Run, error)
 	}
+
+	workspaceClient interface {
+		Get(ctx context.Context, workspaceID string) (*workspace.Workspace, error)
+	}
 )
 
 func NewService(opts Options) *Service {
 	svc := Service{
 		logger:       opts.Logger,
-		db:           &pgdb{opts.Pool},
+		db:           &pgdb{Pool: opts.Pool, keyring: opts.Keyring},
 		workspace:    opts.WorkspaceAuthorizer,
 		organization: &organization.Authorizer{Logger: opts.Logger},
+		workspaces:   opts.WorkspaceService,
 		runs:         opts.RunClient,
 	}
 
@@ -98,6 +106,11 @@ func (s *Service) CreateWorkspaceVariable(ctx context.Context, workspaceID strin
 		return nil, err
 	}
 
+	ws, err := s.workspaces.Get(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
 	var v *Variable
 	err = s.db.Lock(ctx, "variables", func(ctx context.Context, q pggen.Querier) (err error) {
 		workspaceVars, err := s.ListWorkspaceVariables(ctx, workspaceID)
@@ -109,6 +122,9 @@ func (s *Service) CreateWorkspaceVariable(ctx context.Context, workspaceID strin
 		if err != nil {
 			return err
 		}
+		if err := v.checkSecretRef(ws.Organization); err != nil {
+			return err
+		}
 
 		if err := s.db.createWorkspaceVariable(ctx, workspaceID, v); err != nil {
 			return err
@@ -147,11 +163,19 @@ func (s *Service) UpdateWorkspaceVariable(ctx context.Context, variableID string
 			return err
 		}
 
+		ws, err := s.workspaces.Get(ctx, before.WorkspaceID)
+		if err != nil {
+			return err
+		}
+
 		// update a copy of v
 		after = *before
 		if err := after.update(workspaceVariables, opts); err != nil {
 			return err
 		}
+		if err := after.checkSecretRef(ws.Organization); err != nil {
+			return err
+		}
 
 		if err := s.db.updateVariable(ctx, after.Variable); err != nil {
 			return err
@@ -60,6 +60,9 @@ func (s *VariableSet) addVariable(organizationSets []*VariableSet, opts CreateVa
 	if err != nil {
 		return nil, err
 	}
+	if err := v.checkSecretRef(s.Organization); err != nil {
+		return nil, err
+	}
 	if err := s.checkGlobalConflicts(organizationSets); err != nil {
 		return nil, err
 	}
@@ -74,6 +77,9 @@ func (s *VariableSet) updateVariable(organizationSets []*VariableSet, variableID
 	if err := v.update(s.Variables, opts); err != nil {
 		return nil, err
 	}
+	if err := v.checkSecretRef(s.Organization); err != nil {
+		return nil, err
+	}
 	if err := s.checkGlobalConflicts(organizationSets); err != nil {
 		return nil, err
 	}
@@ -64,6 +64,7 @@ func (a *tfe) createWorkspaceVariable(w http.ResponseWriter, r *http.Request) {
 		Category:    (*VariableCategory)(opts.Category),
 		Sensitive:   opts.Sensitive,
 		HCL:         opts.HCL,
+		SecretRef:   opts.SecretRef,
 	})
 	if err != nil {
 		variableError(w, err)
@@ -125,6 +126,7 @@ func (a *tfe) update(w http.ResponseWriter, r *http.Request) {
 		Category:    (*VariableCategory)(opts.Category),
 		Sensitive:   opts.Sensitive,
 		HCL:         opts.HCL,
+		SecretRef:   opts.SecretRef,
 	})
 	if err != nil {
 		tfeapi.Error(w, err)
@@ -306,6 +308,7 @@ func (a *tfe) addVariableToSet(w http.ResponseWriter, r *http.Request) {
 		Category:    (*VariableCategory)(opts.Category),
 		Sensitive:   opts.Sensitive,
 		HCL:         opts.HCL,
+		SecretRef:   opts.SecretRef,
 	})
 	if err != nil {
 		variableError(w, err)
@@ -334,6 +337,7 @@ func (a *tfe) updateVariableSetVariable(w http.ResponseWriter, r *http.Request)
 		Category:    (*VariableCategory)(opts.Category),
 		Sensitive:   opts.Sensitive,
 		HCL:         opts.HCL,
+		SecretRef:   opts.SecretRef,
 	})
 	if err != nil {
 		variableError(w, err)
@@ -479,6 +483,7 @@ func (a *tfe) convertVariable(from *Variable, scrubSensitiveValue bool) *types.V
 		Sensitive:   from.Sensitive,
 		HCL:         from.HCL,
 		VersionID:   from.VersionID,
+		SecretRef:   from.SecretRef,
 	}
 	if to.Sensitive && scrubSensitiveValue {
 		to.Value = "" // scrub sensitive values
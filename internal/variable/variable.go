@@ -14,6 +14,7 @@ import (
 
 	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/run"
+	"github.com/tofutf/tofutf/internal/secrets"
 	"golang.org/x/exp/maps"
 )
 
@@ -40,6 +41,9 @@ type (
 		Category    VariableCategory `jsonapi:"attribute" json:"category"`
 		Sensitive   bool             `jsonapi:"attribute" json:"sensitive"`
 		HCL         bool             `jsonapi:"attribute" json:"hcl"`
+		// SecretRef is true if the value is a reference to a secret held
+		// outside of tofutf, which is resolved when a run is executed.
+		SecretRef bool `jsonapi:"attribute" json:"secret_ref"`
 
 		// OTF doesn't use this internally but the go-tfe integration tests
 		// expect it to be a random value that changes on every update.
@@ -58,6 +62,7 @@ type (
 		Category    *VariableCategory
 		Sensitive   *bool
 		HCL         *bool
+		SecretRef   *bool
 
 		generateVersion
 	}
@@ -69,6 +74,7 @@ type (
 		Category    *VariableCategory
 		Sensitive   *bool
 		HCL         *bool
+		SecretRef   *bool
 
 		generateVersion
 	}
@@ -117,6 +123,9 @@ func newVariable(collection []*Variable, opts CreateVariableOptions) (*Variable,
 	if opts.HCL != nil {
 		v.HCL = *opts.HCL
 	}
+	if opts.SecretRef != nil {
+		v.SecretRef = *opts.SecretRef
+	}
 	if err := v.checkConflicts(collection); err != nil {
 		return nil, err
 	}
@@ -181,6 +190,9 @@ func (v *Variable) update(collection []*Variable, opts UpdateVariableOptions) er
 			return err
 		}
 	}
+	if opts.SecretRef != nil {
+		v.SecretRef = *opts.SecretRef
+	}
 	// check for conflicts with other variables in collection
 	if err := v.checkConflicts(collection); err != nil {
 		return err
@@ -210,6 +222,21 @@ func (v *Variable) setValue(value string) error {
 	return nil
 }
 
+// checkSecretRef checks that the value of a variable that references a secret
+// is a well-formed reference to a secret belonging to the organization. The
+// reference is resolved when a run is executed, but it is checked now so that
+// an invalid reference is reported to the user.
+func (v *Variable) checkSecretRef(organization string) error {
+	if !v.SecretRef {
+		return nil
+	}
+	ref, err := secrets.ParseReference(v.Value)
+	if err != nil {
+		return err
+	}
+	return ref.CheckScope(organization)
+}
+
 func (v *Variable) setDescription(desc string) error {
 	if len(desc) > VariableDescriptionMaxChars {
 		return ErrVariableDescriptionMaxExceeded
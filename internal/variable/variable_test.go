@@ -91,6 +91,38 @@ func TestVariable_Update(t *testing.T) {
 				HCL:      true,
 			},
 		},
+		{
+			name: "literal resembling secret reference",
+			opts: UpdateVariableOptions{Value: internal.String("file:///tmp/x")},
+			before: Variable{
+				Key:      "foo",
+				Value:    "bar",
+				Category: CategoryTerraform,
+			},
+			after: Variable{
+				Key:      "foo",
+				Value:    "file:///tmp/x",
+				Category: CategoryTerraform,
+			},
+		},
+		{
+			name: "secret reference",
+			opts: UpdateVariableOptions{
+				Value:     internal.String("vault://secret/data/tofutf/acme/app#password"),
+				SecretRef: internal.Bool(true),
+			},
+			before: Variable{
+				Key:      "foo",
+				Value:    "bar",
+				Category: CategoryTerraform,
+			},
+			after: Variable{
+				Key:       "foo",
+				Value:     "vault://secret/data/tofutf/acme/app#password",
+				Category:  CategoryTerraform,
+				SecretRef: true,
+			},
+		},
 		{
 			name: "sensitive to non-sensitive",
 			opts: UpdateVariableOptions{Sensitive: internal.Bool(false)},
@@ -333,3 +365,27 @@ func Test_mergeVariables(t *testing.T) {
 		})
 	}
 }
+
+func TestVariable_checkSecretRef(t *testing.T) {
+	tests := []struct {
+		name     string
+		variable Variable
+		err      bool
+	}{
+		{"literal", Variable{Value: "env://FOO"}, false},
+		{"secret reference", Variable{Value: "vault://secret/data/tofutf/acme/app#password", SecretRef: true}, false},
+		{"malformed secret reference", Variable{Value: "vault://secret/data/tofutf/acme/app", SecretRef: true}, true},
+		{"not a secret reference", Variable{Value: "bar", SecretRef: true}, true},
+		{"other organization's secret", Variable{Value: "vault://secret/data/tofutf/other/app#password", SecretRef: true}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.variable.checkSecretRef("acme")
+			if tt.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
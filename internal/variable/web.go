@@ -63,6 +63,7 @@ type (
 		Category    *VariableCategory `schema:"category,required"`
 		Sensitive   bool
 		HCL         bool
+		SecretRef   bool `schema:"secret_ref"`
 	}
 
 	updateVariableParams struct {
@@ -72,6 +73,7 @@ type (
 		Category    *VariableCategory
 		Sensitive   *bool
 		HCL         bool
+		SecretRef   bool   `schema:"secret_ref"`
 		VariableID  string `schema:"variable_id,required"`
 	}
 
@@ -154,6 +156,7 @@ func (h *web) createWorkspaceVariable(w http.ResponseWriter, r *http.Request) {
 		Category:    params.Category,
 		Sensitive:   &params.Sensitive,
 		HCL:         &params.HCL,
+		SecretRef:   &params.SecretRef,
 	})
 	if err != nil {
 		html.FlashError(w, err.Error())
@@ -274,6 +277,7 @@ func (h *web) updateWorkspaceVariable(w http.ResponseWriter, r *http.Request) {
 		Category:    params.Category,
 		Sensitive:   params.Sensitive,
 		HCL:         &params.HCL,
+		SecretRef:   &params.SecretRef,
 	})
 	if err != nil {
 		html.FlashError(w, err.Error())
@@ -571,6 +575,7 @@ func (h *web) createVariableSetVariable(w http.ResponseWriter, r *http.Request)
 		Category:    params.Category,
 		Sensitive:   &params.Sensitive,
 		HCL:         &params.HCL,
+		SecretRef:   &params.SecretRef,
 	})
 	if err != nil {
 		html.FlashError(w, err.Error())
@@ -625,6 +630,7 @@ func (h *web) updateVariableSetVariable(w http.ResponseWriter, r *http.Request)
 		Category:    params.Category,
 		Sensitive:   params.Sensitive,
 		HCL:         &params.HCL,
+		SecretRef:   &params.SecretRef,
 	})
 	if err != nil {
 		html.FlashError(w, err.Error())
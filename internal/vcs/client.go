@@ -18,6 +18,12 @@ type (
 		GetWebhook(ctx context.Context, opts GetWebhookOptions) (Webhook, error)
 		DeleteWebhook(ctx context.Context, opts DeleteWebhookOptions) error
 		SetStatus(ctx context.Context, opts SetStatusOptions) error
+		// CreatePullRequestComment creates a comment on a pull request,
+		// returning the provider's unique ID for the comment.
+		CreatePullRequestComment(ctx context.Context, opts CreatePullRequestCommentOptions) (string, error)
+		// UpdatePullRequestComment replaces the body of an existing comment on a
+		// pull request.
+		UpdatePullRequestComment(ctx context.Context, opts UpdatePullRequestCommentOptions) error
 		// ListTags lists git tags on a repository. Each tag should be prefixed with
 		// 'tags/'.
 		ListTags(ctx context.Context, opts ListTagsOptions) ([]string, error)
@@ -89,6 +95,23 @@ type (
 		Description string
 	}
 
+	// CreatePullRequestCommentOptions are options for creating a comment on a
+	// pull request
+	CreatePullRequestCommentOptions struct {
+		Repo              string // <owner>/<repo>
+		PullRequestNumber int
+		Body              string // markdown
+	}
+
+	// UpdatePullRequestCommentOptions are options for updating a comment on a
+	// pull request
+	UpdatePullRequestCommentOptions struct {
+		Repo              string // <owner>/<repo>
+		PullRequestNumber int
+		ID                string // vcs' comment ID
+		Body              string // markdown
+	}
+
 	Repository struct {
 		Path          string
 		DefaultBranch string
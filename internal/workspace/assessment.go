@@ -0,0 +1,42 @@
+package workspace
+
+import "time"
+
+const (
+	// AssessmentPending indicates the assessment run has yet to finish.
+	AssessmentPending AssessmentStatus = "pending"
+	// AssessmentDrifted indicates the real infrastructure differs from the
+	// workspace's state.
+	AssessmentDrifted AssessmentStatus = "drifted"
+	// AssessmentNoDrift indicates the real infrastructure matches the
+	// workspace's state.
+	AssessmentNoDrift AssessmentStatus = "no_drift"
+	// AssessmentErrored indicates the assessment run failed or was canceled
+	// before it finished.
+	AssessmentErrored AssessmentStatus = "errored"
+	// AssessmentSkipped indicates the workspace could not be assessed because
+	// it has no configuration.
+	AssessmentSkipped AssessmentStatus = "skipped"
+)
+
+type (
+	// Assessment is a health assessment of a workspace, performed by a
+	// refresh-only plan that detects whether the real infrastructure has
+	// drifted from the workspace's state.
+	Assessment struct {
+		// RunID is the ID of the assessment run; empty if the assessment was
+		// skipped.
+		RunID     string
+		CreatedAt time.Time
+		Status    AssessmentStatus
+	}
+
+	// AssessmentStatus is the outcome of a health assessment.
+	AssessmentStatus string
+)
+
+// Drifted determines whether the latest assessment of the workspace detected
+// drift.
+func (ws *Workspace) Drifted() bool {
+	return ws.LatestAssessment != nil && ws.LatestAssessment.Status == AssessmentDrifted
+}
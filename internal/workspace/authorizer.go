@@ -27,6 +27,7 @@ func (a *authorizer) CanAccess(ctx context.Context, action rbac.Action, workspac
 		return nil, internal.ErrResourceNotFound
 	}
 	if subj.CanAccessWorkspace(action, policy) {
+		internal.RecordAuthorized(ctx, subj, action, workspaceID, policy.Organization)
 		return subj, nil
 	}
 	a.logger.Error("unauthorized action", "workspace_id", workspaceID, "organization", policy.Organization, "action", action.String(), "subject", subj)
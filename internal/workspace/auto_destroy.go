@@ -0,0 +1,72 @@
+package workspace
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// reActivityDuration matches an inactivity duration in the format used by
+// TFE: a number of days or hours, e.g. 14d or 2h.
+var reActivityDuration = regexp.MustCompile(`^([1-9][0-9]{0,3})([dh])$`)
+
+// ParseActivityDuration parses an inactivity duration such as 14d or 2h.
+func ParseActivityDuration(s string) (time.Duration, error) {
+	matches := reActivityDuration.FindStringSubmatch(s)
+	if matches == nil {
+		return 0, ErrInvalidAutoDestroyActivityDuration
+	}
+	n, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, ErrInvalidAutoDestroyActivityDuration
+	}
+	if matches[2] == "d" {
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.Duration(n) * time.Hour, nil
+}
+
+// AutoDestroyEnabled determines whether the workspace has either an
+// auto-destroy deadline or an inactivity duration.
+func (ws *Workspace) AutoDestroyEnabled() bool {
+	return ws.AutoDestroyAt != nil || ws.AutoDestroyActivityDuration != nil
+}
+
+// AutoDestroyDue determines whether the workspace's resources are due to be
+// auto-destroyed, either because its deadline has passed or because it has
+// been inactive since lastActivity for longer than its inactivity duration.
+func (ws *Workspace) AutoDestroyDue(lastActivity, now time.Time) bool {
+	if ws.AutoDestroyAt != nil && !now.Before(*ws.AutoDestroyAt) {
+		return true
+	}
+	if ws.AutoDestroyActivityDuration != nil {
+		// duration is validated before it is persisted
+		d, err := ParseActivityDuration(*ws.AutoDestroyActivityDuration)
+		if err != nil {
+			return false
+		}
+		if !now.Before(lastActivity.Add(d)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ws *Workspace) setAutoDestroyAt(t time.Time) {
+	t = t.UTC()
+	ws.AutoDestroyAt = &t
+}
+
+// setAutoDestroyActivityDuration sets the inactivity duration; an empty
+// string unsets it.
+func (ws *Workspace) setAutoDestroyActivityDuration(duration string) error {
+	if duration == "" {
+		ws.AutoDestroyActivityDuration = nil
+		return nil
+	}
+	if _, err := ParseActivityDuration(duration); err != nil {
+		return err
+	}
+	ws.AutoDestroyActivityDuration = &duration
+	return nil
+}
@@ -0,0 +1,121 @@
+package workspace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+)
+
+func TestParseActivityDuration(t *testing.T) {
+	tests := []struct {
+		duration string
+		want     time.Duration
+		wantErr  bool
+	}{
+		{"14d", 14 * 24 * time.Hour, false},
+		{"2h", 2 * time.Hour, false},
+		{"9999h", 9999 * time.Hour, false},
+		{"0d", 0, true},
+		{"10000h", 0, true},
+		{"2m", 0, true},
+		{"1.5h", 0, true},
+		{"d", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.duration, func(t *testing.T) {
+			got, err := ParseActivityDuration(tt.duration)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidAutoDestroyActivityDuration)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWorkspace_AutoDestroyDue(t *testing.T) {
+	now := time.Date(2024, 5, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		ws           *Workspace
+		lastActivity time.Time
+		want         bool
+	}{
+		{
+			name: "disabled",
+			ws:   &Workspace{},
+			want: false,
+		},
+		{
+			name: "deadline passed",
+			ws:   &Workspace{AutoDestroyAt: internal.Time(now.Add(-time.Minute))},
+			want: true,
+		},
+		{
+			name: "deadline not yet passed",
+			ws:   &Workspace{AutoDestroyAt: internal.Time(now.Add(time.Minute))},
+			want: false,
+		},
+		{
+			name:         "inactive",
+			ws:           &Workspace{AutoDestroyActivityDuration: internal.String("2h")},
+			lastActivity: now.Add(-3 * time.Hour),
+			want:         true,
+		},
+		{
+			name:         "active",
+			ws:           &Workspace{AutoDestroyActivityDuration: internal.String("2h")},
+			lastActivity: now.Add(-time.Hour),
+			want:         false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.ws.AutoDestroyDue(tt.lastActivity, now))
+		})
+	}
+}
+
+func TestWorkspace_UpdateAutoDestroy(t *testing.T) {
+	deadline := time.Date(2024, 5, 18, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	ws, err := NewWorkspace(CreateOptions{
+		Name:                        internal.String("preview"),
+		Organization:                internal.String("acme"),
+		AutoDestroyAt:               &deadline,
+		AutoDestroyActivityDuration: internal.String("14d"),
+		DeleteAfterAutoDestroy:      internal.Bool(true),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, deadline.UTC(), *ws.AutoDestroyAt)
+	assert.Equal(t, time.UTC, ws.AutoDestroyAt.Location())
+	assert.Equal(t, "14d", *ws.AutoDestroyActivityDuration)
+	assert.True(t, ws.DeleteAfterAutoDestroy)
+
+	t.Run("invalid activity duration", func(t *testing.T) {
+		_, err := ws.Update(UpdateOptions{AutoDestroyActivityDuration: internal.String("2 weeks")})
+		assert.ErrorIs(t, err, ErrInvalidAutoDestroyActivityDuration)
+	})
+
+	t.Run("clear and set deadline together", func(t *testing.T) {
+		_, err := ws.Update(UpdateOptions{AutoDestroyAt: &deadline, ClearAutoDestroyAt: true})
+		assert.Error(t, err)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		_, err := ws.Update(UpdateOptions{
+			ClearAutoDestroyAt:          true,
+			AutoDestroyActivityDuration: internal.String(""),
+		})
+		require.NoError(t, err)
+		assert.Nil(t, ws.AutoDestroyAt)
+		assert.Nil(t, ws.AutoDestroyActivityDuration)
+		assert.False(t, ws.AutoDestroyEnabled())
+	})
+}
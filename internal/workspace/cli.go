@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	otfapi "github.com/tofutf/tofutf/internal/api"
+	"github.com/tofutf/tofutf/internal/releases"
 
 	"github.com/spf13/cobra"
 	"github.com/tofutf/tofutf/internal/resource"
@@ -47,7 +48,10 @@ func NewCommand(apiClient *otfapi.Client) *cobra.Command {
 }
 
 func (a *CLI) workspaceListCommand() *cobra.Command {
-	var org string
+	var (
+		org       string
+		projectID string
+	)
 
 	cmd := &cobra.Command{
 		Use:           "list",
@@ -56,10 +60,14 @@ func (a *CLI) workspaceListCommand() *cobra.Command {
 		SilenceErrors: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			list, err := resource.ListAll(func(opts resource.PageOptions) (*resource.Page[*Workspace], error) {
-				return a.client.List(cmd.Context(), ListOptions{
+				listOpts := ListOptions{
 					PageOptions:  opts,
 					Organization: &org,
-				})
+				}
+				if projectID != "" {
+					listOpts.ProjectID = &projectID
+				}
+				return a.client.List(cmd.Context(), listOpts)
 			})
 			if err != nil {
 				return fmt.Errorf("retrieving existing workspaces: %w", err)
@@ -74,6 +82,7 @@ func (a *CLI) workspaceListCommand() *cobra.Command {
 
 	cmd.Flags().StringVar(&org, "organization", "", "Organization workspace belongs to")
 	cmd.MarkFlagRequired("organization") //nolint:errcheck
+	cmd.Flags().StringVar(&projectID, "project-id", "", "Only list workspaces belonging to this project")
 
 	return cmd
 }
@@ -117,6 +126,8 @@ func (a *CLI) workspaceEditCommand() *cobra.Command {
 		opts         UpdateOptions
 		mode         string
 		poolID       string
+		engine       string
+		version      string
 	)
 
 	cmd := &cobra.Command{
@@ -133,6 +144,12 @@ func (a *CLI) workspaceEditCommand() *cobra.Command {
 			if poolID != "" {
 				opts.AgentPoolID = &poolID
 			}
+			if engine != "" {
+				opts.Engine = (*releases.Engine)(&engine)
+			}
+			if version != "" {
+				opts.TerraformVersion = &version
+			}
 			ws, err := a.client.GetByName(cmd.Context(), organization, name)
 			if err != nil {
 				return err
@@ -148,6 +165,8 @@ func (a *CLI) workspaceEditCommand() *cobra.Command {
 
 	cmd.Flags().StringVarP(&mode, "execution-mode", "m", "", "Which execution mode to use. Valid values are remote, local, and agent")
 	cmd.Flags().StringVar(&poolID, "agent-pool-id", "", "ID of the agent pool to use for runs. Required if execution-mode is set to agent.")
+	cmd.Flags().StringVar(&engine, "engine", "", "Which engine to use for runs. Valid values are terraform and tofu.")
+	cmd.Flags().StringVar(&version, "terraform-version", "", "Version of the engine to use for runs, or 'latest'.")
 
 	cmd.Flags().StringVar(&organization, "organization", "", "Organization workspace belongs to")
 	cmd.MarkFlagRequired("organization") //nolint:errcheck
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
 )
 
 func TestWorkspaceEdit(t *testing.T) {
@@ -74,6 +75,21 @@ func TestWorkspaceList(t *testing.T) {
 	want := fmt.Sprintf("%s\n%s\n", ws1.Name, ws2.Name)
 	assert.Equal(t, want, got.String())
 
+	t.Run("filter by project", func(t *testing.T) {
+		app := &CLI{
+			client: &FakeService{Workspaces: []*Workspace{
+				{ID: "ws-1", Name: "dev", ProjectID: internal.String("prj-123")},
+				{ID: "ws-2", Name: "prod"},
+			}},
+		}
+		cmd := app.workspaceListCommand()
+		cmd.SetArgs([]string{"--organization", "acme-corp", "--project-id", "prj-123"})
+		got := bytes.Buffer{}
+		cmd.SetOut(&got)
+		require.NoError(t, cmd.Execute())
+		assert.Equal(t, "dev\n", got.String())
+	})
+
 	t.Run("missing organization", func(t *testing.T) {
 		cmd := app.workspaceListCommand()
 		cmd.SetArgs([]string{"automatize"})
@@ -3,9 +3,11 @@ package workspace
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/releases"
 	"github.com/tofutf/tofutf/internal/resource"
 	"github.com/tofutf/tofutf/internal/sql"
 	"github.com/tofutf/tofutf/internal/sql/pggen"
@@ -19,41 +21,54 @@ type (
 
 	// pgresult represents the result of a database query for a workspace.
 	pgresult struct {
-		WorkspaceID                pgtype.Text            `json:"workspace_id"`
-		CreatedAt                  pgtype.Timestamptz     `json:"created_at"`
-		UpdatedAt                  pgtype.Timestamptz     `json:"updated_at"`
-		AllowDestroyPlan           pgtype.Bool            `json:"allow_destroy_plan"`
-		AutoApply                  pgtype.Bool            `json:"auto_apply"`
-		CanQueueDestroyPlan        pgtype.Bool            `json:"can_queue_destroy_plan"`
-		Description                pgtype.Text            `json:"description"`
-		Environment                pgtype.Text            `json:"environment"`
-		ExecutionMode              pgtype.Text            `json:"execution_mode"`
-		GlobalRemoteState          pgtype.Bool            `json:"global_remote_state"`
-		MigrationEnvironment       pgtype.Text            `json:"migration_environment"`
-		Name                       pgtype.Text            `json:"name"`
-		QueueAllRuns               pgtype.Bool            `json:"queue_all_runs"`
-		SpeculativeEnabled         pgtype.Bool            `json:"speculative_enabled"`
-		SourceName                 pgtype.Text            `json:"source_name"`
-		SourceURL                  pgtype.Text            `json:"source_url"`
-		StructuredRunOutputEnabled pgtype.Bool            `json:"structured_run_output_enabled"`
-		TerraformVersion           pgtype.Text            `json:"terraform_version"`
-		TriggerPrefixes            []string               `json:"trigger_prefixes"`
-		WorkingDirectory           pgtype.Text            `json:"working_directory"`
-		LockRunID                  pgtype.Text            `json:"lock_run_id"`
-		LatestRunID                pgtype.Text            `json:"latest_run_id"`
-		OrganizationName           pgtype.Text            `json:"organization_name"`
-		Branch                     pgtype.Text            `json:"branch"`
-		LockUsername               pgtype.Text            `json:"lock_username"`
-		CurrentStateVersionID      pgtype.Text            `json:"current_state_version_id"`
-		TriggerPatterns            []string               `json:"trigger_patterns"`
-		VCSTagsRegex               pgtype.Text            `json:"vcs_tags_regex"`
-		AllowCLIApply              pgtype.Bool            `json:"allow_cli_apply"`
-		AgentPoolID                pgtype.Text            `json:"agent_pool_id"`
-		Tags                       []string               `json:"tags"`
-		LatestRunStatus            pgtype.Text            `json:"latest_run_status"`
-		UserLock                   *pggen.Users           `json:"user_lock"`
-		RunLock                    *pggen.Runs            `json:"run_lock"`
-		WorkspaceConnection        *pggen.RepoConnections `json:"workspace_connection"`
+		WorkspaceID                 pgtype.Text            `json:"workspace_id"`
+		CreatedAt                   pgtype.Timestamptz     `json:"created_at"`
+		UpdatedAt                   pgtype.Timestamptz     `json:"updated_at"`
+		AllowDestroyPlan            pgtype.Bool            `json:"allow_destroy_plan"`
+		AutoApply                   pgtype.Bool            `json:"auto_apply"`
+		CanQueueDestroyPlan         pgtype.Bool            `json:"can_queue_destroy_plan"`
+		Description                 pgtype.Text            `json:"description"`
+		Environment                 pgtype.Text            `json:"environment"`
+		ExecutionMode               pgtype.Text            `json:"execution_mode"`
+		GlobalRemoteState           pgtype.Bool            `json:"global_remote_state"`
+		MigrationEnvironment        pgtype.Text            `json:"migration_environment"`
+		Name                        pgtype.Text            `json:"name"`
+		QueueAllRuns                pgtype.Bool            `json:"queue_all_runs"`
+		SpeculativeEnabled          pgtype.Bool            `json:"speculative_enabled"`
+		SourceName                  pgtype.Text            `json:"source_name"`
+		SourceURL                   pgtype.Text            `json:"source_url"`
+		StructuredRunOutputEnabled  pgtype.Bool            `json:"structured_run_output_enabled"`
+		TerraformVersion            pgtype.Text            `json:"terraform_version"`
+		TriggerPrefixes             []string               `json:"trigger_prefixes"`
+		WorkingDirectory            pgtype.Text            `json:"working_directory"`
+		LockRunID                   pgtype.Text            `json:"lock_run_id"`
+		LatestRunID                 pgtype.Text            `json:"latest_run_id"`
+		OrganizationName            pgtype.Text            `json:"organization_name"`
+		Branch                      pgtype.Text            `json:"branch"`
+		LockUsername                pgtype.Text            `json:"lock_username"`
+		CurrentStateVersionID       pgtype.Text            `json:"current_state_version_id"`
+		TriggerPatterns             []string               `json:"trigger_patterns"`
+		VCSTagsRegex                pgtype.Text            `json:"vcs_tags_regex"`
+		AllowCLIApply               pgtype.Bool            `json:"allow_cli_apply"`
+		AgentPoolID                 pgtype.Text            `json:"agent_pool_id"`
+		Engine                      pgtype.Text            `json:"engine"`
+		AssessmentsEnabled          pgtype.Bool            `json:"assessments_enabled"`
+		LatestAssessmentRunID       pgtype.Text            `json:"latest_assessment_run_id"`
+		LatestAssessmentStatus      pgtype.Text            `json:"latest_assessment_status"`
+		LatestAssessmentCreatedAt   pgtype.Timestamptz     `json:"latest_assessment_created_at"`
+		AutoDestroyAt               pgtype.Timestamptz     `json:"auto_destroy_at"`
+		AutoDestroyActivityDuration pgtype.Text            `json:"auto_destroy_activity_duration"`
+		DeleteAfterAutoDestroy      pgtype.Bool            `json:"delete_after_auto_destroy"`
+		AutoDestroyRunID            pgtype.Text            `json:"auto_destroy_run_id"`
+		ProjectID                   pgtype.Text            `json:"project_id"`
+		AgentSelector               pgtype.Text            `json:"agent_selector"`
+		PlanTimeout                 pgtype.Int4            `json:"plan_timeout"`
+		ApplyTimeout                pgtype.Int4            `json:"apply_timeout"`
+		Tags                        []string               `json:"tags"`
+		LatestRunStatus             pgtype.Text            `json:"latest_run_status"`
+		UserLock                    *pggen.Users           `json:"user_lock"`
+		RunLock                     *pggen.Runs            `json:"run_lock"`
+		WorkspaceConnection         *pggen.RepoConnections `json:"workspace_connection"`
 	}
 )
 
@@ -63,9 +78,11 @@ func (r pgresult) toWorkspace() (*Workspace, error) {
 		CreatedAt:                  r.CreatedAt.Time.UTC(),
 		UpdatedAt:                  r.UpdatedAt.Time.UTC(),
 		AllowDestroyPlan:           r.AllowDestroyPlan.Bool,
+		AssessmentsEnabled:         r.AssessmentsEnabled.Bool,
 		AutoApply:                  r.AutoApply.Bool,
 		CanQueueDestroyPlan:        r.CanQueueDestroyPlan.Bool,
 		Description:                r.Description.String,
+		Engine:                     releases.Engine(r.Engine.String),
 		Environment:                r.Environment.String,
 		ExecutionMode:              ExecutionMode(r.ExecutionMode.String),
 		GlobalRemoteState:          r.GlobalRemoteState.Bool,
@@ -86,6 +103,29 @@ func (r pgresult) toWorkspace() (*Workspace, error) {
 	if r.AgentPoolID.Valid {
 		ws.AgentPoolID = &r.AgentPoolID.String
 	}
+	if r.AutoDestroyAt.Valid {
+		autoDestroyAt := r.AutoDestroyAt.Time.UTC()
+		ws.AutoDestroyAt = &autoDestroyAt
+	}
+	if r.AutoDestroyActivityDuration.Valid {
+		ws.AutoDestroyActivityDuration = &r.AutoDestroyActivityDuration.String
+	}
+	ws.DeleteAfterAutoDestroy = r.DeleteAfterAutoDestroy.Bool
+	if r.AutoDestroyRunID.Valid {
+		ws.AutoDestroyRunID = &r.AutoDestroyRunID.String
+	}
+	if r.ProjectID.Valid {
+		ws.ProjectID = &r.ProjectID.String
+	}
+	ws.AgentSelector = r.AgentSelector.String
+	if r.PlanTimeout.Valid {
+		planTimeout := int(r.PlanTimeout.Int32)
+		ws.PlanTimeout = &planTimeout
+	}
+	if r.ApplyTimeout.Valid {
+		applyTimeout := int(r.ApplyTimeout.Int32)
+		ws.ApplyTimeout = &applyTimeout
+	}
 
 	if r.WorkspaceConnection != nil {
 		ws.Connection = &Connection{
@@ -99,6 +139,14 @@ func (r pgresult) toWorkspace() (*Workspace, error) {
 		}
 	}
 
+	if r.LatestAssessmentCreatedAt.Valid {
+		ws.LatestAssessment = &Assessment{
+			RunID:     r.LatestAssessmentRunID.String,
+			CreatedAt: r.LatestAssessmentCreatedAt.Time.UTC(),
+			Status:    AssessmentStatus(r.LatestAssessmentStatus.String),
+		}
+	}
+
 	if r.LatestRunID.Valid && r.LatestRunStatus.Valid {
 		ws.LatestRun = &LatestRun{
 			ID:     r.LatestRunID.String,
@@ -124,32 +172,41 @@ func (r pgresult) toWorkspace() (*Workspace, error) {
 func (db *pgdb) create(ctx context.Context, ws *Workspace) error {
 	err := db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
 		params := pggen.InsertWorkspaceParams{
-			ID:                         sql.String(ws.ID),
-			CreatedAt:                  sql.Timestamptz(ws.CreatedAt),
-			UpdatedAt:                  sql.Timestamptz(ws.UpdatedAt),
-			AgentPoolID:                sql.StringPtr(ws.AgentPoolID),
-			AllowCLIApply:              sql.Bool(false),
-			AllowDestroyPlan:           sql.Bool(ws.AllowDestroyPlan),
-			AutoApply:                  sql.Bool(ws.AutoApply),
-			Branch:                     sql.String(""),
-			CanQueueDestroyPlan:        sql.Bool(ws.CanQueueDestroyPlan),
-			Description:                sql.String(ws.Description),
-			Environment:                sql.String(ws.Environment),
-			ExecutionMode:              sql.String(string(ws.ExecutionMode)),
-			GlobalRemoteState:          sql.Bool(ws.GlobalRemoteState),
-			MigrationEnvironment:       sql.String(ws.MigrationEnvironment),
-			Name:                       sql.String(ws.Name),
-			QueueAllRuns:               sql.Bool(ws.QueueAllRuns),
-			SpeculativeEnabled:         sql.Bool(ws.SpeculativeEnabled),
-			SourceName:                 sql.String(ws.SourceName),
-			SourceURL:                  sql.String(ws.SourceURL),
-			StructuredRunOutputEnabled: sql.Bool(ws.StructuredRunOutputEnabled),
-			TerraformVersion:           sql.String(ws.TerraformVersion),
-			TriggerPrefixes:            ws.TriggerPrefixes,
-			TriggerPatterns:            ws.TriggerPatterns,
-			VCSTagsRegex:               sql.StringPtr(nil),
-			WorkingDirectory:           sql.String(ws.WorkingDirectory),
-			OrganizationName:           sql.String(ws.Organization),
+			ID:                          sql.String(ws.ID),
+			CreatedAt:                   sql.Timestamptz(ws.CreatedAt),
+			UpdatedAt:                   sql.Timestamptz(ws.UpdatedAt),
+			AgentPoolID:                 sql.StringPtr(ws.AgentPoolID),
+			AllowCLIApply:               sql.Bool(false),
+			AllowDestroyPlan:            sql.Bool(ws.AllowDestroyPlan),
+			AssessmentsEnabled:          sql.Bool(ws.AssessmentsEnabled),
+			AutoApply:                   sql.Bool(ws.AutoApply),
+			AutoDestroyAt:               sql.TimestamptzPtr(ws.AutoDestroyAt),
+			AutoDestroyActivityDuration: sql.StringPtr(ws.AutoDestroyActivityDuration),
+			Branch:                      sql.String(""),
+			CanQueueDestroyPlan:         sql.Bool(ws.CanQueueDestroyPlan),
+			DeleteAfterAutoDestroy:      sql.Bool(ws.DeleteAfterAutoDestroy),
+			Description:                 sql.String(ws.Description),
+			Environment:                 sql.String(ws.Environment),
+			ExecutionMode:               sql.String(string(ws.ExecutionMode)),
+			GlobalRemoteState:           sql.Bool(ws.GlobalRemoteState),
+			MigrationEnvironment:        sql.String(ws.MigrationEnvironment),
+			Name:                        sql.String(ws.Name),
+			QueueAllRuns:                sql.Bool(ws.QueueAllRuns),
+			SpeculativeEnabled:          sql.Bool(ws.SpeculativeEnabled),
+			SourceName:                  sql.String(ws.SourceName),
+			SourceURL:                   sql.String(ws.SourceURL),
+			StructuredRunOutputEnabled:  sql.Bool(ws.StructuredRunOutputEnabled),
+			TerraformVersion:            sql.String(ws.TerraformVersion),
+			TriggerPrefixes:             ws.TriggerPrefixes,
+			TriggerPatterns:             ws.TriggerPatterns,
+			VCSTagsRegex:                sql.StringPtr(nil),
+			WorkingDirectory:            sql.String(ws.WorkingDirectory),
+			OrganizationName:            sql.String(ws.Organization),
+			Engine:                      sql.String(string(ws.Engine)),
+			ProjectID:                   sql.StringPtr(ws.ProjectID),
+			AgentSelector:               sql.String(ws.AgentSelector),
+			PlanTimeout:                 sql.Int4Ptr(ws.PlanTimeout),
+			ApplyTimeout:                sql.Int4Ptr(ws.ApplyTimeout),
 		}
 		if ws.Connection != nil {
 			params.AllowCLIApply = sql.Bool(ws.Connection.AllowCLIApply)
@@ -185,25 +242,34 @@ func (db *pgdb) update(ctx context.Context, workspaceID string, fn func(*Workspa
 		}
 		// persist update
 		params := pggen.UpdateWorkspaceByIDParams{
-			AgentPoolID:                sql.StringPtr(ws.AgentPoolID),
-			AllowDestroyPlan:           sql.Bool(ws.AllowDestroyPlan),
-			AllowCLIApply:              sql.Bool(false),
-			AutoApply:                  sql.Bool(ws.AutoApply),
-			Branch:                     sql.String(""),
-			Description:                sql.String(ws.Description),
-			ExecutionMode:              sql.String(string(ws.ExecutionMode)),
-			GlobalRemoteState:          sql.Bool(ws.GlobalRemoteState),
-			Name:                       sql.String(ws.Name),
-			QueueAllRuns:               sql.Bool(ws.QueueAllRuns),
-			SpeculativeEnabled:         sql.Bool(ws.SpeculativeEnabled),
-			StructuredRunOutputEnabled: sql.Bool(ws.StructuredRunOutputEnabled),
-			TerraformVersion:           sql.String(ws.TerraformVersion),
-			TriggerPrefixes:            ws.TriggerPrefixes,
-			TriggerPatterns:            ws.TriggerPatterns,
-			VCSTagsRegex:               sql.StringPtr(nil),
-			WorkingDirectory:           sql.String(ws.WorkingDirectory),
-			UpdatedAt:                  sql.Timestamptz(ws.UpdatedAt),
-			ID:                         sql.String(ws.ID),
+			AgentPoolID:                 sql.StringPtr(ws.AgentPoolID),
+			AgentSelector:               sql.String(ws.AgentSelector),
+			AllowDestroyPlan:            sql.Bool(ws.AllowDestroyPlan),
+			AllowCLIApply:               sql.Bool(false),
+			ApplyTimeout:                sql.Int4Ptr(ws.ApplyTimeout),
+			AssessmentsEnabled:          sql.Bool(ws.AssessmentsEnabled),
+			AutoApply:                   sql.Bool(ws.AutoApply),
+			AutoDestroyAt:               sql.TimestamptzPtr(ws.AutoDestroyAt),
+			AutoDestroyActivityDuration: sql.StringPtr(ws.AutoDestroyActivityDuration),
+			Branch:                      sql.String(""),
+			DeleteAfterAutoDestroy:      sql.Bool(ws.DeleteAfterAutoDestroy),
+			Description:                 sql.String(ws.Description),
+			Engine:                      sql.String(string(ws.Engine)),
+			ExecutionMode:               sql.String(string(ws.ExecutionMode)),
+			GlobalRemoteState:           sql.Bool(ws.GlobalRemoteState),
+			Name:                        sql.String(ws.Name),
+			PlanTimeout:                 sql.Int4Ptr(ws.PlanTimeout),
+			ProjectID:                   sql.StringPtr(ws.ProjectID),
+			QueueAllRuns:                sql.Bool(ws.QueueAllRuns),
+			SpeculativeEnabled:          sql.Bool(ws.SpeculativeEnabled),
+			StructuredRunOutputEnabled:  sql.Bool(ws.StructuredRunOutputEnabled),
+			TerraformVersion:            sql.String(ws.TerraformVersion),
+			TriggerPrefixes:             ws.TriggerPrefixes,
+			TriggerPatterns:             ws.TriggerPatterns,
+			VCSTagsRegex:                sql.StringPtr(nil),
+			WorkingDirectory:            sql.String(ws.WorkingDirectory),
+			UpdatedAt:                   sql.Timestamptz(ws.UpdatedAt),
+			ID:                          sql.String(ws.ID),
 		}
 		if ws.Connection != nil {
 			params.AllowCLIApply = sql.Bool(ws.Connection.AllowCLIApply)
@@ -211,7 +277,7 @@ func (db *pgdb) update(ctx context.Context, workspaceID string, fn func(*Workspa
 			params.VCSTagsRegex = sql.String(ws.Connection.TagsRegex)
 		}
 		_, err = q.UpdateWorkspaceByID(ctx, params)
-		return ws, err
+		return ws, sql.Error(err)
 	})
 	if err != nil {
 		return nil, err
@@ -237,6 +303,106 @@ func (db *pgdb) setCurrentRun(ctx context.Context, workspaceID, runID string) (*
 	return ws, nil
 }
 
+// setLatestAssessment sets the latest health assessment for the specified
+// workspace.
+func (db *pgdb) setLatestAssessment(ctx context.Context, workspaceID string, assessment Assessment) (*Workspace, error) {
+	// a skipped assessment has no run
+	runID := sql.NullString()
+	if assessment.RunID != "" {
+		runID = sql.String(assessment.RunID)
+	}
+	ws, err := sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*Workspace, error) {
+		_, err := q.UpdateWorkspaceLatestAssessment(ctx, pggen.UpdateWorkspaceLatestAssessmentParams{
+			RunID:       runID,
+			Status:      sql.String(string(assessment.Status)),
+			CreatedAt:   sql.Timestamptz(assessment.CreatedAt),
+			WorkspaceID: sql.String(workspaceID),
+		})
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+
+		return db.get(ctx, workspaceID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ws, nil
+}
+
+// listForAssessment lists workspaces with health assessments enabled that
+// have not been assessed since the given time.
+func (db *pgdb) listForAssessment(ctx context.Context, dueBefore time.Time) ([]*Workspace, error) {
+	ws, err := sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]*Workspace, error) {
+		rows, err := q.FindWorkspacesForAssessment(ctx, sql.Timestamptz(dueBefore))
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]*Workspace, len(rows))
+		for i, r := range rows {
+			ws, err := pgresult(r).toWorkspace()
+			if err != nil {
+				return nil, err
+			}
+			items[i] = ws
+		}
+
+		return items, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ws, nil
+}
+
+// setAutoDestroyRun records the destroy run queued by auto-destroy for the
+// specified workspace.
+func (db *pgdb) setAutoDestroyRun(ctx context.Context, workspaceID, runID string) (*Workspace, error) {
+	ws, err := sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*Workspace, error) {
+		_, err := q.UpdateWorkspaceAutoDestroyRun(ctx, sql.String(runID), sql.String(workspaceID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+
+		return db.get(ctx, workspaceID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ws, nil
+}
+
+// listForAutoDestroy lists workspaces with either an auto-destroy deadline
+// or an inactivity duration.
+func (db *pgdb) listForAutoDestroy(ctx context.Context) ([]*Workspace, error) {
+	ws, err := sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]*Workspace, error) {
+		rows, err := q.FindWorkspacesForAutoDestroy(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]*Workspace, len(rows))
+		for i, r := range rows {
+			ws, err := pgresult(r).toWorkspace()
+			if err != nil {
+				return nil, err
+			}
+			items[i] = ws
+		}
+
+		return items, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ws, nil
+}
+
 func (db *pgdb) list(ctx context.Context, opts ListOptions) (*resource.Page[*Workspace], error) {
 	ws, err := sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*resource.Page[*Workspace], error) {
 
@@ -255,6 +421,7 @@ func (db *pgdb) list(ctx context.Context, opts ListOptions) (*resource.Page[*Wor
 			OrganizationNames: []string{organization},
 			Search:            sql.String(opts.Search),
 			Tags:              tags,
+			ProjectID:         sql.StringPtr(opts.ProjectID),
 			Limit:             opts.GetLimit(),
 			Offset:            opts.GetOffset(),
 		})
@@ -265,6 +432,7 @@ func (db *pgdb) list(ctx context.Context, opts ListOptions) (*resource.Page[*Wor
 			Search:            sql.String(opts.Search),
 			OrganizationNames: []string{organization},
 			Tags:              tags,
+			ProjectID:         sql.StringPtr(opts.ProjectID),
 		})
 		if err != nil {
 			return nil, err
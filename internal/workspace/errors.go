@@ -11,11 +11,12 @@ var (
 	ErrWorkspaceInvalidLock           = errors.New("invalid workspace lock")
 	ErrUnsupportedTerraformVersion    = errors.New("unsupported terraform version")
 
-	ErrTagsRegexAndTriggerPatterns     = errors.New("cannot specify both tags-regex and trigger-patterns")
-	ErrTagsRegexAndAlwaysTrigger       = errors.New("cannot specify both tags-regex and always-trigger")
-	ErrTriggerPatternsAndAlwaysTrigger = errors.New("cannot specify both trigger-patterns and always-trigger")
-	ErrInvalidTriggerPattern           = errors.New("invalid trigger glob pattern")
-	ErrInvalidTagsRegex                = errors.New("invalid vcs tags regular expression")
-	ErrAgentExecutionModeWithoutPool   = errors.New("agent execution mode requires agent pool ID")
-	ErrNonAgentExecutionModeWithPool   = errors.New("agent pool ID can only be specified with agent execution mode")
+	ErrTagsRegexAndTriggerPatterns        = errors.New("cannot specify both tags-regex and trigger-patterns")
+	ErrTagsRegexAndAlwaysTrigger          = errors.New("cannot specify both tags-regex and always-trigger")
+	ErrTriggerPatternsAndAlwaysTrigger    = errors.New("cannot specify both trigger-patterns and always-trigger")
+	ErrInvalidTriggerPattern              = errors.New("invalid trigger glob pattern")
+	ErrInvalidTagsRegex                   = errors.New("invalid vcs tags regular expression")
+	ErrAgentExecutionModeWithoutPool      = errors.New("agent execution mode requires agent pool ID")
+	ErrNonAgentExecutionModeWithPool      = errors.New("agent pool ID can only be specified with agent execution mode")
+	ErrInvalidAutoDestroyActivityDuration = errors.New("invalid auto-destroy activity duration: must be a number of days or hours, e.g. 14d or 2h")
 )
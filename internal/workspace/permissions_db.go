@@ -54,6 +54,21 @@ func (db *pgdb) GetWorkspacePolicy(ctx context.Context, workspaceID string) (int
 				Role:   role,
 			})
 		}
+
+		projectPerms, err := q.FindProjectPermissionsByWorkspaceID(ctx, sql.String(workspaceID))
+		if err != nil {
+			return internal.WorkspacePolicy{}, sql.Error(err)
+		}
+		for _, perm := range projectPerms {
+			role, err := rbac.WorkspaceRoleFromString(perm.Role.String)
+			if err != nil {
+				return internal.WorkspacePolicy{}, err
+			}
+			policy.ProjectPermissions = append(policy.ProjectPermissions, internal.WorkspacePermission{
+				TeamID: perm.TeamID.String,
+				Role:   role,
+			})
+		}
 		return policy, nil
 	})
 }
@@ -0,0 +1,96 @@
+package workspace
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/resource"
+)
+
+var (
+	ErrProjectOrganizationMismatch = errors.New("project belongs to a different organization than the workspace")
+	ErrProjectNotEmpty             = errors.New("project cannot be deleted while it contains workspaces")
+)
+
+type (
+	// Project groups workspaces within an organization. Teams can be granted
+	// permissions on a project, which then apply to each of its workspaces.
+	Project struct {
+		ID           string    `jsonapi:"primary,projects"`
+		CreatedAt    time.Time `jsonapi:"attribute" json:"created_at"`
+		UpdatedAt    time.Time `jsonapi:"attribute" json:"updated_at"`
+		Name         string    `jsonapi:"attribute" json:"name"`
+		Description  string    `jsonapi:"attribute" json:"description"`
+		Organization string    `jsonapi:"attribute" json:"organization"`
+	}
+
+	// ProjectPermission binds a workspace role to a team on a project.
+	ProjectPermission struct {
+		ID        string
+		ProjectID string
+		TeamID    string
+		Role      rbac.Role
+	}
+
+	CreateProjectOptions struct {
+		Name        *string
+		Description *string
+	}
+
+	UpdateProjectOptions struct {
+		Name        *string
+		Description *string
+	}
+
+	// ListProjectsOptions are options for paginating and filtering a list of
+	// projects.
+	ListProjectsOptions struct {
+		Search string `schema:"q"`
+
+		resource.PageOptions
+	}
+)
+
+func newProject(organization string, opts CreateProjectOptions) (*Project, error) {
+	if err := resource.ValidateName(opts.Name); err != nil {
+		return nil, err
+	}
+	now := internal.CurrentTimestamp(nil)
+	project := &Project{
+		ID:           internal.NewID("prj"),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		Name:         *opts.Name,
+		Organization: organization,
+	}
+	if opts.Description != nil {
+		project.Description = *opts.Description
+	}
+	return project, nil
+}
+
+func (p *Project) update(opts UpdateProjectOptions) error {
+	if opts.Name != nil {
+		if err := resource.ValidateName(opts.Name); err != nil {
+			return err
+		}
+		p.Name = *opts.Name
+	}
+	if opts.Description != nil {
+		p.Description = *opts.Description
+	}
+	p.UpdatedAt = internal.CurrentTimestamp(nil)
+	return nil
+}
+
+// LogValue implements slog.LogValuer.
+func (p *Project) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", p.ID),
+		slog.String("organization", p.Organization),
+		slog.String("name", p.Name),
+	)
+}
@@ -0,0 +1,202 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/resource"
+	"github.com/tofutf/tofutf/internal/sql"
+	"github.com/tofutf/tofutf/internal/sql/pggen"
+)
+
+type (
+	// projectresult represents the result of a database query for a project.
+	projectresult struct {
+		ProjectID        pgtype.Text        `json:"project_id"`
+		CreatedAt        pgtype.Timestamptz `json:"created_at"`
+		UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+		Name             pgtype.Text        `json:"name"`
+		Description      pgtype.Text        `json:"description"`
+		OrganizationName pgtype.Text        `json:"organization_name"`
+	}
+
+	// projectpermresult represents the result of a database query for a
+	// project permission.
+	projectpermresult struct {
+		ProjectPermissionID pgtype.Text `json:"project_permission_id"`
+		ProjectID           pgtype.Text `json:"project_id"`
+		TeamID              pgtype.Text `json:"team_id"`
+		Role                pgtype.Text `json:"role"`
+	}
+)
+
+func (r projectresult) toProject() *Project {
+	return &Project{
+		ID:           r.ProjectID.String,
+		CreatedAt:    r.CreatedAt.Time.UTC(),
+		UpdatedAt:    r.UpdatedAt.Time.UTC(),
+		Name:         r.Name.String,
+		Description:  r.Description.String,
+		Organization: r.OrganizationName.String,
+	}
+}
+
+func (r projectpermresult) toProjectPermission() (*ProjectPermission, error) {
+	role, err := rbac.WorkspaceRoleFromString(r.Role.String)
+	if err != nil {
+		return nil, err
+	}
+	return &ProjectPermission{
+		ID:        r.ProjectPermissionID.String,
+		ProjectID: r.ProjectID.String,
+		TeamID:    r.TeamID.String,
+		Role:      role,
+	}, nil
+}
+
+func (db *pgdb) createProject(ctx context.Context, project *Project) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.InsertProject(ctx, pggen.InsertProjectParams{
+			ProjectID:        sql.String(project.ID),
+			CreatedAt:        sql.Timestamptz(project.CreatedAt),
+			UpdatedAt:        sql.Timestamptz(project.UpdatedAt),
+			Name:             sql.String(project.Name),
+			Description:      sql.String(project.Description),
+			OrganizationName: sql.String(project.Organization),
+		})
+		return sql.Error(err)
+	})
+}
+
+func (db *pgdb) updateProject(ctx context.Context, projectID string, fn func(*Project) error) (*Project, error) {
+	return sql.Tx(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*Project, error) {
+		result, err := q.FindProjectByIDForUpdate(ctx, sql.String(projectID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		project := projectresult(result).toProject()
+		if err := fn(project); err != nil {
+			return nil, err
+		}
+		_, err = q.UpdateProject(ctx, pggen.UpdateProjectParams{
+			Name:        sql.String(project.Name),
+			Description: sql.String(project.Description),
+			UpdatedAt:   sql.Timestamptz(project.UpdatedAt),
+			ProjectID:   sql.String(project.ID),
+		})
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return project, nil
+	})
+}
+
+func (db *pgdb) listProjects(ctx context.Context, organization string, opts ListProjectsOptions) (*resource.Page[*Project], error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*resource.Page[*Project], error) {
+		rows, err := q.FindProjects(ctx, pggen.FindProjectsParams{
+			OrganizationName: sql.String(organization),
+			Search:           sql.String(opts.Search),
+			Limit:            opts.GetLimit(),
+			Offset:           opts.GetOffset(),
+		})
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		count, err := q.CountProjects(ctx, sql.String(organization), sql.String(opts.Search))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+
+		items := make([]*Project, len(rows))
+		for i, r := range rows {
+			items[i] = projectresult(r).toProject()
+		}
+
+		return resource.NewPage(items, opts.PageOptions, internal.Int64(count.Int64)), nil
+	})
+}
+
+func (db *pgdb) getProject(ctx context.Context, projectID string) (*Project, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*Project, error) {
+		result, err := q.FindProjectByID(ctx, sql.String(projectID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return projectresult(result).toProject(), nil
+	})
+}
+
+func (db *pgdb) deleteProject(ctx context.Context, projectID string) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.DeleteProject(ctx, sql.String(projectID))
+		if err != nil {
+			err = sql.Error(err)
+			var fkerr *internal.ForeignKeyError
+			if errors.As(err, &fkerr) {
+				if fkerr.ConstraintName == "project_id_fk" && fkerr.TableName == "workspaces" {
+					return ErrProjectNotEmpty
+				}
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+func (db *pgdb) setProjectPermission(ctx context.Context, projectID, teamID string, role rbac.Role) (*ProjectPermission, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*ProjectPermission, error) {
+		_, err := q.UpsertProjectPermission(ctx, pggen.UpsertProjectPermissionParams{
+			ProjectPermissionID: sql.String(internal.NewID("tprj")),
+			ProjectID:           sql.String(projectID),
+			TeamID:              sql.String(teamID),
+			Role:                sql.String(role.String()),
+		})
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		// retrieve permission because an existing permission retains its ID
+		result, err := q.FindProjectPermissionByTeamID(ctx, sql.String(projectID), sql.String(teamID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return projectpermresult(result).toProjectPermission()
+	})
+}
+
+func (db *pgdb) getProjectPermission(ctx context.Context, permissionID string) (*ProjectPermission, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) (*ProjectPermission, error) {
+		result, err := q.FindProjectPermissionByID(ctx, sql.String(permissionID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		return projectpermresult(result).toProjectPermission()
+	})
+}
+
+func (db *pgdb) listProjectPermissions(ctx context.Context, projectID string) ([]*ProjectPermission, error) {
+	return sql.Query(ctx, db.Pool, func(ctx context.Context, q pggen.Querier) ([]*ProjectPermission, error) {
+		rows, err := q.FindProjectPermissionsByProjectID(ctx, sql.String(projectID))
+		if err != nil {
+			return nil, sql.Error(err)
+		}
+		perms := make([]*ProjectPermission, len(rows))
+		for i, r := range rows {
+			perm, err := projectpermresult(r).toProjectPermission()
+			if err != nil {
+				return nil, err
+			}
+			perms[i] = perm
+		}
+		return perms, nil
+	})
+}
+
+func (db *pgdb) unsetProjectPermission(ctx context.Context, projectID, teamID string) error {
+	return db.Query(ctx, func(ctx context.Context, q pggen.Querier) error {
+		_, err := q.DeleteProjectPermission(ctx, sql.String(projectID), sql.String(teamID))
+		return sql.Error(err)
+	})
+}
@@ -0,0 +1,170 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/resource"
+)
+
+func (s *Service) CreateProject(ctx context.Context, organization string, opts CreateProjectOptions) (*Project, error) {
+	subject, err := s.organization.CanAccess(ctx, rbac.CreateProjectAction, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := newProject(organization, opts)
+	if err != nil {
+		s.logger.Error("constructing project", "organization", organization, "subject", subject, "err", err)
+		return nil, err
+	}
+	if err := s.db.createProject(ctx, project); err != nil {
+		s.logger.Error("creating project", "project", project, "subject", subject, "err", err)
+		return nil, err
+	}
+	s.logger.Info("created project", "project", project, "subject", subject)
+	return project, nil
+}
+
+func (s *Service) UpdateProject(ctx context.Context, projectID string, opts UpdateProjectOptions) (*Project, error) {
+	project, err := s.db.getProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	subject, err := s.organization.CanAccess(ctx, rbac.UpdateProjectAction, project.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.db.updateProject(ctx, projectID, func(project *Project) error {
+		return project.update(opts)
+	})
+	if err != nil {
+		s.logger.Error("updating project", "project", project, "subject", subject, "err", err)
+		return nil, err
+	}
+	s.logger.Info("updated project", "project", updated, "subject", subject)
+	return updated, nil
+}
+
+func (s *Service) ListProjects(ctx context.Context, organization string, opts ListProjectsOptions) (*resource.Page[*Project], error) {
+	subject, err := s.organization.CanAccess(ctx, rbac.ListProjectsAction, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.db.listProjects(ctx, organization, opts)
+	if err != nil {
+		s.logger.Error("listing projects", "organization", organization, "subject", subject, "err", err)
+		return nil, err
+	}
+	s.logger.Debug("listed projects", "organization", organization, "subject", subject)
+	return page, nil
+}
+
+func (s *Service) GetProject(ctx context.Context, projectID string) (*Project, error) {
+	project, err := s.db.getProject(ctx, projectID)
+	if err != nil {
+		s.logger.Error("retrieving project", "project_id", projectID, "err", err)
+		return nil, err
+	}
+	subject, err := s.organization.CanAccess(ctx, rbac.GetProjectAction, project.Organization)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Debug("retrieved project", "project", project, "subject", subject)
+	return project, nil
+}
+
+// DeleteProject deletes a project. A project cannot be deleted while it
+// still contains workspaces.
+func (s *Service) DeleteProject(ctx context.Context, projectID string) error {
+	project, err := s.db.getProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	subject, err := s.organization.CanAccess(ctx, rbac.DeleteProjectAction, project.Organization)
+	if err != nil {
+		return err
+	}
+
+	if err := s.db.deleteProject(ctx, projectID); err != nil {
+		s.logger.Error("deleting project", "project", project, "subject", subject, "err", err)
+		return err
+	}
+	s.logger.Info("deleted project", "project", project, "subject", subject)
+	return nil
+}
+
+// SetProjectPermission grants a team a role on each of the project's
+// workspaces, replacing any role the team already has on the project.
+func (s *Service) SetProjectPermission(ctx context.Context, projectID, teamID string, role rbac.Role) (*ProjectPermission, error) {
+	project, err := s.db.getProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	subject, err := s.organization.CanAccess(ctx, rbac.SetProjectPermissionAction, project.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	perm, err := s.db.setProjectPermission(ctx, projectID, teamID, role)
+	if err != nil {
+		s.logger.Error("setting project permission", "project", project, "team_id", teamID, "subject", subject, "err", err)
+		return nil, err
+	}
+	s.logger.Info("set project permission", "project", project, "team_id", teamID, "role", role, "subject", subject)
+	return perm, nil
+}
+
+func (s *Service) GetProjectPermission(ctx context.Context, permissionID string) (*ProjectPermission, error) {
+	perm, err := s.db.getProjectPermission(ctx, permissionID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.GetProject(ctx, perm.ProjectID); err != nil {
+		return nil, err
+	}
+	return perm, nil
+}
+
+func (s *Service) ListProjectPermissions(ctx context.Context, projectID string) ([]*ProjectPermission, error) {
+	if _, err := s.GetProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+	return s.db.listProjectPermissions(ctx, projectID)
+}
+
+func (s *Service) UnsetProjectPermission(ctx context.Context, projectID, teamID string) error {
+	project, err := s.db.getProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	subject, err := s.organization.CanAccess(ctx, rbac.UnsetProjectPermissionAction, project.Organization)
+	if err != nil {
+		return err
+	}
+
+	if err := s.db.unsetProjectPermission(ctx, projectID, teamID); err != nil {
+		s.logger.Error("unsetting project permission", "project", project, "team_id", teamID, "subject", subject, "err", err)
+		return err
+	}
+	s.logger.Info("unset project permission", "project", project, "team_id", teamID, "subject", subject)
+	return nil
+}
+
+// checkProject checks the workspace's project, if any, belongs to the same
+// organization as the workspace.
+func (s *Service) checkProject(ctx context.Context, ws *Workspace) error {
+	if ws.ProjectID == nil {
+		return nil
+	}
+	project, err := s.db.getProject(ctx, *ws.ProjectID)
+	if err != nil {
+		return err
+	}
+	if project.Organization != ws.Organization {
+		return ErrProjectOrganizationMismatch
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package workspace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/tfeapi/types"
+)
+
+func TestNewProject(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		got, err := newProject("acme-corp", CreateProjectOptions{
+			Name:        internal.String("networking"),
+			Description: internal.String("all things networking"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "networking", got.Name)
+		assert.Equal(t, "all things networking", got.Description)
+		assert.Equal(t, "acme-corp", got.Organization)
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		_, err := newProject("acme-corp", CreateProjectOptions{})
+		assert.Equal(t, internal.ErrRequiredName, err)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		_, err := newProject("acme-corp", CreateProjectOptions{Name: internal.String(".")})
+		assert.Equal(t, internal.ErrInvalidName, err)
+	})
+}
+
+func TestWorkspace_UpdateProject(t *testing.T) {
+	ws := &Workspace{}
+
+	_, err := ws.Update(UpdateOptions{ProjectID: internal.String("prj-123")})
+	require.NoError(t, err)
+	assert.Equal(t, internal.String("prj-123"), ws.ProjectID)
+
+	// empty string removes workspace from project
+	_, err = ws.Update(UpdateOptions{ProjectID: internal.String("")})
+	require.NoError(t, err)
+	assert.Nil(t, ws.ProjectID)
+}
+
+func TestProjectAccessRoles(t *testing.T) {
+	tests := []struct {
+		access types.TeamProjectAccessType
+		role   rbac.Role
+		// want is the access mapped back from the role
+		want types.TeamProjectAccessType
+	}{
+		{types.TeamProjectAccessRead, rbac.WorkspaceReadRole, types.TeamProjectAccessRead},
+		{types.TeamProjectAccessWrite, rbac.WorkspaceWriteRole, types.TeamProjectAccessWrite},
+		{types.TeamProjectAccessMaintain, rbac.WorkspaceAdminRole, types.TeamProjectAccessAdmin},
+		{types.TeamProjectAccessAdmin, rbac.WorkspaceAdminRole, types.TeamProjectAccessAdmin},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.access), func(t *testing.T) {
+			role, err := projectAccessToRole(tt.access)
+			require.NoError(t, err)
+			assert.Equal(t, tt.role.String(), role.String())
+			assert.Equal(t, tt.want, roleToProjectAccess(role))
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := projectAccessToRole("custom")
+		assert.Equal(t, errInvalidProjectAccess, err)
+	})
+}
@@ -0,0 +1,303 @@
+package workspace
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal/http/decode"
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/resource"
+	"github.com/tofutf/tofutf/internal/tfeapi"
+	"github.com/tofutf/tofutf/internal/tfeapi/types"
+)
+
+var errInvalidProjectAccess = errors.New("invalid access: must be one of admin, maintain, write or read")
+
+func (a *tfe) addProjectHandlers(r *mux.Router) {
+	r = r.PathPrefix(tfeapi.APIPrefixV2).Subrouter()
+
+	r.HandleFunc("/organizations/{organization_name}/projects", a.createProject).Methods("POST")
+	r.HandleFunc("/organizations/{organization_name}/projects", a.listProjects).Methods("GET")
+	r.HandleFunc("/projects/{project_id}", a.getProject).Methods("GET")
+	r.HandleFunc("/projects/{project_id}", a.updateProject).Methods("PATCH")
+	r.HandleFunc("/projects/{project_id}", a.deleteProject).Methods("DELETE")
+
+	r.HandleFunc("/team-projects", a.addTeamProjectAccess).Methods("POST")
+	r.HandleFunc("/team-projects", a.listTeamProjectAccesses).Methods("GET")
+	r.HandleFunc("/team-projects/{team_project_id}", a.getTeamProjectAccess).Methods("GET")
+	r.HandleFunc("/team-projects/{team_project_id}", a.updateTeamProjectAccess).Methods("PATCH")
+	r.HandleFunc("/team-projects/{team_project_id}", a.removeTeamProjectAccess).Methods("DELETE")
+}
+
+func (a *tfe) createProject(w http.ResponseWriter, r *http.Request) {
+	org, err := decode.Param("organization_name", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	var params types.ProjectCreateOptions
+	if err := tfeapi.Unmarshal(r.Body, &params); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	project, err := a.CreateProject(r.Context(), org, CreateProjectOptions{
+		Name:        params.Name,
+		Description: params.Description,
+	})
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	a.Respond(w, r, a.toProject(project), http.StatusCreated)
+}
+
+func (a *tfe) listProjects(w http.ResponseWriter, r *http.Request) {
+	org, err := decode.Param("organization_name", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	var params types.ProjectListOptions
+	if err := decode.All(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	page, err := a.ListProjects(r.Context(), org, ListProjectsOptions{
+		Search:      params.Query,
+		PageOptions: resource.PageOptions(params.ListOptions),
+	})
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	// convert items
+	items := make([]*types.Project, len(page.Items))
+	for i, from := range page.Items {
+		items[i] = a.toProject(from)
+	}
+	a.RespondWithPage(w, r, items, page.Pagination)
+}
+
+func (a *tfe) getProject(w http.ResponseWriter, r *http.Request) {
+	id, err := decode.Param("project_id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	project, err := a.GetProject(r.Context(), id)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	a.Respond(w, r, a.toProject(project), http.StatusOK)
+}
+
+func (a *tfe) updateProject(w http.ResponseWriter, r *http.Request) {
+	id, err := decode.Param("project_id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	var params types.ProjectUpdateOptions
+	if err := tfeapi.Unmarshal(r.Body, &params); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	project, err := a.UpdateProject(r.Context(), id, UpdateProjectOptions{
+		Name:        params.Name,
+		Description: params.Description,
+	})
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	a.Respond(w, r, a.toProject(project), http.StatusOK)
+}
+
+func (a *tfe) deleteProject(w http.ResponseWriter, r *http.Request) {
+	id, err := decode.Param("project_id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	if err := a.DeleteProject(r.Context(), id); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a *tfe) addTeamProjectAccess(w http.ResponseWriter, r *http.Request) {
+	var params types.TeamProjectAccessAddOptions
+	if err := tfeapi.Unmarshal(r.Body, &params); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	if params.Access == nil || params.Team == nil || params.Project == nil {
+		tfeapi.Error(w, errors.New("must specify access, team and project"))
+		return
+	}
+	role, err := projectAccessToRole(*params.Access)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	perm, err := a.SetProjectPermission(r.Context(), params.Project.ID, params.Team.ID, role)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	a.Respond(w, r, a.toTeamProjectAccess(perm), http.StatusCreated)
+}
+
+func (a *tfe) listTeamProjectAccesses(w http.ResponseWriter, r *http.Request) {
+	var params types.TeamProjectAccessListOptions
+	if err := decode.All(&params, r); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	perms, err := a.ListProjectPermissions(r.Context(), params.ProjectID)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	// convert items
+	items := make([]*types.TeamProjectAccess, len(perms))
+	for i, from := range perms {
+		items[i] = a.toTeamProjectAccess(from)
+	}
+	page := resource.NewPage(items, resource.PageOptions(params.ListOptions), nil)
+	a.RespondWithPage(w, r, page.Items, page.Pagination)
+}
+
+func (a *tfe) getTeamProjectAccess(w http.ResponseWriter, r *http.Request) {
+	id, err := decode.Param("team_project_id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	perm, err := a.GetProjectPermission(r.Context(), id)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	a.Respond(w, r, a.toTeamProjectAccess(perm), http.StatusOK)
+}
+
+func (a *tfe) updateTeamProjectAccess(w http.ResponseWriter, r *http.Request) {
+	id, err := decode.Param("team_project_id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	var params types.TeamProjectAccessUpdateOptions
+	if err := tfeapi.Unmarshal(r.Body, &params); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	if params.Access == nil {
+		tfeapi.Error(w, errors.New("must specify access"))
+		return
+	}
+	role, err := projectAccessToRole(*params.Access)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	perm, err := a.GetProjectPermission(r.Context(), id)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	perm, err = a.SetProjectPermission(r.Context(), perm.ProjectID, perm.TeamID, role)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	a.Respond(w, r, a.toTeamProjectAccess(perm), http.StatusOK)
+}
+
+func (a *tfe) removeTeamProjectAccess(w http.ResponseWriter, r *http.Request) {
+	id, err := decode.Param("team_project_id", r)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	perm, err := a.GetProjectPermission(r.Context(), id)
+	if err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+	if err := a.UnsetProjectPermission(r.Context(), perm.ProjectID, perm.TeamID); err != nil {
+		tfeapi.Error(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a *tfe) toProject(from *Project) *types.Project {
+	return &types.Project{
+		ID:           from.ID,
+		Name:         from.Name,
+		Description:  from.Description,
+		Organization: &types.Organization{Name: from.Organization},
+	}
+}
+
+func (a *tfe) toTeamProjectAccess(from *ProjectPermission) *types.TeamProjectAccess {
+	return &types.TeamProjectAccess{
+		ID:      from.ID,
+		Access:  roleToProjectAccess(from.Role),
+		Team:    &types.Team{ID: from.TeamID},
+		Project: &types.Project{ID: from.ProjectID},
+	}
+}
+
+// projectAccessToRole maps a TFE project access type to a workspace role.
+// TFE's maintain access has no equivalent and is mapped to the admin role.
+func projectAccessToRole(access types.TeamProjectAccessType) (rbac.Role, error) {
+	switch access {
+	case types.TeamProjectAccessRead:
+		return rbac.WorkspaceReadRole, nil
+	case types.TeamProjectAccessWrite:
+		return rbac.WorkspaceWriteRole, nil
+	case types.TeamProjectAccessMaintain, types.TeamProjectAccessAdmin:
+		return rbac.WorkspaceAdminRole, nil
+	default:
+		return rbac.Role{}, errInvalidProjectAccess
+	}
+}
+
+// roleToProjectAccess maps a workspace role to a TFE project access type. The
+// plan role has no equivalent and is mapped to read access.
+func roleToProjectAccess(role rbac.Role) types.TeamProjectAccessType {
+	switch role.String() {
+	case rbac.WorkspaceAdminRole.String():
+		return types.TeamProjectAccessAdmin
+	case rbac.WorkspaceWriteRole.String():
+		return types.TeamProjectAccessWrite
+	default:
+		return types.TeamProjectAccessRead
+	}
+}
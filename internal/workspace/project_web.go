@@ -0,0 +1,286 @@
+package workspace
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/http/decode"
+	"github.com/tofutf/tofutf/internal/http/html"
+	"github.com/tofutf/tofutf/internal/http/html/paths"
+	"github.com/tofutf/tofutf/internal/organization"
+	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/resource"
+	"github.com/tofutf/tofutf/internal/team"
+)
+
+func (h *webHandlers) addProjectHandlers(r *mux.Router) {
+	r = html.UIRouter(r)
+
+	r.HandleFunc("/organizations/{organization_name}/projects", h.listProjects).Methods("GET")
+	r.HandleFunc("/organizations/{organization_name}/projects/new", h.newProject).Methods("GET")
+	r.HandleFunc("/organizations/{organization_name}/projects/create", h.createProject).Methods("POST")
+	r.HandleFunc("/projects/{project_id}/edit", h.editProject).Methods("GET")
+	r.HandleFunc("/projects/{project_id}/update", h.updateProject).Methods("POST")
+	r.HandleFunc("/projects/{project_id}/delete", h.deleteProject).Methods("POST")
+	r.HandleFunc("/projects/{project_id}/set-permission", h.setProjectPermission).Methods("POST")
+	r.HandleFunc("/projects/{project_id}/unset-permission", h.unsetProjectPermission).Methods("POST")
+}
+
+func (h *webHandlers) listProjects(w http.ResponseWriter, r *http.Request) {
+	org, err := decode.Param("organization_name", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	projects, err := h.listAllProjects(r, org)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	user, err := internal.SubjectFromContext(r.Context())
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.Render("project_list.tmpl", w, struct {
+		organization.OrganizationPage
+		Projects  []*Project
+		CanCreate bool
+	}{
+		OrganizationPage: organization.NewPage(r, "projects", org),
+		Projects:         projects,
+		CanCreate:        user.CanAccessOrganization(rbac.CreateProjectAction, org),
+	})
+}
+
+func (h *webHandlers) newProject(w http.ResponseWriter, r *http.Request) {
+	org, err := decode.Param("organization_name", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	h.Render("project_new.tmpl", w, struct {
+		organization.OrganizationPage
+		Project    *Project
+		FormAction string
+	}{
+		OrganizationPage: organization.NewPage(r, "new project", org),
+		Project:          &Project{},
+		FormAction:       paths.CreateProject(org),
+	})
+}
+
+func (h *webHandlers) createProject(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Name         *string `schema:"name,required"`
+		Description  *string
+		Organization string `schema:"organization_name,required"`
+	}
+	if err := decode.All(&params, r); err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	project, err := h.client.CreateProject(r.Context(), params.Organization, CreateProjectOptions{
+		Name:        params.Name,
+		Description: params.Description,
+	})
+	if err != nil {
+		html.FlashError(w, err.Error())
+		http.Redirect(w, r, paths.NewProject(params.Organization), http.StatusFound)
+		return
+	}
+
+	html.FlashSuccess(w, "created project: "+project.Name)
+	http.Redirect(w, r, paths.EditProject(project.ID), http.StatusFound)
+}
+
+func (h *webHandlers) editProject(w http.ResponseWriter, r *http.Request) {
+	projectID, err := decode.Param("project_id", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	project, err := h.client.GetProject(r.Context(), projectID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	perms, err := h.client.ListProjectPermissions(r.Context(), projectID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Get teams for populating team permissions
+	teams, err := h.teams.List(r.Context(), project.Organization)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// want permissions to include not only team ID but team name too for
+	// user's benefit
+	type perm struct {
+		Role rbac.Role
+		Team *team.Team
+	}
+	var (
+		assigned   []perm
+		unassigned []*team.Team
+	)
+	for _, t := range teams {
+		var found bool
+		for _, pp := range perms {
+			if t.ID == pp.TeamID {
+				assigned = append(assigned, perm{Role: pp.Role, Team: t})
+				found = true
+				break
+			}
+		}
+		if !found {
+			unassigned = append(unassigned, t)
+		}
+	}
+
+	user, err := internal.SubjectFromContext(r.Context())
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.Render("project_edit.tmpl", w, struct {
+		organization.OrganizationPage
+		Project    *Project
+		FormAction string
+		Assigned   []perm
+		Unassigned []*team.Team
+		Roles      []rbac.Role
+		CanUpdate  bool
+		CanDelete  bool
+	}{
+		OrganizationPage: organization.NewPage(r, "edit | "+project.ID, project.Organization),
+		Project:          project,
+		FormAction:       paths.UpdateProject(project.ID),
+		Assigned:         assigned,
+		Unassigned:       unassigned,
+		Roles: []rbac.Role{
+			rbac.WorkspaceReadRole,
+			rbac.WorkspacePlanRole,
+			rbac.WorkspaceWriteRole,
+			rbac.WorkspaceAdminRole,
+		},
+		CanUpdate: user.CanAccessOrganization(rbac.UpdateProjectAction, project.Organization),
+		CanDelete: user.CanAccessOrganization(rbac.DeleteProjectAction, project.Organization),
+	})
+}
+
+func (h *webHandlers) updateProject(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		ProjectID   string `schema:"project_id,required"`
+		Name        *string
+		Description *string
+	}
+	if err := decode.All(&params, r); err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	project, err := h.client.UpdateProject(r.Context(), params.ProjectID, UpdateProjectOptions{
+		Name:        params.Name,
+		Description: params.Description,
+	})
+	if err != nil {
+		html.FlashError(w, err.Error())
+		http.Redirect(w, r, paths.EditProject(params.ProjectID), http.StatusFound)
+		return
+	}
+
+	html.FlashSuccess(w, "updated project: "+project.Name)
+	http.Redirect(w, r, paths.EditProject(project.ID), http.StatusFound)
+}
+
+func (h *webHandlers) deleteProject(w http.ResponseWriter, r *http.Request) {
+	projectID, err := decode.Param("project_id", r)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	project, err := h.client.GetProject(r.Context(), projectID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := h.client.DeleteProject(r.Context(), projectID); err != nil {
+		html.FlashError(w, "deleting project: "+err.Error())
+		http.Redirect(w, r, paths.EditProject(projectID), http.StatusFound)
+		return
+	}
+
+	html.FlashSuccess(w, "deleted project: "+project.Name)
+	http.Redirect(w, r, paths.Projects(project.Organization), http.StatusFound)
+}
+
+func (h *webHandlers) setProjectPermission(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		ProjectID string `schema:"project_id,required"`
+		TeamID    string `schema:"team_id,required"`
+		Role      string `schema:"role,required"`
+	}
+	if err := decode.All(&params, r); err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	role, err := rbac.WorkspaceRoleFromString(params.Role)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	_, err = h.client.SetProjectPermission(r.Context(), params.ProjectID, params.TeamID, role)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	html.FlashSuccess(w, "updated project permissions")
+	http.Redirect(w, r, paths.EditProject(params.ProjectID), http.StatusFound)
+}
+
+func (h *webHandlers) unsetProjectPermission(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		ProjectID string `schema:"project_id,required"`
+		TeamID    string `schema:"team_id,required"`
+	}
+	if err := decode.All(&params, r); err != nil {
+		h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	err := h.client.UnsetProjectPermission(r.Context(), params.ProjectID, params.TeamID)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	html.FlashSuccess(w, "deleted project permission")
+	http.Redirect(w, r, paths.EditProject(params.ProjectID), http.StatusFound)
+}
+
+// listAllProjects lists all projects in an organization.
+func (h *webHandlers) listAllProjects(r *http.Request, organization string) ([]*Project, error) {
+	return resource.ListAll(func(opts resource.PageOptions) (*resource.Page[*Project], error) {
+		return h.client.ListProjects(r.Context(), organization, ListProjectsOptions{
+			PageOptions: opts,
+		})
+	})
+}
@@ -0,0 +1,111 @@
+package workspace
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/antchfx/htmlquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/http/html/paths"
+	"github.com/tofutf/tofutf/internal/team"
+	"github.com/tofutf/tofutf/internal/testutils"
+	"github.com/tofutf/tofutf/internal/user"
+)
+
+func TestListProjectsHandler(t *testing.T) {
+	h := &webHandlers{
+		Renderer: testutils.NewRenderer(t),
+		client: &FakeService{Projects: []*Project{
+			{ID: "prj-1", Name: "networking", Organization: "acme-corp"},
+			{ID: "prj-2", Name: "compute", Organization: "acme-corp"},
+		}},
+	}
+
+	r := httptest.NewRequest("GET", "/?organization_name=acme-corp", nil)
+	r = r.WithContext(internal.AddSubjectToContext(context.Background(), &user.SiteAdmin))
+	w := httptest.NewRecorder()
+	h.listProjects(w, r)
+	require.Equal(t, 200, w.Code, w.Body.String())
+
+	doc, err := htmlquery.Parse(w.Body)
+	require.NoError(t, err)
+	assert.NotNil(t, htmlquery.FindOne(doc, "//div[@id='item-project-networking']"))
+	assert.NotNil(t, htmlquery.FindOne(doc, "//div[@id='item-project-compute']"))
+	assert.NotNil(t, htmlquery.FindOne(doc, "//button[@id='new-project-button']"))
+}
+
+func TestNewProjectHandler(t *testing.T) {
+	h := &webHandlers{Renderer: testutils.NewRenderer(t)}
+
+	r := httptest.NewRequest("GET", "/?organization_name=acme-corp", nil)
+	w := httptest.NewRecorder()
+	h.newProject(w, r)
+	assert.Equal(t, 200, w.Code, w.Body.String())
+}
+
+func TestCreateProjectHandler(t *testing.T) {
+	h := &webHandlers{
+		Renderer: testutils.NewRenderer(t),
+		client:   &FakeService{},
+	}
+
+	form := strings.NewReader(url.Values{
+		"name": {"networking"},
+	}.Encode())
+	r := httptest.NewRequest("POST", "/?organization_name=acme-corp", form)
+	r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	h.createProject(w, r)
+	if assert.Equal(t, 302, w.Code, w.Body.String()) {
+		redirect, err := w.Result().Location()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(redirect.Path, "/app/projects/prj-"), redirect.Path)
+	}
+}
+
+func TestEditProjectHandler(t *testing.T) {
+	h := &webHandlers{
+		Renderer: testutils.NewRenderer(t),
+		client: &FakeService{Projects: []*Project{
+			{ID: "prj-1", Name: "networking", Organization: "acme-corp"},
+		}},
+		teams: &fakeTeamService{teams: []*team.Team{
+			{ID: "team-1", Name: "devops"},
+		}},
+	}
+
+	r := httptest.NewRequest("GET", "/?project_id=prj-1", nil)
+	r = r.WithContext(internal.AddSubjectToContext(context.Background(), &user.SiteAdmin))
+	w := httptest.NewRecorder()
+	h.editProject(w, r)
+	require.Equal(t, 200, w.Code, w.Body.String())
+
+	doc, err := htmlquery.Parse(w.Body)
+	require.NoError(t, err)
+	// unassigned team should be selectable
+	findText(t, doc, "devops", "//select[@id='permissions-add-select-team']/option[@value='team-1']")
+	assert.NotNil(t, htmlquery.FindOne(doc, "//button[@id='delete-project-button']"))
+}
+
+func TestDeleteProjectHandler(t *testing.T) {
+	h := &webHandlers{
+		Renderer: testutils.NewRenderer(t),
+		client: &FakeService{Projects: []*Project{
+			{ID: "prj-1", Name: "networking", Organization: "acme-corp"},
+		}},
+	}
+
+	r := httptest.NewRequest("POST", "/?project_id=prj-1", nil)
+	w := httptest.NewRecorder()
+	h.deleteProject(w, r)
+	if assert.Equal(t, 302, w.Code, w.Body.String()) {
+		redirect, err := w.Result().Location()
+		require.NoError(t, err)
+		assert.Equal(t, paths.Projects("acme-corp"), redirect.Path)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/tofutf/tofutf/internal"
@@ -104,7 +105,9 @@ func (s *Service) AddHandlers(r *mux.Router) {
 	s.web.addHandlers(r)
 	s.tfeapi.addHandlers(r)
 	s.web.addTagHandlers(r)
+	s.web.addProjectHandlers(r)
 	s.tfeapi.addTagHandlers(r)
+	s.tfeapi.addProjectHandlers(r)
 	s.api.addHandlers(r)
 }
 
@@ -130,6 +133,9 @@ func (s *Service) Create(ctx context.Context, opts CreateOptions) (*Workspace, e
 				return err
 			}
 		}
+		if err := s.checkProject(ctx, ws); err != nil {
+			return err
+		}
 		if err := s.db.create(ctx, ws); err != nil {
 			return err
 		}
@@ -223,7 +229,7 @@ func (s *Service) List(ctx context.Context, opts ListOptions) (*resource.Page[*W
 			if err != nil {
 				return nil, err
 			}
-			if user, ok := subject.(*user.User); ok {
+			if user, ok := internal.UnwrapSubject(subject).(*user.User); ok {
 				return s.db.listByUsername(ctx, user.Username, *opts.Organization, opts.PageOptions)
 			}
 		} else if err != nil {
@@ -259,7 +265,13 @@ func (s *Service) Update(ctx context.Context, workspaceID string, opts UpdateOpt
 				}
 			}
 			connect, err = ws.Update(opts)
-			return err
+			if err != nil {
+				return err
+			}
+			if opts.ProjectID != nil {
+				return s.checkProject(ctx, ws)
+			}
+			return nil
 		})
 		if err != nil {
 			return err
@@ -361,3 +373,26 @@ func (s *Service) disconnect(ctx context.Context, workspaceID string) error {
 func (s *Service) SetCurrentRun(ctx context.Context, workspaceID, runID string) (*Workspace, error) {
 	return s.db.setCurrentRun(ctx, workspaceID, runID)
 }
+
+// SetLatestAssessment sets the latest health assessment for the workspace.
+func (s *Service) SetLatestAssessment(ctx context.Context, workspaceID string, assessment Assessment) (*Workspace, error) {
+	return s.db.setLatestAssessment(ctx, workspaceID, assessment)
+}
+
+// ListForAssessment lists workspaces with health assessments enabled that
+// have not been assessed since the given time.
+func (s *Service) ListForAssessment(ctx context.Context, dueBefore time.Time) ([]*Workspace, error) {
+	return s.db.listForAssessment(ctx, dueBefore)
+}
+
+// SetAutoDestroyRun records the destroy run queued by auto-destroy for the
+// workspace.
+func (s *Service) SetAutoDestroyRun(ctx context.Context, workspaceID, runID string) (*Workspace, error) {
+	return s.db.setAutoDestroyRun(ctx, workspaceID, runID)
+}
+
+// ListForAutoDestroy lists workspaces with either an auto-destroy deadline or
+// an inactivity duration.
+func (s *Service) ListForAutoDestroy(ctx context.Context) ([]*Workspace, error) {
+	return s.db.listForAutoDestroy(ctx)
+}
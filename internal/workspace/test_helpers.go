@@ -14,6 +14,7 @@ import (
 type FakeService struct {
 	Workspaces []*Workspace
 	Policy     internal.WorkspacePolicy
+	Projects   []*Project
 }
 
 func (f *FakeService) ListConnectedWorkspaces(ctx context.Context, vcsProviderID, repoPath string) ([]*Workspace, error) {
@@ -30,7 +31,16 @@ func (f *FakeService) Update(_ context.Context, _ string, opts UpdateOptions) (*
 }
 
 func (f *FakeService) List(ctx context.Context, opts ListOptions) (*resource.Page[*Workspace], error) {
-	return resource.NewPage(f.Workspaces, opts.PageOptions, nil), nil
+	if opts.ProjectID == nil {
+		return resource.NewPage(f.Workspaces, opts.PageOptions, nil), nil
+	}
+	var filtered []*Workspace
+	for _, ws := range f.Workspaces {
+		if ws.ProjectID != nil && *ws.ProjectID == *opts.ProjectID {
+			filtered = append(filtered, ws)
+		}
+	}
+	return resource.NewPage(filtered, opts.PageOptions, nil), nil
 }
 
 func (f *FakeService) Get(context.Context, string) (*Workspace, error) {
@@ -77,6 +87,41 @@ func (f *FakeService) UnsetPermission(ctx context.Context, workspaceID, teamID s
 	return nil
 }
 
+func (f *FakeService) CreateProject(ctx context.Context, organization string, opts CreateProjectOptions) (*Project, error) {
+	return newProject(organization, opts)
+}
+
+func (f *FakeService) UpdateProject(ctx context.Context, projectID string, opts UpdateProjectOptions) (*Project, error) {
+	if err := f.Projects[0].update(opts); err != nil {
+		return nil, err
+	}
+	return f.Projects[0], nil
+}
+
+func (f *FakeService) ListProjects(ctx context.Context, organization string, opts ListProjectsOptions) (*resource.Page[*Project], error) {
+	return resource.NewPage(f.Projects, opts.PageOptions, nil), nil
+}
+
+func (f *FakeService) GetProject(ctx context.Context, projectID string) (*Project, error) {
+	return f.Projects[0], nil
+}
+
+func (f *FakeService) DeleteProject(ctx context.Context, projectID string) error {
+	return nil
+}
+
+func (f *FakeService) SetProjectPermission(ctx context.Context, projectID, teamID string, role rbac.Role) (*ProjectPermission, error) {
+	return &ProjectPermission{ProjectID: projectID, TeamID: teamID, Role: role}, nil
+}
+
+func (f *FakeService) ListProjectPermissions(ctx context.Context, projectID string) ([]*ProjectPermission, error) {
+	return nil, nil
+}
+
+func (f *FakeService) UnsetProjectPermission(ctx context.Context, projectID, teamID string) error {
+	return nil
+}
+
 type fakeVCSProviderService struct {
 	providers []*vcsprovider.VCSProvider
 	repos     []string
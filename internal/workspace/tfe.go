@@ -11,6 +11,7 @@ import (
 	"github.com/tofutf/tofutf/internal"
 	"github.com/tofutf/tofutf/internal/http/decode"
 	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/releases"
 	"github.com/tofutf/tofutf/internal/resource"
 	"github.com/tofutf/tofutf/internal/tfeapi"
 	"github.com/tofutf/tofutf/internal/tfeapi/types"
@@ -62,24 +63,29 @@ func (a *tfe) createWorkspace(w http.ResponseWriter, r *http.Request) {
 	}
 
 	opts := CreateOptions{
-		AgentPoolID:                params.AgentPoolID,
-		AllowDestroyPlan:           params.AllowDestroyPlan,
-		AutoApply:                  params.AutoApply,
-		Description:                params.Description,
-		ExecutionMode:              (*ExecutionMode)(params.ExecutionMode),
-		GlobalRemoteState:          params.GlobalRemoteState,
-		MigrationEnvironment:       params.MigrationEnvironment,
-		Name:                       params.Name,
-		Organization:               params.Organization,
-		QueueAllRuns:               params.QueueAllRuns,
-		SpeculativeEnabled:         params.SpeculativeEnabled,
-		SourceName:                 params.SourceName,
-		SourceURL:                  params.SourceURL,
-		StructuredRunOutputEnabled: params.StructuredRunOutputEnabled,
-		TerraformVersion:           params.TerraformVersion,
-		TriggerPrefixes:            params.TriggerPrefixes,
-		TriggerPatterns:            params.TriggerPatterns,
-		WorkingDirectory:           params.WorkingDirectory,
+		AgentPoolID:                 params.AgentPoolID,
+		AllowDestroyPlan:            params.AllowDestroyPlan,
+		AssessmentsEnabled:          params.AssessmentsEnabled,
+		AutoApply:                   params.AutoApply,
+		AutoDestroyAt:               params.AutoDestroyAt,
+		AutoDestroyActivityDuration: params.AutoDestroyActivityDuration,
+		DeleteAfterAutoDestroy:      params.DeleteAfterAutoDestroy,
+		Description:                 params.Description,
+		Engine:                      (*releases.Engine)(params.Engine),
+		ExecutionMode:               (*ExecutionMode)(params.ExecutionMode),
+		GlobalRemoteState:           params.GlobalRemoteState,
+		MigrationEnvironment:        params.MigrationEnvironment,
+		Name:                        params.Name,
+		Organization:                params.Organization,
+		QueueAllRuns:                params.QueueAllRuns,
+		SpeculativeEnabled:          params.SpeculativeEnabled,
+		SourceName:                  params.SourceName,
+		SourceURL:                   params.SourceURL,
+		StructuredRunOutputEnabled:  params.StructuredRunOutputEnabled,
+		TerraformVersion:            params.TerraformVersion,
+		TriggerPrefixes:             params.TriggerPrefixes,
+		TriggerPatterns:             params.TriggerPatterns,
+		WorkingDirectory:            params.WorkingDirectory,
 		// convert from json:api structs to tag specs
 		Tags: toTagSpecs(params.Tags),
 	}
@@ -99,6 +105,9 @@ func (a *tfe) createWorkspace(w http.ResponseWriter, r *http.Request) {
 			opts.ExecutionMode = ExecutionModePtr(LocalExecutionMode)
 		}
 	}
+	if params.Project != nil {
+		opts.ProjectID = &params.Project.ID
+	}
 	if params.VCSRepo != nil {
 		if params.VCSRepo.Identifier == nil || params.VCSRepo.OAuthTokenID == nil {
 			tfeapi.Error(w, errors.New("must specify both oauth-token-id and identifier attributes for vcs-repo"))
@@ -182,12 +191,16 @@ func (a *tfe) listWorkspaces(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	page, err := a.List(r.Context(), ListOptions{
+	opts := ListOptions{
 		Search:       params.Search,
 		Organization: &organization,
 		PageOptions:  resource.PageOptions(params.ListOptions),
 		Tags:         internal.SplitCSV(params.Tags),
-	})
+	}
+	if params.ProjectID != "" {
+		opts.ProjectID = &params.ProjectID
+	}
+	page, err := a.List(r.Context(), opts)
 	if err != nil {
 		tfeapi.Error(w, err)
 		return
@@ -351,8 +364,11 @@ func (a *tfe) updateWorkspace(w http.ResponseWriter, r *http.Request, workspaceI
 	opts := UpdateOptions{
 		AgentPoolID:                params.AgentPoolID,
 		AllowDestroyPlan:           params.AllowDestroyPlan,
+		AssessmentsEnabled:         params.AssessmentsEnabled,
 		AutoApply:                  params.AutoApply,
+		DeleteAfterAutoDestroy:     params.DeleteAfterAutoDestroy,
 		Description:                params.Description,
+		Engine:                     (*releases.Engine)(params.Engine),
 		ExecutionMode:              (*ExecutionMode)(params.ExecutionMode),
 		GlobalRemoteState:          params.GlobalRemoteState,
 		Name:                       params.Name,
@@ -377,6 +393,26 @@ func (a *tfe) updateWorkspace(w http.ResponseWriter, r *http.Request, workspaceI
 		opts.AlwaysTrigger = internal.Bool(true)
 	}
 
+	// A null auto-destroy-at removes the deadline, and a null
+	// auto-destroy-activity-duration stops destroying inactive resources.
+	if params.AutoDestroyAt.Set {
+		if params.AutoDestroyAt.Valid {
+			opts.AutoDestroyAt = &params.AutoDestroyAt.Time
+		} else {
+			opts.ClearAutoDestroyAt = true
+		}
+	}
+	if params.AutoDestroyActivityDuration.Set {
+		opts.AutoDestroyActivityDuration = internal.String("")
+		if params.AutoDestroyActivityDuration.Valid {
+			opts.AutoDestroyActivityDuration = &params.AutoDestroyActivityDuration.String
+		}
+	}
+
+	if params.Project != nil {
+		opts.ProjectID = &params.Project.ID
+	}
+
 	if params.VCSRepo.Set {
 		if params.VCSRepo.Valid {
 			// client has provided non-null vcs options, which means they either
@@ -436,17 +472,23 @@ func (a *tfe) convert(from *Workspace, r *http.Request) (*types.Workspace, error
 		Actions: &types.WorkspaceActions{
 			IsDestroyable: true,
 		},
-		AllowDestroyPlan:     from.AllowDestroyPlan,
-		AutoApply:            from.AutoApply,
-		CanQueueDestroyPlan:  from.CanQueueDestroyPlan,
-		CreatedAt:            from.CreatedAt,
-		Description:          from.Description,
-		Environment:          from.Environment,
-		ExecutionMode:        string(from.ExecutionMode),
-		GlobalRemoteState:    from.GlobalRemoteState,
-		Locked:               from.Locked(),
-		MigrationEnvironment: from.MigrationEnvironment,
-		Name:                 from.Name,
+		AllowDestroyPlan:            from.AllowDestroyPlan,
+		AssessmentsEnabled:          from.AssessmentsEnabled,
+		AutoApply:                   from.AutoApply,
+		AutoDestroyAt:               from.AutoDestroyAt,
+		AutoDestroyActivityDuration: from.AutoDestroyActivityDuration,
+		CanQueueDestroyPlan:         from.CanQueueDestroyPlan,
+		CreatedAt:                   from.CreatedAt,
+		DeleteAfterAutoDestroy:      from.DeleteAfterAutoDestroy,
+		Description:                 from.Description,
+		Drifted:                     from.Drifted(),
+		Engine:                      string(from.Engine),
+		Environment:                 from.Environment,
+		ExecutionMode:               string(from.ExecutionMode),
+		GlobalRemoteState:           from.GlobalRemoteState,
+		Locked:                      from.Locked(),
+		MigrationEnvironment:        from.MigrationEnvironment,
+		Name:                        from.Name,
 		// Operations is deprecated but clients and go-tfe tests still use it
 		Operations:                 from.ExecutionMode == "remote",
 		Permissions:                perms,
@@ -472,6 +514,9 @@ func (a *tfe) convert(from *Workspace, r *http.Request) (*types.Workspace, error
 	if from.LatestRun != nil {
 		to.CurrentRun = &types.Run{ID: from.LatestRun.ID}
 	}
+	if from.ProjectID != nil {
+		to.Project = &types.Project{ID: *from.ProjectID}
+	}
 
 	// Add VCS repo to json:api struct if connected. NOTE: the terraform CLI
 	// uses the presence of VCS repo to determine whether to allow a terraform
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/tofutf/tofutf/internal"
@@ -13,6 +14,7 @@ import (
 	"github.com/tofutf/tofutf/internal/http/html/paths"
 	"github.com/tofutf/tofutf/internal/organization"
 	"github.com/tofutf/tofutf/internal/rbac"
+	"github.com/tofutf/tofutf/internal/releases"
 	"github.com/tofutf/tofutf/internal/resource"
 	"github.com/tofutf/tofutf/internal/team"
 	"github.com/tofutf/tofutf/internal/vcs"
@@ -38,6 +40,10 @@ const (
 	VCSTriggerPatterns string = "patterns"
 	// only push tag vcs events trigger runs
 	VCSTriggerTags string = "tags"
+
+	// autoDestroyAtFormat is the format of the datetime-local input for the
+	// auto-destroy deadline.
+	autoDestroyAtFormat = "2006-01-02T15:04"
 )
 
 type (
@@ -78,6 +84,15 @@ type (
 		GetPolicy(ctx context.Context, workspaceID string) (internal.WorkspacePolicy, error)
 		SetPermission(ctx context.Context, workspaceID, teamID string, role rbac.Role) error
 		UnsetPermission(ctx context.Context, workspaceID, teamID string) error
+
+		CreateProject(ctx context.Context, organization string, opts CreateProjectOptions) (*Project, error)
+		UpdateProject(ctx context.Context, projectID string, opts UpdateProjectOptions) (*Project, error)
+		ListProjects(ctx context.Context, organization string, opts ListProjectsOptions) (*resource.Page[*Project], error)
+		GetProject(ctx context.Context, projectID string) (*Project, error)
+		DeleteProject(ctx context.Context, projectID string) error
+		SetProjectPermission(ctx context.Context, projectID, teamID string, role rbac.Role) (*ProjectPermission, error)
+		ListProjectPermissions(ctx context.Context, projectID string) ([]*ProjectPermission, error)
+		UnsetProjectPermission(ctx context.Context, projectID, teamID string) error
 	}
 
 	// WorkspacePage contains data shared by all workspace-based pages.
@@ -122,6 +137,7 @@ func (h *webHandlers) listWorkspaces(w http.ResponseWriter, r *http.Request) {
 	var params struct {
 		Search       string   `schema:"search[name],omitempty"`
 		Tags         []string `schema:"search[tags],omitempty"`
+		ProjectID    string   `schema:"search[project],omitempty"`
 		Organization *string  `schema:"organization_name,required"`
 		PageNumber   int      `schema:"page[number]"`
 	}
@@ -130,7 +146,7 @@ func (h *webHandlers) listWorkspaces(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	workspaces, err := h.client.List(r.Context(), ListOptions{
+	opts := ListOptions{
 		Search:       params.Search,
 		Tags:         params.Tags,
 		Organization: params.Organization,
@@ -138,7 +154,11 @@ func (h *webHandlers) listWorkspaces(w http.ResponseWriter, r *http.Request) {
 			PageNumber: params.PageNumber,
 			PageSize:   html.PageSize,
 		},
-	})
+	}
+	if params.ProjectID != "" {
+		opts.ProjectID = &params.ProjectID
+	}
+	workspaces, err := h.client.List(r.Context(), opts)
 	if err != nil {
 		h.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -169,6 +189,12 @@ func (h *webHandlers) listWorkspaces(w http.ResponseWriter, r *http.Request) {
 		return m
 	}
 
+	projects, err := h.listAllProjects(r, *params.Organization)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	user, err := internal.SubjectFromContext(r.Context())
 	if err != nil {
 		h.Error(w, err.Error(), http.StatusInternalServerError)
@@ -179,6 +205,8 @@ func (h *webHandlers) listWorkspaces(w http.ResponseWriter, r *http.Request) {
 		organization.OrganizationPage
 		*resource.Page[*Workspace]
 		TagFilters         map[string]bool
+		Projects           []*Project
+		ProjectID          string
 		Search             string
 		CanCreateWorkspace bool
 	}{
@@ -186,6 +214,8 @@ func (h *webHandlers) listWorkspaces(w http.ResponseWriter, r *http.Request) {
 		CanCreateWorkspace: user.CanAccessOrganization(rbac.CreateTeamAction, *params.Organization),
 		Page:               workspaces,
 		TagFilters:         tagfilters(),
+		Projects:           projects,
+		ProjectID:          params.ProjectID,
 		Search:             params.Search,
 	}
 
@@ -418,12 +448,20 @@ func (h *webHandlers) editWorkspace(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	projects, err := h.listAllProjects(r, workspace.Organization)
+	if err != nil {
+		h.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	h.Render("workspace_edit.tmpl", w, struct {
 		WorkspacePage
 		Assigned           []perm
 		Unassigned         []*team.Team
 		Roles              []rbac.Role
 		VCSProvider        *vcsprovider.VCSProvider
+		Projects           []*Project
+		ProjectID          string
 		UnassignedTags     []string
 		CanUpdateWorkspace bool
 		CanDeleteWorkspace bool
@@ -445,6 +483,8 @@ func (h *webHandlers) editWorkspace(w http.ResponseWriter, r *http.Request) {
 			rbac.WorkspaceAdminRole,
 		},
 		VCSProvider:        provider,
+		Projects:           projects,
+		ProjectID:          internal.NewStringFromPtr(workspace.ProjectID),
 		UnassignedTags:     internal.DiffStrings(getTagNames(), workspace.Tags),
 		VCSTagRegexDefault: vcsTagRegexDefault,
 		VCSTagRegexPrefix:  vcsTagRegexPrefix,
@@ -460,15 +500,26 @@ func (h *webHandlers) editWorkspace(w http.ResponseWriter, r *http.Request) {
 
 func (h *webHandlers) updateWorkspace(w http.ResponseWriter, r *http.Request) {
 	var params struct {
-		AgentPoolID       string `schema:"agent_pool_id"`
-		AutoApply         bool   `schema:"auto_apply"`
-		Name              string
-		Description       string
-		ExecutionMode     ExecutionMode `schema:"execution_mode"`
-		TerraformVersion  string        `schema:"terraform_version"`
-		WorkingDirectory  string        `schema:"working_directory"`
-		WorkspaceID       string        `schema:"workspace_id,required"`
-		GlobalRemoteState bool          `schema:"global_remote_state"`
+		AgentPoolID        string `schema:"agent_pool_id"`
+		AgentSelector      string `schema:"agent_selector"`
+		PlanTimeout        int    `schema:"plan_timeout"`
+		ApplyTimeout       int    `schema:"apply_timeout"`
+		AssessmentsEnabled bool   `schema:"assessments_enabled"`
+		AutoApply          bool   `schema:"auto_apply"`
+		Name               string
+		Description        string
+		Engine             releases.Engine `schema:"engine"`
+
+		// Auto-destroy
+		AutoDestroyAt               string        `schema:"auto_destroy_at"`
+		AutoDestroyActivityDuration string        `schema:"auto_destroy_activity_duration"`
+		DeleteAfterAutoDestroy      bool          `schema:"delete_after_auto_destroy"`
+		ExecutionMode               ExecutionMode `schema:"execution_mode"`
+		TerraformVersion            string        `schema:"terraform_version"`
+		WorkingDirectory            string        `schema:"working_directory"`
+		WorkspaceID                 string        `schema:"workspace_id,required"`
+		GlobalRemoteState           bool          `schema:"global_remote_state"`
+		ProjectID                   string        `schema:"project_id"`
 
 		// VCS connection
 		VCSTriggerStrategy  string `schema:"vcs_trigger"`
@@ -491,13 +542,39 @@ func (h *webHandlers) updateWorkspace(w http.ResponseWriter, r *http.Request) {
 	}
 
 	opts := UpdateOptions{
-		AutoApply:         &params.AutoApply,
-		Name:              &params.Name,
-		Description:       &params.Description,
-		ExecutionMode:     &params.ExecutionMode,
-		TerraformVersion:  &params.TerraformVersion,
-		WorkingDirectory:  &params.WorkingDirectory,
-		GlobalRemoteState: &params.GlobalRemoteState,
+		AgentSelector:      &params.AgentSelector,
+		PlanTimeout:        &params.PlanTimeout,
+		ApplyTimeout:       &params.ApplyTimeout,
+		AssessmentsEnabled: &params.AssessmentsEnabled,
+		AutoApply:          &params.AutoApply,
+		Name:               &params.Name,
+		Description:        &params.Description,
+		ExecutionMode:      &params.ExecutionMode,
+		TerraformVersion:   &params.TerraformVersion,
+		WorkingDirectory:   &params.WorkingDirectory,
+		GlobalRemoteState:  &params.GlobalRemoteState,
+
+		AutoDestroyActivityDuration: &params.AutoDestroyActivityDuration,
+		DeleteAfterAutoDestroy:      &params.DeleteAfterAutoDestroy,
+	}
+	if params.Engine != "" {
+		opts.Engine = &params.Engine
+	}
+	if params.AutoDestroyAt != "" {
+		// datetime-local inputs have no timezone; the form specifies UTC.
+		autoDestroyAt, err := time.Parse(autoDestroyAtFormat, params.AutoDestroyAt)
+		if err != nil {
+			h.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		opts.AutoDestroyAt = &autoDestroyAt
+	} else if ws.AutoDestroyAt != nil {
+		opts.ClearAutoDestroyAt = true
+	}
+	// only move workspace if the project has changed; an empty project ID
+	// removes the workspace from its project.
+	if (ws.ProjectID == nil && params.ProjectID != "") || (ws.ProjectID != nil && *ws.ProjectID != params.ProjectID) {
+		opts.ProjectID = &params.ProjectID
 	}
 	if ws.Connection != nil {
 		// workspace is connected, so set connection fields
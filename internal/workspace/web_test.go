@@ -160,6 +160,20 @@ func TestEditWorkspaceHandler(t *testing.T) {
 				findTextNot(t, doc, "//select[@form='permissions-add-form']/option[@value='owners']")
 			},
 		},
+		{
+			name: "with assessment",
+			ws: &Workspace{
+				ID:                 "ws-123",
+				AssessmentsEnabled: true,
+				LatestAssessment:   &Assessment{RunID: "run-123", Status: AssessmentNoDrift},
+			},
+			user: user.SiteAdmin,
+			want: func(t *testing.T, doc *html.Node) {
+				got := htmlquery.FindOne(doc, "//input[@id='assessments-enabled']")
+				assert.Contains(t, testutils.AttrMap(got), "checked")
+				findText(t, doc, "no drift", "//span[@id='latest-assessment-status']")
+			},
+		},
 		{
 			name: "connected repo",
 			ws:   &Workspace{ID: "ws-123", Connection: &Connection{Repo: "leg100/otf"}},
@@ -277,6 +291,25 @@ func TestListWorkspacesHandler_WithLatestRun(t *testing.T) {
 	assert.Equal(t, 200, w.Code, w.Body.String())
 }
 
+func TestListWorkspacesHandler_Drifted(t *testing.T) {
+	ws := &Workspace{
+		ID:               "ws-foo",
+		Name:             "dev",
+		LatestAssessment: &Assessment{RunID: "run-123", Status: AssessmentDrifted},
+	}
+	app := &webHandlers{
+		Renderer: testutils.NewRenderer(t),
+		client:   &FakeService{Workspaces: []*Workspace{ws}},
+	}
+
+	r := httptest.NewRequest("GET", "/?organization_name=acme", nil)
+	r = r.WithContext(internal.AddSubjectToContext(context.Background(), &user.SiteAdmin))
+	w := httptest.NewRecorder()
+	app.listWorkspaces(w, r)
+	assert.Equal(t, 200, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), `id="ws-foo-drifted"`)
+}
+
 func TestDeleteWorkspace(t *testing.T) {
 	ws := &Workspace{ID: "ws-123", Organization: "acme-corp"}
 	app := &webHandlers{
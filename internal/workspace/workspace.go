@@ -25,6 +25,7 @@ const (
 
 	DefaultAllowDestroyPlan = true
 	MinTerraformVersion     = "1.2.0"
+	MinTofuVersion          = "1.6.0"
 )
 
 var (
@@ -34,30 +35,65 @@ var (
 type (
 	// Workspace is a terraform workspace.
 	Workspace struct {
-		ID                         string        `jsonapi:"primary,workspaces"`
-		CreatedAt                  time.Time     `jsonapi:"attribute" json:"created_at"`
-		UpdatedAt                  time.Time     `jsonapi:"attribute" json:"updated_at"`
-		AgentPoolID                *string       `jsonapi:"attribute" json:"agent-pool-id"`
-		AllowDestroyPlan           bool          `jsonapi:"attribute" json:"allow_destroy_plan"`
-		AutoApply                  bool          `jsonapi:"attribute" json:"auto_apply"`
-		CanQueueDestroyPlan        bool          `jsonapi:"attribute" json:"can_queue_destroy_plan"`
-		Description                string        `jsonapi:"attribute" json:"description"`
-		Environment                string        `jsonapi:"attribute" json:"environment"`
-		ExecutionMode              ExecutionMode `jsonapi:"attribute" json:"execution_mode"`
-		GlobalRemoteState          bool          `jsonapi:"attribute" json:"global_remote_state"`
-		MigrationEnvironment       string        `jsonapi:"attribute" json:"migration_environment"`
-		Name                       string        `jsonapi:"attribute" json:"name"`
-		QueueAllRuns               bool          `jsonapi:"attribute" json:"queue_all_runs"`
-		SpeculativeEnabled         bool          `jsonapi:"attribute" json:"speculative_enabled"`
-		StructuredRunOutputEnabled bool          `jsonapi:"attribute" json:"structured_run_output_enabled"`
-		SourceName                 string        `jsonapi:"attribute" json:"source_name"`
-		SourceURL                  string        `jsonapi:"attribute" json:"source_url"`
-		TerraformVersion           string        `jsonapi:"attribute" json:"terraform_version"`
-		WorkingDirectory           string        `jsonapi:"attribute" json:"working_directory"`
-		Organization               string        `jsonapi:"attribute" json:"organization"`
-		LatestRun                  *LatestRun    `jsonapi:"attribute" json:"latest_run"`
-		Tags                       []string      `jsonapi:"attribute" json:"tags"`
-		Lock                       *Lock         `jsonapi:"attribute" json:"lock"`
+		ID                         string          `jsonapi:"primary,workspaces"`
+		CreatedAt                  time.Time       `jsonapi:"attribute" json:"created_at"`
+		UpdatedAt                  time.Time       `jsonapi:"attribute" json:"updated_at"`
+		AgentPoolID                *string         `jsonapi:"attribute" json:"agent-pool-id"`
+		AllowDestroyPlan           bool            `jsonapi:"attribute" json:"allow_destroy_plan"`
+		AssessmentsEnabled         bool            `jsonapi:"attribute" json:"assessments_enabled"`
+		AutoApply                  bool            `jsonapi:"attribute" json:"auto_apply"`
+		CanQueueDestroyPlan        bool            `jsonapi:"attribute" json:"can_queue_destroy_plan"`
+		Description                string          `jsonapi:"attribute" json:"description"`
+		Engine                     releases.Engine `jsonapi:"attribute" json:"engine"`
+		Environment                string          `jsonapi:"attribute" json:"environment"`
+		ExecutionMode              ExecutionMode   `jsonapi:"attribute" json:"execution_mode"`
+		GlobalRemoteState          bool            `jsonapi:"attribute" json:"global_remote_state"`
+		MigrationEnvironment       string          `jsonapi:"attribute" json:"migration_environment"`
+		Name                       string          `jsonapi:"attribute" json:"name"`
+		QueueAllRuns               bool            `jsonapi:"attribute" json:"queue_all_runs"`
+		SpeculativeEnabled         bool            `jsonapi:"attribute" json:"speculative_enabled"`
+		StructuredRunOutputEnabled bool            `jsonapi:"attribute" json:"structured_run_output_enabled"`
+		SourceName                 string          `jsonapi:"attribute" json:"source_name"`
+		SourceURL                  string          `jsonapi:"attribute" json:"source_url"`
+		TerraformVersion           string          `jsonapi:"attribute" json:"terraform_version"`
+		WorkingDirectory           string          `jsonapi:"attribute" json:"working_directory"`
+		Organization               string          `jsonapi:"attribute" json:"organization"`
+		LatestRun                  *LatestRun      `jsonapi:"attribute" json:"latest_run"`
+		Tags                       []string        `jsonapi:"attribute" json:"tags"`
+		Lock                       *Lock           `jsonapi:"attribute" json:"lock"`
+
+		// LatestAssessment is the most recent health assessment; nil means the
+		// workspace has never been assessed.
+		LatestAssessment *Assessment
+
+		// AutoDestroyAt is the time at which the workspace's resources are
+		// automatically destroyed; nil means there is no deadline.
+		AutoDestroyAt *time.Time `jsonapi:"attribute" json:"auto_destroy_at"`
+		// AutoDestroyActivityDuration automatically destroys the workspace's
+		// resources after a period of inactivity, e.g. "14d" or "2h"; nil means
+		// inactive workspaces are not destroyed.
+		AutoDestroyActivityDuration *string `jsonapi:"attribute" json:"auto_destroy_activity_duration"`
+		// DeleteAfterAutoDestroy deletes the workspace itself once its
+		// resources have been successfully auto-destroyed.
+		DeleteAfterAutoDestroy bool `jsonapi:"attribute" json:"delete_after_auto_destroy"`
+		// AutoDestroyRunID is the ID of the most recent destroy run queued by
+		// auto-destroy; nil means no run has been queued.
+		AutoDestroyRunID *string `jsonapi:"attribute" json:"auto_destroy_run_id"`
+
+		// ProjectID is the ID of the project to which the workspace belongs;
+		// nil means the workspace does not belong to a project.
+		ProjectID *string `jsonapi:"attribute" json:"project_id"`
+
+		// AgentSelector restricts the agents to which the workspace's jobs are
+		// allocated to those with labels matching the selector, e.g.
+		// "region=eu,gpu!=true". An empty string matches any agent.
+		AgentSelector string `jsonapi:"attribute" json:"agent_selector"`
+
+		// PlanTimeout and ApplyTimeout are the maximum durations in minutes of
+		// the plan and apply phases of the workspace's runs; nil means the
+		// organization's timeout applies.
+		PlanTimeout  *int `jsonapi:"attribute" json:"plan_timeout"`
+		ApplyTimeout *int `jsonapi:"attribute" json:"apply_timeout"`
 
 		// VCS Connection; nil means the workspace is not connected.
 		Connection *Connection
@@ -105,25 +141,34 @@ type (
 
 	// CreateOptions represents the options for creating a new workspace.
 	CreateOptions struct {
-		AgentPoolID                *string
-		AllowDestroyPlan           *bool
-		AutoApply                  *bool
-		Description                *string
-		ExecutionMode              *ExecutionMode
-		GlobalRemoteState          *bool
-		MigrationEnvironment       *string
-		Name                       *string
-		QueueAllRuns               *bool
-		SpeculativeEnabled         *bool
-		SourceName                 *string
-		SourceURL                  *string
-		StructuredRunOutputEnabled *bool
-		Tags                       []TagSpec
-		TerraformVersion           *string
-		TriggerPrefixes            []string
-		TriggerPatterns            []string
-		WorkingDirectory           *string
-		Organization               *string
+		AgentPoolID                 *string
+		AgentSelector               *string
+		AllowDestroyPlan            *bool
+		ApplyTimeout                *int
+		AssessmentsEnabled          *bool
+		AutoApply                   *bool
+		AutoDestroyAt               *time.Time
+		AutoDestroyActivityDuration *string
+		DeleteAfterAutoDestroy      *bool
+		Description                 *string
+		Engine                      *releases.Engine
+		ExecutionMode               *ExecutionMode
+		GlobalRemoteState           *bool
+		MigrationEnvironment        *string
+		Name                        *string
+		PlanTimeout                 *int
+		QueueAllRuns                *bool
+		SpeculativeEnabled          *bool
+		SourceName                  *string
+		SourceURL                   *string
+		StructuredRunOutputEnabled  *bool
+		Tags                        []TagSpec
+		TerraformVersion            *string
+		TriggerPrefixes             []string
+		TriggerPatterns             []string
+		WorkingDirectory            *string
+		Organization                *string
+		ProjectID                   *string
 
 		// Always trigger runs. A value of true is mutually exclusive with
 		// setting TriggerPatterns or ConnectOptions.TagsRegex.
@@ -133,14 +178,29 @@ type (
 	}
 
 	UpdateOptions struct {
-		AgentPoolID                *string `json:"agent-pool-id,omitempty"`
-		AllowDestroyPlan           *bool
-		AutoApply                  *bool
-		Name                       *string
-		Description                *string
-		ExecutionMode              *ExecutionMode `json:"execution-mode,omitempty"`
-		GlobalRemoteState          *bool
-		Operations                 *bool
+		AgentPoolID        *string `json:"agent-pool-id,omitempty"`
+		AgentSelector      *string
+		AllowDestroyPlan   *bool
+		AssessmentsEnabled *bool
+		AutoApply          *bool
+		AutoDestroyAt      *time.Time
+		// AutoDestroyActivityDuration sets the period of inactivity after
+		// which resources are destroyed. An empty string unsets it.
+		AutoDestroyActivityDuration *string
+		DeleteAfterAutoDestroy      *bool
+		Name                        *string
+		Description                 *string
+		Engine                      *releases.Engine
+		ExecutionMode               *ExecutionMode `json:"execution-mode,omitempty"`
+		GlobalRemoteState           *bool
+		Operations                  *bool
+		// PlanTimeout and ApplyTimeout override the organization's run phase
+		// timeouts, in minutes. Zero removes the override.
+		PlanTimeout  *int
+		ApplyTimeout *int
+		// ProjectID moves the workspace into a project. An empty string
+		// removes the workspace from its project.
+		ProjectID                  *string
 		QueueAllRuns               *bool
 		SpeculativeEnabled         *bool
 		StructuredRunOutputEnabled *bool
@@ -153,6 +213,10 @@ type (
 		// setting TriggerPatterns or ConnectOptions.TagsRegex.
 		AlwaysTrigger *bool
 
+		// ClearAutoDestroyAt unsets AutoDestroyAt. It is invalid to specify
+		// true along with a non-nil AutoDestroyAt.
+		ClearAutoDestroyAt bool
+
 		// Disconnect workspace from repo. It is invalid to specify true for an
 		// already disconnected workspace.
 		Disconnect bool
@@ -169,6 +233,7 @@ type (
 		Search       string
 		Tags         []string
 		Organization *string `schema:"organization_name"`
+		ProjectID    *string `schema:"project_id"`
 
 		resource.PageOptions
 	}
@@ -191,6 +256,7 @@ func NewWorkspace(opts CreateOptions) (*Workspace, error) {
 		CreatedAt:          internal.CurrentTimestamp(nil),
 		UpdatedAt:          internal.CurrentTimestamp(nil),
 		AllowDestroyPlan:   DefaultAllowDestroyPlan,
+		Engine:             releases.DefaultEngine,
 		ExecutionMode:      RemoteExecutionMode,
 		TerraformVersion:   releases.DefaultTerraformVersion,
 		SpeculativeEnabled: true,
@@ -205,15 +271,47 @@ func NewWorkspace(opts CreateOptions) (*Workspace, error) {
 	if opts.AllowDestroyPlan != nil {
 		ws.AllowDestroyPlan = *opts.AllowDestroyPlan
 	}
+	if opts.AssessmentsEnabled != nil {
+		ws.AssessmentsEnabled = *opts.AssessmentsEnabled
+	}
 	if opts.AutoApply != nil {
 		ws.AutoApply = *opts.AutoApply
 	}
+	if opts.AutoDestroyAt != nil {
+		ws.setAutoDestroyAt(*opts.AutoDestroyAt)
+	}
+	if opts.AutoDestroyActivityDuration != nil {
+		if err := ws.setAutoDestroyActivityDuration(*opts.AutoDestroyActivityDuration); err != nil {
+			return nil, err
+		}
+	}
+	if opts.DeleteAfterAutoDestroy != nil {
+		ws.DeleteAfterAutoDestroy = *opts.DeleteAfterAutoDestroy
+	}
 	if opts.Description != nil {
 		ws.Description = *opts.Description
 	}
 	if opts.GlobalRemoteState != nil {
 		ws.GlobalRemoteState = *opts.GlobalRemoteState
 	}
+	if opts.ProjectID != nil {
+		ws.setProjectID(*opts.ProjectID)
+	}
+	if opts.AgentSelector != nil {
+		if err := ws.setAgentSelector(*opts.AgentSelector); err != nil {
+			return nil, err
+		}
+	}
+	if opts.PlanTimeout != nil {
+		if err := setTimeout(&ws.PlanTimeout, *opts.PlanTimeout); err != nil {
+			return nil, err
+		}
+	}
+	if opts.ApplyTimeout != nil {
+		if err := setTimeout(&ws.ApplyTimeout, *opts.ApplyTimeout); err != nil {
+			return nil, err
+		}
+	}
 	if opts.QueueAllRuns != nil {
 		ws.QueueAllRuns = *opts.QueueAllRuns
 	}
@@ -229,6 +327,13 @@ func NewWorkspace(opts CreateOptions) (*Workspace, error) {
 	if opts.StructuredRunOutputEnabled != nil {
 		ws.StructuredRunOutputEnabled = *opts.StructuredRunOutputEnabled
 	}
+	if opts.Engine != nil {
+		if err := ws.setEngine(*opts.Engine); err != nil {
+			return nil, err
+		}
+		// default to the engine's own default version
+		ws.TerraformVersion = ws.Engine.DefaultVersion()
+	}
 	if opts.TerraformVersion != nil {
 		if err := ws.setTerraformVersion(*opts.TerraformVersion); err != nil {
 			return nil, err
@@ -305,10 +410,35 @@ func (ws *Workspace) Update(opts UpdateOptions) (*bool, error) {
 		ws.AllowDestroyPlan = *opts.AllowDestroyPlan
 		updated = true
 	}
+	if opts.AssessmentsEnabled != nil {
+		ws.AssessmentsEnabled = *opts.AssessmentsEnabled
+		updated = true
+	}
 	if opts.AutoApply != nil {
 		ws.AutoApply = *opts.AutoApply
 		updated = true
 	}
+	if opts.ClearAutoDestroyAt {
+		if opts.AutoDestroyAt != nil {
+			return nil, errors.New("auto-destroy-at must be nil if clear auto-destroy-at is true")
+		}
+		ws.AutoDestroyAt = nil
+		updated = true
+	}
+	if opts.AutoDestroyAt != nil {
+		ws.setAutoDestroyAt(*opts.AutoDestroyAt)
+		updated = true
+	}
+	if opts.AutoDestroyActivityDuration != nil {
+		if err := ws.setAutoDestroyActivityDuration(*opts.AutoDestroyActivityDuration); err != nil {
+			return nil, err
+		}
+		updated = true
+	}
+	if opts.DeleteAfterAutoDestroy != nil {
+		ws.DeleteAfterAutoDestroy = *opts.DeleteAfterAutoDestroy
+		updated = true
+	}
 	if opts.Description != nil {
 		ws.Description = *opts.Description
 		updated = true
@@ -330,6 +460,28 @@ func (ws *Workspace) Update(opts UpdateOptions) (*bool, error) {
 		ws.GlobalRemoteState = *opts.GlobalRemoteState
 		updated = true
 	}
+	if opts.ProjectID != nil {
+		ws.setProjectID(*opts.ProjectID)
+		updated = true
+	}
+	if opts.AgentSelector != nil {
+		if err := ws.setAgentSelector(*opts.AgentSelector); err != nil {
+			return nil, err
+		}
+		updated = true
+	}
+	if opts.PlanTimeout != nil {
+		if err := setTimeout(&ws.PlanTimeout, *opts.PlanTimeout); err != nil {
+			return nil, err
+		}
+		updated = true
+	}
+	if opts.ApplyTimeout != nil {
+		if err := setTimeout(&ws.ApplyTimeout, *opts.ApplyTimeout); err != nil {
+			return nil, err
+		}
+		updated = true
+	}
 	if opts.QueueAllRuns != nil {
 		ws.QueueAllRuns = *opts.QueueAllRuns
 		updated = true
@@ -342,6 +494,18 @@ func (ws *Workspace) Update(opts UpdateOptions) (*bool, error) {
 		ws.StructuredRunOutputEnabled = *opts.StructuredRunOutputEnabled
 		updated = true
 	}
+	if opts.Engine != nil && *opts.Engine != ws.Engine {
+		if err := ws.setEngine(*opts.Engine); err != nil {
+			return nil, err
+		}
+		if opts.TerraformVersion == nil {
+			// check existing version is supported by the new engine
+			if err := ws.setTerraformVersion(ws.TerraformVersion); err != nil {
+				return nil, err
+			}
+		}
+		updated = true
+	}
 	if opts.TerraformVersion != nil {
 		if err := ws.setTerraformVersion(*opts.TerraformVersion); err != nil {
 			return nil, err
@@ -502,6 +666,48 @@ func (ws *Workspace) setExecutionModeAndAgentPoolID(m *ExecutionMode, agentPoolI
 	return true, nil
 }
 
+// setProjectID sets the workspace's project; an empty string removes the
+// workspace from its project.
+func (ws *Workspace) setProjectID(projectID string) {
+	if projectID == "" {
+		ws.ProjectID = nil
+		return
+	}
+	ws.ProjectID = &projectID
+}
+
+// setAgentSelector validates and sets the workspace's agent selector.
+func (ws *Workspace) setAgentSelector(selector string) error {
+	parsed, err := internal.ParseLabelSelector(selector)
+	if err != nil {
+		return err
+	}
+	ws.AgentSelector = parsed.String()
+	return nil
+}
+
+// setTimeout sets a run phase timeout in minutes; zero removes the timeout.
+func setTimeout(timeout **int, minutes int) error {
+	switch {
+	case minutes < 0:
+		return internal.ErrInvalidTimeout
+	case minutes == 0:
+		*timeout = nil
+	default:
+		*timeout = &minutes
+	}
+	return nil
+}
+
+func (ws *Workspace) setEngine(engine releases.Engine) error {
+	if err := engine.Valid(); err != nil {
+		return err
+	}
+	ws.Engine = engine
+	return nil
+}
+
+// setTerraformVersion sets the version of the workspace's engine.
 func (ws *Workspace) setTerraformVersion(v string) error {
 	if v == releases.LatestVersionString {
 		ws.TerraformVersion = v
@@ -510,14 +716,21 @@ func (ws *Workspace) setTerraformVersion(v string) error {
 	if !semver.IsValid(v) {
 		return internal.ErrInvalidTerraformVersion
 	}
-	// only accept terraform versions above the minimum requirement.
+	// only accept versions above the minimum requirement for the engine.
 	//
 	// NOTE: we make an exception for the specific versions posted by the go-tfe
 	// integration tests.
-	if result := semver.Compare(v, MinTerraformVersion); result < 0 {
-		if !slices.Contains(apiTestTerraformVersions, v) {
+	switch ws.Engine {
+	case releases.TofuEngine:
+		if result := semver.Compare(v, MinTofuVersion); result < 0 {
 			return ErrUnsupportedTerraformVersion
 		}
+	default:
+		if result := semver.Compare(v, MinTerraformVersion); result < 0 {
+			if !slices.Contains(apiTestTerraformVersions, v) {
+				return ErrUnsupportedTerraformVersion
+			}
+		}
 	}
 	ws.TerraformVersion = v
 	return nil
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tofutf/tofutf/internal"
+	"github.com/tofutf/tofutf/internal/releases"
 )
 
 func TestNewWorkspace(t *testing.T) {
@@ -69,6 +70,34 @@ func TestNewWorkspace(t *testing.T) {
 			},
 			want: ErrUnsupportedTerraformVersion,
 		},
+		{
+			name: "tofu engine",
+			opts: CreateOptions{
+				Name:             internal.String("my-workspace"),
+				Organization:     internal.String("my-org"),
+				Engine:           enginePtr(releases.TofuEngine),
+				TerraformVersion: internal.String("1.6.2"),
+			},
+		},
+		{
+			name: "unsupported tofu version",
+			opts: CreateOptions{
+				Name:             internal.String("my-workspace"),
+				Organization:     internal.String("my-org"),
+				Engine:           enginePtr(releases.TofuEngine),
+				TerraformVersion: internal.String("1.5.0"),
+			},
+			want: ErrUnsupportedTerraformVersion,
+		},
+		{
+			name: "invalid engine",
+			opts: CreateOptions{
+				Name:         internal.String("my-workspace"),
+				Organization: internal.String("my-org"),
+				Engine:       enginePtr("pulumi"),
+			},
+			want: releases.ErrInvalidEngine,
+		},
 		{
 			name: "specifying both tags regex and trigger patterns",
 			opts: CreateOptions{
@@ -187,6 +216,22 @@ func TestWorkspace_UpdateError(t *testing.T) {
 			},
 			want: internal.ErrInvalidName,
 		},
+		{
+			name: "invalid agent selector",
+			ws:   &Workspace{Name: "dev", Organization: "acme"},
+			opts: UpdateOptions{
+				AgentSelector: internal.String("region=eu=us"),
+			},
+			want: internal.ErrInvalidLabelSelector,
+		},
+		{
+			name: "negative plan timeout",
+			ws:   &Workspace{Name: "dev", Organization: "acme"},
+			opts: UpdateOptions{
+				PlanTimeout: internal.Int(-1),
+			},
+			want: internal.ErrInvalidTimeout,
+		},
 		{
 			name: "bad terraform version",
 			ws:   &Workspace{Name: "dev", Organization: "acme"},
@@ -205,6 +250,14 @@ func TestWorkspace_UpdateError(t *testing.T) {
 			},
 			want: ErrUnsupportedTerraformVersion,
 		},
+		{
+			name: "switch to tofu with unsupported existing version",
+			ws:   &Workspace{Name: "dev", Organization: "acme", Engine: releases.TerraformEngine, TerraformVersion: "1.5.7"},
+			opts: UpdateOptions{
+				Engine: enginePtr(releases.TofuEngine),
+			},
+			want: ErrUnsupportedTerraformVersion,
+		},
 		{
 			name: "specifying both tags regex and trigger patterns",
 			ws:   &Workspace{Name: "dev", Organization: "acme"},
@@ -339,6 +392,60 @@ func TestWorkspace_Update(t *testing.T) {
 				assert.Equal(t, []string{"/foo/**/*.tf"}, got.TriggerPatterns)
 			},
 		},
+		{
+			name: "switch to tofu",
+			ws:   &Workspace{Name: "dev", Organization: "acme", Engine: releases.TerraformEngine, TerraformVersion: "1.5.7"},
+			opts: UpdateOptions{
+				Engine:           enginePtr(releases.TofuEngine),
+				TerraformVersion: internal.String("1.6.2"),
+			},
+			want: func(t *testing.T, got *Workspace) {
+				assert.Equal(t, releases.TofuEngine, got.Engine)
+				assert.Equal(t, "1.6.2", got.TerraformVersion)
+			},
+		},
+		{
+			name: "enable assessments",
+			ws:   &Workspace{Name: "dev", Organization: "acme"},
+			opts: UpdateOptions{
+				AssessmentsEnabled: internal.Bool(true),
+			},
+			want: func(t *testing.T, got *Workspace) {
+				assert.True(t, got.AssessmentsEnabled)
+			},
+		},
+		{
+			name: "set agent selector",
+			ws:   &Workspace{Name: "dev", Organization: "acme"},
+			opts: UpdateOptions{
+				AgentSelector: internal.String("region = eu, gpu!=true"),
+			},
+			want: func(t *testing.T, got *Workspace) {
+				assert.Equal(t, "region=eu,gpu!=true", got.AgentSelector)
+			},
+		},
+		{
+			name: "set timeouts",
+			ws:   &Workspace{Name: "dev", Organization: "acme"},
+			opts: UpdateOptions{
+				PlanTimeout:  internal.Int(30),
+				ApplyTimeout: internal.Int(120),
+			},
+			want: func(t *testing.T, got *Workspace) {
+				assert.Equal(t, internal.Int(30), got.PlanTimeout)
+				assert.Equal(t, internal.Int(120), got.ApplyTimeout)
+			},
+		},
+		{
+			name: "remove timeout",
+			ws:   &Workspace{Name: "dev", Organization: "acme", PlanTimeout: internal.Int(30)},
+			opts: UpdateOptions{
+				PlanTimeout: internal.Int(0),
+			},
+			want: func(t *testing.T, got *Workspace) {
+				assert.Nil(t, got.PlanTimeout)
+			},
+		},
 		{
 			name: "trigger patterns to tags regex",
 			ws: &Workspace{
@@ -425,3 +532,5 @@ func TestWorkspace_UpdateConnection(t *testing.T) {
 		})
 	}
 }
+
+func enginePtr(e releases.Engine) *releases.Engine { return &e }